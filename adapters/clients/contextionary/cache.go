@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// CacheConfig controls the local cache of word vectors in front of the
+// remote contextionary
+type CacheConfig struct {
+	// MaxEntries is the number of word vectors held before the least
+	// recently used ones are evicted. A value of 0 disables the cache.
+	MaxEntries int
+
+	// TTL is the time after which a cached vector is no longer served. A
+	// value of 0 keeps vectors until they are evicted.
+	TTL time.Duration
+
+	// VersionCheckInterval is the minimum time between two checks of the
+	// contextionary version. The cache is purged when the version changes.
+	VersionCheckInterval time.Duration
+}
+
+// Enabled is true if the word vector cache should be used
+func (c CacheConfig) Enabled() bool {
+	return c.MaxEntries > 0
+}
+
+// CacheStats are counters of the word vector cache since startup or the last
+// purge caused by a version change
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Size      int64
+}
+
+type cacheEntry struct {
+	word     string
+	vector   []float32
+	cachedAt time.Time
+}
+
+type wordVectorCache struct {
+	sync.Mutex
+	config  CacheConfig
+	entries map[string]*list.Element
+	lru     *list.List
+	stats   CacheStats
+	now     func() time.Time
+
+	version          string
+	versionCheckedAt time.Time
+}
+
+func newWordVectorCache(config CacheConfig) *wordVectorCache {
+	return &wordVectorCache{
+		config:  config,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *wordVectorCache) get(word string) ([]float32, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[word]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if c.config.TTL > 0 && c.now().Sub(entry.cachedAt) > c.config.TTL {
+		c.remove(elem)
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.stats.Hits++
+	return entry.vector, true
+}
+
+func (c *wordVectorCache) set(word string, vector []float32) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[word]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.vector = vector
+		entry.cachedAt = c.now()
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[word] = c.lru.PushFront(&cacheEntry{
+		word:     word,
+		vector:   vector,
+		cachedAt: c.now(),
+	})
+
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// remove must be called with the lock held
+func (c *wordVectorCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).word)
+}
+
+// versionCheckDue is true if the version of the contextionary should be
+// verified before the cache is used again
+func (c *wordVectorCache) versionCheckDue() bool {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.versionCheckedAt.IsZero() ||
+		c.now().Sub(c.versionCheckedAt) >= c.config.VersionCheckInterval
+}
+
+// setVersion purges all entries if the version differs from the one the
+// cached vectors were retrieved with
+func (c *wordVectorCache) setVersion(version string) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.versionCheckedAt = c.now()
+	if version == c.version {
+		return
+	}
+
+	c.version = version
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+	c.stats = CacheStats{}
+}
+
+func (c *wordVectorCache) getStats() CacheStats {
+	c.Lock()
+	defer c.Unlock()
+
+	stats := c.stats
+	stats.Size = int64(c.lru.Len())
+	return stats
+}
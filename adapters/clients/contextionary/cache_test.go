@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/semi-technologies/contextionary/contextionary"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestWordVectorCache(t *testing.T) {
+	newTestCache := func(config CacheConfig) (*wordVectorCache, *time.Time) {
+		now := time.Now()
+		c := newWordVectorCache(config)
+		c.now = func() time.Time { return now }
+		return c, &now
+	}
+
+	t.Run("the least recently used word is evicted", func(t *testing.T) {
+		c, _ := newTestCache(CacheConfig{MaxEntries: 2})
+		c.set("car", []float32{1})
+		c.set("bike", []float32{2})
+		_, ok := c.get("car")
+		require.True(t, ok)
+
+		c.set("train", []float32{3})
+
+		_, ok = c.get("bike")
+		assert.False(t, ok)
+		_, ok = c.get("car")
+		assert.True(t, ok)
+		assert.Equal(t, CacheStats{Hits: 2, Misses: 1, Evictions: 1, Size: 2}, c.getStats())
+	})
+
+	t.Run("an entry is no longer served after the ttl", func(t *testing.T) {
+		c, now := newTestCache(CacheConfig{MaxEntries: 2, TTL: time.Minute})
+		c.set("car", []float32{1})
+
+		*now = now.Add(59 * time.Second)
+		_, ok := c.get("car")
+		assert.True(t, ok)
+
+		*now = now.Add(2 * time.Second)
+		_, ok = c.get("car")
+		assert.False(t, ok)
+		assert.Equal(t, int64(0), c.getStats().Size)
+	})
+
+	t.Run("a version change purges the cache", func(t *testing.T) {
+		c, now := newTestCache(CacheConfig{MaxEntries: 2, VersionCheckInterval: time.Minute})
+		assert.True(t, c.versionCheckDue())
+		c.setVersion("0.1.0")
+		c.set("car", []float32{1})
+		assert.False(t, c.versionCheckDue())
+
+		*now = now.Add(time.Minute)
+		assert.True(t, c.versionCheckDue())
+		c.setVersion("0.1.0")
+		_, ok := c.get("car")
+		assert.True(t, ok)
+
+		c.setVersion("0.2.0")
+		_, ok = c.get("car")
+		assert.False(t, ok)
+		assert.Equal(t, CacheStats{Misses: 1}, c.getStats())
+	})
+}
+
+func TestClientWithCache(t *testing.T) {
+	remote := &fakeGRPCClient{version: "0.1.0"}
+	logger, _ := test.NewNullLogger()
+	c := &Client{
+		grpcClient: remote,
+		retry:      newRetrier(RetryConfig{}, logger),
+		cache: newWordVectorCache(CacheConfig{
+			MaxEntries:           100,
+			VersionCheckInterval: time.Hour,
+		}),
+	}
+	ctx := context.Background()
+
+	t.Run("only uncached words are requested", func(t *testing.T) {
+		_, err := c.MultiVectorForWord(ctx, []string{"car", "bike"})
+		require.Nil(t, err)
+
+		res, err := c.MultiVectorForWord(ctx, []string{"car", "train", "bike"})
+		require.Nil(t, err)
+
+		assert.Equal(t, [][]float32{{3}, {5}, {4}}, res)
+		assert.Equal(t, [][]string{{"car", "bike"}, {"train"}}, remote.requested)
+		assert.Equal(t, CacheStats{Hits: 2, Misses: 3, Size: 3}, c.CacheStats())
+	})
+
+	t.Run("a new contextionary version invalidates the cache", func(t *testing.T) {
+		remote.version = "0.2.0"
+		_, err := c.Version(ctx)
+		require.Nil(t, err)
+
+		v, err := c.VectorForWord(ctx, "car")
+		require.Nil(t, err)
+
+		assert.Equal(t, []float32{3}, v)
+		assert.Equal(t, CacheStats{Misses: 1, Size: 1}, c.CacheStats())
+	})
+}
+
+// fakeGRPCClient returns the length of the word as the only vector position
+type fakeGRPCClient struct {
+	pb.ContextionaryClient
+	version   string
+	metaErr   error
+	metaCalls int
+	requested [][]string
+}
+
+func (f *fakeGRPCClient) Meta(ctx context.Context, in *pb.MetaParams,
+	opts ...grpc.CallOption) (*pb.MetaOverview, error) {
+	f.metaCalls++
+	if f.metaErr != nil {
+		return nil, f.metaErr
+	}
+	return &pb.MetaOverview{Version: f.version}, nil
+}
+
+func (f *fakeGRPCClient) VectorForWord(ctx context.Context, in *pb.Word,
+	opts ...grpc.CallOption) (*pb.Vector, error) {
+	f.requested = append(f.requested, []string{in.Word})
+	return vectorToProto([]float32{float32(len(in.Word))}), nil
+}
+
+func (f *fakeGRPCClient) MultiVectorForWord(ctx context.Context, in *pb.WordList,
+	opts ...grpc.CallOption) (*pb.VectorList, error) {
+	var words []string
+	var vectors []*pb.Vector
+	for _, word := range in.Words {
+		words = append(words, word.Word)
+		vectors = append(vectors, vectorToProto([]float32{float32(len(word.Word))}))
+	}
+	f.requested = append(f.requested, words)
+	return &pb.VectorList{Vectors: vectors}, nil
+}
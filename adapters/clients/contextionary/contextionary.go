@@ -20,6 +20,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,24 +29,58 @@ import (
 // Client establishes a gRPC connection to a remote contextionary service
 type Client struct {
 	grpcClient pb.ContextionaryClient
+	retry      *retrier
+	cache      *wordVectorCache
 }
 
-// NewClient from gRPC discovery url to connect to a remote contextionary service
-func NewClient(uri string) (*Client, error) {
-	conn, err := grpc.Dial(uri, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*48)))
+// NewClient from gRPC discovery url to connect to a remote contextionary
+// service. The connection is secured according to the TLSConfig. Transient
+// errors are retried and a circuit breaker is used according to the
+// specified RetryConfig. Word vectors are cached locally according to the
+// CacheConfig.
+func NewClient(uri string, tlsConfig TLSConfig, retryConfig RetryConfig,
+	cacheConfig CacheConfig, logger logrus.FieldLogger) (*Client, error) {
+	transportSecurity, err := tlsConfig.dialOption()
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls config for remote contextionary: %v", err)
+	}
+
+	conn, err := grpc.Dial(uri, transportSecurity,
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*48)),
+		grpc.WithUnaryInterceptor(tracingInterceptor))
 	if err != nil {
 		return nil, fmt.Errorf("couldn't connect to remote contextionary gRPC server: %s", err)
 	}
 
 	client := pb.NewContextionaryClient(conn)
-	return &Client{
+	c := &Client{
 		grpcClient: client,
-	}, nil
+		retry:      newRetrier(retryConfig, logger),
+	}
+	if cacheConfig.Enabled() {
+		c.cache = newWordVectorCache(cacheConfig)
+	}
+
+	return c, nil
+}
+
+// Ping checks whether the contextionary can currently be used. It goes
+// through the circuit breaker like every other call, so while the breaker is
+// open it fails without contacting the contextionary. Once the cooldown has
+// passed, a ping can be the trial call which closes the breaker again.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Version(ctx)
+	return err
 }
 
 // IsStopWord returns true if the given word is a stopword, errors on connection errors
 func (c *Client) IsStopWord(ctx context.Context, word string) (bool, error) {
-	res, err := c.grpcClient.IsWordStopword(ctx, &pb.Word{Word: word})
+	var res *pb.WordStopword
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.IsWordStopword(ctx, &pb.Word{Word: word})
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -55,7 +90,12 @@ func (c *Client) IsStopWord(ctx context.Context, word string) (bool, error) {
 
 // IsWordPresent returns true if the given word is a stopword, errors on connection errors
 func (c *Client) IsWordPresent(ctx context.Context, word string) (bool, error) {
-	res, err := c.grpcClient.IsWordPresent(ctx, &pb.Word{Word: word})
+	var res *pb.WordPresent
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.IsWordPresent(ctx, &pb.Word{Word: word})
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -65,7 +105,12 @@ func (c *Client) IsWordPresent(ctx context.Context, word string) (bool, error) {
 
 //SafeGetSimilarWordsWithCertainty will alwasy return a list words - unless there is a network error
 func (c *Client) SafeGetSimilarWordsWithCertainty(ctx context.Context, word string, certainty float32) ([]string, error) {
-	res, err := c.grpcClient.SafeGetSimilarWordsWithCertainty(ctx, &pb.SimilarWordsParams{Word: word, Certainty: certainty})
+	var res *pb.SimilarWordsResults
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.SafeGetSimilarWordsWithCertainty(ctx, &pb.SimilarWordsParams{Word: word, Certainty: certainty})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +133,12 @@ func (c *Client) SchemaSearch(ctx context.Context, params traverser.SearchParams
 		SearchType: searchTypeToProto(params.SearchType),
 	}
 
-	res, err := c.grpcClient.SchemaSearch(ctx, pbParams)
+	var res *pb.SchemaSearchResults
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.SchemaSearch(ctx, pbParams)
+		return err
+	})
 	if err != nil {
 		return traverser.SearchResults{}, err
 	}
@@ -173,24 +223,94 @@ func searchResultsFromProto(input []*pb.SchemaSearchResult) []traverser.SearchRe
 	return output
 }
 
+// CacheStats of the local word vector cache, all counters are zero if the
+// cache is disabled
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+
+	return c.cache.getStats()
+}
+
+// BreakerState of the circuit breaker in front of the contextionary
+func (c *Client) BreakerState() BreakerState {
+	return c.retry.breakerState()
+}
+
+// cacheUsable verifies the contextionary version if a check is due. The cache
+// is bypassed if the version cannot be determined.
+func (c *Client) cacheUsable(ctx context.Context) bool {
+	if c.cache == nil {
+		return false
+	}
+
+	if !c.cache.versionCheckDue() {
+		return true
+	}
+
+	// Version updates the cache with the current version
+	_, err := c.Version(ctx)
+	return err == nil
+}
+
 func (c *Client) VectorForWord(ctx context.Context, word string) ([]float32, error) {
-	res, err := c.grpcClient.VectorForWord(ctx, &pb.Word{Word: word})
+	useCache := c.cacheUsable(ctx)
+	if useCache {
+		if v, ok := c.cache.get(word); ok {
+			return v, nil
+		}
+	}
+
+	var res *pb.Vector
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.VectorForWord(ctx, &pb.Word{Word: word})
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("could not get vector from remote: %v", err)
 	}
 	v, _, _ := vectorFromProto(res)
+	if useCache && len(v) > 0 {
+		c.cache.set(word, v)
+	}
 	return v, nil
 }
 
 func (c *Client) MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error) {
 	out := make([][]float32, len(words))
-	wordParams := make([]*pb.Word, len(words))
+	useCache := c.cacheUsable(ctx)
 
+	// only the words which could not be served from the cache are requested,
+	// missingPos holds their position in the output
+	var missingPos []int
 	for i, word := range words {
-		wordParams[i] = &pb.Word{Word: word}
+		if useCache {
+			if v, ok := c.cache.get(word); ok {
+				out[i] = v
+				continue
+			}
+		}
+
+		missingPos = append(missingPos, i)
+	}
+
+	if len(missingPos) == 0 {
+		return out, nil
 	}
 
-	res, err := c.grpcClient.MultiVectorForWord(ctx, &pb.WordList{Words: wordParams})
+	wordParams := make([]*pb.Word, len(missingPos))
+	for i, pos := range missingPos {
+		wordParams[i] = &pb.Word{Word: words[pos]}
+	}
+
+	var res *pb.VectorList
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.MultiVectorForWord(ctx, &pb.WordList{Words: wordParams})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +321,11 @@ func (c *Client) MultiVectorForWord(ctx context.Context, words []string) ([][]fl
 			continue
 		}
 
-		out[i], _, _ = vectorFromProto(elem)
+		pos := missingPos[i]
+		out[pos], _, _ = vectorFromProto(elem)
+		if useCache {
+			c.cache.set(words[pos], out[pos])
+		}
 	}
 
 	return out, nil
@@ -219,7 +343,12 @@ func (c *Client) MultiNearestWordsByVector(ctx context.Context, vectors [][]floa
 		}
 	}
 
-	res, err := c.grpcClient.MultiNearestWordsByVector(ctx, &pb.VectorNNParamsList{Params: searchParams})
+	var res *pb.NearestWordsList
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.MultiNearestWordsByVector(ctx, &pb.VectorNNParamsList{Params: searchParams})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +395,17 @@ func vectorFromProto(in *pb.Vector) ([]float32, []vectorizer.InputElement, error
 
 func (c *Client) VectorForCorpi(ctx context.Context, corpi []string, overridesMap map[string]string) ([]float32, []vectorizer.InputElement, error) {
 	overrides := overridesFromMap(overridesMap)
-	res, err := c.grpcClient.VectorForCorpi(ctx, &pb.Corpi{Corpi: corpi, Overrides: overrides})
+	var res *pb.Vector
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.VectorForCorpi(ctx, &pb.Corpi{Corpi: corpi, Overrides: overrides})
+		return err
+	})
 	if err != nil {
+		if _, ok := err.(vectorizer.ErrContextionaryUnavailable); ok {
+			return nil, nil, err
+		}
+
 		st, ok := status.FromError(err)
 		if !ok || st.Code() != codes.InvalidArgument {
 			return nil, nil, fmt.Errorf("could not get vector from remote: %v", err)
@@ -280,10 +418,15 @@ func (c *Client) VectorForCorpi(ctx context.Context, corpi []string, overridesMa
 }
 
 func (c *Client) NearestWordsByVector(ctx context.Context, vector []float32, n int, k int) ([]string, []float32, error) {
-	res, err := c.grpcClient.NearestWordsByVector(ctx, &pb.VectorNNParams{
-		K:      int32(k),
-		N:      int32(n),
-		Vector: vectorToProto(vector),
+	var res *pb.NearestWords
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = c.grpcClient.NearestWordsByVector(ctx, &pb.VectorNNParams{
+			K:      int32(k),
+			N:      int32(n),
+			Vector: vectorToProto(vector),
+		})
+		return err
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not get nearest words by vector: %v", err)
@@ -293,16 +436,30 @@ func (c *Client) NearestWordsByVector(ctx context.Context, vector []float32, n i
 }
 
 func (c *Client) Version(ctx context.Context) (string, error) {
-	m, err := c.grpcClient.Meta(ctx, &pb.MetaParams{})
+	var m *pb.MetaOverview
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		m, err = c.grpcClient.Meta(ctx, &pb.MetaParams{})
+		return err
+	})
 	if err != nil {
 		return "", err
 	}
 
+	if c.cache != nil {
+		c.cache.setVersion(m.Version)
+	}
+
 	return m.Version, nil
 }
 
 func (c *Client) WordCount(ctx context.Context) (int64, error) {
-	m, err := c.grpcClient.Meta(ctx, &pb.MetaParams{})
+	var m *pb.MetaOverview
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		var err error
+		m, err = c.grpcClient.Meta(ctx, &pb.MetaParams{})
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -311,10 +468,13 @@ func (c *Client) WordCount(ctx context.Context) (int64, error) {
 }
 
 func (c *Client) AddExtension(ctx context.Context, extension *models.C11yExtension) error {
-	_, err := c.grpcClient.AddExtension(ctx, &pb.ExtensionInput{
-		Concept:    extension.Concept,
-		Definition: extension.Definition,
-		Weight:     extension.Weight,
+	err := c.retry.do(ctx, func(ctx context.Context) error {
+		_, err := c.grpcClient.AddExtension(ctx, &pb.ExtensionInput{
+			Concept:    extension.Concept,
+			Definition: extension.Definition,
+			Weight:     extension.Weight,
+		})
+		return err
 	})
 
 	return err
@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig controls how transient failures of the remote contextionary
+// are handled
+type RetryConfig struct {
+	// MaxRetries is the number of additional attempts after the first call
+	// failed with a transient error
+	MaxRetries int
+
+	// InitialBackoff is the wait time before the first retry, it doubles with
+	// every subsequent retry
+	InitialBackoff time.Duration
+
+	// BreakerThreshold is the number of consecutive failed calls (after all
+	// retries) after which the breaker opens. A value of 0 disables the
+	// breaker.
+	BreakerThreshold int
+
+	// BreakerCooldown is the time the breaker stays open before a single trial
+	// call is let through
+	BreakerCooldown time.Duration
+}
+
+// BreakerState indicates whether calls to the contextionary are currently
+// allowed
+type BreakerState string
+
+const (
+	// BreakerClosed is the healthy state, all calls are let through
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen means the contextionary is considered unavailable, calls
+	// fail immediately
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen means the cooldown has passed and a single trial call
+	// is allowed to determine whether the contextionary is back, all other
+	// calls fail immediately until it has returned
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+type retrier struct {
+	sync.Mutex
+	config           RetryConfig
+	logger           logrus.FieldLogger
+	state            BreakerState
+	consecutiveFails int
+	openedAt         time.Time
+	trialInFlight    bool
+	now              func() time.Time
+	sleep            func(ctx context.Context, d time.Duration) error
+}
+
+func newRetrier(config RetryConfig, logger logrus.FieldLogger) *retrier {
+	return &retrier{
+		config: config,
+		logger: logger,
+		state:  BreakerClosed,
+		now:    time.Now,
+		sleep:  sleepWithContext,
+	}
+}
+
+// do executes the specified call, retries it on transient errors and keeps
+// track of the breaker state. Non-transient errors, such as invalid
+// arguments, are returned as is and do not affect the breaker.
+func (r *retrier) do(ctx context.Context, call func(ctx context.Context) error) error {
+	trial, err := r.allow()
+	if err != nil {
+		return err
+	}
+
+	backoff := r.config.InitialBackoff
+	for attempt := 0; attempt <= r.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if sleepErr := r.sleep(ctx, backoff); sleepErr != nil {
+				// the caller gave up, this says nothing about the health of the
+				// contextionary, so the breaker is left untouched apart from
+				// letting another call be the trial
+				if trial {
+					r.abandonTrial()
+				}
+				return err
+			}
+			backoff = backoff * 2
+		}
+
+		err = call(ctx)
+		if err == nil {
+			r.success()
+			return nil
+		}
+
+		if !isTransient(err) {
+			r.success()
+			return err
+		}
+	}
+
+	r.failure()
+	return vectorizer.NewErrContextionaryUnavailablef(
+		"contextionary unreachable after %d attempt(s): %v", r.config.MaxRetries+1, err)
+}
+
+// allow returns an error if the call must fail fast, trial indicates that it
+// is the single call let through while the breaker is half-open
+func (r *retrier) allow() (trial bool, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	switch r.state {
+	case BreakerClosed:
+		return false, nil
+	case BreakerHalfOpen:
+		if r.trialInFlight {
+			return false, vectorizer.NewErrContextionaryUnavailablef(
+				"contextionary unreachable: circuit breaker is half-open and waiting for its trial call")
+		}
+	default:
+		if r.now().Sub(r.openedAt) < r.config.BreakerCooldown {
+			return false, vectorizer.NewErrContextionaryUnavailablef(
+				"contextionary unreachable: circuit breaker is open after %d consecutive failures",
+				r.consecutiveFails)
+		}
+
+		r.setState(BreakerHalfOpen)
+	}
+
+	r.trialInFlight = true
+	return true, nil
+}
+
+func (r *retrier) success() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.consecutiveFails = 0
+	r.trialInFlight = false
+	if r.state != BreakerClosed {
+		r.setState(BreakerClosed)
+	}
+}
+
+func (r *retrier) failure() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.consecutiveFails++
+	r.trialInFlight = false
+	if r.config.BreakerThreshold <= 0 {
+		return
+	}
+
+	if r.state == BreakerHalfOpen || r.consecutiveFails >= r.config.BreakerThreshold {
+		r.openedAt = r.now()
+		if r.state != BreakerOpen {
+			r.setState(BreakerOpen)
+		}
+	}
+}
+
+// abandonTrial is called when the caller of the trial call gave up before its
+// outcome was known, so the next call becomes the trial instead
+func (r *retrier) abandonTrial() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.trialInFlight = false
+}
+
+// setState must be called with the lock held
+func (r *retrier) setState(state BreakerState) {
+	r.logger.WithField("action", "contextionary_circuit_breaker").
+		WithField("previous_state", r.state).
+		WithField("state", state).
+		WithField("consecutive_failures", r.consecutiveFails).
+		Warnf("contextionary circuit breaker changed from %s to %s", r.state, state)
+	r.state = state
+}
+
+func (r *retrier) breakerState() BreakerState {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.state
+}
+
+// isTransient indicates whether an error was caused by the contextionary
+// being (temporarily) unreachable, rather than by the request itself
+func isTransient(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted,
+		codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context done while waiting to retry: %v", ctx.Err())
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetrier(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	invalid := status.Error(codes.InvalidArgument, "no usable words")
+
+	newTestRetrier := func() (*retrier, *time.Time) {
+		logger, _ := test.NewNullLogger()
+		now := time.Now()
+		r := newRetrier(RetryConfig{
+			MaxRetries:       2,
+			InitialBackoff:   time.Millisecond,
+			BreakerThreshold: 2,
+			BreakerCooldown:  10 * time.Second,
+		}, logger)
+		r.now = func() time.Time { return now }
+		r.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+		return r, &now
+	}
+
+	t.Run("a transient error is absorbed by a retry", func(t *testing.T) {
+		r, _ := newTestRetrier()
+		calls := 0
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			if calls == 1 {
+				return unavailable
+			}
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+
+	t.Run("a non-transient error is not retried", func(t *testing.T) {
+		r, _ := newTestRetrier()
+		calls := 0
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return invalid
+		})
+
+		assert.Equal(t, invalid, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+
+	t.Run("exhausting all retries returns an unavailable error", func(t *testing.T) {
+		r, _ := newTestRetrier()
+		calls := 0
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return unavailable
+		})
+
+		assert.IsType(t, vectorizer.ErrContextionaryUnavailable{}, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+
+	t.Run("the breaker opens, fails fast and recovers after cooldown", func(t *testing.T) {
+		r, now := newTestRetrier()
+		failing := func(ctx context.Context) error { return unavailable }
+
+		r.do(context.Background(), failing)
+		r.do(context.Background(), failing)
+		assert.Equal(t, BreakerOpen, r.breakerState())
+
+		calls := 0
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return nil
+		})
+		assert.IsType(t, vectorizer.ErrContextionaryUnavailable{}, err)
+		assert.Equal(t, 0, calls, "no call should be made while the breaker is open")
+
+		*now = now.Add(11 * time.Second)
+		err = r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+
+	t.Run("a failed trial call re-opens the breaker", func(t *testing.T) {
+		r, now := newTestRetrier()
+		failing := func(ctx context.Context) error { return unavailable }
+
+		r.do(context.Background(), failing)
+		r.do(context.Background(), failing)
+		*now = now.Add(11 * time.Second)
+		r.do(context.Background(), failing)
+
+		assert.Equal(t, BreakerOpen, r.breakerState())
+	})
+
+	t.Run("only a single trial call is let through while half-open", func(t *testing.T) {
+		r, now := newTestRetrier()
+		failing := func(ctx context.Context) error { return unavailable }
+
+		r.do(context.Background(), failing)
+		r.do(context.Background(), failing)
+		*now = now.Add(11 * time.Second)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		trialErr := make(chan error)
+		go func() {
+			trialErr <- r.do(context.Background(), func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+		<-started
+		assert.Equal(t, BreakerHalfOpen, r.breakerState())
+
+		calls := 0
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return nil
+		})
+		assert.IsType(t, vectorizer.ErrContextionaryUnavailable{}, err)
+		assert.Equal(t, 0, calls, "no other call should be made during the trial")
+
+		close(release)
+		require.Nil(t, <-trialErr)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+
+	t.Run("an abandoned trial call lets the next call be the trial", func(t *testing.T) {
+		r, now := newTestRetrier()
+		failing := func(ctx context.Context) error { return unavailable }
+
+		r.do(context.Background(), failing)
+		r.do(context.Background(), failing)
+		*now = now.Add(11 * time.Second)
+
+		r.sleep = func(ctx context.Context, d time.Duration) error {
+			return context.Canceled
+		}
+		r.do(context.Background(), failing)
+		assert.Equal(t, BreakerHalfOpen, r.breakerState())
+
+		err := r.do(context.Background(), func(ctx context.Context) error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, BreakerClosed, r.breakerState())
+	})
+}
+
+func TestPingWithOpenBreaker(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	remote := &fakeGRPCClient{
+		version: "0.1.0",
+		metaErr: status.Error(codes.Unavailable, "connection refused"),
+	}
+	c := &Client{
+		grpcClient: remote,
+		retry: newRetrier(RetryConfig{
+			BreakerThreshold: 1,
+			BreakerCooldown:  time.Hour,
+		}, logger),
+	}
+
+	require.NotNil(t, c.Ping(context.Background()))
+	require.Equal(t, BreakerOpen, c.retry.breakerState())
+
+	remote.metaErr = nil
+	err := c.Ping(context.Background())
+	assert.IsType(t, vectorizer.ErrContextionaryUnavailable{}, err,
+		"the instance must not be ready while the breaker is open")
+	assert.Equal(t, 1, remote.metaCalls)
+}
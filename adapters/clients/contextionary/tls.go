@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig secures the connection to the remote contextionary. If all
+// fields are empty, the connection is not encrypted.
+type TLSConfig struct {
+	// CAFile is a PEM-encoded bundle of the certificate authorities the
+	// server certificate is verified against. If empty, the system's root
+	// certificates are used.
+	CAFile string
+
+	// CertFile and KeyFile are the PEM-encoded client certificate and key
+	// presented to the server (mTLS). Either both or none must be set.
+	CertFile string
+	KeyFile  string
+
+	// ServerName overrides the host name the server certificate is verified
+	// against, defaults to the host of the url
+	ServerName string
+}
+
+// Enabled is true if any TLS setting is present
+func (c TLSConfig) Enabled() bool {
+	return c.CAFile != "" || c.CertFile != "" || c.KeyFile != "" || c.ServerName != ""
+}
+
+// dialOption returns the transport security for the connection. A
+// misconfiguration is an error, there is no fallback to an unencrypted
+// connection.
+func (c TLSConfig) dialOption() (grpc.DialOption, error) {
+	if !c.Enabled() {
+		return grpc.WithInsecure(), nil
+	}
+
+	cfg := &tls.Config{
+		ServerName: c.ServerName,
+	}
+
+	if c.CAFile != "" {
+		pem, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca file %q does not contain any PEM-encoded certificate",
+				c.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, fmt.Errorf("client certificate and key must be set together, " +
+			"but only one of them is present")
+	}
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "c11y-tls")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeSelfSignedCert(t, dir)
+	notPEM := filepath.Join(dir, "not-pem.txt")
+	require.Nil(t, ioutil.WriteFile(notPEM, []byte("not a certificate"), 0600))
+
+	type test struct {
+		name        string
+		config      TLSConfig
+		expectedErr bool
+	}
+
+	tests := []test{
+		{
+			name:   "without any settings",
+			config: TLSConfig{},
+		},
+		{
+			name:   "with a ca file",
+			config: TLSConfig{CAFile: certFile},
+		},
+		{
+			name:   "with a server name only",
+			config: TLSConfig{ServerName: "contextionary"},
+		},
+		{
+			name:   "with a client certificate",
+			config: TLSConfig{CAFile: certFile, CertFile: certFile, KeyFile: keyFile},
+		},
+		{
+			name:        "with a missing ca file",
+			config:      TLSConfig{CAFile: filepath.Join(dir, "missing.pem")},
+			expectedErr: true,
+		},
+		{
+			name:        "with a ca file without certificates",
+			config:      TLSConfig{CAFile: notPEM},
+			expectedErr: true,
+		},
+		{
+			name:        "with a client certificate but no key",
+			config:      TLSConfig{CertFile: certFile},
+			expectedErr: true,
+		},
+		{
+			name:        "with a key which does not match the certificate",
+			config:      TLSConfig{CertFile: certFile, KeyFile: notPEM},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opt, err := test.config.dialOption()
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, opt)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotNil(t, opt)
+		})
+	}
+
+	t.Run("the client cannot be created with an invalid config", func(t *testing.T) {
+		_, err := NewClient("localhost:9999", TLSConfig{CertFile: certFile},
+			RetryConfig{}, CacheConfig{}, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func writeSelfSignedCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "contextionary"},
+		DNSNames:              []string{"contextionary"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.Nil(t, ioutil.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certFile, keyFile
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package contextionary
+
+import (
+	"context"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// tracingInterceptor wraps every call to the contextionary in a span and
+// propagates the trace and the request id to the contextionary through the
+// gRPC metadata
+func tracingInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "contextionary"+method)
+	defer span.Finish()
+
+	if spanCtx := span.Context(); spanCtx.TraceID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			strings.ToLower(tracing.TraceIDHeader), spanCtx.TraceID,
+			strings.ToLower(tracing.SpanIDHeader), spanCtx.SpanID)
+	}
+
+	if id := requestid.FromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			strings.ToLower(requestid.Header), id)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		span.SetTag("error", err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package webhook delivers change events to an HTTP endpoint
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/usecases/events"
+)
+
+// Sink posts every event as JSON to the configured URL. Any response other
+// than 2xx is considered a failed delivery.
+type Sink struct {
+	url    string
+	client *http.Client
+}
+
+// New webhook sink for the specified url
+func New(url string) *Sink {
+	return &Sink{
+		url:    url,
+		client: &http.Client{},
+	}
+}
+
+// Send the event to the webhook
+func (s *Sink) Send(ctx context.Context, event events.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("webhook: marshal event: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("webhook: send request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -39,6 +39,9 @@ func ClassPropertyField(dataType schema.DataType, class *models.Class,
 	case schema.DataTypeGeoCoordinates:
 		// simply skip for now, see gh-729
 		return nil, nil
+	case schema.DataTypeBlob:
+		// binary data can't be aggregated
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
@@ -13,15 +13,24 @@
 package descriptions
 
 const (
-	LocalExplore         = "Explore Concepts on a local weaviate with vector-aided search"
-	LocalExploreConcepts = "Explore Concepts on a local weaviate with vector-aided serach through keyword-based search terms"
-	VectorMovement       = "Move your search term closer to or further away from another vector described by keywords"
-	Keywords             = "Keywords are a list of search terms. Array type, e.g. [\"keyword 1\", \"keyword 2\"]"
-	Network              = "Set to true, if the exploration should include remote peers"
-	Limit                = "Limit the results set (usually fewer results mean faster queries)"
-	Certainty            = "Desired Certainty. The higher the value the stricter the search becomes, the lower the value the fuzzier the search becomes"
-	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
-	ClassName            = "Name of the Class"
-	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
-	Distance             = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	LocalExplore          = "Explore Concepts on a local weaviate with vector-aided search"
+	LocalExploreConcepts  = "Explore Concepts on a local weaviate with vector-aided serach through keyword-based search terms"
+	VectorMovement        = "Move your search term closer to or further away from another vector described by keywords"
+	Keywords              = "Keywords are a list of search terms. Array type, e.g. [\"keyword 1\", \"keyword 2\"]"
+	Network               = "Set to true, if the exploration should include remote peers"
+	Limit                 = "Limit the results set (usually fewer results mean faster queries)"
+	Certainty             = "Desired Certainty. The higher the value the stricter the search becomes, the lower the value the fuzzier the search becomes"
+	EF                    = "Size of the dynamic candidate list of the vector index for this search. Higher values improve recall at the cost of speed. Defaults to the ef of the class or 8 times the limit"
+	ExploreClassName      = "Only explore the objects of these classes, which is faster than exploring all classes. Array type, e.g. [\"Article\", \"Author\"]"
+	NearVector            = "Search by a vector that was computed outside of weaviate instead of by concepts. The vector must have the same dimensions as the vectors in the index"
+	NearVectorVector      = "The raw search vector. Array type, e.g. [0.1, -0.3, 0.7]"
+	PropertyBoost         = "Order the results by how similar their individual text properties are to the concepts, with the listed properties counting more or less than the others. Properties which are not listed have a weight of 1"
+	PropertyBoostProperty = "The name of the text property to boost"
+	PropertyBoostWeight   = "The weight of the property, must not be negative. A weight of 0 ignores the property, a weight of 2 counts the property twice as much as the others"
+	Force                 = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
+	MovementWeights       = "Optionally weigh the concepts of the movement against each other, one weight between 0 and 1 per concept in the same order as the concepts. Without weights, all concepts count the same"
+	ClassName             = "Name of the Class"
+	Beacon                = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
+	Distance              = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	ResultCertainty       = "Certainty of the match between the result item and the search vector. This is 1 minus the distance and the value which the certainty argument is compared against"
 )
@@ -35,7 +35,7 @@ const WhereOperands = "Contains the Operands that can be applied to a 'where' fi
 const WhereOperandsInpObj = "An object containing the Operands that can be applied to a 'where' filter"
 
 const WhereOperator = "Contains the Operators that can be applied to a 'where' filter"
-const WhereOperatorEnum = "An object containing the Operators that can be applied to a 'where' filter"
+const WhereOperatorEnum = "An object containing the Operators that can be applied to a 'where' filter. IsNull and IsNotNull match objects without or with a value for the property at the path and take no value. An empty string, a string of only whitespace and a text without any words count as null."
 
 const WherePath = "Specify the path from the Things or Actions fields to the property name (e.g. ['Things', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 
@@ -23,6 +23,14 @@ const GetThingsActionsObj = "An object used to get %ss on a local Weaviate"
 
 const GetClassUUID = "The UUID of a Thing or Action, assigned by its local Weaviate"
 
+const GetRerank = "Reorder the results of a vector search by a weighted blend of their certainty and a numeric property"
+const GetRerankProperty = "The numeric property to blend into the ranking, e.g. a popularity score or a timestamp"
+const GetRerankWeight = "The weight of the property in the ranking. Must be between 0 and 1 where 0 keeps the vector search order and 1 orders by the property alone"
+
+const GetSort = "Sort the results of a list query by the creation time of the objects"
+const GetSortPath = "The path of the property to sort by, only [\"_creationTimeUnix\"] is supported"
+const GetSortOrder = "The sort order, either ascending (asc) or descending (desc). Defaults to asc"
+
 // Network
 const NetworkGet = "Get Things or Actions from a Weaviate in a network"
 const NetworkGetObj = "An object used to Get Things or Actions from a Weaviate in a network"
@@ -262,6 +262,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypePhoneNumber:
 		// skipping for now, see gh-1088 where it was outscoped
 		return nil, nil
+	case schema.DataTypeBlob:
+		// binary data can't be aggregated
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
@@ -18,11 +18,21 @@ import "github.com/semi-technologies/weaviate/usecases/traverser"
 func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 	var args traverser.ExploreParams
 
-	// keywords is a required argument, so we don't need to check for its existing
-	keywords := source["concepts"].([]interface{})
-	args.Values = make([]string, len(keywords), len(keywords))
-	for i, value := range keywords {
-		args.Values[i] = value.(string)
+	// keywords are required on Get, but optional on Explore where nearVector
+	// can be used instead
+	if keywords, ok := source["concepts"].([]interface{}); ok {
+		args.Values = make([]string, len(keywords), len(keywords))
+		for i, value := range keywords {
+			args.Values[i] = value.(string)
+		}
+	}
+
+	// className is an optional arg, so it could be nil
+	if classNames, ok := source["className"].([]interface{}); ok {
+		args.ClassNames = make([]string, len(classNames))
+		for i, value := range classNames {
+			args.ClassNames[i] = value.(string)
+		}
 	}
 
 	// limit is an optional arg, so it could be nil
@@ -38,6 +48,12 @@ func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 		args.Certainty = certainty.(float64)
 	}
 
+	// ef is an optional arg, so it could be nil
+	ef, ok := source["ef"]
+	if ok {
+		args.EF = ef.(int)
+	}
+
 	// moveTo is an optional arg, so it could be nil
 	moveTo, ok := source["moveTo"]
 	if ok {
@@ -56,13 +72,49 @@ func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 		args.MoveAwayFrom = extractMovement(moveAwayFrom)
 	}
 
+	// boost is an optional arg, so it could be nil
+	if boosts, ok := source["boost"].([]interface{}); ok {
+		args.PropertyBoosts = extractPropertyBoosts(boosts)
+	}
+
+	// nearVector is an optional arg, so it could be nil
+	nearVector, ok := source["nearVector"]
+	if ok {
+		args.Vector = ExtractNearVector(nearVector.(map[string]interface{})).Vector
+	}
+
+	return args
+}
+
+// ExtractNearVector arguments, such as "vector" and "certainty"
+func ExtractNearVector(source map[string]interface{}) traverser.ExploreParams {
+	var args traverser.ExploreParams
+
+	// vector is a required argument, so we don't need to check for its existing
+	vector := source["vector"].([]interface{})
+	args.Vector = make([]float32, len(vector), len(vector))
+	for i, value := range vector {
+		args.Vector[i] = float32(value.(float64))
+	}
+
+	certainty, ok := source["certainty"]
+	if ok {
+		args.Certainty = certainty.(float64)
+	}
+
+	// ef is an optional arg, so it could be nil
+	ef, ok := source["ef"]
+	if ok {
+		args.EF = ef.(int)
+	}
+
 	return args
 }
 
 func extractMovement(input interface{}) traverser.ExploreMove {
 	// the type is fixed through gql config, no need to catch incorrect type
-	// assumption, all fields are required so we don't need to check for their
-	// presence
+	// assumption, all fields except for the weights are required so we don't
+	// need to check for their presence
 	moveToMap := input.(map[string]interface{})
 	res := traverser.ExploreMove{}
 	res.Force = float32(moveToMap["force"].(float64))
@@ -73,5 +125,28 @@ func extractMovement(input interface{}) traverser.ExploreMove {
 		res.Values[i] = value.(string)
 	}
 
+	if weights, ok := moveToMap["weights"].([]interface{}); ok {
+		res.Weights = make([]float32, len(weights))
+		for i, value := range weights {
+			res.Weights[i] = float32(value.(float64))
+		}
+	}
+
+	return res
+}
+
+func extractPropertyBoosts(input []interface{}) []traverser.PropertyBoost {
+	// the type is fixed through gql config, no need to catch incorrect type
+	// assumption, all fields are required so we don't need to check for their
+	// presence
+	res := make([]traverser.PropertyBoost, len(input))
+	for i, item := range input {
+		boost := item.(map[string]interface{})
+		res[i] = traverser.PropertyBoost{
+			Property: boost["property"].(string),
+			Weight:   float32(boost["weight"].(float64)),
+		}
+	}
+
 	return res
 }
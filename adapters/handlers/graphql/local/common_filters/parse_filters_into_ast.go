@@ -14,7 +14,6 @@ package common_filters
 import (
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -32,9 +31,13 @@ func ExtractFilters(args map[string]interface{}, rootClass string) (*filters.Loc
 		rootClause, err := parseClause(whereMap, rootClass)
 		if err != nil {
 			return nil, err
-		} else {
-			return &filters.LocalFilter{Root: rootClause}, nil
 		}
+
+		if err := filters.ValidateClause(rootClause); err != nil {
+			return nil, err
+		}
+
+		return &filters.LocalFilter{Root: rootClause}, nil
 	}
 }
 
@@ -54,7 +57,7 @@ func parseClause(args map[string]interface{}, rootClass string) (*filters.Clause
 	case "Or":
 		clause, err = parseOperandsOp(args, filters.OperatorOr, rootClass)
 	case "Not":
-		clause, err = parseOperandsOp(args, filters.OperatorOr, rootClass)
+		clause, err = parseOperandsOp(args, filters.OperatorNot, rootClass)
 	case "Equal":
 		clause, err = parseCompareOp(args, filters.OperatorEqual, rootClass)
 	case "Like":
@@ -71,6 +74,10 @@ func parseClause(args map[string]interface{}, rootClass string) (*filters.Clause
 		clause, err = parseCompareOp(args, filters.OperatorLessThanEqual, rootClass)
 	case "WithinGeoRange":
 		clause, err = parseCompareOp(args, filters.OperatorWithinGeoRange, rootClass)
+	case "IsNull":
+		clause, err = parseNullOp(args, filters.OperatorIsNull, rootClass)
+	case "IsNotNull":
+		clause, err = parseNullOp(args, filters.OperatorIsNotNull, rootClass)
 	default:
 		err = fmt.Errorf("Unknown operator '%s' in clause %s", operator, jsonify(args))
 	}
@@ -107,6 +114,29 @@ func parseCompareOp(args map[string]interface{}, operator filters.Operator, root
 	}, nil
 }
 
+// Parses an IsNull or IsNotNull filter, it only has a path, but no value
+func parseNullOp(args map[string]interface{}, operator filters.Operator, rootClass string) (*filters.Clause, error) {
+	if _, operandsPresent := args["operands"]; operandsPresent {
+		return nil, fmt.Errorf("a 'operands' is given in clause '%s'; this is not allowed for a %s clause", jsonify(args), operator.Name())
+	}
+
+	path, err := parsePathFromArgs(args, rootClass)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extractor := range valueExtractors {
+		if value, _ := extractor(args); value != nil {
+			return nil, fmt.Errorf("a value is given in clause '%s'; this is not allowed for a %s clause", jsonify(args), operator.Name())
+		}
+	}
+
+	return &filters.Clause{
+		Operator: operator,
+		On:       path,
+	}, nil
+}
+
 // Parse an 'operand' filter.
 // One of those has:
 // 1. The operator appied (e.g. And, Or)
@@ -334,10 +364,9 @@ var valueExtractors [](func(args map[string]interface{}) (*filters.Value, error)
 			return nil, fmt.Errorf("the provided valueDate is not a date string")
 		}
 
-		date, err := time.Parse(time.RFC3339, stringVal)
-
+		date, err := filters.ParseDate(stringVal)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse the value '%s' as a date in valueDate", stringVal)
+			return nil, fmt.Errorf("failed to parse the value in valueDate: %v", err)
 		}
 
 		return &filters.Value{
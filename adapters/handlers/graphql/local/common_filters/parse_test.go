@@ -13,6 +13,7 @@ package common_filters
 
 import (
 	"testing"
+	"time"
 
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/location"
@@ -52,6 +53,85 @@ func TestExtractFilterToplevelField(t *testing.T) {
 	query := `{ SomeAction(where: { path: ["intField"], operator: Equal, valueInt: 42}) }`
 	resolver.AssertResolve(t, query)
 }
+func TestExtractFilterDate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a timezone offset", func(t *testing.T) {
+		resolver := newMockResolver()
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorGreaterThan,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("dateField"),
+			},
+			Value: &filters.Value{
+				Value: time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+				Type:  schema.DataTypeDate,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+				path: ["dateField"],
+				operator: GreaterThan,
+				valueDate: "2020-06-01T12:00:00+02:00",
+			}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an invalid date", func(t *testing.T) {
+		resolver := newMockResolver()
+		query := `{ SomeAction(where: {
+				path: ["dateField"],
+				operator: GreaterThan,
+				valueDate: "yesterday",
+			}) }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestExtractFilterCreationTimeRange(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	creationTimePath := &filters.Path{
+		Class:    schema.AssertValidClassName("SomeAction"),
+		Property: schema.PropertyName(filters.InternalPropCreationTimeUnix),
+	}
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{
+			filters.Clause{
+				Operator: filters.OperatorGreaterThanEqual,
+				On:       creationTimePath,
+				Value: &filters.Value{
+					Value: time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+					Type:  schema.DataTypeDate,
+				},
+			},
+			filters.Clause{
+				Operator: filters.OperatorLessThan,
+				On:       creationTimePath,
+				Value: &filters.Value{
+					Value: time.Date(2020, 6, 1, 11, 0, 0, 0, time.UTC),
+					Type:  schema.DataTypeDate,
+				},
+			},
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: { operator: And, operands: [
+			{ operator: GreaterThanEqual, valueDate: "2020-06-01T10:00:00Z", path: ["_creationTimeUnix"] },
+			{ operator: LessThan, valueDate: "2020-06-01T11:00:00Z", path: ["_creationTimeUnix"] }
+		]}) }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestExtractFilterLike(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +185,59 @@ func TestExtractFilterLike_ValueText(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterIsNull(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorIsNull,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: IsNull,
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterIsNotNull(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorIsNotNull,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: IsNotNull,
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterIsNullFailsWithValue(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ SomeAction(where: { path: ["name"], operator: IsNull, valueString: "foo" }) }`
+	resolver.AssertFailToResolve(t, query)
+}
+
 func TestExtractFilterGeoLocation(t *testing.T) {
 	t.Parallel()
 
@@ -268,3 +401,61 @@ func TestExtractOperandFailsIfPathPresent(t *testing.T) {
 	query := `{ SomeAction(where: { path:["should", "not", "be", "present"], operator: And  })}`
 	resolver.AssertFailToResolve(t, query)
 }
+
+func TestExtractNegatedOperand(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorNot,
+		Operands: []filters.Clause{filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 42,
+					Type:  schema.DataTypeInt,
+				},
+			},
+				filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("SomeAction"),
+						Property: schema.AssertValidPropertyName("name"),
+					},
+					Value: &filters.Value{
+						Value: "foo",
+						Type:  schema.DataTypeString,
+					},
+				},
+			}},
+		}}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: { operator: Not, operands: [
+      { operator: And, operands: [
+        { operator: Equal, valueInt: 42,      path: ["intField"]},
+        { operator: Equal, valueString: "foo", path: ["name"] }
+      ]}
+    ]}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractNegatedOperandFailsWithMultipleOperands(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ SomeAction(where: { operator: Not, operands: [
+      { operator: Equal, valueInt: 42, path: ["intField"]},
+      { operator: Equal, valueInt: 43, path: ["intField"]}
+    ]}) }`
+	resolver.AssertFailToResolve(t, query)
+}
@@ -33,12 +33,29 @@ func Build() *graphql.Field {
 			},
 			"concepts": &graphql.ArgumentConfig{
 				Description: descriptions.Keywords,
-				Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+				Type:        graphql.NewList(graphql.String),
+			},
+			"nearVector": &graphql.ArgumentConfig{
+				Description: descriptions.NearVector,
+				Type: graphql.NewInputObject(
+					graphql.InputObjectConfig{
+						Name: "ExploreNearVectorInpObj",
+						Fields: graphql.InputObjectConfigFieldMap{
+							"vector": &graphql.InputObjectFieldConfig{
+								Description: descriptions.NearVectorVector,
+								Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+							},
+						},
+					}),
 			},
 			"limit": &graphql.ArgumentConfig{
 				Type:        graphql.Int,
 				Description: descriptions.Limit,
 			},
+			"className": &graphql.ArgumentConfig{
+				Description: descriptions.ExploreClassName,
+				Type:        graphql.NewList(graphql.NewNonNull(graphql.String)),
+			},
 			"certainty": &graphql.ArgumentConfig{
 				Type:        graphql.Float,
 				Description: descriptions.Certainty,
@@ -94,18 +111,32 @@ func exploreObject() *graphql.Object {
 		},
 
 		"certainty": &graphql.Field{
-			Name:        "ExploreBeacon",
-			Description: descriptions.Distance,
+			Name:        "ExploreCertainty",
+			Description: descriptions.ResultCertainty,
 			Type:        graphql.Float,
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 				vsr, ok := p.Source.(search.Result)
 				if !ok {
-					return nil, fmt.Errorf("unknown type %T in Explore..className resolver", p.Source)
+					return nil, fmt.Errorf("unknown type %T in Explore..certainty resolver", p.Source)
 				}
 
 				return vsr.Certainty, nil
 			},
 		},
+
+		"distance": &graphql.Field{
+			Name:        "ExploreDistance",
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				vsr, ok := p.Source.(search.Result)
+				if !ok {
+					return nil, fmt.Errorf("unknown type %T in Explore..distance resolver", p.Source)
+				}
+
+				return vsr.Distance, nil
+			},
+		},
 	}
 
 	getLocalExploreFieldsObject := graphql.ObjectConfig{
@@ -127,5 +158,9 @@ func movementInp() graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Force,
 			Type:        graphql.NewNonNull(graphql.Float),
 		},
+		"weights": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MovementWeights,
+			Type:        graphql.NewList(graphql.NewNonNull(graphql.Float)),
+		},
 	}
 }
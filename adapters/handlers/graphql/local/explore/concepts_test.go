@@ -43,7 +43,7 @@ func Test_ResolveExplore(t *testing.T) {
 			query: `
 			{ 
 					Explore(concepts: ["car", "best brand"]) {
-							beacon className certainty
+							beacon className certainty distance
 					}
 			}`,
 			expectedParamsToTraverser: traverser.ExploreParams{
@@ -54,6 +54,7 @@ func Test_ResolveExplore(t *testing.T) {
 					Beacon:    "weaviate://localhost/things/some-uuid",
 					ClassName: "bestClass",
 					Certainty: 0.7,
+					Distance:  0.3,
 				},
 			},
 			expectedResults: []result{{
@@ -63,6 +64,7 @@ func Test_ResolveExplore(t *testing.T) {
 						"beacon":    "weaviate://localhost/things/some-uuid",
 						"className": "bestClass",
 						"certainty": float32(0.7),
+						"distance":  float32(0.3),
 					},
 				},
 			}},
@@ -100,6 +102,64 @@ func Test_ResolveExplore(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "with a nearVector instead of concepts",
+			query: `
+			{
+					Explore(nearVector: {vector: [0.1, -0.3, 0.7]}, certainty: 0.6) {
+							beacon className
+				}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				Vector:    []float32{0.1, -0.3, 0.7},
+				Certainty: 0.6,
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "limited to specific classes",
+			query: `
+			{
+					Explore(concepts: ["car"], className: ["Car", "Manufacturer"]) {
+							beacon className
+				}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				Values:     []string{"car"},
+				ClassNames: []string{"Car", "Manufacturer"},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "Car",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "Car",
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with moveTo set",
 			query: `
@@ -187,6 +247,56 @@ func Test_ResolveExplore(t *testing.T) {
 				},
 			}},
 		},
+
+		testCase{
+			name: "with weighted concepts in moveTo and moveAwayFrom",
+			query: `
+			{
+					Explore(
+							concepts: ["apple"]
+							moveTo: {
+								concepts: ["company", "technology"]
+								force: 0.8
+								weights: [1, 0.5]
+							}
+							moveAwayFrom: {
+								concepts: ["fruit"]
+								force: 0.6
+								weights: [0.9]
+							}
+							) {
+							beacon className
+						}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				Values: []string{"apple"},
+				MoveTo: traverser.ExploreMove{
+					Values:  []string{"company", "technology"},
+					Force:   0.8,
+					Weights: []float32{1, 0.5},
+				},
+				MoveAwayFrom: traverser.ExploreMove{
+					Values:  []string{"fruit"},
+					Force:   0.6,
+					Weights: []float32{0.9},
+				},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
 	}
 
 	tests.AssertExtraction(t)
@@ -159,7 +159,14 @@ func (b *classBuilder) underscoreFields(classProperties graphql.Fields, kindName
 	classProperties["_nearestNeighbors"] = b.underscoreNNField(kindName, class)
 	classProperties["_featureProjection"] = b.underscoreFeatureProjectionField(kindName, class)
 	classProperties["_semanticPath"] = b.underscoreSemanticPathField(kindName, class)
-
+	classProperties["_certainty"] = &graphql.Field{
+		Description: descriptions.ResultCertainty,
+		Type:        graphql.Float,
+	}
+	classProperties["_distance"] = &graphql.Field{
+		Description: descriptions.Distance,
+		Type:        graphql.Float,
+	}
 }
 
 func (b *classBuilder) underscoreClassificationField(kindName string, class *models.Class) *graphql.Field {
@@ -190,6 +197,13 @@ func (b *classBuilder) underscoreInterpretationField(kindName string, class *mod
 						"occurrence": &graphql.Field{Type: graphql.Int},
 					},
 				}))},
+				"contributions": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sUnderscoreInterpretationContribution", class.Class),
+					Fields: graphql.Fields{
+						"concept": &graphql.Field{Type: graphql.String},
+						"weight":  &graphql.Field{Type: graphql.Float},
+					},
+				}))},
 			},
 		}),
 	}
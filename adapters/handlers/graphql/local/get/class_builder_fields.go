@@ -50,6 +50,13 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String,
 		}
+	case schema.DataTypeBlob:
+		// base64 encoded, only returned if explicitly selected
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.String,
+		}
 	case schema.DataTypeInt:
 		return &graphql.Field{
 			Description: property.Description,
@@ -172,9 +179,12 @@ func buildGetClassField(classObject *graphql.Object, k kind.Kind,
 				Description: descriptions.First,
 				Type:        graphql.Int,
 			},
-			"explore": exploreArgument(kindName, class.Class),
-			"where":   whereArgument(kindName, class.Class),
-			"group":   groupArgument(kindName, class.Class),
+			"explore":    exploreArgument(kindName, class.Class),
+			"nearVector": nearVectorArgument(kindName, class.Class),
+			"where":      whereArgument(kindName, class.Class),
+			"group":      groupArgument(kindName, class.Class),
+			"rerank":     rerankArgument(kindName, class.Class),
+			"sort":       sortArgument(kindName, class.Class),
 		},
 		Resolve: makeResolveGetClass(k, class.Class),
 	}
@@ -271,7 +281,22 @@ func makeResolveGetClass(k kind.Kind, className string) graphql.FieldResolveFn {
 			exploreParams = &p
 		}
 
+		if nearVector, ok := p.Args["nearVector"]; ok {
+			if exploreParams != nil {
+				return nil, fmt.Errorf("explore and nearVector cannot be combined")
+			}
+
+			p := common_filters.ExtractNearVector(nearVector.(map[string]interface{}))
+			exploreParams = &p
+		}
+
 		group := extractGroup(p.Args)
+		rerank := extractRerank(p.Args)
+
+		sort, err := extractSort(p.Args)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract sort: %v", err)
+		}
 
 		params := traverser.GetParams{
 			Filters:              filters,
@@ -281,6 +306,8 @@ func makeResolveGetClass(k kind.Kind, className string) graphql.FieldResolveFn {
 			Properties:           properties,
 			Explore:              exploreParams,
 			Group:                group,
+			Rerank:               rerank,
+			Sort:                 sort,
 			UnderscoreProperties: underscore,
 		}
 
@@ -305,6 +332,21 @@ func extractGroup(args map[string]interface{}) *traverser.GroupParams {
 	}
 }
 
+func extractRerank(args map[string]interface{}) *traverser.RerankParams {
+	rerank, ok := args["rerank"]
+	if !ok {
+		return nil
+	}
+
+	asMap := rerank.(map[string]interface{}) // guaranteed by graphql
+	property := asMap["property"].(string)
+	weight := asMap["weight"].(float64)
+	return &traverser.RerankParams{
+		Property: property,
+		Weight:   float32(weight),
+	}
+}
+
 func principalFromContext(ctx context.Context) *models.Principal {
 	principal := ctx.Value("principal")
 	if principal == nil {
@@ -407,6 +449,10 @@ func extractProperties(selections *ast.SelectionSet, fragments map[string]ast.De
 				underscoreProps.NearestNeighbors = true
 			case "_semanticPath":
 				underscoreProps.SemanticPath = &sempath.Params{}
+			case "_certainty":
+				underscoreProps.Certainty = true
+			case "_distance":
+				underscoreProps.Distance = true
 			case "_featureProjection":
 				underscoreProps.FeatureProjection = parseFeatureProjectionArguments(field.Arguments)
 			}
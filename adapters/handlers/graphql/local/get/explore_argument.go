@@ -50,6 +50,10 @@ func exploreFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"ef": &graphql.InputObjectFieldConfig{
+			Description: descriptions.EF,
+			Type:        graphql.Int,
+		},
 		"moveAwayFrom": &graphql.InputObjectFieldConfig{
 			Description: descriptions.VectorMovement,
 			Type: graphql.NewInputObject(
@@ -58,6 +62,44 @@ func exploreFields(prefix string) graphql.InputObjectConfigFieldMap {
 					Fields: movementInp(),
 				}),
 		},
+		"boost": &graphql.InputObjectFieldConfig{
+			Description: descriptions.PropertyBoost,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:   fmt.Sprintf("%sBoost", prefix),
+					Fields: boostInp(),
+				})),
+		},
+	}
+}
+
+func nearVectorArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.NearVector,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields: nearVectorFields(),
+			},
+		),
+	}
+}
+
+func nearVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.NearVectorVector,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"ef": &graphql.InputObjectFieldConfig{
+			Description: descriptions.EF,
+			Type:        graphql.Int,
+		},
 	}
 }
 
@@ -71,5 +113,22 @@ func movementInp() graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Force,
 			Type:        graphql.NewNonNull(graphql.Float),
 		},
+		"weights": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MovementWeights,
+			Type:        graphql.NewList(graphql.NewNonNull(graphql.Float)),
+		},
+	}
+}
+
+func boostInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.PropertyBoostProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"weight": &graphql.InputObjectFieldConfig{
+			Description: descriptions.PropertyBoostWeight,
+			Type:        graphql.NewNonNull(graphql.Float),
+		},
 	}
 }
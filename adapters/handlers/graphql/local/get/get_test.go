@@ -528,6 +528,28 @@ func TestExtractUnderscoreFields(t *testing.T) {
 				},
 			},
 		},
+		test{
+			name:  "with _certainty and _distance",
+			query: "{ Get { Actions { SomeAction { _certainty _distance } } } }",
+			expectedParams: traverser.GetParams{
+				Kind:      kind.Action,
+				ClassName: "SomeAction",
+				UnderscoreProperties: traverser.UnderscoreProperties{
+					Certainty: true,
+					Distance:  true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_certainty": float32(0.75),
+					"_distance":  float32(0.25),
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_certainty": float32(0.75),
+				"_distance":  float32(0.25),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -618,6 +640,81 @@ func TestExploreRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with optional ef set", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(explore: {
+                concepts: ["c1", "c2", "c3"],
+								ef: 256
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Explore: &traverser.ExploreParams{
+				Values: []string{"c1", "c2", "c3"},
+				EF:     256,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with property boosts", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(explore: {
+                concepts: ["c1"],
+								boost: [{property: "title", weight: 2}, {property: "body", weight: 0.5}]
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Explore: &traverser.ExploreParams{
+				Values: []string{"c1"},
+				PropertyBoosts: []traverser.PropertyBoost{
+					{Property: "title", Weight: 2},
+					{Property: "body", Weight: 0.5},
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with a nearVector", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(nearVector: {
+                vector: [0.5, -1, 2],
+                certainty: 0.7
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Explore: &traverser.ExploreParams{
+				Vector:    []float32{0.5, -1, 2},
+				Certainty: 0.7,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with both explore and nearVector", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(
+								explore: { concepts: ["c1"] },
+								nearVector: { vector: [0.5, -1, 2] }
+        			) { intField } } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
 }
 
 func TestExtractPagination(t *testing.T) {
@@ -663,6 +760,79 @@ func TestExtractGroupParams(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractRerankParams(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	expectedParams := traverser.GetParams{
+		Kind:       kind.Action,
+		ClassName:  "SomeAction",
+		Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Rerank: &traverser.RerankParams{
+			Property: "intField",
+			Weight:   0.25,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := "{ Get { Actions { SomeAction(rerank: {property: \"intField\", weight: 0.25}) { intField } } } }"
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractSortParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("by creation time, newest first", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Sort: &filters.Sort{
+				Property: filters.InternalPropCreationTimeUnix,
+				Order:    filters.SortOrderDesc,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := "{ Get { Actions { SomeAction(sort: {path: [\"_creationTimeUnix\"], order: desc}) { intField } } } }"
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("without an order", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Sort: &filters.Sort{
+				Property: filters.InternalPropCreationTimeUnix,
+				Order:    filters.SortOrderAsc,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := "{ Get { Actions { SomeAction(sort: {path: [\"_creationTimeUnix\"]}) { intField } } } }"
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("by a regular property", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		query := "{ Get { Actions { SomeAction(sort: {path: [\"intField\"]}) { intField } } } }"
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
 func TestGetRelation(t *testing.T) {
 	t.Parallel()
 
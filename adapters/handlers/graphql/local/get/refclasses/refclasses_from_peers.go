@@ -141,6 +141,12 @@ func buildPrimitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String, // String since no graphql date datatype exists
 		}
+	case schema.DataTypeBlob:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.String,
+		}
 	default:
 		panic(fmt.Sprintf("buildGetClass: unknown primitive type for %s.%s.%s; %s",
 			networkClassName, className, property.Name, propertyType.AsPrimitive()))
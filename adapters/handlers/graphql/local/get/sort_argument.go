@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
+
+func sortArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GetSort,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sSortInpObj", prefix),
+				Fields:      sortFields(prefix),
+				Description: descriptions.GetSort,
+			},
+		),
+	}
+}
+
+func sortFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"path": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GetSortPath,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+		},
+		"order": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GetSortOrder,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sSortInpObjOrderEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					string(filters.SortOrderAsc):  &graphql.EnumValueConfig{},
+					string(filters.SortOrderDesc): &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+	}
+}
+
+func extractSort(args map[string]interface{}) (*filters.Sort, error) {
+	sort, ok := args["sort"]
+	if !ok {
+		return nil, nil
+	}
+
+	asMap := sort.(map[string]interface{}) // guaranteed by graphql
+	path := asMap["path"].([]interface{})
+	if len(path) != 1 {
+		return nil, fmt.Errorf("sort path must contain exactly one property, got %v", path)
+	}
+
+	property, ok := path[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("sort path must be a list of strings, got %v", path)
+	}
+
+	order := filters.SortOrderAsc
+	if asString, ok := asMap["order"].(string); ok {
+		order = filters.SortOrder(asString)
+	}
+
+	out := &filters.Sort{
+		Property: property,
+		Order:    order,
+	}
+
+	if err := filters.ValidateSort(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// batchLimiter limits how many batch requests are processed at the same
+// time across the whole server, so that several large imports running in
+// parallel can't exhaust memory, file handles and the contextionary. A
+// request which finds no free slot waits up to the queue timeout for one. A
+// nil limiter admits every request.
+type batchLimiter struct {
+	slots        chan struct{}
+	queueTimeout time.Duration
+}
+
+// newBatchLimiter returns nil if maxConcurrent is 0, which disables the limit
+func newBatchLimiter(maxConcurrent int, queueTimeout time.Duration) *batchLimiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+
+	return &batchLimiter{
+		slots:        make(chan struct{}, maxConcurrent),
+		queueTimeout: queueTimeout,
+	}
+}
+
+// acquire blocks until a slot is free and returns the func to free it again.
+// If no slot is freed within the queue timeout or the request is cancelled
+// in the meantime, it returns a kinds.ErrRateLimited.
+func (l *batchLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	default:
+	}
+
+	if l.queueTimeout > 0 {
+		timer := time.NewTimer(l.queueTimeout)
+		defer timer.Stop()
+
+		select {
+		case l.slots <- struct{}{}:
+			return l.release, nil
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+
+	return nil, kinds.NewErrRateLimited(time.Second,
+		"too many batch requests: at most %d are processed at the same time", cap(l.slots))
+}
+
+func (l *batchLimiter) release() {
+	<-l.slots
+}
+
+// handle runs the handler once a slot is acquired. If there is no free slot,
+// the response built by tooManyRequests is returned instead.
+func (l *batchLimiter) handle(ctx context.Context,
+	tooManyRequests func(retryAfter int64, payload *models.ErrorResponse) middleware.Responder,
+	handler func() middleware.Responder) middleware.Responder {
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return tooManyRequests(retryAfterSeconds(err), errPayloadFromSingleErr(err))
+	}
+	defer release()
+
+	return handler()
+}
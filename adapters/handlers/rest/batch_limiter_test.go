@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLimiter(t *testing.T) {
+	t.Run("without a limit", func(t *testing.T) {
+		limiter := newBatchLimiter(0, time.Second)
+		require.Nil(t, limiter)
+
+		for i := 0; i < 10; i++ {
+			_, err := limiter.acquire(context.Background())
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("without a queue timeout the request is rejected right away", func(t *testing.T) {
+		limiter := newBatchLimiter(1, 0)
+
+		release, err := limiter.acquire(context.Background())
+		require.Nil(t, err)
+
+		_, err = limiter.acquire(context.Background())
+		require.NotNil(t, err)
+		assert.IsType(t, kinds.ErrRateLimited{}, err)
+		assert.Equal(t, "too many batch requests: at most 1 are processed at the same time",
+			err.Error())
+
+		release()
+		release, err = limiter.acquire(context.Background())
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("with a queue timeout the request waits for a free slot", func(t *testing.T) {
+		limiter := newBatchLimiter(1, time.Second)
+
+		release, err := limiter.acquire(context.Background())
+		require.Nil(t, err)
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			release()
+		}()
+
+		release, err = limiter.acquire(context.Background())
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("with the queue timeout exceeded", func(t *testing.T) {
+		limiter := newBatchLimiter(1, 20*time.Millisecond)
+
+		_, err := limiter.acquire(context.Background())
+		require.Nil(t, err)
+
+		_, err = limiter.acquire(context.Background())
+		assert.IsType(t, kinds.ErrRateLimited{}, err)
+	})
+
+	t.Run("with the request cancelled while waiting", func(t *testing.T) {
+		limiter := newBatchLimiter(1, time.Minute)
+
+		_, err := limiter.acquire(context.Background())
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = limiter.acquire(ctx)
+		assert.IsType(t, kinds.ErrRateLimited{}, err)
+	})
+	t.Run("handling a request", func(t *testing.T) {
+		limiter := newBatchLimiter(1, 0)
+		var rejectedWith int64
+		tooManyRequests := func(retryAfter int64,
+			payload *models.ErrorResponse) middleware.Responder {
+			rejectedWith = retryAfter
+			return middleware.NotImplemented("rejected")
+		}
+
+		handled := 0
+		handler := func() middleware.Responder {
+			handled++
+			// a second request arrives while this one holds the slot
+			limiter.handle(context.Background(), tooManyRequests,
+				func() middleware.Responder {
+					handled++
+					return nil
+				})
+			return nil
+		}
+
+		limiter.handle(context.Background(), tooManyRequests, handler)
+		assert.Equal(t, 1, handled)
+		assert.Equal(t, int64(1), rejectedWith)
+
+		// the slot is freed again once the handler returned
+		limiter.handle(context.Background(), tooManyRequests,
+			func() middleware.Responder {
+				handled++
+				return nil
+			})
+		assert.Equal(t, 2, handled)
+	})
+}
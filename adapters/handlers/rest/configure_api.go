@@ -13,6 +13,7 @@ package rest
 
 import (
 	"context"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/semi-technologies/weaviate/adapters/clients/contextionary"
+	"github.com/semi-technologies/weaviate/adapters/clients/webhook"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/locks"
@@ -30,16 +32,22 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/esvector"
 	"github.com/semi-technologies/weaviate/adapters/repos/etcd"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/events"
+	"github.com/semi-technologies/weaviate/usecases/interpretation"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/semi-technologies/weaviate/usecases/nearestneighbors"
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
 	"github.com/semi-technologies/weaviate/usecases/projector"
+	"github.com/semi-technologies/weaviate/usecases/querycache"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
@@ -59,14 +67,10 @@ type vectorRepo interface {
 	kinds.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
+	kinds.ExpiredObjectsLister
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(time.Duration) error
-}
-
-type vectorizer interface {
-	kinds.Vectorizer
-	traverser.CorpiVectorizer
-	SetIndexChecker(libvectorizer.IndexCheck)
+	Ping(ctx context.Context) error
 }
 
 type explorer interface {
@@ -82,6 +86,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = errors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	// non-streamed responses, such as errors, are a single JSON document which
+	// is also a valid NDJSON stream
+	api.RegisterProducer(ndjsonMimeType, runtime.JSONProducer())
+	// shard restores upload the tar archive as is, it's unpacked by the
+	// storage
+	api.RegisterConsumer(tarMimeType, runtime.ByteStreamConsumer())
 
 	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
 		return appState.OIDC.ValidateAndExtract(token, scopes)
@@ -93,23 +103,51 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	var vectorRepo vectorRepo
 	var vectorMigrator migrate.Migrator
-	var vectorizer vectorizer
 	var migrator migrate.Migrator
 	var explorer explorer
-	nnExtender := nearestneighbors.NewExtender(appState.Contextionary)
+	var vectorIndexStats vectorIndexStatsProvider
+	var standaloneRepo *db.DB
+	vectorizer, err := libvectorizer.NewModule(appState.ServerConfig.Config.Vectorizer.Module,
+		libvectorizer.ModuleDependencies{
+			Contextionary:    appState.Contextionary,
+			BatchConcurrency: *appState.ServerConfig.Config.Batch.VectorizationConcurrency,
+		})
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize vectorizer module")
+		os.Exit(1)
+	}
+	wordSpace := libvectorizer.WordSpaceOf(vectorizer)
+	nnExtender := nearestneighbors.NewExtender(wordSpace)
 	featureProjector := projector.New()
-	pathBuilder := sempath.New(appState.Contextionary)
+	pathBuilder := sempath.New(wordSpace)
+	explainer := interpretation.New(wordSpace,
+		*appState.ServerConfig.Config.QueryDefaults.InterpretationContributions) // guaranteed not to be nil as there are defaults
 
 	if appState.ServerConfig.Config.Standalone {
 		repo := db.New(appState.Logger, db.Config{
-			RootPath: appState.ServerConfig.Config.Persistence.DataPath,
+			RootPath:   appState.ServerConfig.Config.Persistence.DataPath,
+			SyncPolicy: db.SyncPolicy(appState.ServerConfig.Config.Persistence.SyncPolicy),
+			SyncEveryN: *appState.ServerConfig.Config.Persistence.SyncEveryNWrites,
+			VectorIndexRecovery: db.VectorIndexRecovery(
+				appState.ServerConfig.Config.Persistence.VectorIndexRecovery),
+			CompactionBytesPerSecond: *appState.ServerConfig.Config.Persistence.CompactionMaxBytesPerSecond,
+			BoltOptions: db.BoltOptions{
+				InitialMmapSize: *appState.ServerConfig.Config.Persistence.InitialMmapSizeBytes,
+				OpenTimeout: time.Duration(
+					*appState.ServerConfig.Config.Persistence.OpenTimeoutSeconds) * time.Second,
+				ReadOnly: appState.ServerConfig.Config.Persistence.ReadOnly,
+			},
+			DuplicateIDPolicy: db.DuplicateIDPolicy(appState.ServerConfig.Config.Batch.DuplicateIDs),
 		})
 		vectorMigrator = db.NewMigrator(repo)
 		vectorRepo = repo
+		vectorIndexStats = repo
+		standaloneRepo = repo
 		migrator = vectorMigrator
-		vectorizer = libvectorizer.New(appState.Contextionary, nil)
 		explorer = traverser.NewExplorer(repo, vectorizer, libvectorizer.NormalizedDistance,
-			appState.Logger, nnExtender, featureProjector, pathBuilder)
+			appState.Logger, nnExtender, featureProjector, pathBuilder, explainer)
 	} else {
 		repo := esvector.NewRepo(esClient, appState.Logger, nil,
 			*appState.ServerConfig.Config.VectorIndex.NumberOfShards,     // guaranteed not to be nil as there are defaults
@@ -118,9 +156,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		vectorMigrator = esvector.NewMigrator(repo)
 		vectorRepo = repo
 		migrator = vectorMigrator
-		vectorizer = libvectorizer.New(appState.Contextionary, nil)
 		explorer = traverser.NewExplorer(repo, vectorizer, libvectorizer.NormalizedDistance,
-			appState.Logger, nnExtender, featureProjector, pathBuilder)
+			appState.Logger, nnExtender, featureProjector, pathBuilder, explainer)
 	}
 
 	schemaRepo := etcd.NewSchemaRepo(etcdClient)
@@ -136,10 +173,21 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	schemaManager.SetClassificationLister(classifierRepo)
 	vectorRepo.SetSchemaGetter(schemaManager)
 	vectorizer.SetIndexChecker(schemaManager)
+	if normalizer, ok := vectorizer.(libvectorizer.NormalizationSetter); ok {
+		textNormalization := appState.ServerConfig.Config.TextNormalization
+		normalizer.SetNormalization(libvectorizer.Normalization{
+			Lowercase:        *textNormalization.Lowercase, // guaranteed not to be nil as there are defaults
+			StripPunctuation: textNormalization.StripPunctuation,
+			UnicodeNFC:       textNormalization.UnicodeNFC,
+			RemoveStopwords:  textNormalization.RemoveStopwords,
+		}, appState.StopwordDetector)
+	}
 
-	err = vectorRepo.WaitForStartup(2 * time.Minute)
+	err = vectorRepo.WaitForStartup(
+		time.Duration(*appState.ServerConfig.Config.Startup.TimeoutSeconds) * time.Second)
 	if err != nil {
 		appState.Logger.
 			WithError(err).
@@ -148,20 +196,100 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	var queryCache *querycache.Cache
+	if cacheConfig := appState.ServerConfig.Config.QueryCache; cacheConfig.Enabled {
+		if standaloneRepo == nil {
+			// es only makes writes visible with the next refresh, so a query
+			// right after the invalidation could still cache the old result
+			appState.Logger.
+				WithField("action", "startup").
+				Warn("the query cache requires standalone mode, it is disabled")
+		} else {
+			queryCache = querycache.New(querycache.Config{
+				TTL:        time.Duration(*cacheConfig.TTLSeconds) * time.Second, // guaranteed not to be nil as there are defaults
+				MaxEntries: *cacheConfig.MaxEntries,
+			})
+			schemaManager.RegisterSchemaUpdateCallback(func(schema.Schema) {
+				queryCache.Purge()
+			})
+			vectorRepo = newCacheInvalidatingRepo(vectorRepo, queryCache)
+		}
+	}
+
 	kindsManager := kinds.NewManager(appState.Locks,
 		schemaManager, appState.Network, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorizer, vectorRepo, nnExtender, featureProjector)
+	var emitters events.Emitters
+	if eventsConfig := appState.ServerConfig.Config.Events; eventsConfig.WebhookURL != "" {
+		dispatcher := events.NewDispatcher(webhook.New(eventsConfig.WebhookURL), events.Config{
+			QueueSize:      *eventsConfig.QueueSize, // guaranteed not to be nil as there are defaults
+			MaxRetries:     *eventsConfig.MaxRetries,
+			InitialBackoff: time.Duration(*eventsConfig.RetryBackoffMS) * time.Millisecond,
+			SendTimeout:    time.Duration(*eventsConfig.TimeoutMS) * time.Millisecond,
+		}, appState.Logger)
+		dispatcher.Start()
+		emitters = append(emitters, dispatcher)
+	}
 	batchKindsManager := kinds.NewBatchManager(vectorRepo, vectorizer, appState.Locks,
 		schemaManager, appState.Network, appState.ServerConfig, appState.Logger,
 		appState.Authorizer)
-	vectorInspector := libvectorizer.NewInspector(appState.Contextionary)
+	idempotencyConfig := appState.ServerConfig.Config.BatchIdempotency
+	batchKindsManager.SetIdempotencyStore(etcd.NewIdempotencyRepo(etcdClient),
+		time.Duration(*idempotencyConfig.TTLSeconds)*time.Second,
+		time.Duration(*idempotencyConfig.LeaseSeconds)*time.Second)
+	switch auditConfig := appState.ServerConfig.Config.Audit; auditConfig.Sink {
+	case config.AuditSinkLogger:
+		loggerSink := audit.NewLoggerSink(appState.Logger)
+		kindsManager.SetAuditSink(loggerSink)
+		batchKindsManager.SetAuditSink(loggerSink)
+	case config.AuditSinkFile:
+		sink, err := audit.NewFileSink(auditConfig.FilePath)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not open audit log")
+			os.Exit(1)
+		}
+		kindsManager.SetAuditSink(sink)
+		batchKindsManager.SetAuditSink(sink)
+	}
+	if standaloneRepo != nil {
+		kindsManager.SetStorageCompactor(standaloneRepo)
+	}
+	kindsManager.SetReshardRepo(etcd.NewReshardRepo(etcdClient))
+	if err := kindsManager.ResumeReshards(context.Background()); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Error("could not resume interrupted reshards")
+	}
+	if interval := *appState.ServerConfig.Config.Expiry.SweepIntervalSeconds; interval > 0 &&
+		!appState.ServerConfig.Config.Persistence.ReadOnly {
+		// a read-only storage can't delete the expired objects
+		kinds.NewExpirySweeper(kindsManager, vectorRepo,
+			time.Duration(interval)*time.Second, appState.Logger).Start()
+	}
+	if rateLimit := appState.ServerConfig.Config.WriteRateLimit; rateLimit.Enabled() {
+		// a shared limiter, so single and batch writes count towards the same limits
+		limiter := kinds.NewWriteRateLimiter(*rateLimit.ObjectsPerSecond,
+			*rateLimit.PerClassObjectsPerSecond, rateLimit.ClassOverrides)
+		kindsManager.SetWriteRateLimiter(limiter)
+		batchKindsManager.SetWriteRateLimiter(limiter)
+	}
+	vectorInspector := libvectorizer.NewInspector(wordSpace, nnExtender)
 
 	kindsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorizer,
 		vectorRepo, explorer, schemaManager)
+	if queryCache != nil {
+		kindsTraverser.SetQueryCache(queryCache)
+	}
+	if len(emitters) > 0 {
+		kindsManager.SetEventEmitter(emitters)
+		batchKindsManager.SetEventEmitter(emitters)
+	}
 
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
-		appState.Contextionary, appState.Logger)
+		appState.Contextionary, vectorizer, appState.Logger)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, kindsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -175,18 +303,27 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	})
 	appState.Network.RegisterSchemaGetter(schemaManager)
 
+	itemErrs := newItemErrors(appState.Logger, appState.ServerConfig.Config.Errors.Safe())
 	setupSchemaHandlers(api, schemaManager)
 	setupKindHandlers(api, kindsManager, appState.ServerConfig.Config, appState.Logger)
-	setupKindBatchHandlers(api, batchKindsManager)
+	setupKindBatchHandlers(api, batchKindsManager, appState.ServerConfig.Config.Batch, itemErrs)
+	setupFacetHandlers(api, kindsTraverser)
+	setupQueryHandlers(api, kindsTraverser)
+	setupCorpusHandlers(api, kindsManager, vectorizer, appState.ServerConfig.Config.Debug)
 	setupC11yHandlers(api, vectorInspector, appState.Contextionary)
-	setupGraphQLHandlers(api, appState)
-	setupMiscHandlers(api, appState.ServerConfig, appState.Network, schemaManager, appState.Contextionary)
+	setupGraphQLHandlers(api, appState, itemErrs)
+	setupMiscHandlers(api, appState.ServerConfig, appState.Network, schemaManager,
+		appState.Contextionary, vectorIndexStats)
 	setupClassificationHandlers(api, classifier)
 
 	api.ServerShutdown = func() {}
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
-	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState)
+	readiness := newReadinessChecker(appState.Logger)
+	readiness.add("vector repo", vectorRepo.Ping)
+	readiness.add("schema repo", schemaRepo.Ping)
+	readiness.add("contextionary", contextionaryReadiness(appState.Contextionary))
+	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, readiness)
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
@@ -222,6 +359,13 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("config loaded")
 
+	tracer, err := tracing.New(serverConfig.Config.Tracing.Tracer, logger)
+	if err != nil {
+		logger.WithField("action", "startup").WithError(err).Error("could not configure tracing")
+		logger.Exit(1)
+	}
+	tracing.SetGlobalTracer(tracer)
+
 	appState.OIDC = configureOIDC(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	appState.Authorizer = configureAuthorizer(appState)
@@ -287,7 +431,22 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("initialized stopword detector")
 
-	c11y, err := contextionary.NewClient(appState.ServerConfig.Config.Contextionary.URL)
+	c11yConfig := appState.ServerConfig.Config.Contextionary
+	c11y, err := contextionary.NewClient(c11yConfig.URL, contextionary.TLSConfig{
+		CAFile:     c11yConfig.TLS.CAFile,
+		CertFile:   c11yConfig.TLS.CertFile,
+		KeyFile:    c11yConfig.TLS.KeyFile,
+		ServerName: c11yConfig.TLS.ServerName,
+	}, contextionary.RetryConfig{
+		MaxRetries:       *c11yConfig.MaxRetries,
+		InitialBackoff:   time.Duration(*c11yConfig.RetryBackoffMS) * time.Millisecond,
+		BreakerThreshold: *c11yConfig.BreakerThreshold,
+		BreakerCooldown:  time.Duration(*c11yConfig.BreakerCooldownSeconds) * time.Second,
+	}, contextionary.CacheConfig{
+		MaxEntries:           *c11yConfig.Cache.MaxEntries,
+		TTL:                  time.Duration(*c11yConfig.Cache.TTLSeconds) * time.Second,
+		VersionCheckInterval: time.Duration(*c11yConfig.Cache.VersionCheckSeconds) * time.Second,
+	}, logger)
 	if err != nil {
 		logger.WithField("action", "startup").
 			WithError(err).Error("cannot create c11y client")
@@ -330,16 +489,37 @@ func (d *dummyLock) LockSchema() (func() error, error) {
 	return func() error { return nil }, nil
 }
 
-func validateContextionaryVersion(appState *state.State) {
-	for {
-		time.Sleep(1 * time.Second)
+const (
+	contextionaryStartupInitialBackoff = 500 * time.Millisecond
+	contextionaryStartupMaxBackoff     = 15 * time.Second
+)
 
+func validateContextionaryVersion(appState *state.State) {
+	maxWait := time.Duration(*appState.ServerConfig.Config.Contextionary.StartupMaxWaitSeconds) *
+		time.Second
+	deadline := time.Now().Add(maxWait)
+	// seeded individually, so replicas starting at the same time don't share
+	// the same jitter
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for attempt := 0; ; attempt++ {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-		defer cancel()
 		v, err := appState.Contextionary.Version(ctx)
+		cancel()
 		if err != nil {
+			wait := startupBackoff(attempt, contextionaryStartupInitialBackoff,
+				contextionaryStartupMaxBackoff, rnd.Int63n)
+			if time.Now().Add(wait).After(deadline) {
+				appState.Logger.WithField("action", "startup_check_contextionary").
+					WithField("maxWait", maxWait.String()).
+					WithError(err).
+					Fatalf("could not connect to contextionary within the maximum startup wait time, giving up")
+				return
+			}
+
 			appState.Logger.WithField("action", "startup_check_contextionary").WithError(err).
-				Warnf("could not connect to contextionary at startup, trying again in 1 sec")
+				Warnf("could not connect to contextionary at startup, trying again in %s", wait)
+			time.Sleep(wait)
 			continue
 		}
 
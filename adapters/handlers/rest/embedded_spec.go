@@ -135,7 +135,11 @@ func init() {
     },
     "/actions": {
       "get": {
-        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Action per line.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
         "tags": [
           "actions"
         ],
@@ -150,6 +154,21 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonWhereParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonCountParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeTotalParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           }
         ],
         "responses": {
@@ -205,6 +224,15 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Action"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonIfNotExistsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTTLParameterHeader"
           }
         ],
         "responses": {
@@ -223,12 +251,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "An object with the same id already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -243,6 +289,78 @@ func init() {
         ]
       }
     },
+    "/actions/facets/{className}/{propertyName}": {
+      "get": {
+        "description": "Returns the most common distinct values of a property of a Action class, each with the number of Actions that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get the distribution of the values of a property.",
+        "operationId": "actions.facets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of distinct values to be returned. Defaults to 10, cannot exceed 1000.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query. Only Actions matching the filter are taken into account. Paths are relative to the class set in the path.",
+            "name": "where",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The distribution of the values of the property.",
+            "schema": {
+              "$ref": "#/definitions/PropertyFacets"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or property does not exist."
+          },
+          "422": {
+            "description": "The property cannot be faceted, the limit is out of range or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
     "/actions/validate": {
       "post": {
         "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
@@ -316,6 +434,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonExpandParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonDepthParameterQuery"
           }
         ],
         "responses": {
@@ -357,7 +481,7 @@ func init() {
         ]
       },
       "put": {
-        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.",
         "tags": [
           "actions"
         ],
@@ -379,6 +503,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Action"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonChangedOnlyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -400,12 +530,30 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -466,7 +614,7 @@ func init() {
         ]
       },
       "patch": {
-        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.",
         "tags": [
           "actions"
         ],
@@ -488,6 +636,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Action"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonUniqueReferencesParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -509,12 +663,30 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -529,14 +701,14 @@ func init() {
         ]
       }
     },
-    "/actions/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/actions/{id}/corpus": {
+      "get": {
+        "description": "Returns the exact text the vectorizer builds from a Action to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Action, it is only available if debug mode is enabled.",
         "tags": [
           "actions"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "actions.references.update",
+        "summary": "Get the vectorizer corpus of a Action.",
+        "operationId": "actions.corpus",
         "parameters": [
           {
             "type": "string",
@@ -545,26 +717,77 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/VectorizerCorpus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden, either because of missing permissions or because debug mode is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/actions/{id}/references": {
+      "get": {
+        "description": "Lists only the reference properties of a Action, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "List the references of a Action.",
+        "operationId": "actions.references.list",
+        "parameters": [
           {
             "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
+            "type": "boolean",
+            "description": "Add the kind and id of the target to every reference. Defaults to false.",
+            "name": "parse",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Add the target object to every local reference whose target exists. Implies parse. Defaults to false.",
+            "name": "resolve",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ReferencesListResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -575,11 +798,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -591,16 +811,16 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "put": {
+        "description": "Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.",
         "tags": [
           "actions"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "actions.references.create",
+        "summary": "Replace all references of several class-properties.",
+        "operationId": "actions.references.bulkUpdate",
         "parameters": [
           {
             "type": "string",
@@ -610,25 +830,18 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/PropertyReferences"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successfully replaced the references of all listed properties."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -640,7 +853,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the properties exist and are references?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -657,14 +870,16 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/actions/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
         "tags": [
           "actions"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "actions.references.delete",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "actions.references.update",
         "parameters": [
           {
             "type": "string",
@@ -686,13 +901,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/MultipleRef"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -703,8 +918,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -721,59 +936,42 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/batching/actions": {
+      },
       "post": {
-        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "description": "Add a single reference to a class-property.",
         "tags": [
-          "batching",
           "actions"
         ],
-        "summary": "Creates new Actions based on an Action template as a batch.",
-        "operationId": "batching.actions.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "actions.references.create",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Action"
-                  }
-                },
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                }
-              }
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ActionsGetResponse"
-              }
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -785,7 +983,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -800,42 +998,44 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/batching/references": {
-      "post": {
-        "description": "Register cross-references between any class items (things or actions) in bulk.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "batching",
-          "references"
+          "actions"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batching.references.create",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "actions.references.delete",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/SingleRef"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -846,8 +1046,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -862,19 +1062,19 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/batching/things": {
+    "/batching/actions": {
       "post": {
-        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
         "tags": [
           "batching",
-          "things"
+          "actions"
         ],
-        "summary": "Creates new Things based on a Thing template as a batch.",
-        "operationId": "batching.things.create",
+        "summary": "Creates new Actions based on an Action template as a batch.",
+        "operationId": "batching.actions.create",
         "parameters": [
           {
             "name": "body",
@@ -883,6 +1083,12 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
+                },
                 "fields": {
                   "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
@@ -897,24 +1103,88 @@ func init() {
                       "creationTimeUnix"
                     ]
                   }
-                },
-                "things": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Thing"
-                  }
                 }
               }
             }
+          },
+          {
+            "$ref": "#/parameters/CommonValidateOnlyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonVectorizeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIdempotencyKeyParameterHeader"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Request succeeded and every batched item was created, see response body to get detailed information about each batched item.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ThingsGetResponse"
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
+            }
+          },
+          "207": {
+            "description": "Request succeeded, but at least one of the batched actions failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
               }
             }
           },
@@ -927,12 +1197,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A request with the same idempotency key is still being executed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -947,32 +1235,37 @@ func init() {
         ]
       }
     },
-    "/c11y/concepts/{concept}": {
-      "get": {
-        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+    "/batching/actions/get": {
+      "post": {
+        "description": "Retrieve multiple Actions by their UUIDs in a single request. UUIDs for which no Action exists are listed separately.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "actions"
         ],
-        "summary": "Checks if a concept is part of the contextionary.",
-        "operationId": "c11y.concepts",
+        "summary": "Get multiple Actions based on their UUIDs.",
+        "operationId": "batching.actions.get",
         "parameters": [
           {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "concept",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchGetRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
+              "$ref": "#/definitions/ActionsBatchGetResponse"
             }
           },
           "400": {
-            "description": "Incorrect request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -986,89 +1279,69 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.query"
         ]
       }
     },
-    "/c11y/corpus": {
+    "/batching/references": {
       "post": {
-        "description": "Analyzes a sentence based on the contextionary",
+        "description": "Register cross-references between any class items (things or actions) in bulk. With validateOnly, every reference is only validated without adding it: the source and target objects must exist, the property must be a reference property of the source class and the target must be of one of the classes the property can point to.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "references"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.corpus.get",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batching.references.create",
         "parameters": [
           {
-            "description": "A text corpus",
-            "name": "corpus",
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "The text corpus.",
-              "type": "object",
-              "properties": {
-                "corpus": {
-                  "type": "string",
-                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
               }
             }
-          }
-        ],
-        "responses": {
-          "501": {
-            "description": "Not (yet) implemented."
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.c11y.corpus.get"
-        ]
-      }
-    },
-    "/c11y/extensions/": {
-      "post": {
-        "description": "Extend the contextionary with your own custom concepts",
-        "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Extend the contextionary with custom concepts",
-        "operationId": "c11y.extensions",
-        "parameters": [
-          {
-            "description": "Description and definition of the concept to extend the contextionary with",
-            "name": "extension",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
+          },
+          {
+            "$ref": "#/parameters/CommonValidateOnlyParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully extended the contextionary with the custom cocnept",
+            "description": "Request succeeded and every batched reference was created (or is valid with validateOnly), see response body to get detailed information about each batched reference.",
             "schema": {
-              "$ref": "#/definitions/C11yExtension"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
-          "400": {
-            "description": "Incorrect request",
+          "207": {
+            "description": "Request succeeded, but at least one of the batched references failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "401": {
@@ -1080,47 +1353,69 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.extensions.post"
+          "weaviate.local.add"
         ]
       }
     },
-    "/c11y/words/{words}": {
-      "get": {
-        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+    "/batching/references/resolve": {
+      "post": {
+        "description": "Resolve a list of beacons to the objects they point to in a single request. Every beacon has its own status, so that beacons which could not be resolved do not fail the whole request.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "references"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.words",
+        "summary": "Resolve multiple beacons to their objects.",
+        "operationId": "batching.references.resolve",
         "parameters": [
           {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "words",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BeaconsResolveRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Successful response. Inspect the status of every result to see which beacons could be resolved.",
             "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
+              "$ref": "#/definitions/BeaconsResolveResponse"
             }
           },
           "400": {
-            "description": "Incorrect request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1134,53 +1429,146 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.query"
         ]
       }
     },
-    "/classifications/": {
+    "/batching/things": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
+        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
         "tags": [
-          "classifications"
+          "batching",
+          "things"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Creates new Things based on a Thing template as a batch.",
+        "operationId": "batching.things.create",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
             }
+          },
+          {
+            "$ref": "#/parameters/CommonValidateOnlyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonVectorizeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIdempotencyKeyParameterHeader"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
+          "200": {
+            "description": "Request succeeded and every batched item was created, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
             }
           },
-          "400": {
-            "description": "Incorrect request",
+          "207": {
+            "description": "Request succeeded, but at least one of the batched things failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
             }
           },
           "401": {
@@ -1192,54 +1580,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "409": {
+            "description": "A request with the same idempotency key is still being executed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.post"
-        ]
-      }
-    },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
-        "tags": [
-          "classifications"
-        ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the classification, returned as body",
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1247,35 +1611,46 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.add"
         ]
       }
     },
-    "/graphql": {
+    "/batching/things/get": {
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Retrieve multiple Things by their UUIDs in a single request. UUIDs for which no Thing exists are listed separately.",
         "tags": [
-          "graphql"
+          "batching",
+          "things"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Get multiple Things based on their UUIDs.",
+        "operationId": "batching.things.get",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/BatchGetRequest"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/ThingsBatchGetResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1288,7 +1663,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1303,37 +1678,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/c11y/concepts/{concept}": {
+      "get": {
+        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
         "tags": [
-          "graphql"
+          "contextionary-API"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Checks if a concept is part of the contextionary.",
+        "operationId": "c11y.concepts",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "concept",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1345,87 +1721,89 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.c11y.words.get"
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/c11y/corpus": {
+      "post": {
+        "description": "Analyzes a sentence based on the contextionary",
         "tags": [
-          "meta"
+          "contextionary-API"
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.corpus.get",
+        "parameters": [
+          {
+            "description": "A text corpus",
+            "name": "corpus",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "description": "The text corpus.",
+              "type": "object",
+              "properties": {
+                "corpus": {
+                  "type": "string",
+                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                }
+              }
             }
           }
+        ],
+        "responses": {
+          "501": {
+            "description": "Not (yet) implemented."
+          }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.c11y.corpus.get"
         ]
       }
     },
-    "/schema": {
-      "get": {
+    "/c11y/extensions/": {
+      "post": {
+        "description": "Extend the contextionary with your own custom concepts",
         "tags": [
-          "schema"
+          "contextionary-API"
+        ],
+        "summary": "Extend the contextionary with custom concepts",
+        "operationId": "c11y.extensions",
+        "parameters": [
+          {
+            "description": "Description and definition of the concept to extend the contextionary with",
+            "name": "extension",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/C11yExtension"
+            }
+          }
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Successfully extended the contextionary with the custom cocnept",
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "$ref": "#/definitions/Schema"
-                },
-                "things": {
-                  "$ref": "#/definitions/Schema"
-                }
-              }
+              "$ref": "#/definitions/C11yExtension"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1442,35 +1820,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.extensions.post"
         ]
       }
     },
-    "/schema/actions": {
-      "post": {
+    "/c11y/inspect/{word}": {
+      "get": {
+        "description": "Shows the vector of a single word and the words closest to it in the contextionary, ordered by their distance. Words that are not part of the contextionary result in a 404.",
         "tags": [
-          "schema"
+          "contextionary-API"
         ],
-        "summary": "Create a new Action class in the schema.",
-        "operationId": "schema.actions.create",
+        "summary": "Inspect the vector and the nearest neighbors of a single word.",
+        "operationId": "c11y.inspect",
         "parameters": [
           {
-            "name": "actionClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "description": "The single word to inspect.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of nearest neighbors to return. Defaults to 10, must be between 1 and 32.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Action class to the schema.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/C11yWordInspection"
             }
           },
           "401": {
@@ -1482,8 +1870,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The word is not part of the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Action class",
+            "description": "The word or the limit is invalid, e.g. the word contains more than a single word.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1496,31 +1890,36 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.c11y.words.get"
         ]
       }
     },
-    "/schema/actions/{className}": {
-      "delete": {
+    "/c11y/words/{words}": {
+      "get": {
+        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
         "tags": [
-          "schema"
+          "contextionary-API"
         ],
-        "summary": "Remove an Action class (and all data in the instances) from the schema.",
-        "operationId": "schema.actions.delete",
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.words",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "CamelCase list of words to validate.",
+            "name": "words",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Action class from the schema."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
           },
           "400": {
-            "description": "Could not delete the Action class.",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1539,41 +1938,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.c11y.words.get"
         ]
       }
     },
-    "/schema/actions/{className}/properties": {
+    "/classifications/": {
       "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Add a property to an Action class.",
-        "operationId": "schema.actions.properties.add",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Classification"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1585,12 +1991,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid property.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1599,32 +1999,40 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/schema/things": {
+    "/classifications/preview": {
       "post": {
+        "description": "Predicts the reference targets of a single object the same way a kNN classification does. Nothing is stored, so this can be used to tune the parameters before running a classification.",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Create a new Thing class in the schema.",
-        "operationId": "schema.things.create",
+        "summary": "Previews a classification of a single object.",
+        "operationId": "classifications.preview",
         "parameters": [
           {
-            "name": "thingClass",
+            "description": "parameters of the preview",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ClassificationPreviewParams"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Thing class to the schema.",
+            "description": "Successfully predicted the reference targets.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ClassificationPreview"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1636,12 +2044,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Thing class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1650,33 +2052,39 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.classifications.preview"
         ]
       }
     },
-    "/schema/things/{className}": {
-      "delete": {
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Remove a Thing class (and all data in the instances) from the schema.",
-        "operationId": "schema.things.delete",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "classification id",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "If set, the request blocks until the classification is no longer running, but at most for the specified number of seconds (capped at 300). The classification is returned in its state at that time, so a status of 'running' indicates the wait timed out.",
+            "name": "waitTimeoutSeconds",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Thing class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Thing class.",
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -1688,6 +2096,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "422": {
+            "description": "Invalid waitTimeoutSeconds.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1696,38 +2113,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/schema/things/{className}/properties": {
+    "/graphql": {
       "post": {
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "schema"
+          "graphql"
         ],
-        "summary": "Add a property to a Thing class.",
-        "operationId": "schema.things.properties.add",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -1740,7 +2153,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1752,41 +2165,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/things": {
-      "get": {
-        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "things"
+          "graphql"
         ],
-        "summary": "Get a list of Things.",
-        "operationId": "things.list",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonMetaParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ThingsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -1798,8 +2210,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1811,31 +2226,29 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "post": {
-        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+      }
+    },
+    "/graphql/queries": {
+      "get": {
+        "description": "Lists all GraphQL queries which are currently running, the longest running first.",
         "tags": [
-          "things"
-        ],
-        "summary": "Create a new Thing based on a Thing template.",
-        "operationId": "things.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
-          }
+          "graphql"
         ],
+        "summary": "List the running GraphQL queries.",
+        "operationId": "graphql.queries.list",
         "responses": {
           "200": {
-            "description": "Thing created.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/RunningQuery"
+              }
             }
           },
           "401": {
@@ -1847,12 +2260,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1863,31 +2270,30 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/things/validate": {
-      "post": {
-        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+    "/graphql/queries/{id}": {
+      "delete": {
+        "description": "Cancels a running GraphQL query. The query ends with an error as soon as the cancellation is noticed.",
         "tags": [
-          "things"
+          "graphql"
         ],
-        "summary": "Validate Things schema.",
-        "operationId": "things.validate",
+        "summary": "Cancel a running GraphQL query.",
+        "operationId": "graphql.queries.cancel",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
+            "type": "string",
+            "description": "The id of the running query.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully canceled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1898,11 +2304,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1914,45 +2317,23 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/things/{id}": {
+    "/meta": {
       "get": {
-        "description": "Returns a particular Thing data.",
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "things"
-        ],
-        "summary": "Get a Thing based on its UUID.",
-        "operationId": "things.get",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonMetaParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Thing"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -1964,9 +2345,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1977,39 +2355,26 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
-      },
-      "put": {
-        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/meta/compaction": {
+      "post": {
+        "description": "Rewrites the storage file of every shard into a fresh file and reports the reclaimed disk space. Space of deleted and overwritten objects is otherwise never released. Reads continue during the compaction, writes to the shard which is being compacted wait until it is done. The compaction is throttled according to the server configuration. Closing the request cancels the compaction, shards which were already compacted stay compacted. Only available with the standalone storage.",
         "tags": [
-          "things"
-        ],
-        "summary": "Update a Thing based on its UUID.",
-        "operationId": "things.update",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
-          }
+          "meta"
         ],
+        "summary": "Compacts the storage files of the current Weaviate instance.",
+        "operationId": "meta.compact",
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShardCompaction"
+              }
             }
           },
           "401": {
@@ -2021,11 +2386,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "409": {
+            "description": "A compaction is already running.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2035,34 +2397,52 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
+          },
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
+      }
+    },
+    "/meta/snapshots/{className}/{shardName}": {
+      "get": {
+        "description": "Streams a point-in-time snapshot of a single shard of the class as a tar archive. Writes to the shard are paused only until the snapshot has been taken, not while it is downloaded. The snapshot can be loaded back with a PUT on the same path. Only available with the standalone storage.",
+        "produces": [
+          "application/json",
+          "application/x-tar"
+        ],
         "tags": [
-          "things"
+          "meta"
         ],
-        "summary": "Delete a Thing based on its UUID.",
-        "operationId": "things.delete",
+        "summary": "Takes a snapshot of a shard of the current Weaviate instance.",
+        "operationId": "meta.shardSnapshot",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "description": "The name of the class of the shard.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the shard, as listed in the vector index statistics.",
+            "name": "shardName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2074,52 +2454,66 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or the shard doesn't exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       },
-      "patch": {
-        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "put": {
+        "description": "Replaces the contents of a single shard of the class with a snapshot which was taken with a GET on the same path. Writes to the shard wait until the restore is done. The shard is left untouched if the snapshot is malformed or cannot be loaded. Only available with the standalone storage.",
+        "consumes": [
+          "application/x-tar"
+        ],
         "tags": [
-          "things"
+          "meta"
         ],
-        "summary": "Update a Thing based on its UUID (using patch semantics).",
-        "operationId": "things.patch",
+        "summary": "Restores a shard of the current Weaviate instance from a snapshot.",
+        "operationId": "meta.shardRestore",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "description": "The name of the class of the shard.",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
+            "type": "string",
+            "description": "The name of the shard, as listed in the vector index statistics.",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The tar archive of the snapshot.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "type": "string",
+              "format": "binary"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content returned"
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Successful response."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2131,10 +2525,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or the shard doesn't exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "The snapshot is malformed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2144,51 +2541,83 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/things/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/meta/vector-index": {
+      "get": {
+        "description": "Reports the size and build parameters of the vector index of every shard. Only available with the standalone storage.",
         "tags": [
-          "things"
+          "meta"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "things.references.update",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "summary": "Returns statistics of the vector indices of the current Weaviate instance.",
+        "operationId": "meta.vectorIndexStats",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/VectorIndexStats"
+              }
+            }
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
         ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references (success is based on the behavior of the datastore)."
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "$ref": "#/definitions/Schema"
+                },
+                "things": {
+                  "$ref": "#/definitions/Schema"
+                }
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2199,12 +2628,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2212,47 +2635,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
+      }
+    },
+    "/schema/actions": {
       "post": {
-        "description": "Add a single reference to a class-property.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "things.references.create",
+        "summary": "Create a new Action class in the schema.",
+        "operationId": "schema.actions.create",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "actionClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Added the new Action class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2264,7 +2674,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid Action class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2276,47 +2686,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add.meta"
         ]
-      },
+      }
+    },
+    "/schema/actions/{className}": {
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "things.references.delete",
+        "summary": "Remove an Action class (and all data in the instances) from the schema.",
+        "operationId": "schema.actions.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Removed the Action class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Action class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2327,12 +2725,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2340,1639 +2732,6597 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
-    }
-  },
-  "definitions": {
-    "Action": {
-      "type": "object",
-      "properties": {
-        "_classification": {
-          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/UnderscorePropertiesClassification"
-        },
-        "_featureProjection": {
-          "description": "A feature projection of the object's vector into lower dimensions for visualization",
-          "$ref": "#/definitions/FeatureProjection"
-        },
-        "_interpretation": {
-          "description": "Additional information about how this property was interpreted at vectorization. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/Interpretation"
-        },
-        "_nearestNeighbors": {
-          "description": "Additional information about the neighboring concepts of this element",
-          "$ref": "#/definitions/NearestNeighbors"
-        },
-        "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "class": {
-          "description": "Type of the Action, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Action in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Action.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last update made to the Action since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "meta": {
-          "$ref": "#/definitions/UnderscoreProperties"
-        },
-        "schema": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
-      }
     },
-    "ActionsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
-        },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
+    "/schema/actions/{className}/frozen": {
+      "put": {
+        "description": "Makes the Action class read-only, e.g. for the duration of a migration. Creating, updating or deleting Actions of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Freeze a Action class.",
+        "operationId": "schema.actions.freeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Action.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "The Action class is frozen."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        }
-      ]
-    },
-    "ActionsListResponse": {
-      "description": "List of Actions.",
-      "type": "object",
-      "properties": {
-        "actions": {
-          "description": "The actual list of Actions.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Action"
           }
         },
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Makes a frozen Action class writable again. Unfreezing a class which is not frozen has no effect.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Unfreeze a Action class.",
+        "operationId": "schema.actions.unfreeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The Action class is writable."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "totalResults": {
-          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'actions', 'things' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/things/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+    "/schema/actions/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Action class.",
+        "operationId": "schema.actions.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/things/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
+    "/schema/actions/{className}/properties/batch": {
+      "post": {
+        "description": "Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add multiple properties to an Action class at once.",
+        "operationId": "schema.actions.properties.batchAdd",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Property"
               }
             }
           }
-        }
-      ]
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
-          "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the properties, returns the updated class.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid properties, none of them were added.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
+    "/schema/actions/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a property from an Action class.",
+        "operationId": "schema.actions.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "word": {
-            "type": "string"
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float",
-        "maximum": 300,
-        "minimum": 300
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property from the Action class."
+          },
+          "400": {
+            "description": "Could not delete the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
+    "/schema/counts": {
+      "get": {
+        "description": "Returns every Thing and Action class of the schema together with the number of objects it holds. The counts are determined without loading any objects and are cached for a few seconds.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List all classes with their object counts.",
+        "operationId": "schema.counts",
+        "responses": {
+          "200": {
+            "description": "The classes with their object counts.",
+            "schema": {
+              "$ref": "#/definitions/ClassCounts"
             }
-          }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "inC11y": {
-                "type": "boolean"
-              },
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "word": {
-                "type": "string"
-              }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "keywords": {
-          "$ref": "#/definitions/Keywords"
-        },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+    "/schema/export": {
+      "get": {
+        "description": "Exports all thing and action classes including their properties and configuration. The document can be imported into another instance with a POST to /schema/import.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Export the complete schema as a single document.",
+        "operationId": "schema.export",
+        "responses": {
+          "200": {
+            "description": "The complete schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDocument"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "vectorizeClassName": {
-          "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
-          "type": "boolean",
-          "x-nullable": true
-        }
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
-      "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/import": {
+      "post": {
+        "description": "Creates all classes of the document which don't exist yet. Classes which already exist with an identical definition are left untouched, so the import can safely be repeated. If any class already exists with a different definition, nothing is created and the conflicts are reported instead.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Import a previously exported schema.",
+        "operationId": "schema.import",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SchemaDocument"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The schema was imported.",
+            "schema": {
+              "$ref": "#/definitions/SchemaImportResponse"
+            }
           },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "informationGainCutoffPercentile": {
-          "description": "Only available on type=contextual. All words in a source corpus are ranked by their information gain against the possible target objects. A cutoff percentile of 40 implies that the top 40% are used and the bottom 60% are cut-off.",
-          "type": "integer",
-          "format": "int32",
-          "default": 30,
-          "example": 30
-        },
-        "informationGainMaximumBoost": {
-          "description": "Only available on type=contextual. Words in a corpus will receive an additional boost based on how high they are ranked according to information gain. Setting this value to 3 implies that the top-ranked word will be ranked 3 times as high as the bottom ranked word. The curve in between is logarithmic. A maximum boost of 1 implies that no boosting occurs.",
-          "type": "integer",
-          "format": "int32",
-          "default": 3,
-          "example": 3
-        },
-        "k": {
-          "description": "k-value when using k-Neareast-Neighbor",
-          "type": "integer",
-          "format": "int32",
-          "default": 3,
-          "example": 3
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "minimumUsableWords": {
-          "description": "Only available on type=contextual. Both IG and tf-idf are mechanisms to remove words from the corpora. However, on very short corpora this could lead to a removal of all words, or all but a single word. This value guarantees that - regardless of tf-idf and IG score - always at least n words are used.",
-          "type": "integer",
-          "format": "int32",
-          "default": 3,
-          "example": 3
-        },
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on trainig data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "tfidfCutoffPercentile": {
-          "description": "Only available on type=contextual. All words in a corpus are ranked by their tf-idf score. A cutoff percentile of 80 implies that the top 80% are used and the bottom 20% are cut-off. This is very effective to remove words that occur in almost all objects, such as filler and stop words.",
-          "type": "integer",
-          "format": "int32",
-          "default": 80,
-          "example": 80
-        },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Some classes already exist with a different definition, nothing was imported.",
+            "schema": {
+              "$ref": "#/definitions/SchemaImportResponse"
+            }
+          },
+          "422": {
+            "description": "The schema could not be imported.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "type": {
-          "description": "which algorythim to use for classifications",
-          "type": "string",
-          "default": "knn",
-          "enum": [
-            "knn",
-            "contextual"
-          ],
-          "example": "knn"
-        }
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
-      "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
+    "/schema/reindex/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the progress of the reindex of a class.",
+        "operationId": "schema.reindex.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The progress of the most recent reindex of the class.",
+            "schema": {
+              "$ref": "#/definitions/ReindexStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "No reindex of this class has been started."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Re-vectorizes every object of the class with the current vectorizer configuration and replaces the vectors in the vector index. The reindex runs in the background, its progress can be retrieved with a GET on the same path. A reindex starts over, unless resume is set, in which case it continues where the previous reindex of the class was interrupted.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Re-vectorize all objects of a class.",
+        "operationId": "schema.reindex",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Continue an interrupted reindex of the class instead of starting over. Defaults to false.",
+            "name": "resume",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The reindex was started.",
+            "schema": {
+              "$ref": "#/definitions/ReindexStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "409": {
+            "description": "A reindex of this class is already running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "Deprecation": {
-      "type": "object",
-      "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/shards/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the progress of the reshard of a class.",
+        "operationId": "schema.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The progress of the most recent reshard of the class.",
+            "schema": {
+              "$ref": "#/definitions/ReshardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "No reshard of this class has been started."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "mitigation": {
-          "description": "User-required action to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Moves all objects of the class to a new index with the specified number of shards and replicas. The objects are copied in the background while the class stays queryable, only during the final catch-up writes to the class are rejected. The progress can be retrieved with a GET on the same path. Starting a reshard with the same configuration as an interrupted one resumes it. Only supported by the elasticsearch vector index.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Change the shard configuration of a class.",
+        "operationId": "schema.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardingConfig"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The reshard was started.",
+            "schema": {
+              "$ref": "#/definitions/ReshardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "409": {
+            "description": "A reshard of this class is already running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid shard configuration.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
+    "/schema/things": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Thing class in the schema.",
+        "operationId": "schema.things.create",
+        "parameters": [
+          {
+            "name": "thingClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
             }
           }
-        }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Thing class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
       }
     },
-    "FeatureProjection": {
-      "description": "A lower-dimensional representation of the objects vector",
-      "properties": {
-        "vector": {
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float"
+    "/schema/things/{className}": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a Thing class (and all data in the instances) from the schema.",
+        "operationId": "schema.things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Thing class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
+    "/schema/things/{className}/frozen": {
+      "put": {
+        "description": "Makes the Thing class read-only, e.g. for the duration of a migration. Creating, updating or deleting Things of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Freeze a Thing class.",
+        "operationId": "schema.things.freeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The Thing class is frozen."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Makes a frozen Thing class writable again. Unfreezing a class which is not frozen has no effect.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Unfreeze a Thing class.",
+        "operationId": "schema.things.unfreeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The Thing class is writable."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+    "/schema/things/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to a Thing class.",
+        "operationId": "schema.things.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           }
-        },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "Interpretation": {
-      "description": "This underscore property contains additional info about the how the class was vectorized",
-      "properties": {
-        "source": {
-          "description": "The input that was used to vectorize this object",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/InterpretationSource"
+    "/schema/things/{className}/properties/batch": {
+      "post": {
+        "description": "Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add multiple properties to a Thing class at once.",
+        "operationId": "schema.things.properties.batchAdd",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Property"
+              }
+            }
           }
-        }
-      }
-    },
-    "InterpretationSource": {
-      "description": "This underscore property contains additional info about the how the class was vectorized",
-      "properties": {
-        "concept": {
-          "type": "string"
-        },
-        "occurrence": {
-          "type": "number",
-          "format": "uint64"
-        },
-        "weight": {
-          "type": "number",
-          "format": "float32"
-        }
-      }
-    },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Keywords": {
-      "description": "Describes a class or property using multiple weighted words.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "keyword": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the properties, returns the updated class.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
-          "weight": {
-            "type": "number",
-            "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid properties, none of them were added.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
-        },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
-      }
-    },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "contextionaryVersion": {
-          "description": "Version of the contextionary service connected to weaviate",
-          "type": "string"
-        },
-        "contextionaryWordCount": {
-          "description": "Number of total words in the contextionary",
-          "type": "number",
-          "format": "int"
-        },
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
         },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "NearestNeighbor": {
-      "description": "A group of neighboring concepts",
-      "type": "object",
-      "properties": {
-        "concept": {
-          "description": "The neighboring concept",
-          "type": "string"
-        },
-        "distance": {
-          "description": "The distance between the result and this neighbor",
-          "type": "number",
-          "format": "float"
-        },
-        "vector": {
-          "description": "The neighbor's vector position",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float"
+    "/schema/things/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a property from a Thing class.",
+        "operationId": "schema.things.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "NearestNeighbors": {
-      "description": "A group of neighboring concepts",
-      "type": "object",
-      "properties": {
-        "neighbors": {
-          "description": "The individual neighbor items",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NearestNeighbor"
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property from the Thing class."
+          },
+          "400": {
+            "description": "Could not delete the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Thing"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "PatchDocumentThing": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Thing"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+    "/things": {
+      "get": {
+        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Thing per line.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a list of Things.",
+        "operationId": "things.list",
+        "parameters": [
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonMetaParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonWhereParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonCountParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeTotalParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create a new Thing based on a Thing template.",
+        "operationId": "things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonIfNotExistsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTTLParameterHeader"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Thing created.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "An object with the same id already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
+    "/things/facets/{className}/{propertyName}": {
+      "get": {
+        "description": "Returns the most common distinct values of a property of a Thing class, each with the number of Things that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get the distribution of the values of a property.",
+        "operationId": "things.facets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of distinct values to be returned. Defaults to 10, cannot exceed 1000.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query. Only Things matching the filter are taken into account. Paths are relative to the class set in the path.",
+            "name": "where",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The distribution of the values of the property.",
+            "schema": {
+              "$ref": "#/definitions/PropertyFacets"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or property does not exist."
+          },
+          "422": {
+            "description": "The property cannot be faceted, the limit is out of range or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
-        }
-      }
-    },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
+    "/things/validate": {
+      "post": {
+        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Validate Things schema.",
+        "operationId": "things.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/things/{id}": {
+      "get": {
+        "description": "Returns a particular Thing data.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a Thing based on its UUID.",
+        "operationId": "things.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonMetaParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonExpandParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonDepthParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a Thing based on its UUID.",
+        "operationId": "things.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonChangedOnlyParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Delete a Thing based on its UUID.",
+        "operationId": "things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a Thing based on its UUID (using patch semantics).",
+        "operationId": "things.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonUniqueReferencesParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content returned"
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/things/{id}/corpus": {
+      "get": {
+        "description": "Returns the exact text the vectorizer builds from a Thing to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Thing, it is only available if debug mode is enabled.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get the vectorizer corpus of a Thing.",
+        "operationId": "things.corpus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/VectorizerCorpus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden, either because of missing permissions or because debug mode is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/things/{id}/references": {
+      "get": {
+        "description": "Lists only the reference properties of a Thing, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.",
+        "tags": [
+          "things"
+        ],
+        "summary": "List the references of a Thing.",
+        "operationId": "things.references.list",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Add the kind and id of the target to every reference. Defaults to false.",
+            "name": "parse",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Add the target object to every local reference whose target exists. Implies parse. Defaults to false.",
+            "name": "resolve",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ReferencesListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Replace all references of several class-properties.",
+        "operationId": "things.references.bulkUpdate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyReferences"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced the references of all listed properties."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the properties exist and are references?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/things/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "things.references.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references (success is based on the behavior of the datastore)."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "things.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "things.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "Action": {
+      "type": "object",
+      "properties": {
+        "_classification": {
+          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/UnderscorePropertiesClassification"
+        },
+        "_featureProjection": {
+          "description": "A feature projection of the object's vector into lower dimensions for visualization",
+          "$ref": "#/definitions/FeatureProjection"
+        },
+        "_interpretation": {
+          "description": "Additional information about how this property was interpreted at vectorization. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/Interpretation"
+        },
+        "_nearestNeighbors": {
+          "description": "Additional information about the neighboring concepts of this element",
+          "$ref": "#/definitions/NearestNeighbors"
+        },
+        "_vector": {
+          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "class": {
+          "description": "Type of the Action, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Action in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiryTimeUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which this Action expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Action.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last update made to the Action since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meta": {
+          "$ref": "#/definitions/UnderscoreProperties"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "version": {
+          "description": "Version of this Action, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Action has not been changed in the meantime. Ignored in request bodies.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ActionsBatchGetResponse": {
+      "description": "Actions retrieved by their UUIDs.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The Actions which were found, in the order of the requested UUIDs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "notFound": {
+          "description": "The requested UUIDs for which no Action exists.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "ActionsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Action.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ActionsListResponse": {
+      "description": "List of Actions.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of Actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "limit": {
+          "description": "The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nextCursor": {
+          "description": "Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "totalResults": {
+          "description": "The total number of Actions matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "BatchGetRequest": {
+      "description": "A list of UUIDs to retrieve in a single request.",
+      "type": "object",
+      "required": [
+        "ids"
+      ],
+      "properties": {
+        "expand": {
+          "description": "Names of reference properties whose targets are resolved and inlined into the 'schema' of the references. Every target is fetched once, no matter how many objects point to it. Only the first level of references is resolved, at most 1000 distinct targets per request.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expandProperties": {
+          "description": "The properties of the resolved targets to inline. If omitted, all properties of the targets are inlined.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ids": {
+          "description": "The UUIDs of the objects to retrieve. The objects are returned in the same order.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'actions', 'things' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/things/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/things/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BeaconResolveResult": {
+      "description": "The outcome of resolving a single beacon.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "beacon": {
+          "description": "The beacon as it was requested.",
+          "type": "string",
+          "format": "uri"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "SUCCESS if the target was found, NOT_FOUND if no object with this id exists and FAILED if the beacon could not be resolved.",
+          "type": "string",
+          "enum": [
+            "SUCCESS",
+            "NOT_FOUND",
+            "FAILED"
+          ]
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        }
+      }
+    },
+    "BeaconsResolveRequest": {
+      "description": "A list of beacons to resolve in a single request.",
+      "type": "object",
+      "required": [
+        "beacons"
+      ],
+      "properties": {
+        "beacons": {
+          "description": "The beacons to resolve, such as weaviate://localhost/things/\u003cuuid\u003e. The results are returned in the same order.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uri"
+          }
+        }
+      }
+    },
+    "BeaconsResolveResponse": {
+      "description": "The objects the requested beacons point to.",
+      "type": "object",
+      "properties": {
+        "results": {
+          "description": "One result per requested beacon, in the order of the request.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BeaconResolveResult"
+          }
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float",
+        "maximum": 300,
+        "minimum": 300
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordInspection": {
+      "description": "The position of a single word in the contextionary and the words closest to it.",
+      "type": "object",
+      "properties": {
+        "nearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "vector": {
+          "$ref": "#/definitions/C11yVector"
+        },
+        "word": {
+          "description": "The inspected word",
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "inC11y": {
+                "type": "boolean"
+              },
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "frozen": {
+          "description": "A frozen class is read-only, its objects and its schema can not be changed. Use the frozen endpoints of the class to change this.",
+          "type": "boolean"
+        },
+        "keyProperty": {
+          "description": "Name of a string, text or int property which identifies the objects of this class. Objects created without an id get a deterministic id derived from the class name and the value of this property (UUIDv5), so creating an object with the same key twice targets the same id. The property must be set on every object.",
+          "type": "string"
+        },
+        "keywords": {
+          "$ref": "#/definitions/Keywords"
+        },
+        "moduleConfig": {
+          "description": "Free-form metadata of the class. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.",
+          "type": "object"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "textNormalization": {
+          "$ref": "#/definitions/TextNormalization"
+        },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
+        "vectorizeClassName": {
+          "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "ClassCount": {
+      "description": "The number of objects of a single class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "count": {
+          "description": "The number of objects of the class. Counts may be a few seconds old, as they are cached for a short time.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ClassCounts": {
+      "description": "The classes of the schema, each with the number of objects it holds.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The Action classes in schema order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassCount"
+          }
+        },
+        "things": {
+          "description": "The Thing classes in schema order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassCount"
+          }
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "informationGainCutoffPercentile": {
+          "description": "Only available on type=contextual. All words in a source corpus are ranked by their information gain against the possible target objects. A cutoff percentile of 40 implies that the top 40% are used and the bottom 60% are cut-off.",
+          "type": "integer",
+          "format": "int32",
+          "default": 30,
+          "example": 30
+        },
+        "informationGainMaximumBoost": {
+          "description": "Only available on type=contextual. Words in a corpus will receive an additional boost based on how high they are ranked according to information gain. Setting this value to 3 implies that the top-ranked word will be ranked 3 times as high as the bottom ranked word. The curve in between is logarithmic. A maximum boost of 1 implies that no boosting occurs.",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "k": {
+          "description": "k-value when using k-Neareast-Neighbor",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "minimumUsableWords": {
+          "description": "Only available on type=contextual. Both IG and tf-idf are mechanisms to remove words from the corpora. However, on very short corpora this could lead to a removal of all words, or all but a single word. This value guarantees that - regardless of tf-idf and IG score - always at least n words are used.",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on trainig data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "tfidfCutoffPercentile": {
+          "description": "Only available on type=contextual. All words in a corpus are ranked by their tf-idf score. A cutoff percentile of 80 implies that the top 80% are used and the bottom 20% are cut-off. This is very effective to remove words that occur in almost all objects, such as filler and stop words.",
+          "type": "integer",
+          "format": "int32",
+          "default": 80,
+          "example": 80
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "type": {
+          "description": "which algorythim to use for classifications",
+          "type": "string",
+          "default": "knn",
+          "enum": [
+            "knn",
+            "contextual"
+          ],
+          "example": "knn"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "ClassificationPreview": {
+      "description": "The predicted reference targets of a single object, nothing was stored.",
+      "type": "object",
+      "properties": {
+        "properties": {
+          "description": "the predicted target per classified property",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationPreviewProperty"
+          }
+        }
+      }
+    },
+    "ClassificationPreviewParams": {
+      "description": "Predict the reference targets of a single object exactly like a kNN classification would, without storing anything. Set exactly one of the id of an existing object, the schema of an unsaved object or a vector.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "class (name) of the object, the training data is taken from the same class",
+          "type": "string",
+          "example": "Article"
+        },
+        "classifyProperties": {
+          "description": "which ref-properties to predict",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "ofCategory"
+          ]
+        },
+        "id": {
+          "description": "id of an existing object which has none of the classify properties set yet, its vector is used",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "k": {
+          "description": "k-value of the k-Neareast-Neighbor vote, at most 100",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "schema": {
+          "description": "properties of an unsaved object of the class, it is vectorized just like an imported object",
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "trainingSetWhere": {
+          "description": "limit the training objects",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "vector": {
+          "description": "vector to predict the reference targets for, it must have the same dimensions as the vectors of the class",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "ClassificationPreviewProperty": {
+      "description": "The predicted reference target of a single ref-property, which is the same a classification would set.",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "the predicted reference target",
+          "type": "string",
+          "format": "uri"
+        },
+        "confidence": {
+          "description": "share of the k nearest neighbors which reference the predicted target, between 0 and 1",
+          "type": "number",
+          "format": "double"
+        },
+        "count": {
+          "description": "number of the k nearest neighbors which reference the predicted target",
+          "type": "integer",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "mean distance of all other neighbors, not set if every neighbor references the predicted target",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "property": {
+          "description": "name of the ref-property",
+          "type": "string"
+        },
+        "winningDistance": {
+          "description": "mean distance of the neighbors which reference the predicted target",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "ContextionaryCacheStats": {
+      "description": "Hit and miss counters of the local word vector cache. The counters are reset when the contextionary version changes.",
+      "type": "object",
+      "properties": {
+        "evictions": {
+          "description": "Number of word vectors removed because the cache was full",
+          "type": "integer",
+          "format": "int64"
+        },
+        "hits": {
+          "description": "Number of word vectors served from the cache",
+          "type": "integer",
+          "format": "int64"
+        },
+        "misses": {
+          "description": "Number of word vectors which had to be requested from the contextionary",
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "description": "Number of word vectors currently held in the cache",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mitigation": {
+          "description": "User-required action to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "FeatureProjection": {
+      "description": "A lower-dimensional representation of the objects vector",
+      "properties": {
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          }
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "Interpretation": {
+      "description": "This underscore property contains additional info about the how the class was vectorized",
+      "properties": {
+        "contributions": {
+          "description": "The concepts of the source which contributed most to matching the search, only set on vector searches",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InterpretationContribution"
+          }
+        },
+        "source": {
+          "description": "The input that was used to vectorize this object",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InterpretationSource"
+          }
+        }
+      }
+    },
+    "InterpretationContribution": {
+      "description": "A concept of the source and how much it contributed to matching the search",
+      "properties": {
+        "concept": {
+          "type": "string"
+        },
+        "weight": {
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "InterpretationSource": {
+      "description": "This underscore property contains additional info about the how the class was vectorized",
+      "properties": {
+        "concept": {
+          "type": "string"
+        },
+        "occurrence": {
+          "type": "number",
+          "format": "uint64"
+        },
+        "weight": {
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Keywords": {
+      "description": "Describes a class or property using multiple weighted words.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "keyword": {
+            "type": "string"
+          },
+          "weight": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "ListedReference": {
+      "description": "A single reference of an object.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "beacon": {
+          "description": "The beacon as it is stored.",
+          "type": "string",
+          "format": "uri"
+        },
+        "forbidden": {
+          "description": "The target was not resolved, as it may not be read with the given credentials. Only set if the targets were resolved.",
+          "type": "boolean"
+        },
+        "id": {
+          "description": "The id of the target. Only set if the beacon was parsed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "description": "The kind of the target, either thing or action. Only set if the beacon was parsed.",
+          "type": "string"
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "contextionaryCache": {
+          "description": "Statistics of the local word vector cache in front of the contextionary",
+          "$ref": "#/definitions/ContextionaryCacheStats"
+        },
+        "contextionaryVersion": {
+          "description": "Version of the contextionary service connected to weaviate",
+          "type": "string"
+        },
+        "contextionaryWordCount": {
+          "description": "Number of total words in the contextionary",
+          "type": "number",
+          "format": "int"
+        },
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NearestNeighbor": {
+      "description": "A group of neighboring concepts",
+      "type": "object",
+      "properties": {
+        "concept": {
+          "description": "The neighboring concept",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The distance between the result and this neighbor",
+          "type": "number",
+          "format": "float"
+        },
+        "vector": {
+          "description": "The neighbor's vector position",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "NearestNeighbors": {
+      "description": "A group of neighboring concepts",
+      "type": "object",
+      "properties": {
+        "neighbors": {
+          "description": "The individual neighbor items",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NearestNeighbor"
+          }
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Thing"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentThing": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Thing"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
         "input": {
           "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
           "type": "string"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "DEPRECATED - do not use anymore.",
+          "type": "string",
+          "default": ""
+        },
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "index": {
+          "description": "Optional. By default each property is fully indexed both for full-text, as well as vector-search. You can ignore properties in searches by explicitly setting index to false. Not set is the same as true",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexFilterable": {
+          "description": "Optional. Set to false to skip the inverted index which is used to filter by the value of an int, number, boolean, date or reference property. Not supported for string and text properties, see indexSearchable. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Set to false to skip building the inverted index for this property. The property can then no longer be used in where filters, but it is still stored and vectorized. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Set to false to skip the inverted index of the words of a string or text property, which is used to filter by its value. Only supported for string and text properties. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "keywords": {
+          "$ref": "#/definitions/Keywords"
+        },
+        "maxLength": {
+          "description": "Optional. The maximum number of characters of a string or text property.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maxReferences": {
+          "description": "Optional. The maximum number of references a reference property can hold on a single object. Not set means unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maximum": {
+          "description": "Optional. The largest value allowed for an int or number property.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "minLength": {
+          "description": "Optional. The minimum number of characters of a string or text property.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "Optional. The smallest value allowed for an int or number property.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Free-form metadata of the property. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "pattern": {
+          "description": "Optional. A regular expression (RE2 syntax) which every value of a string or text property must match. Use ^ and $ to match the whole value.",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Optional. Controls how the value of a string or text property is split into words before it is vectorized. 'word' (default) splits at every non-alphanumeric character, 'whitespace' only splits at whitespace and keeps the rest of a word together, 'field' passes the whole value on as a single lowercase phrase, so that it is vectorized as one concept if the contextionary knows it as a compound word, e.g. 'New York' or a concept added through an extension, which is useful for ids or categories. The setting only applies to the vectorization, it does not change how filters match the value.",
+          "type": "string",
+          "enum": [
+            "word",
+            "whitespace",
+            "field"
+          ]
+        },
+        "unique": {
+          "description": "Optional. If set to true, no two objects of the class may have the same value for this property. Only supported for the data types string, text, int, number and date. Defaults to false.",
+          "type": "boolean"
+        },
+        "vectorWeight": {
+          "description": "Optional. Relative weight of this property when the property vectors are combined into the object vector, must not be negative. A weight of 0 excludes the property from vectorization. Not set is the same as 1.",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "vectorizePropertyName": {
+          "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
+          "type": "boolean"
+        }
+      }
+    },
+    "PropertyFacetValue": {
+      "description": "A single distinct value of a property.",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "The number of objects that have this value.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the property."
+        }
+      }
+    },
+    "PropertyFacets": {
+      "description": "The distribution of the values of a single property.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "The approximate number of distinct values of the property.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "otherCount": {
+          "description": "The number of objects with a value that is not part of values because of the limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "values": {
+          "description": "The most common values, ordered by the number of objects that have them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PropertyFacetValue"
+          }
+        }
+      }
+    },
+    "PropertyReferences": {
+      "description": "The references of several reference properties of a single object, keyed by the property name.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/MultipleRef"
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMeta": {
+      "description": "Additional Meta information about this particular reference.",
+      "properties": {
+        "classification": {
+          "description": "If a property was set through a classification, this meta field contains additional info",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "losingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "winningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReferenceProperty": {
+      "description": "The references of a single reference property.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the property.",
+          "type": "string"
+        },
+        "references": {
+          "description": "The references in the order they are stored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ListedReference"
+          }
+        }
+      }
+    },
+    "ReferencesListResponse": {
+      "description": "The reference properties of a single object.",
+      "type": "object",
+      "properties": {
+        "properties": {
+          "description": "One entry per reference property which is set on the object, ordered by the property name.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReferenceProperty"
+          }
+        }
+      }
+    },
+    "ReindexStatus": {
+      "description": "The progress of re-vectorizing all objects of a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class that is reindexed.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The reason the reindex failed, only set if the status is failed.",
+          "type": "string"
+        },
+        "finished": {
+          "description": "Time the reindex completed or failed, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "The kind of the class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "processed": {
+          "description": "The number of objects that have been re-vectorized so far. If an interrupted reindex was resumed, objects of the previous run are not included.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "description": "Time the reindex was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Status of the reindex.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        }
+      }
+    },
+    "ReshardStatus": {
+      "description": "The progress of moving all objects of a class to a new shard configuration.",
+      "type": "object",
+      "properties": {
+        "autoExpandReplicas": {
+          "description": "The auto expand replicas setting of the new shard configuration.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class that is resharded.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The reason the reshard failed, only set if the status is failed.",
+          "type": "string"
+        },
+        "finished": {
+          "description": "Time the reshard completed or failed, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "The kind of the class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "numberOfShards": {
+          "description": "The number of shards of the new shard configuration.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "processed": {
+          "description": "The number of objects that have been copied to the new shards so far. Objects which a resumed reshard skipped because they had already been copied are included.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "description": "Time the reshard was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Status of the reshard.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "total": {
+          "description": "The number of objects to copy.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "RunningQuery": {
+      "description": "A GraphQL query which has not finished yet.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "The class the query targets, if any.",
+          "type": "string"
+        },
+        "durationMs": {
+          "description": "How long the query has been running, in milliseconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The id of the query, used to cancel it.",
+          "type": "string"
+        },
+        "operation": {
+          "description": "The kind of query, e.g. Get, Explore, Aggregate.",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "Time the query was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "username": {
+          "description": "The user who issued the query.",
+          "type": "string"
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of schema, should be \"thing\" or \"action\".",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        }
+      }
+    },
+    "SchemaDocument": {
+      "description": "The complete schema of an instance, used to promote a schema from one environment to another.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/Schema"
+        },
+        "things": {
+          "$ref": "#/definitions/Schema"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SchemaImportConflict": {
+      "description": "A class of the imported schema which already exists with a different definition.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "The kind of the imported class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "reason": {
+          "description": "The first difference between the existing and the imported class.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaImportResponse": {
+      "description": "The outcome of a schema import for every imported class.",
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The classes which already exist with a different definition. If there is any conflict, no class is created at all.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaImportConflict"
+          }
+        },
+        "created": {
+          "description": "The classes which did not exist yet and have been created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "unchanged": {
+          "description": "The classes which already existed with an identical definition.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SemanticPath": {
+      "description": "A semantic path between two objects, e.g. a search query and a result",
+      "properties": {
+        "path": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SemanticPathElement"
+          }
+        }
+      }
+    },
+    "SemanticPathElement": {
+      "description": "On link on the semantic path chain",
+      "properties": {
+        "concept": {
+          "type": "string"
+        },
+        "distanceToNext": {
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "distanceToPrevious": {
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "distanceToQuery": {
+          "type": "number",
+          "format": "float"
+        },
+        "distanceToResult": {
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "ShardCompaction": {
+      "description": "Result of the compaction of the storage file of a single shard.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the shard belongs to",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Kind of the class, either thing or action",
+          "type": "string"
+        },
+        "reclaimedBytes": {
+          "description": "Disk space which was released by the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "sizeAfterBytes": {
+          "description": "Size of the storage file after the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sizeBeforeBytes": {
+          "description": "Size of the storage file before the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ShardingConfig": {
+      "description": "The shard and replica configuration of a class in the vector index.",
+      "type": "object",
+      "properties": {
+        "autoExpandReplicas": {
+          "description": "The range of replicas per shard, which is expanded automatically depending on the number of nodes, such as \"0-1\" or \"0-all\". Use \"false\" for no replicas.",
+          "type": "string"
+        },
+        "numberOfShards": {
+          "description": "The number of primary shards.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "_classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "meta": {
+          "description": "Additional Meta information about this particular reference. Only shown if meta==true.",
+          "$ref": "#/definitions/ReferenceMeta"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "TextNormalization": {
+      "description": "Steps which are applied to the values of the string and text properties of a class before they are vectorized. It can only be set when the class is created. Steps which are not set use the global configuration.",
+      "type": "object",
+      "properties": {
+        "lowercase": {
+          "description": "Convert the text to lowercase. Enabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "removeStopwords": {
+          "description": "Remove the words which the contextionary considers stopwords. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "stripPunctuation": {
+          "description": "Replace punctuation characters with spaces. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "unicodeNFC": {
+          "description": "Normalize the text to the unicode normalization form C, so that identical characters which are composed differently lead to the same words. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "Thing": {
+      "type": "object",
+      "properties": {
+        "_classification": {
+          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/UnderscorePropertiesClassification"
+        },
+        "_featureProjection": {
+          "description": "A feature projection of the object's vector into lower dimensions for visualization",
+          "$ref": "#/definitions/FeatureProjection"
+        },
+        "_interpretation": {
+          "description": "Additional information about how this property was interpreted at vectorization. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/Interpretation"
+        },
+        "_nearestNeighbors": {
+          "description": "Additional information about the neighboring concepts of this element",
+          "$ref": "#/definitions/NearestNeighbors"
+        },
+        "_vector": {
+          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "class": {
+          "description": "Class of the Thing, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Thing in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiryTimeUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which this Thing expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Thing.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Thing update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meta": {
+          "$ref": "#/definitions/UnderscoreProperties"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "version": {
+          "description": "Version of this Thing, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Thing has not been changed in the meantime. Ignored in request bodies.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ThingsBatchGetResponse": {
+      "description": "Things retrieved by their UUIDs.",
+      "type": "object",
+      "properties": {
+        "notFound": {
+          "description": "The requested UUIDs for which no Thing exists.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "things": {
+          "description": "The Things which were found, in the order of the requested UUIDs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Thing"
+          }
+        }
+      }
+    },
+    "ThingsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Thing"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Thing.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ThingsListResponse": {
+      "description": "List of Things.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "limit": {
+          "description": "The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nextCursor": {
+          "description": "Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "things": {
+          "description": "The actual list of Things.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Thing"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of Things matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "UnderscoreProperties": {
+      "description": "Additional Meta information about a single thing/action object.",
+      "properties": {
+        "certainty": {
+          "description": "The certainty of the match between the search vector and the result, which is 1 minus the distance. This is the value the certainty argument of a search is compared against. Only on 'explore' searches",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "classification": {
+          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here",
+          "$ref": "#/definitions/UnderscorePropertiesClassification"
+        },
+        "distance": {
+          "description": "The normalized distance between the search vector and the result, between 0 (identical vectors) and 1 (perfect opposite). Results are ranked by this distance. Only on 'explore' searches",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "featureProjection": {
+          "description": "The concepts vector projected into a lower dimensional space (for visualization purposes)",
+          "$ref": "#/definitions/FeatureProjection"
+        },
+        "interpretation": {
+          "description": "Additional information about how the object was vectorized",
+          "$ref": "#/definitions/Interpretation"
+        },
+        "nearestNeighbors": {
+          "description": "Neighboring concepts of your search results",
+          "$ref": "#/definitions/NearestNeighbors"
+        },
+        "semanticPath": {
+          "description": "The semantic path between the search query and the result. Only on 'explore' searches",
+          "$ref": "#/definitions/SemanticPath"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space",
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "UnderscorePropertiesClassification": {
+      "description": "This underscore property contains additional info about the classification which affected this object",
+      "properties": {
+        "basedOn": {
+          "description": "The (primitive) field(s) which were used as a basis for classification. For example, if the type of classification is \"knn\" with k=3, the 3 nearest neighbors - based on these fields - were considered for the classification.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classifiedFields": {
+          "description": "The (reference) fields which were classified as part of this classification. Note that this might contain fewere entries than \"scope\", if one of the fields was already set prior to the classification, for example",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completed": {
+          "description": "Timestamp when this particular object was classified. This is usually sooner than the overall completion time of the classification, as the overall completion time will only be set once every object has been classified.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "unique identifier of the classification run",
+          "type": "string",
+          "format": "uuid"
+        },
+        "scope": {
+          "description": "The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See \"classifiedFields\" for details.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "VectorIndexConfig": {
+      "description": "Parameters of the HNSW vector index of a class. They can only be set when the class is created. Parameters which are not set use the defaults.",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "Size of the dynamic candidate list at query time. Higher values improve the recall at the cost of query speed. It is never smaller than the number of requested results. Must be between 1 and 4096, defaults to eight times the number of requested results. Can be overridden per query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list while building the index. Higher values improve the recall at the cost of import speed. Must be between 4 and 4096, defaults to 128.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per node on every layer, the lowest layer allows twice as many. Higher values improve the recall at the cost of memory and import speed. Must be between 4 and 256, defaults to 60.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorIndexStats": {
+      "description": "Size and build parameters of the vector index of a single shard.",
+      "type": "object",
+      "properties": {
+        "cachedVectors": {
+          "description": "Number of vectors currently held in the in-memory vector cache",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "Name of the class the shard belongs to",
+          "type": "string"
+        },
+        "efConstruction": {
+          "description": "Build parameter: size of the dynamic candidate list during construction",
+          "type": "integer",
+          "format": "int64"
+        },
+        "estimatedMemoryBytes": {
+          "description": "Approximate memory used by the index graph and the vector cache in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "Kind of the class, either thing or action",
+          "type": "string"
+        },
+        "maximumConnections": {
+          "description": "Build parameter: maximum number of connections per node and layer",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maximumLayer": {
+          "description": "Highest layer of the index graph",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "tombstoneCount": {
+          "description": "Number of vectors which are marked as deleted, but not cleaned up yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorCount": {
+          "description": "Number of vectors in the index, including vectors which are marked as deleted, but not cleaned up yet",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "VectorizerCorpus": {
+      "description": "The text the vectorizer builds from an object to determine its vector position.",
+      "type": "object",
+      "properties": {
+        "corpi": {
+          "description": "The corpi that are vectorized. Corpi of properties with the same vector weight are combined into a single corpus.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WeightedCorpus"
+          }
+        }
+      }
+    },
+    "WeightedCorpus": {
+      "description": "A single corpus as passed to the contextionary.",
+      "type": "object",
+      "properties": {
+        "corpus": {
+          "description": "The concatenated and preprocessed text.",
+          "type": "string"
+        },
+        "weight": {
+          "description": "The weight of this corpus in the final vector.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use. IsNull and IsNotNull match objects without or with a value for the property at the path, they require no value. An empty string, a string of only whitespace and a text without any words count as null.",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "Not",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "IsNotNull"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueString": {
+          "description": "value as string",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueText": {
+          "description": "value as text (on text props)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonChangedOnlyParameterQuery": {
+      "type": "boolean",
+      "description": "Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.",
+      "name": "changedOnly",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Restrict the list to objects of this class. Required when a 'where' filter is set.",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyParameterQuery": {
+      "enum": [
+        "eventual",
+        "strong"
+      ],
+      "type": "string",
+      "description": "Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.",
+      "name": "consistency",
+      "in": "query"
+    },
+    "CommonCountParameterQuery": {
+      "type": "boolean",
+      "description": "Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.",
+      "name": "count",
+      "in": "query"
+    },
+    "CommonDepthParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.",
+      "name": "depth",
+      "in": "query"
+    },
+    "CommonExpandParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.",
+      "name": "expand",
+      "in": "query"
+    },
+    "CommonIdempotencyKeyParameterHeader": {
+      "type": "string",
+      "description": "A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
+    "CommonIfMatchParameterHeader": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "CommonIfNotExistsParameterQuery": {
+      "type": "boolean",
+      "description": "If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.",
+      "name": "ifNotExists",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonIncludeTotalParameterQuery": {
+      "type": "boolean",
+      "description": "Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.",
+      "name": "includeTotal",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "minimum": 0,
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonMetaParameterQuery": {
+      "type": "boolean",
+      "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+      "name": "meta",
+      "in": "query"
+    },
+    "CommonTTLParameterHeader": {
+      "type": "integer",
+      "format": "int64",
+      "description": "Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.",
+      "name": "TTL",
+      "in": "header"
+    },
+    "CommonUniqueReferencesParameterQuery": {
+      "type": "boolean",
+      "description": "References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.",
+      "name": "uniqueReferences",
+      "in": "query"
+    },
+    "CommonValidateOnlyParameterQuery": {
+      "type": "boolean",
+      "description": "Only validate the objects in the batch without storing them. The response contains the validation result of each object.",
+      "name": "validateOnly",
+      "in": "query"
+    },
+    "CommonVectorizeParameterQuery": {
+      "type": "boolean",
+      "description": "In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.",
+      "name": "vectorize",
+      "in": "query"
+    },
+    "CommonWhereParameterQuery": {
+      "type": "string",
+      "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{\"operator\":\"Equal\",\"path\":[\"name\"],\"valueString\":\"foo\"}'. Paths are relative to the class set with the 'class' parameter.",
+      "name": "where",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "actions"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Things and Actions. Mostly used for importing large datasets.",
+      "name": "batching"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "name": "things"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/semi-technologies/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Open Source Search Graph (GraphQL/RESTful/P2P)",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/semi-technologies",
+      "email": "hello@semi.technology"
+    },
+    "version": "0.22.18"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/actions": {
+      "get": {
+        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Action per line.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a list of Actions.",
+        "operationId": "actions.list",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+            "name": "meta",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Restrict the list to objects of this class. Required when a 'where' filter is set.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{\"operator\":\"Equal\",\"path\":[\"name\"],\"valueString\":\"foo\"}'. Paths are relative to the class set with the 'class' parameter.",
+            "name": "where",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.",
+            "name": "count",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.",
+            "name": "includeTotal",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.",
+            "name": "after",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Action. Provided meta-data and schema values are validated.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Create Actions between two Things (object and subject).",
+        "operationId": "actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.",
+            "name": "ifNotExists",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "eventual",
+              "strong"
+            ],
+            "type": "string",
+            "description": "Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.",
+            "name": "consistency",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.",
+            "name": "TTL",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Action created.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "An object with the same id already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/actions/facets/{className}/{propertyName}": {
+      "get": {
+        "description": "Returns the most common distinct values of a property of a Action class, each with the number of Actions that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get the distribution of the values of a property.",
+        "operationId": "actions.facets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of distinct values to be returned. Defaults to 10, cannot exceed 1000.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query. Only Actions matching the filter are taken into account. Paths are relative to the class set in the path.",
+            "name": "where",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The distribution of the values of the property.",
+            "schema": {
+              "$ref": "#/definitions/PropertyFacets"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or property does not exist."
+          },
+          "422": {
+            "description": "The property cannot be faceted, the limit is out of range or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/actions/validate": {
+      "post": {
+        "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Validate an Action based on a schema.",
+        "operationId": "actions.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/actions/{id}": {
+      "get": {
+        "description": "Lists Actions.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a specific Action based on its UUID and a Thing UUID. Also available as Websocket bus.",
+        "operationId": "actions.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+            "name": "meta",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.",
+            "name": "expand",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.",
+            "name": "depth",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an Action based on its UUID.",
+        "operationId": "actions.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.",
+            "name": "changedOnly",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Action from the system.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Delete an Action based on its UUID.",
+        "operationId": "actions.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an Action based on its UUID (using patch semantics).",
+        "operationId": "actions.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the action object to merge into the existing action object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.",
+            "name": "uniqueReferences",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/actions/{id}/corpus": {
+      "get": {
+        "description": "Returns the exact text the vectorizer builds from a Action to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Action, it is only available if debug mode is enabled.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get the vectorizer corpus of a Action.",
+        "operationId": "actions.corpus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/VectorizerCorpus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden, either because of missing permissions or because debug mode is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/actions/{id}/references": {
+      "get": {
+        "description": "Lists only the reference properties of a Action, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "List the references of a Action.",
+        "operationId": "actions.references.list",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Add the kind and id of the target to every reference. Defaults to false.",
+            "name": "parse",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Add the target object to every local reference whose target exists. Implies parse. Defaults to false.",
+            "name": "resolve",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ReferencesListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Replace all references of several class-properties.",
+        "operationId": "actions.references.bulkUpdate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyReferences"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced the references of all listed properties."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the properties exist and are references?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/actions/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "actions.references.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "actions.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "actions.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/batching/actions": {
+      "post": {
+        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Creates new Actions based on an Action template as a batch.",
+        "operationId": "batching.actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
+                },
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                }
+              }
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only validate the objects in the batch without storing them. The response contains the validation result of each object.",
+            "name": "validateOnly",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.",
+            "name": "vectorize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded and every batched item was created, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
+            }
+          },
+          "207": {
+            "description": "Request succeeded, but at least one of the batched actions failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A request with the same idempotency key is still being executed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "cardinality": {
-          "description": "DEPRECATED - do not use anymore.",
-          "type": "string",
-          "default": ""
-        },
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/batching/actions/get": {
+      "post": {
+        "description": "Retrieve multiple Actions by their UUIDs in a single request. UUIDs for which no Action exists are listed separately.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Get multiple Actions based on their UUIDs.",
+        "operationId": "batching.actions.get",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchGetRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+            "name": "include",
+            "in": "query"
           }
-        },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "index": {
-          "description": "Optional. By default each property is fully indexed both for full-text, as well as vector-search. You can ignore properties in searches by explicitly setting index to false. Not set is the same as true",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "keywords": {
-          "$ref": "#/definitions/Keywords"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "vectorizePropertyName": {
-          "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
-          "type": "boolean"
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMeta": {
-      "description": "Additional Meta information about this particular reference.",
-      "properties": {
-        "classification": {
-          "description": "If a property was set through a classification, this meta field contains additional info",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        }
-      }
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "losingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "winningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        }
-      }
-    },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionsBatchGetResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        },
-        "type": {
-          "description": "Type of schema, should be \"thing\" or \"action\".",
-          "type": "string",
-          "enum": [
-            "thing",
-            "action"
-          ]
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "SemanticPath": {
-      "description": "A semantic path between two objects, e.g. a search query and a result",
-      "properties": {
-        "path": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/SemanticPathElement"
+    "/batching/references": {
+      "post": {
+        "description": "Register cross-references between any class items (things or actions) in bulk. With validateOnly, every reference is only validated without adding it: the source and target objects must exist, the property must be a reference property of the source class and the target must be of one of the classes the property can point to.",
+        "tags": [
+          "batching",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batching.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only validate the objects in the batch without storing them. The response contains the validation result of each object.",
+            "name": "validateOnly",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded and every batched reference was created (or is valid with validateOnly), see response body to get detailed information about each batched reference.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "207": {
+            "description": "Request succeeded, but at least one of the batched references failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "SemanticPathElement": {
-      "description": "On link on the semantic path chain",
-      "properties": {
-        "concept": {
-          "type": "string"
-        },
-        "distanceToNext": {
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "distanceToPrevious": {
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "distanceToQuery": {
-          "type": "number",
-          "format": "float"
-        },
-        "distanceToResult": {
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "_classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
-        },
-        "meta": {
-          "description": "Additional Meta information about this particular reference. Only shown if meta==true.",
-          "$ref": "#/definitions/ReferenceMeta"
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "Thing": {
-      "type": "object",
-      "properties": {
-        "_classification": {
-          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/UnderscorePropertiesClassification"
-        },
-        "_featureProjection": {
-          "description": "A feature projection of the object's vector into lower dimensions for visualization",
-          "$ref": "#/definitions/FeatureProjection"
-        },
-        "_interpretation": {
-          "description": "Additional information about how this property was interpreted at vectorization. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/Interpretation"
-        },
-        "_nearestNeighbors": {
-          "description": "Additional information about the neighboring concepts of this element",
-          "$ref": "#/definitions/NearestNeighbors"
-        },
-        "_vector": {
-          "description": "This object's position in the Contextionary vector space. (Underscore properties are optional, include them using the ?include=_\u003cpropName\u003e parameter)",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "class": {
-          "description": "Class of the Thing, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Thing in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Thing.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Thing update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "meta": {
-          "$ref": "#/definitions/UnderscoreProperties"
-        },
-        "schema": {
-          "$ref": "#/definitions/PropertySchema"
+    "/batching/references/resolve": {
+      "post": {
+        "description": "Resolve a list of beacons to the objects they point to in a single request. Every beacon has its own status, so that beacons which could not be resolved do not fail the whole request.",
+        "tags": [
+          "batching",
+          "references"
+        ],
+        "summary": "Resolve multiple beacons to their objects.",
+        "operationId": "batching.references.resolve",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BeaconsResolveRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+            "name": "include",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response. Inspect the status of every result to see which beacons could be resolved.",
+            "schema": {
+              "$ref": "#/definitions/BeaconsResolveResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "ThingsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Thing"
-        },
-        {
-          "properties": {
-            "deprecations": {
+    "/batching/things": {
+      "post": {
+        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batching",
+          "things"
+        ],
+        "summary": "Creates new Things based on a Thing template as a batch.",
+        "operationId": "batching.things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only validate the objects in the batch without storing them. The response contains the validation result of each object.",
+            "name": "validateOnly",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.",
+            "name": "vectorize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded and every batched item was created, see response body to get detailed information about each batched item.",
+            "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Deprecation"
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
+              }
+            }
+          },
+          "207": {
+            "description": "Request succeeded, but at least one of the batched things failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            },
+            "headers": {
+              "X-Batch-Total": {
+                "type": "integer",
+                "description": "Number of items in the batch."
+              },
+              "X-Batch-Succeeded": {
+                "type": "integer",
+                "description": "Number of items which were imported successfully."
+              },
+              "X-Batch-Failed": {
+                "type": "integer",
+                "description": "Number of items which failed."
+              },
+              "X-Batch-Failed-Validation": {
+                "type": "integer",
+                "description": "Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property."
+              },
+              "X-Batch-Failed-Conflict": {
+                "type": "integer",
+                "description": "Number of failed items which conflicted with an existing object, e.g. because the id was already taken."
+              },
+              "X-Batch-Failed-Internal": {
+                "type": "integer",
+                "description": "Number of failed items which could not be imported because of a server-side error."
               }
             }
-          }
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Thing.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A request with the same idempotency key is still being executed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
               }
             }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      ]
-    },
-    "ThingsListResponse": {
-      "description": "List of Things.",
-      "type": "object",
-      "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
-        },
-        "things": {
-          "description": "The actual list of Things.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Thing"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Things for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "UnderscoreProperties": {
-      "description": "Additional Meta information about a single thing/action object.",
-      "properties": {
-        "classification": {
-          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here",
-          "$ref": "#/definitions/UnderscorePropertiesClassification"
-        },
-        "featureProjection": {
-          "description": "The concepts vector projected into a lower dimensional space (for visualization purposes)",
-          "$ref": "#/definitions/FeatureProjection"
-        },
-        "interpretation": {
-          "description": "Additional information about how the object was vectorized",
-          "$ref": "#/definitions/Interpretation"
-        },
-        "nearestNeighbors": {
-          "description": "Neighboring concepts of your search results",
-          "$ref": "#/definitions/NearestNeighbors"
-        },
-        "semanticPath": {
-          "description": "The semantic path between the search query and the result. Only on 'explore' searches",
-          "$ref": "#/definitions/SemanticPath"
-        },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space",
-          "$ref": "#/definitions/C11yVector"
-        }
-      }
-    },
-    "UnderscorePropertiesClassification": {
-      "description": "This underscore property contains additional info about the classification which affected this object",
-      "properties": {
-        "basedOn": {
-          "description": "The (primitive) field(s) which were used as a basis for classification. For example, if the type of classification is \"knn\" with k=3, the 3 nearest neighbors - based on these fields - were considered for the classification.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "classifiedFields": {
-          "description": "The (reference) fields which were classified as part of this classification. Note that this might contain fewere entries than \"scope\", if one of the fields was already set prior to the classification, for example",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "completed": {
-          "description": "Timestamp when this particular object was classified. This is usually sooner than the overall completion time of the classification, as the overall completion time will only be set once every object has been classified.",
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "description": "unique identifier of the classification run",
-          "type": "string",
-          "format": "uuid"
         },
-        "scope": {
-          "description": "The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See \"classifiedFields\" for details.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/batching/things/get": {
+      "post": {
+        "description": "Retrieve multiple Things by their UUIDs in a single request. UUIDs for which no Thing exists are listed separately.",
+        "tags": [
+          "batching",
+          "things"
+        ],
+        "summary": "Get multiple Things based on their UUIDs.",
+        "operationId": "batching.things.get",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchGetRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+            "name": "include",
+            "in": "query"
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "Not",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingsBatchGetResponse"
+            }
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
-        },
-        "valueString": {
-          "description": "value as string",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueText": {
-          "description": "value as text (on text props)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+    "/c11y/concepts/{concept}": {
+      "get": {
+        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a concept is part of the contextionary.",
+        "operationId": "c11y.concepts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "concept",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonMetaParameterQuery": {
-      "type": "boolean",
-      "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-      "name": "meta",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "actions"
     },
-    {
-      "description": "These operations allow to execute batch requests for Things and Actions. Mostly used for importing large datasets.",
-      "name": "batching"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "name": "things"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/semi-technologies/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Open Source Search Graph (GraphQL/RESTful/P2P)",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/semi-technologies",
-      "email": "hello@semi.technology"
+    "/c11y/corpus": {
+      "post": {
+        "description": "Analyzes a sentence based on the contextionary",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.corpus.get",
+        "parameters": [
+          {
+            "description": "A text corpus",
+            "name": "corpus",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "The text corpus.",
+              "type": "object",
+              "properties": {
+                "corpus": {
+                  "type": "string",
+                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.c11y.corpus.get"
+        ]
+      }
     },
-    "version": "0.22.18"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
+    "/c11y/extensions/": {
+      "post": {
+        "description": "Extend the contextionary with your own custom concepts",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Extend the contextionary with custom concepts",
+        "operationId": "c11y.extensions",
+        "parameters": [
+          {
+            "description": "Description and definition of the concept to extend the contextionary with",
+            "name": "extension",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/C11yExtension"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Successfully extended the contextionary with the custom cocnept",
+            "schema": {
+              "$ref": "#/definitions/C11yExtension"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.extensions.post"
+        ]
       }
     },
-    "/.well-known/live": {
+    "/c11y/inspect/{word}": {
       "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
+        "description": "Shows the vector of a single word and the words closest to it in the contextionary, ordered by their distance. Words that are not part of the contextionary result in a 404.",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Inspect the vector and the nearest neighbors of a single word.",
+        "operationId": "c11y.inspect",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The single word to inspect.",
+            "name": "word",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of nearest neighbors to return. Defaults to 10, must be between 1 and 32.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordInspection"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The word is not part of the contextionary.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The word or the limit is invalid, e.g. the word contains more than a single word.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
+    "/c11y/words/{words}": {
       "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "contextionary-API"
+        ],
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.words",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "words",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Successful response.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/C11yWordsResponse"
             }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
       }
     },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receving traffic, all traffic should be redirected there instead."
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
       }
     },
-    "/actions": {
-      "get": {
-        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.",
+    "/classifications/preview": {
+      "post": {
+        "description": "Predicts the reference targets of a single object the same way a kNN classification does. Nothing is stored, so this can be used to tune the parameters before running a classification.",
         "tags": [
-          "actions"
+          "classifications"
         ],
-        "summary": "Get a list of Actions.",
-        "operationId": "actions.list",
+        "summary": "Previews a classification of a single object.",
+        "operationId": "classifications.preview",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
+            "description": "parameters of the preview",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClassificationPreviewParams"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully predicted the reference targets.",
+            "schema": {
+              "$ref": "#/definitions/ClassificationPreview"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.preview"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
           {
-            "type": "boolean",
-            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-            "name": "meta",
-            "in": "query"
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
-            "name": "include",
+            "type": "integer",
+            "format": "int64",
+            "description": "If set, the request blocks until the classification is no longer running, but at most for the specified number of seconds (capped at 300). The classification is returned in its state at that time, so a status of 'running' indicates the wait timed out.",
+            "name": "waitTimeoutSeconds",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ActionsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -3985,7 +9335,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Classification does not exist"
+          },
+          "422": {
+            "description": "Invalid waitTimeoutSeconds.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3994,34 +9350,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.classifications.get"
         ]
-      },
+      }
+    },
+    "/graphql": {
       "post": {
-        "description": "Registers a new Action. Provided meta-data and schema values are validated.",
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "actions"
+          "graphql"
         ],
-        "summary": "Create Actions between two Things (object and subject).",
-        "operationId": "actions.create",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Action created.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -4049,31 +9406,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/actions/validate": {
+    "/graphql/batch": {
       "post": {
-        "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "actions"
+          "graphql"
         ],
-        "summary": "Validate an Action based on a schema.",
-        "operationId": "actions.validate",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4100,51 +9464,222 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/actions/{id}": {
+    "/graphql/queries": {
       "get": {
-        "description": "Lists Actions.",
+        "description": "Lists all GraphQL queries which are currently running, the longest running first.",
         "tags": [
-          "actions"
+          "graphql"
         ],
-        "summary": "Get a specific Action based on its UUID and a Thing UUID. Also available as Websocket bus.",
-        "operationId": "actions.get",
+        "summary": "List the running GraphQL queries.",
+        "operationId": "graphql.queries.list",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/RunningQuery"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/graphql/queries/{id}": {
+      "delete": {
+        "description": "Cancels a running GraphQL query. The query ends with an error as soon as the cancellation is noticed.",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Cancel a running GraphQL query.",
+        "operationId": "graphql.queries.cancel",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
+            "description": "The id of the running query.",
             "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully canceled."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/meta/compaction": {
+      "post": {
+        "description": "Rewrites the storage file of every shard into a fresh file and reports the reclaimed disk space. Space of deleted and overwritten objects is otherwise never released. Reads continue during the compaction, writes to the shard which is being compacted wait until it is done. The compaction is throttled according to the server configuration. Closing the request cancels the compaction, shards which were already compacted stay compacted. Only available with the standalone storage.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Compacts the storage files of the current Weaviate instance.",
+        "operationId": "meta.compact",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShardCompaction"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A compaction is already running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/meta/snapshots/{className}/{shardName}": {
+      "get": {
+        "description": "Streams a point-in-time snapshot of a single shard of the class as a tar archive. Writes to the shard are paused only until the snapshot has been taken, not while it is downloaded. The snapshot can be loaded back with a PUT on the same path. Only available with the standalone storage.",
+        "produces": [
+          "application/json",
+          "application/x-tar"
+        ],
+        "tags": [
+          "meta"
+        ],
+        "summary": "Takes a snapshot of a shard of the current Weaviate instance.",
+        "operationId": "meta.shardSnapshot",
+        "parameters": [
           {
-            "type": "boolean",
-            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-            "name": "meta",
-            "in": "query"
+            "type": "string",
+            "description": "The name of the class of the shard.",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
-            "name": "include",
-            "in": "query"
+            "description": "The name of the shard, as listed in the vector index statistics.",
+            "name": "shardName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Action"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "file"
             }
           },
           "401": {
@@ -4157,52 +9692,66 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or the shard doesn't exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
       },
       "put": {
-        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Replaces the contents of a single shard of the class with a snapshot which was taken with a GET on the same path. Writes to the shard wait until the restore is done. The shard is left untouched if the snapshot is malformed or cannot be loaded. Only available with the standalone storage.",
+        "consumes": [
+          "application/x-tar"
+        ],
         "tags": [
-          "actions"
+          "meta"
         ],
-        "summary": "Update an Action based on its UUID.",
-        "operationId": "actions.update",
+        "summary": "Restores a shard of the current Weaviate instance from a snapshot.",
+        "operationId": "meta.shardRestore",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
+            "description": "The name of the class of the shard.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the shard, as listed in the vector index statistics.",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
+            "description": "The tar archive of the snapshot.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "type": "string",
+              "format": "binary"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Action"
-            }
+            "description": "Successful response."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4214,10 +9763,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or the shard doesn't exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The snapshot is malformed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4227,34 +9779,83 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Action from the system.",
+      }
+    },
+    "/meta/vector-index": {
+      "get": {
+        "description": "Reports the size and build parameters of the vector index of every shard. Only available with the standalone storage.",
         "tags": [
-          "actions"
+          "meta"
         ],
-        "summary": "Delete an Action based on its UUID.",
-        "operationId": "actions.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "summary": "Returns statistics of the vector indices of the current Weaviate instance.",
+        "operationId": "meta.vectorIndexStats",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/VectorIndexStats"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
         ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "$ref": "#/definitions/Schema"
+                },
+                "things": {
+                  "$ref": "#/definitions/Schema"
+                }
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4265,9 +9866,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4275,43 +9873,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/actions": {
+      "post": {
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Update an Action based on its UUID (using patch semantics).",
-        "operationId": "actions.patch",
+        "summary": "Create a new Action class in the schema.",
+        "operationId": "schema.actions.create",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the action object to merge into the existing action object.",
-            "name": "body",
+            "name": "actionClass",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Added the new Action class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4322,11 +9911,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid Action class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4338,49 +9924,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/actions/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/schema/actions/{className}": {
+      "delete": {
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "actions.references.update",
+        "summary": "Remove an Action class (and all data in the instances) from the schema.",
+        "operationId": "schema.actions.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Removed the Action class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Action class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4391,12 +9963,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4404,63 +9970,43 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      }
+    },
+    "/schema/actions/{className}/frozen": {
+      "put": {
+        "description": "Makes the Action class read-only, e.g. for the duration of a migration. Creating, updating or deleting Actions of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "actions.references.create",
+        "summary": "Freeze a Action class.",
+        "operationId": "schema.actions.freeze",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "The Action class is frozen."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class does not exist."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4468,47 +10014,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Makes a frozen Action class writable again. Unfreezing a class which is not frozen has no effect.",
         "tags": [
-          "actions"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "actions.references.delete",
+        "summary": "Unfreeze a Action class.",
+        "operationId": "schema.actions.unfreeze",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "The Action class is writable."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4520,10 +10047,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The class does not exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4532,63 +10056,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/batching/actions": {
+    "/schema/actions/{className}/properties": {
       "post": {
-        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
         "tags": [
-          "batching",
-          "actions"
+          "schema"
         ],
-        "summary": "Creates new Actions based on an Action template as a batch.",
-        "operationId": "batching.actions.create",
+        "summary": "Add a property to an Action class.",
+        "operationId": "schema.actions.properties.add",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Action"
-                  }
-                },
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                }
-              }
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Added the property.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ActionsGetResponse"
-              }
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -4601,7 +10101,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4613,44 +10113,43 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/batching/references": {
+    "/schema/actions/{className}/properties/batch": {
       "post": {
-        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "description": "Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.",
         "tags": [
-          "batching",
-          "references"
+          "schema"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batching.references.create",
+        "summary": "Add multiple properties to an Action class at once.",
+        "operationId": "schema.actions.properties.batchAdd",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BatchReference"
+                "$ref": "#/definitions/Property"
               }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Added the properties, returns the updated class.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -4663,7 +10162,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid properties, none of them were added.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4675,63 +10174,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/batching/things": {
-      "post": {
-        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+    "/schema/actions/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.",
         "tags": [
-          "batching",
-          "things"
+          "schema"
         ],
-        "summary": "Creates new Things based on a Thing template as a batch.",
-        "operationId": "batching.things.create",
+        "summary": "Remove a property from an Action class.",
+        "operationId": "schema.actions.properties.delete",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "things": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Thing"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Removed the property from the Action class."
+          },
+          "400": {
+            "description": "Could not delete the property.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ThingsGetResponse"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4743,12 +10220,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4756,41 +10227,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/c11y/concepts/{concept}": {
+    "/schema/counts": {
       "get": {
-        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
+        "description": "Returns every Thing and Action class of the schema together with the number of objects it holds. The counts are determined without loading any objects and are cached for a few seconds.",
         "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Checks if a concept is part of the contextionary.",
-        "operationId": "c11y.concepts",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "concept",
-            "in": "path",
-            "required": true
-          }
+          "schema"
         ],
+        "summary": "List all classes with their object counts.",
+        "operationId": "schema.counts",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The classes with their object counts.",
             "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ClassCounts"
             }
           },
           "401": {
@@ -4807,84 +10261,72 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/c11y/corpus": {
-      "post": {
-        "description": "Analyzes a sentence based on the contextionary",
+    "/schema/export": {
+      "get": {
+        "description": "Exports all thing and action classes including their properties and configuration. The document can be imported into another instance with a POST to /schema/import.",
         "tags": [
-          "contextionary-API"
+          "schema"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.corpus.get",
-        "parameters": [
-          {
-            "description": "A text corpus",
-            "name": "corpus",
-            "in": "body",
-            "required": true,
+        "summary": "Export the complete schema as a single document.",
+        "operationId": "schema.export",
+        "responses": {
+          "200": {
+            "description": "The complete schema.",
             "schema": {
-              "description": "The text corpus.",
-              "type": "object",
-              "properties": {
-                "corpus": {
-                  "type": "string",
-                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
-                }
-              }
+              "$ref": "#/definitions/SchemaDocument"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        ],
-        "responses": {
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.corpus.get"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/c11y/extensions/": {
+    "/schema/import": {
       "post": {
-        "description": "Extend the contextionary with your own custom concepts",
+        "description": "Creates all classes of the document which don't exist yet. Classes which already exist with an identical definition are left untouched, so the import can safely be repeated. If any class already exists with a different definition, nothing is created and the conflicts are reported instead.",
         "tags": [
-          "contextionary-API"
+          "schema"
         ],
-        "summary": "Extend the contextionary with custom concepts",
-        "operationId": "c11y.extensions",
+        "summary": "Import a previously exported schema.",
+        "operationId": "schema.import",
         "parameters": [
           {
-            "description": "Description and definition of the concept to extend the contextionary with",
-            "name": "extension",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/C11yExtension"
+              "$ref": "#/definitions/SchemaDocument"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully extended the contextionary with the custom cocnept",
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "The schema was imported.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/SchemaImportResponse"
             }
           },
           "401": {
@@ -4896,49 +10338,50 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "Some classes already exist with a different definition, nothing was imported.",
+            "schema": {
+              "$ref": "#/definitions/SchemaImportResponse"
+            }
+          },
+          "422": {
+            "description": "The schema could not be imported.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-serviceIds": [
-          "weaviate.extensions.post"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/c11y/words/{words}": {
+    "/schema/reindex/{className}": {
       "get": {
-        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/schema-schema.md#camelcase",
         "tags": [
-          "contextionary-API"
+          "schema"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.words",
+        "summary": "Get the progress of the reindex of a class.",
+        "operationId": "schema.reindex.get",
         "parameters": [
           {
             "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "words",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "The progress of the most recent reindex of the class.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ReindexStatus"
             }
           },
           "401": {
@@ -4950,53 +10393,46 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "No reindex of this class has been started."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.query.meta"
         ]
-      }
-    },
-    "/classifications/": {
+      },
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
+        "description": "Re-vectorizes every object of the class with the current vectorizer configuration and replaces the vectors in the vector index. The reindex runs in the background, its progress can be retrieved with a GET on the same path. A reindex starts over, unless resume is set, in which case it continues where the previous reindex of the class was interrupted.",
         "tags": [
-          "classifications"
+          "schema"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Re-vectorize all objects of a class.",
+        "operationId": "schema.reindex",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Continue an interrupted reindex of the class instead of starting over. Defaults to false.",
+            "name": "resume",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "202": {
+            "description": "The reindex was started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ReindexStatus"
             }
           },
           "401": {
@@ -5008,6 +10444,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "409": {
+            "description": "A reindex of this class is already running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5016,32 +10461,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/schema/shards/{className}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "classifications"
+          "schema"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get the progress of the reshard of a class.",
+        "operationId": "schema.shards.get",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "The progress of the most recent reshard of the class.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/ReshardStatus"
             }
           },
           "401": {
@@ -5054,7 +10497,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "No reshard of this class has been started."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5064,34 +10507,37 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query.meta"
         ]
-      }
-    },
-    "/graphql": {
+      },
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Moves all objects of the class to a new index with the specified number of shards and replicas. The objects are copied in the background while the class stays queryable, only during the final catch-up writes to the class are rejected. The progress can be retrieved with a GET on the same path. Starting a reshard with the same configuration as an interrupted one resumes it. Only supported by the elasticsearch vector index.",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Change the shard configuration of a class.",
+        "operationId": "schema.shards.update",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/ShardingConfig"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
+          "202": {
+            "description": "The reshard was started.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/ReshardStatus"
             }
           },
           "401": {
@@ -5103,8 +10549,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "409": {
+            "description": "A reshard of this class is already running.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid shard configuration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5116,41 +10571,127 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/graphql/batch": {
+    "/schema/things": {
       "post": {
-        "description": "Perform a batched GraphQL query",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Create a new Thing class in the schema.",
+        "operationId": "schema.things.create",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
+            "name": "thingClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Thing class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/things/{className}": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a Thing class (and all data in the instances) from the schema.",
+        "operationId": "schema.things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Thing class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/things/{className}/frozen": {
+      "put": {
+        "description": "Makes the Thing class read-only, e.g. for the duration of a migration. Creating, updating or deleting Things of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Freeze a Thing class.",
+        "operationId": "schema.things.freeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+            "description": "The Thing class is frozen."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5161,11 +10702,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "The class does not exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5174,30 +10712,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+      },
+      "delete": {
+        "description": "Makes a frozen Thing class writable again. Unfreezing a class which is not frozen has no effect.",
         "tags": [
-          "meta"
+          "schema"
+        ],
+        "summary": "Unfreeze a Thing class.",
+        "operationId": "schema.things.unfreeze",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
+            "description": "The Thing class is writable."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5208,6 +10744,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class does not exist."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5215,33 +10754,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema": {
-      "get": {
+    "/schema/things/{className}/properties": {
+      "post": {
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Add a property to a Thing class.",
+        "operationId": "schema.things.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Added the property.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "$ref": "#/definitions/Schema"
-                },
-                "things": {
-                  "$ref": "#/definitions/Schema"
-                }
-              }
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -5253,6 +10798,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5261,30 +10812,40 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/actions": {
+    "/schema/things/{className}/properties/batch": {
       "post": {
+        "description": "Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new Action class in the schema.",
-        "operationId": "schema.actions.create",
+        "summary": "Add multiple properties to a Thing class at once.",
+        "operationId": "schema.things.properties.batchAdd",
         "parameters": [
           {
-            "name": "actionClass",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Property"
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Action class to the schema.",
+            "description": "Added the properties, returns the updated class.",
             "schema": {
               "$ref": "#/definitions/Class"
             }
@@ -5299,7 +10860,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Action class",
+            "description": "Invalid properties, none of them were added.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5312,31 +10873,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/actions/{className}": {
+    "/schema/things/{className}/properties/{propertyName}": {
       "delete": {
+        "description": "Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.",
         "tags": [
           "schema"
         ],
-        "summary": "Remove an Action class (and all data in the instances) from the schema.",
-        "operationId": "schema.actions.delete",
+        "summary": "Remove a property from a Thing class.",
+        "operationId": "schema.things.properties.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Action class from the schema."
+            "description": "Removed the property from the Thing class."
           },
           "400": {
-            "description": "Could not delete the Action class.",
+            "description": "Could not delete the property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5362,34 +10930,82 @@ func init() {
         ]
       }
     },
-    "/schema/actions/{className}/properties": {
-      "post": {
+    "/things": {
+      "get": {
+        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Thing per line.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
         "tags": [
-          "schema"
+          "things"
         ],
-        "summary": "Add a property to an Action class.",
-        "operationId": "schema.actions.properties.add",
+        "summary": "Get a list of Things.",
+        "operationId": "things.list",
         "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+            "name": "meta",
+            "in": "query"
+          },
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
+            "name": "include",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "type": "string",
+            "description": "Restrict the list to objects of this class. Required when a 'where' filter is set.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{\"operator\":\"Equal\",\"path\":[\"name\"],\"valueString\":\"foo\"}'. Paths are relative to the class set with the 'class' parameter.",
+            "name": "where",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.",
+            "name": "count",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.",
+            "name": "includeTotal",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.",
+            "name": "after",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ThingsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5401,11 +11017,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid property.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5414,33 +11027,57 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/schema/things": {
+      },
       "post": {
+        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
         "tags": [
-          "schema"
+          "things"
         ],
-        "summary": "Create a new Thing class in the schema.",
-        "operationId": "schema.things.create",
+        "summary": "Create a new Thing based on a Thing template.",
+        "operationId": "things.create",
         "parameters": [
           {
-            "name": "thingClass",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Thing"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.",
+            "name": "ifNotExists",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "eventual",
+              "strong"
+            ],
+            "type": "string",
+            "description": "Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.",
+            "name": "consistency",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.",
+            "name": "TTL",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Thing class to the schema.",
+            "description": "Thing created.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Thing"
             }
           },
           "401": {
@@ -5452,12 +11089,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "An object with the same id already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Thing class.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5465,34 +11120,53 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.add"
         ]
       }
     },
-    "/schema/things/{className}": {
-      "delete": {
+    "/things/facets/{className}/{propertyName}": {
+      "get": {
+        "description": "Returns the most common distinct values of a property of a Thing class, each with the number of Things that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.",
         "tags": [
-          "schema"
+          "things"
         ],
-        "summary": "Remove a Thing class (and all data in the instances) from the schema.",
-        "operationId": "schema.things.delete",
+        "summary": "Get the distribution of the values of a property.",
+        "operationId": "things.facets",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of distinct values to be returned. Defaults to 10, cannot exceed 1000.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query. Only Things matching the filter are taken into account. Paths are relative to the class set in the path.",
+            "name": "where",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Thing class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Thing class.",
+            "description": "The distribution of the values of the property.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/PropertyFacets"
             }
           },
           "401": {
@@ -5504,6 +11178,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class or property does not exist."
+          },
+          "422": {
+            "description": "The property cannot be faceted, the limit is out of range or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5512,39 +11195,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/things/{className}/properties": {
+    "/things/validate": {
       "post": {
+        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
         "tags": [
-          "schema"
+          "things"
         ],
-        "summary": "Add a property to a Thing class.",
-        "operationId": "schema.things.properties.add",
+        "summary": "Validate Things schema.",
+        "operationId": "things.validate",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Thing"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5556,7 +11231,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5568,26 +11243,29 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/things": {
+    "/things/{id}": {
       "get": {
-        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Returns a particular Thing data.",
         "tags": [
           "things"
         ],
-        "summary": "Get a list of Things.",
-        "operationId": "things.list",
+        "summary": "Get a Thing based on its UUID.",
+        "operationId": "things.get",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "boolean",
@@ -5600,13 +11278,26 @@ func init() {
             "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
             "name": "include",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.",
+            "name": "expand",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.",
+            "name": "depth",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ThingsListResponse"
+              "$ref": "#/definitions/Thing"
             }
           },
           "400": {
@@ -5640,14 +11331,22 @@ func init() {
           "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+      "put": {
+        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.",
         "tags": [
           "things"
         ],
-        "summary": "Create a new Thing based on a Thing template.",
-        "operationId": "things.create",
+        "summary": "Update a Thing based on its UUID.",
+        "operationId": "things.update",
         "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
@@ -5655,11 +11354,24 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Thing"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.",
+            "name": "changedOnly",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Thing created.",
+            "description": "Successfully received.",
             "schema": {
               "$ref": "#/definitions/Thing"
             }
@@ -5673,12 +11385,33 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5689,31 +11422,29 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/things/validate": {
-      "post": {
-        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+      },
+      "delete": {
+        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
         "tags": [
           "things"
         ],
-        "summary": "Validate Things schema.",
-        "operationId": "things.validate",
+        "summary": "Delete a Thing based on its UUID.",
+        "operationId": "things.delete",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5724,11 +11455,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5737,21 +11465,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/things/{id}": {
-      "get": {
-        "description": "Returns a particular Thing data.",
+      },
+      "patch": {
+        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.",
         "tags": [
           "things"
         ],
-        "summary": "Get a Thing based on its UUID.",
-        "operationId": "things.get",
+        "summary": "Update a Thing based on its UUID (using patch semantics).",
+        "operationId": "things.patch",
         "parameters": [
           {
             "type": "string",
@@ -5761,31 +11487,34 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
           {
             "type": "boolean",
-            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-            "name": "meta",
+            "description": "References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.",
+            "name": "uniqueReferences",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
-            "name": "include",
-            "in": "query"
+            "type": "integer",
+            "format": "int64",
+            "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
+          "204": {
+            "description": "Successfully applied. No content returned"
           },
           "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5799,6 +11528,30 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "409": {
+            "description": "Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request is expected to be admitted."
+              }
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5809,16 +11562,18 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
-      },
-      "put": {
-        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/things/{id}/corpus": {
+      "get": {
+        "description": "Returns the exact text the vectorizer builds from a Thing to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Thing, it is only available if debug mode is enabled.",
         "tags": [
           "things"
         ],
-        "summary": "Update a Thing based on its UUID.",
-        "operationId": "things.update",
+        "summary": "Get the vectorizer corpus of a Thing.",
+        "operationId": "things.corpus",
         "parameters": [
           {
             "type": "string",
@@ -5827,28 +11582,20 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/VectorizerCorpus"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden",
+            "description": "Forbidden, either because of missing permissions or because debug mode is disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5856,12 +11603,6 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5872,16 +11613,18 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      },
-      "delete": {
-        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
+      }
+    },
+    "/things/{id}/references": {
+      "get": {
+        "description": "Lists only the reference properties of a Thing, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.",
         "tags": [
           "things"
         ],
-        "summary": "Delete a Thing based on its UUID.",
-        "operationId": "things.delete",
+        "summary": "List the references of a Thing.",
+        "operationId": "things.references.list",
         "parameters": [
           {
             "type": "string",
@@ -5890,11 +11633,26 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Add the kind and id of the target to every reference. Defaults to false.",
+            "name": "parse",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Add the target object to every local reference whose target exists. Implies parse. Defaults to false.",
+            "name": "resolve",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ReferencesListResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5915,19 +11673,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "patch": {
-        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "put": {
+        "description": "Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.",
         "tags": [
           "things"
         ],
-        "summary": "Update a Thing based on its UUID (using patch semantics).",
-        "operationId": "things.patch",
+        "summary": "Replace all references of several class-properties.",
+        "operationId": "things.references.bulkUpdate",
         "parameters": [
           {
             "type": "string",
@@ -5938,20 +11696,17 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/PropertyReferences"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content returned"
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Successfully replaced the references of all listed properties."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5962,11 +11717,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the properties exist and are references?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6213,6 +11965,11 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "expiryTimeUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which this Action expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
         "id": {
           "description": "ID of the Action.",
           "type": "string",
@@ -6231,6 +11988,32 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "version": {
+          "description": "Version of this Action, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Action has not been changed in the meantime. Ignored in request bodies.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ActionsBatchGetResponse": {
+      "description": "Actions retrieved by their UUIDs.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The Actions which were found, in the order of the requested UUIDs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "notFound": {
+          "description": "The requested UUIDs for which no Action exists.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         }
       }
     },
@@ -6309,10 +12092,52 @@ func init() {
             "$ref": "#/definitions/Deprecation"
           }
         },
-        "totalResults": {
-          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
+        "limit": {
+          "description": "The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.",
           "type": "integer",
           "format": "int64"
+        },
+        "nextCursor": {
+          "description": "Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "totalResults": {
+          "description": "The total number of Actions matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "BatchGetRequest": {
+      "description": "A list of UUIDs to retrieve in a single request.",
+      "type": "object",
+      "required": [
+        "ids"
+      ],
+      "properties": {
+        "expand": {
+          "description": "Names of reference properties whose targets are resolved and inlined into the 'schema' of the references. Every target is fetched once, no matter how many objects point to it. Only the first level of references is resolved, at most 1000 distinct targets per request.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expandProperties": {
+          "description": "The properties of the resolved targets to inline. If omitted, all properties of the targets are inlined.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ids": {
+          "description": "The UUIDs of the objects to retrieve. The objects are returned in the same order.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         }
       }
     },
@@ -6380,6 +12205,65 @@ func init() {
         }
       }
     },
+    "BeaconResolveResult": {
+      "description": "The outcome of resolving a single beacon.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "beacon": {
+          "description": "The beacon as it was requested.",
+          "type": "string",
+          "format": "uri"
+        },
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "description": "SUCCESS if the target was found, NOT_FOUND if no object with this id exists and FAILED if the beacon could not be resolved.",
+          "type": "string",
+          "enum": [
+            "SUCCESS",
+            "NOT_FOUND",
+            "FAILED"
+          ]
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        }
+      }
+    },
+    "BeaconsResolveRequest": {
+      "description": "A list of beacons to resolve in a single request.",
+      "type": "object",
+      "required": [
+        "beacons"
+      ],
+      "properties": {
+        "beacons": {
+          "description": "The beacons to resolve, such as weaviate://localhost/things/\u003cuuid\u003e. The results are returned in the same order.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uri"
+          }
+        }
+      }
+    },
+    "BeaconsResolveResponse": {
+      "description": "The objects the requested beacons point to.",
+      "type": "object",
+      "properties": {
+        "results": {
+          "description": "One result per requested beacon, in the order of the request.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BeaconResolveResult"
+          }
+        }
+      }
+    },
     "C11yExtension": {
       "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
@@ -6475,6 +12359,22 @@ func init() {
         }
       }
     },
+    "C11yWordInspection": {
+      "description": "The position of a single word in the contextionary and the words closest to it.",
+      "type": "object",
+      "properties": {
+        "nearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "vector": {
+          "$ref": "#/definitions/C11yVector"
+        },
+        "word": {
+          "description": "The inspected word",
+          "type": "string"
+        }
+      }
+    },
     "C11yWordsResponse": {
       "description": "An array of available words and contexts.",
       "properties": {
@@ -6573,9 +12473,21 @@ func init() {
           "description": "Description of the class.",
           "type": "string"
         },
+        "frozen": {
+          "description": "A frozen class is read-only, its objects and its schema can not be changed. Use the frozen endpoints of the class to change this.",
+          "type": "boolean"
+        },
+        "keyProperty": {
+          "description": "Name of a string, text or int property which identifies the objects of this class. Objects created without an id get a deterministic id derived from the class name and the value of this property (UUIDv5), so creating an object with the same key twice targets the same id. The property must be set on every object.",
+          "type": "string"
+        },
         "keywords": {
           "$ref": "#/definitions/Keywords"
         },
+        "moduleConfig": {
+          "description": "Free-form metadata of the class. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.",
+          "type": "object"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -6583,6 +12495,12 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "textNormalization": {
+          "$ref": "#/definitions/TextNormalization"
+        },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
         "vectorizeClassName": {
           "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
           "type": "boolean",
@@ -6590,6 +12508,41 @@ func init() {
         }
       }
     },
+    "ClassCount": {
+      "description": "The number of objects of a single class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "count": {
+          "description": "The number of objects of the class. Counts may be a few seconds old, as they are cached for a short time.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ClassCounts": {
+      "description": "The classes of the schema, each with the number of objects it holds.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The Action classes in schema order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassCount"
+          }
+        },
+        "things": {
+          "description": "The Thing classes in schema order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassCount"
+          }
+        }
+      }
+    },
     "Classification": {
       "description": "Manage classifications, trigger them and view status of past classifications.",
       "type": "object",
@@ -6708,36 +12661,162 @@ func init() {
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "ClassificationPreview": {
+      "description": "The predicted reference targets of a single object, nothing was stored.",
+      "type": "object",
+      "properties": {
+        "properties": {
+          "description": "the predicted target per classified property",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationPreviewProperty"
+          }
+        }
+      }
+    },
+    "ClassificationPreviewParams": {
+      "description": "Predict the reference targets of a single object exactly like a kNN classification would, without storing anything. Set exactly one of the id of an existing object, the schema of an unsaved object or a vector.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "class (name) of the object, the training data is taken from the same class",
+          "type": "string",
+          "example": "Article"
+        },
+        "classifyProperties": {
+          "description": "which ref-properties to predict",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "ofCategory"
+          ]
+        },
+        "id": {
+          "description": "id of an existing object which has none of the classify properties set yet, its vector is used",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "k": {
+          "description": "k-value of the k-Neareast-Neighbor vote, at most 100",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "schema": {
+          "description": "properties of an unsaved object of the class, it is vectorized just like an imported object",
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "trainingSetWhere": {
+          "description": "limit the training objects",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "vector": {
+          "description": "vector to predict the reference targets for, it must have the same dimensions as the vectors of the class",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "ClassificationPreviewProperty": {
+      "description": "The predicted reference target of a single ref-property, which is the same a classification would set.",
       "type": "object",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
+        "beacon": {
+          "description": "the predicted reference target",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "format": "uri"
+        },
+        "confidence": {
+          "description": "share of the k nearest neighbors which reference the predicted target, between 0 and 1",
+          "type": "number",
+          "format": "double"
         },
         "count": {
-          "description": "number of objects which were taken into consideration for classification",
+          "description": "number of the k nearest neighbors which reference the predicted target",
           "type": "integer",
-          "example": 147
+          "format": "int64"
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
+        "losingDistance": {
+          "description": "mean distance of all other neighbors, not set if every neighbor references the predicted target",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "property": {
+          "description": "name of the ref-property",
+          "type": "string"
+        },
+        "winningDistance": {
+          "description": "mean distance of the neighbors which reference the predicted target",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "ContextionaryCacheStats": {
+      "description": "Hit and miss counters of the local word vector cache. The counters are reset when the contextionary version changes.",
+      "type": "object",
+      "properties": {
+        "evictions": {
+          "description": "Number of word vectors removed because the cache was full",
           "type": "integer",
-          "example": 7
+          "format": "int64"
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "hits": {
+          "description": "Number of word vectors served from the cache",
           "type": "integer",
-          "example": 140
+          "format": "int64"
         },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "misses": {
+          "description": "Number of word vectors which had to be requested from the contextionary",
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "description": "Number of word vectors currently held in the cache",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -6932,6 +13011,13 @@ func init() {
     "Interpretation": {
       "description": "This underscore property contains additional info about the how the class was vectorized",
       "properties": {
+        "contributions": {
+          "description": "The concepts of the source which contributed most to matching the search, only set on vector searches",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InterpretationContribution"
+          }
+        },
         "source": {
           "description": "The input that was used to vectorize this object",
           "type": "array",
@@ -6941,6 +13027,18 @@ func init() {
         }
       }
     },
+    "InterpretationContribution": {
+      "description": "A concept of the source and how much it contributed to matching the search",
+      "properties": {
+        "concept": {
+          "type": "string"
+        },
+        "weight": {
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
     "InterpretationSource": {
       "description": "This underscore property contains additional info about the how the class was vectorized",
       "properties": {
@@ -7001,10 +13099,44 @@ func init() {
         }
       }
     },
+    "ListedReference": {
+      "description": "A single reference of an object.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/Action"
+        },
+        "beacon": {
+          "description": "The beacon as it is stored.",
+          "type": "string",
+          "format": "uri"
+        },
+        "forbidden": {
+          "description": "The target was not resolved, as it may not be read with the given credentials. Only set if the targets were resolved.",
+          "type": "boolean"
+        },
+        "id": {
+          "description": "The id of the target. Only set if the beacon was parsed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "description": "The kind of the target, either thing or action. Only set if the beacon was parsed.",
+          "type": "string"
+        },
+        "thing": {
+          "$ref": "#/definitions/Thing"
+        }
+      }
+    },
     "Meta": {
       "description": "Contains meta information of the current Weaviate instance.",
       "type": "object",
       "properties": {
+        "contextionaryCache": {
+          "description": "Statistics of the local word vector cache in front of the contextionary",
+          "$ref": "#/definitions/ContextionaryCacheStats"
+        },
         "contextionaryVersion": {
           "description": "Version of the contextionary service connected to weaviate",
           "type": "string"
@@ -7243,19 +13375,135 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexFilterable": {
+          "description": "Optional. Set to false to skip the inverted index which is used to filter by the value of an int, number, boolean, date or reference property. Not supported for string and text properties, see indexSearchable. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Set to false to skip building the inverted index for this property. The property can then no longer be used in where filters, but it is still stored and vectorized. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Set to false to skip the inverted index of the words of a string or text property, which is used to filter by its value. Only supported for string and text properties. Not set is the same as true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "keywords": {
           "$ref": "#/definitions/Keywords"
         },
+        "maxLength": {
+          "description": "Optional. The maximum number of characters of a string or text property.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maxReferences": {
+          "description": "Optional. The maximum number of references a reference property can hold on a single object. Not set means unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "maximum": {
+          "description": "Optional. The largest value allowed for an int or number property.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "minLength": {
+          "description": "Optional. The minimum number of characters of a string or text property.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "Optional. The smallest value allowed for an int or number property.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Free-form metadata of the property. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.",
+          "type": "object"
+        },
         "name": {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "pattern": {
+          "description": "Optional. A regular expression (RE2 syntax) which every value of a string or text property must match. Use ^ and $ to match the whole value.",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Optional. Controls how the value of a string or text property is split into words before it is vectorized. 'word' (default) splits at every non-alphanumeric character, 'whitespace' only splits at whitespace and keeps the rest of a word together, 'field' passes the whole value on as a single lowercase phrase, so that it is vectorized as one concept if the contextionary knows it as a compound word, e.g. 'New York' or a concept added through an extension, which is useful for ids or categories. The setting only applies to the vectorization, it does not change how filters match the value.",
+          "type": "string",
+          "enum": [
+            "word",
+            "whitespace",
+            "field"
+          ]
+        },
+        "unique": {
+          "description": "Optional. If set to true, no two objects of the class may have the same value for this property. Only supported for the data types string, text, int, number and date. Defaults to false.",
+          "type": "boolean"
+        },
+        "vectorWeight": {
+          "description": "Optional. Relative weight of this property when the property vectors are combined into the object vector, must not be negative. A weight of 0 excludes the property from vectorization. Not set is the same as 1.",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
         "vectorizePropertyName": {
           "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
           "type": "boolean"
         }
       }
     },
+    "PropertyFacetValue": {
+      "description": "A single distinct value of a property.",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "The number of objects that have this value.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the property."
+        }
+      }
+    },
+    "PropertyFacets": {
+      "description": "The distribution of the values of a single property.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "The approximate number of distinct values of the property.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "otherCount": {
+          "description": "The number of objects with a value that is not part of values because of the limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "values": {
+          "description": "The most common values, ordered by the number of objects that have them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PropertyFacetValue"
+          }
+        }
+      }
+    },
+    "PropertyReferences": {
+      "description": "The references of several reference properties of a single object, keyed by the property name.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/MultipleRef"
+      }
+    },
     "PropertySchema": {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
@@ -7278,10 +13526,178 @@ func init() {
           "format": "float32",
           "x-nullable": true
         },
-        "winningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "winningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReferenceProperty": {
+      "description": "The references of a single reference property.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the property.",
+          "type": "string"
+        },
+        "references": {
+          "description": "The references in the order they are stored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ListedReference"
+          }
+        }
+      }
+    },
+    "ReferencesListResponse": {
+      "description": "The reference properties of a single object.",
+      "type": "object",
+      "properties": {
+        "properties": {
+          "description": "One entry per reference property which is set on the object, ordered by the property name.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReferenceProperty"
+          }
+        }
+      }
+    },
+    "ReindexStatus": {
+      "description": "The progress of re-vectorizing all objects of a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class that is reindexed.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The reason the reindex failed, only set if the status is failed.",
+          "type": "string"
+        },
+        "finished": {
+          "description": "Time the reindex completed or failed, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "The kind of the class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "processed": {
+          "description": "The number of objects that have been re-vectorized so far. If an interrupted reindex was resumed, objects of the previous run are not included.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "description": "Time the reindex was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Status of the reindex.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        }
+      }
+    },
+    "ReshardStatus": {
+      "description": "The progress of moving all objects of a class to a new shard configuration.",
+      "type": "object",
+      "properties": {
+        "autoExpandReplicas": {
+          "description": "The auto expand replicas setting of the new shard configuration.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class that is resharded.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The reason the reshard failed, only set if the status is failed.",
+          "type": "string"
+        },
+        "finished": {
+          "description": "Time the reshard completed or failed, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "The kind of the class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "numberOfShards": {
+          "description": "The number of shards of the new shard configuration.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "processed": {
+          "description": "The number of objects that have been copied to the new shards so far. Objects which a resumed reshard skipped because they had already been copied are included.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "description": "Time the reshard was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Status of the reshard.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "total": {
+          "description": "The number of objects to copy.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "RunningQuery": {
+      "description": "A GraphQL query which has not finished yet.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "The class the query targets, if any.",
+          "type": "string"
+        },
+        "durationMs": {
+          "description": "How long the query has been running, in milliseconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The id of the query, used to cancel it.",
+          "type": "string"
+        },
+        "operation": {
+          "description": "The kind of query, e.g. Get, Explore, Aggregate.",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "Time the query was started, in milliseconds since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "username": {
+          "description": "The user who issued the query.",
+          "type": "string"
         }
       }
     },
@@ -7315,10 +13731,71 @@ func init() {
         }
       }
     },
+    "SchemaDocument": {
+      "description": "The complete schema of an instance, used to promote a schema from one environment to another.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "$ref": "#/definitions/Schema"
+        },
+        "things": {
+          "$ref": "#/definitions/Schema"
+        }
+      }
+    },
     "SchemaHistory": {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "SchemaImportConflict": {
+      "description": "A class of the imported schema which already exists with a different definition.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "The kind of the imported class.",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "reason": {
+          "description": "The first difference between the existing and the imported class.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaImportResponse": {
+      "description": "The outcome of a schema import for every imported class.",
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The classes which already exist with a different definition. If there is any conflict, no class is created at all.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaImportConflict"
+          }
+        },
+        "created": {
+          "description": "The classes which did not exist yet and have been created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "unchanged": {
+          "description": "The classes which already existed with an identical definition.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "SemanticPath": {
       "description": "A semantic path between two objects, e.g. a search query and a result",
       "properties": {
@@ -7356,6 +13833,54 @@ func init() {
         }
       }
     },
+    "ShardCompaction": {
+      "description": "Result of the compaction of the storage file of a single shard.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the shard belongs to",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Kind of the class, either thing or action",
+          "type": "string"
+        },
+        "reclaimedBytes": {
+          "description": "Disk space which was released by the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "sizeAfterBytes": {
+          "description": "Size of the storage file after the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sizeBeforeBytes": {
+          "description": "Size of the storage file before the compaction in bytes",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ShardingConfig": {
+      "description": "The shard and replica configuration of a class in the vector index.",
+      "type": "object",
+      "properties": {
+        "autoExpandReplicas": {
+          "description": "The range of replicas per shard, which is expanded automatically depending on the number of nodes, such as \"0-1\" or \"0-all\". Use \"false\" for no replicas.",
+          "type": "string"
+        },
+        "numberOfShards": {
+          "description": "The number of primary shards.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "SingleRef": {
       "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
@@ -7388,6 +13913,32 @@ func init() {
         }
       }
     },
+    "TextNormalization": {
+      "description": "Steps which are applied to the values of the string and text properties of a class before they are vectorized. It can only be set when the class is created. Steps which are not set use the global configuration.",
+      "type": "object",
+      "properties": {
+        "lowercase": {
+          "description": "Convert the text to lowercase. Enabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "removeStopwords": {
+          "description": "Remove the words which the contextionary considers stopwords. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "stripPunctuation": {
+          "description": "Replace punctuation characters with spaces. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "unicodeNFC": {
+          "description": "Normalize the text to the unicode normalization form C, so that identical characters which are composed differently lead to the same words. Disabled by default.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
     "Thing": {
       "type": "object",
       "properties": {
@@ -7420,6 +13971,11 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "expiryTimeUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which this Thing expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
         "id": {
           "description": "ID of the Thing.",
           "type": "string",
@@ -7438,6 +13994,32 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "version": {
+          "description": "Version of this Thing, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Thing has not been changed in the meantime. Ignored in request bodies.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ThingsBatchGetResponse": {
+      "description": "Things retrieved by their UUIDs.",
+      "type": "object",
+      "properties": {
+        "notFound": {
+          "description": "The requested UUIDs for which no Thing exists.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "things": {
+          "description": "The Things which were found, in the order of the requested UUIDs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Thing"
+          }
         }
       }
     },
@@ -7509,6 +14091,16 @@ func init() {
             "$ref": "#/definitions/Deprecation"
           }
         },
+        "limit": {
+          "description": "The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nextCursor": {
+          "description": "Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.",
+          "type": "string",
+          "format": "uuid"
+        },
         "things": {
           "description": "The actual list of Things.",
           "type": "array",
@@ -7517,19 +14109,32 @@ func init() {
           }
         },
         "totalResults": {
-          "description": "The total number of Things for the query. The number of items in a response may be smaller due to paging.",
+          "description": "The total number of Things matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.",
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "x-nullable": true
         }
       }
     },
     "UnderscoreProperties": {
       "description": "Additional Meta information about a single thing/action object.",
       "properties": {
+        "certainty": {
+          "description": "The certainty of the match between the search vector and the result, which is 1 minus the distance. This is the value the certainty argument of a search is compared against. Only on 'explore' searches",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
         "classification": {
           "description": "If this object was subject of a classificiation, additional meta info about this classification is available here",
           "$ref": "#/definitions/UnderscorePropertiesClassification"
         },
+        "distance": {
+          "description": "The normalized distance between the search vector and the result, between 0 (identical vectors) and 1 (perfect opposite). Results are ranked by this distance. Only on 'explore' searches",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
         "featureProjection": {
           "description": "The concepts vector projected into a lower dimensional space (for visualization purposes)",
           "$ref": "#/definitions/FeatureProjection"
@@ -7588,10 +14193,112 @@ func init() {
         }
       }
     },
+    "VectorIndexConfig": {
+      "description": "Parameters of the HNSW vector index of a class. They can only be set when the class is created. Parameters which are not set use the defaults.",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "Size of the dynamic candidate list at query time. Higher values improve the recall at the cost of query speed. It is never smaller than the number of requested results. Must be between 1 and 4096, defaults to eight times the number of requested results. Can be overridden per query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list while building the index. Higher values improve the recall at the cost of import speed. Must be between 4 and 4096, defaults to 128.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per node on every layer, the lowest layer allows twice as many. Higher values improve the recall at the cost of memory and import speed. Must be between 4 and 256, defaults to 60.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorIndexStats": {
+      "description": "Size and build parameters of the vector index of a single shard.",
+      "type": "object",
+      "properties": {
+        "cachedVectors": {
+          "description": "Number of vectors currently held in the in-memory vector cache",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "Name of the class the shard belongs to",
+          "type": "string"
+        },
+        "efConstruction": {
+          "description": "Build parameter: size of the dynamic candidate list during construction",
+          "type": "integer",
+          "format": "int64"
+        },
+        "estimatedMemoryBytes": {
+          "description": "Approximate memory used by the index graph and the vector cache in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kind": {
+          "description": "Kind of the class, either thing or action",
+          "type": "string"
+        },
+        "maximumConnections": {
+          "description": "Build parameter: maximum number of connections per node and layer",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maximumLayer": {
+          "description": "Highest layer of the index graph",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "tombstoneCount": {
+          "description": "Number of vectors which are marked as deleted, but not cleaned up yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorCount": {
+          "description": "Number of vectors in the index, including vectors which are marked as deleted, but not cleaned up yet",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "VectorizerCorpus": {
+      "description": "The text the vectorizer builds from an object to determine its vector position.",
+      "type": "object",
+      "properties": {
+        "corpi": {
+          "description": "The corpi that are vectorized. Corpi of properties with the same vector weight are combined into a single corpus.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WeightedCorpus"
+          }
+        }
+      }
+    },
+    "WeightedCorpus": {
+      "description": "A single corpus as passed to the contextionary.",
+      "type": "object",
+      "properties": {
+        "corpus": {
+          "description": "The concatenated and preprocessed text.",
+          "type": "string"
+        },
+        "weight": {
+          "description": "The weight of this corpus in the final vector.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -7604,7 +14311,7 @@ func init() {
           }
         },
         "operator": {
-          "description": "operator to use",
+          "description": "operator to use. IsNull and IsNotNull match objects without or with a value for the property at the path, they require no value. An empty string, a string of only whitespace and a text without any words count as null.",
           "type": "string",
           "enum": [
             "And",
@@ -7617,7 +14324,9 @@ func init() {
             "GreaterThanEqual",
             "LessThan",
             "LessThanEqual",
-            "WithinGeoRange"
+            "WithinGeoRange",
+            "IsNull",
+            "IsNotNull"
           ],
           "example": "GreaterThanEqual"
         },
@@ -7709,13 +14418,87 @@ func init() {
     }
   },
   "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonChangedOnlyParameterQuery": {
+      "type": "boolean",
+      "description": "Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.",
+      "name": "changedOnly",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Restrict the list to objects of this class. Required when a 'where' filter is set.",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyParameterQuery": {
+      "enum": [
+        "eventual",
+        "strong"
+      ],
+      "type": "string",
+      "description": "Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.",
+      "name": "consistency",
+      "in": "query"
+    },
+    "CommonCountParameterQuery": {
+      "type": "boolean",
+      "description": "Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.",
+      "name": "count",
+      "in": "query"
+    },
+    "CommonDepthParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.",
+      "name": "depth",
+      "in": "query"
+    },
+    "CommonExpandParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.",
+      "name": "expand",
+      "in": "query"
+    },
+    "CommonIdempotencyKeyParameterHeader": {
+      "type": "string",
+      "description": "A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
+    "CommonIfMatchParameterHeader": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "CommonIfNotExistsParameterQuery": {
+      "type": "boolean",
+      "description": "If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.",
+      "name": "ifNotExists",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)",
       "name": "include",
       "in": "query"
     },
+    "CommonIncludeTotalParameterQuery": {
+      "type": "boolean",
+      "description": "Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.",
+      "name": "includeTotal",
+      "in": "query"
+    },
     "CommonLimitParameterQuery": {
+      "minimum": 0,
       "type": "integer",
       "format": "int64",
       "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
@@ -7727,6 +14510,37 @@ func init() {
       "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
       "name": "meta",
       "in": "query"
+    },
+    "CommonTTLParameterHeader": {
+      "type": "integer",
+      "format": "int64",
+      "description": "Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.",
+      "name": "TTL",
+      "in": "header"
+    },
+    "CommonUniqueReferencesParameterQuery": {
+      "type": "boolean",
+      "description": "References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.",
+      "name": "uniqueReferences",
+      "in": "query"
+    },
+    "CommonValidateOnlyParameterQuery": {
+      "type": "boolean",
+      "description": "Only validate the objects in the batch without storing them. The response contains the validation result of each object.",
+      "name": "validateOnly",
+      "in": "query"
+    },
+    "CommonVectorizeParameterQuery": {
+      "type": "boolean",
+      "description": "In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.",
+      "name": "vectorize",
+      "in": "query"
+    },
+    "CommonWhereParameterQuery": {
+      "type": "string",
+      "description": "JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{\"operator\":\"Equal\",\"path\":[\"name\"],\"valueString\":\"foo\"}'. Paths are relative to the class set with the 'class' parameter.",
+      "name": "where",
+      "in": "query"
     }
   },
   "securityDefinitions": {
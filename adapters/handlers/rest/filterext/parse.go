@@ -20,6 +20,28 @@ import (
 
 // Parse Filter from REST construct to entities filter
 func Parse(in *models.WhereFilter) (*filters.LocalFilter, error) {
+	return ParseWithRootClass(in, "Todo") // TODO: do we need to set a root class?
+}
+
+// ParseWithRootClass parses the filter like Parse, but sets the specified
+// root class on all paths, so that the paths can be resolved against the
+// schema
+func ParseWithRootClass(in *models.WhereFilter,
+	rootClass string) (*filters.LocalFilter, error) {
+	filter, err := parseWithRootClass(in, rootClass)
+	if err != nil || filter == nil {
+		return filter, err
+	}
+
+	if err := filters.ValidateClause(filter.Root); err != nil {
+		return nil, fmt.Errorf("invalid where filter: %v", err)
+	}
+
+	return filter, nil
+}
+
+func parseWithRootClass(in *models.WhereFilter,
+	rootClass string) (*filters.LocalFilter, error) {
 	if in == nil {
 		return nil, nil
 	}
@@ -29,15 +51,23 @@ func Parse(in *models.WhereFilter) (*filters.LocalFilter, error) {
 		return nil, err
 	}
 
+	if operator.WithoutValue() {
+		filter, err := parseNullFilter(in, operator, rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("invalid where filter: %v", err)
+		}
+		return filter, nil
+	}
+
 	if operator.OnValue() {
-		filter, err := parseValueFilter(in, operator)
+		filter, err := parseValueFilter(in, operator, rootClass)
 		if err != nil {
 			return nil, fmt.Errorf("invalid where filter: %v", err)
 		}
 		return filter, nil
 	}
 
-	filter, err := parseNestedFilter(in, operator)
+	filter, err := parseNestedFilter(in, operator, rootClass)
 	if err != nil {
 		return nil, fmt.Errorf("invalid where filter: %v", err)
 	}
@@ -45,13 +75,13 @@ func Parse(in *models.WhereFilter) (*filters.LocalFilter, error) {
 }
 
 func parseValueFilter(in *models.WhereFilter,
-	operator filters.Operator) (*filters.LocalFilter, error) {
+	operator filters.Operator, rootClass string) (*filters.LocalFilter, error) {
 	value, err := parseValue(in)
 	if err != nil {
 		return nil, err
 	}
 
-	path, err := parsePath(in.Path)
+	path, err := parsePath(in.Path, rootClass)
 	if err != nil {
 		return nil, err
 	}
@@ -65,8 +95,29 @@ func parseValueFilter(in *models.WhereFilter,
 	}, nil
 }
 
+func parseNullFilter(in *models.WhereFilter,
+	operator filters.Operator, rootClass string) (*filters.LocalFilter, error) {
+	if !allValuesNil(in) {
+		return nil, fmt.Errorf(
+			"operator '%s' not compatible with field 'value<Type>', "+
+				"remove value field", operator.Name())
+	}
+
+	path, err := parsePath(in.Path, rootClass)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: operator,
+			On:       path,
+		},
+	}, nil
+}
+
 func parseNestedFilter(in *models.WhereFilter,
-	operator filters.Operator) (*filters.LocalFilter, error) {
+	operator filters.Operator, rootClass string) (*filters.LocalFilter, error) {
 
 	if in.Path != nil {
 		return nil, fmt.Errorf(
@@ -89,7 +140,7 @@ func parseNestedFilter(in *models.WhereFilter,
 			operator.Name())
 	}
 
-	operands, err := parseOperands(in.Operands)
+	operands, err := parseOperands(in.Operands, rootClass)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +153,11 @@ func parseNestedFilter(in *models.WhereFilter,
 	}, nil
 }
 
-func parseOperands(ops []*models.WhereFilter) ([]filters.Clause, error) {
+func parseOperands(ops []*models.WhereFilter,
+	rootClass string) ([]filters.Clause, error) {
 	out := make([]filters.Clause, len(ops), len(ops))
 	for i, operand := range ops {
-		res, err := Parse(operand)
+		res, err := parseWithRootClass(operand, rootClass)
 		if err != nil {
 			return nil, fmt.Errorf("operand %d: %v", i, err)
 		}
@@ -134,6 +186,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorIsNull:
+		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorIsNotNull:
+		return filters.OperatorIsNotNull, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
@@ -146,7 +202,7 @@ func parseOperator(in string) (filters.Operator, error) {
 	}
 }
 
-func parsePath(in []string) (*filters.Path, error) {
+func parsePath(in []string, rootClass string) (*filters.Path, error) {
 	if in == nil || len(in) == 0 {
 		return nil, fmt.Errorf("field 'path': must have at least one element")
 	}
@@ -156,7 +212,7 @@ func parsePath(in []string) (*filters.Path, error) {
 		asInterface[i] = elem
 	}
 
-	return filters.ParsePath(asInterface, "Todo") // TODO: do we need to set a root class?
+	return filters.ParsePath(asInterface, rootClass)
 }
 
 func allValuesNil(in *models.WhereFilter) bool {
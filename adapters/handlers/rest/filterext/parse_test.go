@@ -14,6 +14,7 @@ package filterext
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -78,7 +79,7 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				name: "valid date filter",
 				input: &models.WhereFilter{
 					Operator:  "Equal",
-					ValueDate: ptString("foo bar"),
+					ValueDate: ptString("2020-06-01T12:00:00+02:00"),
 					Path:      []string{"dateField"},
 				},
 				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
@@ -88,7 +89,7 @@ func Test_ExtractFlatFilters(t *testing.T) {
 						Property: schema.AssertValidPropertyName("dateField"),
 					},
 					Value: &filters.Value{
-						Value: "foo bar",
+						Value: time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
 						Type:  schema.DataTypeDate,
 					},
 				}},
@@ -272,6 +273,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: " +
 					"got operator 'Equal', but no value<Type> field set"),
 			},
+			test{
+				name: "invalid date",
+				input: &models.WhereFilter{
+					Operator:  "Equal",
+					ValueDate: ptString("foo bar"),
+					Path:      []string{"dateField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueDate: " +
+					"'foo bar' is not a valid RFC3339 date, such as 2006-01-02T15:04:05Z07:00"),
+			},
 			test{
 				name: "equal operator and no path set",
 				input: &models.WhereFilter{
@@ -341,6 +352,69 @@ func Test_ExtractFlatFilters(t *testing.T) {
 		}
 	})
 
+	t.Run("null operators", func(t *testing.T) {
+		tests := []test{
+			test{
+				name: "is null",
+				input: &models.WhereFilter{
+					Operator: "IsNull",
+					Path:     []string{"description"},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorIsNull,
+						On: &filters.Path{
+							Class:    schema.AssertValidClassName("Todo"),
+							Property: schema.AssertValidPropertyName("description"),
+						},
+					},
+				},
+			},
+			test{
+				name: "is not null",
+				input: &models.WhereFilter{
+					Operator: "IsNotNull",
+					Path:     []string{"description"},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorIsNotNull,
+						On: &filters.Path{
+							Class:    schema.AssertValidClassName("Todo"),
+							Property: schema.AssertValidPropertyName("description"),
+						},
+					},
+				},
+			},
+			test{
+				name: "is null with a value",
+				input: &models.WhereFilter{
+					Operator:    "IsNull",
+					Path:        []string{"description"},
+					ValueString: ptString("foo"),
+				},
+				expectedErr: fmt.Errorf("invalid where filter: operator 'IsNull' not " +
+					"compatible with field 'value<Type>', remove value field"),
+			},
+			test{
+				name: "is not null without a path",
+				input: &models.WhereFilter{
+					Operator: "IsNotNull",
+				},
+				expectedErr: fmt.Errorf("invalid where filter: field 'path': " +
+					"must have at least one element"),
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				filter, err := Parse(test.input)
+				assert.Equal(t, test.expectedErr, err)
+				assert.Equal(t, test.expectedFilter, filter)
+			})
+		}
+	})
+
 	t.Run("nested filters", func(t *testing.T) {
 		// all tests use int as the value type, value types are tested separately
 		tests := []test{
@@ -440,6 +514,77 @@ func Test_ExtractFlatFilters(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("negated nested filters", func(t *testing.T) {
+		t.Run("not wrapping an and", func(t *testing.T) {
+			input := &models.WhereFilter{
+				Operator: "Not",
+				Operands: []*models.WhereFilter{
+					&models.WhereFilter{
+						Operator: "And",
+						Operands: []*models.WhereFilter{
+							inputIntFilterWithValue(42),
+							inputIntFilterWithValue(43),
+						},
+					},
+				},
+			}
+
+			filter, err := Parse(input)
+			assert.Nil(t, err)
+			assert.Equal(t, filters.OperatorNot, filter.Root.Operator)
+			assert.Len(t, filter.Root.Operands, 1)
+			assert.Equal(t, filters.OperatorAnd, filter.Root.Operands[0].Operator)
+			assert.Len(t, filter.Root.Operands[0].Operands, 2)
+		})
+
+		t.Run("not with more than one operand", func(t *testing.T) {
+			input := &models.WhereFilter{
+				Operator: "Not",
+				Operands: []*models.WhereFilter{
+					inputIntFilterWithValue(42),
+					inputIntFilterWithValue(43),
+				},
+			}
+
+			filter, err := Parse(input)
+			assert.NotNil(t, err)
+			assert.Nil(t, filter)
+		})
+
+		t.Run("malformed not nested in an or", func(t *testing.T) {
+			input := &models.WhereFilter{
+				Operator: "Or",
+				Operands: []*models.WhereFilter{
+					inputIntFilterWithValue(42),
+					&models.WhereFilter{
+						Operator: "Not",
+					},
+				},
+			}
+
+			filter, err := Parse(input)
+			assert.NotNil(t, err)
+			assert.Nil(t, filter)
+		})
+	})
+
+	t.Run("with a root class", func(t *testing.T) {
+		input := &models.WhereFilter{
+			Operator: "And",
+			Operands: []*models.WhereFilter{
+				&models.WhereFilter{
+					Operator:    "Equal",
+					ValueString: ptString("foo"),
+					Path:        []string{"stringField"},
+				},
+			},
+		}
+
+		filter, err := ParseWithRootClass(input, "Car")
+		assert.Nil(t, err)
+		assert.Equal(t, schema.ClassName("Car"), filter.Root.Operands[0].On.Class)
+	})
 }
 
 func ptInt(in int) *int64 {
@@ -83,13 +83,18 @@ var valueExtractors = []valueExtractorFunc{
 
 		return valueFilter(*in.ValueText, schema.DataTypeText), nil
 	},
-	// date (as string)
+	// date
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueDate == nil {
 			return nil, nil
 		}
 
-		return valueFilter(*in.ValueDate, schema.DataTypeDate), nil
+		date, err := filters.ParseDate(*in.ValueDate)
+		if err != nil {
+			return nil, fmt.Errorf("valueDate: %v", err)
+		}
+
+		return valueFilter(date, schema.DataTypeDate), nil
 	},
 	// boolean
 	func(in *models.WhereFilter) (*filters.Value, error) {
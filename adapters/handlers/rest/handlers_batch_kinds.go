@@ -12,28 +12,50 @@
 package rest
 
 import (
+	"context"
+	"time"
+
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
 )
 
 type batchKindHandlers struct {
 	manager *kinds.BatchManager
+	errors  itemErrors
 }
 
 func (h *batchKindHandlers) addThings(params batching.BatchingThingsCreateParams,
 	principal *models.Principal) middleware.Responder {
-	things, err := h.manager.AddThings(params.HTTPRequest.Context(), principal,
-		params.Body.Things, params.Body.Fields)
+	var (
+		things kinds.BatchThings
+		err    error
+	)
+	if params.ValidateOnly != nil && *params.ValidateOnly {
+		vectorize := params.Vectorize != nil && *params.Vectorize
+		things, err = h.manager.ValidateThings(params.HTTPRequest.Context(), principal,
+			params.Body.Things, params.Body.Fields, vectorize)
+	} else {
+		things, err = h.manager.AddThingsWithIdempotencyKey(params.HTTPRequest.Context(), principal,
+			params.Body.Things, params.Body.Fields, idempotencyKey(params.IdempotencyKey))
+	}
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return batching.NewBatchingThingsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return batching.NewBatchingThingsCreateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists:
+			return batching.NewBatchingThingsCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return batching.NewBatchingThingsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -43,16 +65,27 @@ func (h *batchKindHandlers) addThings(params batching.BatchingThingsCreateParams
 		}
 	}
 
-	return batching.NewBatchingThingsCreateOK().
-		WithPayload(h.thingsResponse(things))
+	response, failed := h.thingsResponse(params.HTTPRequest.Context(), things)
+	summary := things.Summary()
+	if failed {
+		return withBatchSummary(
+			batching.NewBatchingThingsCreateMultiStatus().WithPayload(response), summary)
+	}
+
+	return withBatchSummary(batching.NewBatchingThingsCreateOK().WithPayload(response), summary)
 }
 
-func (h *batchKindHandlers) thingsResponse(input kinds.BatchThings) []*models.ThingsGetResponse {
-	response := make([]*models.ThingsGetResponse, len(input), len(input))
+// thingsResponse keeps the order of the request, failed is true if at least
+// one of the things could not be created
+func (h *batchKindHandlers) thingsResponse(ctx context.Context, input kinds.BatchThings) (response []*models.ThingsGetResponse, failed bool) {
+	response = make([]*models.ThingsGetResponse, len(input), len(input))
 	for i, thing := range input {
 		var errorResponse *models.ErrorResponse
+		status := models.ThingsGetResponseAO2ResultStatusSUCCESS
 		if thing.Err != nil {
-			errorResponse = errPayloadFromSingleErr(thing.Err)
+			errorResponse = h.errors.batchPayload(ctx, thing.Err)
+			status = models.ThingsGetResponseAO2ResultStatusFAILED
+			failed = true
 		}
 
 		thing.Thing.ID = thing.UUID
@@ -60,22 +93,40 @@ func (h *batchKindHandlers) thingsResponse(input kinds.BatchThings) []*models.Th
 			Thing: *thing.Thing,
 			Result: &models.ThingsGetResponseAO2Result{
 				Errors: errorResponse,
+				Status: &status,
 			},
 		}
 	}
 
-	return response
+	return response, failed
 }
 
 func (h *batchKindHandlers) addActions(params batching.BatchingActionsCreateParams,
 	principal *models.Principal) middleware.Responder {
-	actions, err := h.manager.AddActions(params.HTTPRequest.Context(), principal,
-		params.Body.Actions, params.Body.Fields)
+	var (
+		actions kinds.BatchActions
+		err     error
+	)
+	if params.ValidateOnly != nil && *params.ValidateOnly {
+		vectorize := params.Vectorize != nil && *params.Vectorize
+		actions, err = h.manager.ValidateActions(params.HTTPRequest.Context(), principal,
+			params.Body.Actions, params.Body.Fields, vectorize)
+	} else {
+		actions, err = h.manager.AddActionsWithIdempotencyKey(params.HTTPRequest.Context(), principal,
+			params.Body.Actions, params.Body.Fields, idempotencyKey(params.IdempotencyKey))
+	}
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return batching.NewBatchingActionsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return batching.NewBatchingActionsCreateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists:
+			return batching.NewBatchingActionsCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return batching.NewBatchingActionsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -85,16 +136,27 @@ func (h *batchKindHandlers) addActions(params batching.BatchingActionsCreatePara
 		}
 	}
 
-	return batching.NewBatchingActionsCreateOK().
-		WithPayload(h.actionsResponse(actions))
+	response, failed := h.actionsResponse(params.HTTPRequest.Context(), actions)
+	summary := actions.Summary()
+	if failed {
+		return withBatchSummary(
+			batching.NewBatchingActionsCreateMultiStatus().WithPayload(response), summary)
+	}
+
+	return withBatchSummary(batching.NewBatchingActionsCreateOK().WithPayload(response), summary)
 }
 
-func (h *batchKindHandlers) actionsResponse(input kinds.BatchActions) []*models.ActionsGetResponse {
-	response := make([]*models.ActionsGetResponse, len(input), len(input))
+// actionsResponse keeps the order of the request, failed is true if at least
+// one of the actions could not be created
+func (h *batchKindHandlers) actionsResponse(ctx context.Context, input kinds.BatchActions) (response []*models.ActionsGetResponse, failed bool) {
+	response = make([]*models.ActionsGetResponse, len(input), len(input))
 	for i, action := range input {
 		var errorResponse *models.ErrorResponse
+		status := models.ActionsGetResponseAO2ResultStatusSUCCESS
 		if action.Err != nil {
-			errorResponse = errPayloadFromSingleErr(action.Err)
+			errorResponse = h.errors.batchPayload(ctx, action.Err)
+			status = models.ActionsGetResponseAO2ResultStatusFAILED
+			failed = true
 		}
 
 		action.Action.ID = action.UUID
@@ -102,19 +164,29 @@ func (h *batchKindHandlers) actionsResponse(input kinds.BatchActions) []*models.
 			Action: *action.Action,
 			Result: &models.ActionsGetResponseAO2Result{
 				Errors: errorResponse,
+				Status: &status,
 			},
 		}
 	}
 
-	return response
+	return response, failed
 }
 
 func (h *batchKindHandlers) addReferences(params batching.BatchingReferencesCreateParams,
 	principal *models.Principal) middleware.Responder {
-	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body)
+	var (
+		references kinds.BatchReferences
+		err        error
+	)
+	if params.ValidateOnly != nil && *params.ValidateOnly {
+		references, err = h.manager.ValidateReferences(params.HTTPRequest.Context(), principal,
+			params.Body)
+	} else {
+		references, err = h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body)
+	}
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return batching.NewBatchingReferencesCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
@@ -126,20 +198,27 @@ func (h *batchKindHandlers) addReferences(params batching.BatchingReferencesCrea
 		}
 	}
 
-	return batching.NewBatchingReferencesCreateOK().
-		WithPayload(h.referencesResponse(references))
+	response, failed := h.referencesResponse(params.HTTPRequest.Context(), references)
+	if failed {
+		return batching.NewBatchingReferencesCreateMultiStatus().WithPayload(response)
+	}
+
+	return batching.NewBatchingReferencesCreateOK().WithPayload(response)
 }
 
-func (h *batchKindHandlers) referencesResponse(input kinds.BatchReferences) []*models.BatchReferenceResponse {
-	response := make([]*models.BatchReferenceResponse, len(input), len(input))
+// referencesResponse keeps the order of the request, failed is true if at
+// least one of the references could not be created
+func (h *batchKindHandlers) referencesResponse(ctx context.Context, input kinds.BatchReferences) (response []*models.BatchReferenceResponse, failed bool) {
+	response = make([]*models.BatchReferenceResponse, len(input), len(input))
 	for i, ref := range input {
 		var errorResponse *models.ErrorResponse
 		var reference models.BatchReference
 
 		status := models.BatchReferenceResponseAO1ResultStatusSUCCESS
 		if ref.Err != nil {
-			errorResponse = errPayloadFromSingleErr(ref.Err)
+			errorResponse = h.errors.batchPayload(ctx, ref.Err)
 			status = models.BatchReferenceResponseAO1ResultStatusFAILED
+			failed = true
 		} else {
 			reference.From = strfmt.URI(ref.From.String())
 			reference.To = strfmt.URI(ref.To.String())
@@ -154,16 +233,74 @@ func (h *batchKindHandlers) referencesResponse(input kinds.BatchReferences) []*m
 		}
 	}
 
-	return response
+	return response, failed
+}
+
+// batchSummaryResponder is implemented by every successful batch create
+// response, they all carry the summary of the batch in their headers
+type batchSummaryResponder interface {
+	middleware.Responder
+	SetXBatchTotal(int64)
+	SetXBatchSucceeded(int64)
+	SetXBatchFailed(int64)
+	SetXBatchFailedValidation(int64)
+	SetXBatchFailedConflict(int64)
+	SetXBatchFailedInternal(int64)
+}
+
+func withBatchSummary(res batchSummaryResponder,
+	summary kinds.BatchSummary) middleware.Responder {
+	res.SetXBatchTotal(int64(summary.Total))
+	res.SetXBatchSucceeded(int64(summary.Succeeded))
+	res.SetXBatchFailed(int64(summary.Failed))
+	res.SetXBatchFailedValidation(int64(summary.FailedValidation))
+	res.SetXBatchFailedConflict(int64(summary.FailedConflict))
+	res.SetXBatchFailedInternal(int64(summary.FailedInternal))
+	return res
+}
+
+func idempotencyKey(key *string) string {
+	if key == nil {
+		return ""
+	}
+
+	return *key
 }
 
-func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *kinds.BatchManager) {
-	h := &batchKindHandlers{manager}
+func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *kinds.BatchManager,
+	config config.Batch, itemErrs itemErrors) {
+	h := &batchKindHandlers{manager, itemErrs}
+	limiter := newBatchLimiter(*config.MaxConcurrentRequests,
+		time.Duration(*config.QueueTimeoutSeconds)*time.Second)
 
 	api.BatchingBatchingThingsCreateHandler = batching.
-		BatchingThingsCreateHandlerFunc(h.addThings)
+		BatchingThingsCreateHandlerFunc(func(params batching.BatchingThingsCreateParams,
+			principal *models.Principal) middleware.Responder {
+			return limiter.handle(params.HTTPRequest.Context(),
+				func(retryAfter int64, payload *models.ErrorResponse) middleware.Responder {
+					return batching.NewBatchingThingsCreateTooManyRequests().
+						WithRetryAfter(retryAfter).WithPayload(payload)
+				},
+				func() middleware.Responder { return h.addThings(params, principal) })
+		})
 	api.BatchingBatchingActionsCreateHandler = batching.
-		BatchingActionsCreateHandlerFunc(h.addActions)
+		BatchingActionsCreateHandlerFunc(func(params batching.BatchingActionsCreateParams,
+			principal *models.Principal) middleware.Responder {
+			return limiter.handle(params.HTTPRequest.Context(),
+				func(retryAfter int64, payload *models.ErrorResponse) middleware.Responder {
+					return batching.NewBatchingActionsCreateTooManyRequests().
+						WithRetryAfter(retryAfter).WithPayload(payload)
+				},
+				func() middleware.Responder { return h.addActions(params, principal) })
+		})
 	api.BatchingBatchingReferencesCreateHandler = batching.
-		BatchingReferencesCreateHandlerFunc(h.addReferences)
+		BatchingReferencesCreateHandlerFunc(func(params batching.BatchingReferencesCreateParams,
+			principal *models.Principal) middleware.Responder {
+			return limiter.handle(params.HTTPRequest.Context(),
+				func(retryAfter int64, payload *models.ErrorResponse) middleware.Responder {
+					return batching.NewBatchingReferencesCreateTooManyRequests().
+						WithRetryAfter(retryAfter).WithPayload(payload)
+				},
+				func() middleware.Responder { return h.addReferences(params, principal) })
+		})
 }
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchThingsResponse(t *testing.T) {
+	h := &batchKindHandlers{}
+
+	t.Run("when all things succeeded", func(t *testing.T) {
+		response, failed := h.thingsResponse(context.Background(), kinds.BatchThings{
+			{OriginalIndex: 0, Thing: &models.Thing{Class: "Foo"}, UUID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+		})
+
+		assert.False(t, failed)
+		require.Len(t, response, 1)
+		assert.Equal(t, models.ThingsGetResponseAO2ResultStatusSUCCESS, *response[0].Result.Status)
+		assert.Nil(t, response[0].Result.Errors)
+	})
+
+	t.Run("when one of the things failed", func(t *testing.T) {
+		response, failed := h.thingsResponse(context.Background(), kinds.BatchThings{
+			{OriginalIndex: 0, Thing: &models.Thing{Class: "Foo"}, UUID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+			{OriginalIndex: 1, Thing: &models.Thing{Class: "Foo"}, Err: errors.New("oops")},
+		})
+
+		assert.True(t, failed)
+		require.Len(t, response, 2)
+		assert.Equal(t, models.ThingsGetResponseAO2ResultStatusSUCCESS, *response[0].Result.Status)
+		assert.Equal(t, models.ThingsGetResponseAO2ResultStatusFAILED, *response[1].Result.Status)
+		assert.Equal(t, "oops", response[1].Result.Errors.Error[0].Message)
+	})
+
+	t.Run("when error details are hidden", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		h := &batchKindHandlers{errors: newItemErrors(logger, true)}
+		response, _ := h.thingsResponse(context.Background(), kinds.BatchThings{
+			{OriginalIndex: 0, Thing: &models.Thing{Class: "Foo"},
+				Err: kinds.NewErrInvalidUserInput("invalid thing")},
+			{OriginalIndex: 1, Thing: &models.Thing{Class: "Foo"},
+				Err: errors.New("open /var/lib/weaviate/main.db: permission denied")},
+		})
+
+		require.Len(t, response, 2)
+		assert.Equal(t, "invalid thing", response[0].Result.Errors.Error[0].Message)
+		assert.NotContains(t, response[1].Result.Errors.Error[0].Message, "permission denied")
+		require.NotNil(t, hook.LastEntry())
+		id := hook.LastEntry().Data["correlation_id"]
+		assert.Equal(t, "internal server error, correlation id: "+id.(string),
+			response[1].Result.Errors.Error[0].Message)
+	})
+}
+
+func TestBatchActionsResponse(t *testing.T) {
+	h := &batchKindHandlers{}
+
+	response, failed := h.actionsResponse(context.Background(), kinds.BatchActions{
+		{OriginalIndex: 0, Action: &models.Action{Class: "Foo"}, Err: errors.New("oops")},
+		{OriginalIndex: 1, Action: &models.Action{Class: "Foo"}, UUID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+	})
+
+	assert.True(t, failed)
+	require.Len(t, response, 2)
+	assert.Equal(t, models.ActionsGetResponseAO2ResultStatusFAILED, *response[0].Result.Status)
+	assert.Equal(t, models.ActionsGetResponseAO2ResultStatusSUCCESS, *response[1].Result.Status)
+}
+
+func TestBatchSummaryHeaders(t *testing.T) {
+	summary := kinds.BatchSummary{Total: 6, Succeeded: 3, Failed: 3,
+		FailedValidation: 1, FailedConflict: 1, FailedInternal: 1}
+
+	res := withBatchSummary(batching.NewBatchingActionsCreateMultiStatus(), summary)
+
+	parsed, ok := res.(*batching.BatchingActionsCreateMultiStatus)
+	require.True(t, ok)
+	assert.Equal(t, int64(6), parsed.XBatchTotal)
+	assert.Equal(t, int64(3), parsed.XBatchSucceeded)
+	assert.Equal(t, int64(3), parsed.XBatchFailed)
+	assert.Equal(t, int64(1), parsed.XBatchFailedValidation)
+	assert.Equal(t, int64(1), parsed.XBatchFailedConflict)
+	assert.Equal(t, int64(1), parsed.XBatchFailedInternal)
+}
+
+func TestBatchReferencesResponse(t *testing.T) {
+	h := &batchKindHandlers{}
+	from := crossref.NewSource(kind.Thing, "Foo", "hasBar", "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	to := crossref.New("localhost", "4b8f3b5c-8e5a-4e59-a6b5-8a8a9c5e3f64", kind.Thing)
+
+	t.Run("when all references succeeded", func(t *testing.T) {
+		_, failed := h.referencesResponse(context.Background(), kinds.BatchReferences{{From: from, To: to}})
+
+		assert.False(t, failed)
+	})
+
+	t.Run("when one of the references failed", func(t *testing.T) {
+		response, failed := h.referencesResponse(context.Background(), kinds.BatchReferences{
+			{From: from, To: to},
+			{OriginalIndex: 1, Err: errors.New("oops")},
+		})
+
+		assert.True(t, failed)
+		require.Len(t, response, 2)
+		assert.Equal(t, models.BatchReferenceResponseAO1ResultStatusSUCCESS, *response[0].Result.Status)
+		assert.Equal(t, models.BatchReferenceResponseAO1ResultStatusFAILED, *response[1].Result.Status)
+	})
+}
@@ -16,6 +16,7 @@ import (
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/semi-technologies/weaviate/entities/models"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/contextionary_api"
@@ -23,6 +24,7 @@ import (
 
 type inspector interface {
 	GetWords(ctx context.Context, words string) (*models.C11yWordsResponse, error)
+	InspectWord(ctx context.Context, word string, limit *int) (*models.C11yWordInspection, error)
 }
 
 type c11yProxy interface {
@@ -54,6 +56,31 @@ func setupC11yHandlers(api *operations.WeaviateAPI, inspector inspector, proxy c
 			return contextionary_api.NewC11yConceptsOK().WithPayload(res)
 		})
 
+	api.ContextionaryAPIC11yInspectHandler = contextionary_api.C11yInspectHandlerFunc(
+		func(params contextionary_api.C11yInspectParams, principal *models.Principal) middleware.Responder {
+			ctx := params.HTTPRequest.Context()
+
+			var limit *int
+			if params.Limit != nil {
+				l := int(*params.Limit)
+				limit = &l
+			}
+
+			res, err := inspector.InspectWord(ctx, params.Word, limit)
+			if err != nil {
+				switch err.(type) {
+				case libvectorizer.ErrWordNotPresent:
+					return contextionary_api.NewC11yInspectNotFound().WithPayload(errPayloadFromSingleErr(err))
+				case libvectorizer.ErrInvalidInspection:
+					return contextionary_api.NewC11yInspectUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return contextionary_api.NewC11yInspectInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return contextionary_api.NewC11yInspectOK().WithPayload(res)
+		})
+
 	api.ContextionaryAPIC11yExtensionsHandler = contextionary_api.C11yExtensionsHandlerFunc(func(params contextionary_api.C11yExtensionsParams, principal *models.Principal) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 
@@ -12,11 +12,15 @@
 package rest
 
 import (
+	"fmt"
+	"time"
+
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 )
 
@@ -26,7 +30,20 @@ func setupClassificationHandlers(api *operations.WeaviateAPI,
 	api.ClassificationsClassificationsGetHandler = classifications.ClassificationsGetHandlerFunc(
 		func(params classifications.ClassificationsGetParams, principal *models.Principal) middleware.Responder {
 
-			res, err := classifier.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			var res *models.Classification
+			var err error
+			if params.WaitTimeoutSeconds != nil {
+				if *params.WaitTimeoutSeconds < 0 {
+					return classifications.NewClassificationsGetUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+							"waitTimeoutSeconds must not be negative, got %d", *params.WaitTimeoutSeconds)))
+				}
+
+				res, err = classifier.WaitForCompletion(params.HTTPRequest.Context(), principal,
+					strfmt.UUID(params.ID), time.Duration(*params.WaitTimeoutSeconds)*time.Second)
+			} else {
+				res, err = classifier.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			}
 			if err != nil {
 				return classifications.NewClassificationsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
 			}
@@ -50,4 +67,23 @@ func setupClassificationHandlers(api *operations.WeaviateAPI,
 			return classifications.NewClassificationsPostCreated().WithPayload(res)
 		},
 	)
+	api.ClassificationsClassificationsPreviewHandler = classifications.ClassificationsPreviewHandlerFunc(
+		func(params classifications.ClassificationsPreviewParams, principal *models.Principal) middleware.Responder {
+			signalReadOnly(params.HTTPRequest.Context())
+
+			res, err := classifier.Preview(params.HTTPRequest.Context(), principal, *params.Params)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return classifications.NewClassificationsPreviewForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case classification.ErrInvalidPreview:
+					return classifications.NewClassificationsPreviewBadRequest().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return classifications.NewClassificationsPreviewInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return classifications.NewClassificationsPreviewOK().WithPayload(res)
+		},
+	)
 }
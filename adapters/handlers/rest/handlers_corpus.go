@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+type corpusObjectGetter interface {
+	GetThing(ctx context.Context, principal *models.Principal, id strfmt.UUID,
+		underscore traverser.UnderscoreProperties, expand kinds.ExpandParams) (*models.Thing, error)
+	GetAction(ctx context.Context, principal *models.Principal, id strfmt.UUID,
+		underscore traverser.UnderscoreProperties, expand kinds.ExpandParams) (*models.Action, error)
+}
+
+type corpusBuilder interface {
+	ThingCorpus(ctx context.Context, object *models.Thing) ([]libvectorizer.WeightedCorpus, error)
+	ActionCorpus(ctx context.Context, object *models.Action) ([]libvectorizer.WeightedCorpus, error)
+}
+
+type corpusHandlers struct {
+	getter  corpusObjectGetter
+	builder corpusBuilder
+
+	// the corpus contains the full contents of the object, so it is only
+	// exposed in debug mode
+	enabled bool
+}
+
+var errCorpusDisabled = fmt.Errorf("the vectorizer corpus is only available " +
+	"if debug mode is enabled")
+
+func (h *corpusHandlers) thingsCorpus(params things.ThingsCorpusParams,
+	principal *models.Principal) middleware.Responder {
+	if !h.enabled {
+		return things.NewThingsCorpusForbidden().
+			WithPayload(errPayloadFromSingleErr(errCorpusDisabled))
+	}
+
+	thing, err := h.getter.GetThing(params.HTTPRequest.Context(), principal, params.ID,
+		traverser.UnderscoreProperties{}, kinds.ExpandParams{})
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return things.NewThingsCorpusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return things.NewThingsCorpusNotFound()
+		default:
+			return things.NewThingsCorpusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	corpus, err := h.builder.ThingCorpus(params.HTTPRequest.Context(), thing)
+	if err != nil {
+		return things.NewThingsCorpusInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return things.NewThingsCorpusOK().WithPayload(corpusPayload(corpus))
+}
+
+func (h *corpusHandlers) actionsCorpus(params actions.ActionsCorpusParams,
+	principal *models.Principal) middleware.Responder {
+	if !h.enabled {
+		return actions.NewActionsCorpusForbidden().
+			WithPayload(errPayloadFromSingleErr(errCorpusDisabled))
+	}
+
+	action, err := h.getter.GetAction(params.HTTPRequest.Context(), principal, params.ID,
+		traverser.UnderscoreProperties{}, kinds.ExpandParams{})
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return actions.NewActionsCorpusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return actions.NewActionsCorpusNotFound()
+		default:
+			return actions.NewActionsCorpusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	corpus, err := h.builder.ActionCorpus(params.HTTPRequest.Context(), action)
+	if err != nil {
+		return actions.NewActionsCorpusInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return actions.NewActionsCorpusOK().WithPayload(corpusPayload(corpus))
+}
+
+func corpusPayload(in []libvectorizer.WeightedCorpus) *models.VectorizerCorpus {
+	corpi := make([]*models.WeightedCorpus, len(in))
+	for i, corpus := range in {
+		corpi[i] = &models.WeightedCorpus{
+			Corpus: corpus.Corpus,
+			Weight: corpus.Weight,
+		}
+	}
+
+	return &models.VectorizerCorpus{Corpi: corpi}
+}
+
+func setupCorpusHandlers(api *operations.WeaviateAPI, getter corpusObjectGetter,
+	builder corpusBuilder, enabled bool) {
+	h := &corpusHandlers{getter: getter, builder: builder, enabled: enabled}
+
+	api.ThingsThingsCorpusHandler = things.ThingsCorpusHandlerFunc(h.thingsCorpus)
+	api.ActionsActionsCorpusHandler = actions.ActionsCorpusHandlerFunc(h.actionsCorpus)
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCorpusObjectGetter struct {
+	thing  *models.Thing
+	action *models.Action
+	err    error
+}
+
+func (f *fakeCorpusObjectGetter) GetThing(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand kinds.ExpandParams) (*models.Thing, error) {
+	return f.thing, f.err
+}
+
+func (f *fakeCorpusObjectGetter) GetAction(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand kinds.ExpandParams) (*models.Action, error) {
+	return f.action, f.err
+}
+
+type fakeCorpusBuilder struct{}
+
+func (f *fakeCorpusBuilder) ThingCorpus(ctx context.Context,
+	object *models.Thing) ([]libvectorizer.WeightedCorpus, error) {
+	return []libvectorizer.WeightedCorpus{{Corpus: "thing " + object.Class, Weight: 1}}, nil
+}
+
+func (f *fakeCorpusBuilder) ActionCorpus(ctx context.Context,
+	object *models.Action) ([]libvectorizer.WeightedCorpus, error) {
+	return []libvectorizer.WeightedCorpus{{Corpus: "action " + object.Class, Weight: 2}}, nil
+}
+
+func TestCorpus(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc/corpus", nil)
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	t.Run("with debug mode disabled", func(t *testing.T) {
+		h := &corpusHandlers{
+			getter:  &fakeCorpusObjectGetter{thing: &models.Thing{Class: "Car"}},
+			builder: &fakeCorpusBuilder{},
+		}
+
+		res := h.thingsCorpus(things.ThingsCorpusParams{HTTPRequest: req, ID: id}, nil)
+		_, ok := res.(*things.ThingsCorpusForbidden)
+		assert.True(t, ok)
+	})
+
+	t.Run("with a thing", func(t *testing.T) {
+		h := &corpusHandlers{
+			getter:  &fakeCorpusObjectGetter{thing: &models.Thing{Class: "Car"}},
+			builder: &fakeCorpusBuilder{},
+			enabled: true,
+		}
+
+		res := h.thingsCorpus(things.ThingsCorpusParams{HTTPRequest: req, ID: id}, nil)
+		parsed, ok := res.(*things.ThingsCorpusOK)
+		require.True(t, ok)
+		assert.Equal(t, &models.VectorizerCorpus{
+			Corpi: []*models.WeightedCorpus{{Corpus: "thing Car", Weight: 1}},
+		}, parsed.Payload)
+	})
+
+	t.Run("with an action", func(t *testing.T) {
+		h := &corpusHandlers{
+			getter:  &fakeCorpusObjectGetter{action: &models.Action{Class: "Drive"}},
+			builder: &fakeCorpusBuilder{},
+			enabled: true,
+		}
+
+		res := h.actionsCorpus(actions.ActionsCorpusParams{HTTPRequest: req, ID: id}, nil)
+		parsed, ok := res.(*actions.ActionsCorpusOK)
+		require.True(t, ok)
+		assert.Equal(t, &models.VectorizerCorpus{
+			Corpi: []*models.WeightedCorpus{{Corpus: "action Drive", Weight: 2}},
+		}, parsed.Payload)
+	})
+
+	t.Run("with a non-existing thing", func(t *testing.T) {
+		h := &corpusHandlers{
+			getter:  &fakeCorpusObjectGetter{err: kinds.NewErrNotFound("not found")},
+			builder: &fakeCorpusBuilder{},
+			enabled: true,
+		}
+
+		res := h.thingsCorpus(things.ThingsCorpusParams{HTTPRequest: req, ID: id}, nil)
+		_, ok := res.(*things.ThingsCorpusNotFound)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+type facetProvider interface {
+	Facet(ctx context.Context, principal *models.Principal,
+		params traverser.FacetParams) (*aggregation.Facet, error)
+}
+
+type facetHandlers struct {
+	provider facetProvider
+}
+
+func (h *facetHandlers) thingsFacets(params things.ThingsFacetsParams,
+	principal *models.Principal) middleware.Responder {
+	facetParams, err := facetParamsFromRequest(kind.Thing, params.ClassName,
+		params.PropertyName, params.Limit, params.Where)
+	if err != nil {
+		return things.NewThingsFacetsUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	facet, err := h.provider.Facet(params.HTTPRequest.Context(), principal, facetParams)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return things.NewThingsFacetsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case traverser.ErrNotFound:
+			return things.NewThingsFacetsNotFound()
+		case traverser.ErrInvalidUserInput:
+			return things.NewThingsFacetsUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return things.NewThingsFacetsInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return things.NewThingsFacetsOK().WithPayload(facetPayload(facet))
+}
+
+func (h *facetHandlers) actionsFacets(params actions.ActionsFacetsParams,
+	principal *models.Principal) middleware.Responder {
+	facetParams, err := facetParamsFromRequest(kind.Action, params.ClassName,
+		params.PropertyName, params.Limit, params.Where)
+	if err != nil {
+		return actions.NewActionsFacetsUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	facet, err := h.provider.Facet(params.HTTPRequest.Context(), principal, facetParams)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return actions.NewActionsFacetsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case traverser.ErrNotFound:
+			return actions.NewActionsFacetsNotFound()
+		case traverser.ErrInvalidUserInput:
+			return actions.NewActionsFacetsUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return actions.NewActionsFacetsInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return actions.NewActionsFacetsOK().WithPayload(facetPayload(facet))
+}
+
+func facetParamsFromRequest(k kind.Kind, className, propertyName string,
+	limit *int64, where *string) (traverser.FacetParams, error) {
+	filter, err := parseWhereParam(&className, where)
+	if err != nil {
+		return traverser.FacetParams{}, err
+	}
+
+	params := traverser.FacetParams{
+		Kind:      k,
+		ClassName: schema.ClassName(className),
+		Property:  schema.PropertyName(propertyName),
+		Filters:   filter,
+	}
+
+	if limit != nil {
+		params.Limit = int(*limit)
+	}
+
+	return params, nil
+}
+
+func facetPayload(facet *aggregation.Facet) *models.PropertyFacets {
+	values := make([]*models.PropertyFacetValue, len(facet.Values))
+	for i, v := range facet.Values {
+		values[i] = &models.PropertyFacetValue{
+			Value: v.Value,
+			Count: int64(v.Count),
+		}
+	}
+
+	return &models.PropertyFacets{
+		Values:      values,
+		Cardinality: int64(facet.Cardinality),
+		OtherCount:  int64(facet.OtherCount),
+	}
+}
+
+func setupFacetHandlers(api *operations.WeaviateAPI, provider facetProvider) {
+	h := &facetHandlers{provider}
+
+	api.ThingsThingsFacetsHandler = things.ThingsFacetsHandlerFunc(h.thingsFacets)
+	api.ActionsActionsFacetsHandler = actions.ActionsFacetsHandlerFunc(h.actionsFacets)
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFacetProvider struct {
+	params traverser.FacetParams
+	facet  *aggregation.Facet
+	err    error
+}
+
+func (f *fakeFacetProvider) Facet(ctx context.Context, principal *models.Principal,
+	params traverser.FacetParams) (*aggregation.Facet, error) {
+	f.params = params
+	return f.facet, f.err
+}
+
+func TestFacets(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things/facets/Car/brand", nil)
+	ptString := func(in string) *string { return &in }
+	ptInt64 := func(in int64) *int64 { return &in }
+
+	t.Run("with a limit and a where filter", func(t *testing.T) {
+		provider := &fakeFacetProvider{facet: &aggregation.Facet{
+			Values: []aggregation.FacetValue{
+				{Value: "Audi", Count: 4},
+				{Value: "Volkswagen", Count: 2},
+			},
+			Cardinality: 3,
+			OtherCount:  1,
+		}}
+		h := &facetHandlers{provider}
+
+		res := h.thingsFacets(things.ThingsFacetsParams{
+			HTTPRequest:  req,
+			ClassName:    "Car",
+			PropertyName: "brand",
+			Limit:        ptInt64(2),
+			Where:        ptString(`{"operator":"GreaterThan","path":["horsepower"],"valueInt":100}`),
+		}, nil)
+
+		parsed, ok := res.(*things.ThingsFacetsOK)
+		require.True(t, ok)
+		assert.Equal(t, &models.PropertyFacets{
+			Values: []*models.PropertyFacetValue{
+				{Value: "Audi", Count: 4},
+				{Value: "Volkswagen", Count: 2},
+			},
+			Cardinality: 3,
+			OtherCount:  1,
+		}, parsed.Payload)
+
+		assert.Equal(t, kind.Thing, provider.params.Kind)
+		assert.Equal(t, 2, provider.params.Limit)
+		require.NotNil(t, provider.params.Filters)
+		assert.Equal(t, filters.OperatorGreaterThan, provider.params.Filters.Root.Operator)
+		assert.Equal(t, "Car", provider.params.Filters.Root.On.Class.String())
+	})
+
+	t.Run("with an invalid where filter", func(t *testing.T) {
+		h := &facetHandlers{&fakeFacetProvider{}}
+
+		res := h.actionsFacets(actions.ActionsFacetsParams{
+			HTTPRequest:  req,
+			ClassName:    "Drive",
+			PropertyName: "distance",
+			Where:        ptString(`{"operator":"Not"}`),
+		}, nil)
+
+		_, ok := res.(*actions.ActionsFacetsUnprocessableEntity)
+		assert.True(t, ok)
+	})
+
+	t.Run("on a property that does not exist", func(t *testing.T) {
+		h := &facetHandlers{&fakeFacetProvider{
+			err: traverser.NewErrNotFound("property 'color' does not exist"),
+		}}
+
+		res := h.thingsFacets(things.ThingsFacetsParams{
+			HTTPRequest:  req,
+			ClassName:    "Car",
+			PropertyName: "color",
+		}, nil)
+
+		_, ok := res.(*things.ThingsFacetsNotFound)
+		assert.True(t, ok)
+	})
+
+	t.Run("on a property that cannot be faceted", func(t *testing.T) {
+		h := &facetHandlers{&fakeFacetProvider{
+			err: traverser.NewErrInvalidUserInput("property 'ofBrand' is a reference"),
+		}}
+
+		res := h.actionsFacets(actions.ActionsFacetsParams{
+			HTTPRequest:  req,
+			ClassName:    "Drive",
+			PropertyName: "ofBrand",
+		}, nil)
+
+		_, ok := res.(*actions.ActionsFacetsUnprocessableEntity)
+		assert.True(t, ok)
+	})
+}
@@ -36,8 +36,10 @@ type graphQLProvider interface {
 	GetGraphQL() libgraphql.GraphQL
 }
 
-func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider) {
+func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider,
+	itemErrs itemErrors) {
 	api.GraphqlGraphqlPostHandler = graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
+		signalReadOnly(params.HTTPRequest.Context())
 		errorResponse := &models.ErrorResponse{}
 
 		// Get all input from the body of the request, as it is a POST.
@@ -74,6 +76,7 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
+		itemErrs.graphQL(ctx, result.Errors)
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -103,6 +106,7 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 	})
 
 	api.GraphqlGraphqlBatchHandler = graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
+		signalReadOnly(params.HTTPRequest.Context())
 		amountOfBatchedRequests := len(params.Body)
 		errorResponse := &models.ErrorResponse{}
 
@@ -126,7 +130,7 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 		// Generate a goroutine for each separate request
 		for requestIndex, unbatchedRequest := range params.Body {
 			wg.Add(1)
-			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, unbatchedRequest, requestIndex, &requestResults)
+			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, itemErrs, unbatchedRequest, requestIndex, &requestResults)
 		}
 
 		wg.Wait()
@@ -145,7 +149,7 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 }
 
 // Handle a single unbatched GraphQL request, return a tuple containing the index of the request in the batch and either the response or an error
-func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse) {
+func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, itemErrs itemErrors, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse) {
 	defer wg.Done()
 
 	// Get all input from the body of the request
@@ -174,6 +178,7 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 		}
 
 		result := graphQL.Resolve(ctx, query, operationName, variables)
+		itemErrs.graphQL(ctx, result.Errors)
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -13,15 +13,24 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
 	"github.com/semi-technologies/weaviate/deprecations"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
@@ -45,37 +54,89 @@ type requestLog interface {
 type kindsManager interface {
 	AddThing(context.Context, *models.Principal, *models.Thing) (*models.Thing, error)
 	AddAction(context.Context, *models.Principal, *models.Action) (*models.Action, error)
+	AwaitThing(context.Context, *models.Principal, strfmt.UUID) error
+	AwaitAction(context.Context, *models.Principal, strfmt.UUID) error
 	ValidateThing(context.Context, *models.Principal, *models.Thing) error
 	ValidateAction(context.Context, *models.Principal, *models.Action) error
-	GetThing(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties) (*models.Thing, error)
-	GetAction(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties) (*models.Action, error)
-	GetThings(context.Context, *models.Principal, *int64, traverser.UnderscoreProperties) ([]*models.Thing, error)
-	GetActions(context.Context, *models.Principal, *int64, traverser.UnderscoreProperties) ([]*models.Action, error)
+	GetThing(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties, kinds.ExpandParams) (*models.Thing, error)
+	GetAction(context.Context, *models.Principal, strfmt.UUID, traverser.UnderscoreProperties, kinds.ExpandParams) (*models.Action, error)
+	GetThings(context.Context, *models.Principal, *int64, string, *filters.LocalFilter, traverser.UnderscoreProperties) ([]*models.Thing, error)
+	GetActions(context.Context, *models.Principal, *int64, string, *filters.LocalFilter, traverser.UnderscoreProperties) ([]*models.Action, error)
+	CountThings(context.Context, *models.Principal, string, *filters.LocalFilter) (int64, error)
+	CountActions(context.Context, *models.Principal, string, *filters.LocalFilter) (int64, error)
+	GetThingsAfter(context.Context, *models.Principal, *int64, string, strfmt.UUID) ([]*models.Thing, strfmt.UUID, error)
+	GetActionsAfter(context.Context, *models.Principal, *int64, string, strfmt.UUID) ([]*models.Action, strfmt.UUID, error)
+	GetThingsByIDs(context.Context, *models.Principal, []strfmt.UUID, traverser.UnderscoreProperties, kinds.BatchExpandParams) ([]*models.Thing, []strfmt.UUID, error)
+	GetActionsByIDs(context.Context, *models.Principal, []strfmt.UUID, traverser.UnderscoreProperties, kinds.BatchExpandParams) ([]*models.Action, []strfmt.UUID, error)
+	ResolveBeacons(context.Context, *models.Principal, []strfmt.URI, traverser.UnderscoreProperties) ([]kinds.ResolvedBeacon, error)
+	GetThingReferences(context.Context, *models.Principal, strfmt.UUID, bool) ([]kinds.PropertyReferences, error)
+	GetActionReferences(context.Context, *models.Principal, strfmt.UUID, bool) ([]kinds.PropertyReferences, error)
+	StreamThings(context.Context, *models.Principal, *int64, string, *filters.LocalFilter, traverser.UnderscoreProperties, func(*models.Thing) error) error
+	StreamActions(context.Context, *models.Principal, *int64, string, *filters.LocalFilter, traverser.UnderscoreProperties, func(*models.Action) error) error
 	UpdateThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) (*models.Thing, error)
 	UpdateAction(context.Context, *models.Principal, strfmt.UUID, *models.Action) (*models.Action, error)
-	MergeThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) error
-	MergeAction(context.Context, *models.Principal, strfmt.UUID, *models.Action) error
+	UpdateThingChangedFields(context.Context, *models.Principal, strfmt.UUID, *models.Thing) (*models.Thing, error)
+	UpdateActionChangedFields(context.Context, *models.Principal, strfmt.UUID, *models.Action) (*models.Action, error)
+	MergeThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing, bool) error
+	MergeAction(context.Context, *models.Principal, strfmt.UUID, *models.Action, bool) error
 	DeleteThing(context.Context, *models.Principal, strfmt.UUID) error
 	DeleteAction(context.Context, *models.Principal, strfmt.UUID) error
 	AddThingReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
 	AddActionReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
 	UpdateThingReferences(context.Context, *models.Principal, strfmt.UUID, string, models.MultipleRef) error
 	UpdateActionReferences(context.Context, *models.Principal, strfmt.UUID, string, models.MultipleRef) error
+	BulkUpdateThingReferences(context.Context, *models.Principal, strfmt.UUID, models.PropertyReferences) error
+	BulkUpdateActionReferences(context.Context, *models.Principal, strfmt.UUID, models.PropertyReferences) error
 	DeleteThingReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
 	DeleteActionReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
+	ReindexClass(context.Context, *models.Principal, string, bool) (*models.ReindexStatus, error)
+	GetReindexStatus(context.Context, *models.Principal, string) (*models.ReindexStatus, error)
+	ReshardClass(context.Context, *models.Principal, string, models.ShardingConfig) (*models.ReshardStatus, error)
+	GetReshardStatus(context.Context, *models.Principal, string) (*models.ReshardStatus, error)
+	GetClassCounts(context.Context, *models.Principal) (*models.ClassCounts, error)
+	CompactStorage(context.Context, *models.Principal) ([]*models.ShardCompaction, error)
+	SnapshotShard(context.Context, *models.Principal, string, string, io.Writer) error
+	RestoreShard(context.Context, *models.Principal, string, string, io.Reader) error
 }
 
 func (h *kindHandlers) addThing(params things.ThingsCreateParams,
 	principal *models.Principal) middleware.Responder {
+	if err := applyTTL(params.TTL, &params.Body.ExpiryTimeUnix); err != nil {
+		return things.NewThingsCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	thing, err := h.manager.AddThing(params.HTTPRequest.Context(), principal, params.Body)
+	if _, ok := err.(kinds.ErrAlreadyExists); ok && derefBool(params.IfNotExists) {
+		// a violated unique property is reported as well, but there is no
+		// existing object with this id then
+		existing, getErr := h.manager.GetThing(params.HTTPRequest.Context(), principal, params.Body.ID,
+			traverser.UnderscoreProperties{}, kinds.ExpandParams{})
+		if getErr == nil {
+			thing, err = existing, nil
+		}
+	}
+	if err == nil && derefString(params.Consistency) == kinds.ConsistencyStrong {
+		h.logAwaitErr("thing", thing.ID,
+			h.manager.AwaitThing(params.HTTPRequest.Context(), principal, thing.ID))
+	}
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return things.NewThingsCreateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists:
+			return things.NewThingsCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return things.NewThingsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return things.NewThingsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -90,8 +151,24 @@ func (h *kindHandlers) addThing(params things.ThingsCreateParams,
 	return things.NewThingsCreateOK().WithPayload(thing)
 }
 
+// logAwaitErr only logs if a created object could not be read back, as the
+// write itself succeeded. Reporting it as failed would make clients repeat
+// the create.
+func (h *kindHandlers) logAwaitErr(kindName string, id strfmt.UUID, err error) {
+	if err == nil {
+		return
+	}
+
+	h.logger.WithField("action", "restapi_await_consistency").
+		WithField("kind", kindName).
+		WithField("id", id).
+		WithError(err).
+		Warn("created object is not readable yet")
+}
+
 func (h *kindHandlers) validateThing(params things.ThingsValidateParams,
 	principal *models.Principal) middleware.Responder {
+	signalReadOnly(params.HTTPRequest.Context())
 
 	err := h.manager.ValidateThing(params.HTTPRequest.Context(), principal, params.Body)
 	if err != nil {
@@ -113,15 +190,42 @@ func (h *kindHandlers) validateThing(params things.ThingsValidateParams,
 
 func (h *kindHandlers) addAction(params actions.ActionsCreateParams,
 	principal *models.Principal) middleware.Responder {
+	if err := applyTTL(params.TTL, &params.Body.ExpiryTimeUnix); err != nil {
+		return actions.NewActionsCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	action, err := h.manager.AddAction(params.HTTPRequest.Context(), principal, params.Body)
+	if _, ok := err.(kinds.ErrAlreadyExists); ok && derefBool(params.IfNotExists) {
+		// a violated unique property is reported as well, but there is no
+		// existing object with this id then
+		existing, getErr := h.manager.GetAction(params.HTTPRequest.Context(), principal, params.Body.ID,
+			traverser.UnderscoreProperties{}, kinds.ExpandParams{})
+		if getErr == nil {
+			action, err = existing, nil
+		}
+	}
+	if err == nil && derefString(params.Consistency) == kinds.ConsistencyStrong {
+		h.logAwaitErr("action", action.ID,
+			h.manager.AwaitAction(params.HTTPRequest.Context(), principal, action.ID))
+	}
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return actions.NewActionsCreateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists:
+			return actions.NewActionsCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return actions.NewActionsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return actions.NewActionsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -138,6 +242,7 @@ func (h *kindHandlers) addAction(params actions.ActionsCreateParams,
 
 func (h *kindHandlers) validateAction(params actions.ActionsValidateParams,
 	principal *models.Principal) middleware.Responder {
+	signalReadOnly(params.HTTPRequest.Context())
 
 	err := h.manager.ValidateAction(params.HTTPRequest.Context(), principal, params.Body)
 	if err != nil {
@@ -173,12 +278,17 @@ func (h *kindHandlers) getThing(params things.ThingsGetParams,
 		underscores.Vector = true
 	}
 
-	thing, err := h.manager.GetThing(params.HTTPRequest.Context(), principal, params.ID, underscores)
+	expand := parseExpandParams(params.Expand, params.Depth)
+
+	thing, err := h.manager.GetThing(params.HTTPRequest.Context(), principal, params.ID, underscores, expand)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return things.NewThingsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return things.NewThingsGetBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return things.NewThingsGetNotFound()
 		default:
@@ -209,12 +319,17 @@ func (h *kindHandlers) getAction(params actions.ActionsGetParams,
 		underscores.RefMeta = true
 		underscores.Vector = true
 	}
-	action, err := h.manager.GetAction(params.HTTPRequest.Context(), principal, params.ID, underscores)
+	expand := parseExpandParams(params.Expand, params.Depth)
+
+	action, err := h.manager.GetAction(params.HTTPRequest.Context(), principal, params.ID, underscores, expand)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return actions.NewActionsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return actions.NewActionsGetBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return actions.NewActionsGetNotFound()
 		default:
@@ -239,6 +354,41 @@ func (h *kindHandlers) getThings(params things.ThingsListParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	where, err := parseWhereParam(params.Class, params.Where)
+	if err != nil {
+		return things.NewThingsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if derefBool(params.Count) {
+		count, err := h.manager.CountThings(params.HTTPRequest.Context(), principal,
+			derefString(params.Class), where)
+		if err != nil {
+			return thingsListErrorResponse(err)
+		}
+
+		return things.NewThingsListOK().
+			WithPayload(&models.ThingsListResponse{
+				Things:       []*models.Thing{},
+				TotalResults: &count,
+			})
+	}
+
+	limit, err := h.config.QueryDefaults.EffectiveLimit(params.Limit)
+	if err != nil {
+		return things.NewThingsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if params.After != nil {
+		if where != nil {
+			return things.NewThingsListBadRequest().WithPayload(errPayloadFromSingleErr(
+				fmt.Errorf("a cursor can not be combined with a where filter")))
+		}
+
+		return h.getThingsAfter(params, principal, limit)
+	}
+
 	var deprecationsRes []*models.Deprecation
 
 	if derefBool(params.Meta) {
@@ -250,16 +400,20 @@ func (h *kindHandlers) getThings(params things.ThingsListParams,
 		underscores.Vector = true
 	}
 
-	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal, params.Limit, underscores)
+	if acceptsNDJSON(params.HTTPRequest) {
+		return h.streamThings(params, principal, &limit, where, underscores)
+	}
+
+	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal, &limit,
+		derefString(params.Class), where, underscores)
 	if err != nil {
-		switch err.(type) {
-		case errors.Forbidden:
-			return things.NewThingsListForbidden().
-				WithPayload(errPayloadFromSingleErr(err))
-		default:
-			return things.NewThingsListInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
-		}
+		return thingsListErrorResponse(err)
+	}
+
+	total, err := h.totalThings(params.HTTPRequest.Context(), principal,
+		derefString(params.Class), where, params.IncludeTotal)
+	if err != nil {
+		return thingsListErrorResponse(err)
 	}
 
 	for i, thing := range list {
@@ -272,7 +426,8 @@ func (h *kindHandlers) getThings(params things.ThingsListParams,
 	return things.NewThingsListOK().
 		WithPayload(&models.ThingsListResponse{
 			Things:       list,
-			TotalResults: int64(len(list)),
+			TotalResults: total,
+			Limit:        limit,
 			Deprecations: deprecationsRes,
 		})
 }
@@ -285,6 +440,41 @@ func (h *kindHandlers) getActions(params actions.ActionsListParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	where, err := parseWhereParam(params.Class, params.Where)
+	if err != nil {
+		return actions.NewActionsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if derefBool(params.Count) {
+		count, err := h.manager.CountActions(params.HTTPRequest.Context(), principal,
+			derefString(params.Class), where)
+		if err != nil {
+			return actionsListErrorResponse(err)
+		}
+
+		return actions.NewActionsListOK().
+			WithPayload(&models.ActionsListResponse{
+				Actions:      []*models.Action{},
+				TotalResults: &count,
+			})
+	}
+
+	limit, err := h.config.QueryDefaults.EffectiveLimit(params.Limit)
+	if err != nil {
+		return actions.NewActionsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if params.After != nil {
+		if where != nil {
+			return actions.NewActionsListBadRequest().WithPayload(errPayloadFromSingleErr(
+				fmt.Errorf("a cursor can not be combined with a where filter")))
+		}
+
+		return h.getActionsAfter(params, principal, limit)
+	}
+
 	var deprecationsRes []*models.Deprecation
 
 	if derefBool(params.Meta) {
@@ -295,16 +485,20 @@ func (h *kindHandlers) getActions(params actions.ActionsListParams,
 		underscores.RefMeta = true
 		underscores.Vector = true
 	}
-	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal, params.Limit, underscores)
+	if acceptsNDJSON(params.HTTPRequest) {
+		return h.streamActions(params, principal, &limit, where, underscores)
+	}
+
+	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal, &limit,
+		derefString(params.Class), where, underscores)
 	if err != nil {
-		switch err.(type) {
-		case errors.Forbidden:
-			return actions.NewActionsListForbidden().
-				WithPayload(errPayloadFromSingleErr(err))
-		default:
-			return actions.NewActionsListInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
-		}
+		return actionsListErrorResponse(err)
+	}
+
+	total, err := h.totalActions(params.HTTPRequest.Context(), principal,
+		derefString(params.Class), where, params.IncludeTotal)
+	if err != nil {
+		return actionsListErrorResponse(err)
 	}
 
 	for i, action := range list {
@@ -318,21 +512,105 @@ func (h *kindHandlers) getActions(params actions.ActionsListParams,
 		WithPayload(&models.ActionsListResponse{
 			Actions:      list,
 			Deprecations: deprecationsRes,
-			TotalResults: int64(len(list)),
+			TotalResults: total,
+			Limit:        limit,
+		})
+}
+
+// getThingsAfter serves a single page of a cursor iteration over all things of
+// a class
+func (h *kindHandlers) getThingsAfter(params things.ThingsListParams,
+	principal *models.Principal, limit int64) middleware.Responder {
+	list, next, err := h.manager.GetThingsAfter(params.HTTPRequest.Context(), principal,
+		&limit, derefString(params.Class), *params.After)
+	if err != nil {
+		return thingsListErrorResponse(err)
+	}
+
+	total, err := h.totalThings(params.HTTPRequest.Context(), principal,
+		derefString(params.Class), nil, params.IncludeTotal)
+	if err != nil {
+		return thingsListErrorResponse(err)
+	}
+
+	for i, thing := range list {
+		schemaMap, ok := thing.Schema.(map[string]interface{})
+		if ok {
+			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+		}
+	}
+
+	return things.NewThingsListOK().
+		WithPayload(&models.ThingsListResponse{
+			Things:       list,
+			TotalResults: total,
+			Limit:        limit,
+			NextCursor:   next,
+		})
+}
+
+// getActionsAfter serves a single page of a cursor iteration over all actions of
+// a class
+func (h *kindHandlers) getActionsAfter(params actions.ActionsListParams,
+	principal *models.Principal, limit int64) middleware.Responder {
+	list, next, err := h.manager.GetActionsAfter(params.HTTPRequest.Context(), principal,
+		&limit, derefString(params.Class), *params.After)
+	if err != nil {
+		return actionsListErrorResponse(err)
+	}
+
+	total, err := h.totalActions(params.HTTPRequest.Context(), principal,
+		derefString(params.Class), nil, params.IncludeTotal)
+	if err != nil {
+		return actionsListErrorResponse(err)
+	}
+
+	for i, action := range list {
+		schemaMap, ok := action.Schema.(map[string]interface{})
+		if ok {
+			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+		}
+	}
+
+	return actions.NewActionsListOK().
+		WithPayload(&models.ActionsListResponse{
+			Actions:      list,
+			TotalResults: total,
+			Limit:        limit,
+			NextCursor:   next,
 		})
 }
 
 func (h *kindHandlers) updateThing(params things.ThingsUpdateParams,
 	principal *models.Principal) middleware.Responder {
-	thing, err := h.manager.UpdateThing(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	update := h.manager.UpdateThing
+	if derefBool(params.ChangedOnly) {
+		update = h.manager.UpdateThingChangedFields
+	}
+
+	if params.Body != nil {
+		// the version in the body is ignored, only the If-Match header is used
+		params.Body.Version = derefInt64(params.IfMatch)
+	}
+
+	thing, err := update(params.HTTPRequest.Context(), principal, params.ID, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return things.NewThingsUpdateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists, kinds.ErrVersionConflict:
+			return things.NewThingsUpdateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return things.NewThingsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return things.NewThingsUpdateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -349,15 +627,34 @@ func (h *kindHandlers) updateThing(params things.ThingsUpdateParams,
 
 func (h *kindHandlers) updateAction(params actions.ActionsUpdateParams,
 	principal *models.Principal) middleware.Responder {
-	action, err := h.manager.UpdateAction(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	update := h.manager.UpdateAction
+	if derefBool(params.ChangedOnly) {
+		update = h.manager.UpdateActionChangedFields
+	}
+
+	if params.Body != nil {
+		// the version in the body is ignored, only the If-Match header is used
+		params.Body.Version = derefInt64(params.IfMatch)
+	}
+
+	action, err := update(params.HTTPRequest.Context(), principal, params.ID, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return actions.NewActionsUpdateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists, kinds.ErrVersionConflict:
+			return actions.NewActionsUpdateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return actions.NewActionsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return actions.NewActionsUpdateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -377,11 +674,13 @@ func (h *kindHandlers) deleteThing(params things.ThingsDeleteParams,
 	err := h.manager.DeleteThing(params.HTTPRequest.Context(), principal, params.ID)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return things.NewThingsDeleteNotFound()
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return things.NewThingsDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -396,11 +695,13 @@ func (h *kindHandlers) deleteAction(params actions.ActionsDeleteParams,
 	err := h.manager.DeleteAction(params.HTTPRequest.Context(), principal, params.ID)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return actions.NewActionsDeleteNotFound()
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return actions.NewActionsDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -411,16 +712,30 @@ func (h *kindHandlers) deleteAction(params actions.ActionsDeleteParams,
 }
 
 func (h *kindHandlers) patchThing(params things.ThingsPatchParams, principal *models.Principal) middleware.Responder {
+	if params.Body != nil {
+		// the version in the body is ignored, only the If-Match header is used
+		params.Body.Version = derefInt64(params.IfMatch)
+	}
 
-	err := h.manager.MergeThing(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	err := h.manager.MergeThing(params.HTTPRequest.Context(), principal, params.ID, params.Body,
+		derefBool(params.UniqueReferences))
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return things.NewThingsPatchTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists, kinds.ErrVersionConflict:
+			return things.NewThingsPatchConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return things.NewThingsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return things.NewThingsUpdateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -431,15 +746,30 @@ func (h *kindHandlers) patchThing(params things.ThingsPatchParams, principal *mo
 }
 
 func (h *kindHandlers) patchAction(params actions.ActionsPatchParams, principal *models.Principal) middleware.Responder {
-	err := h.manager.MergeAction(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	if params.Body != nil {
+		// the version in the body is ignored, only the If-Match header is used
+		params.Body.Version = derefInt64(params.IfMatch)
+	}
+
+	err := h.manager.MergeAction(params.HTTPRequest.Context(), principal, params.ID, params.Body,
+		derefBool(params.UniqueReferences))
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrRateLimited:
+			return actions.NewActionsPatchTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(err)).
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists, kinds.ErrVersionConflict:
+			return actions.NewActionsPatchConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrInvalidUserInput:
 			return actions.NewActionsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrUnavailable:
+			return unavailableResponse(err)
 		default:
 			return actions.NewActionsUpdateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -454,7 +784,7 @@ func (h *kindHandlers) addThingReference(params things.ThingsReferencesCreatePar
 	err := h.manager.AddThingReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsReferencesCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -474,7 +804,7 @@ func (h *kindHandlers) addActionReference(params actions.ActionsReferencesCreate
 	err := h.manager.AddActionReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsReferencesCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -494,7 +824,7 @@ func (h *kindHandlers) updateActionReferences(params actions.ActionsReferencesUp
 	err := h.manager.UpdateActionReferences(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsReferencesUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -509,12 +839,32 @@ func (h *kindHandlers) updateActionReferences(params actions.ActionsReferencesUp
 	return actions.NewActionsReferencesUpdateOK()
 }
 
+func (h *kindHandlers) bulkUpdateActionReferences(params actions.ActionsReferencesBulkUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	err := h.manager.BulkUpdateActionReferences(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, kinds.ErrReadOnly:
+			return actions.NewActionsReferencesBulkUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
+			return actions.NewActionsReferencesBulkUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return actions.NewActionsReferencesBulkUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return actions.NewActionsReferencesBulkUpdateOK()
+}
+
 func (h *kindHandlers) updateThingReferences(params things.ThingsReferencesUpdateParams,
 	principal *models.Principal) middleware.Responder {
 	err := h.manager.UpdateThingReferences(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsReferencesUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -529,12 +879,32 @@ func (h *kindHandlers) updateThingReferences(params things.ThingsReferencesUpdat
 	return things.NewThingsReferencesUpdateOK()
 }
 
+func (h *kindHandlers) bulkUpdateThingReferences(params things.ThingsReferencesBulkUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	err := h.manager.BulkUpdateThingReferences(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, kinds.ErrReadOnly:
+			return things.NewThingsReferencesBulkUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
+			return things.NewThingsReferencesBulkUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return things.NewThingsReferencesBulkUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return things.NewThingsReferencesBulkUpdateOK()
+}
+
 func (h *kindHandlers) deleteActionReference(params actions.ActionsReferencesDeleteParams,
 	principal *models.Principal) middleware.Responder {
 	err := h.manager.DeleteActionReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return actions.NewActionsReferencesDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -554,7 +924,7 @@ func (h *kindHandlers) deleteThingReference(params things.ThingsReferencesDelete
 	err := h.manager.DeleteThingReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, kinds.ErrReadOnly:
 			return things.NewThingsReferencesDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound, kinds.ErrInvalidUserInput:
@@ -593,6 +963,10 @@ func setupKindHandlers(api *operations.WeaviateAPI,
 		ThingsReferencesDeleteHandlerFunc(h.deleteThingReference)
 	api.ThingsThingsReferencesUpdateHandler = things.
 		ThingsReferencesUpdateHandlerFunc(h.updateThingReferences)
+	api.ThingsThingsReferencesBulkUpdateHandler = things.
+		ThingsReferencesBulkUpdateHandlerFunc(h.bulkUpdateThingReferences)
+	api.ThingsThingsReferencesListHandler = things.
+		ThingsReferencesListHandlerFunc(h.listThingReferences)
 
 	api.ActionsActionsCreateHandler = actions.
 		ActionsCreateHandlerFunc(h.addAction)
@@ -614,7 +988,87 @@ func setupKindHandlers(api *operations.WeaviateAPI,
 		ActionsReferencesDeleteHandlerFunc(h.deleteActionReference)
 	api.ActionsActionsReferencesUpdateHandler = actions.
 		ActionsReferencesUpdateHandlerFunc(h.updateActionReferences)
+	api.ActionsActionsReferencesBulkUpdateHandler = actions.
+		ActionsReferencesBulkUpdateHandlerFunc(h.bulkUpdateActionReferences)
+	api.ActionsActionsReferencesListHandler = actions.
+		ActionsReferencesListHandlerFunc(h.listActionReferences)
+
+	api.BatchingBatchingThingsGetHandler = batching.
+		BatchingThingsGetHandlerFunc(h.getThingsByIDs)
+	api.BatchingBatchingActionsGetHandler = batching.
+		BatchingActionsGetHandlerFunc(h.getActionsByIDs)
+	api.BatchingBatchingReferencesResolveHandler = batching.
+		BatchingReferencesResolveHandlerFunc(h.resolveBeacons)
+
+	api.SchemaSchemaReindexHandler = schema.
+		SchemaReindexHandlerFunc(h.reindexClass)
+	api.SchemaSchemaReindexGetHandler = schema.
+		SchemaReindexGetHandlerFunc(h.getReindexStatus)
+	api.SchemaSchemaShardsUpdateHandler = schema.
+		SchemaShardsUpdateHandlerFunc(h.reshardClass)
+	api.SchemaSchemaShardsGetHandler = schema.
+		SchemaShardsGetHandlerFunc(h.getReshardStatus)
+	api.SchemaSchemaCountsHandler = schema.
+		SchemaCountsHandlerFunc(h.getClassCounts)
+
+	api.MetaMetaCompactHandler = meta.
+		MetaCompactHandlerFunc(h.compactStorage)
+	api.MetaMetaShardSnapshotHandler = meta.
+		MetaShardSnapshotHandlerFunc(h.snapshotShard)
+	api.MetaMetaShardRestoreHandler = meta.
+		MetaShardRestoreHandlerFunc(h.restoreShard)
+}
 
+// applyTTL turns the optional TTL header into an absolute expiry time. It
+// can't be combined with an expiryTimeUnix in the body.
+func applyTTL(ttl *int64, expiry *int64) error {
+	if ttl == nil {
+		return nil
+	}
+
+	if *ttl <= 0 {
+		return fmt.Errorf("header 'TTL' must be a positive number of seconds, got %d", *ttl)
+	}
+
+	if *expiry != 0 {
+		return fmt.Errorf("header 'TTL' and field 'expiryTimeUnix' are mutually exclusive")
+	}
+
+	*expiry = time.Now().Add(time.Duration(*ttl)*time.Second).UnixNano() / int64(time.Millisecond)
+	return nil
+}
+
+// totalThings counts all things matching the class and where filter of a list
+// request, so that TotalResults is independent of the page size. It returns
+// nil without counting if the user opted out through includeTotal=false.
+func (h *kindHandlers) totalThings(ctx context.Context, principal *models.Principal,
+	class string, where *filters.LocalFilter, includeTotal *bool) (*int64, error) {
+	if includeTotal != nil && !*includeTotal {
+		return nil, nil
+	}
+
+	count, err := h.manager.CountThings(ctx, principal, class, where)
+	if err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+// totalActions counts all actions matching the class and where filter of a
+// list request, see totalThings.
+func (h *kindHandlers) totalActions(ctx context.Context, principal *models.Principal,
+	class string, where *filters.LocalFilter, includeTotal *bool) (*int64, error) {
+	if includeTotal != nil && !*includeTotal {
+		return nil, nil
+	}
+
+	count, err := h.manager.CountActions(ctx, principal, class, where)
+	if err != nil {
+		return nil, err
+	}
+
+	return &count, nil
 }
 
 func derefBool(in *bool) bool {
@@ -625,6 +1079,22 @@ func derefBool(in *bool) bool {
 	return *in
 }
 
+func derefString(in *string) string {
+	if in == nil {
+		return ""
+	}
+
+	return *in
+}
+
+func derefInt64(in *int64) int64 {
+	if in == nil {
+		return 0
+	}
+
+	return *in
+}
+
 func (h *kindHandlers) extendSchemaWithAPILinks(schema map[string]interface{}) map[string]interface{} {
 	if schema == nil {
 		return schema
@@ -653,23 +1123,95 @@ func (h *kindHandlers) extendReferenceWithAPILink(ref *models.SingleRef) *models
 
 	parsed, err := crossref.Parse(ref.Beacon.String())
 	if err != nil {
-		// ignore return unchanged
+		// the reference is still returned, just without an href, but the reason
+		// should not go unnoticed
+		h.logger.WithField("action", "extend_reference_with_api_link").
+			WithField("beacon", ref.Beacon).
+			WithError(err).
+			Warn("could not parse beacon")
 		return ref
 	}
 
 	ref.Href = strfmt.URI(fmt.Sprintf("%s/v1/%ss/%s", h.config.Origin, parsed.Kind.Name(), parsed.TargetID))
+
+	// the reference might have been expanded, in which case the target can
+	// contain references itself
+	schemaMap, ok := ref.Schema.(map[string]interface{})
+	if ok {
+		ref.Schema = h.extendSchemaWithAPILinks(schemaMap)
+	}
+
 	return ref
 }
 
+func parseExpandParams(props *string, depth *int64) kinds.ExpandParams {
+	out := kinds.ExpandParams{Depth: 1}
+	if props == nil {
+		return out
+	}
+
+	for _, prop := range strings.Split(*props, ",") {
+		prop = strings.TrimSpace(prop)
+		if prop == "" {
+			continue
+		}
+
+		out.Properties = append(out.Properties, prop)
+	}
+
+	if depth != nil {
+		out.Depth = int(*depth)
+	}
+
+	return out
+}
+
+func thingsListErrorResponse(err error) middleware.Responder {
+	switch err.(type) {
+	case errors.Forbidden:
+		return things.NewThingsListForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case kinds.ErrInvalidUserInput:
+		return things.NewThingsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return things.NewThingsListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func actionsListErrorResponse(err error) middleware.Responder {
+	switch err.(type) {
+	case errors.Forbidden:
+		return actions.NewActionsListForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case kinds.ErrInvalidUserInput:
+		return actions.NewActionsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return actions.NewActionsListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
 func parseIncludeParam(in *string) (traverser.UnderscoreProperties, error) {
 	out := traverser.UnderscoreProperties{}
 	if in == nil {
 		return out, nil
 	}
 
-	parts := strings.Split(*in, ",")
+	parts, err := splitIncludeParam(*in)
+	if err != nil {
+		return out, err
+	}
+
+	for _, part := range parts {
+		prop, args, err := parseIncludeArgs(part)
+		if err != nil {
+			return out, err
+		}
 
-	for _, prop := range parts {
+		acceptsArgs := false
 		switch prop {
 		case "_classification", "classification":
 			out.Classification = true
@@ -679,14 +1221,182 @@ func parseIncludeParam(in *string) (traverser.UnderscoreProperties, error) {
 		case "_nearestNeighbors", "nearestNeighbors", "nearestneighbors", "_nearestneighbors", "nearest-neighbors", "nearest_neighbors", "_nearest_neighbors":
 			out.NearestNeighbors = true
 		case "_featureProjection", "featureProjection", "featureprojection", "_featureprojection", "feature-projection", "feature_projection", "_feature_projection":
-			out.FeatureProjection = &projector.Params{}
+			params, err := parseFeatureProjectionArgs(args)
+			if err != nil {
+				return out, fmt.Errorf("invalid arguments for '%s' in ?include list: %v", prop, err)
+			}
+			out.FeatureProjection = params
+			acceptsArgs = true
 		case "_vector", "vector":
 			out.Vector = true
 
 		default:
 			return out, fmt.Errorf("unrecognized property '%s' in ?include list", prop)
 		}
+
+		if args != nil && !acceptsArgs {
+			return out, fmt.Errorf("property '%s' in ?include list does not accept arguments", prop)
+		}
+	}
+
+	return out, nil
+}
+
+// splitIncludeParam splits the ?include list on every comma which is not
+// part of an argument list, so that "_vector,_featureProjection(a:1,b:2)"
+// results in two elements
+func splitIncludeParam(in string) ([]string, error) {
+	var out []string
+	depth := 0
+	start := 0
+	for i, r := range in {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in ?include list")
+			}
+		case ',':
+			if depth == 0 {
+				out = append(out, in[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses in ?include list")
+	}
+
+	return append(out, in[start:]), nil
+}
+
+// parseIncludeArgs splits an element of the ?include list such as
+// "_featureProjection(dimensions:3)" into the property name and its
+// arguments. args is nil if the element has no argument list.
+func parseIncludeArgs(in string) (string, map[string]string, error) {
+	open := strings.Index(in, "(")
+	if open == -1 {
+		return in, nil, nil
+	}
+
+	if !strings.HasSuffix(in, ")") {
+		return "", nil, fmt.Errorf("invalid element '%s' in ?include list: "+
+			"arguments must be the last part of the element", in)
+	}
+
+	prop := in[:open]
+	args := map[string]string{}
+	inner := strings.TrimSpace(in[open+1 : len(in)-1])
+	if inner == "" {
+		return prop, args, nil
+	}
+
+	for _, pair := range strings.Split(inner, ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 {
+			return "", nil, fmt.Errorf("invalid argument '%s' for '%s' in ?include list: "+
+				"must be of form key:value", pair, prop)
+		}
+
+		args[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return prop, args, nil
+}
+
+const maxFeatureProjectionIterations = 1000
+
+// parseFeatureProjectionArgs turns the arguments of the _featureProjection
+// element of the ?include list into projector params. Arguments which are
+// not set are left nil, so the projector can apply its defaults.
+func parseFeatureProjectionArgs(args map[string]string) (*projector.Params, error) {
+	out := &projector.Params{Enabled: true}
+	for key, value := range args {
+		switch key {
+		case "algorithm":
+			algorithm := value
+			out.Algorithm = &algorithm
+		case "dimensions":
+			dims, err := parseIntArg(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if dims != 2 && dims != 3 {
+				return nil, fmt.Errorf("dimensions must be 2 or 3, got %d", dims)
+			}
+			out.Dimensions = &dims
+		case "iterations":
+			iterations, err := parseIntArg(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if iterations < 1 || iterations > maxFeatureProjectionIterations {
+				return nil, fmt.Errorf("iterations must be between 1 and %d, got %d",
+					maxFeatureProjectionIterations, iterations)
+			}
+			out.Iterations = &iterations
+		case "perplexity":
+			perplexity, err := parseIntArg(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if perplexity < 1 {
+				return nil, fmt.Errorf("perplexity must be at least 1, got %d", perplexity)
+			}
+			out.Perplexity = &perplexity
+		case "learningRate":
+			learningRate, err := parseIntArg(key, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if learningRate < 1 {
+				return nil, fmt.Errorf("learningRate must be at least 1, got %d", learningRate)
+			}
+			out.LearningRate = &learningRate
+		default:
+			return nil, fmt.Errorf("unrecognized argument '%s'", key)
+		}
 	}
 
 	return out, nil
 }
+
+func parseIntArg(key, value string) (int, error) {
+	asInt, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer, got '%s'", key, value)
+	}
+
+	return asInt, nil
+}
+
+// parseWhereParam turns the JSON-encoded ?where filter into the same filter
+// structure the GraphQL Get resolver uses. Paths are relative to the class
+// set with ?class, so a filter without a class is rejected.
+func parseWhereParam(class, where *string) (*filters.LocalFilter, error) {
+	if where == nil {
+		return nil, nil
+	}
+
+	if class == nil {
+		return nil, fmt.Errorf("a ?where filter requires the ?class parameter to be set")
+	}
+
+	var whereFilter models.WhereFilter
+	if err := json.Unmarshal([]byte(*where), &whereFilter); err != nil {
+		return nil, fmt.Errorf("invalid ?where filter: %v", err)
+	}
+
+	if err := whereFilter.Validate(strfmt.Default); err != nil {
+		return nil, fmt.Errorf("invalid ?where filter: %v", err)
+	}
+
+	return filterext.ParseWithRootClass(&whereFilter, *class)
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+func (h *kindHandlers) getThingsByIDs(params batching.BatchingThingsGetParams,
+	principal *models.Principal) middleware.Responder {
+	underscores, err := parseIncludeParam(params.Include)
+	if err != nil {
+		return batching.NewBatchingThingsGetBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	signalReadOnly(params.HTTPRequest.Context())
+
+	list, notFound, err := h.manager.GetThingsByIDs(params.HTTPRequest.Context(),
+		principal, params.Body.Ids, underscores, batchExpandParams(params.Body))
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingThingsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingThingsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingThingsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for i := range list {
+		schemaMap, ok := list[i].Schema.(map[string]interface{})
+		if ok {
+			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+		}
+	}
+
+	return batching.NewBatchingThingsGetOK().
+		WithPayload(&models.ThingsBatchGetResponse{
+			Things:   list,
+			NotFound: notFound,
+		})
+}
+
+func (h *kindHandlers) getActionsByIDs(params batching.BatchingActionsGetParams,
+	principal *models.Principal) middleware.Responder {
+	underscores, err := parseIncludeParam(params.Include)
+	if err != nil {
+		return batching.NewBatchingActionsGetBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	signalReadOnly(params.HTTPRequest.Context())
+
+	list, notFound, err := h.manager.GetActionsByIDs(params.HTTPRequest.Context(),
+		principal, params.Body.Ids, underscores, batchExpandParams(params.Body))
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingActionsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingActionsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingActionsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for i := range list {
+		schemaMap, ok := list[i].Schema.(map[string]interface{})
+		if ok {
+			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+		}
+	}
+
+	return batching.NewBatchingActionsGetOK().
+		WithPayload(&models.ActionsBatchGetResponse{
+			Actions:  list,
+			NotFound: notFound,
+		})
+}
+
+func batchExpandParams(body *models.BatchGetRequest) kinds.BatchExpandParams {
+	return kinds.BatchExpandParams{
+		Properties: body.Expand,
+		Select:     body.ExpandProperties,
+	}
+}
+
+func (h *kindHandlers) resolveBeacons(params batching.BatchingReferencesResolveParams,
+	principal *models.Principal) middleware.Responder {
+	underscores, err := parseIncludeParam(params.Include)
+	if err != nil {
+		return batching.NewBatchingReferencesResolveBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	signalReadOnly(params.HTTPRequest.Context())
+
+	resolved, err := h.manager.ResolveBeacons(params.HTTPRequest.Context(),
+		principal, params.Body.Beacons, underscores)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingReferencesResolveForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingReferencesResolveUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingReferencesResolveInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	results := make([]*models.BeaconResolveResult, len(resolved))
+	for i, res := range resolved {
+		result := &models.BeaconResolveResult{Beacon: res.Beacon}
+		switch {
+		case res.Err != nil:
+			result.Status = models.BeaconResolveResultStatusFAILED
+			result.Errors = errPayloadFromSingleErr(res.Err)
+		case res.Thing != nil:
+			result.Status = models.BeaconResolveResultStatusSUCCESS
+			if schemaMap, ok := res.Thing.Schema.(map[string]interface{}); ok {
+				res.Thing.Schema = h.extendSchemaWithAPILinks(schemaMap)
+			}
+			result.Thing = res.Thing
+		case res.Action != nil:
+			result.Status = models.BeaconResolveResultStatusSUCCESS
+			if schemaMap, ok := res.Action.Schema.(map[string]interface{}); ok {
+				res.Action.Schema = h.extendSchemaWithAPILinks(schemaMap)
+			}
+			result.Action = res.Action
+		default:
+			result.Status = models.BeaconResolveResultStatusNOTFOUND
+		}
+
+		results[i] = result
+	}
+
+	return batching.NewBatchingReferencesResolveOK().
+		WithPayload(&models.BeaconsResolveResponse{Results: results})
+}
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+func (h *kindHandlers) compactStorage(params meta.MetaCompactParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.CompactStorage(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return meta.NewMetaCompactForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrAlreadyExists:
+			return meta.NewMetaCompactConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotImplemented:
+			return meta.NewMetaCompactNotImplemented()
+		default:
+			return meta.NewMetaCompactInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return meta.NewMetaCompactOK().WithPayload(res)
+}
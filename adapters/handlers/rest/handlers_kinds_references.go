@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+func (h *kindHandlers) listThingReferences(params things.ThingsReferencesListParams,
+	principal *models.Principal) middleware.Responder {
+	resolve := derefBool(params.Resolve)
+	props, err := h.manager.GetThingReferences(params.HTTPRequest.Context(), principal,
+		params.ID, resolve)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return things.NewThingsReferencesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return things.NewThingsReferencesListNotFound()
+		default:
+			return things.NewThingsReferencesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return things.NewThingsReferencesListOK().
+		WithPayload(h.referencesListPayload(props, resolve || derefBool(params.Parse)))
+}
+
+func (h *kindHandlers) listActionReferences(params actions.ActionsReferencesListParams,
+	principal *models.Principal) middleware.Responder {
+	resolve := derefBool(params.Resolve)
+	props, err := h.manager.GetActionReferences(params.HTTPRequest.Context(), principal,
+		params.ID, resolve)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return actions.NewActionsReferencesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return actions.NewActionsReferencesListNotFound()
+		default:
+			return actions.NewActionsReferencesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return actions.NewActionsReferencesListOK().
+		WithPayload(h.referencesListPayload(props, resolve || derefBool(params.Parse)))
+}
+
+func (h *kindHandlers) referencesListPayload(props []kinds.PropertyReferences,
+	parse bool) *models.ReferencesListResponse {
+	out := make([]*models.ReferenceProperty, len(props))
+	for i, prop := range props {
+		refs := make([]*models.ListedReference, len(prop.References))
+		for j, ref := range prop.References {
+			listed := &models.ListedReference{Beacon: ref.Beacon, Forbidden: ref.Forbidden}
+			if parse && ref.Ref != nil {
+				listed.Kind = ref.Ref.Kind.Name()
+				listed.ID = ref.Ref.TargetID
+			}
+
+			if ref.Thing != nil {
+				if schemaMap, ok := ref.Thing.Schema.(map[string]interface{}); ok {
+					ref.Thing.Schema = h.extendSchemaWithAPILinks(schemaMap)
+				}
+				listed.Thing = ref.Thing
+			}
+
+			if ref.Action != nil {
+				if schemaMap, ok := ref.Action.Schema.(map[string]interface{}); ok {
+					ref.Action.Schema = h.extendSchemaWithAPILinks(schemaMap)
+				}
+				listed.Action = ref.Action
+			}
+
+			refs[j] = listed
+		}
+
+		out[i] = &models.ReferenceProperty{Name: prop.Property, References: refs}
+	}
+
+	return &models.ReferencesListResponse{Properties: out}
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+func (h *kindHandlers) reindexClass(params schema.SchemaReindexParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := h.manager.ReindexClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, derefBool(params.Resume))
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaReindexForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return schema.NewSchemaReindexNotFound()
+		case kinds.ErrAlreadyExists:
+			return schema.NewSchemaReindexConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaReindexInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaReindexAccepted().WithPayload(status)
+}
+
+func (h *kindHandlers) getReindexStatus(params schema.SchemaReindexGetParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := h.manager.GetReindexStatus(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaReindexGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return schema.NewSchemaReindexGetNotFound()
+		default:
+			return schema.NewSchemaReindexGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaReindexGetOK().WithPayload(status)
+}
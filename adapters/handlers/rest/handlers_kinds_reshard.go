@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+func (h *kindHandlers) reshardClass(params schema.SchemaShardsUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := h.manager.ReshardClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, *params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaShardsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return schema.NewSchemaShardsUpdateNotFound()
+		case kinds.ErrAlreadyExists:
+			return schema.NewSchemaShardsUpdateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return schema.NewSchemaShardsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaShardsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaShardsUpdateAccepted().WithPayload(status)
+}
+
+func (h *kindHandlers) getReshardStatus(params schema.SchemaShardsGetParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := h.manager.GetReshardStatus(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaShardsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return schema.NewSchemaShardsGetNotFound()
+		default:
+			return schema.NewSchemaShardsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaShardsGetOK().WithPayload(status)
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+const tarMimeType = "application/x-tar"
+
+// snapshotWriter sends the status code together with the first bytes of the
+// snapshot, so that errors which occur before anything was written can still
+// use the regular error responses
+type snapshotWriter struct {
+	rw      http.ResponseWriter
+	started bool
+}
+
+func (w *snapshotWriter) start() {
+	if w.started {
+		return
+	}
+
+	w.rw.Header().Set(runtime.HeaderContentType, tarMimeType)
+	w.rw.WriteHeader(http.StatusOK)
+	w.started = true
+}
+
+func (w *snapshotWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	w.start()
+	return w.rw.Write(p)
+}
+
+func (h *kindHandlers) snapshotShard(params meta.MetaShardSnapshotParams,
+	principal *models.Principal) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		w := &snapshotWriter{rw: rw}
+		err := h.manager.SnapshotShard(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ShardName, w)
+		if err == nil {
+			w.start()
+			return
+		}
+
+		if w.started {
+			// the status code is already sent, the client is left with a
+			// truncated tar archive which can't be restored
+			h.logger.WithField("action", "shard_snapshot").WithError(err).
+				Error("streaming shard snapshot aborted")
+			return
+		}
+
+		// the error is a JSON document, even if the client asked for a tar
+		// archive
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		snapshotShardErrorResponse(err).WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+func snapshotShardErrorResponse(err error) middleware.Responder {
+	switch err.(type) {
+	case errors.Forbidden:
+		return meta.NewMetaShardSnapshotForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case kinds.ErrNotFound:
+		return meta.NewMetaShardSnapshotNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	case kinds.ErrNotImplemented:
+		return meta.NewMetaShardSnapshotNotImplemented()
+	default:
+		return meta.NewMetaShardSnapshotInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func (h *kindHandlers) restoreShard(params meta.MetaShardRestoreParams,
+	principal *models.Principal) middleware.Responder {
+	defer params.Body.Close()
+
+	err := h.manager.RestoreShard(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, kinds.ErrReadOnly:
+			return meta.NewMetaShardRestoreForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			return meta.NewMetaShardRestoreNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return meta.NewMetaShardRestoreUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotImplemented:
+			return meta.NewMetaShardRestoreNotImplemented()
+		default:
+			return meta.NewMetaShardRestoreInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return meta.NewMetaShardRestoreOK()
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+const ndjsonMimeType = "application/x-ndjson"
+
+func acceptsNDJSON(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), ndjsonMimeType)
+}
+
+// ndjsonStream writes one JSON document per line and flushes after each of
+// them, so clients can process the results incrementally. The status code is
+// only sent together with the first document, so that errors which occur
+// before anything was streamed can still use the regular error responses.
+type ndjsonStream struct {
+	rw      http.ResponseWriter
+	enc     *json.Encoder
+	started bool
+}
+
+func newNDJSONStream(rw http.ResponseWriter) *ndjsonStream {
+	return &ndjsonStream{rw: rw, enc: json.NewEncoder(rw)}
+}
+
+func (s *ndjsonStream) start() {
+	if s.started {
+		return
+	}
+
+	s.rw.Header().Set(runtime.HeaderContentType, ndjsonMimeType)
+	s.rw.WriteHeader(http.StatusOK)
+	s.started = true
+}
+
+func (s *ndjsonStream) write(doc interface{}) error {
+	s.start()
+	if err := s.enc.Encode(doc); err != nil {
+		return err
+	}
+
+	if f, ok := s.rw.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+// finish completes the stream. Once the status code is sent, an error can
+// only be reported as the last line of the stream.
+func (s *ndjsonStream) finish(err error,
+	errResponse func(error) middleware.Responder, p runtime.Producer) {
+	if err == nil {
+		s.start()
+		return
+	}
+
+	if !s.started {
+		errResponse(err).WriteResponse(s.rw, p)
+		return
+	}
+
+	s.write(errPayloadFromSingleErr(err))
+}
+
+func (h *kindHandlers) streamThings(params things.ThingsListParams,
+	principal *models.Principal, limit *int64, where *filters.LocalFilter,
+	underscores traverser.UnderscoreProperties) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		stream := newNDJSONStream(rw)
+		err := h.manager.StreamThings(params.HTTPRequest.Context(), principal,
+			limit, derefString(params.Class), where, underscores,
+			func(thing *models.Thing) error {
+				schemaMap, ok := thing.Schema.(map[string]interface{})
+				if ok {
+					thing.Schema = h.extendSchemaWithAPILinks(schemaMap)
+				}
+
+				return stream.write(thing)
+			})
+		if err != nil && stream.started {
+			h.logger.WithField("action", "things_list_stream").WithError(err).
+				Error("streaming things aborted")
+		}
+
+		stream.finish(err, thingsListErrorResponse, p)
+	})
+}
+
+func (h *kindHandlers) streamActions(params actions.ActionsListParams,
+	principal *models.Principal, limit *int64, where *filters.LocalFilter,
+	underscores traverser.UnderscoreProperties) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		stream := newNDJSONStream(rw)
+		err := h.manager.StreamActions(params.HTTPRequest.Context(), principal,
+			limit, derefString(params.Class), where, underscores,
+			func(action *models.Action) error {
+				schemaMap, ok := action.Schema.(map[string]interface{})
+				if ok {
+					action.Schema = h.extendSchemaWithAPILinks(schemaMap)
+				}
+
+				return stream.write(action)
+			})
+		if err != nil && stream.started {
+			h.logger.WithField("action", "actions_list_stream").WithError(err).
+				Error("streaming actions aborted")
+		}
+
+		stream.finish(err, actionsListErrorResponse, p)
+	})
+}
@@ -13,15 +13,31 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -208,6 +224,39 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 					},
 				}},
 			},
+			test{
+				name: "with an expanded ref prop - nested refs are extended as well",
+				thing: &models.Thing{Class: "Foo", Schema: map[string]interface{}{
+					"someRef": models.MultipleRef{
+						&models.SingleRef{
+							Beacon: "weaviate://localhost/things/85f78e29-5937-4390-a121-5379f262b4e5",
+							Schema: map[string]interface{}{
+								"otherRef": models.MultipleRef{
+									&models.SingleRef{
+										Beacon: "weaviate://localhost/actions/4a8ba7bc-b3d1-4c5b-bb8e-4a1b2c5e0d11",
+									},
+								},
+							},
+						},
+					},
+				}},
+				expectedResult: &models.Thing{Class: "Foo", Schema: map[string]interface{}{
+					"someRef": models.MultipleRef{
+						&models.SingleRef{
+							Beacon: "weaviate://localhost/things/85f78e29-5937-4390-a121-5379f262b4e5",
+							Href:   "/v1/things/85f78e29-5937-4390-a121-5379f262b4e5",
+							Schema: map[string]interface{}{
+								"otherRef": models.MultipleRef{
+									&models.SingleRef{
+										Beacon: "weaviate://localhost/actions/4a8ba7bc-b3d1-4c5b-bb8e-4a1b2c5e0d11",
+										Href:   "/v1/actions/4a8ba7bc-b3d1-4c5b-bb8e-4a1b2c5e0d11",
+									},
+								},
+							},
+						},
+					},
+				}},
+			},
 		}
 
 		for _, test := range tests {
@@ -378,6 +427,21 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		}
 	})
 
+	t.Run("update thing with changedOnly", func(t *testing.T) {
+		changedOnly := true
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.updateThing(things.ThingsUpdateParams{
+			HTTPRequest: httptest.NewRequest("PUT", "/v1/things", nil),
+			Body: &models.Thing{Class: "Foo", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+				Schema: map[string]interface{}{"name": "unchanged"}},
+			ChangedOnly: &changedOnly,
+		}, nil)
+		parsed, ok := res.(*things.ThingsUpdateOK)
+		require.True(t, ok)
+		assert.Equal(t, &models.Thing{Class: "Foo", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+			parsed.Payload)
+	})
+
 	t.Run("add action", func(t *testing.T) {
 		type test struct {
 			name           string
@@ -662,6 +726,761 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 
 }
 
+func TestListWithWhereFilter(t *testing.T) {
+	h := &kindHandlers{manager: &fakeManager{}}
+	req := httptest.NewRequest("GET", "/v1/things", nil)
+
+	t.Run("with a valid filter", func(t *testing.T) {
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":"Equal","path":["name"],"valueString":"bar"}`),
+		}, nil)
+		_, ok := res.(*things.ThingsListOK)
+		assert.True(t, ok)
+	})
+
+	t.Run("with invalid json", func(t *testing.T) {
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":`),
+		}, nil)
+		_, ok := res.(*things.ThingsListBadRequest)
+		assert.True(t, ok)
+	})
+
+	t.Run("with an unknown operator", func(t *testing.T) {
+		res := h.getActions(actions.ActionsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":"Almost","path":["name"],"valueString":"bar"}`),
+		}, nil)
+		_, ok := res.(*actions.ActionsListBadRequest)
+		assert.True(t, ok)
+	})
+
+	t.Run("without a class", func(t *testing.T) {
+		res := h.getActions(actions.ActionsListParams{
+			HTTPRequest: req,
+			Where:       ptString(`{"operator":"Equal","path":["name"],"valueString":"bar"}`),
+		}, nil)
+		_, ok := res.(*actions.ActionsListBadRequest)
+		assert.True(t, ok)
+	})
+}
+
+func TestListCountOnly(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things?count=true", nil)
+
+	t.Run("counting things with a filter", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{countReturn: 17}}
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":"Equal","path":["name"],"valueString":"bar"}`),
+			Count:       ptBool(true),
+		}, nil)
+		parsed, ok := res.(*things.ThingsListOK)
+		require.True(t, ok)
+		assert.Equal(t, ptInt64(17), parsed.Payload.TotalResults)
+		assert.Len(t, parsed.Payload.Things, 0)
+	})
+
+	t.Run("counting actions ignores the limit", func(t *testing.T) {
+		rejecting := config.QueryDefaults{Limit: 20, MaxLimit: 100, RejectExceedingLimit: true}
+		h := &kindHandlers{manager: &fakeManager{countReturn: 3},
+			config: config.Config{QueryDefaults: rejecting}}
+		res := h.getActions(actions.ActionsListParams{
+			HTTPRequest: req,
+			Limit:       ptInt64(5000),
+			Count:       ptBool(true),
+		}, nil)
+		parsed, ok := res.(*actions.ActionsListOK)
+		require.True(t, ok)
+		assert.Equal(t, ptInt64(3), parsed.Payload.TotalResults)
+	})
+}
+
+func TestListTotalResults(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things", nil)
+
+	t.Run("the total is independent of the page size", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getThingsReturn: []*models.Thing{{}, {}},
+			countReturn:     4567,
+		}}
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":"Equal","path":["name"],"valueString":"bar"}`),
+			Limit:       ptInt64(2),
+		}, nil)
+		parsed, ok := res.(*things.ThingsListOK)
+		require.True(t, ok)
+		assert.Len(t, parsed.Payload.Things, 2)
+		assert.Equal(t, ptInt64(4567), parsed.Payload.TotalResults)
+	})
+
+	t.Run("the total is omitted if turned off", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getActionsReturn: []*models.Action{{}, {}},
+			countReturn:      4567,
+		}}
+		res := h.getActions(actions.ActionsListParams{
+			HTTPRequest:  req,
+			IncludeTotal: ptBool(false),
+		}, nil)
+		parsed, ok := res.(*actions.ActionsListOK)
+		require.True(t, ok)
+		assert.Len(t, parsed.Payload.Actions, 2)
+		assert.Nil(t, parsed.Payload.TotalResults)
+	})
+}
+
+func TestListAfterCursor(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things?class=Foo&after=00000000-0000-0000-0000-000000000000", nil)
+	start := strfmt.UUID("00000000-0000-0000-0000-000000000000")
+	next := strfmt.UUID("6dde1a39-4a9e-4e8b-8a74-8bbc4f4e8e6a")
+
+	t.Run("listing a page of things", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getThingsReturn:  []*models.Thing{{ID: next}},
+			nextCursorReturn: next,
+		}}
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			After:       &start,
+		}, nil)
+		parsed, ok := res.(*things.ThingsListOK)
+		require.True(t, ok)
+		assert.Len(t, parsed.Payload.Things, 1)
+		assert.Equal(t, next, parsed.Payload.NextCursor)
+	})
+
+	t.Run("listing the last page of actions", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getActionsReturn: []*models.Action{{ID: next}},
+		}}
+		res := h.getActions(actions.ActionsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			After:       &next,
+		}, nil)
+		parsed, ok := res.(*actions.ActionsListOK)
+		require.True(t, ok)
+		assert.Len(t, parsed.Payload.Actions, 1)
+		assert.Equal(t, strfmt.UUID(""), parsed.Payload.NextCursor)
+	})
+
+	t.Run("combined with a where filter", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.getThings(things.ThingsListParams{
+			HTTPRequest: req,
+			Class:       ptString("Foo"),
+			Where:       ptString(`{"operator":"Equal","path":["name"],"valueString":"bar"}`),
+			After:       &start,
+		}, nil)
+		_, ok := res.(*things.ThingsListBadRequest)
+		assert.True(t, ok)
+	})
+}
+
+func TestListLimits(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things", nil)
+	defaults := config.QueryDefaults{Limit: 20, MaxLimit: 100}
+
+	t.Run("without a limit", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}, config: config.Config{QueryDefaults: defaults}}
+		res := h.getThings(things.ThingsListParams{HTTPRequest: req}, nil)
+		parsed, ok := res.(*things.ThingsListOK)
+		require.True(t, ok)
+		assert.Equal(t, int64(20), parsed.Payload.Limit)
+	})
+
+	t.Run("with a limit below the maximum", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}, config: config.Config{QueryDefaults: defaults}}
+		res := h.getActions(actions.ActionsListParams{HTTPRequest: req, Limit: ptInt64(50)}, nil)
+		parsed, ok := res.(*actions.ActionsListOK)
+		require.True(t, ok)
+		assert.Equal(t, int64(50), parsed.Payload.Limit)
+	})
+
+	t.Run("with a limit above the maximum", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}, config: config.Config{QueryDefaults: defaults}}
+		res := h.getThings(things.ThingsListParams{HTTPRequest: req, Limit: ptInt64(5000)}, nil)
+		parsed, ok := res.(*things.ThingsListOK)
+		require.True(t, ok)
+		assert.Equal(t, int64(100), parsed.Payload.Limit)
+	})
+
+	t.Run("with a limit above the maximum and rejection turned on", func(t *testing.T) {
+		rejecting := defaults
+		rejecting.RejectExceedingLimit = true
+		h := &kindHandlers{manager: &fakeManager{}, config: config.Config{QueryDefaults: rejecting}}
+		res := h.getActions(actions.ActionsListParams{HTTPRequest: req, Limit: ptInt64(5000)}, nil)
+		parsed, ok := res.(*actions.ActionsListBadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "limit 5000 exceeds the maximum limit of 100",
+			parsed.Payload.Error[0].Message)
+	})
+
+	t.Run("with a negative limit", func(t *testing.T) {
+		negative := httptest.NewRequest("GET", "/v1/things?limit=-1", nil)
+		for _, params := range []interface {
+			BindRequest(*http.Request, *middleware.MatchedRoute) error
+		}{
+			&things.ThingsListParams{},
+			&actions.ActionsListParams{},
+		} {
+			err := params.BindRequest(negative, &middleware.MatchedRoute{})
+			require.NotNil(t, err)
+			parsed, ok := err.(errors.Error)
+			require.True(t, ok)
+			assert.Equal(t, int32(http.StatusUnprocessableEntity), parsed.Code())
+		}
+	})
+}
+
+func TestGetByIDs(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/batching/things/get", nil)
+	found := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	missing := strfmt.UUID("f2f3b2b1-6f4c-4b6a-9c3e-0b7f2b6a1f11")
+
+	t.Run("with found and missing things", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getThingsReturn: []*models.Thing{{ID: found, Class: "Foo"}},
+			notFoundReturn:  []strfmt.UUID{missing},
+		}}
+		res := h.getThingsByIDs(batching.BatchingThingsGetParams{
+			HTTPRequest: req,
+			Body:        &models.BatchGetRequest{Ids: []strfmt.UUID{found, missing}},
+		}, nil)
+		parsed, ok := res.(*batching.BatchingThingsGetOK)
+		require.True(t, ok)
+		require.Len(t, parsed.Payload.Things, 1)
+		assert.Equal(t, found, parsed.Payload.Things[0].ID)
+		assert.Equal(t, []strfmt.UUID{missing}, parsed.Payload.NotFound)
+	})
+
+	t.Run("with an invalid include param", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.getActionsByIDs(batching.BatchingActionsGetParams{
+			HTTPRequest: req,
+			Body:        &models.BatchGetRequest{Ids: []strfmt.UUID{found}},
+			Include:     ptString("_unknown"),
+		}, nil)
+		_, ok := res.(*batching.BatchingActionsGetBadRequest)
+		assert.True(t, ok)
+	})
+}
+
+func TestResolveBeacons(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/batching/references/resolve", nil)
+	thingBeacon := strfmt.URI("weaviate://localhost/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	actionBeacon := strfmt.URI("weaviate://localhost/actions/99ee9968-22ec-416a-9032-cff80f2f7fdf")
+	missingBeacon := strfmt.URI("weaviate://localhost/things/f2f3b2b1-6f4c-4b6a-9c3e-0b7f2b6a1f11")
+	invalidBeacon := strfmt.URI("weaviate://localhost/things/foo")
+
+	t.Run("with resolved, missing and invalid beacons", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			resolveReturn: []kinds.ResolvedBeacon{
+				{Beacon: thingBeacon, Thing: &models.Thing{ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc", Class: "Foo"}},
+				{Beacon: actionBeacon, Action: &models.Action{ID: "99ee9968-22ec-416a-9032-cff80f2f7fdf", Class: "Bar"}},
+				{Beacon: missingBeacon},
+				{Beacon: invalidBeacon, Err: fmt.Errorf("invalid cref URI")},
+			},
+		}}
+		res := h.resolveBeacons(batching.BatchingReferencesResolveParams{
+			HTTPRequest: req,
+			Body: &models.BeaconsResolveRequest{
+				Beacons: []strfmt.URI{thingBeacon, actionBeacon, missingBeacon, invalidBeacon},
+			},
+		}, nil)
+		parsed, ok := res.(*batching.BatchingReferencesResolveOK)
+		require.True(t, ok)
+		results := parsed.Payload.Results
+		require.Len(t, results, 4)
+
+		assert.Equal(t, models.BeaconResolveResultStatusSUCCESS, results[0].Status)
+		assert.Equal(t, "Foo", results[0].Thing.Class)
+		assert.Equal(t, models.BeaconResolveResultStatusSUCCESS, results[1].Status)
+		assert.Equal(t, "Bar", results[1].Action.Class)
+		assert.Equal(t, models.BeaconResolveResultStatusNOTFOUND, results[2].Status)
+		assert.Equal(t, missingBeacon, results[2].Beacon)
+		assert.Equal(t, models.BeaconResolveResultStatusFAILED, results[3].Status)
+		assert.Equal(t, "invalid cref URI", results[3].Errors.Error[0].Message)
+	})
+
+	t.Run("with an invalid include param", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.resolveBeacons(batching.BatchingReferencesResolveParams{
+			HTTPRequest: req,
+			Body:        &models.BeaconsResolveRequest{Beacons: []strfmt.URI{thingBeacon}},
+			Include:     ptString("_unknown"),
+		}, nil)
+		_, ok := res.(*batching.BatchingReferencesResolveBadRequest)
+		assert.True(t, ok)
+	})
+}
+
+func TestListReferences(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/things/9a4cd8dc-2c76-4b4b-8f5a-5e1bd6d7b0a1/references", nil)
+	targetID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	beacon := strfmt.URI("weaviate://localhost/things/" + targetID)
+	props := func() []kinds.PropertyReferences {
+		return []kinds.PropertyReferences{{
+			Property: "hasBaz",
+			References: []kinds.ListedReference{{
+				Beacon: beacon,
+				Ref:    crossref.New("localhost", targetID, kind.Thing),
+				Thing:  &models.Thing{ID: targetID, Class: "Baz"},
+			}},
+		}}
+	}
+
+	t.Run("only the beacons", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{referencesReturn: props()}}
+		res := h.listThingReferences(things.ThingsReferencesListParams{
+			HTTPRequest: req,
+			ID:          "9a4cd8dc-2c76-4b4b-8f5a-5e1bd6d7b0a1",
+		}, nil)
+		parsed, ok := res.(*things.ThingsReferencesListOK)
+		require.True(t, ok)
+		require.Len(t, parsed.Payload.Properties, 1)
+		assert.Equal(t, "hasBaz", parsed.Payload.Properties[0].Name)
+		assert.Equal(t, &models.ListedReference{
+			Beacon: beacon,
+			Thing:  &models.Thing{ID: targetID, Class: "Baz"},
+		}, parsed.Payload.Properties[0].References[0])
+	})
+
+	t.Run("with parsed beacons", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{referencesReturn: props()}}
+		res := h.listActionReferences(actions.ActionsReferencesListParams{
+			HTTPRequest: req,
+			ID:          "9a4cd8dc-2c76-4b4b-8f5a-5e1bd6d7b0a1",
+			Parse:       ptBool(true),
+		}, nil)
+		parsed, ok := res.(*actions.ActionsReferencesListOK)
+		require.True(t, ok)
+		ref := parsed.Payload.Properties[0].References[0]
+		assert.Equal(t, "thing", ref.Kind)
+		assert.Equal(t, targetID, ref.ID)
+	})
+}
+
+func TestCreateWithExistingID(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/things", nil)
+	existing := &models.Thing{
+		ID:     "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+		Class:  "Foo",
+		Schema: map[string]interface{}{"name": "existing"},
+	}
+
+	t.Run("without ifNotExists", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			addErr:         kinds.NewErrAlreadyExists("id '%s' already exists", existing.ID),
+			getThingReturn: existing,
+		}}
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Thing{ID: existing.ID, Class: "Foo"},
+		}, nil)
+		_, ok := res.(*things.ThingsCreateConflict)
+		assert.True(t, ok)
+	})
+
+	t.Run("with ifNotExists", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			addErr:         kinds.NewErrAlreadyExists("id '%s' already exists", existing.ID),
+			getThingReturn: existing,
+		}}
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Thing{ID: existing.ID, Class: "Foo"},
+			IfNotExists: ptBool(true),
+		}, nil)
+		parsed, ok := res.(*things.ThingsCreateOK)
+		require.True(t, ok)
+		assert.Equal(t, existing, parsed.Payload)
+	})
+
+	t.Run("with ifNotExists on an action", func(t *testing.T) {
+		existingAction := &models.Action{ID: existing.ID, Class: "Bar"}
+		h := &kindHandlers{manager: &fakeManager{
+			addErr:          kinds.NewErrAlreadyExists("id '%s' already exists", existing.ID),
+			getActionReturn: existingAction,
+		}}
+		res := h.addAction(actions.ActionsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Action{ID: existing.ID, Class: "Bar"},
+			IfNotExists: ptBool(true),
+		}, nil)
+		parsed, ok := res.(*actions.ActionsCreateOK)
+		require.True(t, ok)
+		assert.Equal(t, existingAction, parsed.Payload)
+	})
+}
+
+func TestCreateWhileUnavailable(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/things", nil)
+	h := &kindHandlers{manager: &fakeManager{
+		addErr: kinds.NewErrUnavailable(5*time.Second, "class is being resharded"),
+	}}
+
+	res := h.addThing(things.ThingsCreateParams{
+		HTTPRequest: req,
+		Body:        &models.Thing{Class: "Foo"},
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	res.WriteResponse(rec, runtime.JSONProducer())
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "5", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), "class is being resharded")
+}
+
+func TestCreateWithTTL(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/things", nil)
+	ttl := func(in int64) *int64 { return &in }
+
+	t.Run("with a positive ttl", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		before := time.Now().UnixNano() / int64(time.Millisecond)
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Thing{Class: "Foo"},
+			TTL:         ttl(60),
+		}, nil)
+		parsed, ok := res.(*things.ThingsCreateOK)
+		require.True(t, ok)
+		assert.GreaterOrEqual(t, parsed.Payload.ExpiryTimeUnix, before+60*1000)
+		assert.Less(t, parsed.Payload.ExpiryTimeUnix, before+61*1000)
+	})
+
+	t.Run("with a negative ttl", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.addAction(actions.ActionsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Action{Class: "Bar"},
+			TTL:         ttl(-1),
+		}, nil)
+		_, ok := res.(*actions.ActionsCreateUnprocessableEntity)
+		assert.True(t, ok)
+	})
+
+	t.Run("with a ttl and an expiry time", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Thing{Class: "Foo", ExpiryTimeUnix: 1},
+			TTL:         ttl(60),
+		}, nil)
+		_, ok := res.(*things.ThingsCreateUnprocessableEntity)
+		assert.True(t, ok)
+	})
+}
+
+func TestCreateWithStrongConsistency(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/things", nil)
+	thing := &models.Thing{ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc", Class: "Foo"}
+
+	t.Run("without consistency", func(t *testing.T) {
+		manager := &fakeManager{}
+		h := &kindHandlers{manager: manager}
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        thing,
+		}, nil)
+		_, ok := res.(*things.ThingsCreateOK)
+		assert.True(t, ok)
+		assert.Len(t, manager.awaited, 0)
+	})
+
+	t.Run("with strong consistency", func(t *testing.T) {
+		manager := &fakeManager{}
+		h := &kindHandlers{manager: manager}
+		res := h.addThing(things.ThingsCreateParams{
+			HTTPRequest: req,
+			Body:        thing,
+			Consistency: ptString(kinds.ConsistencyStrong),
+		}, nil)
+		_, ok := res.(*things.ThingsCreateOK)
+		assert.True(t, ok)
+		assert.Equal(t, []strfmt.UUID{thing.ID}, manager.awaited)
+	})
+
+	t.Run("with strong consistency when the action never becomes readable", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		manager := &fakeManager{awaitErr: kinds.NewErrInternal("not readable")}
+		h := &kindHandlers{manager: manager, logger: logger}
+		res := h.addAction(actions.ActionsCreateParams{
+			HTTPRequest: req,
+			Body:        &models.Action{ID: thing.ID, Class: "Bar"},
+			Consistency: ptString(kinds.ConsistencyStrong),
+		}, nil)
+
+		// the action was written, so the create must not be reported as failed
+		parsed, ok := res.(*actions.ActionsCreateOK)
+		require.True(t, ok)
+		assert.Equal(t, thing.ID, parsed.Payload.ID)
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, "restapi_await_consistency", hook.LastEntry().Data["action"])
+	})
+}
+
+func TestBulkUpdateReferences(t *testing.T) {
+	req := httptest.NewRequest("PUT", "/v1/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc/references", nil)
+	body := models.PropertyReferences{
+		"hasAnimals": models.MultipleRef{
+			&models.SingleRef{Beacon: "weaviate://localhost/things/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7"},
+		},
+	}
+
+	t.Run("successfully", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.bulkUpdateThingReferences(things.ThingsReferencesBulkUpdateParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, nil)
+		_, ok := res.(*things.ThingsReferencesBulkUpdateOK)
+		assert.True(t, ok)
+	})
+
+	t.Run("with an invalid property", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			updateRefsErr: kinds.NewErrInvalidUserInput("property 'name' is a primitive datatype"),
+		}}
+		res := h.bulkUpdateThingReferences(things.ThingsReferencesBulkUpdateParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, nil)
+		_, ok := res.(*things.ThingsReferencesBulkUpdateUnprocessableEntity)
+		assert.True(t, ok)
+	})
+
+	t.Run("with a missing action", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			updateRefsErr: kinds.NewErrNotFound("no object with id '%s'", "foo"),
+		}}
+		res := h.bulkUpdateActionReferences(actions.ActionsReferencesBulkUpdateParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, nil)
+		_, ok := res.(*actions.ActionsReferencesBulkUpdateUnprocessableEntity)
+		assert.True(t, ok)
+	})
+}
+
+func TestListAsNDJSON(t *testing.T) {
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		req.Header.Set("Accept", "application/x-ndjson")
+		return req
+	}
+
+	t.Run("streaming things", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			getThingsReturn: []*models.Thing{
+				&models.Thing{Class: "Foo", Schema: map[string]interface{}{"name": "one"}},
+				&models.Thing{Class: "Foo", Schema: map[string]interface{}{"name": "two"}},
+			},
+		}}
+		rec := httptest.NewRecorder()
+		h.getThings(things.ThingsListParams{HTTPRequest: newRequest()}, nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"name":"one"`)
+		assert.Contains(t, lines[1], `"name":"two"`)
+	})
+
+	t.Run("streaming actions without results", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		rec := httptest.NewRecorder()
+		h.getActions(actions.ActionsListParams{HTTPRequest: newRequest()}, nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "", rec.Body.String())
+	})
+
+	t.Run("failing before the first object", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			streamErr: kinds.NewErrInvalidUserInput("invalid"),
+		}}
+		rec := httptest.NewRecorder()
+		h.getThings(things.ThingsListParams{HTTPRequest: newRequest()}, nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("failing after the first object", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		h := &kindHandlers{
+			manager: &fakeManager{
+				getThingsReturn: []*models.Thing{&models.Thing{Class: "Foo"}},
+				streamErr:       fmt.Errorf("disk on fire"),
+			},
+			logger: logger,
+		}
+		rec := httptest.NewRecorder()
+		h.getThings(things.ThingsListParams{HTTPRequest: newRequest()}, nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[1], "disk on fire")
+	})
+}
+
+func TestShardSnapshots(t *testing.T) {
+	snapshotParams := func() meta.MetaShardSnapshotParams {
+		return meta.MetaShardSnapshotParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/meta/snapshots/Foo/single", nil),
+			ClassName:   "Foo",
+			ShardName:   "single",
+		}
+	}
+
+	restoreParams := func() meta.MetaShardRestoreParams {
+		return meta.MetaShardRestoreParams{
+			HTTPRequest: httptest.NewRequest("PUT", "/v1/meta/snapshots/Foo/single", nil),
+			ClassName:   "Foo",
+			ShardName:   "single",
+			Body:        ioutil.NopCloser(strings.NewReader("tar")),
+		}
+	}
+
+	t.Run("streaming a snapshot", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{snapshotReturn: []byte("tar")}}
+		rec := httptest.NewRecorder()
+		h.snapshotShard(snapshotParams(), nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-tar", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "tar", rec.Body.String())
+	})
+
+	t.Run("failing before the snapshot is streamed", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			snapshotErr: kinds.NewErrNotFound("shard 'single' not found"),
+		}}
+		rec := httptest.NewRecorder()
+		h.snapshotShard(snapshotParams(), nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), "shard 'single' not found")
+	})
+
+	t.Run("failing while the snapshot is streamed", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		h := &kindHandlers{
+			manager: &fakeManager{
+				snapshotReturn: []byte("ta"),
+				snapshotErr:    fmt.Errorf("disk on fire"),
+			},
+			logger: logger,
+		}
+		rec := httptest.NewRecorder()
+		h.snapshotShard(snapshotParams(), nil).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "ta", rec.Body.String())
+	})
+
+	t.Run("restoring a snapshot", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}}
+		res := h.restoreShard(restoreParams(), nil)
+
+		assert.IsType(t, &meta.MetaShardRestoreOK{}, res)
+	})
+
+	t.Run("restoring a malformed snapshot", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			restoreErr: kinds.NewErrInvalidUserInput("snapshot is missing an entry"),
+		}}
+		res := h.restoreShard(restoreParams(), nil)
+
+		assert.IsType(t, &meta.MetaShardRestoreUnprocessableEntity{}, res)
+	})
+
+	t.Run("restoring a snapshot of a frozen class", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{
+			restoreErr: kinds.NewErrReadOnly("class 'Foo' is frozen"),
+		}}
+		res := h.restoreShard(restoreParams(), nil)
+
+		assert.IsType(t, &meta.MetaShardRestoreForbidden{}, res)
+	})
+}
+
+func TestParseIncludeParamWithFeatureProjectionArgs(t *testing.T) {
+	t.Run("without arguments", func(t *testing.T) {
+		res, err := parseIncludeParam(ptString("_vector,_featureProjection"))
+		require.Nil(t, err)
+		assert.True(t, res.Vector)
+		require.NotNil(t, res.FeatureProjection)
+		assert.True(t, res.FeatureProjection.Enabled)
+		assert.Nil(t, res.FeatureProjection.Dimensions)
+	})
+
+	t.Run("with arguments", func(t *testing.T) {
+		res, err := parseIncludeParam(ptString(
+			"_featureProjection(dimensions:3, iterations:200,perplexity:4,learningRate:20),_vector"))
+		require.Nil(t, err)
+		assert.True(t, res.Vector)
+		require.NotNil(t, res.FeatureProjection)
+		assert.Equal(t, 3, *res.FeatureProjection.Dimensions)
+		assert.Equal(t, 200, *res.FeatureProjection.Iterations)
+		assert.Equal(t, 4, *res.FeatureProjection.Perplexity)
+		assert.Equal(t, 20, *res.FeatureProjection.LearningRate)
+	})
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"dimensions out of range", "_featureProjection(dimensions:4)"},
+		{"iterations out of range", "_featureProjection(iterations:0)"},
+		{"perplexity not an int", "_featureProjection(perplexity:high)"},
+		{"unknown argument", "_featureProjection(foo:3)"},
+		{"argument without value", "_featureProjection(dimensions)"},
+		{"unbalanced parentheses", "_featureProjection(dimensions:3"},
+		{"arguments on a prop without arguments", "_vector(dimensions:3)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseIncludeParam(ptString(test.input))
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func ptString(in string) *string {
+	return &in
+}
+
+func ptInt64(in int64) *int64 {
+	return &in
+}
+
+func ptBool(in bool) *bool {
+	return &in
+}
+
 type fakeManager struct {
 	getThingReturn     *models.Thing
 	getActionReturn    *models.Action
@@ -669,18 +1488,47 @@ type fakeManager struct {
 	addActionReturn    *models.Action
 	getThingsReturn    []*models.Thing
 	getActionsReturn   []*models.Action
+	notFoundReturn     []strfmt.UUID
+	resolveReturn      []kinds.ResolvedBeacon
+	referencesReturn   []kinds.PropertyReferences
+	countReturn        int64
+	nextCursorReturn   strfmt.UUID
 	updateThingReturn  *models.Thing
 	updateActionReturn *models.Action
+	addErr             error
+	awaitErr           error
+	awaited            []strfmt.UUID
+	streamErr          error
+	updateRefsErr      error
+	snapshotReturn     []byte
+	snapshotErr        error
+	restoreErr         error
 }
 
 func (f *fakeManager) AddThing(_ context.Context, _ *models.Principal, thing *models.Thing) (*models.Thing, error) {
+	if f.addErr != nil {
+		return nil, f.addErr
+	}
 	return thing, nil
 }
 
 func (f *fakeManager) AddAction(_ context.Context, _ *models.Principal, action *models.Action) (*models.Action, error) {
+	if f.addErr != nil {
+		return nil, f.addErr
+	}
 	return action, nil
 }
 
+func (f *fakeManager) AwaitThing(_ context.Context, _ *models.Principal, id strfmt.UUID) error {
+	f.awaited = append(f.awaited, id)
+	return f.awaitErr
+}
+
+func (f *fakeManager) AwaitAction(_ context.Context, _ *models.Principal, id strfmt.UUID) error {
+	f.awaited = append(f.awaited, id)
+	return f.awaitErr
+}
+
 func (f *fakeManager) ValidateThing(_ context.Context, _ *models.Principal, _ *models.Thing) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -689,22 +1537,89 @@ func (f *fakeManager) ValidateAction(_ context.Context, _ *models.Principal, _ *
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) GetThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.UnderscoreProperties) (*models.Thing, error) {
+func (f *fakeManager) GetThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.UnderscoreProperties, _ kinds.ExpandParams) (*models.Thing, error) {
 	return f.getThingReturn, nil
 }
 
-func (f *fakeManager) GetAction(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.UnderscoreProperties) (*models.Action, error) {
+func (f *fakeManager) GetAction(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.UnderscoreProperties, _ kinds.ExpandParams) (*models.Action, error) {
 	return f.getActionReturn, nil
 }
 
-func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _ *int64, _ traverser.UnderscoreProperties) ([]*models.Thing, error) {
+func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _ *int64, _ string,
+	_ *filters.LocalFilter, _ traverser.UnderscoreProperties) ([]*models.Thing, error) {
 	return f.getThingsReturn, nil
 }
 
-func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _ *int64, _ traverser.UnderscoreProperties) ([]*models.Action, error) {
+func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _ *int64, _ string,
+	_ *filters.LocalFilter, _ traverser.UnderscoreProperties) ([]*models.Action, error) {
 	return f.getActionsReturn, nil
 }
 
+func (f *fakeManager) GetThingsByIDs(_ context.Context, _ *models.Principal, _ []strfmt.UUID,
+	_ traverser.UnderscoreProperties, _ kinds.BatchExpandParams) ([]*models.Thing, []strfmt.UUID, error) {
+	return f.getThingsReturn, f.notFoundReturn, nil
+}
+
+func (f *fakeManager) GetActionsByIDs(_ context.Context, _ *models.Principal, _ []strfmt.UUID,
+	_ traverser.UnderscoreProperties, _ kinds.BatchExpandParams) ([]*models.Action, []strfmt.UUID, error) {
+	return f.getActionsReturn, f.notFoundReturn, nil
+}
+
+func (f *fakeManager) CountThings(_ context.Context, _ *models.Principal, _ string,
+	_ *filters.LocalFilter) (int64, error) {
+	return f.countReturn, nil
+}
+
+func (f *fakeManager) CountActions(_ context.Context, _ *models.Principal, _ string,
+	_ *filters.LocalFilter) (int64, error) {
+	return f.countReturn, nil
+}
+
+func (f *fakeManager) GetThingsAfter(_ context.Context, _ *models.Principal, _ *int64,
+	_ string, _ strfmt.UUID) ([]*models.Thing, strfmt.UUID, error) {
+	return f.getThingsReturn, f.nextCursorReturn, nil
+}
+
+func (f *fakeManager) GetActionsAfter(_ context.Context, _ *models.Principal, _ *int64,
+	_ string, _ strfmt.UUID) ([]*models.Action, strfmt.UUID, error) {
+	return f.getActionsReturn, f.nextCursorReturn, nil
+}
+
+func (f *fakeManager) ResolveBeacons(_ context.Context, _ *models.Principal, _ []strfmt.URI,
+	_ traverser.UnderscoreProperties) ([]kinds.ResolvedBeacon, error) {
+	return f.resolveReturn, nil
+}
+
+func (f *fakeManager) GetThingReferences(_ context.Context, _ *models.Principal,
+	_ strfmt.UUID, _ bool) ([]kinds.PropertyReferences, error) {
+	return f.referencesReturn, nil
+}
+
+func (f *fakeManager) GetActionReferences(_ context.Context, _ *models.Principal,
+	_ strfmt.UUID, _ bool) ([]kinds.PropertyReferences, error) {
+	return f.referencesReturn, nil
+}
+
+func (f *fakeManager) StreamThings(_ context.Context, _ *models.Principal, _ *int64, _ string,
+	_ *filters.LocalFilter, _ traverser.UnderscoreProperties, fn func(*models.Thing) error) error {
+	for _, thing := range f.getThingsReturn {
+		if err := fn(thing); err != nil {
+			return err
+		}
+	}
+	return f.streamErr
+}
+
+func (f *fakeManager) StreamActions(_ context.Context, _ *models.Principal, _ *int64, _ string,
+	_ *filters.LocalFilter, _ traverser.UnderscoreProperties, fn func(*models.Action) error) error {
+	for _, action := range f.getActionsReturn {
+		if err := fn(action); err != nil {
+			return err
+		}
+	}
+	return f.streamErr
+}
+
 func (f *fakeManager) UpdateThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, thing *models.Thing) (*models.Thing, error) {
 	return thing, nil
 }
@@ -713,11 +1628,19 @@ func (f *fakeManager) UpdateAction(_ context.Context, _ *models.Principal, _ str
 	return action, nil
 }
 
-func (f *fakeManager) MergeThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ *models.Thing) error {
+func (f *fakeManager) UpdateThingChangedFields(_ context.Context, _ *models.Principal, _ strfmt.UUID, thing *models.Thing) (*models.Thing, error) {
+	return &models.Thing{Class: thing.Class, ID: thing.ID}, nil
+}
+
+func (f *fakeManager) UpdateActionChangedFields(_ context.Context, _ *models.Principal, _ strfmt.UUID, action *models.Action) (*models.Action, error) {
+	return &models.Action{Class: action.Class, ID: action.ID}, nil
+}
+
+func (f *fakeManager) MergeThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ *models.Thing, _ bool) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) MergeAction(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ *models.Action) error {
+func (f *fakeManager) MergeAction(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ *models.Action, _ bool) error {
 	panic("not implemented") // TODO: Implement
 }
 
@@ -745,6 +1668,14 @@ func (f *fakeManager) UpdateActionReferences(_ context.Context, _ *models.Princi
 	panic("not implemented") // TODO: Implement
 }
 
+func (f *fakeManager) BulkUpdateThingReferences(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ models.PropertyReferences) error {
+	return f.updateRefsErr
+}
+
+func (f *fakeManager) BulkUpdateActionReferences(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ models.PropertyReferences) error {
+	return f.updateRefsErr
+}
+
 func (f *fakeManager) DeleteThingReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -752,3 +1683,38 @@ func (f *fakeManager) DeleteThingReference(_ context.Context, _ *models.Principa
 func (f *fakeManager) DeleteActionReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef) error {
 	panic("not implemented") // TODO: Implement
 }
+
+func (f *fakeManager) ReindexClass(_ context.Context, _ *models.Principal, _ string, _ bool) (*models.ReindexStatus, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) GetReindexStatus(_ context.Context, _ *models.Principal, _ string) (*models.ReindexStatus, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) ReshardClass(_ context.Context, _ *models.Principal, _ string, _ models.ShardingConfig) (*models.ReshardStatus, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) GetReshardStatus(_ context.Context, _ *models.Principal, _ string) (*models.ReshardStatus, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) GetClassCounts(_ context.Context, _ *models.Principal) (*models.ClassCounts, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) CompactStorage(_ context.Context, _ *models.Principal) ([]*models.ShardCompaction, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (f *fakeManager) SnapshotShard(_ context.Context, _ *models.Principal, _ string, _ string, w io.Writer) error {
+	if _, err := w.Write(f.snapshotReturn); err != nil {
+		return err
+	}
+	return f.snapshotErr
+}
+
+func (f *fakeManager) RestoreShard(_ context.Context, _ *models.Principal, _ string, _ string, _ io.Reader) error {
+	return f.restoreErr
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/clients/contextionary"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/well_known"
@@ -42,8 +43,21 @@ type c11yMetaProvider interface {
 	WordCount(ctx context.Context) (int64, error)
 }
 
+// c11yCacheStatsProvider is implemented by contextionary clients which keep
+// a local word vector cache
+type c11yCacheStatsProvider interface {
+	CacheStats() contextionary.CacheStats
+}
+
+// vectorIndexStatsProvider is only present with the standalone storage, as
+// it reads from the vector indices owned by weaviate itself
+type vectorIndexStatsProvider interface {
+	VectorIndexStats(ctx context.Context) ([]*models.VectorIndexStats, error)
+}
+
 func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.WeaviateConfig,
-	network network.Network, schemaManager schemaManager, c11y c11yMetaProvider) {
+	network network.Network, schemaManager schemaManager, c11y c11yMetaProvider,
+	vectorIndexStats vectorIndexStatsProvider) {
 
 	var swj swaggerJSON
 	err := json.Unmarshal(SwaggerJSON, &swj)
@@ -70,9 +84,34 @@ func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.Weaviat
 			ContextionaryWordCount: c11yWordCount,
 		}
 
+		if cached, ok := c11y.(c11yCacheStatsProvider); ok {
+			stats := cached.CacheStats()
+			res.ContextionaryCache = &models.ContextionaryCacheStats{
+				Hits:      stats.Hits,
+				Misses:    stats.Misses,
+				Evictions: stats.Evictions,
+				Size:      stats.Size,
+			}
+		}
+
 		return meta.NewMetaGetOK().WithPayload(res)
 	})
 
+	api.MetaMetaVectorIndexStatsHandler = meta.MetaVectorIndexStatsHandlerFunc(
+		func(params meta.MetaVectorIndexStatsParams, principal *models.Principal) middleware.Responder {
+			if vectorIndexStats == nil {
+				return meta.NewMetaVectorIndexStatsNotImplemented()
+			}
+
+			stats, err := vectorIndexStats.VectorIndexStats(params.HTTPRequest.Context())
+			if err != nil {
+				return meta.NewMetaVectorIndexStatsInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return meta.NewMetaVectorIndexStatsOK().WithPayload(stats)
+		})
+
 	api.WellKnownGetWellKnownOpenidConfigurationHandler = well_known.GetWellKnownOpenidConfigurationHandlerFunc(
 		func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			if !serverConfig.Config.Authentication.OIDC.Enabled {
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+type runningQueriesProvider interface {
+	ListQueries(ctx context.Context,
+		principal *models.Principal) ([]traverser.RunningQuery, error)
+	CancelQuery(ctx context.Context, principal *models.Principal, id string) error
+}
+
+type queryHandlers struct {
+	provider runningQueriesProvider
+	now      func() time.Time
+}
+
+func (h *queryHandlers) listQueries(params graphql.GraphqlQueriesListParams,
+	principal *models.Principal) middleware.Responder {
+	queries, err := h.provider.ListQueries(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return graphql.NewGraphqlQueriesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return graphql.NewGraphqlQueriesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	now := h.now()
+	payload := make([]*models.RunningQuery, len(queries))
+	for i, q := range queries {
+		payload[i] = &models.RunningQuery{
+			ID:         q.ID,
+			Username:   q.Username,
+			Operation:  q.Operation,
+			ClassName:  q.ClassName,
+			StartedAt:  q.Started.UnixNano() / int64(time.Millisecond),
+			DurationMs: int64(now.Sub(q.Started) / time.Millisecond),
+		}
+	}
+
+	return graphql.NewGraphqlQueriesListOK().WithPayload(payload)
+}
+
+func (h *queryHandlers) cancelQuery(params graphql.GraphqlQueriesCancelParams,
+	principal *models.Principal) middleware.Responder {
+	err := h.provider.CancelQuery(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return graphql.NewGraphqlQueriesCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case traverser.ErrNotFound:
+			return graphql.NewGraphqlQueriesCancelNotFound()
+		default:
+			return graphql.NewGraphqlQueriesCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return graphql.NewGraphqlQueriesCancelNoContent()
+}
+
+func setupQueryHandlers(api *operations.WeaviateAPI, provider runningQueriesProvider) {
+	h := &queryHandlers{provider: provider, now: time.Now}
+
+	api.GraphqlGraphqlQueriesListHandler = graphql.GraphqlQueriesListHandlerFunc(h.listQueries)
+	api.GraphqlGraphqlQueriesCancelHandler = graphql.GraphqlQueriesCancelHandlerFunc(h.cancelQuery)
+}
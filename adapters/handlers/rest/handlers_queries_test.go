@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunningQueriesProvider struct {
+	queries  []traverser.RunningQuery
+	canceled string
+	err      error
+}
+
+func (f *fakeRunningQueriesProvider) ListQueries(ctx context.Context,
+	principal *models.Principal) ([]traverser.RunningQuery, error) {
+	return f.queries, f.err
+}
+
+func (f *fakeRunningQueriesProvider) CancelQuery(ctx context.Context,
+	principal *models.Principal, id string) error {
+	f.canceled = id
+	return f.err
+}
+
+func TestRunningQueries(t *testing.T) {
+	started := time.Unix(1000, 0)
+	now := func() time.Time { return started.Add(1500 * time.Millisecond) }
+
+	t.Run("listing the running queries", func(t *testing.T) {
+		provider := &fakeRunningQueriesProvider{queries: []traverser.RunningQuery{
+			{ID: "my-id", Username: "alice", Operation: "Get", ClassName: "Car", Started: started},
+		}}
+		h := &queryHandlers{provider: provider, now: now}
+
+		res := h.listQueries(graphql.GraphqlQueriesListParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/graphql/queries", nil),
+		}, nil)
+
+		parsed, ok := res.(*graphql.GraphqlQueriesListOK)
+		require.True(t, ok)
+		assert.Equal(t, []*models.RunningQuery{
+			{
+				ID:         "my-id",
+				Username:   "alice",
+				Operation:  "Get",
+				ClassName:  "Car",
+				StartedAt:  1000000,
+				DurationMs: 1500,
+			},
+		}, parsed.Payload)
+	})
+
+	t.Run("canceling a running query", func(t *testing.T) {
+		provider := &fakeRunningQueriesProvider{}
+		h := &queryHandlers{provider: provider, now: now}
+
+		res := h.cancelQuery(graphql.GraphqlQueriesCancelParams{
+			HTTPRequest: httptest.NewRequest("DELETE", "/v1/graphql/queries/my-id", nil),
+			ID:          "my-id",
+		}, nil)
+
+		_, ok := res.(*graphql.GraphqlQueriesCancelNoContent)
+		assert.True(t, ok)
+		assert.Equal(t, "my-id", provider.canceled)
+	})
+
+	t.Run("canceling a query which is not running", func(t *testing.T) {
+		provider := &fakeRunningQueriesProvider{
+			err: traverser.NewErrNotFound("no running query with id 'foo'"),
+		}
+		h := &queryHandlers{provider: provider, now: now}
+
+		res := h.cancelQuery(graphql.GraphqlQueriesCancelParams{
+			HTTPRequest: httptest.NewRequest("DELETE", "/v1/graphql/queries/foo", nil),
+			ID:          "foo",
+		}, nil)
+
+		_, ok := res.(*graphql.GraphqlQueriesCancelNotFound)
+		assert.True(t, ok)
+	})
+}
@@ -48,7 +48,7 @@ func (s *schemaHandlers) deleteAction(params schema.SchemaActionsDeleteParams, p
 	err := s.manager.DeleteAction(params.HTTPRequest.Context(), principal, params.ClassName)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, schemaUC.ErrReadOnly:
 			return schema.NewSchemaActionsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -64,7 +64,7 @@ func (s *schemaHandlers) addActionProperty(params schema.SchemaActionsProperties
 	err := s.manager.AddActionProperty(params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, schemaUC.ErrReadOnly:
 			return schema.NewSchemaActionsPropertiesAddForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -76,6 +76,45 @@ func (s *schemaHandlers) addActionProperty(params schema.SchemaActionsProperties
 	return schema.NewSchemaActionsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) addActionProperties(params schema.SchemaActionsPropertiesBatchAddParams,
+	principal *models.Principal) middleware.Responder {
+	class, err := s.manager.AddActionProperties(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, schemaUC.ErrReadOnly:
+			return schema.NewSchemaActionsPropertiesBatchAddForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaActionsPropertiesBatchAddUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaActionsPropertiesBatchAddOK().WithPayload(class)
+}
+
+func (s *schemaHandlers) deleteActionProperty(params schema.SchemaActionsPropertiesDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteActionProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, schemaUC.ErrReadOnly:
+			return schema.NewSchemaActionsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrInternal:
+			return schema.NewSchemaActionsPropertiesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaActionsPropertiesDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaActionsPropertiesDeleteOK()
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -96,6 +135,63 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) exportSchema(params schema.SchemaExportParams,
+	principal *models.Principal) middleware.Responder {
+	dbSchema, err := s.manager.GetSchema(principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaExportOK().WithPayload(&models.SchemaDocument{
+		Actions: dbSchema.Actions,
+		Things:  dbSchema.Things,
+	})
+}
+
+func (s *schemaHandlers) importSchema(params schema.SchemaImportParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := s.manager.ImportSchema(params.HTTPRequest.Context(), principal,
+		params.Body.Things, params.Body.Actions)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaImportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaImportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	conflicts := make([]*models.SchemaImportConflict, len(res.Conflicts))
+	for i, conflict := range res.Conflicts {
+		conflicts[i] = &models.SchemaImportConflict{
+			Class:  conflict.Class,
+			Kind:   conflict.Kind.Name(),
+			Reason: conflict.Reason,
+		}
+	}
+
+	payload := &models.SchemaImportResponse{
+		Conflicts: conflicts,
+		Created:   res.Created,
+		Unchanged: res.Unchanged,
+	}
+
+	if len(conflicts) > 0 {
+		return schema.NewSchemaImportConflict().WithPayload(payload)
+	}
+
+	return schema.NewSchemaImportOK().WithPayload(payload)
+}
+
 func (s *schemaHandlers) addThing(params schema.SchemaThingsCreateParams, principal *models.Principal) middleware.Responder {
 	err := s.manager.AddThing(params.HTTPRequest.Context(), principal, params.ThingClass)
 	if err != nil {
@@ -116,7 +212,7 @@ func (s *schemaHandlers) deleteThing(params schema.SchemaThingsDeleteParams, pri
 	err := s.manager.DeleteThing(params.HTTPRequest.Context(), principal, params.ClassName)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, schemaUC.ErrReadOnly:
 			return schema.NewSchemaThingsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -132,7 +228,7 @@ func (s *schemaHandlers) addThingProperty(params schema.SchemaThingsPropertiesAd
 	err := s.manager.AddThingProperty(params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
 	if err != nil {
 		switch err.(type) {
-		case errors.Forbidden:
+		case errors.Forbidden, schemaUC.ErrReadOnly:
 			return schema.NewSchemaThingsPropertiesAddForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -144,6 +240,121 @@ func (s *schemaHandlers) addThingProperty(params schema.SchemaThingsPropertiesAd
 	return schema.NewSchemaThingsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) addThingProperties(params schema.SchemaThingsPropertiesBatchAddParams,
+	principal *models.Principal) middleware.Responder {
+	class, err := s.manager.AddThingProperties(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, schemaUC.ErrReadOnly:
+			return schema.NewSchemaThingsPropertiesBatchAddForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaThingsPropertiesBatchAddUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaThingsPropertiesBatchAddOK().WithPayload(class)
+}
+
+func (s *schemaHandlers) deleteThingProperty(params schema.SchemaThingsPropertiesDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteThingProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden, schemaUC.ErrReadOnly:
+			return schema.NewSchemaThingsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrInternal:
+			return schema.NewSchemaThingsPropertiesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaThingsPropertiesDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaThingsPropertiesDeleteOK()
+}
+
+func (s *schemaHandlers) freezeThing(params schema.SchemaThingsFreezeParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.FreezeThing(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaThingsFreezeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrClassNotFound:
+			return schema.NewSchemaThingsFreezeNotFound()
+		default:
+			return schema.NewSchemaThingsFreezeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaThingsFreezeOK()
+}
+
+func (s *schemaHandlers) unfreezeThing(params schema.SchemaThingsUnfreezeParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.UnfreezeThing(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaThingsUnfreezeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrClassNotFound:
+			return schema.NewSchemaThingsUnfreezeNotFound()
+		default:
+			return schema.NewSchemaThingsUnfreezeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaThingsUnfreezeOK()
+}
+
+func (s *schemaHandlers) freezeAction(params schema.SchemaActionsFreezeParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.FreezeAction(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaActionsFreezeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrClassNotFound:
+			return schema.NewSchemaActionsFreezeNotFound()
+		default:
+			return schema.NewSchemaActionsFreezeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaActionsFreezeOK()
+}
+
+func (s *schemaHandlers) unfreezeAction(params schema.SchemaActionsUnfreezeParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.UnfreezeAction(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaActionsUnfreezeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrClassNotFound:
+			return schema.NewSchemaActionsUnfreezeNotFound()
+		default:
+			return schema.NewSchemaActionsUnfreezeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaActionsUnfreezeOK()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -153,6 +364,14 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaActionsDeleteHandlerFunc(h.deleteAction)
 	api.SchemaSchemaActionsPropertiesAddHandler = schema.
 		SchemaActionsPropertiesAddHandlerFunc(h.addActionProperty)
+	api.SchemaSchemaActionsPropertiesBatchAddHandler = schema.
+		SchemaActionsPropertiesBatchAddHandlerFunc(h.addActionProperties)
+	api.SchemaSchemaActionsPropertiesDeleteHandler = schema.
+		SchemaActionsPropertiesDeleteHandlerFunc(h.deleteActionProperty)
+	api.SchemaSchemaActionsFreezeHandler = schema.
+		SchemaActionsFreezeHandlerFunc(h.freezeAction)
+	api.SchemaSchemaActionsUnfreezeHandler = schema.
+		SchemaActionsUnfreezeHandlerFunc(h.unfreezeAction)
 
 	api.SchemaSchemaThingsCreateHandler = schema.
 		SchemaThingsCreateHandlerFunc(h.addThing)
@@ -160,9 +379,21 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaThingsDeleteHandlerFunc(h.deleteThing)
 	api.SchemaSchemaThingsPropertiesAddHandler = schema.
 		SchemaThingsPropertiesAddHandlerFunc(h.addThingProperty)
+	api.SchemaSchemaThingsPropertiesBatchAddHandler = schema.
+		SchemaThingsPropertiesBatchAddHandlerFunc(h.addThingProperties)
+	api.SchemaSchemaThingsPropertiesDeleteHandler = schema.
+		SchemaThingsPropertiesDeleteHandlerFunc(h.deleteThingProperty)
+	api.SchemaSchemaThingsFreezeHandler = schema.
+		SchemaThingsFreezeHandlerFunc(h.freezeThing)
+	api.SchemaSchemaThingsUnfreezeHandler = schema.
+		SchemaThingsUnfreezeHandlerFunc(h.unfreezeThing)
 
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
+	api.SchemaSchemaExportHandler = schema.
+		SchemaExportHandlerFunc(h.exportSchema)
+	api.SchemaSchemaImportHandler = schema.
+		SchemaImportHandlerFunc(h.importSchema)
 }
 
 type unlocker interface {
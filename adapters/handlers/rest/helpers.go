@@ -13,8 +13,14 @@ package rest
 
 import (
 	"fmt"
+	"math"
+	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 )
 
 // createErrorResponseObject is a common function to create an error response
@@ -37,3 +43,28 @@ func errPayloadFromSingleErr(err error) *models.ErrorResponse {
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// retryAfterSeconds rounds up, so that a client which waits for the
+// Retry-After header is not rejected again for being a fraction too early
+func retryAfterSeconds(err error) int64 {
+	switch e := err.(type) {
+	case kinds.ErrRateLimited:
+		return int64(math.Ceil(e.RetryAfter.Seconds()))
+	case kinds.ErrUnavailable:
+		return int64(math.Ceil(e.RetryAfter.Seconds()))
+	default:
+		return 0
+	}
+}
+
+// unavailableResponse responds to a kinds.ErrUnavailable with a 503, which
+// asks the client to retry after the time in the Retry-After header
+func unavailableResponse(err error) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		rw.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds(err), 10))
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		if err := p.Produce(rw, errPayloadFromSingleErr(err)); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
@@ -12,11 +12,15 @@
 package rest
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/rs/cors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,15 +30,20 @@ import (
 // we are setting the middlewares from within configureAPI, as we need access
 // to some resources which are not exposed
 func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler {
+	addRequestTimeout := makeAddRequestTimeout(
+		appState.ServerConfig.Config.RequestTimeout, appState.Logger)
+	addReadOnly := makeAddReadOnly(appState.ServerConfig.Config.Persistence.ReadOnly)
+
 	return func(handler http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler = addReadOnly(handler)
+		return addRequestTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 			if r.URL.String() == "/v1/.well-known/openid-configuration" {
 				handler.ServeHTTP(w, r)
 				return
 			}
 			appState.AnonymousAccess.Middleware(handler).ServeHTTP(w, r)
-		})
+		}))
 	}
 
 }
@@ -56,7 +65,8 @@ func addHandleRoot(next http.Handler) http.Handler {
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 // Contains "x-api-key", "x-api-token" for legacy reasons, older interfaces might need these headers.
-func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Handler {
+func makeSetupGlobalMiddleware(appState *state.State,
+	readiness readinessProbe) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
 		handleCORS := cors.New(cors.Options{
 			OptionsPassthrough: true,
@@ -64,9 +74,14 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		}).Handler
 		handler = handleCORS(handler)
 		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON), handler)
+		handler = makeAddSafeErrors(appState.Logger,
+			appState.ServerConfig.Config.Errors.Safe())(handler)
 		handler = makeAddLogging(appState.Logger)(handler)
+		handler = addRequestID(handler)
+		handler = addTracing(handler)
 		handler = addPreflight(handler)
-		handler = addLiveAndReadyness(handler)
+		handler = addLiveAndReadyness(handler, readiness)
+		handler = makeAddGzip(appState.ServerConfig.Config.RequestBody)(handler)
 		handler = addHandleRoot(handler)
 
 		return handler
@@ -76,7 +91,7 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 func makeAddLogging(logger logrus.FieldLogger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			logger.
+			requestid.Logger(r.Context(), logger).
 				WithField("action", "restapi_request").
 				WithField("method", r.Method).
 				WithField("url", r.URL).
@@ -86,13 +101,54 @@ func makeAddLogging(logger logrus.FieldLogger) func(http.Handler) http.Handler {
 	}
 }
 
+// addRequestID continues the request id sent by the client or starts a new
+// one. The id is returned in the response headers, so that a client can refer
+// to the log entries of its request.
+func addRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(requestid.ContextWithID(r.Context(), id)))
+	})
+}
+
+// addTracing starts the root span of a request. A trace id sent by the
+// client is continued, so that the spans can be correlated with the client's
+// own trace. The trace id is returned in the response headers.
+func addTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if traceID := r.Header.Get(tracing.TraceIDHeader); traceID != "" {
+			ctx = tracing.ContextWithRemoteParent(ctx, tracing.SpanContext{
+				TraceID: traceID,
+				SpanID:  r.Header.Get(tracing.SpanIDHeader),
+			})
+		}
+
+		span, ctx := tracing.StartSpanFromContext(ctx, "http_request")
+		defer span.Finish()
+		span.SetTag("method", r.Method)
+		span.SetTag("path", r.URL.Path)
+
+		if traceID := span.Context().TraceID; traceID != "" {
+			w.Header().Set(tracing.TraceIDHeader, traceID)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func addPreflight(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		if r.Method == "OPTIONS" {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "*")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Batch")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Encoding, Authorization, Batch")
 			return
 		}
 
@@ -100,7 +156,14 @@ func addPreflight(next http.Handler) http.Handler {
 	})
 }
 
-func addLiveAndReadyness(next http.Handler) http.Handler {
+type readinessProbe interface {
+	Ready(ctx context.Context) error
+}
+
+// addLiveAndReadyness answers the kubernetes probes. The process is alive as
+// long as it can answer HTTP requests, whereas it is only ready if all of its
+// dependencies are healthy.
+func addLiveAndReadyness(next http.Handler, readiness readinessProbe) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		if r.URL.String() == "/v1/.well-known/live" {
@@ -109,6 +172,13 @@ func addLiveAndReadyness(next http.Handler) http.Handler {
 		}
 
 		if r.URL.String() == "/v1/.well-known/ready" {
+			if err := readiness.Ready(r.Context()); err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+				return
+			}
+
 			w.WriteHeader(http.StatusOK)
 			return
 		}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/sirupsen/logrus"
+)
+
+// correlationIDHeader is set on internal server errors whose details have
+// been hidden from the client
+const correlationIDHeader = "X-Correlation-Id"
+
+// makeAddSafeErrors hides the details of 5xx responses from the client.
+// The client receives a generic message with a correlation id instead,
+// while the original response is logged with that same id. The handlers
+// therefore don't need to know whether details may be exposed. The
+// correlation id is always generated by the server, the request id which may
+// have been chosen by the client is only logged alongside it.
+func makeAddSafeErrors(logger logrus.FieldLogger, safe bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !safe {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sw := &safeErrorResponseWriter{ResponseWriter: w}
+			next.ServeHTTP(sw, r)
+			sw.finish(logger, r)
+		})
+	}
+}
+
+// safeErrorResponseWriter passes through all responses below 500 as well as
+// 503s. The body of any other response is held back, so that it can be
+// replaced once the handler is done.
+type safeErrorResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *safeErrorResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = code
+
+	if !w.hidden() {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *safeErrorResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.hidden() {
+		return w.body.Write(p)
+	}
+
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *safeErrorResponseWriter) Flush() {
+	if w.hidden() {
+		return
+	}
+
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// hidden is false for a 503, its message is meant for the client, for
+// example that a write has to be retried later
+func (w *safeErrorResponseWriter) hidden() bool {
+	return w.status >= http.StatusInternalServerError &&
+		w.status != http.StatusServiceUnavailable
+}
+
+func (w *safeErrorResponseWriter) finish(logger logrus.FieldLogger, r *http.Request) {
+	if !w.hidden() {
+		return
+	}
+
+	id := uuid.New().String()
+	requestid.Logger(r.Context(), logger).
+		WithField("action", "restapi_internal_error").
+		WithField("correlation_id", id).
+		WithField("method", r.Method).
+		WithField("url", r.URL).
+		WithField("status", w.status).
+		WithField("response", w.body.String()).
+		Error("internal server error")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(correlationIDHeader, id)
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+	json.NewEncoder(w.ResponseWriter).Encode(createErrorResponseObject(
+		internalErrorMessage(id)))
+}
+
+func internalErrorMessage(correlationID string) string {
+	return fmt.Sprintf("internal server error, correlation id: %s", correlationID)
+}
+
+// itemErrors hides the details of internal errors which are part of an
+// otherwise successful response, such as the errors of single batch items or
+// of GraphQL resolvers. The middleware can't recognize those, as they are
+// returned with a 2xx status.
+type itemErrors struct {
+	safe   bool
+	logger logrus.FieldLogger
+}
+
+func newItemErrors(logger logrus.FieldLogger, safe bool) itemErrors {
+	return itemErrors{safe: safe, logger: logger}
+}
+
+// batchPayload is the error of a single batch item. Only errors which are
+// categorized as internal are hidden.
+func (e itemErrors) batchPayload(ctx context.Context, err error) *models.ErrorResponse {
+	internal := kinds.CategorizeBatchError(err) == kinds.BatchErrorInternal
+	return createErrorResponseObject(e.message(ctx, err, internal))
+}
+
+// graphQL replaces the messages of all resolver errors which are not one of
+// the known user-facing error types. Errors without an original error are
+// returned by the query parser and validation and are kept.
+func (e itemErrors) graphQL(ctx context.Context, errs []gqlerrors.FormattedError) {
+	for i, err := range errs {
+		if err.OriginalError() == nil {
+			continue
+		}
+
+		errs[i].Message = e.message(ctx, err.OriginalError(),
+			!userFacingResolverError(err.OriginalError()))
+	}
+}
+
+func userFacingResolverError(err error) bool {
+	switch err.(type) {
+	case traverser.ErrInvalidUserInput, traverser.ErrNotFound,
+		kinds.ErrInvalidUserInput, kinds.ErrNotFound, autherrs.Forbidden,
+		vectorizer.ErrNoUsableWords:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e itemErrors) message(ctx context.Context, err error, internal bool) string {
+	if !e.safe || !internal {
+		return err.Error()
+	}
+
+	id := uuid.New().String()
+	requestid.Logger(ctx, e.logger).
+		WithField("action", "restapi_internal_error").
+		WithField("correlation_id", id).
+		WithError(err).
+		Error("internal server error")
+
+	return internalErrorMessage(id)
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeErrorsMiddleware(t *testing.T) {
+	respond := func(code int) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(errPayloadFromSingleErr(
+				errors.New("open /var/lib/weaviate/main.db: permission denied")))
+		})
+	}
+
+	t.Run("in debug mode the full error is returned", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		makeAddSafeErrors(logger, false)(respond(http.StatusInternalServerError)).
+			ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "permission denied")
+		assert.Empty(t, w.Header().Get(correlationIDHeader))
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	t.Run("in safe mode an internal error is replaced", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		makeAddSafeErrors(logger, true)(respond(http.StatusInternalServerError)).
+			ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotContains(t, w.Body.String(), "permission denied")
+
+		id := w.Header().Get(correlationIDHeader)
+		require.NotEmpty(t, id)
+
+		var payload models.ErrorResponse
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &payload))
+		require.Len(t, payload.Error, 1)
+		assert.Equal(t, "internal server error, correlation id: "+id,
+			payload.Error[0].Message)
+
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, id, hook.LastEntry().Data["correlation_id"])
+		assert.Contains(t, hook.LastEntry().Data["response"], "permission denied")
+	})
+
+	t.Run("in safe mode the request id is only logged", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		req = req.WithContext(requestid.ContextWithID(req.Context(), "import-42"))
+		w := httptest.NewRecorder()
+
+		makeAddSafeErrors(logger, true)(respond(http.StatusInternalServerError)).
+			ServeHTTP(w, req)
+
+		id := w.Header().Get(correlationIDHeader)
+		assert.NotEqual(t, "import-42", id)
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, id, hook.LastEntry().Data["correlation_id"])
+		assert.Equal(t, "import-42", hook.LastEntry().Data[requestid.LogField])
+	})
+
+	t.Run("in safe mode a client error is returned unchanged", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		makeAddSafeErrors(logger, true)(respond(http.StatusUnprocessableEntity)).
+			ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "permission denied")
+		assert.Empty(t, w.Header().Get(correlationIDHeader))
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+}
+
+func TestSafeGraphQLErrors(t *testing.T) {
+	errs := func() []gqlerrors.FormattedError {
+		return gqlerrors.FormatErrors(
+			gqlerrors.NewError("Syntax Error: Unexpected Name", nil, "", nil, nil, nil),
+			gqlerrors.NewLocatedError(traverser.NewErrInvalidUserInput("invalid filter"), nil),
+			gqlerrors.NewLocatedError(
+				errors.New("open /var/lib/weaviate/main.db: permission denied"), nil),
+		)
+	}
+
+	t.Run("in debug mode all errors are kept", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		res := errs()
+
+		newItemErrors(logger, false).graphQL(context.Background(), res)
+
+		assert.Contains(t, res[2].Message, "permission denied")
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	t.Run("in safe mode internal resolver errors are replaced", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		ctx := requestid.ContextWithID(context.Background(), "import-42")
+		res := errs()
+
+		newItemErrors(logger, true).graphQL(ctx, res)
+
+		assert.Equal(t, "Syntax Error: Unexpected Name", res[0].Message)
+		assert.Equal(t, "invalid filter", res[1].Message)
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, "internal server error, correlation id: "+entry.Data["correlation_id"].(string),
+			res[2].Message)
+		assert.Equal(t, "import-42", entry.Data[requestid.LogField])
+		assert.Contains(t, entry.Data["error"].(error).Error(), "permission denied")
+	})
+}
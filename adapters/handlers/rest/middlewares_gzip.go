@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// makeAddGzip limits gzip compressed request bodies to the configured size
+// after decompression
+func makeAddGzip(cfg config.RequestBody) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return addGzip(int64(*cfg.MaxDecompressedBytes), next)
+	}
+}
+
+// addGzip transparently decompresses request bodies sent with
+// "Content-Encoding: gzip" and compresses responses for clients which
+// indicate support through "Accept-Encoding: gzip". A decompressed body
+// larger than maxBytes is answered with a 413, 0 disables the limit.
+func addGzip(maxBytes int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body *gzipRequestBody
+		if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+			gz, err := gzip.NewReader(r.Body)
+			if err != nil {
+				writeBadRequest(w, fmt.Errorf("invalid gzip request body: %v", err))
+				return
+			}
+			defer gz.Close()
+
+			body = &gzipRequestBody{ReadCloser: r.Body, gz: gz, maxBytes: maxBytes}
+			body.decompressed = ioutil.NopCloser(gz)
+			if maxBytes > 0 {
+				body.decompressed = http.MaxBytesReader(w, ioutil.NopCloser(body.counted()), maxBytes)
+			}
+
+			r.Body = body
+			r.Header.Del("Content-Encoding")
+			r.Header.Del("Content-Length")
+			r.ContentLength = -1
+		}
+
+		if acceptsGzip(r) {
+			gw := &gzipResponseWriter{ResponseWriter: w}
+			defer gw.close()
+			w.Header().Add("Vary", "Accept-Encoding")
+			w = gw
+		}
+
+		if body != nil && maxBytes > 0 {
+			w = &bodyLimitResponseWriter{ResponseWriter: w, body: body}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		enc = strings.TrimSpace(strings.Split(enc, ";")[0])
+		if strings.EqualFold(enc, "gzip") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeBadRequest(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+}
+
+// gzipRequestBody reads the decompressed body, but closes the original one.
+// It counts the decompressed bytes, so that a read error caused by the size
+// limit can be told apart from a malformed body.
+type gzipRequestBody struct {
+	io.ReadCloser
+	gz                *gzip.Reader
+	decompressed      io.ReadCloser
+	decompressedBytes int64
+	maxBytes          int64
+}
+
+func (b *gzipRequestBody) Read(p []byte) (int, error) {
+	return b.decompressed.Read(p)
+}
+
+// counted reads from the gzip reader and counts what was read
+func (b *gzipRequestBody) counted() io.Reader {
+	return readerFunc(func(p []byte) (int, error) {
+		n, err := b.gz.Read(p)
+		b.decompressedBytes += int64(n)
+		return n, err
+	})
+}
+
+// tooLarge is true once more than maxBytes were decompressed. The limited
+// reader reads one byte past the limit to detect this.
+func (b *gzipRequestBody) tooLarge() bool {
+	return b.maxBytes > 0 && b.decompressedBytes > b.maxBytes
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+// bodyLimitResponseWriter replaces the handler's response with a 413 if the
+// request body exceeded its limit. The handler only sees a read error, which
+// it would otherwise answer like a malformed body. A response which was
+// already started before the limit was hit is left untouched.
+type bodyLimitResponseWriter struct {
+	http.ResponseWriter
+	body     *gzipRequestBody
+	started  bool
+	replaced bool
+}
+
+func (w *bodyLimitResponseWriter) WriteHeader(code int) {
+	if w.replace() {
+		return
+	}
+
+	w.started = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *bodyLimitResponseWriter) Write(p []byte) (int, error) {
+	if w.replace() {
+		return len(p), nil
+	}
+
+	w.started = true
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *bodyLimitResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// replace sends the 413 if the body was too large and nothing was sent yet.
+// It's true if the handler's response must be dropped.
+func (w *bodyLimitResponseWriter) replace() bool {
+	if w.replaced {
+		return true
+	}
+
+	if w.started || !w.body.tooLarge() {
+		return false
+	}
+
+	w.replaced = true
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Type", "application/json")
+	w.ResponseWriter.WriteHeader(http.StatusRequestEntityTooLarge)
+	json.NewEncoder(w.ResponseWriter).Encode(errPayloadFromSingleErr(
+		fmt.Errorf("decompressed request body exceeds the maximum of %d bytes",
+			w.body.maxBytes)))
+	return true
+}
+
+// gzipResponseWriter only compresses if a body is actually written, so that
+// responses without a body, such as a 204 or an empty 401, remain valid. The
+// status code is therefore held back until the first write.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz          *gzip.Writer
+	compress    bool
+	status      int
+	wroteHeader bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(code int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = code
+}
+
+// writeHeader sends the held back status code. The response is compressed
+// if it has a body and is not already encoded.
+func (w *gzipResponseWriter) writeHeader(hasBody bool) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	if hasBody && w.status != http.StatusNoContent &&
+		w.status != http.StatusNotModified &&
+		w.Header().Get("Content-Encoding") == "" {
+		w.compress = true
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Del("Content-Length")
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 && !w.wroteHeader {
+		return 0, nil
+	}
+	w.writeHeader(true)
+
+	if !w.compress {
+		return w.ResponseWriter.Write(p)
+	}
+
+	if w.gz == nil {
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+	}
+
+	return w.gz.Write(p)
+}
+
+func (w *gzipResponseWriter) Flush() {
+	// nothing was written yet, so the response is not known to have a body
+	w.writeHeader(false)
+
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *gzipResponseWriter) close() {
+	if w.status != 0 {
+		w.writeHeader(false)
+	}
+
+	if w.gz != nil {
+		w.gz.Close()
+	}
+}
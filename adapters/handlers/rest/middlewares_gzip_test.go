@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzipMiddleware(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write(body)
+	})
+
+	compress := func(in string) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write([]byte(in))
+		gz.Close()
+		return buf.Bytes()
+	}
+
+	t.Run("a gzipped request body is decompressed", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader(compress(`{"things":[]}`)))
+		req.Header.Set("Content-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(0, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"things":[]}`, w.Body.String())
+	})
+
+	t.Run("a malformed gzip request body is a bad request", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader([]byte(`{"things":[]}`)))
+		req.Header.Set("Content-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(0, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid gzip request body")
+	})
+
+	t.Run("a decompressed body within the limit is passed on", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader(compress(`{"things":[]}`)))
+		req.Header.Set("Content-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(13, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"things":[]}`, w.Body.String())
+	})
+
+	t.Run("a decompressed body beyond the limit is too large", func(t *testing.T) {
+		// compresses to a few hundred bytes, but expands to 1MB
+		oversized := compress(strings.Repeat("a", 1024*1024))
+		require.Less(t, len(oversized), 4096)
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader(oversized))
+		req.Header.Set("Content-Encoding", "gzip")
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(64*1024, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		gz, err := gzip.NewReader(w.Body)
+		require.Nil(t, err)
+		body, err := ioutil.ReadAll(gz)
+		require.Nil(t, err)
+		assert.Contains(t, string(body), "exceeds the maximum of 65536 bytes")
+	})
+
+	t.Run("an uncompressed request body is left untouched", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader([]byte(`{"things":[]}`)))
+		w := httptest.NewRecorder()
+
+		addGzip(0, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, `{"things":[]}`, w.Body.String())
+	})
+
+	t.Run("the response is compressed if the client accepts it", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things",
+			bytes.NewReader([]byte(`{"things":[]}`)))
+		req.Header.Set("Accept-Encoding", "deflate, gzip;q=1.0")
+		w := httptest.NewRecorder()
+
+		addGzip(0, echo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		gz, err := gzip.NewReader(w.Body)
+		require.Nil(t, err)
+		body, err := ioutil.ReadAll(gz)
+		require.Nil(t, err)
+		assert.Equal(t, `{"things":[]}`, string(body))
+	})
+
+	t.Run("a response without a body is not compressed", func(t *testing.T) {
+		noContent := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		req := httptest.NewRequest("DELETE", "/v1/things/some-id", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(0, noContent).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, 0, w.Body.Len())
+	})
+
+	t.Run("an error without a body is not compressed", func(t *testing.T) {
+		unauthorized := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(0, unauthorized).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, 0, w.Body.Len())
+	})
+
+	t.Run("the status code is kept if a body is written", func(t *testing.T) {
+		created := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"foo"}`))
+		})
+		req := httptest.NewRequest("POST", "/v1/things", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+
+		addGzip(0, created).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	})
+}
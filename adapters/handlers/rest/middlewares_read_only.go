@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// readOnlyOperations don't change the storage even though they aren't sent
+// as a GET, e.g. because the query is too large for the url
+var readOnlyOperations = map[string]bool{
+	"things.validate":             true,
+	"actions.validate":            true,
+	"batching.things.get":         true,
+	"batching.actions.get":        true,
+	"batching.references.resolve": true,
+	"graphql.post":                true,
+	"graphql.batch":               true,
+	"graphql.queries.cancel":      true,
+	"c11y.corpus.get":             true,
+	"classifications.preview":     true,
+}
+
+func allowedWhileReadOnly(method, operationID string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return true
+	}
+
+	return readOnlyOperations[operationID]
+}
+
+// makeAddReadOnly rejects every operation which would change the storage or
+// the schema with a 403 while the storage is opened read-only. They are
+// rejected before they reach the usecases, so that neither the schema nor
+// the storage is changed partially. Must run after routing, as the decision
+// depends on the route.
+func makeAddReadOnly(readOnly bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !readOnly {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil || route.Operation == nil {
+				// unknown routes are answered by the router
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if allowedWhileReadOnly(r.Method, route.Operation.ID) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(errPayloadFromSingleErr(fmt.Errorf(
+				"the storage is opened read-only, %s %s would change it",
+				r.Method, r.URL.Path)))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	type test struct {
+		method      string
+		operationID string
+		allowed     bool
+	}
+
+	tests := []test{
+		{"GET", "things.list", true},
+		{"GET", "schema.dump", true},
+		{"POST", "graphql.post", true},
+		{"POST", "batching.things.get", true},
+		{"POST", "things.validate", true},
+		{"POST", "things.create", false},
+		{"PUT", "things.update", false},
+		{"PATCH", "things.patch", false},
+		{"DELETE", "things.delete", false},
+		{"POST", "batching.things.create", false},
+		{"POST", "schema.things.create", false},
+		{"POST", "schema.things.properties.add", false},
+		{"POST", "classifications.post", false},
+		{"POST", "meta.compact", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.allowed, allowedWhileReadOnly(test.method, test.operationID),
+			"%s %s", test.method, test.operationID)
+	}
+
+	t.Run("without the read-only mode", func(t *testing.T) {
+		called := false
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		})
+
+		req := httptest.NewRequest("POST", "/v1/things", nil)
+		makeAddReadOnly(false)(handler).ServeHTTP(httptest.NewRecorder(), req)
+		assert.True(t, called)
+	})
+}
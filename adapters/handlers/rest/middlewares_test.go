@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var seen string
+	handler := addRequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = requestid.FromContext(r.Context())
+	}))
+
+	t.Run("a request id sent by the client is continued", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things", nil)
+		req.Header.Set(requestid.Header, "import-42")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, "import-42", seen)
+		assert.Equal(t, "import-42", w.Header().Get(requestid.Header))
+	})
+
+	t.Run("a new request id is started if the client sent none", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.True(t, requestid.Valid(seen))
+		assert.Equal(t, seen, w.Header().Get(requestid.Header))
+	})
+
+	t.Run("an invalid request id is replaced", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v1/batching/things", nil)
+		req.Header.Set(requestid.Header, "foo bar")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.NotEqual(t, "foo bar", seen)
+		assert.True(t, requestid.Valid(seen))
+		assert.Equal(t, seen, w.Header().Get(requestid.Header))
+	})
+}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/sirupsen/logrus"
+)
+
+// requestTimeouts picks the timeout of a request based on the operation id
+// of its route
+type requestTimeouts struct {
+	defaultTimeout time.Duration
+	batchTimeout   time.Duration
+	overrides      map[string]time.Duration
+}
+
+func newRequestTimeouts(cfg config.RequestTimeout) requestTimeouts {
+	overrides := map[string]time.Duration{}
+	for operationID, seconds := range cfg.RouteOverrides {
+		overrides[operationID] = time.Duration(seconds) * time.Second
+	}
+
+	return requestTimeouts{
+		defaultTimeout: time.Duration(*cfg.DefaultSeconds) * time.Second,
+		batchTimeout:   time.Duration(*cfg.BatchSeconds) * time.Second,
+		overrides:      overrides,
+	}
+}
+
+// forOperation returns the timeout for the operation id, 0 means the
+// operation has no timeout
+func (t requestTimeouts) forOperation(operationID string) time.Duration {
+	if timeout, ok := t.overrides[operationID]; ok {
+		return timeout
+	}
+
+	if strings.HasPrefix(operationID, "batching.") || operationID == "graphql.batch" {
+		return t.batchTimeout
+	}
+
+	return t.defaultTimeout
+}
+
+// makeAddRequestTimeout answers requests which exceed their deadline with a
+// 503. The deadline is set on the request context, so that downstream work,
+// such as vectorization or writing to the database, is cancelled as well.
+//
+// A 503 must never hide a change which was still made after the deadline, as
+// the client would repeat it. Handlers therefore signal through the context
+// whether they can commit a change, see signalReadOnly. Requests which can't,
+// such as GET requests, are answered right when the deadline passes, their
+// handler is left to notice the cancellation in the background. All other
+// requests are only answered once the handler returned: if it succeeded
+// anyway, its response is sent instead of the 503. This means a write whose
+// handler ignores the cancellation can still keep the client waiting past the
+// deadline.
+//
+// The handler's response is buffered until then. Streamed responses are
+// therefore excluded, they have to rely on the cancellation alone. Must run
+// after routing, as the timeout depends on the route.
+func makeAddRequestTimeout(cfg config.RequestTimeout,
+	logger logrus.FieldLogger) func(http.Handler) http.Handler {
+	return addRequestTimeouts(newRequestTimeouts(cfg), logger)
+}
+
+func addRequestTimeouts(timeouts requestTimeouts,
+	logger logrus.FieldLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var operationID string
+			if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+				operationID = route.Operation.ID
+			}
+
+			timeout := timeouts.forOperation(operationID)
+			if timeout == 0 || acceptsNDJSON(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			signal := &commitSignal{
+				readOnly: r.Method == http.MethodGet || r.Method == http.MethodHead,
+			}
+			ctx = context.WithValue(ctx, commitSignalKey{}, signal)
+
+			tw := &timeoutResponseWriter{header: http.Header{}}
+			done := make(chan interface{}, 1)
+			go func() {
+				defer func() { done <- recover() }()
+				next.ServeHTTP(tw, r.WithContext(ctx))
+			}()
+
+			log := requestid.Logger(r.Context(), logger).
+				WithField("action", "restapi_request_timeout").
+				WithField("method", r.Method).
+				WithField("url", r.URL).
+				WithField("operation", operationID).
+				WithField("timeout", timeout)
+
+			var handlerPanic interface{}
+			select {
+			case handlerPanic = <-done:
+			case <-ctx.Done():
+				if ctx.Err() == context.DeadlineExceeded && signal.isReadOnly() {
+					// the handler can't commit anything, so there is no need to
+					// wait for it. Its response is dropped.
+					go func() {
+						if p := <-done; p != nil {
+							log.WithField("panic", p).
+								Error("handler panicked after the request timed out")
+						}
+					}()
+
+					log.Warn("request exceeded its timeout")
+					writeRequestTimeout(w, timeout)
+					return
+				}
+
+				handlerPanic = <-done
+			}
+
+			if handlerPanic != nil {
+				// continue the panic on the serving goroutine, so that it is
+				// handled like the panic of any other handler
+				panic(handlerPanic)
+			}
+
+			if ctx.Err() != context.DeadlineExceeded || tw.succeeded() {
+				tw.flushTo(w)
+				return
+			}
+
+			log.WithField("status", tw.status).Warn("request exceeded its timeout")
+			writeRequestTimeout(w, timeout)
+		})
+	}
+}
+
+func writeRequestTimeout(w http.ResponseWriter, timeout time.Duration) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(
+		fmt.Errorf("request did not complete within %s", timeout)))
+}
+
+type commitSignalKey struct{}
+
+// commitSignal tells the timeout middleware whether the handler of a request
+// can commit a change
+type commitSignal struct {
+	sync.Mutex
+	readOnly bool
+}
+
+func (s *commitSignal) isReadOnly() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.readOnly
+}
+
+// signalReadOnly tells the timeout middleware that the handler of the
+// request never commits a change, e.g. because it only runs a query through
+// a POST request. A timeout is then answered right away, instead of waiting
+// for the handler to return. It does nothing for requests without a timeout.
+func signalReadOnly(ctx context.Context) {
+	signal, ok := ctx.Value(commitSignalKey{}).(*commitSignal)
+	if !ok {
+		return
+	}
+
+	signal.Lock()
+	defer signal.Unlock()
+	signal.readOnly = true
+}
+
+// timeoutResponseWriter holds back the response of the handler until it is
+// clear whether it is sent or replaced by the timeout response
+type timeoutResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *timeoutResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *timeoutResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = code
+}
+
+func (w *timeoutResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.body.Write(p)
+}
+
+// succeeded is true if the handler answered with a 2xx status, a handler
+// which didn't write anything succeeded as well
+func (w *timeoutResponseWriter) succeeded() bool {
+	return !w.wroteHeader || (w.status >= 200 && w.status < 300)
+}
+
+func (w *timeoutResponseWriter) flushTo(target http.ResponseWriter) {
+	for key, values := range w.header {
+		target.Header()[key] = values
+	}
+
+	if !w.wroteHeader {
+		w.status = http.StatusOK
+	}
+
+	target.WriteHeader(w.status)
+	target.Write(w.body.Bytes())
+}
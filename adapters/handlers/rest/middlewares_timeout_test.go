@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestTimeoutMiddleware(t *testing.T) {
+	ptInt := func(in int) *int {
+		return &in
+	}
+
+	cfg := func(seconds int) config.RequestTimeout {
+		return config.RequestTimeout{
+			DefaultSeconds: ptInt(seconds),
+			BatchSeconds:   ptInt(seconds),
+		}
+	}
+
+	t.Run("a fast request is passed through", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		makeAddRequestTimeout(cfg(1), logger)(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, ok := r.Context().Deadline()
+				assert.True(t, ok, "context should have a deadline")
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id":"foo"}`))
+			})).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"id":"foo"}`, w.Body.String())
+	})
+
+	t.Run("a slow request times out and is cancelled", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		req := httptest.NewRequest("POST", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		cancelled := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+			close(cancelled)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":[{"message":"context deadline exceeded"}]}`))
+		})
+
+		// the smallest configurable timeout is a second, which is too slow for
+		// a unit test
+		timeouts := newRequestTimeouts(cfg(1))
+		timeouts.defaultTimeout = 10 * time.Millisecond
+		addRequestTimeouts(timeouts, logger)(handler).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var payload models.ErrorResponse
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &payload))
+		require.Len(t, payload.Error, 1)
+		assert.Equal(t, "request did not complete within 10ms", payload.Error[0].Message)
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("downstream work was not cancelled")
+		}
+
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, "restapi_request_timeout", hook.LastEntry().Data["action"])
+	})
+
+	t.Run("a slow request which succeeds anyway is not reported as timed out", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		req := httptest.NewRequest("POST", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		// the write completes after the deadline, as it never checks the
+		// context again, a 503 would make the client repeat it
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id":"foo"}`))
+		})
+
+		timeouts := newRequestTimeouts(cfg(1))
+		timeouts.defaultTimeout = 10 * time.Millisecond
+		addRequestTimeouts(timeouts, logger)(handler).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"id":"foo"}`, w.Body.String())
+	})
+
+	t.Run("a read-only request is answered without waiting for the handler", func(t *testing.T) {
+		for _, req := range []*http.Request{
+			httptest.NewRequest("GET", "/v1/things", nil),
+			httptest.NewRequest("POST", "/v1/graphql", nil),
+		} {
+			logger, _ := test.NewNullLogger()
+			w := httptest.NewRecorder()
+
+			release := make(chan struct{})
+			returned := make(chan struct{})
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer close(returned)
+				signalReadOnly(r.Context())
+				<-release
+				w.WriteHeader(http.StatusOK)
+			})
+
+			timeouts := newRequestTimeouts(cfg(1))
+			timeouts.defaultTimeout = 10 * time.Millisecond
+			addRequestTimeouts(timeouts, logger)(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusServiceUnavailable, w.Code, req.Method)
+			select {
+			case <-returned:
+				t.Fatal("the handler should still be running")
+			default:
+			}
+
+			close(release)
+			<-returned
+		}
+	})
+
+	t.Run("a panicking handler is not swallowed", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("oops")
+		})
+
+		assert.PanicsWithValue(t, "oops", func() {
+			makeAddRequestTimeout(cfg(1), logger)(handler).ServeHTTP(w, req)
+		})
+	})
+
+	t.Run("a timeout of 0 disables it", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		req := httptest.NewRequest("GET", "/v1/things", nil)
+		w := httptest.NewRecorder()
+
+		makeAddRequestTimeout(cfg(0), logger)(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, ok := r.Context().Deadline()
+				assert.False(t, ok, "context should not have a deadline")
+				w.WriteHeader(http.StatusOK)
+			})).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestRequestTimeoutsPerOperation(t *testing.T) {
+	defaultSeconds, batchSeconds := 60, 600
+	timeouts := newRequestTimeouts(config.RequestTimeout{
+		DefaultSeconds: &defaultSeconds,
+		BatchSeconds:   &batchSeconds,
+		RouteOverrides: map[string]int{
+			"things.create":           5,
+			"batching.actions.create": 0,
+		},
+	})
+
+	tests := []struct {
+		operationID string
+		expected    time.Duration
+	}{
+		{"things.get", 60 * time.Second},
+		{"", 60 * time.Second},
+		{"batching.things.create", 600 * time.Second},
+		{"graphql.batch", 600 * time.Second},
+		{"things.create", 5 * time.Second},
+		{"batching.actions.create", 0},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, timeouts.forOperation(test.operationID),
+			"operation '%s'", test.operationID)
+	}
+}
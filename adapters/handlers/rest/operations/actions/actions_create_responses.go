@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -136,6 +137,50 @@ func (o *ActionsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// ActionsCreateConflictCode is the HTTP code returned for type ActionsCreateConflict
+const ActionsCreateConflictCode int = 409
+
+/*ActionsCreateConflict An object with the same id already exists.
+
+swagger:response actionsCreateConflict
+*/
+type ActionsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsCreateConflict creates ActionsCreateConflict with default headers values
+func NewActionsCreateConflict() *ActionsCreateConflict {
+
+	return &ActionsCreateConflict{}
+}
+
+// WithPayload adds the payload to the actions create conflict response
+func (o *ActionsCreateConflict) WithPayload(payload *models.ErrorResponse) *ActionsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions create conflict response
+func (o *ActionsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsCreateUnprocessableEntityCode is the HTTP code returned for type ActionsCreateUnprocessableEntity
 const ActionsCreateUnprocessableEntityCode int = 422
 
@@ -180,6 +225,73 @@ func (o *ActionsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ActionsCreateTooManyRequestsCode is the HTTP code returned for type ActionsCreateTooManyRequests
+const ActionsCreateTooManyRequestsCode int = 429
+
+/*ActionsCreateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response actionsCreateTooManyRequests
+*/
+type ActionsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsCreateTooManyRequests creates ActionsCreateTooManyRequests with default headers values
+func NewActionsCreateTooManyRequests() *ActionsCreateTooManyRequests {
+
+	return &ActionsCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the actions create too many requests response
+func (o *ActionsCreateTooManyRequests) WithRetryAfter(retryAfter int64) *ActionsCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the actions create too many requests response
+func (o *ActionsCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the actions create too many requests response
+func (o *ActionsCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *ActionsCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions create too many requests response
+func (o *ActionsCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsCreateInternalServerErrorCode is the HTTP code returned for type ActionsCreateInternalServerError
 const ActionsCreateInternalServerErrorCode int = 500
 
@@ -20,11 +20,18 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // ActionsCreateURL generates an URL for the actions create operation
 type ActionsCreateURL struct {
+	Consistency *string
+	IfNotExists *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +61,26 @@ func (o *ActionsCreateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var consistencyQ string
+	if o.Consistency != nil {
+		consistencyQ = *o.Consistency
+	}
+	if consistencyQ != "" {
+		qs.Set("consistency", consistencyQ)
+	}
+
+	var ifNotExistsQ string
+	if o.IfNotExists != nil {
+		ifNotExistsQ = swag.FormatBool(*o.IfNotExists)
+	}
+	if ifNotExistsQ != "" {
+		qs.Set("ifNotExists", ifNotExistsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
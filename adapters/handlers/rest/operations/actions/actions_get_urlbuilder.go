@@ -30,6 +30,8 @@ import (
 type ActionsGetURL struct {
 	ID strfmt.UUID
 
+	Depth   *int64
+	Expand  *string
 	Include *string
 	Meta    *bool
 
@@ -74,6 +76,22 @@ func (o *ActionsGetURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var depthQ string
+	if o.Depth != nil {
+		depthQ = swag.FormatInt64(*o.Depth)
+	}
+	if depthQ != "" {
+		qs.Set("depth", depthQ)
+	}
+
+	var expandQ string
+	if o.Expand != nil {
+		expandQ = *o.Expand
+	}
+	if expandQ != "" {
+		qs.Set("expand", expandQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
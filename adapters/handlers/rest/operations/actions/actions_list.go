@@ -46,7 +46,7 @@ func NewActionsList(ctx *middleware.Context, handler ActionsListHandler) *Action
 
 Get a list of Actions.
 
-Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.
+Lists all Actions in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Action per line.
 
 */
 type ActionsList struct {
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -164,6 +165,50 @@ func (o *ActionsPatchNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 	rw.WriteHeader(404)
 }
 
+// ActionsPatchConflictCode is the HTTP code returned for type ActionsPatchConflict
+const ActionsPatchConflictCode int = 409
+
+/*ActionsPatchConflict Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.
+
+swagger:response actionsPatchConflict
+*/
+type ActionsPatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsPatchConflict creates ActionsPatchConflict with default headers values
+func NewActionsPatchConflict() *ActionsPatchConflict {
+
+	return &ActionsPatchConflict{}
+}
+
+// WithPayload adds the payload to the actions patch conflict response
+func (o *ActionsPatchConflict) WithPayload(payload *models.ErrorResponse) *ActionsPatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions patch conflict response
+func (o *ActionsPatchConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsPatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsPatchUnprocessableEntityCode is the HTTP code returned for type ActionsPatchUnprocessableEntity
 const ActionsPatchUnprocessableEntityCode int = 422
 
@@ -208,6 +253,73 @@ func (o *ActionsPatchUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ActionsPatchTooManyRequestsCode is the HTTP code returned for type ActionsPatchTooManyRequests
+const ActionsPatchTooManyRequestsCode int = 429
+
+/*ActionsPatchTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response actionsPatchTooManyRequests
+*/
+type ActionsPatchTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsPatchTooManyRequests creates ActionsPatchTooManyRequests with default headers values
+func NewActionsPatchTooManyRequests() *ActionsPatchTooManyRequests {
+
+	return &ActionsPatchTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the actions patch too many requests response
+func (o *ActionsPatchTooManyRequests) WithRetryAfter(retryAfter int64) *ActionsPatchTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the actions patch too many requests response
+func (o *ActionsPatchTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the actions patch too many requests response
+func (o *ActionsPatchTooManyRequests) WithPayload(payload *models.ErrorResponse) *ActionsPatchTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions patch too many requests response
+func (o *ActionsPatchTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsPatchTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsPatchInternalServerErrorCode is the HTTP code returned for type ActionsPatchInternalServerError
 const ActionsPatchInternalServerErrorCode int = 500
 
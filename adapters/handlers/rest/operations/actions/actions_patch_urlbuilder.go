@@ -23,12 +23,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // ActionsPatchURL generates an URL for the actions patch operation
 type ActionsPatchURL struct {
 	ID strfmt.UUID
 
+	UniqueReferences *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -68,6 +71,18 @@ func (o *ActionsPatchURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var uniqueReferencesQ string
+	if o.UniqueReferences != nil {
+		uniqueReferencesQ = swag.FormatBool(*o.UniqueReferences)
+	}
+	if uniqueReferencesQ != "" {
+		qs.Set("uniqueReferences", uniqueReferencesQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -46,7 +46,7 @@ func NewActionsUpdate(ctx *middleware.Context, handler ActionsUpdateHandler) *Ac
 
 Update an Action based on its UUID.
 
-Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.
 
 */
 type ActionsUpdate struct {
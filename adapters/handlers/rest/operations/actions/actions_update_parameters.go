@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -50,11 +51,19 @@ type ActionsUpdateParams struct {
 	  In: body
 	*/
 	Body *models.Action
+	/*Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.
+	  In: query
+	*/
+	ChangedOnly *bool
 	/*Unique ID of the Action.
 	  Required: true
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.
+	  In: header
+	*/
+	IfMatch *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -66,6 +75,8 @@ func (o *ActionsUpdateParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Action
@@ -88,17 +99,48 @@ func (o *ActionsUpdateParams) BindRequest(r *http.Request, route *middleware.Mat
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	qChangedOnly, qhkChangedOnly, _ := qs.GetOK("changedOnly")
+	if err := o.bindChangedOnly(qChangedOnly, qhkChangedOnly, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindChangedOnly binds and validates parameter ChangedOnly from query.
+func (o *ActionsUpdateParams) bindChangedOnly(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("changedOnly", "query", "bool", raw)
+	}
+	o.ChangedOnly = &value
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ActionsUpdateParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -131,3 +173,25 @@ func (o *ActionsUpdateParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ActionsUpdateParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("If-Match", "header", "int64", raw)
+	}
+	o.IfMatch = &value
+
+	return nil
+}
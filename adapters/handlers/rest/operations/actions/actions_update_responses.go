@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -160,6 +161,50 @@ func (o *ActionsUpdateNotFound) WriteResponse(rw http.ResponseWriter, producer r
 	rw.WriteHeader(404)
 }
 
+// ActionsUpdateConflictCode is the HTTP code returned for type ActionsUpdateConflict
+const ActionsUpdateConflictCode int = 409
+
+/*ActionsUpdateConflict Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.
+
+swagger:response actionsUpdateConflict
+*/
+type ActionsUpdateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsUpdateConflict creates ActionsUpdateConflict with default headers values
+func NewActionsUpdateConflict() *ActionsUpdateConflict {
+
+	return &ActionsUpdateConflict{}
+}
+
+// WithPayload adds the payload to the actions update conflict response
+func (o *ActionsUpdateConflict) WithPayload(payload *models.ErrorResponse) *ActionsUpdateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions update conflict response
+func (o *ActionsUpdateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsUpdateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsUpdateUnprocessableEntityCode is the HTTP code returned for type ActionsUpdateUnprocessableEntity
 const ActionsUpdateUnprocessableEntityCode int = 422
 
@@ -204,6 +249,73 @@ func (o *ActionsUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ActionsUpdateTooManyRequestsCode is the HTTP code returned for type ActionsUpdateTooManyRequests
+const ActionsUpdateTooManyRequestsCode int = 429
+
+/*ActionsUpdateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response actionsUpdateTooManyRequests
+*/
+type ActionsUpdateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsUpdateTooManyRequests creates ActionsUpdateTooManyRequests with default headers values
+func NewActionsUpdateTooManyRequests() *ActionsUpdateTooManyRequests {
+
+	return &ActionsUpdateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the actions update too many requests response
+func (o *ActionsUpdateTooManyRequests) WithRetryAfter(retryAfter int64) *ActionsUpdateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the actions update too many requests response
+func (o *ActionsUpdateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the actions update too many requests response
+func (o *ActionsUpdateTooManyRequests) WithPayload(payload *models.ErrorResponse) *ActionsUpdateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions update too many requests response
+func (o *ActionsUpdateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsUpdateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsUpdateInternalServerErrorCode is the HTTP code returned for type ActionsUpdateInternalServerError
 const ActionsUpdateInternalServerErrorCode int = 500
 
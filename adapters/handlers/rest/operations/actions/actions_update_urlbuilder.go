@@ -23,12 +23,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // ActionsUpdateURL generates an URL for the actions update operation
 type ActionsUpdateURL struct {
 	ID strfmt.UUID
 
+	ChangedOnly *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -68,6 +71,18 @@ func (o *ActionsUpdateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var changedOnlyQ string
+	if o.ChangedOnly != nil {
+		changedOnlyQ = swag.FormatBool(*o.ChangedOnly)
+	}
+	if changedOnlyQ != "" {
+		qs.Set("changedOnly", changedOnlyQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -23,6 +23,8 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewBatchingActionsCreateParams creates a new BatchingActionsCreateParams object
@@ -46,6 +48,18 @@ type BatchingActionsCreateParams struct {
 	  In: body
 	*/
 	Body BatchingActionsCreateBody
+	/*A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.
+	  In: header
+	*/
+	IdempotencyKey *string
+	/*Only validate the objects in the batch without storing them. The response contains the validation result of each object.
+	  In: query
+	*/
+	ValidateOnly *bool
+	/*In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.
+	  In: query
+	*/
+	Vectorize *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -57,6 +71,8 @@ func (o *BatchingActionsCreateParams) BindRequest(r *http.Request, route *middle
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body BatchingActionsCreateBody
@@ -79,8 +95,84 @@ func (o *BatchingActionsCreateParams) BindRequest(r *http.Request, route *middle
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qValidateOnly, qhkValidateOnly, _ := qs.GetOK("validateOnly")
+	if err := o.bindValidateOnly(qValidateOnly, qhkValidateOnly, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVectorize, qhkVectorize, _ := qs.GetOK("vectorize")
+	if err := o.bindVectorize(qVectorize, qhkVectorize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindIdempotencyKey binds and validates parameter IdempotencyKey from header.
+func (o *BatchingActionsCreateParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IdempotencyKey = &raw
+
+	return nil
+}
+
+// bindValidateOnly binds and validates parameter ValidateOnly from query.
+func (o *BatchingActionsCreateParams) bindValidateOnly(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("validateOnly", "query", "bool", raw)
+	}
+	o.ValidateOnly = &value
+
+	return nil
+}
+
+// bindVectorize binds and validates parameter Vectorize from query.
+func (o *BatchingActionsCreateParams) bindVectorize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("vectorize", "query", "bool", raw)
+	}
+	o.Vectorize = &value
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -27,11 +28,36 @@ import (
 // BatchingActionsCreateOKCode is the HTTP code returned for type BatchingActionsCreateOK
 const BatchingActionsCreateOKCode int = 200
 
-/*BatchingActionsCreateOK Request succeeded, see response body to get detailed information about each batched item.
+/*BatchingActionsCreateOK Request succeeded and every batched item was created, see response body to get detailed information about each batched item.
 
 swagger:response batchingActionsCreateOK
 */
 type BatchingActionsCreateOK struct {
+	/*Number of items which failed.
+
+	 */
+	XBatchFailed int64 `json:"X-Batch-Failed"`
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+
+	 */
+	XBatchFailedConflict int64 `json:"X-Batch-Failed-Conflict"`
+	/*Number of failed items which could not be imported because of a server-side error.
+
+	 */
+	XBatchFailedInternal int64 `json:"X-Batch-Failed-Internal"`
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+
+	 */
+	XBatchFailedValidation int64 `json:"X-Batch-Failed-Validation"`
+	/*Number of items which were imported successfully.
+
+	 */
+	XBatchSucceeded int64 `json:"X-Batch-Succeeded"`
+	/*Number of items in the batch.
+
+	 */
+	XBatchTotal int64 `json:"X-Batch-Total"`
+
 
 	/*
 	  In: Body
@@ -45,6 +71,72 @@ func NewBatchingActionsCreateOK() *BatchingActionsCreateOK {
 	return &BatchingActionsCreateOK{}
 }
 
+// WithXBatchFailed adds the xBatchFailed to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchFailed(xBatchFailed int64) *BatchingActionsCreateOK {
+	o.XBatchFailed = xBatchFailed
+	return o
+}
+
+// SetXBatchFailed sets the xBatchFailed to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchFailed(xBatchFailed int64) {
+	o.XBatchFailed = xBatchFailed
+}
+
+// WithXBatchFailedConflict adds the xBatchFailedConflict to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchFailedConflict(xBatchFailedConflict int64) *BatchingActionsCreateOK {
+	o.XBatchFailedConflict = xBatchFailedConflict
+	return o
+}
+
+// SetXBatchFailedConflict sets the xBatchFailedConflict to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchFailedConflict(xBatchFailedConflict int64) {
+	o.XBatchFailedConflict = xBatchFailedConflict
+}
+
+// WithXBatchFailedInternal adds the xBatchFailedInternal to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchFailedInternal(xBatchFailedInternal int64) *BatchingActionsCreateOK {
+	o.XBatchFailedInternal = xBatchFailedInternal
+	return o
+}
+
+// SetXBatchFailedInternal sets the xBatchFailedInternal to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchFailedInternal(xBatchFailedInternal int64) {
+	o.XBatchFailedInternal = xBatchFailedInternal
+}
+
+// WithXBatchFailedValidation adds the xBatchFailedValidation to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchFailedValidation(xBatchFailedValidation int64) *BatchingActionsCreateOK {
+	o.XBatchFailedValidation = xBatchFailedValidation
+	return o
+}
+
+// SetXBatchFailedValidation sets the xBatchFailedValidation to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchFailedValidation(xBatchFailedValidation int64) {
+	o.XBatchFailedValidation = xBatchFailedValidation
+}
+
+// WithXBatchSucceeded adds the xBatchSucceeded to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchSucceeded(xBatchSucceeded int64) *BatchingActionsCreateOK {
+	o.XBatchSucceeded = xBatchSucceeded
+	return o
+}
+
+// SetXBatchSucceeded sets the xBatchSucceeded to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchSucceeded(xBatchSucceeded int64) {
+	o.XBatchSucceeded = xBatchSucceeded
+}
+
+// WithXBatchTotal adds the xBatchTotal to the batching actions create o k response
+func (o *BatchingActionsCreateOK) WithXBatchTotal(xBatchTotal int64) *BatchingActionsCreateOK {
+	o.XBatchTotal = xBatchTotal
+	return o
+}
+
+// SetXBatchTotal sets the xBatchTotal to the batching actions create o k response
+func (o *BatchingActionsCreateOK) SetXBatchTotal(xBatchTotal int64) {
+	o.XBatchTotal = xBatchTotal
+}
+
 // WithPayload adds the payload to the batching actions create o k response
 func (o *BatchingActionsCreateOK) WithPayload(payload []*models.ActionsGetResponse) *BatchingActionsCreateOK {
 	o.Payload = payload
@@ -59,6 +151,48 @@ func (o *BatchingActionsCreateOK) SetPayload(payload []*models.ActionsGetRespons
 // WriteResponse to the client
 func (o *BatchingActionsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Batch-Failed
+
+	xBatchFailed := swag.FormatInt64(o.XBatchFailed)
+	if xBatchFailed != "" {
+		rw.Header().Set("X-Batch-Failed", xBatchFailed)
+	}
+
+	// response header X-Batch-Failed-Conflict
+
+	xBatchFailedConflict := swag.FormatInt64(o.XBatchFailedConflict)
+	if xBatchFailedConflict != "" {
+		rw.Header().Set("X-Batch-Failed-Conflict", xBatchFailedConflict)
+	}
+
+	// response header X-Batch-Failed-Internal
+
+	xBatchFailedInternal := swag.FormatInt64(o.XBatchFailedInternal)
+	if xBatchFailedInternal != "" {
+		rw.Header().Set("X-Batch-Failed-Internal", xBatchFailedInternal)
+	}
+
+	// response header X-Batch-Failed-Validation
+
+	xBatchFailedValidation := swag.FormatInt64(o.XBatchFailedValidation)
+	if xBatchFailedValidation != "" {
+		rw.Header().Set("X-Batch-Failed-Validation", xBatchFailedValidation)
+	}
+
+	// response header X-Batch-Succeeded
+
+	xBatchSucceeded := swag.FormatInt64(o.XBatchSucceeded)
+	if xBatchSucceeded != "" {
+		rw.Header().Set("X-Batch-Succeeded", xBatchSucceeded)
+	}
+
+	// response header X-Batch-Total
+
+	xBatchTotal := swag.FormatInt64(o.XBatchTotal)
+	if xBatchTotal != "" {
+		rw.Header().Set("X-Batch-Total", xBatchTotal)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -71,6 +205,186 @@ func (o *BatchingActionsCreateOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// BatchingActionsCreateMultiStatusCode is the HTTP code returned for type BatchingActionsCreateMultiStatus
+const BatchingActionsCreateMultiStatusCode int = 207
+
+/*BatchingActionsCreateMultiStatus Request succeeded, but at least one of the batched actions failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+
+swagger:response batchingActionsCreateMultiStatus
+*/
+type BatchingActionsCreateMultiStatus struct {
+	/*Number of items which failed.
+
+	 */
+	XBatchFailed int64 `json:"X-Batch-Failed"`
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+
+	 */
+	XBatchFailedConflict int64 `json:"X-Batch-Failed-Conflict"`
+	/*Number of failed items which could not be imported because of a server-side error.
+
+	 */
+	XBatchFailedInternal int64 `json:"X-Batch-Failed-Internal"`
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+
+	 */
+	XBatchFailedValidation int64 `json:"X-Batch-Failed-Validation"`
+	/*Number of items which were imported successfully.
+
+	 */
+	XBatchSucceeded int64 `json:"X-Batch-Succeeded"`
+	/*Number of items in the batch.
+
+	 */
+	XBatchTotal int64 `json:"X-Batch-Total"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ActionsGetResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsCreateMultiStatus creates BatchingActionsCreateMultiStatus with default headers values
+func NewBatchingActionsCreateMultiStatus() *BatchingActionsCreateMultiStatus {
+
+	return &BatchingActionsCreateMultiStatus{}
+}
+
+// WithXBatchFailed adds the xBatchFailed to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchFailed(xBatchFailed int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchFailed = xBatchFailed
+	return o
+}
+
+// SetXBatchFailed sets the xBatchFailed to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchFailed(xBatchFailed int64) {
+	o.XBatchFailed = xBatchFailed
+}
+
+// WithXBatchFailedConflict adds the xBatchFailedConflict to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchFailedConflict(xBatchFailedConflict int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchFailedConflict = xBatchFailedConflict
+	return o
+}
+
+// SetXBatchFailedConflict sets the xBatchFailedConflict to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchFailedConflict(xBatchFailedConflict int64) {
+	o.XBatchFailedConflict = xBatchFailedConflict
+}
+
+// WithXBatchFailedInternal adds the xBatchFailedInternal to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchFailedInternal(xBatchFailedInternal int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchFailedInternal = xBatchFailedInternal
+	return o
+}
+
+// SetXBatchFailedInternal sets the xBatchFailedInternal to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchFailedInternal(xBatchFailedInternal int64) {
+	o.XBatchFailedInternal = xBatchFailedInternal
+}
+
+// WithXBatchFailedValidation adds the xBatchFailedValidation to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchFailedValidation(xBatchFailedValidation int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchFailedValidation = xBatchFailedValidation
+	return o
+}
+
+// SetXBatchFailedValidation sets the xBatchFailedValidation to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchFailedValidation(xBatchFailedValidation int64) {
+	o.XBatchFailedValidation = xBatchFailedValidation
+}
+
+// WithXBatchSucceeded adds the xBatchSucceeded to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchSucceeded(xBatchSucceeded int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchSucceeded = xBatchSucceeded
+	return o
+}
+
+// SetXBatchSucceeded sets the xBatchSucceeded to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchSucceeded(xBatchSucceeded int64) {
+	o.XBatchSucceeded = xBatchSucceeded
+}
+
+// WithXBatchTotal adds the xBatchTotal to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithXBatchTotal(xBatchTotal int64) *BatchingActionsCreateMultiStatus {
+	o.XBatchTotal = xBatchTotal
+	return o
+}
+
+// SetXBatchTotal sets the xBatchTotal to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetXBatchTotal(xBatchTotal int64) {
+	o.XBatchTotal = xBatchTotal
+}
+
+// WithPayload adds the payload to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) WithPayload(payload []*models.ActionsGetResponse) *BatchingActionsCreateMultiStatus {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions create multi status response
+func (o *BatchingActionsCreateMultiStatus) SetPayload(payload []*models.ActionsGetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsCreateMultiStatus) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header X-Batch-Failed
+
+	xBatchFailed := swag.FormatInt64(o.XBatchFailed)
+	if xBatchFailed != "" {
+		rw.Header().Set("X-Batch-Failed", xBatchFailed)
+	}
+
+	// response header X-Batch-Failed-Conflict
+
+	xBatchFailedConflict := swag.FormatInt64(o.XBatchFailedConflict)
+	if xBatchFailedConflict != "" {
+		rw.Header().Set("X-Batch-Failed-Conflict", xBatchFailedConflict)
+	}
+
+	// response header X-Batch-Failed-Internal
+
+	xBatchFailedInternal := swag.FormatInt64(o.XBatchFailedInternal)
+	if xBatchFailedInternal != "" {
+		rw.Header().Set("X-Batch-Failed-Internal", xBatchFailedInternal)
+	}
+
+	// response header X-Batch-Failed-Validation
+
+	xBatchFailedValidation := swag.FormatInt64(o.XBatchFailedValidation)
+	if xBatchFailedValidation != "" {
+		rw.Header().Set("X-Batch-Failed-Validation", xBatchFailedValidation)
+	}
+
+	// response header X-Batch-Succeeded
+
+	xBatchSucceeded := swag.FormatInt64(o.XBatchSucceeded)
+	if xBatchSucceeded != "" {
+		rw.Header().Set("X-Batch-Succeeded", xBatchSucceeded)
+	}
+
+	// response header X-Batch-Total
+
+	xBatchTotal := swag.FormatInt64(o.XBatchTotal)
+	if xBatchTotal != "" {
+		rw.Header().Set("X-Batch-Total", xBatchTotal)
+	}
+
+	rw.WriteHeader(207)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ActionsGetResponse, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // BatchingActionsCreateUnauthorizedCode is the HTTP code returned for type BatchingActionsCreateUnauthorized
 const BatchingActionsCreateUnauthorizedCode int = 401
 
@@ -139,6 +453,50 @@ func (o *BatchingActionsCreateForbidden) WriteResponse(rw http.ResponseWriter, p
 	}
 }
 
+// BatchingActionsCreateConflictCode is the HTTP code returned for type BatchingActionsCreateConflict
+const BatchingActionsCreateConflictCode int = 409
+
+/*BatchingActionsCreateConflict A request with the same idempotency key is still being executed.
+
+swagger:response batchingActionsCreateConflict
+*/
+type BatchingActionsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsCreateConflict creates BatchingActionsCreateConflict with default headers values
+func NewBatchingActionsCreateConflict() *BatchingActionsCreateConflict {
+
+	return &BatchingActionsCreateConflict{}
+}
+
+// WithPayload adds the payload to the batching actions create conflict response
+func (o *BatchingActionsCreateConflict) WithPayload(payload *models.ErrorResponse) *BatchingActionsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions create conflict response
+func (o *BatchingActionsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchingActionsCreateUnprocessableEntityCode is the HTTP code returned for type BatchingActionsCreateUnprocessableEntity
 const BatchingActionsCreateUnprocessableEntityCode int = 422
 
@@ -183,6 +541,73 @@ func (o *BatchingActionsCreateUnprocessableEntity) WriteResponse(rw http.Respons
 	}
 }
 
+// BatchingActionsCreateTooManyRequestsCode is the HTTP code returned for type BatchingActionsCreateTooManyRequests
+const BatchingActionsCreateTooManyRequestsCode int = 429
+
+/*BatchingActionsCreateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+
+swagger:response batchingActionsCreateTooManyRequests
+*/
+type BatchingActionsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsCreateTooManyRequests creates BatchingActionsCreateTooManyRequests with default headers values
+func NewBatchingActionsCreateTooManyRequests() *BatchingActionsCreateTooManyRequests {
+
+	return &BatchingActionsCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the batching actions create too many requests response
+func (o *BatchingActionsCreateTooManyRequests) WithRetryAfter(retryAfter int64) *BatchingActionsCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the batching actions create too many requests response
+func (o *BatchingActionsCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the batching actions create too many requests response
+func (o *BatchingActionsCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *BatchingActionsCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions create too many requests response
+func (o *BatchingActionsCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchingActionsCreateInternalServerErrorCode is the HTTP code returned for type BatchingActionsCreateInternalServerError
 const BatchingActionsCreateInternalServerErrorCode int = 500
 
@@ -46,7 +46,7 @@ func NewBatchingReferencesCreate(ctx *middleware.Context, handler BatchingRefere
 
 Creates new Cross-References between arbitrary classes in bulk.
 
-Register cross-references between any class items (things or actions) in bulk.
+Register cross-references between any class items (things or actions) in bulk. With validateOnly, every reference is only validated without adding it: the source and target objects must exist, the property must be a reference property of the source class and the target must be of one of the classes the property can point to.
 
 */
 type BatchingReferencesCreate struct {
@@ -23,6 +23,8 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -48,6 +50,10 @@ type BatchingReferencesCreateParams struct {
 	  In: body
 	*/
 	Body []*models.BatchReference
+	/*Only validate the objects in the batch without storing them. The response contains the validation result of each object.
+	  In: query
+	*/
+	ValidateOnly *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -59,6 +65,8 @@ func (o *BatchingReferencesCreateParams) BindRequest(r *http.Request, route *mid
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body []*models.BatchReference
@@ -86,8 +94,35 @@ func (o *BatchingReferencesCreateParams) BindRequest(r *http.Request, route *mid
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	qValidateOnly, qhkValidateOnly, _ := qs.GetOK("validateOnly")
+	if err := o.bindValidateOnly(qValidateOnly, qhkValidateOnly, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindValidateOnly binds and validates parameter ValidateOnly from query.
+func (o *BatchingReferencesCreateParams) bindValidateOnly(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("validateOnly", "query", "bool", raw)
+	}
+	o.ValidateOnly = &value
+
+	return nil
+}
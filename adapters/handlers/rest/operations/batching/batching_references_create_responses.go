@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -27,7 +28,7 @@ import (
 // BatchingReferencesCreateOKCode is the HTTP code returned for type BatchingReferencesCreateOK
 const BatchingReferencesCreateOKCode int = 200
 
-/*BatchingReferencesCreateOK Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.
+/*BatchingReferencesCreateOK Request succeeded and every batched reference was created (or is valid with validateOnly), see response body to get detailed information about each batched reference.
 
 swagger:response batchingReferencesCreateOK
 */
@@ -71,6 +72,53 @@ func (o *BatchingReferencesCreateOK) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// BatchingReferencesCreateMultiStatusCode is the HTTP code returned for type BatchingReferencesCreateMultiStatus
+const BatchingReferencesCreateMultiStatusCode int = 207
+
+/*BatchingReferencesCreateMultiStatus Request succeeded, but at least one of the batched references failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+
+swagger:response batchingReferencesCreateMultiStatus
+*/
+type BatchingReferencesCreateMultiStatus struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.BatchReferenceResponse `json:"body,omitempty"`
+}
+
+// NewBatchingReferencesCreateMultiStatus creates BatchingReferencesCreateMultiStatus with default headers values
+func NewBatchingReferencesCreateMultiStatus() *BatchingReferencesCreateMultiStatus {
+
+	return &BatchingReferencesCreateMultiStatus{}
+}
+
+// WithPayload adds the payload to the batching references create multi status response
+func (o *BatchingReferencesCreateMultiStatus) WithPayload(payload []*models.BatchReferenceResponse) *BatchingReferencesCreateMultiStatus {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching references create multi status response
+func (o *BatchingReferencesCreateMultiStatus) SetPayload(payload []*models.BatchReferenceResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingReferencesCreateMultiStatus) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(207)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.BatchReferenceResponse, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // BatchingReferencesCreateUnauthorizedCode is the HTTP code returned for type BatchingReferencesCreateUnauthorized
 const BatchingReferencesCreateUnauthorizedCode int = 401
 
@@ -183,6 +231,73 @@ func (o *BatchingReferencesCreateUnprocessableEntity) WriteResponse(rw http.Resp
 	}
 }
 
+// BatchingReferencesCreateTooManyRequestsCode is the HTTP code returned for type BatchingReferencesCreateTooManyRequests
+const BatchingReferencesCreateTooManyRequestsCode int = 429
+
+/*BatchingReferencesCreateTooManyRequests Too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+
+swagger:response batchingReferencesCreateTooManyRequests
+*/
+type BatchingReferencesCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingReferencesCreateTooManyRequests creates BatchingReferencesCreateTooManyRequests with default headers values
+func NewBatchingReferencesCreateTooManyRequests() *BatchingReferencesCreateTooManyRequests {
+
+	return &BatchingReferencesCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the batching references create too many requests response
+func (o *BatchingReferencesCreateTooManyRequests) WithRetryAfter(retryAfter int64) *BatchingReferencesCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the batching references create too many requests response
+func (o *BatchingReferencesCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the batching references create too many requests response
+func (o *BatchingReferencesCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *BatchingReferencesCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching references create too many requests response
+func (o *BatchingReferencesCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingReferencesCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchingReferencesCreateInternalServerErrorCode is the HTTP code returned for type BatchingReferencesCreateInternalServerError
 const BatchingReferencesCreateInternalServerErrorCode int = 500
 
@@ -20,11 +20,17 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // BatchingReferencesCreateURL generates an URL for the batching references create operation
 type BatchingReferencesCreateURL struct {
+	ValidateOnly *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +60,18 @@ func (o *BatchingReferencesCreateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var validateOnlyQ string
+	if o.ValidateOnly != nil {
+		validateOnlyQ = swag.FormatBool(*o.ValidateOnly)
+	}
+	if validateOnlyQ != "" {
+		qs.Set("validateOnly", validateOnlyQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -27,11 +28,36 @@ import (
 // BatchingThingsCreateOKCode is the HTTP code returned for type BatchingThingsCreateOK
 const BatchingThingsCreateOKCode int = 200
 
-/*BatchingThingsCreateOK Request succeeded, see response body to get detailed information about each batched item.
+/*BatchingThingsCreateOK Request succeeded and every batched item was created, see response body to get detailed information about each batched item.
 
 swagger:response batchingThingsCreateOK
 */
 type BatchingThingsCreateOK struct {
+	/*Number of items which failed.
+
+	 */
+	XBatchFailed int64 `json:"X-Batch-Failed"`
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+
+	 */
+	XBatchFailedConflict int64 `json:"X-Batch-Failed-Conflict"`
+	/*Number of failed items which could not be imported because of a server-side error.
+
+	 */
+	XBatchFailedInternal int64 `json:"X-Batch-Failed-Internal"`
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+
+	 */
+	XBatchFailedValidation int64 `json:"X-Batch-Failed-Validation"`
+	/*Number of items which were imported successfully.
+
+	 */
+	XBatchSucceeded int64 `json:"X-Batch-Succeeded"`
+	/*Number of items in the batch.
+
+	 */
+	XBatchTotal int64 `json:"X-Batch-Total"`
+
 
 	/*
 	  In: Body
@@ -45,6 +71,72 @@ func NewBatchingThingsCreateOK() *BatchingThingsCreateOK {
 	return &BatchingThingsCreateOK{}
 }
 
+// WithXBatchFailed adds the xBatchFailed to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchFailed(xBatchFailed int64) *BatchingThingsCreateOK {
+	o.XBatchFailed = xBatchFailed
+	return o
+}
+
+// SetXBatchFailed sets the xBatchFailed to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchFailed(xBatchFailed int64) {
+	o.XBatchFailed = xBatchFailed
+}
+
+// WithXBatchFailedConflict adds the xBatchFailedConflict to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchFailedConflict(xBatchFailedConflict int64) *BatchingThingsCreateOK {
+	o.XBatchFailedConflict = xBatchFailedConflict
+	return o
+}
+
+// SetXBatchFailedConflict sets the xBatchFailedConflict to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchFailedConflict(xBatchFailedConflict int64) {
+	o.XBatchFailedConflict = xBatchFailedConflict
+}
+
+// WithXBatchFailedInternal adds the xBatchFailedInternal to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchFailedInternal(xBatchFailedInternal int64) *BatchingThingsCreateOK {
+	o.XBatchFailedInternal = xBatchFailedInternal
+	return o
+}
+
+// SetXBatchFailedInternal sets the xBatchFailedInternal to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchFailedInternal(xBatchFailedInternal int64) {
+	o.XBatchFailedInternal = xBatchFailedInternal
+}
+
+// WithXBatchFailedValidation adds the xBatchFailedValidation to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchFailedValidation(xBatchFailedValidation int64) *BatchingThingsCreateOK {
+	o.XBatchFailedValidation = xBatchFailedValidation
+	return o
+}
+
+// SetXBatchFailedValidation sets the xBatchFailedValidation to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchFailedValidation(xBatchFailedValidation int64) {
+	o.XBatchFailedValidation = xBatchFailedValidation
+}
+
+// WithXBatchSucceeded adds the xBatchSucceeded to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchSucceeded(xBatchSucceeded int64) *BatchingThingsCreateOK {
+	o.XBatchSucceeded = xBatchSucceeded
+	return o
+}
+
+// SetXBatchSucceeded sets the xBatchSucceeded to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchSucceeded(xBatchSucceeded int64) {
+	o.XBatchSucceeded = xBatchSucceeded
+}
+
+// WithXBatchTotal adds the xBatchTotal to the batching things create o k response
+func (o *BatchingThingsCreateOK) WithXBatchTotal(xBatchTotal int64) *BatchingThingsCreateOK {
+	o.XBatchTotal = xBatchTotal
+	return o
+}
+
+// SetXBatchTotal sets the xBatchTotal to the batching things create o k response
+func (o *BatchingThingsCreateOK) SetXBatchTotal(xBatchTotal int64) {
+	o.XBatchTotal = xBatchTotal
+}
+
 // WithPayload adds the payload to the batching things create o k response
 func (o *BatchingThingsCreateOK) WithPayload(payload []*models.ThingsGetResponse) *BatchingThingsCreateOK {
 	o.Payload = payload
@@ -59,6 +151,48 @@ func (o *BatchingThingsCreateOK) SetPayload(payload []*models.ThingsGetResponse)
 // WriteResponse to the client
 func (o *BatchingThingsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Batch-Failed
+
+	xBatchFailed := swag.FormatInt64(o.XBatchFailed)
+	if xBatchFailed != "" {
+		rw.Header().Set("X-Batch-Failed", xBatchFailed)
+	}
+
+	// response header X-Batch-Failed-Conflict
+
+	xBatchFailedConflict := swag.FormatInt64(o.XBatchFailedConflict)
+	if xBatchFailedConflict != "" {
+		rw.Header().Set("X-Batch-Failed-Conflict", xBatchFailedConflict)
+	}
+
+	// response header X-Batch-Failed-Internal
+
+	xBatchFailedInternal := swag.FormatInt64(o.XBatchFailedInternal)
+	if xBatchFailedInternal != "" {
+		rw.Header().Set("X-Batch-Failed-Internal", xBatchFailedInternal)
+	}
+
+	// response header X-Batch-Failed-Validation
+
+	xBatchFailedValidation := swag.FormatInt64(o.XBatchFailedValidation)
+	if xBatchFailedValidation != "" {
+		rw.Header().Set("X-Batch-Failed-Validation", xBatchFailedValidation)
+	}
+
+	// response header X-Batch-Succeeded
+
+	xBatchSucceeded := swag.FormatInt64(o.XBatchSucceeded)
+	if xBatchSucceeded != "" {
+		rw.Header().Set("X-Batch-Succeeded", xBatchSucceeded)
+	}
+
+	// response header X-Batch-Total
+
+	xBatchTotal := swag.FormatInt64(o.XBatchTotal)
+	if xBatchTotal != "" {
+		rw.Header().Set("X-Batch-Total", xBatchTotal)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -71,6 +205,186 @@ func (o *BatchingThingsCreateOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// BatchingThingsCreateMultiStatusCode is the HTTP code returned for type BatchingThingsCreateMultiStatus
+const BatchingThingsCreateMultiStatusCode int = 207
+
+/*BatchingThingsCreateMultiStatus Request succeeded, but at least one of the batched things failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+
+swagger:response batchingThingsCreateMultiStatus
+*/
+type BatchingThingsCreateMultiStatus struct {
+	/*Number of items which failed.
+
+	 */
+	XBatchFailed int64 `json:"X-Batch-Failed"`
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+
+	 */
+	XBatchFailedConflict int64 `json:"X-Batch-Failed-Conflict"`
+	/*Number of failed items which could not be imported because of a server-side error.
+
+	 */
+	XBatchFailedInternal int64 `json:"X-Batch-Failed-Internal"`
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+
+	 */
+	XBatchFailedValidation int64 `json:"X-Batch-Failed-Validation"`
+	/*Number of items which were imported successfully.
+
+	 */
+	XBatchSucceeded int64 `json:"X-Batch-Succeeded"`
+	/*Number of items in the batch.
+
+	 */
+	XBatchTotal int64 `json:"X-Batch-Total"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ThingsGetResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsCreateMultiStatus creates BatchingThingsCreateMultiStatus with default headers values
+func NewBatchingThingsCreateMultiStatus() *BatchingThingsCreateMultiStatus {
+
+	return &BatchingThingsCreateMultiStatus{}
+}
+
+// WithXBatchFailed adds the xBatchFailed to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchFailed(xBatchFailed int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchFailed = xBatchFailed
+	return o
+}
+
+// SetXBatchFailed sets the xBatchFailed to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchFailed(xBatchFailed int64) {
+	o.XBatchFailed = xBatchFailed
+}
+
+// WithXBatchFailedConflict adds the xBatchFailedConflict to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchFailedConflict(xBatchFailedConflict int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchFailedConflict = xBatchFailedConflict
+	return o
+}
+
+// SetXBatchFailedConflict sets the xBatchFailedConflict to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchFailedConflict(xBatchFailedConflict int64) {
+	o.XBatchFailedConflict = xBatchFailedConflict
+}
+
+// WithXBatchFailedInternal adds the xBatchFailedInternal to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchFailedInternal(xBatchFailedInternal int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchFailedInternal = xBatchFailedInternal
+	return o
+}
+
+// SetXBatchFailedInternal sets the xBatchFailedInternal to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchFailedInternal(xBatchFailedInternal int64) {
+	o.XBatchFailedInternal = xBatchFailedInternal
+}
+
+// WithXBatchFailedValidation adds the xBatchFailedValidation to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchFailedValidation(xBatchFailedValidation int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchFailedValidation = xBatchFailedValidation
+	return o
+}
+
+// SetXBatchFailedValidation sets the xBatchFailedValidation to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchFailedValidation(xBatchFailedValidation int64) {
+	o.XBatchFailedValidation = xBatchFailedValidation
+}
+
+// WithXBatchSucceeded adds the xBatchSucceeded to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchSucceeded(xBatchSucceeded int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchSucceeded = xBatchSucceeded
+	return o
+}
+
+// SetXBatchSucceeded sets the xBatchSucceeded to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchSucceeded(xBatchSucceeded int64) {
+	o.XBatchSucceeded = xBatchSucceeded
+}
+
+// WithXBatchTotal adds the xBatchTotal to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithXBatchTotal(xBatchTotal int64) *BatchingThingsCreateMultiStatus {
+	o.XBatchTotal = xBatchTotal
+	return o
+}
+
+// SetXBatchTotal sets the xBatchTotal to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetXBatchTotal(xBatchTotal int64) {
+	o.XBatchTotal = xBatchTotal
+}
+
+// WithPayload adds the payload to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) WithPayload(payload []*models.ThingsGetResponse) *BatchingThingsCreateMultiStatus {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things create multi status response
+func (o *BatchingThingsCreateMultiStatus) SetPayload(payload []*models.ThingsGetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsCreateMultiStatus) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header X-Batch-Failed
+
+	xBatchFailed := swag.FormatInt64(o.XBatchFailed)
+	if xBatchFailed != "" {
+		rw.Header().Set("X-Batch-Failed", xBatchFailed)
+	}
+
+	// response header X-Batch-Failed-Conflict
+
+	xBatchFailedConflict := swag.FormatInt64(o.XBatchFailedConflict)
+	if xBatchFailedConflict != "" {
+		rw.Header().Set("X-Batch-Failed-Conflict", xBatchFailedConflict)
+	}
+
+	// response header X-Batch-Failed-Internal
+
+	xBatchFailedInternal := swag.FormatInt64(o.XBatchFailedInternal)
+	if xBatchFailedInternal != "" {
+		rw.Header().Set("X-Batch-Failed-Internal", xBatchFailedInternal)
+	}
+
+	// response header X-Batch-Failed-Validation
+
+	xBatchFailedValidation := swag.FormatInt64(o.XBatchFailedValidation)
+	if xBatchFailedValidation != "" {
+		rw.Header().Set("X-Batch-Failed-Validation", xBatchFailedValidation)
+	}
+
+	// response header X-Batch-Succeeded
+
+	xBatchSucceeded := swag.FormatInt64(o.XBatchSucceeded)
+	if xBatchSucceeded != "" {
+		rw.Header().Set("X-Batch-Succeeded", xBatchSucceeded)
+	}
+
+	// response header X-Batch-Total
+
+	xBatchTotal := swag.FormatInt64(o.XBatchTotal)
+	if xBatchTotal != "" {
+		rw.Header().Set("X-Batch-Total", xBatchTotal)
+	}
+
+	rw.WriteHeader(207)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ThingsGetResponse, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // BatchingThingsCreateUnauthorizedCode is the HTTP code returned for type BatchingThingsCreateUnauthorized
 const BatchingThingsCreateUnauthorizedCode int = 401
 
@@ -139,6 +453,50 @@ func (o *BatchingThingsCreateForbidden) WriteResponse(rw http.ResponseWriter, pr
 	}
 }
 
+// BatchingThingsCreateConflictCode is the HTTP code returned for type BatchingThingsCreateConflict
+const BatchingThingsCreateConflictCode int = 409
+
+/*BatchingThingsCreateConflict A request with the same idempotency key is still being executed.
+
+swagger:response batchingThingsCreateConflict
+*/
+type BatchingThingsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsCreateConflict creates BatchingThingsCreateConflict with default headers values
+func NewBatchingThingsCreateConflict() *BatchingThingsCreateConflict {
+
+	return &BatchingThingsCreateConflict{}
+}
+
+// WithPayload adds the payload to the batching things create conflict response
+func (o *BatchingThingsCreateConflict) WithPayload(payload *models.ErrorResponse) *BatchingThingsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things create conflict response
+func (o *BatchingThingsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchingThingsCreateUnprocessableEntityCode is the HTTP code returned for type BatchingThingsCreateUnprocessableEntity
 const BatchingThingsCreateUnprocessableEntityCode int = 422
 
@@ -183,6 +541,73 @@ func (o *BatchingThingsCreateUnprocessableEntity) WriteResponse(rw http.Response
 	}
 }
 
+// BatchingThingsCreateTooManyRequestsCode is the HTTP code returned for type BatchingThingsCreateTooManyRequests
+const BatchingThingsCreateTooManyRequestsCode int = 429
+
+/*BatchingThingsCreateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+
+swagger:response batchingThingsCreateTooManyRequests
+*/
+type BatchingThingsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsCreateTooManyRequests creates BatchingThingsCreateTooManyRequests with default headers values
+func NewBatchingThingsCreateTooManyRequests() *BatchingThingsCreateTooManyRequests {
+
+	return &BatchingThingsCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the batching things create too many requests response
+func (o *BatchingThingsCreateTooManyRequests) WithRetryAfter(retryAfter int64) *BatchingThingsCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the batching things create too many requests response
+func (o *BatchingThingsCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the batching things create too many requests response
+func (o *BatchingThingsCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *BatchingThingsCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things create too many requests response
+func (o *BatchingThingsCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchingThingsCreateInternalServerErrorCode is the HTTP code returned for type BatchingThingsCreateInternalServerError
 const BatchingThingsCreateInternalServerErrorCode int = 500
 
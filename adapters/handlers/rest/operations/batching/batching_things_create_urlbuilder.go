@@ -20,11 +20,18 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // BatchingThingsCreateURL generates an URL for the batching things create operation
 type BatchingThingsCreateURL struct {
+	ValidateOnly *bool
+	Vectorize    *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +61,26 @@ func (o *BatchingThingsCreateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var validateOnlyQ string
+	if o.ValidateOnly != nil {
+		validateOnlyQ = swag.FormatBool(*o.ValidateOnly)
+	}
+	if validateOnlyQ != "" {
+		qs.Set("validateOnly", validateOnlyQ)
+	}
+
+	var vectorizeQ string
+	if o.Vectorize != nil {
+		vectorizeQ = swag.FormatBool(*o.Vectorize)
+	}
+	if vectorizeQ != "" {
+		qs.Set("vectorize", vectorizeQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
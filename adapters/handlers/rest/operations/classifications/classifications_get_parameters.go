@@ -20,8 +20,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewClassificationsGetParams creates a new ClassificationsGetParams object
@@ -45,6 +47,10 @@ type ClassificationsGetParams struct {
 	  In: path
 	*/
 	ID string
+	/*If set, the request blocks until the classification is no longer running, but at most for the specified number of seconds (capped at 300). The classification is returned in its state at that time, so a status of 'running' indicates the wait timed out.
+	  In: query
+	*/
+	WaitTimeoutSeconds *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -56,11 +62,18 @@ func (o *ClassificationsGetParams) BindRequest(r *http.Request, route *middlewar
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qWaitTimeoutSeconds, qhkWaitTimeoutSeconds, _ := qs.GetOK("waitTimeoutSeconds")
+	if err := o.bindWaitTimeoutSeconds(qWaitTimeoutSeconds, qhkWaitTimeoutSeconds, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -81,3 +94,25 @@ func (o *ClassificationsGetParams) bindID(rawData []string, hasKey bool, formats
 
 	return nil
 }
+
+// bindWaitTimeoutSeconds binds and validates parameter WaitTimeoutSeconds from query.
+func (o *ClassificationsGetParams) bindWaitTimeoutSeconds(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("waitTimeoutSeconds", "query", "int64", raw)
+	}
+	o.WaitTimeoutSeconds = &value
+
+	return nil
+}
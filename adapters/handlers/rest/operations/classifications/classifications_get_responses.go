@@ -160,6 +160,50 @@ func (o *ClassificationsGetNotFound) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(404)
 }
 
+// ClassificationsGetUnprocessableEntityCode is the HTTP code returned for type ClassificationsGetUnprocessableEntity
+const ClassificationsGetUnprocessableEntityCode int = 422
+
+/*ClassificationsGetUnprocessableEntity Invalid waitTimeoutSeconds.
+
+swagger:response classificationsGetUnprocessableEntity
+*/
+type ClassificationsGetUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsGetUnprocessableEntity creates ClassificationsGetUnprocessableEntity with default headers values
+func NewClassificationsGetUnprocessableEntity() *ClassificationsGetUnprocessableEntity {
+
+	return &ClassificationsGetUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the classifications get unprocessable entity response
+func (o *ClassificationsGetUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClassificationsGetUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications get unprocessable entity response
+func (o *ClassificationsGetUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsGetUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ClassificationsGetInternalServerErrorCode is the HTTP code returned for type ClassificationsGetInternalServerError
 const ClassificationsGetInternalServerErrorCode int = 500
 
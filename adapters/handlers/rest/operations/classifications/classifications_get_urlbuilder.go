@@ -21,12 +21,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // ClassificationsGetURL generates an URL for the classifications get operation
 type ClassificationsGetURL struct {
 	ID string
 
+	WaitTimeoutSeconds *int64
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -66,6 +70,18 @@ func (o *ClassificationsGetURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var waitTimeoutSecondsQ string
+	if o.WaitTimeoutSeconds != nil {
+		waitTimeoutSecondsQ = swag.FormatInt64(*o.WaitTimeoutSeconds)
+	}
+	if waitTimeoutSecondsQ != "" {
+		qs.Set("waitTimeoutSeconds", waitTimeoutSecondsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
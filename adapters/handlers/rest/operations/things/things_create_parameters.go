@@ -23,6 +23,9 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -48,6 +51,18 @@ type ThingsCreateParams struct {
 	  In: body
 	*/
 	Body *models.Thing
+	/*Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.
+	  In: query
+	*/
+	Consistency *string
+	/*If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.
+	  In: query
+	*/
+	IfNotExists *bool
+	/*Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.
+	  In: header
+	*/
+	TTL *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -59,6 +74,8 @@ func (o *ThingsCreateParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Thing
@@ -81,8 +98,98 @@ func (o *ThingsCreateParams) BindRequest(r *http.Request, route *middleware.Matc
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	qConsistency, qhkConsistency, _ := qs.GetOK("consistency")
+	if err := o.bindConsistency(qConsistency, qhkConsistency, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIfNotExists, qhkIfNotExists, _ := qs.GetOK("ifNotExists")
+	if err := o.bindIfNotExists(qIfNotExists, qhkIfNotExists, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindTTL(r.Header[http.CanonicalHeaderKey("TTL")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindConsistency binds and validates parameter Consistency from query.
+func (o *ThingsCreateParams) bindConsistency(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Consistency = &raw
+
+	if err := o.validateConsistency(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConsistency carries on validations for parameter Consistency
+func (o *ThingsCreateParams) validateConsistency(formats strfmt.Registry) error {
+
+	if err := validate.Enum("consistency", "query", *o.Consistency, []interface{}{"eventual", "strong"}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindIfNotExists binds and validates parameter IfNotExists from query.
+func (o *ThingsCreateParams) bindIfNotExists(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("ifNotExists", "query", "bool", raw)
+	}
+	o.IfNotExists = &value
+
+	return nil
+}
+
+// bindTTL binds and validates parameter TTL from header.
+func (o *ThingsCreateParams) bindTTL(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("TTL", "header", "int64", raw)
+	}
+	o.TTL = &value
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -136,6 +137,50 @@ func (o *ThingsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// ThingsCreateConflictCode is the HTTP code returned for type ThingsCreateConflict
+const ThingsCreateConflictCode int = 409
+
+/*ThingsCreateConflict An object with the same id already exists.
+
+swagger:response thingsCreateConflict
+*/
+type ThingsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsCreateConflict creates ThingsCreateConflict with default headers values
+func NewThingsCreateConflict() *ThingsCreateConflict {
+
+	return &ThingsCreateConflict{}
+}
+
+// WithPayload adds the payload to the things create conflict response
+func (o *ThingsCreateConflict) WithPayload(payload *models.ErrorResponse) *ThingsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things create conflict response
+func (o *ThingsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsCreateUnprocessableEntityCode is the HTTP code returned for type ThingsCreateUnprocessableEntity
 const ThingsCreateUnprocessableEntityCode int = 422
 
@@ -180,6 +225,73 @@ func (o *ThingsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ThingsCreateTooManyRequestsCode is the HTTP code returned for type ThingsCreateTooManyRequests
+const ThingsCreateTooManyRequestsCode int = 429
+
+/*ThingsCreateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response thingsCreateTooManyRequests
+*/
+type ThingsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsCreateTooManyRequests creates ThingsCreateTooManyRequests with default headers values
+func NewThingsCreateTooManyRequests() *ThingsCreateTooManyRequests {
+
+	return &ThingsCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the things create too many requests response
+func (o *ThingsCreateTooManyRequests) WithRetryAfter(retryAfter int64) *ThingsCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the things create too many requests response
+func (o *ThingsCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the things create too many requests response
+func (o *ThingsCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *ThingsCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things create too many requests response
+func (o *ThingsCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsCreateInternalServerErrorCode is the HTTP code returned for type ThingsCreateInternalServerError
 const ThingsCreateInternalServerErrorCode int = 500
 
@@ -43,12 +43,20 @@ type ThingsGetParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.
+	  In: query
+	*/
+	Depth *int64
+	/*Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.
+	  In: query
+	*/
+	Expand *string
 	/*Unique ID of the Thing.
 	  Required: true
 	  In: path
 	*/
 	ID strfmt.UUID
-	/*Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
+	/*Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)
 	  In: query
 	*/
 	Include *string
@@ -69,6 +77,16 @@ func (o *ThingsGetParams) BindRequest(r *http.Request, route *middleware.Matched
 
 	qs := runtime.Values(r.URL.Query())
 
+	qDepth, qhkDepth, _ := qs.GetOK("depth")
+	if err := o.bindDepth(qDepth, qhkDepth, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qExpand, qhkExpand, _ := qs.GetOK("expand")
+	if err := o.bindExpand(qExpand, qhkExpand, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -90,6 +108,46 @@ func (o *ThingsGetParams) BindRequest(r *http.Request, route *middleware.Matched
 	return nil
 }
 
+// bindDepth binds and validates parameter Depth from query.
+func (o *ThingsGetParams) bindDepth(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("depth", "query", "int64", raw)
+	}
+	o.Depth = &value
+
+	return nil
+}
+
+// bindExpand binds and validates parameter Expand from query.
+func (o *ThingsGetParams) bindExpand(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Expand = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ThingsGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
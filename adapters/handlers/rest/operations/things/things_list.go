@@ -46,7 +46,7 @@ func NewThingsList(ctx *middleware.Context, handler ThingsListHandler) *ThingsLi
 
 Get a list of Things.
 
-Lists all Things in reverse order of creation, owned by the user that belongs to the used token.
+Lists all Things in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Thing per line.
 
 */
 type ThingsList struct {
@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewThingsListParams creates a new ThingsListParams object
@@ -42,11 +43,28 @@ type ThingsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
+	/*Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.
+	  In: query
+	*/
+	After *strfmt.UUID
+	/*Restrict the list to objects of this class. Required when a 'where' filter is set.
+	  In: query
+	*/
+	Class *string
+	/*Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.
+	  In: query
+	*/
+	Count *bool
+	/*Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)
 	  In: query
 	*/
 	Include *string
+	/*Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.
+	  In: query
+	*/
+	IncludeTotal *bool
 	/*The maximum number of items to be returned per page. Default value is set in Weaviate config.
+	  Minimum: 0
 	  In: query
 	*/
 	Limit *int64
@@ -54,6 +72,10 @@ type ThingsListParams struct {
 	  In: query
 	*/
 	Meta *bool
+	/*JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{"operator":"Equal","path":["name"],"valueString":"foo"}'. Paths are relative to the class set with the 'class' parameter.
+	  In: query
+	*/
+	Where *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -67,11 +89,31 @@ func (o *ThingsListParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qClass, qhkClass, _ := qs.GetOK("class")
+	if err := o.bindClass(qClass, qhkClass, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCount, qhkCount, _ := qs.GetOK("count")
+	if err := o.bindCount(qCount, qhkCount, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qInclude, qhkInclude, _ := qs.GetOK("include")
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qIncludeTotal, qhkIncludeTotal, _ := qs.GetOK("includeTotal")
+	if err := o.bindIncludeTotal(qIncludeTotal, qhkIncludeTotal, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -82,12 +124,93 @@ func (o *ThingsListParams) BindRequest(r *http.Request, route *middleware.Matche
 		res = append(res, err)
 	}
 
+	qWhere, qhkWhere, _ := qs.GetOK("where")
+	if err := o.bindWhere(qWhere, qhkWhere, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *ThingsListParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("after", "query", "strfmt.UUID", raw)
+	}
+	o.After = (value.(*strfmt.UUID))
+
+	if err := o.validateAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAfter carries on validations for parameter After
+func (o *ThingsListParams) validateAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("after", "query", "uuid", (*o.After).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindClass binds and validates parameter Class from query.
+func (o *ThingsListParams) bindClass(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Class = &raw
+
+	return nil
+}
+
+// bindCount binds and validates parameter Count from query.
+func (o *ThingsListParams) bindCount(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("count", "query", "bool", raw)
+	}
+	o.Count = &value
+
+	return nil
+}
+
 // bindInclude binds and validates parameter Include from query.
 func (o *ThingsListParams) bindInclude(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -106,6 +229,28 @@ func (o *ThingsListParams) bindInclude(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindIncludeTotal binds and validates parameter IncludeTotal from query.
+func (o *ThingsListParams) bindIncludeTotal(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("includeTotal", "query", "bool", raw)
+	}
+	o.IncludeTotal = &value
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ThingsListParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -125,6 +270,20 @@ func (o *ThingsListParams) bindLimit(rawData []string, hasKey bool, formats strf
 	}
 	o.Limit = &value
 
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ThingsListParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 0, false); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -149,3 +308,21 @@ func (o *ThingsListParams) bindMeta(rawData []string, hasKey bool, formats strfm
 
 	return nil
 }
+
+// bindWhere binds and validates parameter Where from query.
+func (o *ThingsListParams) bindWhere(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Where = &raw
+
+	return nil
+}
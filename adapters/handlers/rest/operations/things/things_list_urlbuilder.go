@@ -21,14 +21,19 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
 
 // ThingsListURL generates an URL for the things list operation
 type ThingsListURL struct {
+	After   *strfmt.UUID
+	Class   *string
+	Count   *bool
 	Include *string
 	Limit   *int64
 	Meta    *bool
+	Where   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -64,6 +69,30 @@ func (o *ThingsListURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var afterQ string
+	if o.After != nil {
+		afterQ = o.After.String()
+	}
+	if afterQ != "" {
+		qs.Set("after", afterQ)
+	}
+
+	var classQ string
+	if o.Class != nil {
+		classQ = *o.Class
+	}
+	if classQ != "" {
+		qs.Set("class", classQ)
+	}
+
+	var countQ string
+	if o.Count != nil {
+		countQ = swag.FormatBool(*o.Count)
+	}
+	if countQ != "" {
+		qs.Set("count", countQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -88,6 +117,14 @@ func (o *ThingsListURL) Build() (*url.URL, error) {
 		qs.Set("meta", metaQ)
 	}
 
+	var whereQ string
+	if o.Where != nil {
+		whereQ = *o.Where
+	}
+	if whereQ != "" {
+		qs.Set("where", whereQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
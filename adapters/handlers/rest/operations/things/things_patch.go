@@ -46,7 +46,7 @@ func NewThingsPatch(ctx *middleware.Context, handler ThingsPatchHandler) *Things
 
 Update a Thing based on its UUID (using patch semantics).
 
-Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.
 
 */
 type ThingsPatch struct {
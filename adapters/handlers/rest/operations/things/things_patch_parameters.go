@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -53,6 +54,14 @@ type ThingsPatchParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.
+	  In: header
+	*/
+	IfMatch *int64
+	/*References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.
+	  In: query
+	*/
+	UniqueReferences *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -64,6 +73,8 @@ func (o *ThingsPatchParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Thing
@@ -85,6 +96,15 @@ func (o *ThingsPatchParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUniqueReferences, qhkUniqueReferences, _ := qs.GetOK("uniqueReferences")
+	if err := o.bindUniqueReferences(qUniqueReferences, qhkUniqueReferences, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -123,3 +143,47 @@ func (o *ThingsPatchParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ThingsPatchParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("If-Match", "header", "int64", raw)
+	}
+	o.IfMatch = &value
+
+	return nil
+}
+
+// bindUniqueReferences binds and validates parameter UniqueReferences from query.
+func (o *ThingsPatchParams) bindUniqueReferences(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("uniqueReferences", "query", "bool", raw)
+	}
+	o.UniqueReferences = &value
+
+	return nil
+}
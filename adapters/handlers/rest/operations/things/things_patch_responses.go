@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -164,6 +165,50 @@ func (o *ThingsPatchNotFound) WriteResponse(rw http.ResponseWriter, producer run
 	rw.WriteHeader(404)
 }
 
+// ThingsPatchConflictCode is the HTTP code returned for type ThingsPatchConflict
+const ThingsPatchConflictCode int = 409
+
+/*ThingsPatchConflict Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.
+
+swagger:response thingsPatchConflict
+*/
+type ThingsPatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsPatchConflict creates ThingsPatchConflict with default headers values
+func NewThingsPatchConflict() *ThingsPatchConflict {
+
+	return &ThingsPatchConflict{}
+}
+
+// WithPayload adds the payload to the things patch conflict response
+func (o *ThingsPatchConflict) WithPayload(payload *models.ErrorResponse) *ThingsPatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things patch conflict response
+func (o *ThingsPatchConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsPatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsPatchUnprocessableEntityCode is the HTTP code returned for type ThingsPatchUnprocessableEntity
 const ThingsPatchUnprocessableEntityCode int = 422
 
@@ -208,6 +253,73 @@ func (o *ThingsPatchUnprocessableEntity) WriteResponse(rw http.ResponseWriter, p
 	}
 }
 
+// ThingsPatchTooManyRequestsCode is the HTTP code returned for type ThingsPatchTooManyRequests
+const ThingsPatchTooManyRequestsCode int = 429
+
+/*ThingsPatchTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response thingsPatchTooManyRequests
+*/
+type ThingsPatchTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsPatchTooManyRequests creates ThingsPatchTooManyRequests with default headers values
+func NewThingsPatchTooManyRequests() *ThingsPatchTooManyRequests {
+
+	return &ThingsPatchTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the things patch too many requests response
+func (o *ThingsPatchTooManyRequests) WithRetryAfter(retryAfter int64) *ThingsPatchTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the things patch too many requests response
+func (o *ThingsPatchTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the things patch too many requests response
+func (o *ThingsPatchTooManyRequests) WithPayload(payload *models.ErrorResponse) *ThingsPatchTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things patch too many requests response
+func (o *ThingsPatchTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsPatchTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsPatchInternalServerErrorCode is the HTTP code returned for type ThingsPatchInternalServerError
 const ThingsPatchInternalServerErrorCode int = 500
 
@@ -46,7 +46,7 @@ func NewThingsUpdate(ctx *middleware.Context, handler ThingsUpdateHandler) *Thin
 
 Update a Thing based on its UUID.
 
-Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.
 
 */
 type ThingsUpdate struct {
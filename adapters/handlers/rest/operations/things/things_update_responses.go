@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -160,6 +161,50 @@ func (o *ThingsUpdateNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 	rw.WriteHeader(404)
 }
 
+// ThingsUpdateConflictCode is the HTTP code returned for type ThingsUpdateConflict
+const ThingsUpdateConflictCode int = 409
+
+/*ThingsUpdateConflict Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.
+
+swagger:response thingsUpdateConflict
+*/
+type ThingsUpdateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsUpdateConflict creates ThingsUpdateConflict with default headers values
+func NewThingsUpdateConflict() *ThingsUpdateConflict {
+
+	return &ThingsUpdateConflict{}
+}
+
+// WithPayload adds the payload to the things update conflict response
+func (o *ThingsUpdateConflict) WithPayload(payload *models.ErrorResponse) *ThingsUpdateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things update conflict response
+func (o *ThingsUpdateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsUpdateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsUpdateUnprocessableEntityCode is the HTTP code returned for type ThingsUpdateUnprocessableEntity
 const ThingsUpdateUnprocessableEntityCode int = 422
 
@@ -204,6 +249,73 @@ func (o *ThingsUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ThingsUpdateTooManyRequestsCode is the HTTP code returned for type ThingsUpdateTooManyRequests
+const ThingsUpdateTooManyRequestsCode int = 429
+
+/*ThingsUpdateTooManyRequests Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+
+swagger:response thingsUpdateTooManyRequests
+*/
+type ThingsUpdateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsUpdateTooManyRequests creates ThingsUpdateTooManyRequests with default headers values
+func NewThingsUpdateTooManyRequests() *ThingsUpdateTooManyRequests {
+
+	return &ThingsUpdateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the things update too many requests response
+func (o *ThingsUpdateTooManyRequests) WithRetryAfter(retryAfter int64) *ThingsUpdateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the things update too many requests response
+func (o *ThingsUpdateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the things update too many requests response
+func (o *ThingsUpdateTooManyRequests) WithPayload(payload *models.ErrorResponse) *ThingsUpdateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things update too many requests response
+func (o *ThingsUpdateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsUpdateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsUpdateInternalServerErrorCode is the HTTP code returned for type ThingsUpdateInternalServerError
 const ThingsUpdateInternalServerErrorCode int = 500
 
@@ -65,6 +65,105 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		ActionsActionsCorpusHandler: actions.ActionsCorpusHandlerFunc(func(params actions.ActionsCorpusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation actions.ActionsCorpus has not yet been implemented")
+		}),
+		ActionsActionsFacetsHandler: actions.ActionsFacetsHandlerFunc(func(params actions.ActionsFacetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation actions.ActionsFacets has not yet been implemented")
+		}),
+		ActionsActionsReferencesBulkUpdateHandler: actions.ActionsReferencesBulkUpdateHandlerFunc(func(params actions.ActionsReferencesBulkUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation actions.ActionsReferencesBulkUpdate has not yet been implemented")
+		}),
+		ActionsActionsReferencesListHandler: actions.ActionsReferencesListHandlerFunc(func(params actions.ActionsReferencesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation actions.ActionsReferencesList has not yet been implemented")
+		}),
+		BatchingBatchingActionsGetHandler: batching.BatchingActionsGetHandlerFunc(func(params batching.BatchingActionsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingActionsGet has not yet been implemented")
+		}),
+		BatchingBatchingReferencesResolveHandler: batching.BatchingReferencesResolveHandlerFunc(func(params batching.BatchingReferencesResolveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingReferencesResolve has not yet been implemented")
+		}),
+		BatchingBatchingThingsGetHandler: batching.BatchingThingsGetHandlerFunc(func(params batching.BatchingThingsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batching.BatchingThingsGet has not yet been implemented")
+		}),
+		ContextionaryAPIC11yInspectHandler: contextionary_api.C11yInspectHandlerFunc(func(params contextionary_api.C11yInspectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation contextionary_api.C11yInspect has not yet been implemented")
+		}),
+		GraphqlGraphqlQueriesCancelHandler: graphql.GraphqlQueriesCancelHandlerFunc(func(params graphql.GraphqlQueriesCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlQueriesCancel has not yet been implemented")
+		}),
+		GraphqlGraphqlQueriesListHandler: graphql.GraphqlQueriesListHandlerFunc(func(params graphql.GraphqlQueriesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlQueriesList has not yet been implemented")
+		}),
+		MetaMetaCompactHandler: meta.MetaCompactHandlerFunc(func(params meta.MetaCompactParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.MetaCompact has not yet been implemented")
+		}),
+		MetaMetaShardRestoreHandler: meta.MetaShardRestoreHandlerFunc(func(params meta.MetaShardRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.MetaShardRestore has not yet been implemented")
+		}),
+		MetaMetaShardSnapshotHandler: meta.MetaShardSnapshotHandlerFunc(func(params meta.MetaShardSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.MetaShardSnapshot has not yet been implemented")
+		}),
+		MetaMetaVectorIndexStatsHandler: meta.MetaVectorIndexStatsHandlerFunc(func(params meta.MetaVectorIndexStatsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.MetaVectorIndexStats has not yet been implemented")
+		}),
+		SchemaSchemaActionsFreezeHandler: schema.SchemaActionsFreezeHandlerFunc(func(params schema.SchemaActionsFreezeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsFreeze has not yet been implemented")
+		}),
+		SchemaSchemaActionsPropertiesBatchAddHandler: schema.SchemaActionsPropertiesBatchAddHandlerFunc(func(params schema.SchemaActionsPropertiesBatchAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsPropertiesBatchAdd has not yet been implemented")
+		}),
+		SchemaSchemaActionsPropertiesDeleteHandler: schema.SchemaActionsPropertiesDeleteHandlerFunc(func(params schema.SchemaActionsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaActionsUnfreezeHandler: schema.SchemaActionsUnfreezeHandlerFunc(func(params schema.SchemaActionsUnfreezeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaActionsUnfreeze has not yet been implemented")
+		}),
+		SchemaSchemaCountsHandler: schema.SchemaCountsHandlerFunc(func(params schema.SchemaCountsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaCounts has not yet been implemented")
+		}),
+		SchemaSchemaExportHandler: schema.SchemaExportHandlerFunc(func(params schema.SchemaExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaExport has not yet been implemented")
+		}),
+		SchemaSchemaImportHandler: schema.SchemaImportHandlerFunc(func(params schema.SchemaImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaImport has not yet been implemented")
+		}),
+		SchemaSchemaReindexGetHandler: schema.SchemaReindexGetHandlerFunc(func(params schema.SchemaReindexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaReindexGet has not yet been implemented")
+		}),
+		SchemaSchemaReindexHandler: schema.SchemaReindexHandlerFunc(func(params schema.SchemaReindexParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaReindex has not yet been implemented")
+		}),
+		SchemaSchemaShardsGetHandler: schema.SchemaShardsGetHandlerFunc(func(params schema.SchemaShardsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaShardsGet has not yet been implemented")
+		}),
+		SchemaSchemaShardsUpdateHandler: schema.SchemaShardsUpdateHandlerFunc(func(params schema.SchemaShardsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaShardsUpdate has not yet been implemented")
+		}),
+		SchemaSchemaThingsFreezeHandler: schema.SchemaThingsFreezeHandlerFunc(func(params schema.SchemaThingsFreezeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsFreeze has not yet been implemented")
+		}),
+		SchemaSchemaThingsPropertiesBatchAddHandler: schema.SchemaThingsPropertiesBatchAddHandlerFunc(func(params schema.SchemaThingsPropertiesBatchAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsPropertiesBatchAdd has not yet been implemented")
+		}),
+		SchemaSchemaThingsPropertiesDeleteHandler: schema.SchemaThingsPropertiesDeleteHandlerFunc(func(params schema.SchemaThingsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaThingsUnfreezeHandler: schema.SchemaThingsUnfreezeHandlerFunc(func(params schema.SchemaThingsUnfreezeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaThingsUnfreeze has not yet been implemented")
+		}),
+		ThingsThingsCorpusHandler: things.ThingsCorpusHandlerFunc(func(params things.ThingsCorpusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation things.ThingsCorpus has not yet been implemented")
+		}),
+		ThingsThingsFacetsHandler: things.ThingsFacetsHandlerFunc(func(params things.ThingsFacetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation things.ThingsFacets has not yet been implemented")
+		}),
+		ThingsThingsReferencesBulkUpdateHandler: things.ThingsReferencesBulkUpdateHandlerFunc(func(params things.ThingsReferencesBulkUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation things.ThingsReferencesBulkUpdate has not yet been implemented")
+		}),
+		ThingsThingsReferencesListHandler: things.ThingsReferencesListHandlerFunc(func(params things.ThingsReferencesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation things.ThingsReferencesList has not yet been implemented")
+		}),
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
@@ -125,6 +224,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClassificationsClassificationsPreviewHandler: classifications.ClassificationsPreviewHandlerFunc(func(params classifications.ClassificationsPreviewParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation classifications.ClassificationsPreview has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
@@ -243,6 +345,72 @@ type WeaviateAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// ActionsActionsCorpusHandler sets the operation handler for the actions corpus operation
+	ActionsActionsCorpusHandler actions.ActionsCorpusHandler
+	// ActionsActionsFacetsHandler sets the operation handler for the actions facets operation
+	ActionsActionsFacetsHandler actions.ActionsFacetsHandler
+	// ActionsActionsReferencesBulkUpdateHandler sets the operation handler for the actions references bulk update operation
+	ActionsActionsReferencesBulkUpdateHandler actions.ActionsReferencesBulkUpdateHandler
+	// ActionsActionsReferencesListHandler sets the operation handler for the actions references list operation
+	ActionsActionsReferencesListHandler actions.ActionsReferencesListHandler
+	// BatchingBatchingActionsGetHandler sets the operation handler for the batching actions get operation
+	BatchingBatchingActionsGetHandler batching.BatchingActionsGetHandler
+	// BatchingBatchingReferencesResolveHandler sets the operation handler for the batching references resolve operation
+	BatchingBatchingReferencesResolveHandler batching.BatchingReferencesResolveHandler
+	// BatchingBatchingThingsGetHandler sets the operation handler for the batching things get operation
+	BatchingBatchingThingsGetHandler batching.BatchingThingsGetHandler
+	// ContextionaryAPIC11yInspectHandler sets the operation handler for the c11y inspect operation
+	ContextionaryAPIC11yInspectHandler contextionary_api.C11yInspectHandler
+	// GraphqlGraphqlQueriesCancelHandler sets the operation handler for the graphql queries cancel operation
+	GraphqlGraphqlQueriesCancelHandler graphql.GraphqlQueriesCancelHandler
+	// GraphqlGraphqlQueriesListHandler sets the operation handler for the graphql queries list operation
+	GraphqlGraphqlQueriesListHandler graphql.GraphqlQueriesListHandler
+	// MetaMetaCompactHandler sets the operation handler for the meta compact operation
+	MetaMetaCompactHandler meta.MetaCompactHandler
+	// MetaMetaShardRestoreHandler sets the operation handler for the meta shard restore operation
+	MetaMetaShardRestoreHandler meta.MetaShardRestoreHandler
+	// MetaMetaShardSnapshotHandler sets the operation handler for the meta shard snapshot operation
+	MetaMetaShardSnapshotHandler meta.MetaShardSnapshotHandler
+	// MetaMetaVectorIndexStatsHandler sets the operation handler for the meta vector index stats operation
+	MetaMetaVectorIndexStatsHandler meta.MetaVectorIndexStatsHandler
+	// SchemaSchemaActionsFreezeHandler sets the operation handler for the schema actions freeze operation
+	SchemaSchemaActionsFreezeHandler schema.SchemaActionsFreezeHandler
+	// SchemaSchemaActionsPropertiesBatchAddHandler sets the operation handler for the schema actions properties batch add operation
+	SchemaSchemaActionsPropertiesBatchAddHandler schema.SchemaActionsPropertiesBatchAddHandler
+	// SchemaSchemaActionsPropertiesDeleteHandler sets the operation handler for the schema actions properties delete operation
+	SchemaSchemaActionsPropertiesDeleteHandler schema.SchemaActionsPropertiesDeleteHandler
+	// SchemaSchemaActionsUnfreezeHandler sets the operation handler for the schema actions unfreeze operation
+	SchemaSchemaActionsUnfreezeHandler schema.SchemaActionsUnfreezeHandler
+	// SchemaSchemaCountsHandler sets the operation handler for the schema counts operation
+	SchemaSchemaCountsHandler schema.SchemaCountsHandler
+	// SchemaSchemaExportHandler sets the operation handler for the schema export operation
+	SchemaSchemaExportHandler schema.SchemaExportHandler
+	// SchemaSchemaImportHandler sets the operation handler for the schema import operation
+	SchemaSchemaImportHandler schema.SchemaImportHandler
+	// SchemaSchemaReindexGetHandler sets the operation handler for the schema reindex get operation
+	SchemaSchemaReindexGetHandler schema.SchemaReindexGetHandler
+	// SchemaSchemaReindexHandler sets the operation handler for the schema reindex operation
+	SchemaSchemaReindexHandler schema.SchemaReindexHandler
+	// SchemaSchemaShardsGetHandler sets the operation handler for the schema shards get operation
+	SchemaSchemaShardsGetHandler schema.SchemaShardsGetHandler
+	// SchemaSchemaShardsUpdateHandler sets the operation handler for the schema shards update operation
+	SchemaSchemaShardsUpdateHandler schema.SchemaShardsUpdateHandler
+	// SchemaSchemaThingsFreezeHandler sets the operation handler for the schema things freeze operation
+	SchemaSchemaThingsFreezeHandler schema.SchemaThingsFreezeHandler
+	// SchemaSchemaThingsPropertiesBatchAddHandler sets the operation handler for the schema things properties batch add operation
+	SchemaSchemaThingsPropertiesBatchAddHandler schema.SchemaThingsPropertiesBatchAddHandler
+	// SchemaSchemaThingsPropertiesDeleteHandler sets the operation handler for the schema things properties delete operation
+	SchemaSchemaThingsPropertiesDeleteHandler schema.SchemaThingsPropertiesDeleteHandler
+	// SchemaSchemaThingsUnfreezeHandler sets the operation handler for the schema things unfreeze operation
+	SchemaSchemaThingsUnfreezeHandler schema.SchemaThingsUnfreezeHandler
+	// ThingsThingsCorpusHandler sets the operation handler for the things corpus operation
+	ThingsThingsCorpusHandler things.ThingsCorpusHandler
+	// ThingsThingsFacetsHandler sets the operation handler for the things facets operation
+	ThingsThingsFacetsHandler things.ThingsFacetsHandler
+	// ThingsThingsReferencesBulkUpdateHandler sets the operation handler for the things references bulk update operation
+	ThingsThingsReferencesBulkUpdateHandler things.ThingsReferencesBulkUpdateHandler
+	// ThingsThingsReferencesListHandler sets the operation handler for the things references list operation
+	ThingsThingsReferencesListHandler things.ThingsReferencesListHandler
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
 	// ActionsActionsCreateHandler sets the operation handler for the actions create operation
@@ -283,6 +451,8 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClassificationsClassificationsPreviewHandler sets the operation handler for the classifications preview operation
+	ClassificationsClassificationsPreviewHandler classifications.ClassificationsPreviewHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
@@ -402,6 +572,105 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "OidcAuth")
 	}
 
+	if o.ActionsActionsCorpusHandler == nil {
+		unregistered = append(unregistered, "actions.ActionsCorpusHandler")
+	}
+	if o.ActionsActionsFacetsHandler == nil {
+		unregistered = append(unregistered, "actions.ActionsFacetsHandler")
+	}
+	if o.ActionsActionsReferencesBulkUpdateHandler == nil {
+		unregistered = append(unregistered, "actions.ActionsReferencesBulkUpdateHandler")
+	}
+	if o.ActionsActionsReferencesListHandler == nil {
+		unregistered = append(unregistered, "actions.ActionsReferencesListHandler")
+	}
+	if o.BatchingBatchingActionsGetHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingActionsGetHandler")
+	}
+	if o.BatchingBatchingReferencesResolveHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingReferencesResolveHandler")
+	}
+	if o.BatchingBatchingThingsGetHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingThingsGetHandler")
+	}
+	if o.ContextionaryAPIC11yInspectHandler == nil {
+		unregistered = append(unregistered, "contextionary_api.C11yInspectHandler")
+	}
+	if o.GraphqlGraphqlQueriesCancelHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlQueriesCancelHandler")
+	}
+	if o.GraphqlGraphqlQueriesListHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlQueriesListHandler")
+	}
+	if o.MetaMetaCompactHandler == nil {
+		unregistered = append(unregistered, "meta.MetaCompactHandler")
+	}
+	if o.MetaMetaShardRestoreHandler == nil {
+		unregistered = append(unregistered, "meta.MetaShardRestoreHandler")
+	}
+	if o.MetaMetaShardSnapshotHandler == nil {
+		unregistered = append(unregistered, "meta.MetaShardSnapshotHandler")
+	}
+	if o.MetaMetaVectorIndexStatsHandler == nil {
+		unregistered = append(unregistered, "meta.MetaVectorIndexStatsHandler")
+	}
+	if o.SchemaSchemaActionsFreezeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsFreezeHandler")
+	}
+	if o.SchemaSchemaActionsPropertiesBatchAddHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsPropertiesBatchAddHandler")
+	}
+	if o.SchemaSchemaActionsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaActionsUnfreezeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsUnfreezeHandler")
+	}
+	if o.SchemaSchemaCountsHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaCountsHandler")
+	}
+	if o.SchemaSchemaExportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaExportHandler")
+	}
+	if o.SchemaSchemaImportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaImportHandler")
+	}
+	if o.SchemaSchemaReindexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaReindexGetHandler")
+	}
+	if o.SchemaSchemaReindexHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaReindexHandler")
+	}
+	if o.SchemaSchemaShardsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaShardsGetHandler")
+	}
+	if o.SchemaSchemaShardsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaShardsUpdateHandler")
+	}
+	if o.SchemaSchemaThingsFreezeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsFreezeHandler")
+	}
+	if o.SchemaSchemaThingsPropertiesBatchAddHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsPropertiesBatchAddHandler")
+	}
+	if o.SchemaSchemaThingsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaThingsUnfreezeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsUnfreezeHandler")
+	}
+	if o.ThingsThingsCorpusHandler == nil {
+		unregistered = append(unregistered, "things.ThingsCorpusHandler")
+	}
+	if o.ThingsThingsFacetsHandler == nil {
+		unregistered = append(unregistered, "things.ThingsFacetsHandler")
+	}
+	if o.ThingsThingsReferencesBulkUpdateHandler == nil {
+		unregistered = append(unregistered, "things.ThingsReferencesBulkUpdateHandler")
+	}
+	if o.ThingsThingsReferencesListHandler == nil {
+		unregistered = append(unregistered, "things.ThingsReferencesListHandler")
+	}
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
@@ -462,6 +731,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClassificationsClassificationsPreviewHandler == nil {
+		unregistered = append(unregistered, "classifications.ClassificationsPreviewHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
@@ -631,6 +903,138 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/actions/{id}/corpus"] = actions.NewActionsCorpus(o.context, o.ActionsActionsCorpusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/actions/facets/{className}/{propertyName}"] = actions.NewActionsFacets(o.context, o.ActionsActionsFacetsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/actions/{id}/references"] = actions.NewActionsReferencesBulkUpdate(o.context, o.ActionsActionsReferencesBulkUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/actions/{id}/references"] = actions.NewActionsReferencesList(o.context, o.ActionsActionsReferencesListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batching/actions/get"] = batching.NewBatchingActionsGet(o.context, o.BatchingBatchingActionsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batching/references/resolve"] = batching.NewBatchingReferencesResolve(o.context, o.BatchingBatchingReferencesResolveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batching/things/get"] = batching.NewBatchingThingsGet(o.context, o.BatchingBatchingThingsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/c11y/inspect/{word}"] = contextionary_api.NewC11yInspect(o.context, o.ContextionaryAPIC11yInspectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/graphql/queries/{id}"] = graphql.NewGraphqlQueriesCancel(o.context, o.GraphqlGraphqlQueriesCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/graphql/queries"] = graphql.NewGraphqlQueriesList(o.context, o.GraphqlGraphqlQueriesListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/meta/compaction"] = meta.NewMetaCompact(o.context, o.MetaMetaCompactHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/meta/snapshots/{className}/{shardName}"] = meta.NewMetaShardRestore(o.context, o.MetaMetaShardRestoreHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/meta/snapshots/{className}/{shardName}"] = meta.NewMetaShardSnapshot(o.context, o.MetaMetaShardSnapshotHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/meta/vector-index"] = meta.NewMetaVectorIndexStats(o.context, o.MetaMetaVectorIndexStatsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/actions/{className}/frozen"] = schema.NewSchemaActionsFreeze(o.context, o.SchemaSchemaActionsFreezeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/actions/{className}/properties/batch"] = schema.NewSchemaActionsPropertiesBatchAdd(o.context, o.SchemaSchemaActionsPropertiesBatchAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/actions/{className}/properties/{propertyName}"] = schema.NewSchemaActionsPropertiesDelete(o.context, o.SchemaSchemaActionsPropertiesDeleteHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/actions/{className}/frozen"] = schema.NewSchemaActionsUnfreeze(o.context, o.SchemaSchemaActionsUnfreezeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/counts"] = schema.NewSchemaCounts(o.context, o.SchemaSchemaCountsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/export"] = schema.NewSchemaExport(o.context, o.SchemaSchemaExportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/import"] = schema.NewSchemaImport(o.context, o.SchemaSchemaImportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/reindex/{className}"] = schema.NewSchemaReindexGet(o.context, o.SchemaSchemaReindexGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/reindex/{className}"] = schema.NewSchemaReindex(o.context, o.SchemaSchemaReindexHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/shards/{className}"] = schema.NewSchemaShardsGet(o.context, o.SchemaSchemaShardsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/shards/{className}"] = schema.NewSchemaShardsUpdate(o.context, o.SchemaSchemaShardsUpdateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/things/{className}/frozen"] = schema.NewSchemaThingsFreeze(o.context, o.SchemaSchemaThingsFreezeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/things/{className}/properties/batch"] = schema.NewSchemaThingsPropertiesBatchAdd(o.context, o.SchemaSchemaThingsPropertiesBatchAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/things/{className}/properties/{propertyName}"] = schema.NewSchemaThingsPropertiesDelete(o.context, o.SchemaSchemaThingsPropertiesDeleteHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/things/{className}/frozen"] = schema.NewSchemaThingsUnfreeze(o.context, o.SchemaSchemaThingsUnfreezeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/things/{id}/corpus"] = things.NewThingsCorpus(o.context, o.ThingsThingsCorpusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/things/facets/{className}/{propertyName}"] = things.NewThingsFacets(o.context, o.ThingsThingsFacetsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/things/{id}/references"] = things.NewThingsReferencesBulkUpdate(o.context, o.ThingsThingsReferencesBulkUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/things/{id}/references"] = things.NewThingsReferencesList(o.context, o.ThingsThingsReferencesListHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -714,6 +1118,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/classifications/preview"] = classifications.NewClassificationsPreview(o.context, o.ClassificationsClassificationsPreviewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql/batch"] = graphql.NewGraphqlBatch(o.context, o.GraphqlGraphqlBatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
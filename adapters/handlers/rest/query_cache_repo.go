@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+type classInvalidator interface {
+	InvalidateClass(className string)
+}
+
+// cacheInvalidatingRepo invalidates the cached queries of a class after
+// every write to it. As it sits in front of the repo, every user of the repo
+// is covered, such as the classifier or the expiry sweeper, not only the
+// kinds managers. The cache is invalidated even if the write failed, as it
+// might have been applied partially.
+type cacheInvalidatingRepo struct {
+	vectorRepo
+	cache classInvalidator
+}
+
+func newCacheInvalidatingRepo(repo vectorRepo,
+	cache classInvalidator) *cacheInvalidatingRepo {
+	return &cacheInvalidatingRepo{vectorRepo: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingRepo) PutThing(ctx context.Context,
+	concept *models.Thing, vector []float32) error {
+	defer r.cache.InvalidateClass(concept.Class)
+	return r.vectorRepo.PutThing(ctx, concept, vector)
+}
+
+func (r *cacheInvalidatingRepo) PutAction(ctx context.Context,
+	concept *models.Action, vector []float32) error {
+	defer r.cache.InvalidateClass(concept.Class)
+	return r.vectorRepo.PutAction(ctx, concept, vector)
+}
+
+func (r *cacheInvalidatingRepo) CreateThing(ctx context.Context,
+	concept *models.Thing, vector []float32) error {
+	defer r.cache.InvalidateClass(concept.Class)
+	return r.vectorRepo.CreateThing(ctx, concept, vector)
+}
+
+func (r *cacheInvalidatingRepo) CreateAction(ctx context.Context,
+	concept *models.Action, vector []float32) error {
+	defer r.cache.InvalidateClass(concept.Class)
+	return r.vectorRepo.CreateAction(ctx, concept, vector)
+}
+
+func (r *cacheInvalidatingRepo) DeleteThing(ctx context.Context,
+	className string, id strfmt.UUID) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.DeleteThing(ctx, className, id)
+}
+
+func (r *cacheInvalidatingRepo) DeleteAction(ctx context.Context,
+	className string, id strfmt.UUID) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.DeleteAction(ctx, className, id)
+}
+
+func (r *cacheInvalidatingRepo) AddReference(ctx context.Context, k kind.Kind,
+	className string, source strfmt.UUID, propName string,
+	ref *models.SingleRef) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.AddReference(ctx, k, className, source, propName, ref)
+}
+
+func (r *cacheInvalidatingRepo) Merge(ctx context.Context,
+	merge kinds.MergeDocument) error {
+	defer r.cache.InvalidateClass(merge.Class)
+	return r.vectorRepo.Merge(ctx, merge)
+}
+
+func (r *cacheInvalidatingRepo) BatchPutThings(ctx context.Context,
+	things kinds.BatchThings) (kinds.BatchThings, error) {
+	defer func() {
+		for _, thing := range things {
+			if thing.Thing != nil {
+				r.cache.InvalidateClass(thing.Thing.Class)
+			}
+		}
+	}()
+	return r.vectorRepo.BatchPutThings(ctx, things)
+}
+
+func (r *cacheInvalidatingRepo) BatchPutActions(ctx context.Context,
+	actions kinds.BatchActions) (kinds.BatchActions, error) {
+	defer func() {
+		for _, action := range actions {
+			if action.Action != nil {
+				r.cache.InvalidateClass(action.Action.Class)
+			}
+		}
+	}()
+	return r.vectorRepo.BatchPutActions(ctx, actions)
+}
+
+func (r *cacheInvalidatingRepo) AddBatchReferences(ctx context.Context,
+	references kinds.BatchReferences) (kinds.BatchReferences, error) {
+	defer func() {
+		for _, ref := range references {
+			if ref.From != nil {
+				r.cache.InvalidateClass(ref.From.Class.String())
+			}
+		}
+	}()
+	return r.vectorRepo.AddBatchReferences(ctx, references)
+}
+
+func (r *cacheInvalidatingRepo) ReindexClass(ctx context.Context, k kind.Kind,
+	className string, resume bool, vectorize func(*search.Result) ([]float32, error),
+	progress func(processed int)) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.ReindexClass(ctx, k, className, resume, vectorize, progress)
+}
+
+func (r *cacheInvalidatingRepo) ReshardClass(ctx context.Context, k kind.Kind,
+	className string, numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.ReshardClass(ctx, k, className, numberOfShards,
+		autoExpandReplicas, progress)
+}
+
+func (r *cacheInvalidatingRepo) TruncateClass(ctx context.Context, k kind.Kind,
+	className string) (int64, error) {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.TruncateClass(ctx, k, className)
+}
+
+func (r *cacheInvalidatingRepo) RestoreShard(ctx context.Context, k kind.Kind,
+	className, shardName string, rd io.Reader) error {
+	defer r.cache.InvalidateClass(className)
+	return r.vectorRepo.RestoreShard(ctx, k, className, shardName, rd)
+}
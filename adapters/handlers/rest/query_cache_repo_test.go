@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheInvalidatingRepo(t *testing.T) {
+	t.Run("a write invalidates the class", func(t *testing.T) {
+		cache := &fakeClassInvalidator{}
+		repo := newCacheInvalidatingRepo(&fakeWriteRepo{}, cache)
+
+		err := repo.PutThing(context.Background(), &models.Thing{Class: "Car"}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Car"}, cache.invalidated)
+	})
+
+	t.Run("a failed write still invalidates the class", func(t *testing.T) {
+		cache := &fakeClassInvalidator{}
+		repo := newCacheInvalidatingRepo(&fakeWriteRepo{err: errors.New("oops")}, cache)
+
+		err := repo.DeleteAction(context.Background(), "Drive", "some-id")
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{"Drive"}, cache.invalidated)
+	})
+
+	t.Run("a batch invalidates the class of every item", func(t *testing.T) {
+		cache := &fakeClassInvalidator{}
+		repo := newCacheInvalidatingRepo(&fakeWriteRepo{}, cache)
+
+		_, err := repo.BatchPutThings(context.Background(), kinds.BatchThings{
+			{Thing: &models.Thing{Class: "Car"}},
+			{Err: errors.New("invalid")},
+			{Thing: &models.Thing{Class: "Brand"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Car", "Brand"}, cache.invalidated)
+	})
+}
+
+type fakeClassInvalidator struct {
+	invalidated []string
+}
+
+func (f *fakeClassInvalidator) InvalidateClass(className string) {
+	f.invalidated = append(f.invalidated, className)
+}
+
+// fakeWriteRepo only implements the writes which are used in the tests, all
+// other methods panic
+type fakeWriteRepo struct {
+	vectorRepo
+	err error
+}
+
+func (f *fakeWriteRepo) PutThing(ctx context.Context, concept *models.Thing,
+	vector []float32) error {
+	return f.err
+}
+
+func (f *fakeWriteRepo) DeleteAction(ctx context.Context, className string,
+	id strfmt.UUID) error {
+	return f.err
+}
+
+func (f *fakeWriteRepo) BatchPutThings(ctx context.Context,
+	things kinds.BatchThings) (kinds.BatchThings, error) {
+	return things, f.err
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/clients/contextionary"
+	"github.com/sirupsen/logrus"
+)
+
+// readinessCheckTimeout limits how long a single dependency may take to
+// respond before it is considered unhealthy
+const readinessCheckTimeout = 2 * time.Second
+
+type readinessCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// readinessChecker reports whether all dependencies weaviate needs to serve
+// traffic are currently reachable. Unlike during startup, a failing
+// dependency does not stop the process, the instance is only reported as not
+// ready, so load balancers can route traffic elsewhere until it recovers.
+type readinessChecker struct {
+	checks []readinessCheck
+	logger logrus.FieldLogger
+}
+
+func newReadinessChecker(logger logrus.FieldLogger) *readinessChecker {
+	return &readinessChecker{logger: logger}
+}
+
+func (r *readinessChecker) add(name string, check func(ctx context.Context) error) {
+	r.checks = append(r.checks, readinessCheck{name: name, check: check})
+}
+
+// Ready runs all checks concurrently and returns an error listing every
+// unhealthy dependency, or nil if all of them are healthy
+func (r *readinessChecker) Ready(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	errs := make([]error, len(r.checks))
+	wg := &sync.WaitGroup{}
+	for i, check := range r.checks {
+		wg.Add(1)
+		go func(i int, check readinessCheck) {
+			defer wg.Done()
+			if err := check.check(ctx); err != nil {
+				errs[i] = fmt.Errorf("%s: %v", check.name, err)
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	var msgs []string
+	for _, err := range errs {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	err := fmt.Errorf("not ready: %s", strings.Join(msgs, ", "))
+	r.logger.WithField("action", "readiness_check").WithError(err).
+		Warn("instance is not ready to serve traffic")
+	return err
+}
+
+type c11yPinger interface {
+	Ping(ctx context.Context) error
+}
+
+// c11yBreakerStateProvider is implemented by contextionary clients which use
+// a circuit breaker
+type c11yBreakerStateProvider interface {
+	BreakerState() contextionary.BreakerState
+}
+
+// contextionaryReadiness adds the circuit breaker state to a failed ping, so
+// the readiness output shows whether weaviate stopped contacting the
+// contextionary or is still trying to reach it
+func contextionaryReadiness(c11y c11yPinger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		err := c11y.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if breaker, ok := c11y.(c11yBreakerStateProvider); ok {
+			return fmt.Errorf("%v (circuit breaker %s)", err, breaker.BreakerState())
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/clients/contextionary"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	healthy := func(ctx context.Context) error { return nil }
+	unhealthy := func(ctx context.Context) error { return fmt.Errorf("connection refused") }
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	t.Run("with all dependencies healthy", func(t *testing.T) {
+		readiness := newReadinessChecker(logger)
+		readiness.add("vector repo", healthy)
+		readiness.add("contextionary", healthy)
+
+		rec := httptest.NewRecorder()
+		addLiveAndReadyness(next, readiness).
+			ServeHTTP(rec, httptest.NewRequest("GET", "/v1/.well-known/ready", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("with an unhealthy dependency", func(t *testing.T) {
+		readiness := newReadinessChecker(logger)
+		readiness.add("vector repo", healthy)
+		readiness.add("contextionary", unhealthy)
+
+		rec := httptest.NewRecorder()
+		addLiveAndReadyness(next, readiness).
+			ServeHTTP(rec, httptest.NewRequest("GET", "/v1/.well-known/ready", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Body.String(), "contextionary: connection refused")
+		assert.NotContains(t, rec.Body.String(), "vector repo")
+	})
+
+	t.Run("liveness is independent of the dependencies", func(t *testing.T) {
+		readiness := newReadinessChecker(logger)
+		readiness.add("contextionary", unhealthy)
+
+		rec := httptest.NewRecorder()
+		addLiveAndReadyness(next, readiness).
+			ServeHTTP(rec, httptest.NewRequest("GET", "/v1/.well-known/live", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("other requests are passed on", func(t *testing.T) {
+		readiness := newReadinessChecker(logger)
+
+		rec := httptest.NewRecorder()
+		addLiveAndReadyness(next, readiness).
+			ServeHTTP(rec, httptest.NewRequest("GET", "/v1/things", nil))
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+	})
+
+	t.Run("with a dependency exceeding the timeout", func(t *testing.T) {
+		readiness := newReadinessChecker(logger)
+		readiness.add("etcd", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := readiness.Ready(ctx)
+		assert.NotNil(t, err)
+	})
+}
+
+type fakeC11yWithBreaker struct {
+	err   error
+	state contextionary.BreakerState
+}
+
+func (f *fakeC11yWithBreaker) Ping(ctx context.Context) error {
+	return f.err
+}
+
+func (f *fakeC11yWithBreaker) BreakerState() contextionary.BreakerState {
+	return f.state
+}
+
+func TestContextionaryReadiness(t *testing.T) {
+	t.Run("with a healthy contextionary", func(t *testing.T) {
+		c11y := &fakeC11yWithBreaker{state: contextionary.BreakerClosed}
+		assert.Nil(t, contextionaryReadiness(c11y)(context.Background()))
+	})
+
+	t.Run("with an open breaker", func(t *testing.T) {
+		c11y := &fakeC11yWithBreaker{
+			err:   fmt.Errorf("contextionary unreachable"),
+			state: contextionary.BreakerOpen,
+		}
+		err := contextionaryReadiness(c11y)(context.Background())
+		assert.EqualError(t, err, "contextionary unreachable (circuit breaker open)")
+	})
+}
@@ -66,6 +66,7 @@ type contextionary interface {
 	MultiNearestWordsByVector(ctx context.Context, vectors [][]float32, n int, k int) ([]*models.NearestNeighbors, error)
 	VectorForCorpi(ctx context.Context, corpi []string, overrides map[string]string) ([]float32, []vectorizer.InputElement, error)
 	Version(ctx context.Context) (string, error)
+	Ping(ctx context.Context) error
 	WordCount(ctx context.Context) (int64, error)
 	AddExtension(ctx context.Context, extension *models.C11yExtension) error
 }
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 )
 
 const inputVersionRegexString = `^.*-v(?P<Major>[0-9]+)\.(?P<Minor>[0-9]+)\.(?P<Patch>[0-9]+)$`
@@ -75,3 +76,27 @@ func compareSemver(iMaj, iMin, iPat, rMaj, rMin, rPat int) bool {
 	return true
 
 }
+
+// startupBackoff is the time to wait before the given (zero-based) attempt to
+// reach the contextionary on startup. The wait doubles with every attempt up
+// to max, then a random jitter removes up to half of it, so that replicas
+// which start at the same time spread out their attempts. randInt63n must
+// behave like rand.Int63n.
+func startupBackoff(attempt int, initial, max time.Duration,
+	randInt63n func(int64) int64) time.Duration {
+	wait := initial
+	for i := 0; i < attempt && wait < max; i++ {
+		wait *= 2
+	}
+
+	if wait > max {
+		wait = max
+	}
+
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+
+	return wait - time.Duration(randInt63n(half+1))
+}
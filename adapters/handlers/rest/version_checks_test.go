@@ -14,6 +14,7 @@ package rest
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -115,3 +116,30 @@ func TestExtractVersionAndCompare(t *testing.T) {
 		assert.Equal(t, test.expectedErr, err)
 	}
 }
+
+func TestStartupBackoff(t *testing.T) {
+	noJitter := func(int64) int64 { return 0 }
+	maxJitter := func(n int64) int64 { return n - 1 }
+
+	type test struct {
+		name     string
+		attempt  int
+		jitter   func(int64) int64
+		expected time.Duration
+	}
+
+	tests := []test{
+		test{name: "first attempt", attempt: 0, jitter: noJitter, expected: 500 * time.Millisecond},
+		test{name: "doubles", attempt: 2, jitter: noJitter, expected: 2 * time.Second},
+		test{name: "capped at max", attempt: 10, jitter: noJitter, expected: 15 * time.Second},
+		test{name: "very late attempt", attempt: 1000, jitter: noJitter, expected: 15 * time.Second},
+		test{name: "jitter removes up to half", attempt: 2, jitter: maxJitter, expected: 1 * time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wait := startupBackoff(test.attempt, 500*time.Millisecond, 15*time.Second, test.jitter)
+			assert.Equal(t, test.expected, wait)
+		})
+	}
+}
@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
@@ -26,9 +27,20 @@ type batchQueue struct {
 }
 
 func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kinds.BatchThings, error) {
+	ids := make([]strfmt.UUID, len(things))
+	for i, item := range things {
+		if item.Err == nil {
+			ids[i] = item.Thing.ID
+		}
+	}
+	for i, err := range duplicateIDs(db.config.DuplicateIDPolicy, ids) {
+		things[i].Err = err
+	}
+
+	indices := db.indexMap()
 	byIndex := map[string]batchQueue{}
 	for _, item := range things {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != kind.Thing || index.Config.ClassName != schema.ClassName(item.Thing.Class) {
 				continue
 			}
@@ -46,7 +58,7 @@ func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kin
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects)
+		errs := indices[indexID].putObjectBatch(ctx, queue.objects)
 		for index, err := range errs {
 			things[queue.originalIndex[index]].Err = err
 		}
@@ -56,9 +68,20 @@ func (db *DB) BatchPutThings(ctx context.Context, things kinds.BatchThings) (kin
 }
 
 func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (kinds.BatchActions, error) {
+	ids := make([]strfmt.UUID, len(actions))
+	for i, item := range actions {
+		if item.Err == nil {
+			ids[i] = item.Action.ID
+		}
+	}
+	for i, err := range duplicateIDs(db.config.DuplicateIDPolicy, ids) {
+		actions[i].Err = err
+	}
+
+	indices := db.indexMap()
 	byIndex := map[string]batchQueue{}
 	for _, item := range actions {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != kind.Action || index.Config.ClassName != schema.ClassName(item.Action.Class) {
 				continue
 			}
@@ -76,7 +99,7 @@ func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects)
+		errs := indices[indexID].putObjectBatch(ctx, queue.objects)
 		for index, err := range errs {
 			actions[queue.originalIndex[index]].Err = err
 		}
@@ -86,9 +109,10 @@ func (db *DB) BatchPutActions(ctx context.Context, actions kinds.BatchActions) (
 }
 
 func (db *DB) AddBatchReferences(ctx context.Context, references kinds.BatchReferences) (kinds.BatchReferences, error) {
+	indices := db.indexMap()
 	byIndex := map[string]kinds.BatchReferences{}
 	for _, item := range references {
-		for _, index := range db.indices {
+		for _, index := range indices {
 			if index.Config.Kind != item.From.Kind ||
 				index.Config.ClassName != item.From.Class {
 				continue
@@ -106,7 +130,7 @@ func (db *DB) AddBatchReferences(ctx context.Context, references kinds.BatchRefe
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].addReferencesBatch(ctx, queue)
+		errs := indices[indexID].addReferencesBatch(ctx, queue)
 		for index, err := range errs {
 			references[queue[index].OriginalIndex].Err = err
 		}
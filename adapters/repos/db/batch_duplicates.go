@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// DuplicateIDPolicy controls how a batch is imported which contains the same
+// id more than once. Without a check, all occurrences would be written in
+// parallel and race for the same doc id.
+type DuplicateIDPolicy string
+
+const (
+	// DuplicatesMark imports the first occurrence of an id and fails all
+	// later ones, the remaining objects of the batch are imported as usual
+	DuplicatesMark DuplicateIDPolicy = "mark"
+	// DuplicatesReject fails every object of a batch which contains any id
+	// more than once, nothing of the batch is imported
+	DuplicatesReject DuplicateIDPolicy = "reject"
+)
+
+// duplicateIDs returns an error for every position in ids that must not be
+// imported according to the policy. An empty policy behaves like
+// DuplicatesMark. Empty ids belong to objects which are not imported anyway
+// and are ignored.
+func duplicateIDs(policy DuplicateIDPolicy, ids []strfmt.UUID) map[int]error {
+	errs := map[int]error{}
+
+	firstPos := map[strfmt.UUID]int{}
+	duplicates := map[strfmt.UUID]struct{}{}
+	for pos, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		if _, ok := firstPos[id]; !ok {
+			firstPos[id] = pos
+			continue
+		}
+
+		duplicates[id] = struct{}{}
+		if policy != DuplicatesReject {
+			errs[pos] = kinds.NewErrInvalidUserInput("id '%s' occurs more than once in "+
+				"the batch, only its first occurrence is imported", id)
+		}
+	}
+
+	if policy != DuplicatesReject || len(duplicates) == 0 {
+		return errs
+	}
+
+	var list []string
+	for id := range duplicates {
+		list = append(list, string(id))
+	}
+	sort.Strings(list)
+
+	for pos, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		errs[pos] = kinds.NewErrInvalidUserInput("batch rejected, as it contains the "+
+			"following ids more than once: %s", strings.Join(list, ", "))
+	}
+
+	return errs
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchWithDuplicateIDs(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "DuplicateIDsThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+
+	const (
+		duplicateID = strfmt.UUID("5e0c1b2a-7a7e-4d6f-9f0e-2b7c4f6a1d01")
+		otherID     = strfmt.UUID("5e0c1b2a-7a7e-4d6f-9f0e-2b7c4f6a1d02")
+	)
+
+	startRepo := func(t *testing.T, dirName string, policy DuplicateIDPolicy) *DB {
+		schemaGetter := &fakeSchemaGetter{}
+		repo := New(logger, Config{RootPath: dirName, DuplicateIDPolicy: policy})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		require.Nil(t, NewMigrator(repo).AddClass(context.Background(),
+			kind.Thing, thingclass))
+		schemaGetter.schema = schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{thingclass},
+			},
+		}
+		return repo
+	}
+
+	batch := func() kinds.BatchThings {
+		thing := func(pos int, id strfmt.UUID, name string) kinds.BatchThing {
+			return kinds.BatchThing{
+				OriginalIndex: pos,
+				Thing: &models.Thing{
+					Class:  thingclass.Class,
+					ID:     id,
+					Schema: map[string]interface{}{"name": name},
+				},
+				UUID:   id,
+				Vector: []float32{1, 2, 3},
+			}
+		}
+
+		return kinds.BatchThings{
+			thing(0, duplicateID, "first"),
+			thing(1, otherID, "other"),
+			thing(2, duplicateID, "second"),
+			thing(3, duplicateID, "third"),
+		}
+	}
+
+	nameOf := func(t *testing.T, repo *DB, id strfmt.UUID) interface{} {
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		if res == nil {
+			return nil
+		}
+
+		return res.Schema.(map[string]interface{})["name"]
+	}
+
+	tests := []struct {
+		name   string
+		policy DuplicateIDPolicy
+	}{
+		{name: "default policy", policy: ""},
+		{name: "mark", policy: DuplicatesMark},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+			os.MkdirAll(dirName, 0777)
+			defer os.RemoveAll(dirName)
+			repo := startRepo(t, dirName, test.policy)
+
+			res, err := repo.BatchPutThings(context.Background(), batch())
+			require.Nil(t, err)
+
+			assert.Nil(t, res[0].Err)
+			assert.Nil(t, res[1].Err)
+			for _, pos := range []int{2, 3} {
+				require.NotNil(t, res[pos].Err)
+				assert.IsType(t, kinds.ErrInvalidUserInput{}, res[pos].Err)
+				assert.Contains(t, res[pos].Err.Error(), "occurs more than once")
+			}
+
+			assert.Equal(t, "first", nameOf(t, repo, duplicateID))
+			assert.Equal(t, "other", nameOf(t, repo, otherID))
+		})
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0777)
+		defer os.RemoveAll(dirName)
+		repo := startRepo(t, dirName, DuplicatesReject)
+
+		res, err := repo.BatchPutThings(context.Background(), batch())
+		require.Nil(t, err)
+
+		for _, item := range res {
+			require.NotNil(t, item.Err)
+			assert.IsType(t, kinds.ErrInvalidUserInput{}, item.Err)
+			assert.Contains(t, item.Err.Error(), "batch rejected")
+			assert.Contains(t, item.Err.Error(), string(duplicateID))
+		}
+
+		assert.Nil(t, nameOf(t, repo, duplicateID))
+		assert.Nil(t, nameOf(t, repo, otherID))
+	})
+}
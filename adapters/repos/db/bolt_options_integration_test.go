@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltOptions(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "BoltOptionsThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	id := strfmt.UUID("8e2a4a7c-36e0-4c4d-9d0c-2a1f4c0e8b5d")
+
+	startRepo := func(t *testing.T, opts BoltOptions) *DB {
+		schemaGetter := &fakeSchemaGetter{}
+		repo := New(logger, Config{RootPath: dirName, BoltOptions: opts})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		require.Nil(t, NewMigrator(repo).AddClass(context.Background(),
+			kind.Thing, thingclass))
+		schemaGetter.schema = schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{thingclass},
+			},
+		}
+		return repo
+	}
+
+	shardOf := func(repo *DB) *Shard {
+		return repo.GetIndex(kind.Thing, schema.ClassName(thingclass.Class)).
+			Shards["single"]
+	}
+
+	// written by the first test, the read-only shard must not change them
+	var shardID string
+
+	t.Run("with a custom initial mmap size", func(t *testing.T) {
+		mmapSize := 64 * 1024 * 1024
+		repo := startRepo(t, BoltOptions{InitialMmapSize: mmapSize})
+		shard := shardOf(repo)
+		defer shard.db.Close()
+		defer shard.vectorIndex.Shutdown()
+		defer shard.counter.Close()
+		shardID = shard.ID()
+
+		size, ok := mappedSize(t, shard.DBPath())
+		if !ok {
+			t.Skip("memory maps can only be inspected on linux")
+		}
+		assert.Equal(t, mmapSize, size)
+
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     id,
+			Class:  thingclass.Class,
+			Schema: map[string]interface{}{"name": "some name"},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+
+		size, _ = mappedSize(t, shard.DBPath())
+		assert.Equal(t, mmapSize, size, "a small write does not remap the file")
+	})
+
+	t.Run("reopening the existing shard read-only", func(t *testing.T) {
+		readFile := func(path string) []byte {
+			content, err := ioutil.ReadFile(path)
+			require.Nil(t, err)
+			return content
+		}
+		commitLogPath := hnsw.CommitLogFileName(dirName, shardID)
+		indexCountPath := fmt.Sprintf("%s/%s.indexcount", dirName, shardID)
+		commitLog := readFile(commitLogPath)
+		indexCount := readFile(indexCountPath)
+
+		// the class has to be known on startup, a read-only shard can't be
+		// created through the migrator
+		schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{thingclass},
+			},
+		}}
+		repo := New(logger, Config{
+			RootPath:    dirName,
+			BoltOptions: BoltOptions{ReadOnly: true, OpenTimeout: time.Second},
+		})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		shard := shardOf(repo)
+		defer shard.db.Close()
+
+		assert.True(t, shard.db.IsReadOnly())
+		for _, path := range []string{commitLogPath, indexCountPath} {
+			writable, ok := openForWriting(t, path)
+			if !ok {
+				t.Skip("open files can only be inspected on linux")
+			}
+			assert.False(t, writable, "%s must not be opened for writing", path)
+		}
+
+		res, err := repo.ThingByID(context.Background(), id,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		err = repo.PutThing(context.Background(), &models.Thing{
+			ID:     id,
+			Class:  thingclass.Class,
+			Schema: map[string]interface{}{"name": "updated name"},
+		}, []float32{1, 2, 3})
+		assert.NotNil(t, err)
+
+		assert.Equal(t, commitLog, readFile(commitLogPath),
+			"the commit log is not written")
+		assert.Equal(t, indexCount, readFile(indexCountPath),
+			"the index counter is not written")
+	})
+}
+
+// mappedSize sums up the size of all memory maps of the file, it returns
+// false if the maps of the process can't be read
+func mappedSize(t *testing.T, path string) (int, bool) {
+	abs, err := filepath.Abs(path)
+	require.Nil(t, err)
+
+	f, err := os.Open("/proc/self/maps")
+	if err != nil {
+		return 0, false
+	}
+	defer f.Close()
+
+	size := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || fields[5] != abs {
+			continue
+		}
+
+		bounds := strings.Split(fields[0], "-")
+		start, err := strconv.ParseUint(bounds[0], 16, 64)
+		require.Nil(t, err)
+		end, err := strconv.ParseUint(bounds[1], 16, 64)
+		require.Nil(t, err)
+		size += int(end - start)
+	}
+	require.Nil(t, scanner.Err())
+
+	return size, true
+}
+
+// openForWriting checks whether the process holds a writable file descriptor
+// of the file, it returns false if the descriptors can't be read
+func openForWriting(t *testing.T, path string) (bool, bool) {
+	abs, err := filepath.Abs(path)
+	require.Nil(t, err)
+
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return false, false
+	}
+
+	for _, fd := range fds {
+		target, err := os.Readlink("/proc/self/fd/" + fd.Name())
+		if err != nil || target != abs {
+			continue
+		}
+
+		info, err := ioutil.ReadFile("/proc/self/fdinfo/" + fd.Name())
+		if err != nil {
+			continue
+		}
+
+		for _, line := range strings.Split(string(info), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 2 || fields[0] != "flags:" {
+				continue
+			}
+
+			flags, err := strconv.ParseUint(fields[1], 8, 64)
+			require.Nil(t, err)
+			if flags&uint64(os.O_WRONLY|os.O_RDWR) != 0 {
+				return true, true
+			}
+		}
+	}
+
+	return false, true
+}
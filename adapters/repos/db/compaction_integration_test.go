@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompaction(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "CompactionThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "description",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	ids := make([]strfmt.UUID, 300)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-%012d", i))
+	}
+	vectorOf := func(i int) []float32 {
+		return []float32{float32(i), 1, 2, 3}
+	}
+
+	t.Run("import and delete most objects", func(t *testing.T) {
+		for i, id := range ids {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				Class: "CompactionThingClass",
+				ID:    id,
+				Schema: map[string]interface{}{
+					"description": strings.Repeat(fmt.Sprintf("word%d ", i), 200),
+				},
+			}, vectorOf(i))
+			require.Nil(t, err)
+		}
+
+		for _, id := range ids[10:] {
+			require.Nil(t, repo.DeleteThing(context.Background(), "CompactionThingClass", id))
+		}
+	})
+
+	t.Run("a canceled compaction leaves the shard untouched", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := repo.Compact(ctx)
+		assert.NotNil(t, err)
+
+		res, err := repo.ThingByID(context.Background(), ids[0], nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+	})
+
+	t.Run("compacting releases the space of the deleted objects", func(t *testing.T) {
+		// reads continue during the compaction
+		stop := make(chan struct{})
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				res, err := repo.ThingByID(context.Background(), ids[1], nil,
+					traverser.UnderscoreProperties{})
+				assert.Nil(t, err)
+				assert.NotNil(t, res)
+			}
+		}()
+
+		res, err := repo.Compact(context.Background())
+		close(stop)
+		wg.Wait()
+
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "CompactionThingClass", res[0].Class)
+		assert.Equal(t, "thing", res[0].Kind)
+		assert.True(t, res[0].SizeAfterBytes < res[0].SizeBeforeBytes)
+		assert.Equal(t, res[0].SizeBeforeBytes-res[0].SizeAfterBytes, res[0].ReclaimedBytes)
+	})
+
+	t.Run("the remaining objects can still be read", func(t *testing.T) {
+		for i, id := range ids[:10] {
+			res, err := repo.ThingByID(context.Background(), id, nil, traverser.UnderscoreProperties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, strings.Repeat(fmt.Sprintf("word%d ", i), 200),
+				res.Schema.(map[string]interface{})["description"])
+		}
+
+		res, err := repo.ThingByID(context.Background(), ids[10], nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("searching by vector resolves to the compacted file", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			SearchVector: vectorOf(3),
+			Kind:         kind.Thing,
+			ClassName:    "CompactionThingClass",
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[3], res[0].ID)
+	})
+
+	t.Run("writes go to the compacted file", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), "CompactionThingClass", ids[9]))
+
+		res, err := repo.ThingByID(context.Background(), ids[9], nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("the compacted file is used after a restart", func(t *testing.T) {
+		shard := repo.GetIndex(kind.Thing, "CompactionThingClass").Shards["single"]
+		require.Nil(t, shard.db.Close())
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+
+		res, err := restarted.ThingByID(context.Background(), ids[0], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		_, err = os.Stat(shard.DBPath() + compactFileSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// Count returns the number of objects of the class matching the filters,
+// without loading the objects. If no class name is set, the objects of all
+// classes of the kind are counted.
+func (d *DB) Count(ctx context.Context, k kind.Kind, className string,
+	filters *filters.LocalFilter) (int64, error) {
+	if className != "" {
+		idx := d.GetIndex(k, schema.ClassName(className))
+		if idx == nil {
+			return 0, fmt.Errorf("tried to count non-existing index for %s/%s",
+				k, className)
+		}
+
+		count, err := idx.objectCount(ctx, filters)
+		if violation, ok := errors.Cause(err).(kinds.ErrInvalidUserInput); ok {
+			return 0, violation
+		}
+
+		return count, err
+	}
+
+	var count int64
+	for _, index := range d.indexMap() {
+		if index.Config.Kind != k {
+			continue
+		}
+
+		res, err := index.objectCount(ctx, filters)
+		if err != nil {
+			return 0, err
+		}
+
+		count += res
+	}
+
+	return count, nil
+}
+
+func (i *Index) objectCount(ctx context.Context,
+	filters *filters.LocalFilter) (int64, error) {
+	var count int64
+	for _, shard := range i.Shards {
+		res, err := shard.objectCount(ctx, filters)
+		if err != nil {
+			return 0, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		count += res
+	}
+
+	return count, nil
+}
+
+// objectCount resolves the filters to doc ids using the inverted index and
+// counts them. Without filters, all objects of the shard are counted. In
+// neither case are any objects unmarshalled, only their expiry time is read,
+// as expired objects which the sweeper hasn't removed yet are not counted.
+func (s *Shard) objectCount(ctx context.Context,
+	filters *filters.LocalFilter) (int64, error) {
+	db, release := s.readDB()
+	defer release()
+
+	now := nowMillis()
+	var count int64
+	if filters == nil {
+		err := db.View(func(tx *bolt.Tx) error {
+			return tx.Bucket(helpers.ObjectsBucket).ForEach(func(_, v []byte) error {
+				expired, err := expiredBinary(v, now)
+				if err != nil {
+					return err
+				}
+
+				if !expired {
+					count++
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return 0, errors.Wrap(err, "bolt view tx")
+		}
+
+		return count, nil
+	}
+
+	list, err := inverted.NewSearcher(
+		db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
+		DocIDs(ctx, filters, false, s.index.Config.ClassName)
+	if err != nil {
+		return 0, errors.Wrap(err, "build inverted filter allow list")
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		lookup := tx.Bucket(helpers.IndexIDBucket)
+		objects := tx.Bucket(helpers.ObjectsBucket)
+		for docID := range list {
+			keyBuf := bytes.NewBuffer(make([]byte, 4))
+			binary.Write(keyBuf, binary.LittleEndian, &docID)
+			uuid := lookup.Get(keyBuf.Bytes())
+			if uuid == nil {
+				continue
+			}
+
+			v := objects.Get(uuid)
+			if v == nil {
+				continue
+			}
+
+			expired, err := expiredBinary(v, now)
+			if err != nil {
+				return err
+			}
+
+			if !expired {
+				count++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "bolt view tx")
+	}
+
+	return count, nil
+}
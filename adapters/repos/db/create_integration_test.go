@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateObjects(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "CreateThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	id := strfmt.UUID("3c1f6b5e-56a4-4d8e-9b1a-5f0e4a3a7c21")
+	thing := func(name string) *models.Thing {
+		return &models.Thing{
+			Class:  "CreateThingClass",
+			ID:     id,
+			Schema: map[string]interface{}{"name": name},
+		}
+	}
+
+	t.Run("concurrent creates with the same id", func(t *testing.T) {
+		workers := 8
+		errs := make([]error, workers)
+		wg := &sync.WaitGroup{}
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repo.CreateThing(context.Background(),
+					thing(fmt.Sprintf("worker %d", i)), []float32{1, 2, 3})
+			}(i)
+		}
+		wg.Wait()
+
+		var winner int
+		succeeded := 0
+		for i, err := range errs {
+			if err == nil {
+				winner = i
+				succeeded++
+				continue
+			}
+
+			assert.IsType(t, kinds.ErrAlreadyExists{}, err)
+		}
+		require.Equal(t, 1, succeeded)
+
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, fmt.Sprintf("worker %d", winner),
+			res.Schema.(map[string]interface{})["name"])
+	})
+
+	t.Run("a put still replaces the object", func(t *testing.T) {
+		require.Nil(t, repo.PutThing(context.Background(), thing("replaced"),
+			[]float32{1, 2, 3}))
+
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "replaced", res.Schema.(map[string]interface{})["name"])
+	})
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreationTimeFilterAndSort(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "CreationTimeClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	// imported out of order, so that the results can't accidentally be in the
+	// order of the import
+	minutes := []int{30, 0, 90, 60, 120}
+	ids := map[int]strfmt.UUID{}
+
+	t.Run("importing things", func(t *testing.T) {
+		for i, minute := range minutes {
+			ids[minute] = strfmt.UUID(fmt.Sprintf("3f5a2c1b-7d4e-4b6a-9c8d-%012d", i))
+			thing := &models.Thing{
+				ID:     ids[minute],
+				Class:  class.Class,
+				Schema: map[string]interface{}{"name": "alice"},
+				CreationTimeUnix: start.Add(time.Duration(minute)*time.Minute).
+					UnixNano() / int64(time.Millisecond),
+			}
+
+			require.Nil(t, repo.PutThing(context.Background(), thing, []float32{1, 0, 0}))
+		}
+	})
+
+	creationTimeClause := func(op filters.Operator, value interface{},
+		dt schema.DataType) filters.Clause {
+		return filters.Clause{
+			Operator: op,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(filters.InternalPropCreationTimeUnix),
+			},
+			Value: &filters.Value{
+				Value: value,
+				Type:  dt,
+			},
+		}
+	}
+
+	rangeFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				creationTimeClause(filters.OperatorGreaterThanEqual,
+					start.Add(30*time.Minute), schema.DataTypeDate),
+				creationTimeClause(filters.OperatorLessThan,
+					"2020-06-01T13:15:00+02:00", schema.DataTypeDate),
+			},
+		},
+	}
+
+	classSearch := func(t *testing.T, limit int, filter *filters.LocalFilter,
+		sort *filters.Sort) []search.Result {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: limit},
+			Filters:    filter,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	resultIDs := func(res []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out
+	}
+
+	t.Run("filtering by a creation time range", func(t *testing.T) {
+		res := classSearch(t, 10, rangeFilter, nil)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[30], ids[60]}, resultIDs(res))
+	})
+
+	t.Run("filtering by the creation time in milliseconds", func(t *testing.T) {
+		millis := start.Add(90*time.Minute).UnixNano() / int64(time.Millisecond)
+		res := classSearch(t, 10, &filters.LocalFilter{
+			Root: func() *filters.Clause {
+				c := creationTimeClause(filters.OperatorGreaterThan, int(millis),
+					schema.DataTypeInt)
+				return &c
+			}(),
+		}, nil)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[120]}, resultIDs(res))
+	})
+
+	t.Run("sorting by creation time, oldest first", func(t *testing.T) {
+		res := classSearch(t, 10, nil, &filters.Sort{
+			Property: filters.InternalPropCreationTimeUnix,
+			Order:    filters.SortOrderAsc,
+		})
+		assert.Equal(t, []strfmt.UUID{ids[0], ids[30], ids[60], ids[90], ids[120]},
+			resultIDs(res))
+	})
+
+	t.Run("sorting by creation time, newest first with a limit", func(t *testing.T) {
+		res := classSearch(t, 2, nil, &filters.Sort{
+			Property: filters.InternalPropCreationTimeUnix,
+			Order:    filters.SortOrderDesc,
+		})
+		assert.Equal(t, []strfmt.UUID{ids[120], ids[90]}, resultIDs(res))
+	})
+
+	t.Run("sorting a filtered search", func(t *testing.T) {
+		res := classSearch(t, 10, rangeFilter, &filters.Sort{
+			Property: filters.InternalPropCreationTimeUnix,
+			Order:    filters.SortOrderDesc,
+		})
+		assert.Equal(t, []strfmt.UUID{ids[60], ids[30]}, resultIDs(res))
+	})
+
+	t.Run("deleting a thing removes it from the creation time index", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, ids[60]))
+
+		res := classSearch(t, 10, rangeFilter, nil)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[30]}, resultIDs(res))
+	})
+
+	t.Run("an expired thing does not shorten a sorted page", func(t *testing.T) {
+		expired := &models.Thing{
+			ID:     "3f5a2c1b-7d4e-4b6a-9c8d-000000000099",
+			Class:  class.Class,
+			Schema: map[string]interface{}{"name": "alice"},
+			CreationTimeUnix: start.Add(150*time.Minute).
+				UnixNano() / int64(time.Millisecond),
+			ExpiryTimeUnix: time.Now().Add(-time.Minute).
+				UnixNano() / int64(time.Millisecond),
+		}
+		require.Nil(t, repo.PutThing(context.Background(), expired, []float32{1, 0, 0}))
+
+		res := classSearch(t, 2, nil, &filters.Sort{
+			Property: filters.InternalPropCreationTimeUnix,
+			Order:    filters.SortOrderDesc,
+		})
+		assert.Equal(t, []strfmt.UUID{ids[120], ids[90]}, resultIDs(res))
+	})
+}
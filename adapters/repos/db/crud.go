@@ -30,25 +30,75 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
+// PutThing stores the thing. If object.Version is set, it is only stored if the
+// stored thing still has this version. On success object.Version is set to the
+// new version.
 func (d *DB) PutThing(ctx context.Context, object *models.Thing,
 	vector []float32) error {
-	return d.putObject(ctx, storobj.FromThing(object, vector))
+	return d.putThing(ctx, object, vector, false)
 }
 
+// CreateThing stores the thing only if there is no object with its id in the
+// class yet. The check is part of the write, so that of two concurrent
+// creates only one succeeds.
+func (d *DB) CreateThing(ctx context.Context, object *models.Thing,
+	vector []float32) error {
+	return d.putThing(ctx, object, vector, true)
+}
+
+func (d *DB) putThing(ctx context.Context, object *models.Thing,
+	vector []float32, create bool) error {
+	obj := storobj.FromThing(object, vector)
+	if err := d.putObject(ctx, obj, create); err != nil {
+		return err
+	}
+
+	object.Version = obj.Version()
+	return nil
+}
+
+// PutAction stores the action. If object.Version is set, it is only stored if the
+// stored action still has this version. On success object.Version is set to the
+// new version.
 func (d *DB) PutAction(ctx context.Context, object *models.Action,
 	vector []float32) error {
-	return d.putObject(ctx, storobj.FromAction(object, vector))
+	return d.putAction(ctx, object, vector, false)
 }
 
-func (d *DB) putObject(ctx context.Context, object *storobj.Object) error {
+// CreateAction stores the action only if there is no object with its id in the
+// class yet. The check is part of the write, so that of two concurrent
+// creates only one succeeds.
+func (d *DB) CreateAction(ctx context.Context, object *models.Action,
+	vector []float32) error {
+	return d.putAction(ctx, object, vector, true)
+}
+
+func (d *DB) putAction(ctx context.Context, object *models.Action,
+	vector []float32, create bool) error {
+	obj := storobj.FromAction(object, vector)
+	if err := d.putObject(ctx, obj, create); err != nil {
+		return err
+	}
+
+	object.Version = obj.Version()
+	return nil
+}
+
+func (d *DB) putObject(ctx context.Context, object *storobj.Object,
+	create bool) error {
 	idx := d.GetIndex(object.Kind, object.Class())
 	if idx == nil {
 		return fmt.Errorf("import into non-existing index for %s/%s",
 			object.Kind, object.Class())
 	}
 
-	err := idx.putObject(ctx, object)
+	err := idx.putObject(ctx, object, create)
 	if err != nil {
+		switch violation := errors.Cause(err).(type) {
+		case kinds.ErrAlreadyExists, kinds.ErrInvalidUserInput,
+			kinds.ErrVersionConflict:
+			return violation
+		}
 		return errors.Wrapf(err, "import into index %s", idx.ID())
 	}
 
@@ -98,13 +148,14 @@ func (d *DB) ActionByID(ctx context.Context, id strfmt.UUID,
 
 func (d *DB) MultiGet(ctx context.Context,
 	query []multi.Identifier) ([]search.Result, error) {
+	indices := d.indexMap()
 	byIndex := map[string][]multi.Identifier{}
 
 	for i, q := range query {
 		// store original position to make assembly easier later
 		q.OriginalPosition = i
 
-		for _, index := range d.indices {
+		for _, index := range indices {
 			if index.Config.Kind != q.Kind ||
 				index.Config.ClassName != schema.ClassName(q.ClassName) {
 				continue
@@ -118,7 +169,7 @@ func (d *DB) MultiGet(ctx context.Context,
 
 	out := make(search.Results, len(query))
 	for indexID, queries := range byIndex {
-		indexRes, err := d.indices[indexID].multiObjectByID(ctx, queries)
+		indexRes, err := indices[indexID].multiObjectByID(ctx, queries)
 		if err != nil {
 			return nil, errors.Wrapf(err, "index %q", indexID)
 		}
@@ -135,6 +186,87 @@ func (d *DB) MultiGet(ctx context.Context,
 	return out, nil
 }
 
+func (d *DB) ThingsByIDs(ctx context.Context, ids []strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) ([]*search.Result, error) {
+	return d.objectsByIDs(ctx, kind.Thing, ids, props, underscore.Classification)
+}
+
+func (d *DB) ActionsByIDs(ctx context.Context, ids []strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) ([]*search.Result, error) {
+	return d.objectsByIDs(ctx, kind.Action, ids, props, underscore.Classification)
+}
+
+// objectsByIDs looks up all ids in the indices of the particular kind. Each
+// index is only asked for the ids that have not been found yet and reads them
+// in a single transaction. The results are in the order of the ids, with nil
+// entries for ids that could not be found.
+func (d *DB) objectsByIDs(ctx context.Context, kind kind.Kind, ids []strfmt.UUID,
+	props traverser.SelectProperties, meta bool) ([]*search.Result, error) {
+	out := make([]*search.Result, len(ids))
+
+	pending := make([]multi.Identifier, len(ids))
+	for i, id := range ids {
+		pending[i] = multi.Identifier{ID: id.String(), OriginalPosition: i}
+	}
+
+	for _, index := range d.indexMap() {
+		if len(pending) == 0 {
+			break
+		}
+
+		if index.Config.Kind != kind {
+			continue
+		}
+
+		objects, err := index.multiObjectByID(ctx, pending)
+		if err != nil {
+			return nil, errors.Wrapf(err, "search index %s", index.ID())
+		}
+
+		var stillPending []multi.Identifier
+		for i, obj := range objects {
+			if obj == nil {
+				stillPending = append(stillPending, pending[i])
+				continue
+			}
+
+			out[pending[i].OriginalPosition] = obj.SearchResult()
+		}
+		pending = stillPending
+	}
+
+	var found []search.Result
+	for _, res := range out {
+		if res != nil {
+			found = append(found, *res)
+		}
+	}
+
+	if len(found) == 0 {
+		return out, nil
+	}
+
+	found, err := refcache.NewResolver(refcache.NewCacher(d, d.logger)).
+		Do(ctx, found, props, meta)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve cross-refs")
+	}
+
+	pos := 0
+	for i := range out {
+		if out[i] == nil {
+			continue
+		}
+
+		out[i] = &found[pos]
+		pos++
+	}
+
+	return out, nil
+}
+
 // objectByID checks every index of the particular kind for the ID
 func (d *DB) objectByID(ctx context.Context, kind kind.Kind, id strfmt.UUID,
 	props traverser.SelectProperties, meta bool) (*search.Result, error) {
@@ -142,7 +274,7 @@ func (d *DB) objectByID(ctx context.Context, kind kind.Kind, id strfmt.UUID,
 	var result *search.Result
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
+	for _, index := range d.indexMap() {
 		if index.Config.Kind != kind {
 			continue
 		}
@@ -181,7 +313,7 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
+	for _, index := range d.indexMap() {
 		ok, err := index.exists(ctx, id)
 		if err != nil {
 			return false, errors.Wrapf(err, "search index %s", index.ID())
@@ -194,6 +326,27 @@ func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	return false, nil
 }
 
+// ObjectClass returns the name of the class of the object with the given id
+// without reading the object itself. Only the indices of kind k are searched.
+func (d *DB) ObjectClass(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (string, bool, error) {
+	for _, index := range d.indexMap() {
+		if index.Config.Kind != k {
+			continue
+		}
+
+		ok, err := index.exists(ctx, id)
+		if err != nil {
+			return "", false, errors.Wrapf(err, "search index %s", index.ID())
+		}
+		if ok {
+			return index.Config.ClassName.String(), true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
 func (d *DB) AddReference(ctx context.Context, kind kind.Kind,
 	className string, source strfmt.UUID, propName string,
 	ref *models.SingleRef) error {
@@ -226,6 +379,11 @@ func (d *DB) Merge(ctx context.Context, merge kinds.MergeDocument) error {
 
 	err := idx.mergeObject(ctx, merge)
 	if err != nil {
+		switch violation := errors.Cause(err).(type) {
+		case kinds.ErrAlreadyExists, kinds.ErrInvalidUserInput,
+			kinds.ErrVersionConflict:
+			return violation
+		}
 		return errors.Wrapf(err, "merge into index %s", idx.ID())
 	}
 
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectsByIDs(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	firstClass := &models.Class{
+		Class: "ByIDsFirstClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	secondClass := &models.Class{
+		Class: "ByIDsSecondClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, firstClass))
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, secondClass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{firstClass, secondClass},
+		},
+	}
+
+	firstID := strfmt.UUID("1d5c2a4e-9b1f-4d07-8a3c-6e2f0b9a7c11")
+	secondID := strfmt.UUID("2e6d3b5f-0c2a-4e18-9b4d-7f3a1c0b8d22")
+	missingID := strfmt.UUID("3f7e4c6a-1d3b-4f29-8c5e-8a4b2d1c9e33")
+
+	t.Run("importing things into different classes", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     firstID,
+			Class:  firstClass.Class,
+			Schema: map[string]interface{}{"name": "first"},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+
+		err = repo.PutThing(context.Background(), &models.Thing{
+			ID:     secondID,
+			Class:  secondClass.Class,
+			Schema: map[string]interface{}{"name": "second"},
+		}, []float32{3, 2, 1})
+		require.Nil(t, err)
+	})
+
+	t.Run("retrieving them in the order of the ids", func(t *testing.T) {
+		res, err := repo.ThingsByIDs(context.Background(),
+			[]strfmt.UUID{secondID, missingID, firstID}, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		require.NotNil(t, res[0])
+		assert.Equal(t, secondID, res[0].ID)
+		assert.Equal(t, "second", res[0].Schema.(map[string]interface{})["name"])
+		assert.Nil(t, res[1])
+		require.NotNil(t, res[2])
+		assert.Equal(t, firstID, res[2].ID)
+		assert.Equal(t, "first", res[2].Schema.(map[string]interface{})["name"])
+	})
+
+	t.Run("retrieving things as actions", func(t *testing.T) {
+		res, err := repo.ActionsByIDs(context.Background(),
+			[]strfmt.UUID{firstID, secondID}, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, []*search.Result{nil, nil}, res)
+	})
+}
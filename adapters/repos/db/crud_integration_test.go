@@ -143,6 +143,17 @@ func TestCRUD(t *testing.T) {
 		assert.True(t, ok)
 	})
 
+	t.Run("looking up the class of the thing", func(t *testing.T) {
+		className, ok, err := repo.ObjectClass(context.Background(), kind.Thing, thingID)
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "TheBestThingClass", className)
+
+		_, ok, err = repo.ObjectClass(context.Background(), kind.Action, thingID)
+		require.Nil(t, err)
+		assert.False(t, ok, "a thing is not an action")
+	})
+
 	t.Run("trying to add a thing to a non-existing class", func(t *testing.T) {
 
 		thing := &models.Thing{
@@ -205,6 +216,7 @@ func TestCRUD(t *testing.T) {
 			ID:                 thingID,
 			Class:              "TheBestThingClass",
 			VectorWeights:      map[string]string(nil),
+			Version:            2,
 			Schema: map[string]interface{}{
 				"stringProp": "updated value",
 				"phone": &models.PhoneNumber{
@@ -373,7 +385,7 @@ func TestCRUD(t *testing.T) {
 		// somewhat far from the thing. So it should match the action closer
 		searchVector := []float32{2.9, 1.1, 0.5, 8.01}
 
-		res, err := repo.VectorSearch(context.Background(), searchVector, 10, nil)
+		res, err := repo.VectorSearch(context.Background(), searchVector, 10, nil, nil)
 
 		require.Nil(t, err)
 		require.Equal(t, true, len(res) >= 2)
@@ -390,6 +402,18 @@ func TestCRUD(t *testing.T) {
 		assert.Equal(t, int64(1000001), res[1].Updated)
 	})
 
+	t.Run("searching by vector limited to some classes", func(t *testing.T) {
+		searchVector := []float32{2.9, 1.1, 0.5, 8.01}
+
+		res, err := repo.VectorSearch(context.Background(), searchVector, 10, nil,
+			[]string{"TheBestThingClass"})
+
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, thingID, res[0].ID)
+		assert.Equal(t, "TheBestThingClass", res[0].ClassName)
+	})
+
 	t.Run("searching by vector for a single class", func(t *testing.T) {
 		// the search vector is designed to be very close to the action, but
 		// somewhat far from the thing. So it should match the action closer
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateIndexBackfill(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "DateBackfillClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "happenedAt",
+				DataType: []string{string(schema.DataTypeDate)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(30*time.Second))
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8c2b1f0e-4a3d-4e6b-9f7a-1d2c3b4a5e01",
+		"8c2b1f0e-4a3d-4e6b-9f7a-1d2c3b4a5e02",
+	}
+	for i, id := range ids {
+		require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+			ID:    id,
+			Class: class.Class,
+			Schema: map[string]interface{}{
+				"happenedAt": time.Date(2020, 6, 1+i, 10, 0, 0, 0, time.UTC),
+			},
+		}, []float32{1, 0, 0}))
+	}
+
+	afterMay := func(t *testing.T, repo *DB) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: buildFilter("happenedAt", "2020-05-31T00:00:00Z",
+				filters.OperatorGreaterThan, schema.DataTypeDate),
+		})
+		require.Nil(t, err)
+
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out
+	}
+
+	restart := func(t *testing.T, repo *DB) *DB {
+		shard := repo.GetIndex(kind.Thing, schema.ClassName(class.Class)).Shards["single"]
+		require.Nil(t, shard.db.Close())
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+		return restarted
+	}
+
+	t.Run("simulating a shard written before dates were indexed", func(t *testing.T) {
+		shard := repo.GetIndex(kind.Thing, schema.ClassName(class.Class)).Shards["single"]
+		err := shard.db.Update(func(tx *bolt.Tx) error {
+			name := helpers.BucketFromPropName("happenedAt")
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+
+			return tx.Bucket(helpers.MigrationsBucket).Delete(dateIndexMigrationKey)
+		})
+		require.Nil(t, err)
+
+		assert.Len(t, afterMay(t, repo), 0)
+	})
+
+	t.Run("the date index is backfilled on startup", func(t *testing.T) {
+		repo = restart(t, repo)
+		assert.ElementsMatch(t, ids, afterMay(t, repo))
+	})
+
+	t.Run("the backfill only runs once", func(t *testing.T) {
+		repo = restart(t, repo)
+		assert.ElementsMatch(t, ids, afterMay(t, repo))
+	})
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// nowMillis is the current time in the unit of expiry times
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// withoutExpired removes objects whose expiry time has passed. They stay on
+// disk until the expiry sweeper deletes them, but must not be served in the
+// meantime.
+func withoutExpired(objects []*storobj.Object) []*storobj.Object {
+	now := nowMillis()
+	out := objects[:0]
+	for _, obj := range objects {
+		if obj != nil && obj.Expired(now) {
+			continue
+		}
+
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// hideExpired is the positional variant of withoutExpired, expired objects
+// are replaced with nil
+func hideExpired(objects []*storobj.Object) {
+	now := nowMillis()
+	for i, obj := range objects {
+		if obj != nil && obj.Expired(now) {
+			objects[i] = nil
+		}
+	}
+}
+
+// expiredBinary checks the expiry time of a marshalled object without
+// unmarshalling the object
+func expiredBinary(data []byte, now int64) (bool, error) {
+	expiry, err := storobj.ExpiryFromBinary(data)
+	if err != nil {
+		return false, errors.Wrap(err, "read expiry time")
+	}
+
+	return expiry != 0 && expiry <= now, nil
+}
+
+// ExpiredObjects returns up to limit objects of any class whose expiry time
+// is not after now. The results only contain kind, class and id, which is
+// all that's needed to delete them. As expiry times are not indexed, every
+// shard is scanned in full.
+func (d *DB) ExpiredObjects(ctx context.Context, now int64,
+	limit int) ([]search.Result, error) {
+	type sweepTarget struct {
+		index *Index
+		shard *Shard
+	}
+
+	// the sweeper doesn't hold the connector lock, so classes can be added or
+	// renamed while it runs. Only the shards which exist when it starts are
+	// scanned.
+	var targets []sweepTarget
+	d.indexLock.RLock()
+	for _, index := range d.indices {
+		for _, shard := range index.Shards {
+			targets = append(targets, sweepTarget{index, shard})
+		}
+	}
+	d.indexLock.RUnlock()
+
+	var out []search.Result
+	for _, target := range targets {
+		if len(out) >= limit {
+			return out, nil
+		}
+
+		res, err := target.shard.expiredObjects(ctx, now, limit-len(out))
+		if err != nil {
+			return nil, errors.Wrapf(err, "index %s: shard %s", target.index.ID(),
+				target.shard.ID())
+		}
+
+		out = append(out, res...)
+	}
+
+	return out, nil
+}
+
+func (s *Shard) expiredObjects(ctx context.Context, now int64,
+	limit int) ([]search.Result, error) {
+	var out []search.Result
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, last, err := s.objectListPage(after, streamPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range page {
+			if !obj.Expired(now) {
+				continue
+			}
+
+			out = append(out, search.Result{
+				Kind:      obj.Kind,
+				ClassName: obj.Class().String(),
+				ID:        obj.ID(),
+			})
+			if len(out) >= limit {
+				return out, nil
+			}
+		}
+
+		if len(page) < streamPageSize {
+			// reached the end of the bucket
+			return out, nil
+		}
+
+		after = last
+	}
+}
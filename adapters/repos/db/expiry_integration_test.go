@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiry(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "ExpiringThing",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	t.Run("creating the thing class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	expiredID := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	futureID := strfmt.UUID("b0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	permanentID := strfmt.UUID("c0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+
+	t.Run("adding things with and without an expiry time", func(t *testing.T) {
+		things := []*models.Thing{
+			{ID: expiredID, Class: "ExpiringThing", ExpiryTimeUnix: now - 1000},
+			{ID: futureID, Class: "ExpiringThing", ExpiryTimeUnix: now + 60*60*1000},
+			{ID: permanentID, Class: "ExpiringThing"},
+		}
+		for _, thing := range things {
+			thing.Schema = map[string]interface{}{"name": "expiring"}
+		}
+		for _, thing := range things {
+			require.Nil(t, repo.PutThing(context.Background(), thing, []float32{1, 2, 3}))
+		}
+	})
+
+	t.Run("an expired thing can't be retrieved by id", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), expiredID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("a thing which expires later can be retrieved by id", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), futureID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, now+60*60*1000, res.Thing().ExpiryTimeUnix)
+	})
+
+	t.Run("an expired thing is not listed", func(t *testing.T) {
+		res, err := repo.ThingSearch(context.Background(), 10, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{futureID, permanentID}, ids)
+	})
+
+	t.Run("an expired thing does not exist", func(t *testing.T) {
+		ok, err := repo.Exists(context.Background(), expiredID)
+		require.Nil(t, err)
+		assert.False(t, ok)
+
+		ok, err = repo.Exists(context.Background(), futureID)
+		require.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("an expired thing is not counted", func(t *testing.T) {
+		count, err := repo.Count(context.Background(), kind.Thing, "ExpiringThing", nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), count)
+
+		count, err = repo.Count(context.Background(), kind.Thing, "ExpiringThing",
+			&filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    "ExpiringThing",
+					Property: "name",
+				},
+				Value: &filters.Value{
+					Value: "expiring",
+					Type:  schema.DataTypeString,
+				},
+			}})
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("only the expired thing is reported as expired", func(t *testing.T) {
+		res, err := repo.ExpiredObjects(context.Background(), now, 10)
+		require.Nil(t, err)
+		expected := []search.Result{{
+			Kind:      kind.Thing,
+			ClassName: "ExpiringThing",
+			ID:        expiredID,
+		}}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("deleting the expired thing", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), "ExpiringThing", expiredID))
+
+		res, err := repo.ExpiredObjects(context.Background(), now, 10)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+	// the sweeper runs without the connector lock, a missing index lock is
+	// reliably reported when running with -race
+	t.Run("sweeping while classes are added", func(t *testing.T) {
+		done := make(chan error)
+		go func() {
+			for i := 0; i < 20; i++ {
+				err := migrator.AddClass(context.Background(), kind.Thing, &models.Class{
+					Class: fmt.Sprintf("SweptWhileAdded%d", i),
+				})
+				if err != nil {
+					done <- err
+					return
+				}
+			}
+			done <- nil
+		}()
+
+		for {
+			select {
+			case err := <-done:
+				require.Nil(t, err)
+				return
+			default:
+			}
+
+			_, err := repo.ExpiredObjects(context.Background(), now, 10)
+			require.Nil(t, err)
+		}
+	})
+}
@@ -61,6 +61,19 @@ func TestFilters(t *testing.T) {
 	t.Run("chained primitive props",
 		testChainedPrimitiveProps(repo, migrator))
 
+	t.Run("counting without a filter", func(t *testing.T) {
+		count, err := repo.Count(context.Background(), kind.Thing, carClass.Class, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(cars)), count)
+
+		count, err = repo.Count(context.Background(), kind.Thing, "", nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(cars)), count)
+	})
+
+	// imports an additional car, so it needs to run last
+	t.Run("null checks",
+		testNullChecks(repo))
 }
 
 var (
@@ -186,17 +199,35 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("weight", 2069.5, gte, dtNumber),
 				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
 			},
-			// TODO gh-1150 support date
-			// {
-			// 	name:        "before 1980",
-			// 	filter:      buildFilter("released", "1980-01-01T00:00:00+02:00", lt, dtDate),
-			// 	expectedIDs: []strfmt.UUID{carPoloID},
-			// },
-			// {
-			// 	name:        "from 1995 on",
-			// 	filter:      buildFilter("released", "1995-08-17T12:47:00+02:00", gte, dtDate),
-			// 	expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
-			// },
+			{
+				name:        "before 1980",
+				filter:      buildFilter("released", "1980-01-01T00:00:00+02:00", lt, dtDate),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "from 1995 on",
+				filter:      buildFilter("released", "1995-08-17T12:47:00+02:00", gte, dtDate),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
+			},
+			{
+				name:        "after 1995 in a different timezone",
+				filter:      buildFilter("released", "1995-08-17T10:47:00Z", gt, dtDate),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name: "released at exactly the same instant",
+				filter: buildFilter("released",
+					time.Date(1995, 8, 17, 10, 47, 0, 0, time.UTC), eq, dtDate),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "released between 1980 and 2000",
+				filter: filterAnd(
+					buildFilter("released", "1980-01-01T00:00:00Z", gte, dtDate),
+					buildFilter("released", "2000-01-01T00:00:00Z", lt, dtDate),
+				),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
 			{
 				name:        "exactly matching a specific contact email",
 				filter:      buildFilter("contact", "john@heavycars.example.com", eq, dtString),
@@ -255,6 +286,99 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 					ids[pos] = concept.ID
 				}
 				assert.ElementsMatch(t, ids, test.expectedIDs, "ids dont match")
+
+				count, err := repo.Count(context.Background(), kind.Thing,
+					carClass.Class, test.filter)
+				require.Nil(t, err)
+				assert.Equal(t, int64(len(test.expectedIDs)), count, "count doesnt match")
+			})
+		}
+	}
+}
+
+func testNullChecks(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Run("importing a car with missing and empty props", func(t *testing.T) {
+			require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+				Class: carClass.Class,
+				ID:    carUnknownID,
+				Schema: map[string]interface{}{
+					"modelName":   "",
+					"description": "This car is a mystery.",
+				},
+			}, carVectors[3]))
+
+			require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+				Class: carClass.Class,
+				ID:    carBlankID,
+				Schema: map[string]interface{}{
+					"modelName":   " \t ",
+					"description": "?!",
+				},
+			}, carVectors[3]))
+		})
+
+		allCars := []strfmt.UUID{carSprinterID, carE63sID, carPoloID}
+
+		type test struct {
+			name        string
+			filter      *filters.LocalFilter
+			expectedIDs []strfmt.UUID
+		}
+
+		tests := []test{
+			{
+				name:        "horsepower is null",
+				filter:      buildNullFilter("horsepower", filters.OperatorIsNull),
+				expectedIDs: []strfmt.UUID{carUnknownID, carBlankID},
+			},
+			{
+				name:        "horsepower is not null",
+				filter:      buildNullFilter("horsepower", filters.OperatorIsNotNull),
+				expectedIDs: allCars,
+			},
+			{
+				name:        "an empty or whitespace-only string counts as null",
+				filter:      buildNullFilter("modelName", filters.OperatorIsNull),
+				expectedIDs: []strfmt.UUID{carUnknownID, carBlankID},
+			},
+			{
+				name:        "a text without any words counts as null",
+				filter:      buildNullFilter("description", filters.OperatorIsNull),
+				expectedIDs: []strfmt.UUID{carBlankID},
+			},
+			{
+				name:        "a text with several words is matched once",
+				filter:      buildNullFilter("description", filters.OperatorIsNotNull),
+				expectedIDs: append(allCars, carUnknownID),
+			},
+			{
+				name: "combined with another operator",
+				filter: filterAnd(
+					buildNullFilter("weight", filters.OperatorIsNotNull),
+					buildFilter("horsepower", 200, lt, dtInt),
+				),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := traverser.GetParams{
+					SearchVector: []float32{0.1, 0.1, 0.1, 1.1, 0.1},
+					Kind:         kind.Thing,
+					ClassName:    carClass.Class,
+					Pagination:   &filters.Pagination{Limit: 100},
+					Filters:      test.filter,
+				}
+				res, err := repo.ClassSearch(context.Background(), params)
+				require.Nil(t, err)
+
+				ids := make([]strfmt.UUID, len(res))
+				for pos, concept := range res {
+					ids[pos] = concept.ID
+				}
+				assert.ElementsMatch(t, test.expectedIDs, ids, "ids dont match")
 			})
 		}
 	}
@@ -320,22 +444,45 @@ func testChainedPrimitiveProps(repo *DB,
 				),
 				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
 			},
-			// test{
-			// 	name: "NOT modelName == sprinter, modelName == e63s",
-			// 	filter: filterNot(
-			// 		buildFilter("modelName", "sprinter", eq, dtString),
-			// 		buildFilter("modelName", "e63s", eq, dtString),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carPoloID},
-			// },
-			// test{
-			// 	name: "NOT horsepower < 200 , weight > 3000",
-			// 	filter: filterNot(
-			// 		buildFilter("horsepower", 200, lt, dtNumber),
-			// 		buildFilter("weight", 3000, gt, dtNumber),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carE63sID},
-			// },
+			test{
+				name: "NOT (modelName == sprinter OR modelName == e63s)",
+				filter: filterNot(
+					filterOr(
+						buildFilter("modelName", "sprinter", eq, dtString),
+						buildFilter("modelName", "e63s", eq, dtString),
+					),
+				),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			test{
+				name: "NOT (horsepower < 200 AND weight > 3000)",
+				filter: filterNot(
+					filterAnd(
+						buildFilter("horsepower", 200, lt, dtInt),
+						buildFilter("weight", float64(3000), gt, dtNumber),
+					),
+				),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
+			test{
+				name: "NOT NOT modelName == sprinter",
+				filter: filterNot(
+					filterNot(
+						buildFilter("modelName", "sprinter", eq, dtString),
+					),
+				),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			test{
+				name: "light AND NOT modelName == polo",
+				filter: filterAnd(
+					buildFilter("weight", float64(1500), lt, dtNumber),
+					filterNot(
+						buildFilter("modelName", "polo", eq, dtString),
+					),
+				),
+				expectedIDs: []strfmt.UUID{},
+			},
 			test{
 				name: "(heavy AND powerful) OR light",
 				filter: filterOr(
@@ -388,6 +535,18 @@ func buildFilter(propName string, value interface{}, operator filters.Operator,
 	}
 }
 
+func buildNullFilter(propName string, operator filters.Operator) *filters.LocalFilter {
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: operator,
+			On: &filters.Path{
+				Class:    schema.ClassName(carClass.Class),
+				Property: schema.PropertyName(propName),
+			},
+		},
+	}
+}
+
 func compoundFilter(operator filters.Operator,
 	operands ...*filters.LocalFilter) *filters.LocalFilter {
 	clauses := make([]filters.Clause, len(operands), len(operands))
@@ -454,6 +613,8 @@ var (
 	carSprinterID strfmt.UUID = "d4c48788-7798-4bdd-bca9-5cd5012a5271"
 	carE63sID     strfmt.UUID = "62906c61-f92f-4f2c-874f-842d4fb9d80b"
 	carPoloID     strfmt.UUID = "b444e1d8-d73a-4d53-a417-8d6501c27f2e"
+	carUnknownID  strfmt.UUID = "0c9c3aa2-0a8e-4b2e-9a39-7c2f5e0e6a11"
+	carBlankID    strfmt.UUID = "0c9c3aa2-0a8e-4b2e-9a39-7c2f5e0e6a12"
 )
 
 var cars = []models.Thing{
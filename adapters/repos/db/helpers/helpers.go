@@ -16,6 +16,11 @@ import "fmt"
 var (
 	ObjectsBucket []byte = []byte("objects")
 	IndexIDBucket []byte = []byte("index_ids")
+	ReindexBucket []byte = []byte("reindex")
+
+	// MigrationsBucket records which one-off migrations of the stored data a
+	// shard has completed
+	MigrationsBucket []byte = []byte("migrations")
 )
 
 // BucketFromPropName creates the byte-represenation used as the bucket name
@@ -27,6 +32,12 @@ func BucketFromPropName(propName string) []byte {
 // MetaCountProp helps create an internally used propName for meta props that
 // don't explicitly exist in the user schema, but are required for proper
 // indexing, such as the count of arrays.
+// UniqueBucketFromPropName is the bucket holding the value->docID lookup of
+// a property with a unique constraint
+func UniqueBucketFromPropName(propName string) []byte {
+	return []byte(fmt.Sprintf("unique_%s", propName))
+}
+
 func MetaCountProp(propName string) string {
 	return fmt.Sprintf("%s__meta_count", propName)
 }
@@ -14,7 +14,10 @@ package db
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -61,24 +64,74 @@ func NewIndex(config IndexConfig, sg schemaUC.SchemaGetter) (*Index, error) {
 	return index, nil
 }
 
+// shardFor determines which shard owns the object with the specified id. The
+// owner is derived from a hash of the id, so that objects are spread evenly
+// across all shards of the index and every object is always found in the
+// same shard.
+func (i *Index) shardFor(id strfmt.UUID) *Shard {
+	if len(i.Shards) == 1 {
+		for _, shard := range i.Shards {
+			return shard
+		}
+	}
+
+	names := make([]string, 0, len(i.Shards))
+	for name := range i.Shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return i.Shards[names[h.Sum32()%uint32(len(names))]]
+}
+
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
+	for _, shard := range i.Shards {
+		if err := shard.addProperty(ctx, prop); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
 
-	return shard.addProperty(ctx, prop)
+	return nil
+}
+
+func (i *Index) dropProperty(ctx context.Context, propName string) error {
+	for _, shard := range i.Shards {
+		if err := shard.dropProperty(ctx, propName); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
 }
 
 type IndexConfig struct {
 	RootPath  string
 	Kind      kind.Kind
 	ClassName schema.ClassName
+
+	// SyncPolicy and SyncEveryN are passed down from the db Config
+	SyncPolicy SyncPolicy
+	SyncEveryN int
+
+	// VectorIndexRecovery is passed down from the db Config
+	VectorIndexRecovery VectorIndexRecovery
+
+	// BoltOptions is passed down from the db Config
+	BoltOptions BoltOptions
+
+	// VectorIndexConfig contains the user-set hnsw parameters of the class, nil
+	// means all defaults
+	VectorIndexConfig *models.VectorIndexConfig
 }
 
 func indexID(kind kind.Kind, class schema.ClassName) string {
 	return strings.ToLower(fmt.Sprintf("%s_%s", kind, class))
 }
 
-func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
+func (i *Index) putObject(ctx context.Context, object *storobj.Object,
+	create bool) error {
 	if i.Config.Kind != object.Kind {
 		return fmt.Errorf("cannot import object of kind %s into index of kind %s",
 			object.Kind, i.Config.Kind)
@@ -89,9 +142,8 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 			object.Class(), i.Config.ClassName)
 	}
 
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
-	err := shard.putObject(ctx, object)
+	shard := i.shardFor(object.ID())
+	err := shard.putObject(ctx, object, create)
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -102,32 +154,124 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.putObjectBatch(ctx, objects)
+	type shardBatch struct {
+		objects []*storobj.Object
+
+		// originalIndices maps the position in the shard batch to the position
+		// in the batch as the index received it
+		originalIndices []int
+	}
+
+	// collisions within the batch are detected upfront, as the colliding
+	// objects might end up in different shards
+	errs := i.uniqueCollisionsInBatch(objects)
+
+	byShard := map[*Shard]*shardBatch{}
+	for pos, object := range objects {
+		if _, ok := errs[pos]; ok {
+			continue
+		}
+
+		shard := i.shardFor(object.ID())
+		batch, ok := byShard[shard]
+		if !ok {
+			batch = &shardBatch{}
+			byShard[shard] = batch
+		}
+
+		batch.objects = append(batch.objects, object)
+		batch.originalIndices = append(batch.originalIndices, pos)
+	}
+
+	m := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for shard, batch := range byShard {
+		wg.Add(1)
+		go func(shard *Shard, batch *shardBatch) {
+			defer wg.Done()
+
+			shardErrs := shard.putObjectBatch(ctx, batch.objects)
+			m.Lock()
+			defer m.Unlock()
+			for pos, err := range shardErrs {
+				switch err.(type) {
+				case kinds.ErrAlreadyExists, kinds.ErrInvalidUserInput,
+					kinds.ErrVersionConflict:
+					// user-facing, no need for internals
+					errs[batch.originalIndices[pos]] = err
+					continue
+				}
+				errs[batch.originalIndices[pos]] = errors.Wrapf(err, "shard %s", shard.ID())
+			}
+		}(shard, batch)
+	}
+	wg.Wait()
+
+	return errs
 }
 
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) addReferencesBatch(ctx context.Context,
 	refs kinds.BatchReferences) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.addReferencesBatch(ctx, refs)
+	type shardBatch struct {
+		refs            kinds.BatchReferences
+		originalIndices []int
+	}
+
+	byShard := map[*Shard]*shardBatch{}
+	for pos, ref := range refs {
+		shard := i.shardFor(ref.From.TargetID)
+		batch, ok := byShard[shard]
+		if !ok {
+			batch = &shardBatch{}
+			byShard[shard] = batch
+		}
+
+		batch.refs = append(batch.refs, ref)
+		batch.originalIndices = append(batch.originalIndices, pos)
+	}
+
+	m := &sync.Mutex{}
+	errs := map[int]error{}
+	wg := &sync.WaitGroup{}
+	for shard, batch := range byShard {
+		wg.Add(1)
+		go func(shard *Shard, batch *shardBatch) {
+			defer wg.Done()
+
+			shardErrs := shard.addReferencesBatch(ctx, batch.refs)
+			m.Lock()
+			defer m.Unlock()
+			for pos, err := range shardErrs {
+				if _, ok := err.(kinds.ErrInvalidUserInput); ok {
+					// user-facing, no need for internals
+					errs[batch.originalIndices[pos]] = err
+					continue
+				}
+				errs[batch.originalIndices[pos]] = errors.Wrapf(err, "shard %s", shard.ID())
+			}
+		}(shard, batch)
+	}
+	wg.Wait()
+
+	return errs
 }
 
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	props traverser.SelectProperties, meta bool) (*storobj.Object, error) {
 	// TODO: don't ignore meta
 
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
-
-	shard := i.Shards["single"]
+	shard := i.shardFor(id)
 	obj, err := shard.objectByID(ctx, id, props, meta)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	if obj != nil && obj.Expired(nowMillis()) {
+		return nil, nil
+	}
+
+	i.removeDroppedProperties(obj)
 	return obj, nil
 }
 
@@ -142,14 +286,13 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	hideExpired(objects)
+	i.removeDroppedProperties(objects...)
 	return objects, nil
 }
 
 func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
-
-	shard := i.Shards["single"]
+	shard := i.shardFor(id)
 	ok, err := shard.exists(ctx, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "shard %s", shard.ID())
@@ -170,27 +313,47 @@ func (i *Index) objectSearch(ctx context.Context, limit int,
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	res = withoutExpired(res)
+	i.removeDroppedProperties(res...)
 	return res, nil
 }
 
-func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	limit int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
-	// TODO: don't ignore meta
+// objectSortedSearch is like objectSearch, but returns the objects in the
+// order of their creation time, see filters.ValidateSort
+func (i *Index) objectSortedSearch(ctx context.Context, limit int,
+	filters *filters.LocalFilter, sort *filters.Sort,
+	meta bool) ([]*storobj.Object, error) {
 	// TODO: search across all shards, rather than hard-coded "single" shard
 
 	shard := i.Shards["single"]
-	res, err := shard.objectVectorSearch(ctx, searchVector, limit, filters, meta)
+	res, err := shard.objectSortedSearch(ctx, limit, filters, sort, meta)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	res = withoutExpired(res)
+	i.removeDroppedProperties(res...)
 	return res, nil
 }
 
-func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
+func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
+	limit int, ef int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	// TODO: don't ignore meta
 	// TODO: search across all shards, rather than hard-coded "single" shard
 
 	shard := i.Shards["single"]
+	res, err := shard.objectVectorSearch(ctx, searchVector, limit, ef, filters, meta)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	res = withoutExpired(res)
+	i.removeDroppedProperties(res...)
+	return res, nil
+}
+
+func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
+	shard := i.shardFor(id)
 	if err := shard.deleteObject(ctx, id); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -199,12 +362,43 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
 }
 
 func (i *Index) mergeObject(ctx context.Context, merge kinds.MergeDocument) error {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-
-	shard := i.Shards["single"]
+	shard := i.shardFor(merge.ID)
 	if err := shard.mergeObject(ctx, merge); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
 	return nil
 }
+
+// removeDroppedProperties strips the values of properties which are no longer
+// part of the class. The schema is updated before the shards remove the
+// stored values, so a read in between could otherwise still see them.
+func (i *Index) removeDroppedProperties(objects ...*storobj.Object) {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.Kind, i.Config.ClassName)
+	if class == nil {
+		return
+	}
+
+	props := map[string]struct{}{}
+	for _, prop := range class.Properties {
+		props[prop.Name] = struct{}{}
+	}
+
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+
+		schemaMap, ok := obj.Schema().(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for name := range schemaMap {
+			if _, ok := props[name]; !ok {
+				delete(schemaMap, name)
+			}
+		}
+	}
+}
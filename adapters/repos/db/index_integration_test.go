@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_BatchAcrossShards(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	class := &models.Class{
+		Class: "ShardedClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+
+	index, err := NewIndex(IndexConfig{
+		RootPath:  dirName,
+		Kind:      kind.Thing,
+		ClassName: schema.ClassName(class.Class),
+	}, schemaGetter)
+	require.Nil(t, err)
+
+	second, err := NewShard("second", index)
+	require.Nil(t, err)
+	index.Shards["second"] = second
+
+	for _, prop := range class.Properties {
+		require.Nil(t, index.addProperty(context.Background(), prop))
+	}
+
+	var objects []*storobj.Object
+	for i := 0; i < 100; i++ {
+		objects = append(objects, storobj.FromThing(&models.Thing{
+			ID:    strfmt.UUID(uuid.New().String()),
+			Class: class.Class,
+			Schema: map[string]interface{}{
+				"name": fmt.Sprintf("object %d", i),
+			},
+		}, []float32{rand.Float32(), rand.Float32(), rand.Float32()}))
+	}
+
+	t.Run("importing the batch", func(t *testing.T) {
+		errs := index.putObjectBatch(context.Background(), objects)
+		assert.Len(t, errs, 0)
+	})
+
+	t.Run("objects are spread across both shards", func(t *testing.T) {
+		counts := map[string]int{}
+		for _, obj := range objects {
+			for name, shard := range index.Shards {
+				ok, err := shard.exists(context.Background(), obj.ID())
+				require.Nil(t, err)
+				if ok {
+					counts[name]++
+				}
+			}
+		}
+
+		assert.Greater(t, counts["single"], 0)
+		assert.Greater(t, counts["second"], 0)
+		assert.Equal(t, len(objects), counts["single"]+counts["second"],
+			"every object is stored in exactly one shard")
+	})
+
+	t.Run("every object can be retrieved from its owning shard", func(t *testing.T) {
+		for i, obj := range objects {
+			res, err := index.objectByID(context.Background(), obj.ID(),
+				traverser.SelectProperties{}, false)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, fmt.Sprintf("object %d", i),
+				res.Schema().(map[string]interface{})["name"])
+		}
+	})
+	t.Run("paging with a cursor merges the shards in id order", func(t *testing.T) {
+		expected := make([]string, len(objects))
+		for i, obj := range objects {
+			expected[i] = obj.ID().String()
+		}
+		sort.Strings(expected)
+
+		var seen []string
+		var after []byte
+		for {
+			page, next, err := index.objectsAfter(context.Background(), after, 30)
+			require.Nil(t, err)
+			for _, obj := range page {
+				seen = append(seen, obj.ID().String())
+			}
+
+			if next == "" {
+				break
+			}
+			parsed, err := uuid.Parse(next.String())
+			require.Nil(t, err)
+			after, err = parsed.MarshalBinary()
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, expected, seen)
+	})
+}
@@ -23,12 +23,29 @@ import (
 type Counter struct {
 	count uint32
 	sync.Mutex
-	f *os.File
+	f        *os.File
+	readOnly bool
 }
 
 func New(shardID string, rootPath string) (*Counter, error) {
+	return open(shardID, rootPath, os.O_RDWR|os.O_CREATE)
+}
+
+// NewReadOnly opens an existing counter without ever writing to it, it can't
+// be increased
+func NewReadOnly(shardID string, rootPath string) (*Counter, error) {
+	c, err := open(shardID, rootPath, os.O_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+
+	c.readOnly = true
+	return c, nil
+}
+
+func open(shardID string, rootPath string, flag int) (*Counter, error) {
 	fileName := fmt.Sprintf("%s/%s.indexcount", rootPath, shardID)
-	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0666)
+	f, err := os.OpenFile(fileName, flag, 0666)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +73,9 @@ func New(shardID string, rootPath string) (*Counter, error) {
 func (c *Counter) GetAndInc() (uint32, error) {
 	c.Lock()
 	defer c.Unlock()
+	if c.readOnly {
+		return 0, errors.New("counter is opened read-only")
+	}
 	before := c.count
 	c.count++
 	c.f.Seek(0, 0)
@@ -66,3 +86,14 @@ func (c *Counter) GetAndInc() (uint32, error) {
 	c.f.Seek(0, 0)
 	return before, nil
 }
+
+// Get returns the current count without increasing it
+func (c *Counter) Get() uint32 {
+	c.Lock()
+	defer c.Unlock()
+	return c.count
+}
+
+func (c *Counter) Close() error {
+	return c.f.Close()
+}
@@ -12,6 +12,8 @@
 package db
 
 import (
+	"context"
+	"fmt"
 	"os"
 
 	"github.com/pkg/errors"
@@ -21,43 +23,84 @@ import (
 
 // On init we get the current schema and create one index object per class.
 // They will in turn create shards which will either read an existing db file
-// from disk or create a new one if none exists
-func (d *DB) init() error {
+// from disk or create a new one if none exists. The progress is recorded so
+// that it can be reported while waiting for startup.
+func (d *DB) init(ctx context.Context, progress *startupProgress) error {
 	if err := os.MkdirAll(d.config.RootPath, 0777); err != nil {
 		return errors.Wrapf(err, "create root path directory at %s", d.config.RootPath)
 	}
 
+	progress.start("pending index renames")
+	if err := d.completePendingRenames(); err != nil {
+		return errors.Wrap(err, "complete pending index renames")
+	}
+
 	things := d.schemaGetter.GetSchemaSkipAuth().Things
+	actions := d.schemaGetter.GetSchemaSkipAuth().Actions
+	total := 0
+	if things != nil {
+		total += len(things.Classes)
+	}
+	if actions != nil {
+		total += len(actions.Classes)
+	}
+	progress.setTotal(total)
+
 	if things != nil {
 		for _, class := range things.Classes {
+			progress.start(fmt.Sprintf("index %q", indexID(kind.Thing, schema.ClassName(class.Class))))
 			idx, err := NewIndex(IndexConfig{
-				Kind:      kind.Thing,
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
+				Kind:                kind.Thing,
+				ClassName:           schema.ClassName(class.Class),
+				RootPath:            d.config.RootPath,
+				VectorIndexConfig:   class.VectorIndexConfig,
+				SyncPolicy:          d.config.SyncPolicy,
+				SyncEveryN:          d.config.SyncEveryN,
+				VectorIndexRecovery: d.config.VectorIndexRecovery,
+				BoltOptions:         d.config.BoltOptions,
 			}, d.schemaGetter)
 
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
 
+			d.indexLock.Lock()
 			d.indices[idx.ID()] = idx
+			d.indexLock.Unlock()
+			if err := d.recoverVectorIndexes(ctx, idx, progress); err != nil {
+				return errors.Wrap(err, "recover vector index")
+			}
+
+			progress.finishIndex()
 		}
 	}
 
-	actions := d.schemaGetter.GetSchemaSkipAuth().Actions
 	if actions != nil {
 		for _, class := range actions.Classes {
+			progress.start(fmt.Sprintf("index %q", indexID(kind.Action, schema.ClassName(class.Class))))
 			idx, err := NewIndex(IndexConfig{
-				Kind:      kind.Action,
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
+				Kind:                kind.Action,
+				ClassName:           schema.ClassName(class.Class),
+				RootPath:            d.config.RootPath,
+				VectorIndexConfig:   class.VectorIndexConfig,
+				SyncPolicy:          d.config.SyncPolicy,
+				SyncEveryN:          d.config.SyncEveryN,
+				VectorIndexRecovery: d.config.VectorIndexRecovery,
+				BoltOptions:         d.config.BoltOptions,
 			}, d.schemaGetter)
 
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
 
+			d.indexLock.Lock()
 			d.indices[idx.ID()] = idx
+			d.indexLock.Unlock()
+			if err := d.recoverVectorIndexes(ctx, idx, progress); err != nil {
+				return errors.Wrap(err, "recover vector index")
+			}
+
+			progress.finishIndex()
 		}
 
 	}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntPropertyPrecision(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "IntPrecisionThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "externalId",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	id := strfmt.UUID("5a9d5f3e-0b8f-4f0c-9a5e-2d2b7f8b1c01")
+	// 2^53 + 1 is the smallest positive integer which can't be represented
+	// as a float64
+	var bigInt int64 = 9007199254740993
+
+	t.Run("importing a thing with a large int", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     id,
+			Class:  thingclass.Class,
+			Schema: map[string]interface{}{"externalId": bigInt},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+	})
+
+	t.Run("reading it back unchanged", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), id,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		assert.Equal(t, bigInt, res.Schema.(map[string]interface{})["externalId"])
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -127,6 +128,23 @@ func (a *Analyzer) Float(in float64) ([]Countable, error) {
 	}, nil
 }
 
+// Date requires no analysis, the date is indexed as its nanoseconds since
+// the epoch in a lexicographically sortable byte slice, so that range
+// queries compare the instants regardless of the timezone the date was set
+// in.
+func (a *Analyzer) Date(in time.Time) ([]Countable, error) {
+	data, err := LexicographicallySortableInt64(in.UnixNano())
+	if err != nil {
+		return nil, err
+	}
+
+	return []Countable{
+		Countable{
+			Data: data,
+		},
+	}, nil
+}
+
 // Bool requires no analysis, so it's actually just a simple conversion to a
 // little-endian ordered byte slice
 func (a *Analyzer) Bool(in bool) ([]Countable, error) {
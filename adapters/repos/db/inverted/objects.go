@@ -13,13 +13,35 @@ package inverted
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
+// CreationTime indexes the creation time of an object, so that filtering and
+// sorting on it do not require a full scan. The time is given in milliseconds
+// since the epoch, as stored on the object, and indexed like a date.
+func (a *Analyzer) CreationTime(creationTimeUnix int64) (Property, error) {
+	items, err := a.Date(creationTimeFromUnixMillis(creationTimeUnix))
+	if err != nil {
+		return Property{}, errors.Wrap(err, "analyze creation time")
+	}
+
+	return Property{
+		Name:         filters.InternalPropCreationTimeUnix,
+		Items:        items,
+		HasFrequency: false,
+	}, nil
+}
+
+func creationTimeFromUnixMillis(in int64) time.Time {
+	return time.Unix(0, in*int64(time.Millisecond)).UTC()
+}
+
 func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property) ([]Property, error) {
 	propsMap := map[string]*models.Property{}
 	for _, prop := range props {
@@ -32,6 +54,10 @@ func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property
 			return nil, fmt.Errorf("prop %q has no datatype", prop.Name)
 		}
 
+		if !schema.HasInvertedIndex(prop) {
+			continue
+		}
+
 		var property *Property
 		var err error
 		if schema.IsRefDataType(prop.DataType) {
@@ -123,6 +149,20 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
 
+	case schema.DataTypeDate:
+		hasFrequency = false
+		date, err := dateValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected property %s to be a date: %v", prop.Name, err)
+		}
+
+		items, err = a.Date(date)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
+		}
+	case schema.DataTypeBlob:
+		// blobs are stored, but never indexed
+		return nil, nil
 	default:
 		// ignore unsupported prop type
 		return nil, nil
@@ -155,3 +195,16 @@ func (a *Analyzer) analyzeRefProp(prop *models.Property, value interface{}) (*Pr
 		HasFrequency: false,
 	}, nil
 }
+
+// dateValue accepts dates as they are set on a new object (time.Time) as
+// well as they are read back from storage (RFC3339 string)
+func dateValue(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return filters.ParseDate(v)
+	default:
+		return time.Time{}, fmt.Errorf("expected time.Time or string, but got %T", value)
+	}
+}
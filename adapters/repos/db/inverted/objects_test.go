@@ -12,7 +12,9 @@
 package inverted
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -82,6 +84,79 @@ func TestAnalyzeObject(t *testing.T) {
 		assert.ElementsMatch(t, expectedDescription, actualDescription, res)
 	})
 
+	t.Run("with properties without an inverted index", func(t *testing.T) {
+		vFalse := false
+		schema := map[string]interface{}{
+			"title":  "hello",
+			"notes":  "a long text nobody filters on",
+			"rating": 4.5,
+			"views":  float64(12),
+		}
+
+		props := []*models.Property{
+			&models.Property{
+				Name:     "title",
+				DataType: []string{"string"},
+			},
+			&models.Property{
+				Name:            "notes",
+				DataType:        []string{"text"},
+				IndexSearchable: &vFalse,
+			},
+			&models.Property{
+				Name:            "rating",
+				DataType:        []string{"number"},
+				IndexFilterable: &vFalse,
+			},
+			&models.Property{
+				Name:          "views",
+				DataType:      []string{"int"},
+				IndexInverted: &vFalse,
+			},
+		}
+		res, err := a.Object(schema, props)
+		require.Nil(t, err)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, "title", res[0].Name)
+	})
+
+	t.Run("with date properties", func(t *testing.T) {
+		props := []*models.Property{
+			&models.Property{
+				Name:     "happenedAt",
+				DataType: []string{"date"},
+			},
+		}
+
+		// the same instant as set on a new object and as read from storage
+		// in a different timezone
+		fromObject, err := a.Object(map[string]interface{}{
+			"happenedAt": time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+		}, props)
+		require.Nil(t, err)
+
+		fromStorage, err := a.Object(map[string]interface{}{
+			"happenedAt": "2020-06-01T12:00:00+02:00",
+		}, props)
+		require.Nil(t, err)
+
+		require.Len(t, fromObject, 1)
+		assert.Equal(t, "happenedAt", fromObject[0].Name)
+		assert.False(t, fromObject[0].HasFrequency)
+		assert.Equal(t, fromObject, fromStorage)
+
+		later, err := a.Object(map[string]interface{}{
+			"happenedAt": "2020-06-01T10:00:01Z",
+		}, props)
+		require.Nil(t, err)
+		assert.Equal(t, 1, bytes.Compare(later[0].Items[0].Data, fromObject[0].Items[0].Data),
+			"later dates must sort after earlier ones")
+
+		_, err = a.Object(map[string]interface{}{"happenedAt": "yesterday"}, props)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("with refProps", func(t *testing.T) {
 		t.Run("with the ref set in the object schema", func(t *testing.T) {
 			schema := map[string]interface{}{
@@ -43,10 +43,32 @@ func (pv *propValuePair) fetchDocIDs(tx *bolt.Tx, searcher *Searcher, limit int)
 			return err
 		}
 
+		if pv.operator == filters.OperatorIsNull {
+			all, err := searcher.allDocIDs(tx)
+			if err != nil {
+				return errors.Wrap(err, "all doc ids for null check")
+			}
+
+			pointers = complement(all, pointers)
+		}
+
 		pv.docIDs = pointers
 	} else {
+		childLimit := limit
+		if pv.operator == filters.OperatorNot {
+			// the complement is only correct if the negated set is complete, the
+			// pair itself holds all ids it is the complement of
+			childLimit = -1
+			all, err := searcher.allDocIDs(tx)
+			if err != nil {
+				return errors.Wrap(err, "all doc ids for negation")
+			}
+
+			pv.docIDs = all
+		}
+
 		for i, child := range pv.children {
-			err := child.fetchDocIDs(tx, searcher, limit)
+			err := child.fetchDocIDs(tx, searcher, childLimit)
 			if err != nil {
 				return errors.Wrapf(err, "nested child %d", i)
 			}
@@ -66,6 +88,8 @@ func (pv *propValuePair) mergeDocIDs() (*docPointers, error) {
 		return mergeAnd(pv.children)
 	case filters.OperatorOr:
 		return mergeOr(pv.children)
+	case filters.OperatorNot:
+		return mergeNot(pv.docIDs, pv.children)
 	default:
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
@@ -158,6 +182,43 @@ func mergeOr(children []*propValuePair) (*docPointers, error) {
 	return &out, nil
 }
 
+// mergeNot returns all ids which are not matched by the single child
+func mergeNot(all docPointers, children []*propValuePair) (*docPointers, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("operator Not requires exactly one operand, got %d",
+			len(children))
+	}
+
+	negated, err := children[0].mergeDocIDs()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve doc ids of negated child")
+	}
+
+	out := complement(all, *negated)
+	return &out, nil
+}
+
+// complement returns all ids which are not contained in exclude
+func complement(all, exclude docPointers) docPointers {
+	excludeIDs := map[uint32]struct{}{}
+	for _, pointer := range exclude.docIDs {
+		excludeIDs[pointer.id] = struct{}{}
+	}
+
+	var out docPointers
+	for _, pointer := range all.docIDs {
+		if _, ok := excludeIDs[pointer.id]; ok {
+			continue
+		}
+
+		out.docIDs = append(out.docIDs, docPointer{
+			id: pointer.id,
+		})
+	}
+
+	return out
+}
+
 func checksumsIdentical(sets []*docPointers) bool {
 	if len(sets) == 0 {
 		return false
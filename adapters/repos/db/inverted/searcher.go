@@ -25,6 +25,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 )
 
 type Searcher struct {
@@ -62,7 +63,17 @@ func (f *Searcher) Object(ctx context.Context, limit int,
 			return errors.Wrap(err, "merge doc ids by operator")
 		}
 
-		res, err := ObjectsFromDocIDsInTx(tx, pointers.IDs())
+		ids := pointers.IDs()
+		if limit > 0 && len(ids) > limit {
+			// merging, e.g. a negation, can produce more ids than requested
+			ids = ids[:limit]
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		res, err := ObjectsFromDocIDsInTx(tx, ids)
 		if err != nil {
 			return errors.Wrap(err, "resolve doc ids to objects")
 		}
@@ -76,6 +87,30 @@ func (f *Searcher) Object(ctx context.Context, limit int,
 	return out, nil
 }
 
+// allDocIDs contains every doc id of the shard, it is the basis for negations
+func (fs *Searcher) allDocIDs(tx *bolt.Tx) (docPointers, error) {
+	var out docPointers
+	b := tx.Bucket(helpers.IndexIDBucket)
+	if b == nil {
+		return out, fmt.Errorf("index id bucket not found")
+	}
+
+	err := b.ForEach(func(k, _ []byte) error {
+		if len(k) < 4 {
+			return fmt.Errorf("invalid index id key %v", k)
+		}
+
+		// the doc id makes up the last four bytes of the key, see
+		// ObjectsFromDocIDsInTx for how keys are built
+		out.docIDs = append(out.docIDs, docPointer{
+			id: binary.LittleEndian.Uint32(k[len(k)-4:]),
+		})
+		return nil
+	})
+
+	return out, err
+}
+
 func ObjectsFromDocIDsInTx(tx *bolt.Tx,
 	pointers []uint32) ([]*storobj.Object, error) {
 	uuidKeys := make([][]byte, len(pointers))
@@ -253,6 +288,18 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 			"in standalone mode, see %s for details", notimplemented.Link)
 	}
 
+	if filter.Operator.WithoutValue() {
+		return fs.extractNullCheck(className, props[0], filter.Operator)
+	}
+
+	if props[0] == filters.InternalPropCreationTimeUnix {
+		return fs.extractCreationTime(filter.Value.Value, filter.Operator)
+	}
+
+	if err := fs.checkInvertedIndex(className, props[0]); err != nil {
+		return nil, err
+	}
+
 	// we are on a value element
 	if fs.onRefProp(className, props[0]) && filter.Value.Type == schema.DataTypeInt {
 		// ref prop and int type is a special case, the user is looking for the
@@ -283,6 +330,9 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	case schema.DataTypeNumber:
 		extractValueFn = fs.extractNumberValue
 		hasFrequency = false
+	case schema.DataTypeDate:
+		extractValueFn = fs.extractDateValue
+		hasFrequency = false
 	case "":
 		return nil, fmt.Errorf("data type cannot be empty")
 	default:
@@ -318,6 +368,95 @@ func (fs *Searcher) extractReferenceCount(propName string, value interface{},
 	}, nil
 }
 
+// extractCreationTime accepts the same values as a date property, as well as
+// an int of milliseconds since the epoch, the format of _creationTimeUnix
+func (fs *Searcher) extractCreationTime(value interface{},
+	operator filters.Operator) (*propValuePair, error) {
+	byteValue, err := fs.extractCreationTimeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         filters.InternalPropCreationTimeUnix,
+		operator:     operator,
+	}, nil
+}
+
+// extractNullCheck builds a pair for IsNull or IsNotNull. Only values which
+// produced index terms count as set, so an empty string (or a text which
+// consists only of whitespace and punctuation) is null. A reference prop is
+// null if it has no references, which is answered by the reference count.
+// esvector follows the same semantics.
+func (fs *Searcher) extractNullCheck(className schema.ClassName, propName string,
+	operator filters.Operator) (*propValuePair, error) {
+	if propName != filters.InternalPropCreationTimeUnix {
+		if err := fs.checkInvertedIndex(className, propName); err != nil {
+			return nil, err
+		}
+	}
+
+	if fs.onRefProp(className, propName) {
+		if operator == filters.OperatorIsNull {
+			return fs.extractReferenceCount(propName, 0, filters.OperatorEqual)
+		}
+		return fs.extractReferenceCount(propName, 0, filters.OperatorGreaterThan)
+	}
+
+	return &propValuePair{
+		hasFrequency: fs.propHasFrequency(className, propName),
+		prop:         propName,
+		operator:     operator,
+	}, nil
+}
+
+// propHasFrequency mirrors the analyzer, only string and text rows contain a
+// term frequency next to each doc id
+func (fs *Searcher) propHasFrequency(className schema.ClassName,
+	propName string) bool {
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return false
+	}
+
+	for _, prop := range c.Properties {
+		if prop.Name != propName || len(prop.DataType) == 0 {
+			continue
+		}
+
+		switch schema.DataType(prop.DataType[0]) {
+		case schema.DataTypeString, schema.DataTypeText:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// checkInvertedIndex fails for properties which were configured without an
+// inverted index, a filter on them would otherwise silently match nothing
+func (fs *Searcher) checkInvertedIndex(className schema.ClassName,
+	propName string) error {
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return nil
+	}
+
+	for _, prop := range c.Properties {
+		if prop.Name == propName && !schema.HasInvertedIndex(prop) {
+			return kinds.NewErrInvalidUserInput("property '%s' of class '%s' has no "+
+				"inverted index and cannot be used in a filter, it is turned off by "+
+				"indexInverted, indexFilterable or indexSearchable", propName, className)
+		}
+	}
+
+	return nil
+}
+
 func (fs *Searcher) onRefProp(className schema.ClassName, propName string) bool {
 	c := fs.schema.FindClassByName(className)
 	if c == nil {
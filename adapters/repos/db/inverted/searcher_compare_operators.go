@@ -39,6 +39,9 @@ func (fs *Searcher) docPointers(id []byte, operator filters.Operator,
 		return fs.docPointersLessThan(id, b, value, limit, hasFrequency, false)
 	case filters.OperatorLessThanEqual:
 		return fs.docPointersLessThan(id, b, value, limit, hasFrequency, true)
+	case filters.OperatorIsNotNull, filters.OperatorIsNull:
+		// IsNull is the complement of this set, see propValuePair.fetchDocIDs
+		return fs.docPointersNotNull(id, b, hasFrequency)
 	default:
 		return docPointers{}, fmt.Errorf("operator not supported (yet) in standalone "+
 			"mode, see %s for details", notimplemented.Link)
@@ -116,6 +119,44 @@ func (fs *Searcher) docPointersLessThan(prop []byte, b *bolt.Bucket,
 	return pointers, nil
 }
 
+// docPointersNotNull contains every doc id which appears in any row of the
+// prop. A doc can appear in several rows (e.g. one per word of a text), so the
+// ids are deduplicated. The limit can't be applied before deduplication, so
+// all rows are read.
+func (fs *Searcher) docPointersNotNull(prop []byte, b *bolt.Bucket,
+	hasFrequency bool) (docPointers, error) {
+	c := b.Cursor()
+	var pointers docPointers
+	var hashes [][]byte
+	seen := map[uint32]struct{}{}
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		curr, err := fs.parseInvertedIndexRow(rowID(prop, k), v, -1, hasFrequency)
+		if err != nil {
+			return pointers, errors.Wrap(err, "not null: parse inverted index row")
+		}
+
+		for _, pointer := range curr.docIDs {
+			if _, ok := seen[pointer.id]; ok {
+				continue
+			}
+
+			seen[pointer.id] = struct{}{}
+			pointers.docIDs = append(pointers.docIDs, docPointer{id: pointer.id})
+		}
+		hashes = append(hashes, curr.checksum)
+	}
+	pointers.count = uint32(len(pointers.docIDs))
+
+	newChecksum, err := fs.combineChecksums(hashes)
+	if err != nil {
+		return pointers, errors.Wrap(err, "not null: calculate new checksum")
+	}
+
+	pointers.checksum = newChecksum
+	return pointers, nil
+}
+
 func (fs *Searcher) docPointersNotEqual(prop []byte, b *bolt.Bucket,
 	value []byte, limit int, hasFrequency bool) (docPointers, error) {
 	c := b.Cursor()
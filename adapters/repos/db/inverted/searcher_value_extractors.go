@@ -75,6 +75,27 @@ func (fs Searcher) extractIntValue(in interface{}) ([]byte, error) {
 	return LexicographicallySortableInt64(int64(value))
 }
 
+// accepts a parsed date or its RFC3339 representation and stores it the same
+// way as the analyzer indexes dates
+func (fs Searcher) extractDateValue(in interface{}) ([]byte, error) {
+	value, err := dateValue(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return LexicographicallySortableInt64(value.UnixNano())
+}
+
+// accepts the creation time in milliseconds since the epoch or as a date and
+// stores it the same way as the analyzer indexes the creation time
+func (fs Searcher) extractCreationTimeValue(in interface{}) ([]byte, error) {
+	if millis, ok := in.(int); ok {
+		in = creationTimeFromUnixMillis(int64(millis))
+	}
+
+	return fs.extractDateValue(in)
+}
+
 // assumes an untyped int and stores as string-formatted int64
 func (fs Searcher) extractIntCountValue(in interface{}) ([]byte, error) {
 	value, ok := in.(int)
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// ObjectsByCreationTime returns up to limit objects in the order of their
+// creation time. The keys of the creation time index are lexicographically
+// sortable, so only as many rows are read as are needed to fill the limit. If
+// a filter is set, objects that don't match it are skipped. Expired objects
+// and doc ids which no longer point to an object are skipped as well, before
+// the limit is applied, so that they don't make a page come back short.
+func (f *Searcher) ObjectsByCreationTime(ctx context.Context, limit int,
+	filter *filters.LocalFilter, order filters.SortOrder, meta bool,
+	className schema.ClassName) ([]*storobj.Object, error) {
+	var allowList AllowList
+	if filter != nil {
+		list, err := f.DocIDs(ctx, filter, meta, className)
+		if err != nil {
+			return nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	var out []*storobj.Object
+	if err := f.db.View(func(tx *bolt.Tx) error {
+		id := helpers.BucketFromPropName(filters.InternalPropCreationTimeUnix)
+		b := tx.Bucket(id)
+		if b == nil {
+			return fmt.Errorf("bucket for prop %s not found",
+				filters.InternalPropCreationTimeUnix)
+		}
+
+		c := b.Cursor()
+		first, next := c.First, c.Next
+		if order == filters.SortOrderDesc {
+			first, next = c.Last, c.Prev
+		}
+
+		for k, v := first(); k != nil; k, v = next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			row, err := f.parseInvertedIndexRow(rowID(id, k), v, -1, false)
+			if err != nil {
+				return errors.Wrap(err, "parse inverted index row")
+			}
+
+			for _, pointer := range row.docIDs {
+				if limit > 0 && len(out) >= limit {
+					return nil
+				}
+
+				if allowList != nil && !allowList.Contains(pointer.id) {
+					continue
+				}
+
+				obj, err := objectFromDocIDInTx(tx, pointer.id)
+				if err != nil {
+					return errors.Wrap(err, "resolve doc id to object")
+				}
+
+				if obj == nil || obj.Expired(now) {
+					continue
+				}
+
+				out = append(out, obj)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "object sorted search bolt view tx")
+	}
+
+	return out, nil
+}
+
+// objectFromDocIDInTx resolves a single doc id, it returns nil if the doc id
+// no longer points to an object
+func objectFromDocIDInTx(tx *bolt.Tx, docID uint32) (*storobj.Object, error) {
+	b := tx.Bucket(helpers.IndexIDBucket)
+	if b == nil {
+		return nil, fmt.Errorf("index id bucket not found")
+	}
+
+	// built like the keys in ObjectsFromDocIDsInTx
+	keyBuf := bytes.NewBuffer(make([]byte, 4))
+	binary.Write(keyBuf, binary.LittleEndian, &docID)
+	uuid := b.Get(keyBuf.Bytes())
+	if len(uuid) == 0 {
+		return nil, nil
+	}
+
+	b = tx.Bucket(helpers.ObjectsBucket)
+	if b == nil {
+		return nil, fmt.Errorf("objects bucket not found")
+	}
+
+	data := b.Get(uuid)
+	if data == nil {
+		return nil, nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal data object")
+	}
+
+	return obj, nil
+}
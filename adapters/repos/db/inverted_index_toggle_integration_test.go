@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertiesWithoutInvertedIndex(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	vFalse := false
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "NotIndexedClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:            "notes",
+				DataType:        []string{string(schema.DataTypeText)},
+				IndexSearchable: &vFalse,
+			},
+			&models.Property{
+				Name:          "views",
+				DataType:      []string{string(schema.DataTypeInt)},
+				IndexInverted: &vFalse,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	id := strfmt.UUID("2d8f3e6a-9c1b-4f7d-a5e2-6b3c8d1f4a90")
+	filter := func(prop string, dt schema.DataType,
+		value interface{}) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: schema.PropertyName(prop),
+				},
+				Value: &filters.Value{
+					Value: value,
+					Type:  dt,
+				},
+			},
+		}
+	}
+
+	t.Run("importing a thing", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:    id,
+			Class: class.Class,
+			Schema: map[string]interface{}{
+				"title": "hello",
+				"notes": "a long text nobody filters on",
+				"views": int64(12),
+			},
+		}, []float32{1, 0, 0})
+		require.Nil(t, err)
+	})
+
+	t.Run("the properties are stored", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, "a long text nobody filters on", props["notes"])
+		assert.EqualValues(t, 12, props["views"])
+	})
+
+	t.Run("filtering on an indexed property", func(t *testing.T) {
+		count, err := repo.Count(context.Background(), kind.Thing, class.Class,
+			filter("title", schema.DataTypeString, "hello"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("filtering on properties without inverted index", func(t *testing.T) {
+		for _, f := range []*filters.LocalFilter{
+			filter("notes", schema.DataTypeText, "nobody"),
+			filter("views", schema.DataTypeInt, 12),
+		} {
+			_, err := repo.Count(context.Background(), kind.Thing, class.Class, f)
+			require.NotNil(t, err)
+			assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+
+			_, err = repo.ClassSearch(context.Background(), traverser.GetParams{
+				Kind:       kind.Thing,
+				ClassName:  class.Class,
+				Filters:    f,
+				Pagination: &filters.Pagination{Limit: 10},
+			})
+			require.NotNil(t, err)
+			assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+		}
+	})
+
+	t.Run("deleting the thing", func(t *testing.T) {
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, id))
+
+		count, err := repo.Count(context.Background(), kind.Thing, class.Class,
+			filter("title", schema.DataTypeString, "hello"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
@@ -114,6 +114,7 @@ func Test_MergingObjects(t *testing.T) {
 	target2 := strfmt.UUID("5cc94aba-93e4-408a-ab19-3d803216a04e")
 	target3 := strfmt.UUID("81982705-8b1e-4228-b84c-911818d7ee85")
 	target4 := strfmt.UUID("7f69c263-17f4-4529-a54d-891a7c008ca4")
+	target5 := strfmt.UUID("2a7a8c3e-5c1d-4f0b-9e67-0d2b3c4f5a16")
 	sourceID := strfmt.UUID("8738ddd5-a0ed-408d-a5d6-6f818fd56be6")
 
 	t.Run("add objects", func(t *testing.T) {
@@ -126,7 +127,7 @@ func Test_MergingObjects(t *testing.T) {
 		}, []float32{0.5})
 		require.Nil(t, err)
 
-		targets := []strfmt.UUID{target1, target2, target3, target4}
+		targets := []strfmt.UUID{target1, target2, target3, target4, target5}
 
 		for i, target := range targets {
 			err = repo.PutThing(context.Background(), &models.Thing{
@@ -176,7 +177,7 @@ func Test_MergingObjects(t *testing.T) {
 
 			// from merge
 			"number": 7.0,
-			"int":    float64(9),
+			"int":    int64(9),
 			"geo": &models.GeoCoordinates{
 				Latitude:  ptFloat32(30.2),
 				Longitude: ptFloat32(60.2),
@@ -240,7 +241,7 @@ func Test_MergingObjects(t *testing.T) {
 		expectedSchema := map[string]interface{}{
 			"string": "let's update the string prop",
 			"number": 7.0,
-			"int":    float64(9),
+			"int":    int64(9),
 			"geo": &models.GeoCoordinates{
 				Latitude:  ptFloat32(30.2),
 				Longitude: ptFloat32(60.2),
@@ -301,4 +302,55 @@ func Test_MergingObjects(t *testing.T) {
 
 		assert.ElementsMatch(t, foundBeacons, expectedBeacons)
 	})
+
+	t.Run("append present and new references with unique references", func(t *testing.T) {
+		source, err := crossref.ParseSource(fmt.Sprintf(
+			"weaviate://localhost/things/MergeTestSource/%s/toTarget", sourceID))
+		require.Nil(t, err)
+		// target1 and target2 are already present, target5 is new, but
+		// contained twice
+		targets := []strfmt.UUID{target1, target2, target5, target5}
+		refs := make(kinds.BatchReferences, len(targets), len(targets))
+		for i, target := range targets {
+			to, err := crossref.Parse(fmt.Sprintf("weaviate://localhost/things/%s", target))
+			require.Nil(t, err)
+			refs[i] = kinds.BatchReference{
+				Err:  nil,
+				From: source,
+				To:   to,
+			}
+		}
+		md := kinds.MergeDocument{
+			Class:            "MergeTestSource",
+			ID:               sourceID,
+			Kind:             kind.Thing,
+			References:       refs,
+			UniqueReferences: true,
+		}
+		err = repo.Merge(context.Background(), md)
+		assert.Nil(t, err)
+	})
+
+	t.Run("check every reference is present exactly once", func(t *testing.T) {
+		source, err := repo.ThingByID(context.Background(), sourceID, nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+
+		refs := source.Thing().Schema.(map[string]interface{})["toTarget"]
+		refsSlice, ok := refs.(models.MultipleRef)
+		require.True(t, ok, fmt.Sprintf("toTarget must be models.MultipleRef, but got %#v", refs))
+
+		foundBeacons := []string{}
+		for _, ref := range refsSlice {
+			foundBeacons = append(foundBeacons, ref.Beacon.String())
+		}
+		expectedBeacons := []string{
+			fmt.Sprintf("weaviate://localhost/things/%s", target1),
+			fmt.Sprintf("weaviate://localhost/things/%s", target2),
+			fmt.Sprintf("weaviate://localhost/things/%s", target3),
+			fmt.Sprintf("weaviate://localhost/things/%s", target4),
+			fmt.Sprintf("weaviate://localhost/things/%s", target5),
+		}
+
+		assert.ElementsMatch(t, foundBeacons, expectedBeacons)
+	})
 }
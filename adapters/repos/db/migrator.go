@@ -27,9 +27,14 @@ type Migrator struct {
 
 func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.Class) error {
 	idx, err := NewIndex(IndexConfig{
-		Kind:      kind,
-		ClassName: schema.ClassName(class.Class),
-		RootPath:  m.db.config.RootPath,
+		Kind:                kind,
+		ClassName:           schema.ClassName(class.Class),
+		RootPath:            m.db.config.RootPath,
+		VectorIndexConfig:   class.VectorIndexConfig,
+		SyncPolicy:          m.db.config.SyncPolicy,
+		SyncEveryN:          m.db.config.SyncEveryN,
+		VectorIndexRecovery: m.db.config.VectorIndexRecovery,
+		BoltOptions:         m.db.config.BoltOptions,
 	}, m.db.schemaGetter)
 	if err != nil {
 		return errors.Wrap(err, "create index")
@@ -42,7 +47,9 @@ func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.C
 		}
 	}
 
+	m.db.indexLock.Lock()
 	m.db.indices[idx.ID()] = idx
+	m.db.indexLock.Unlock()
 	return nil
 }
 
@@ -50,8 +57,15 @@ func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className stri
 	return fmt.Errorf("dropping a class not (yet) supported")
 }
 
+// UpdateClass renames the index if a new class name is set, keywords have no
+// effect on the stored data
 func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className string, newClassName *string, newKeywords *models.Keywords) error {
-	return fmt.Errorf("updating a class not (yet) supported")
+	if newClassName == nil {
+		return nil
+	}
+
+	return m.db.renameIndex(kind, schema.ClassName(className),
+		schema.ClassName(*newClassName))
 }
 
 func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
@@ -65,7 +79,13 @@ func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className st
 }
 
 func (m *Migrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propertyName string) error {
-	return fmt.Errorf("dropping a property not (yet) supported")
+	idx := m.db.GetIndex(kind, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot drop property from a non-existing index for %s/%s",
+			kind.Name(), className)
+	}
+
+	return idx.dropProperty(ctx, propertyName)
 }
 
 func (m *Migrator) UpdateProperty(ctx context.Context, kind kind.Kind, className string, propName string, newName *string, newKeywords *models.Keywords) error {
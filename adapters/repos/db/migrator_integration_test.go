@@ -0,0 +1,292 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator_DropProperty(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "DropPropertyTestClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "obsolete",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	thingID := strfmt.UUID("6b4b8e5c-4d3f-4b0a-a5f5-3b1b4bd1d0a1")
+
+	t.Run("adding a thing", func(t *testing.T) {
+		thing := &models.Thing{
+			ID:    thingID,
+			Class: "DropPropertyTestClass",
+			Schema: map[string]interface{}{
+				"name":     "some name",
+				"obsolete": "some obsolete value",
+			},
+		}
+
+		require.Nil(t, repo.PutThing(context.Background(), thing, []float32{1, 2, 3}))
+	})
+
+	t.Run("dropping the property", func(t *testing.T) {
+		// the schema manager removes the property from the schema before
+		// calling the migrator
+		class.Properties = class.Properties[:1]
+
+		require.Nil(t,
+			migrator.DropProperty(context.Background(), kind.Thing, "DropPropertyTestClass", "obsolete"))
+	})
+
+	t.Run("the stored value is no longer returned", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), thingID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		schemaMap := res.Schema.(map[string]interface{})
+		assert.Equal(t, "some name", schemaMap["name"])
+		_, ok := schemaMap["obsolete"]
+		assert.False(t, ok)
+	})
+
+	t.Run("re-adding a property with the same name", func(t *testing.T) {
+		prop := &models.Property{
+			Name:     "obsolete",
+			DataType: []string{string(schema.DataTypeString)},
+		}
+		class.Properties = append(class.Properties, prop)
+
+		require.Nil(t,
+			migrator.AddProperty(context.Background(), kind.Thing, "DropPropertyTestClass", prop))
+
+		res, err := repo.ThingByID(context.Background(), thingID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		_, ok := res.Schema.(map[string]interface{})["obsolete"]
+		assert.False(t, ok, "the old value must not resurface")
+
+		class.Properties = class.Properties[:1]
+	})
+
+	t.Run("dropping the property again is not an error", func(t *testing.T) {
+		assert.Nil(t,
+			migrator.DropProperty(context.Background(), kind.Thing, "DropPropertyTestClass", "obsolete"))
+	})
+
+	t.Run("dropping a property of a non-existing class", func(t *testing.T) {
+		assert.NotNil(t,
+			migrator.DropProperty(context.Background(), kind.Thing, "NotAClass", "obsolete"))
+	})
+}
+
+func TestMigrator_RenameClass(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "RenameTestClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	t.Run("creating the classes", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, &models.Class{Class: "OtherClass"}))
+	})
+
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	thingID := strfmt.UUID("0b9f6a0e-7c4f-4a43-9d3e-4d1b2f0c8a11")
+
+	t.Run("adding a thing", func(t *testing.T) {
+		thing := &models.Thing{
+			ID:    thingID,
+			Class: "RenameTestClass",
+			Schema: map[string]interface{}{
+				"name": "some name",
+			},
+		}
+
+		require.Nil(t, repo.PutThing(context.Background(), thing, []float32{1, 2, 3}))
+	})
+
+	t.Run("renaming to an existing class", func(t *testing.T) {
+		newName := "OtherClass"
+		assert.NotNil(t,
+			migrator.UpdateClass(context.Background(), kind.Thing, "RenameTestClass", &newName, nil))
+	})
+
+	t.Run("renaming the class", func(t *testing.T) {
+		newName := "RenamedTestClass"
+		require.Nil(t,
+			migrator.UpdateClass(context.Background(), kind.Thing, "RenameTestClass", &newName, nil))
+		class.Class = newName
+	})
+
+	t.Run("the shard files have been moved", func(t *testing.T) {
+		_, err := os.Stat(fmt.Sprintf("%s/thing_renamedtestclass_single.db", dirName))
+		assert.Nil(t, err)
+		_, err = os.Stat(fmt.Sprintf("%s/thing_renametestclass_single.db", dirName))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("the stored object has the new class name", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), thingID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		assert.Equal(t, "RenamedTestClass", res.ClassName)
+		assert.Equal(t, "some name", res.Schema.(map[string]interface{})["name"])
+	})
+
+	t.Run("renaming a non-existing class", func(t *testing.T) {
+		newName := "SomethingElse"
+		assert.NotNil(t,
+			migrator.UpdateClass(context.Background(), kind.Thing, "NotAClass", &newName, nil))
+	})
+
+	t.Run("renaming onto files which are left on disk", func(t *testing.T) {
+		leftover := fmt.Sprintf("%s/thing_leftoverclass_single.indexcount", dirName)
+		require.Nil(t, ioutil.WriteFile(leftover, []byte{}, 0666))
+		defer os.Remove(leftover)
+
+		newName := "LeftoverClass"
+		assert.NotNil(t,
+			migrator.UpdateClass(context.Background(), kind.Thing, "OtherClass", &newName, nil))
+		_, err := os.Stat(fmt.Sprintf("%s/thing_otherclass.rename", dirName))
+		assert.True(t, os.IsNotExist(err))
+		assert.NotNil(t, repo.GetIndex(kind.Thing, "OtherClass"))
+	})
+
+	recreatedID := strfmt.UUID("6c3a3c2b-2b6e-4c1e-8f2a-1f6e9b1d2a33")
+
+	t.Run("creating a new class with the previous name", func(t *testing.T) {
+		recreated := &models.Class{
+			Class:      "RenameTestClass",
+			Properties: class.Properties,
+		}
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, recreated))
+		schemaGetter.schema.Things.Classes = append(schemaGetter.schema.Things.Classes,
+			recreated)
+
+		thing := &models.Thing{
+			ID:    recreatedID,
+			Class: "RenameTestClass",
+			Schema: map[string]interface{}{
+				"name": "another name",
+			},
+		}
+		require.Nil(t, repo.PutThing(context.Background(), thing, []float32{1, 2, 3}))
+	})
+
+	t.Run("both classes are intact after a restart", func(t *testing.T) {
+		for _, className := range []schema.ClassName{"RenamedTestClass", "RenameTestClass", "OtherClass"} {
+			shard := repo.GetIndex(kind.Thing, className).Shards["single"]
+			require.Nil(t, shard.vectorIndex.Shutdown())
+			require.Nil(t, shard.db.Close())
+		}
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+
+		res, err := restarted.ThingByID(context.Background(), thingID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "RenamedTestClass", res.ClassName)
+
+		res, err = restarted.ThingByID(context.Background(), recreatedID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "RenameTestClass", res.ClassName)
+		assert.Equal(t, "another name", res.Schema.(map[string]interface{})["name"])
+	})
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxReferences(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	maxReferences := int64(2)
+	targetClass := &models.Class{
+		Class:      "MaxReferencesTarget",
+		Properties: []*models.Property{},
+	}
+	sourceClass := &models.Class{
+		Class: "MaxReferencesSource",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:          "toTarget",
+				DataType:      []string{"MaxReferencesTarget"},
+				MaxReferences: &maxReferences,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, targetClass))
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, sourceClass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{targetClass, sourceClass},
+		},
+	}
+
+	targetRef := func(i int) *models.SingleRef {
+		return &models.SingleRef{
+			Beacon: strfmt.URI(fmt.Sprintf(
+				"weaviate://localhost/things/8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c%02d", i)),
+		}
+	}
+
+	putSource := func(t *testing.T, id strfmt.UUID) {
+		require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+			Class:  "MaxReferencesSource",
+			ID:     id,
+			Schema: map[string]interface{}{},
+		}, []float32{1, 2, 3}))
+	}
+
+	referenceCount := func(t *testing.T, id strfmt.UUID) int {
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		refs, _ := res.Schema.(map[string]interface{})["toTarget"].(models.MultipleRef)
+		return len(refs)
+	}
+
+	t.Run("putting an object above the limit", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			Class: "MaxReferencesSource",
+			ID:    "5b1f0c1e-2a3b-4c5d-8e6f-7a8b9c0d1e01",
+			Schema: map[string]interface{}{
+				"toTarget": models.MultipleRef{targetRef(0), targetRef(1), targetRef(2)},
+			},
+		}, []float32{1, 2, 3})
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+
+		res, err := repo.ThingByID(context.Background(),
+			"5b1f0c1e-2a3b-4c5d-8e6f-7a8b9c0d1e01", nil, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("concurrent references onto the same object", func(t *testing.T) {
+		id := strfmt.UUID("5b1f0c1e-2a3b-4c5d-8e6f-7a8b9c0d1e02")
+		putSource(t, id)
+
+		workers := 8
+		errs := make([]error, workers)
+		wg := &sync.WaitGroup{}
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repo.AddReference(context.Background(), kind.Thing,
+					"MaxReferencesSource", id, "toTarget", targetRef(i))
+			}(i)
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+
+			assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+		}
+		assert.Equal(t, 2, succeeded)
+		assert.Equal(t, 2, referenceCount(t, id))
+	})
+
+	t.Run("a batch above the limit only fails the surplus references", func(t *testing.T) {
+		id := strfmt.UUID("5b1f0c1e-2a3b-4c5d-8e6f-7a8b9c0d1e03")
+		putSource(t, id)
+
+		source, err := crossref.ParseSource(fmt.Sprintf(
+			"weaviate://localhost/things/MaxReferencesSource/%s/toTarget", id))
+		require.Nil(t, err)
+		refs := make(kinds.BatchReferences, 3)
+		for i := range refs {
+			to, err := crossref.Parse(targetRef(i).Beacon.String())
+			require.Nil(t, err)
+			refs[i] = kinds.BatchReference{From: source, To: to, OriginalIndex: i}
+		}
+
+		res, err := repo.AddBatchReferences(context.Background(), refs)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, res[2].Err)
+		assert.Equal(t, 2, referenceCount(t, id))
+	})
+}
@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+var reindexCheckpointKey = []byte("checkpoint")
+
+// reindexMaxAttempts is how often an object is vectorized again if it was
+// updated concurrently, before the reindex gives up
+const reindexMaxAttempts = 5
+
+// ReindexClass replaces the vector of every object of the class with the
+// vector returned by vectorize. The objects are processed page by page and
+// progress is reported after every page. Each shard remembers the last page
+// it completed. If resume is set, ReindexClass continues where an interrupted
+// run stopped, otherwise it discards the progress of previous runs and starts
+// over, as the vectorizer might have changed since.
+func (d *DB) ReindexClass(ctx context.Context, k kind.Kind, className string,
+	resume bool, vectorize func(*search.Result) ([]float32, error),
+	progress func(processed int)) error {
+	idx := d.GetIndex(k, schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("reindex non-existing index for %s/%s", k, className)
+	}
+
+	for _, shard := range idx.Shards {
+		if err := shard.reindex(ctx, resume, vectorize, progress); err != nil {
+			return errors.Wrapf(err, "reindex shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) reindex(ctx context.Context, resume bool,
+	vectorize func(*search.Result) ([]float32, error),
+	progress func(processed int)) error {
+	var after []byte
+	if resume {
+		checkpoint, err := s.reindexCheckpoint()
+		if err != nil {
+			return err
+		}
+		after = checkpoint
+	} else if err := s.setReindexCheckpoint(nil); err != nil {
+		return err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, last, err := s.objectListPage(after, streamPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range page {
+			if err := s.reindexObject(ctx, obj, vectorize); err != nil {
+				return err
+			}
+		}
+
+		progress(len(page))
+
+		if len(page) < streamPageSize {
+			// reached the end of the bucket, a future reindex must start over
+			return s.setReindexCheckpoint(nil)
+		}
+
+		if err := s.setReindexCheckpoint(last); err != nil {
+			return err
+		}
+		after = last
+	}
+}
+
+// reindexObject stores the object with a new vector. The write is based on
+// the version of the object as it was read, so that a concurrent update is
+// never overwritten. Instead, the updated object is read and vectorized
+// again. An object which was deleted in the meantime is skipped.
+func (s *Shard) reindexObject(ctx context.Context, obj *storobj.Object,
+	vectorize func(*search.Result) ([]float32, error)) error {
+	for attempt := 1; ; attempt++ {
+		vector, err := vectorize(reindexInput(obj))
+		if err != nil {
+			return errors.Wrapf(err, "vectorize object %s", obj.ID())
+		}
+
+		obj.Vector = vector
+		err = s.putObject(ctx, obj, false)
+		if err == nil {
+			return nil
+		}
+
+		if _, ok := errors.Cause(err).(kinds.ErrVersionConflict); !ok ||
+			attempt == reindexMaxAttempts {
+			return errors.Wrapf(err, "store object %s", obj.ID())
+		}
+
+		obj, err = s.objectByID(ctx, obj.ID(), nil, true)
+		if err != nil {
+			return errors.Wrapf(err, "read updated object")
+		}
+
+		if obj == nil {
+			return nil
+		}
+	}
+}
+
+// reindexInput turns the object into a search result without sharing the
+// schema map, as the caller may modify the result, but the object is stored
+// again afterwards
+func reindexInput(obj *storobj.Object) *search.Result {
+	props := map[string]interface{}{}
+	if schemaMap, ok := obj.Schema().(map[string]interface{}); ok {
+		for key, value := range schemaMap {
+			props[key] = value
+		}
+	}
+
+	return &search.Result{
+		Kind:          obj.Kind,
+		ID:            obj.ID(),
+		ClassName:     obj.Class().String(),
+		Schema:        props,
+		VectorWeights: reindexVectorWeights(obj.VectorWeights()),
+		Created:       obj.CreationTimeUnix(),
+		Updated:       obj.LastUpdateTimeUnix(),
+	}
+}
+
+// vector weights are stored as a non-typed interface{}, which is a
+// map[string]interface{} after unmarshalling
+func reindexVectorWeights(in interface{}) map[string]string {
+	switch weights := in.(type) {
+	case map[string]string:
+		return weights
+	case map[string]interface{}:
+		out := map[string]string{}
+		for key, value := range weights {
+			if asString, ok := value.(string); ok {
+				out[key] = asString
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// reindexCheckpoint is the key of the last object of the last completed page
+// of an interrupted reindex, nil if there is nothing to resume
+func (s *Shard) reindexCheckpoint() ([]byte, error) {
+	db, release := s.readDB()
+	defer release()
+
+	var checkpoint []byte
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.ReindexBucket)
+		if b == nil {
+			return nil
+		}
+
+		if v := b.Get(reindexCheckpointKey); v != nil {
+			// values are only valid for the lifetime of the transaction
+			checkpoint = append([]byte{}, v...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "read reindex checkpoint")
+	}
+
+	return checkpoint, nil
+}
+
+func (s *Shard) setReindexCheckpoint(key []byte) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(helpers.ReindexBucket)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return b.Delete(reindexCheckpointKey)
+		}
+
+		return b.Put(reindexCheckpointKey, key)
+	})
+	if err != nil {
+		return errors.Wrap(err, "store reindex checkpoint")
+	}
+
+	return s.afterWrite()
+}
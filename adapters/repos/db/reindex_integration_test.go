@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindexClass(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "ReindexClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	// more than two pages, so an interrupted reindex has a checkpoint to
+	// resume from
+	total := 2*streamPageSize + 50
+	ids := make([]strfmt.UUID, total)
+
+	t.Run("importing things", func(t *testing.T) {
+		for i := range ids {
+			ids[i] = strfmt.UUID(fmt.Sprintf("1c0e5d3a-6f2b-4c8e-9a7d-%012d", i))
+			err := repo.PutThing(context.Background(), &models.Thing{
+				ID:     ids[i],
+				Class:  class.Class,
+				Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+			}, []float32{1, 0, 0})
+			require.Nil(t, err)
+		}
+	})
+
+	newVector := func(res *search.Result) ([]float32, error) {
+		return []float32{0, 1, 0}, nil
+	}
+
+	t.Run("reindexing a class that doesn't exist", func(t *testing.T) {
+		err := repo.ReindexClass(context.Background(), kind.Thing, "NotThere",
+			false, newVector, func(int) {})
+		assert.NotNil(t, err)
+	})
+
+	interruptReindex := func(t *testing.T) {
+		vectorized := 0
+		failingVectorizer := func(res *search.Result) ([]float32, error) {
+			if vectorized == streamPageSize+10 {
+				return nil, errors.New("c11y went away")
+			}
+			vectorized++
+			return newVector(res)
+		}
+
+		processed := 0
+		err := repo.ReindexClass(context.Background(), kind.Thing, class.Class,
+			false, failingVectorizer, func(n int) { processed += n })
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "c11y went away")
+		assert.Equal(t, streamPageSize, processed, "only the first page is complete")
+	}
+
+	t.Run("an interrupted reindex", func(t *testing.T) {
+		interruptReindex(t)
+	})
+
+	t.Run("resuming the reindex", func(t *testing.T) {
+		processed := 0
+		err := repo.ReindexClass(context.Background(), kind.Thing, class.Class,
+			true, newVector, func(n int) { processed += n })
+		require.Nil(t, err)
+		assert.Equal(t, total-streamPageSize, processed,
+			"starts after the last completed page")
+	})
+
+	t.Run("a reindex which is not resumed starts over", func(t *testing.T) {
+		interruptReindex(t)
+
+		processed := 0
+		err := repo.ReindexClass(context.Background(), kind.Thing, class.Class,
+			false, newVector, func(n int) { processed += n })
+		require.Nil(t, err)
+		assert.Equal(t, total, processed)
+	})
+
+	t.Run("all objects have the new vector", func(t *testing.T) {
+		for _, id := range ids {
+			res, err := repo.ThingByID(context.Background(), id, nil,
+				traverser.UnderscoreProperties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, []float32{0, 1, 0}, res.Vector)
+			assert.Equal(t, class.Class, res.ClassName)
+		}
+
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			SearchVector: []float32{0, 1, 0},
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 10)
+		for _, obj := range res {
+			assert.Equal(t, []float32{0, 1, 0}, obj.Vector,
+				"no stale entries of the previous vectors")
+		}
+	})
+
+	t.Run("a completed reindex starts over the next time", func(t *testing.T) {
+		processed := 0
+		err := repo.ReindexClass(context.Background(), kind.Thing, class.Class,
+			true, newVector, func(n int) { processed += n })
+		require.Nil(t, err)
+		assert.Equal(t, total, processed)
+	})
+
+	t.Run("an object updated during the reindex", func(t *testing.T) {
+		id := ids[0]
+		before, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+
+		updated := false
+		updatingVectorizer := func(res *search.Result) ([]float32, error) {
+			if res.ID == id && !updated {
+				updated = true
+				err := repo.PutThing(context.Background(), &models.Thing{
+					ID:     id,
+					Class:  class.Class,
+					Schema: map[string]interface{}{"name": "updated concurrently"},
+				}, []float32{1, 0, 0})
+				require.Nil(t, err)
+			}
+
+			return []float32{0, 0, 1}, nil
+		}
+
+		err = repo.ReindexClass(context.Background(), kind.Thing, class.Class,
+			false, updatingVectorizer, func(int) {})
+		require.Nil(t, err)
+
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, "updated concurrently",
+			res.Schema.(map[string]interface{})["name"], "the update is not lost")
+		assert.Equal(t, []float32{0, 0, 1}, res.Vector)
+		assert.Equal(t, before.Version+2, res.Version,
+			"one version for the update and one for the new vector")
+	})
+}
@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// shardFileSuffixes are the suffixes of all files which make up a shard, they
+// are prefixed with the shard id
+var shardFileSuffixes = []string{".db", ".indexcount", ".hnsw.commitlog"}
+
+// renameMarker records that an index is being renamed. It is written before
+// any file is touched and only removed on the next startup, once the files
+// are known to match the class name in the schema. This way a rename which
+// was interrupted at any point can be completed (or rolled back, if the
+// schema was never updated) on startup.
+type renameMarker struct {
+	Kind   kind.Kind        `json:"kind"`
+	From   schema.ClassName `json:"from"`
+	To     schema.ClassName `json:"to"`
+	Shards []string         `json:"shards"`
+}
+
+const renameMarkerSuffix = ".rename"
+
+func (r renameMarker) path(rootPath string) string {
+	return fmt.Sprintf("%s/%s%s", rootPath, indexID(r.Kind, r.From), renameMarkerSuffix)
+}
+
+func (r renameMarker) write(rootPath string) error {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path(rootPath), bytes, 0666)
+}
+
+func (d *DB) renameIndex(k kind.Kind, from, to schema.ClassName) error {
+	idx := d.GetIndex(k, from)
+	if idx == nil {
+		return fmt.Errorf("cannot rename non-existing index for %s/%s", k.Name(), from)
+	}
+
+	if d.GetIndex(k, to) != nil {
+		return fmt.Errorf("cannot rename index for %s/%s, target %s already exists",
+			k.Name(), from, to)
+	}
+
+	marker := renameMarker{Kind: k, From: from, To: to}
+	for name := range idx.Shards {
+		marker.Shards = append(marker.Shards, name)
+	}
+
+	// files which are left over from a class with the new name would make the
+	// rename fail halfway, it has to fail before the schema is changed
+	for _, shard := range marker.Shards {
+		toID := fmt.Sprintf("%s_%s", indexID(k, to), shard)
+		if err := checkNoShardFiles(d.config.RootPath, toID); err != nil {
+			return errors.Wrapf(err, "cannot rename index for %s/%s to %s",
+				k.Name(), from, to)
+		}
+	}
+
+	if err := marker.write(d.config.RootPath); err != nil {
+		return errors.Wrapf(err, "write rename marker for index %s", idx.ID())
+	}
+
+	oldID := idx.ID()
+	if err := idx.rename(to); err != nil {
+		return errors.Wrapf(err, "rename index %s", oldID)
+	}
+
+	d.indexLock.Lock()
+	delete(d.indices, oldID)
+	d.indices[idx.ID()] = idx
+	d.indexLock.Unlock()
+	return nil
+}
+
+// rename rewrites the class name of all stored objects and then moves the
+// shard files. Open file handles remain valid, so the shards don't need to be
+// reopened. Writes and compactions of the shards wait until the rename is
+// done.
+func (i *Index) rename(to schema.ClassName) error {
+	for _, shard := range i.Shards {
+		shard.writeLock.Lock()
+		defer shard.writeLock.Unlock()
+	}
+
+	for _, shard := range i.Shards {
+		if err := rewriteClassName(shard.db, to); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	oldIDs := map[string]string{}
+	for name, shard := range i.Shards {
+		oldIDs[name] = shard.ID()
+	}
+
+	i.Config.ClassName = to
+	for name, shard := range i.Shards {
+		if err := renameShardFiles(i.Config.RootPath, oldIDs[name], shard.ID()); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// renameShardFiles is idempotent, files which have already been moved are
+// skipped. It never overwrites an existing file: if the target exists, the
+// file has been moved before and a file at the source belongs to a class
+// which was created with the previous name since, it is left alone.
+func renameShardFiles(rootPath, fromID, toID string) error {
+	for _, suffix := range shardFileSuffixes {
+		src := fmt.Sprintf("%s/%s%s", rootPath, fromID, suffix)
+		dst := fmt.Sprintf("%s/%s%s", rootPath, toID, suffix)
+
+		if _, err := os.Stat(src); os.IsNotExist(err) {
+			continue
+		}
+
+		if _, err := os.Stat(dst); err == nil {
+			continue
+		}
+
+		if err := os.Rename(src, dst); err != nil {
+			return errors.Wrapf(err, "move %s to %s", src, dst)
+		}
+	}
+
+	return nil
+}
+
+func checkNoShardFiles(rootPath, shardID string) error {
+	for _, suffix := range shardFileSuffixes {
+		path := fmt.Sprintf("%s/%s%s", rootPath, shardID, suffix)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("file %s already exists", path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rewriteClassName(db *bolt.DB, to schema.ClassName) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(helpers.ObjectsBucket)
+		if b == nil {
+			return nil
+		}
+
+		updates := map[string][]byte{}
+		err := b.ForEach(func(k, v []byte) error {
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				return errors.Wrapf(err, "unmarshal object")
+			}
+
+			if obj.Class() == to {
+				return nil
+			}
+
+			obj.SetClass(to.String())
+			data, err := obj.MarshalBinary()
+			if err != nil {
+				return errors.Wrapf(err, "marshal object")
+			}
+
+			updates[string(k)] = data
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// a bucket must not be modified while iterating over it
+		for k, v := range updates {
+			if err := b.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// completePendingRenames makes sure the files of every index which was being
+// renamed match the class name in the current schema. It must run before any
+// index is opened.
+func (d *DB) completePendingRenames() error {
+	markers, err := filepath.Glob(fmt.Sprintf("%s/*%s", d.config.RootPath, renameMarkerSuffix))
+	if err != nil {
+		return err
+	}
+
+	sch := d.schemaGetter.GetSchemaSkipAuth()
+	for _, path := range markers {
+		bytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "read rename marker %s", path)
+		}
+
+		var marker renameMarker
+		if err := json.Unmarshal(bytes, &marker); err != nil {
+			return errors.Wrapf(err, "parse rename marker %s", path)
+		}
+
+		from, to := marker.From, marker.To
+		if sch.GetClass(marker.Kind, to) == nil {
+			if sch.GetClass(marker.Kind, from) == nil {
+				// neither name is in use anymore, nothing to restore
+				if err := os.Remove(path); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// the schema was never updated, roll back
+			from, to = to, from
+		}
+
+		d.logger.WithField("action", "startup_complete_index_rename").
+			WithField("kind", marker.Kind).
+			WithField("from", from).
+			WithField("to", to).
+			Info("completing interrupted rename of index")
+
+		for _, shard := range marker.Shards {
+			fromID := fmt.Sprintf("%s_%s", indexID(marker.Kind, from), shard)
+			toID := fmt.Sprintf("%s_%s", indexID(marker.Kind, to), shard)
+			if err := renameShardFiles(d.config.RootPath, fromID, toID); err != nil {
+				return errors.Wrapf(err, "complete rename of %s", path)
+			}
+
+			if err := rewriteClassNameInFile(fmt.Sprintf("%s/%s.db", d.config.RootPath, toID),
+				to); err != nil {
+				return errors.Wrapf(err, "complete rename of %s", path)
+			}
+		}
+
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rewriteClassNameInFile(path string, to schema.ClassName) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", path)
+	}
+	defer db.Close()
+
+	return rewriteClassName(db, to)
+}
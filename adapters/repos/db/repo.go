@@ -12,8 +12,12 @@
 package db
 
 import (
+	"context"
+	"os"
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
@@ -25,35 +29,91 @@ type DB struct {
 	schemaGetter schemaUC.SchemaGetter
 	config       Config
 	indices      map[string]*Index
+
+	// indexLock guards indices only for callers which don't hold the
+	// connector lock, such as background operations or the stats and admin
+	// endpoints. Those callers must take it, which is why all reads in this
+	// package take it, most of them through GetIndex or indexMap
+	indexLock *sync.RWMutex
+
+	startupProgressInterval time.Duration
 }
 
 func (d *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	d.schemaGetter = sg
 }
 
-func (d *DB) WaitForStartup(time.Duration) error {
-	return d.init()
+// Ping makes sure the data path is still accessible. As the db runs in the
+// same process, there is no remote connection to check.
+func (d *DB) Ping(ctx context.Context) error {
+	if _, err := os.Stat(d.config.RootPath); err != nil {
+		return errors.Wrap(err, "stat data path")
+	}
+
+	return nil
 }
 
 func New(logger logrus.FieldLogger, config Config) *DB {
 	return &DB{
-		logger:  logger,
-		config:  config,
-		indices: map[string]*Index{},
+		logger:    logger,
+		config:    config,
+		indices:   map[string]*Index{},
+		indexLock: &sync.RWMutex{},
+
+		startupProgressInterval: 5 * time.Second,
 	}
 }
 
 type Config struct {
 	RootPath string
+
+	// SyncPolicy controls when shard files are fsynced, an empty policy
+	// behaves like SyncAlways. SyncEveryN is only used with the SyncEveryN
+	// policy. See SyncPolicy for what a crash can lose with each mode.
+	SyncPolicy SyncPolicy
+	SyncEveryN int
+
+	// VectorIndexRecovery controls whether the vector indexes are rebuilt from
+	// the stored objects on startup, an empty mode behaves like RecoveryOff
+	VectorIndexRecovery VectorIndexRecovery
+
+	// CompactionBytesPerSecond limits how fast data is copied during a
+	// compaction, so that it does not take all IO from live traffic. 0 means
+	// unlimited.
+	CompactionBytesPerSecond int
+
+	// BoltOptions are used to open the bolt file of every shard, see
+	// BoltOptions for which settings help bulk imports
+	BoltOptions BoltOptions
+
+	// DuplicateIDPolicy controls how a batch is imported which contains the
+	// same id more than once, an empty policy behaves like DuplicatesMark
+	DuplicateIDPolicy DuplicateIDPolicy
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
 func (d *DB) GetIndex(kind kind.Kind, className schema.ClassName) *Index {
 	id := indexID(kind, className)
+	d.indexLock.RLock()
 	index, ok := d.indices[id]
+	d.indexLock.RUnlock()
 	if !ok {
 		return nil
 	}
 
 	return index
 }
+
+// indexMap returns a copy of the indices, so they can be iterated without
+// holding the indexLock while each of them is queried
+func (d *DB) indexMap() map[string]*Index {
+	d.indexLock.RLock()
+	defer d.indexLock.RUnlock()
+
+	out := make(map[string]*Index, len(d.indices))
+	for id, index := range d.indices {
+		out[id] = index
+	}
+
+	return out
+}
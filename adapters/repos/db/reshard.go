@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// ReshardClass is not supported by the standalone repo, every index consists
+// of a single local shard
+func (d *DB) ReshardClass(ctx context.Context, k kind.Kind, className string,
+	numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	return fmt.Errorf("resharding %s/%s: not supported by the standalone repo", k, className)
+}
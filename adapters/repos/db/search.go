@@ -24,6 +24,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -33,6 +34,12 @@ func (db *DB) Aggregate(ctx context.Context,
 		"see %s for details", notimplemented.Link)
 }
 
+func (db *DB) Facet(ctx context.Context,
+	params traverser.FacetParams) (*aggregation.Facet, error) {
+	return nil, fmt.Errorf("facets not supported yet in standalone mode, "+
+		"see %s for details", notimplemented.Link)
+}
+
 func (db *DB) ClassSearch(ctx context.Context,
 	params traverser.GetParams) ([]search.Result, error) {
 	idx := db.GetIndex(params.Kind, schema.ClassName(params.ClassName))
@@ -45,8 +52,18 @@ func (db *DB) ClassSearch(ctx context.Context,
 		return nil, fmt.Errorf("invalid params, pagination object is nil")
 	}
 
-	res, err := idx.objectSearch(ctx, params.Pagination.Limit, params.Filters, false)
+	var res []*storobj.Object
+	var err error
+	if params.Sort != nil {
+		res, err = idx.objectSortedSearch(ctx, params.Pagination.Limit,
+			params.Filters, params.Sort, false)
+	} else {
+		res, err = idx.objectSearch(ctx, params.Pagination.Limit, params.Filters, false)
+	}
 	if err != nil {
+		if violation, ok := errors.Cause(err).(kinds.ErrInvalidUserInput); ok {
+			return nil, violation
+		}
 		return nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
 
@@ -65,24 +82,43 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 			params.Kind, params.ClassName)
 	}
 
+	var ef int
+	if params.Explore != nil {
+		ef = params.Explore.EF
+	}
+
 	res, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		params.Pagination.Limit, params.Filters, false)
+		params.Pagination.Limit, ef, params.Filters, false)
 	if err != nil {
+		if violation, ok := errors.Cause(err).(kinds.ErrInvalidUserInput); ok {
+			return nil, violation
+		}
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
 
 	return db.enrichRefsForList(ctx, storobj.SearchResults(res), params.Properties,
 		params.UnderscoreProperties.RefMeta)
 }
+
+// VectorSearch searches the indices of all classes, or only those of the
+// given classes if any are set
 func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
-	filters *filters.LocalFilter) ([]search.Result, error) {
+	filters *filters.LocalFilter, classNames []string) ([]search.Result, error) {
 	var found search.Results
 
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range db.indices {
+	for _, index := range db.indexMap() {
+		if len(classNames) > 0 && !containsClass(classNames, index.Config.ClassName) {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		// TODO support all underscore props
-		res, err := index.objectVectorSearch(ctx, vector, limit, filters, false)
+		res, err := index.objectVectorSearch(ctx, vector, limit, 0, filters, false)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -126,11 +162,15 @@ func (d *DB) objectSearch(ctx context.Context, kind kind.Kind, limit int,
 
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
-	for _, index := range d.indices {
+	for _, index := range d.indexMap() {
 		if index.Config.Kind != kind {
 			continue
 		}
 
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		// TODO support all underscore props
 		res, err := index.objectSearch(ctx, limit, filters, underscore.Classification)
 		if err != nil {
@@ -163,3 +203,13 @@ func (d *DB) enrichRefsForList(ctx context.Context, objs search.Results,
 
 	return res, nil
 }
+
+func containsClass(classNames []string, needle schema.ClassName) bool {
+	for _, className := range classNames {
+		if schema.ClassName(className) == needle {
+			return true
+		}
+	}
+
+	return false
+}
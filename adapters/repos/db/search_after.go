@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// ObjectsAfter returns up to limit objects of the class whose id follows
+// after, ordered by id. The objects are read straight from the objects bucket
+// without involving the vector index. Every call uses its own read
+// transaction, so objects written behind the cursor in the meantime are
+// never repeated, while new objects ahead of it are picked up by later pages.
+//
+// If the page is full, the id of the last object read is returned as the
+// cursor for the next page. An empty cursor means the end of the class was
+// reached.
+func (db *DB) ObjectsAfter(ctx context.Context, k kind.Kind, className string,
+	after strfmt.UUID, limit int) ([]search.Result, strfmt.UUID, error) {
+	idx := db.GetIndex(k, schema.ClassName(className))
+	if idx == nil {
+		return nil, "", fmt.Errorf("tried to browse non-existing index for %s/%s",
+			k, className)
+	}
+
+	var afterBytes []byte
+	if after != "" {
+		parsed, err := uuid.Parse(after.String())
+		if err != nil {
+			return nil, "", kinds.NewErrInvalidUserInput("invalid cursor %q: %v", after, err)
+		}
+
+		afterBytes, err = parsed.MarshalBinary()
+		if err != nil {
+			return nil, "", errors.Wrap(err, "marshal cursor")
+		}
+	}
+
+	res, next, err := idx.objectsAfter(ctx, afterBytes, limit)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "objects after at index %s", idx.ID())
+	}
+
+	return storobj.SearchResults(res), next, nil
+}
+
+// objectsAfter reads a page from every shard and merges them. Each shard
+// holds its objects in id order, so the first limit objects of the index are
+// always part of the first limit objects of their shard.
+func (i *Index) objectsAfter(ctx context.Context, after []byte,
+	limit int) ([]*storobj.Object, strfmt.UUID, error) {
+	var pages []keyedObject
+	for _, shard := range i.Shards {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+
+		page, keys, err := shard.objectsAfter(after, limit)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		for pos := range page {
+			pages = append(pages, keyedObject{key: keys[pos], object: page[pos]})
+		}
+	}
+
+	sort.Slice(pages, func(a, b int) bool {
+		return bytes.Compare(pages[a].key, pages[b].key) < 0
+	})
+
+	var next strfmt.UUID
+	if limit > 0 && len(pages) >= limit {
+		pages = pages[:limit]
+		last, err := uuid.FromBytes(pages[limit-1].key)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "parse key of last object")
+		}
+		next = strfmt.UUID(last.String())
+	}
+
+	res := make([]*storobj.Object, len(pages))
+	for pos := range pages {
+		res[pos] = pages[pos].object
+	}
+
+	// expired objects are only removed after the cursor was determined, so a
+	// page can be smaller than the limit without being the last one
+	res = withoutExpired(res)
+	i.removeDroppedProperties(res...)
+	return res, next, nil
+}
+
+type keyedObject struct {
+	key    []byte
+	object *storobj.Object
+}
+
+// objectsAfter returns up to limit objects of the shard following after
+// together with their keys
+func (s *Shard) objectsAfter(after []byte,
+	limit int) ([]*storobj.Object, [][]byte, error) {
+	page, _, err := s.objectListPage(after, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([][]byte, len(page))
+	for pos, obj := range page {
+		parsed, err := uuid.Parse(obj.ID().String())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "parse id of object")
+		}
+
+		keys[pos], err = parsed.MarshalBinary()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "marshal id of object")
+		}
+	}
+
+	return page, keys, nil
+}
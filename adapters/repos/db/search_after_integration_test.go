@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectsAfter(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "CursorThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f%06d", i))
+	}
+
+	put := func(t *testing.T, i int) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     id(i),
+			Class:  thingclass.Class,
+			Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+		}, []float32{1, 2, float32(i)})
+		require.Nil(t, err)
+	}
+
+	// import in reverse order to make sure the order is not the order of
+	// insertion
+	t.Run("importing things", func(t *testing.T) {
+		for i := 49; i >= 0; i-- {
+			put(t, 2*i)
+		}
+	})
+
+	t.Run("iterating over all things while writing", func(t *testing.T) {
+		var seen []strfmt.UUID
+		cursor := strfmt.UUID("00000000-0000-0000-0000-000000000000")
+		for page := 0; ; page++ {
+			res, next, err := repo.ObjectsAfter(context.Background(), kind.Thing,
+				thingclass.Class, cursor, 15)
+			require.Nil(t, err)
+
+			for _, obj := range res {
+				seen = append(seen, obj.ID)
+			}
+
+			if page == 1 {
+				// one object behind and one ahead of the cursor
+				put(t, 1)
+				put(t, 99)
+			}
+
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		require.Len(t, seen, 51)
+		for i := 0; i < 50; i++ {
+			assert.Equal(t, id(2*i), seen[i])
+		}
+		assert.Equal(t, id(99), seen[50])
+	})
+
+	t.Run("with a full last page", func(t *testing.T) {
+		res, next, err := repo.ObjectsAfter(context.Background(), kind.Thing,
+			thingclass.Class, id(96), 2)
+		require.Nil(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, id(99), next)
+
+		res, next, err = repo.ObjectsAfter(context.Background(), kind.Thing,
+			thingclass.Class, next, 2)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+		assert.Equal(t, strfmt.UUID(""), next)
+	})
+	t.Run("with a malformed cursor", func(t *testing.T) {
+		_, _, err := repo.ObjectsAfter(context.Background(), kind.Thing,
+			thingclass.Class, "not-a-uuid", 2)
+		require.NotNil(t, err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+	})
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchWithCanceledContext(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "CanceledSearchClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(30*time.Second))
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, repo.PutThing(context.Background(), &models.Thing{
+			ID:     strfmt.UUID(fmt.Sprintf("5b9f6c2e-1d4a-4c8e-9b3f-7a2d1e0c%04d", i)),
+			Class:  class.Class,
+			Schema: map[string]interface{}{"name": "canceled"},
+		}, []float32{1, 2, float32(i)}))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("listing", func(t *testing.T) {
+		_, err := repo.ClassSearch(ctx, traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		assert.Equal(t, context.Canceled, errors.Cause(err))
+	})
+
+	t.Run("filtering", func(t *testing.T) {
+		_, err := repo.ClassSearch(ctx, traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: buildFilter("name", "canceled", filters.OperatorEqual,
+				schema.DataTypeString),
+		})
+		assert.Equal(t, context.Canceled, errors.Cause(err))
+	})
+
+	t.Run("sorting", func(t *testing.T) {
+		_, err := repo.ClassSearch(ctx, traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Sort:       &filters.Sort{Order: filters.SortOrderAsc},
+		})
+		assert.Equal(t, context.Canceled, errors.Cause(err))
+	})
+
+	t.Run("vector search", func(t *testing.T) {
+		_, err := repo.VectorSearch(ctx, []float32{1, 2, 3}, 10, nil, nil)
+		assert.Equal(t, context.Canceled, errors.Cause(err))
+	})
+}
@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// streamPageSize is the number of objects read in a single bolt transaction
+// while streaming. Small pages keep the memory usage flat and make sure we
+// never hold a read transaction while the consumer writes to a client.
+const streamPageSize = 100
+
+// StreamSearch calls fn for every object matching the params as soon as it is
+// read, rather than collecting all results first. If the params contain no
+// class name, all classes of the kind are searched. Returning an error from
+// fn stops the stream.
+func (db *DB) StreamSearch(ctx context.Context, params traverser.GetParams,
+	fn func(search.Result) error) error {
+	if params.Pagination == nil {
+		return fmt.Errorf("invalid params, pagination object is nil")
+	}
+
+	var indices []*Index
+	if params.ClassName != "" {
+		idx := db.GetIndex(params.Kind, schema.ClassName(params.ClassName))
+		if idx == nil {
+			return fmt.Errorf("tried to browse non-existing index for %s/%s",
+				params.Kind, params.ClassName)
+		}
+
+		indices = append(indices, idx)
+	} else {
+		for _, idx := range db.indexMap() {
+			if idx.Config.Kind == params.Kind {
+				indices = append(indices, idx)
+			}
+		}
+	}
+
+	limit := params.Pagination.Limit
+	sent := 0
+	for _, idx := range indices {
+		if sent >= limit {
+			break
+		}
+
+		err := idx.objectStream(ctx, limit-sent, params.Filters,
+			params.UnderscoreProperties.Classification, func(obj *storobj.Object) error {
+				sent++
+				return fn(*obj.SearchResult())
+			})
+		if err != nil {
+			return errors.Wrapf(err, "stream index %s", idx.ID())
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) objectStream(ctx context.Context, limit int,
+	filters *filters.LocalFilter, meta bool, fn func(*storobj.Object) error) error {
+	// TODO: search across all shards, rather than hard-coded "single" shard
+	shard := i.Shards["single"]
+	now := nowMillis()
+	err := shard.objectStream(ctx, limit, filters, meta, func(obj *storobj.Object) error {
+		if obj.Expired(now) {
+			return nil
+		}
+
+		i.removeDroppedProperties(obj)
+		return fn(obj)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return nil
+}
+
+func (s *Shard) objectStream(ctx context.Context, limit int,
+	filters *filters.LocalFilter, meta bool, fn func(*storobj.Object) error) error {
+	if filters == nil {
+		return s.objectListStream(ctx, limit, fn)
+	}
+
+	return s.objectFilterStream(ctx, limit, filters, meta, fn)
+}
+
+func (s *Shard) objectListStream(ctx context.Context, limit int,
+	fn func(*storobj.Object) error) error {
+	var after []byte
+	sent := 0
+	for sent < limit {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		size := streamPageSize
+		if limit-sent < size {
+			size = limit - sent
+		}
+
+		page, last, err := s.objectListPage(after, size)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range page {
+			if err := fn(obj); err != nil {
+				return err
+			}
+			sent++
+		}
+
+		if len(page) < size {
+			// reached the end of the bucket
+			return nil
+		}
+
+		after = last
+	}
+
+	return nil
+}
+
+// objectListPage reads up to size objects which follow the key after. If
+// after is nil the page starts at the first object. The key of the last
+// object is returned, so it can be used to read the next page.
+func (s *Shard) objectListPage(after []byte,
+	size int) ([]*storobj.Object, []byte, error) {
+	db, release := s.readDB()
+	defer release()
+
+	out := make([]*storobj.Object, 0, size)
+	var last []byte
+	err := db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(helpers.ObjectsBucket).Cursor()
+
+		var k, v []byte
+		if after == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(after)
+			if k != nil && bytes.Equal(k, after) {
+				k, v = cursor.Next()
+			}
+		}
+
+		for ; k != nil && len(out) < size; k, v = cursor.Next() {
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				return errors.Wrapf(err, "unmarshal item %d", len(out))
+			}
+
+			out = append(out, obj)
+			// keys are only valid for the lifetime of the transaction
+			last = append([]byte{}, k...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "bolt view tx")
+	}
+
+	return out, last, nil
+}
+
+func (s *Shard) objectFilterStream(ctx context.Context, limit int,
+	filters *filters.LocalFilter, meta bool, fn func(*storobj.Object) error) error {
+	db, release := s.readDB()
+	defer release()
+
+	allowList, err := inverted.NewSearcher(
+		db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
+		DocIDs(ctx, filters, meta, s.index.Config.ClassName)
+	if err != nil {
+		return errors.Wrap(err, "build inverted filter allow list")
+	}
+
+	pointers := make([]uint32, 0, len(allowList))
+	for id := range allowList {
+		pointers = append(pointers, id)
+	}
+	sort.Slice(pointers, func(a, b int) bool { return pointers[a] < pointers[b] })
+	if len(pointers) > limit {
+		pointers = pointers[:limit]
+	}
+
+	for start := 0; start < len(pointers); start += streamPageSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := start + streamPageSize
+		if end > len(pointers) {
+			end = len(pointers)
+		}
+
+		var page []*storobj.Object
+		if err := db.View(func(tx *bolt.Tx) error {
+			res, err := inverted.ObjectsFromDocIDsInTx(tx, pointers[start:end])
+			if err != nil {
+				return errors.Wrap(err, "resolve doc ids to objects")
+			}
+
+			page = res
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "bolt view tx")
+		}
+
+		for _, obj := range page {
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
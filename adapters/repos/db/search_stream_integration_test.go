@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSearch(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "StreamThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "parity",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	// more than two pages to make sure paging does not skip or repeat objects
+	total := 2*streamPageSize + 50
+
+	t.Run("importing things", func(t *testing.T) {
+		for i := 0; i < total; i++ {
+			parity := "odd"
+			if i%2 == 0 {
+				parity = "even"
+			}
+
+			err := repo.PutThing(context.Background(), &models.Thing{
+				ID:     strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f%06d", i)),
+				Class:  thingclass.Class,
+				Schema: map[string]interface{}{"parity": parity},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+	})
+
+	stream := func(t *testing.T, className string, limit int,
+		where *filters.LocalFilter) map[strfmt.UUID]struct{} {
+		seen := map[strfmt.UUID]struct{}{}
+		err := repo.StreamSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  className,
+			Filters:    where,
+			Pagination: &filters.Pagination{Limit: limit},
+		}, func(res search.Result) error {
+			_, ok := seen[res.ID]
+			assert.False(t, ok, "object %s streamed twice", res.ID)
+			seen[res.ID] = struct{}{}
+			return nil
+		})
+		require.Nil(t, err)
+		return seen
+	}
+
+	t.Run("streaming all things of the kind", func(t *testing.T) {
+		assert.Len(t, stream(t, "", 10000, nil), total)
+	})
+
+	t.Run("streaming with a limit", func(t *testing.T) {
+		assert.Len(t, stream(t, thingclass.Class, streamPageSize+20, nil), streamPageSize+20)
+	})
+
+	t.Run("streaming with a filter", func(t *testing.T) {
+		where := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(thingclass.Class),
+				Property: "parity",
+			},
+			Value: &filters.Value{Value: "even", Type: schema.DataTypeString},
+		}}
+		assert.Len(t, stream(t, thingclass.Class, 10000, where), total/2)
+	})
+
+	t.Run("aborting the stream", func(t *testing.T) {
+		calls := 0
+		err := repo.StreamSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			Pagination: &filters.Pagination{Limit: 10000},
+		}, func(res search.Result) error {
+			calls++
+			if calls == 5 {
+				return fmt.Errorf("client went away")
+			}
+			return nil
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, 5, calls)
+	})
+}
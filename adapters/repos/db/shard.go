@@ -12,16 +12,18 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"time"
+	"sync"
 
 	"github.com/boltdb/bolt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/indexcounter"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
@@ -36,6 +38,28 @@ type Shard struct {
 	counter          *indexcounter.Counter
 	vectorIndex      VectorIndex
 	invertedRowCache *inverted.RowCacher
+
+	syncLock       *sync.Mutex
+	unsyncedWrites int
+
+	// writeLock is held shared by every write to the bolt db and exclusively
+	// by a compaction, so that no write can go missing from the compacted
+	// file
+	writeLock *sync.RWMutex
+
+	// dbLock and dbReaders allow a compaction to swap db for the compacted
+	// file while reads continue, see readDB
+	dbLock    *sync.RWMutex
+	dbReaders *sync.WaitGroup
+
+	// vectorIndexCorrupt is set if the commit log of the vector index could
+	// not be read and the index was started empty instead
+	vectorIndexCorrupt bool
+
+	// vectorDimensions is claimed by the first write while the vector index
+	// can't tell its dimensions yet, so that concurrent writes and the objects
+	// of a single batch can't import vectors of different dimensions
+	vectorDimensions int32
 }
 
 func NewShard(shardName string, index *Index) (*Shard, error) {
@@ -43,30 +67,27 @@ func NewShard(shardName string, index *Index) (*Shard, error) {
 		index:            index,
 		name:             shardName,
 		invertedRowCache: inverted.NewRowCacher(10 * 1024 * 1024),
+		syncLock:         &sync.Mutex{},
+		writeLock:        &sync.RWMutex{},
+		dbLock:           &sync.RWMutex{},
+		dbReaders:        &sync.WaitGroup{},
 	}
 
-	vi, err := hnsw.New(hnsw.Config{
-		RootPath: s.index.Config.RootPath,
-		ID:       s.ID(),
-		MakeCommitLoggerThunk: func() hnsw.CommitLogger {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID())
-		},
-		MaximumConnections:       60,
-		EFConstruction:           128,
-		VectorForIDThunk:         s.vectorByIndexID,
-		TombstoneCleanupInterval: 1 * time.Minute,
-	})
+	if err := s.recoverRestore(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: recover interrupted restore", s.ID())
+	}
+
+	err := s.initVectorIndex()
 	if err != nil {
 		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 	}
-	s.vectorIndex = vi
 
 	err = s.initDBFile()
 	if err != nil {
 		return nil, errors.Wrapf(err, "init shard %q: shard db", s.ID())
 	}
 
-	counter, err := indexcounter.New(s.ID(), index.Config.RootPath)
+	counter, err := s.openIndexCounter()
 	if err != nil {
 		return nil, errors.Wrapf(err, "init shard %q: index counter", s.ID())
 	}
@@ -75,6 +96,14 @@ func NewShard(shardName string, index *Index) (*Shard, error) {
 	return s, nil
 }
 
+func (s *Shard) openIndexCounter() (*indexcounter.Counter, error) {
+	if s.index.Config.BoltOptions.ReadOnly {
+		return indexcounter.NewReadOnly(s.ID(), s.index.Config.RootPath)
+	}
+
+	return indexcounter.New(s.ID(), s.index.Config.RootPath)
+}
+
 func (s *Shard) ID() string {
 	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
 }
@@ -84,11 +113,17 @@ func (s *Shard) DBPath() string {
 }
 
 func (s *Shard) initDBFile() error {
-	boltdb, err := bolt.Open(s.DBPath(), 0600, nil)
+	boltdb, err := s.openBolt()
 	if err != nil {
 		return errors.Wrapf(err, "open bolt at %s", s.DBPath())
 	}
 
+	if s.index.Config.BoltOptions.ReadOnly {
+		// the buckets can't be created, a read-only shard has to exist already
+		s.db = boltdb
+		return nil
+	}
+
 	err = boltdb.Update(func(tx *bolt.Tx) error {
 		if _, err := tx.CreateBucketIfNotExists(helpers.ObjectsBucket); err != nil {
 			return errors.Wrapf(err, "create objects bucket '%s'", string(helpers.ObjectsBucket))
@@ -98,6 +133,14 @@ func (s *Shard) initDBFile() error {
 			return errors.Wrapf(err, "create indexID bucket '%s'", string(helpers.IndexIDBucket))
 		}
 
+		if err := s.initCreationTimeBucket(tx); err != nil {
+			return errors.Wrap(err, "init creation time bucket")
+		}
+
+		if err := s.initDateIndexes(tx); err != nil {
+			return errors.Wrap(err, "init date indexes")
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -108,7 +151,127 @@ func (s *Shard) initDBFile() error {
 	return nil
 }
 
+// initCreationTimeBucket creates the inverted index of the creation time. A
+// shard that was created before the creation time was indexed already
+// contains objects, they are added to the new index.
+func (s *Shard) initCreationTimeBucket(tx *bolt.Tx) error {
+	name := helpers.BucketFromPropName(filters.InternalPropCreationTimeUnix)
+	if tx.Bucket(name) != nil {
+		return nil
+	}
+
+	b, err := tx.CreateBucket(name)
+	if err != nil {
+		return err
+	}
+
+	analyzer := inverted.NewAnalyzer()
+	return tx.Bucket(helpers.ObjectsBucket).ForEach(func(k, v []byte) error {
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object %s", string(k))
+		}
+
+		prop, err := analyzer.CreationTime(obj.CreationTimeUnix())
+		if err != nil {
+			return err
+		}
+
+		return s.extendInvertedIndexItem(b, prop.Items[0], obj.IndexID())
+	})
+}
+
+// dateIndexMigrationKey marks a shard in which every object is part of the
+// inverted index of its date properties. Date properties used not to be
+// indexed at all.
+var dateIndexMigrationKey = []byte("date_index")
+
+// initDateIndexes backfills the inverted index of every date property in a
+// shard which was written before date properties were indexed, so that date
+// filters match the objects written before as well. The backfill only runs
+// once per shard.
+func (s *Shard) initDateIndexes(tx *bolt.Tx) error {
+	migrations, err := tx.CreateBucketIfNotExists(helpers.MigrationsBucket)
+	if err != nil {
+		return err
+	}
+
+	if migrations.Get(dateIndexMigrationKey) != nil {
+		return nil
+	}
+
+	objects := tx.Bucket(helpers.ObjectsBucket)
+	if objects.Stats().KeyN > 0 {
+		sch := s.index.getSchema.GetSchemaSkipAuth()
+		class := sch.GetClass(s.index.Config.Kind, s.index.Config.ClassName)
+		if class == nil {
+			// the properties are unknown, try again on the next start
+			return nil
+		}
+
+		if err := s.rebuildDateIndexes(tx, objects, class); err != nil {
+			return err
+		}
+	}
+
+	return migrations.Put(dateIndexMigrationKey, []byte{1})
+}
+
+// rebuildDateIndexes starts every date property with an empty bucket, so
+// that a row never contains the same object twice, even if the shard was
+// truncated and some objects were already indexed.
+func (s *Shard) rebuildDateIndexes(tx *bolt.Tx, objects *bolt.Bucket,
+	class *models.Class) error {
+	var dateProps []*models.Property
+	for _, prop := range class.Properties {
+		if len(prop.DataType) != 1 ||
+			schema.DataType(prop.DataType[0]) != schema.DataTypeDate {
+			continue
+		}
+
+		name := helpers.BucketFromPropName(prop.Name)
+		if tx.Bucket(name) != nil {
+			if err := tx.DeleteBucket(name); err != nil {
+				return errors.Wrapf(err, "delete bucket of property %s", prop.Name)
+			}
+		}
+
+		if _, err := tx.CreateBucket(name); err != nil {
+			return errors.Wrapf(err, "create bucket of property %s", prop.Name)
+		}
+
+		dateProps = append(dateProps, prop)
+	}
+
+	if len(dateProps) == 0 {
+		return nil
+	}
+
+	analyzer := inverted.NewAnalyzer()
+	return objects.ForEach(func(k, v []byte) error {
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object %s", string(k))
+		}
+
+		schemaMap, ok := obj.Schema().(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		props, err := analyzer.Object(schemaMap, dateProps)
+		if err != nil {
+			return errors.Wrapf(err, "analyze object %s", obj.ID())
+		}
+
+		return s.extendInvertedIndices(tx, props, obj.IndexID())
+	})
+}
+
 func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
 	if err := s.db.Update(func(tx *bolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(helpers.BucketFromPropName(prop.Name))
 		if err != nil {
@@ -122,10 +285,121 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 			}
 		}
 
+		if prop.Unique {
+			_, err := tx.CreateBucketIfNotExists(helpers.UniqueBucketFromPropName(prop.Name))
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return errors.Wrap(err, "bolt update tx")
 	}
 
-	return nil
+	return s.afterWrite()
+}
+
+func (s *Shard) dropProperty(ctx context.Context, propName string) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		buckets := [][]byte{
+			helpers.BucketFromPropName(propName),
+			helpers.BucketFromPropName(helpers.MetaCountProp(propName)),
+			helpers.UniqueBucketFromPropName(propName),
+		}
+
+		for _, bucket := range buckets {
+			err := tx.DeleteBucket(bucket)
+			if err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "bolt update tx")
+	}
+
+	if err := s.stripPropertyValues(ctx, propName); err != nil {
+		return errors.Wrap(err, "strip stored values")
+	}
+
+	return s.afterWrite()
+}
+
+// stripPropertyValues removes the values of the property from all stored
+// objects, so that they don't resurface if a property with the same name is
+// added again. The objects are rewritten page by page, each page in its own
+// transaction. The caller must hold the write lock.
+func (s *Shard) stripPropertyValues(ctx context.Context, propName string) error {
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var count int
+		err := s.db.Update(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(helpers.ObjectsBucket)
+			cursor := bucket.Cursor()
+
+			var k, v []byte
+			if after == nil {
+				k, v = cursor.First()
+			} else {
+				k, v = cursor.Seek(after)
+				if k != nil && bytes.Equal(k, after) {
+					k, v = cursor.Next()
+				}
+			}
+
+			// the bucket must not be changed while the cursor is in use, so the
+			// stripped objects are only written once the page is complete
+			updates := map[string][]byte{}
+			for ; k != nil && count < streamPageSize; k, v = cursor.Next() {
+				count++
+				after = append([]byte{}, k...)
+
+				obj, err := storobj.FromBinary(v)
+				if err != nil {
+					return errors.Wrapf(err, "unmarshal object %q", k)
+				}
+
+				schemaMap, ok := obj.Schema().(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if _, ok := schemaMap[propName]; !ok {
+					continue
+				}
+
+				delete(schemaMap, propName)
+				data, err := obj.MarshalBinary()
+				if err != nil {
+					return errors.Wrapf(err, "marshal object %q", k)
+				}
+				updates[string(k)] = data
+			}
+
+			for key, data := range updates {
+				if err := bucket.Put([]byte(key), data); err != nil {
+					return errors.Wrapf(err, "put object %q", key)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "bolt update tx")
+		}
+
+		if count < streamPageSize {
+			// reached the end of the bucket
+			return nil
+		}
+	}
 }
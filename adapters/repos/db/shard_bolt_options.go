@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// BoltOptions are applied whenever a shard opens its bolt file, the zero
+// value uses bolt's defaults.
+//
+// InitialMmapSize pre-sizes the memory map of the file in bytes. While a file
+// grows bolt has to remap it, which blocks every reader and writer of the
+// shard for the duration and shows up as latency spikes. Setting it above the
+// size a shard file is expected to reach avoids the remaps. This mostly helps
+// bulk imports, where files grow quickly. In a steady state files rarely grow
+// and the default is fine. The map only reserves address space, memory is
+// used as pages are touched.
+//
+// OpenTimeout limits how long opening a shard waits for the lock on its file,
+// e.g. while another process still holds it. 0 waits forever.
+//
+// ReadOnly opens every shard read-only, e.g. to serve a restored snapshot.
+// Neither the bolt file, nor the commit log of the vector index, nor the
+// index counter are written, so all writes fail. The REST API rejects writes
+// and schema changes before they reach the shards. The shards need to exist
+// already, they can't be created read-only.
+type BoltOptions struct {
+	InitialMmapSize int
+	OpenTimeout     time.Duration
+	ReadOnly        bool
+}
+
+func (o BoltOptions) boltOptions() *bolt.Options {
+	return &bolt.Options{
+		InitialMmapSize: o.InitialMmapSize,
+		Timeout:         o.OpenTimeout,
+		ReadOnly:        o.ReadOnly,
+	}
+}
@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// compactTxBytes is the amount of keys and values copied into the compacted
+// file per write transaction
+const compactTxBytes = 4 * 1024 * 1024
+
+const compactFileSuffix = ".compact"
+
+// Compact rewrites the bolt file of every shard into a fresh file, which
+// releases the space of deleted and overwritten objects that bolt keeps
+// around otherwise. The copy is limited to config.CompactionBytesPerSecond.
+// Reads continue during the compaction of a shard, writes to the shard wait
+// until it is done. A canceled compaction leaves the shard it was working on
+// untouched, shards which were already compacted stay compacted. Classes
+// which are added while the compaction runs are not compacted.
+func (d *DB) Compact(ctx context.Context) ([]*models.ShardCompaction, error) {
+	type compactionTarget struct {
+		index *Index
+		name  string
+		shard *Shard
+	}
+
+	// the indices can change while the compaction runs, so only the shards
+	// which exist when it starts are compacted
+	var targets []compactionTarget
+	d.indexLock.RLock()
+	for _, index := range d.indices {
+		for name, shard := range index.Shards {
+			targets = append(targets, compactionTarget{index, name, shard})
+		}
+	}
+	d.indexLock.RUnlock()
+
+	var out []*models.ShardCompaction
+	for _, target := range targets {
+		shard := target.shard
+		before, after, err := shard.compact(ctx, d.config.CompactionBytesPerSecond)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compact shard %q", shard.ID())
+		}
+
+		d.logger.WithField("action", "compact_shard").
+			WithField("shard", shard.ID()).
+			WithField("size_before", before).
+			WithField("size_after", after).
+			Info("compacted shard")
+
+		out = append(out, &models.ShardCompaction{
+			Class:           target.index.Config.ClassName.String(),
+			Kind:            target.index.Config.Kind.Name(),
+			Shard:           target.name,
+			SizeBeforeBytes: before,
+			SizeAfterBytes:  after,
+			ReclaimedBytes:  before - after,
+		})
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Class != out[b].Class {
+			return out[a].Class < out[b].Class
+		}
+
+		return out[a].Shard < out[b].Shard
+	})
+
+	return out, nil
+}
+
+// readDB returns the shard's bolt db for reading. release must be called once
+// the db is no longer used, a compaction waits for all readers of the
+// previous file before closing it.
+func (s *Shard) readDB() (*bolt.DB, func()) {
+	s.dbLock.RLock()
+	db, readers := s.db, s.dbReaders
+	readers.Add(1)
+	s.dbLock.RUnlock()
+
+	return db, readers.Done
+}
+
+// compact copies the shard's bolt file into a fresh file and swaps it in. It
+// returns the file size before and after the compaction.
+func (s *Shard) compact(ctx context.Context, bytesPerSecond int) (int64, int64, error) {
+	if s.index.Config.BoltOptions.ReadOnly {
+		return 0, 0, errors.New("shard is opened read-only")
+	}
+
+	s.writeLock.Lock()
+	unlocked := false
+	unlock := func() {
+		if !unlocked {
+			unlocked = true
+			s.writeLock.Unlock()
+		}
+	}
+	defer unlock()
+
+	before, err := fileSize(s.DBPath())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// a leftover of a compaction which was interrupted by a crash
+	tmpPath := s.DBPath() + compactFileSuffix
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return 0, 0, errors.Wrap(err, "remove previous compaction file")
+	}
+
+	compacted, err := bolt.Open(tmpPath, 0600,
+		s.index.Config.BoltOptions.boltOptions())
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "open bolt at %s", tmpPath)
+	}
+
+	// the file is only fsynced once it is complete
+	compacted.NoSync = true
+	if err := copyBolt(ctx, s.db, compacted, bytesPerSecond); err != nil {
+		compacted.Close()
+		os.Remove(tmpPath)
+		return 0, 0, err
+	}
+
+	if err := compacted.Sync(); err != nil {
+		compacted.Close()
+		os.Remove(tmpPath)
+		return 0, 0, errors.Wrap(err, "fsync compacted file")
+	}
+
+	// the open file handle remains valid, so the compacted db does not need
+	// to be reopened
+	if err := os.Rename(tmpPath, s.DBPath()); err != nil {
+		compacted.Close()
+		os.Remove(tmpPath)
+		return 0, 0, errors.Wrap(err, "replace shard file with compacted file")
+	}
+	compacted.NoSync = s.index.Config.SyncPolicy.noSync()
+
+	s.dbLock.Lock()
+	previous, previousReaders := s.db, s.dbReaders
+	s.db, s.dbReaders = compacted, &sync.WaitGroup{}
+	s.dbLock.Unlock()
+
+	// writes go to the compacted file from now on, only reads which started
+	// before the swap still use the previous one
+	unlock()
+	previousReaders.Wait()
+	if err := previous.Close(); err != nil {
+		return 0, 0, errors.Wrap(err, "close previous shard file")
+	}
+
+	after, err := fileSize(s.DBPath())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return before, after, nil
+}
+
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "stat %s", path)
+	}
+
+	return info.Size(), nil
+}
+
+// copyBolt copies all buckets of src into dst, using several write
+// transactions for large files. A bytesPerSecond above 0 limits how fast
+// data is copied.
+func copyBolt(ctx context.Context, src, dst *bolt.DB, bytesPerSecond int) error {
+	c := &boltCopier{
+		ctx:            ctx,
+		dst:            dst,
+		bytesPerSecond: bytesPerSecond,
+		started:        time.Now(),
+	}
+
+	err := src.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return c.copyBucket(b, [][]byte{name})
+		})
+	})
+	if err != nil {
+		if c.tx != nil {
+			c.tx.Rollback()
+		}
+		return err
+	}
+
+	return c.commit()
+}
+
+type boltCopier struct {
+	ctx            context.Context
+	dst            *bolt.DB
+	bytesPerSecond int
+	started        time.Time
+	copied         int64
+
+	// the current write transaction and its size
+	tx      *bolt.Tx
+	txBytes int
+}
+
+func (c *boltCopier) copyBucket(src *bolt.Bucket, path [][]byte) error {
+	b, err := c.bucket(path)
+	if err != nil {
+		return err
+	}
+
+	if err := b.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+
+	return src.ForEach(func(k, v []byte) error {
+		if v == nil {
+			// a nested bucket
+			return c.copyBucket(src.Bucket(k), append(path[:len(path):len(path)], k))
+		}
+
+		if c.txBytes+len(k)+len(v) > compactTxBytes {
+			if err := c.commit(); err != nil {
+				return err
+			}
+		}
+
+		b, err := c.bucket(path)
+		if err != nil {
+			return err
+		}
+
+		c.txBytes += len(k) + len(v)
+		return b.Put(k, v)
+	})
+}
+
+// bucket returns the bucket at path within the current transaction, it is
+// created if it doesn't exist yet
+func (c *boltCopier) bucket(path [][]byte) (*bolt.Bucket, error) {
+	if c.tx == nil {
+		tx, err := c.dst.Begin(true)
+		if err != nil {
+			return nil, err
+		}
+		c.tx = tx
+	}
+
+	b, err := c.tx.CreateBucketIfNotExists(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range path[1:] {
+		if b, err = b.CreateBucketIfNotExists(name); err != nil {
+			return nil, err
+		}
+	}
+
+	// keys are inserted in order, so the pages can be filled completely
+	b.FillPercent = 1.0
+	return b, nil
+}
+
+// commit completes the current transaction and waits if the copy is ahead of
+// the configured rate
+func (c *boltCopier) commit() error {
+	if c.tx == nil {
+		return nil
+	}
+
+	err := c.tx.Commit()
+	c.tx = nil
+	if err != nil {
+		return errors.Wrap(err, "commit compacted data")
+	}
+
+	c.copied += int64(c.txBytes)
+	c.txBytes = 0
+
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+
+	if c.bytesPerSecond <= 0 {
+		return nil
+	}
+
+	due := time.Duration(float64(c.copied) / float64(c.bytesPerSecond) * float64(time.Second))
+	wait := due - time.Since(c.started)
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
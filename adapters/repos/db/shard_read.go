@@ -31,6 +31,9 @@ import (
 
 func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 	props traverser.SelectProperties, meta bool) (*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
+
 	var object *storobj.Object
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
@@ -38,7 +41,7 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, err
 	}
 
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = db.View(func(tx *bolt.Tx) error {
 		bytes := tx.Bucket(helpers.ObjectsBucket).Get(idBytes)
 		if bytes == nil {
 			return nil
@@ -60,6 +63,9 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 
 func (s *Shard) multiObjectByID(ctx context.Context,
 	query []multi.Identifier) ([]*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
+
 	objects := make([]*storobj.Object, len(query))
 
 	ids := make([][]byte, len(query))
@@ -72,7 +78,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		ids[i] = idBytes
 	}
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := db.View(func(tx *bolt.Tx) error {
 		for i, id := range ids {
 			bytes := tx.Bucket(helpers.ObjectsBucket).Get(id)
 			if bytes == nil {
@@ -95,6 +101,9 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 }
 
 func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
+	db, release := s.readDB()
+	defer release()
+
 	var ok bool
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
@@ -102,13 +111,19 @@ func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 		return false, err
 	}
 
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = db.View(func(tx *bolt.Tx) error {
 		bytes := tx.Bucket(helpers.ObjectsBucket).Get(idBytes)
 		if bytes == nil {
 			return nil
 		}
 
-		ok = true
+		// an expired object which the sweeper hasn't removed yet doesn't exist
+		expired, err := expiredBinary(bytes, nowMillis())
+		if err != nil {
+			return err
+		}
+
+		ok = !expired
 		return nil
 	})
 	if err != nil {
@@ -119,12 +134,15 @@ func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 }
 
 func (s *Shard) vectorByIndexID(ctx context.Context, indexID int32) ([]float32, error) {
+	db, release := s.readDB()
+	defer release()
+
 	keyBuf := bytes.NewBuffer(make([]byte, 4))
 	binary.Write(keyBuf, binary.LittleEndian, &indexID)
 	key := keyBuf.Bytes()
 
 	var vec []float32
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := db.View(func(tx *bolt.Tx) error {
 		uuid := tx.Bucket(helpers.IndexIDBucket).Get(key)
 		if uuid == nil {
 			return fmt.Errorf("index id %d resolved to a nil object-id", indexID)
@@ -152,23 +170,41 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID int32) ([]float32,
 
 func (s *Shard) objectSearch(ctx context.Context, limit int,
 	filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
 
 	if filters == nil {
 		return s.objectList(ctx, limit, meta)
 	}
 
 	return inverted.NewSearcher(
-		s.db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
+		db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
 		Object(ctx, limit, filters, meta, s.index.Config.ClassName)
 }
 
+func (s *Shard) objectSortedSearch(ctx context.Context, limit int,
+	filters *filters.LocalFilter, sort *filters.Sort,
+	meta bool) ([]*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
+
+	return inverted.NewSearcher(
+		db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
+		ObjectsByCreationTime(ctx, limit, filters, sort.Order, meta,
+			s.index.Config.ClassName)
+}
+
+// objectVectorSearch returns the limit closest objects to the search vector.
+// An ef of 0 uses the ef configured for the vector index.
 func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
-	limit int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	limit int, ef int, filters *filters.LocalFilter, meta bool) ([]*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
 
 	var allowList inverted.AllowList
 	if filters != nil {
 		list, err := inverted.NewSearcher(
-			s.db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
+			db, s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache).
 			DocIDs(ctx, filters, meta, s.index.Config.ClassName)
 		if err != nil {
 			return nil, errors.Wrap(err, "build inverted filter allow list")
@@ -176,7 +212,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 		allowList = list
 	}
-	ids, err := s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+	ids, err := s.currentVectorIndex().SearchByVector(searchVector, limit, ef, allowList)
 	if err != nil {
 		return nil, errors.Wrap(err, "vector search")
 	}
@@ -185,13 +221,17 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 		return nil, nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	var out []*storobj.Object
 	// TODO: unify
 	idsUint := make([]uint32, len(ids))
 	for i, id := range ids {
 		idsUint[i] = uint32(id)
 	}
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := db.View(func(tx *bolt.Tx) error {
 		res, err := inverted.ObjectsFromDocIDsInTx(tx, idsUint)
 		if err != nil {
 			return errors.Wrap(err, "resolve doc ids to objects")
@@ -208,12 +248,19 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 func (s *Shard) objectList(ctx context.Context, limit int,
 	meta bool) ([]*storobj.Object, error) {
+	db, release := s.readDB()
+	defer release()
+
 	out := make([]*storobj.Object, limit)
 	i := 0
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := db.View(func(tx *bolt.Tx) error {
 		cursor := tx.Bucket(helpers.ObjectsBucket).Cursor()
 
 		for k, v := cursor.First(); k != nil && i < limit; k, v = cursor.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
 			obj, err := storobj.FromBinary(v)
 			if err != nil {
 				return errors.Wrapf(err, "unmarhsal item %d", i)
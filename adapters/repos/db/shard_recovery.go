@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+)
+
+// VectorIndexRecovery controls whether the vector index of a shard is rebuilt
+// from the objects in the shard's bolt file on startup. The rebuild re-adds
+// the stored vector of every object, so it does not need a vectorizer.
+type VectorIndexRecovery string
+
+const (
+	// RecoveryOff never rebuilds a vector index, an unreadable commit log
+	// fails the startup
+	RecoveryOff VectorIndexRecovery = "off"
+	// RecoveryAuto rebuilds the vector index of a shard if its commit log can't
+	// be read, or if the index holds fewer vectors than the shard holds
+	// objects, e.g. because the commit log was lost
+	RecoveryAuto VectorIndexRecovery = "auto"
+	// RecoveryForce rebuilds the vector index of every shard on startup
+	RecoveryForce VectorIndexRecovery = "force"
+)
+
+func (r VectorIndexRecovery) enabled() bool {
+	return r == RecoveryAuto || r == RecoveryForce
+}
+
+func (s *Shard) initVectorIndex() error {
+	vi, corrupt, err := s.newVectorIndex()
+	if err != nil {
+		return err
+	}
+
+	s.vectorIndex = vi
+	s.vectorIndexCorrupt = corrupt
+	return nil
+}
+
+// newVectorIndex restores the vector index from its commit log. With
+// recovery enabled an unreadable commit log is moved aside and an empty index
+// is returned instead, which is marked as corrupt.
+func (s *Shard) newVectorIndex() (VectorIndex, bool, error) {
+	cfg := hnsw.Config{
+		RootPath: s.index.Config.RootPath,
+		ID:       s.ID(),
+		MakeCommitLoggerThunk: func() hnsw.CommitLogger {
+			if s.index.Config.BoltOptions.ReadOnly {
+				// the index is only held in memory, the commit log is never written
+				return hnsw.NewNoopCommitLogger()
+			}
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID())
+		},
+		MaximumConnections:       s.vectorIndexMaxConnections(),
+		EFConstruction:           s.vectorIndexEFConstruction(),
+		EF:                       s.vectorIndexEF(),
+		VectorForIDThunk:         s.vectorByIndexID,
+		TombstoneCleanupInterval: 1 * time.Minute,
+	}
+
+	vi, err := hnsw.New(cfg)
+	if err != nil {
+		if !s.index.Config.VectorIndexRecovery.enabled() ||
+			s.index.Config.BoltOptions.ReadOnly {
+			// a read-only shard can't move its commit log aside
+			return nil, false, err
+		}
+
+		// keep the unreadable commit log for inspection and start with an empty
+		// index instead, it is rebuilt from bolt once the shard is loaded
+		fileName := hnsw.CommitLogFileName(s.index.Config.RootPath, s.ID())
+		if renameErr := os.Rename(fileName, fileName+".corrupt"); renameErr != nil {
+			return nil, false, errors.Wrapf(err, "move aside commit log: %v", renameErr)
+		}
+
+		vi, err = hnsw.New(cfg)
+		if err != nil {
+			return nil, false, err
+		}
+		return vi, true, nil
+	}
+
+	return vi, false, nil
+}
+
+// vectorIndexRebuildReason explains why the vector index has to be rebuilt
+// in the given mode, an empty reason means no rebuild is required
+func (s *Shard) vectorIndexRebuildReason(ctx context.Context,
+	mode VectorIndexRecovery) (string, error) {
+	switch mode {
+	case RecoveryForce:
+		return "recovery is forced", nil
+	case RecoveryAuto:
+	default:
+		return "", nil
+	}
+
+	if s.vectorIndexCorrupt {
+		return "the commit log could not be read", nil
+	}
+
+	objects, err := s.objectCount(ctx, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "count objects")
+	}
+
+	stats := s.vectorIndex.Stats()
+	vectors := int64(stats.VectorCount - stats.TombstoneCount)
+	if vectors < objects {
+		return fmt.Sprintf("the vector index holds %d vectors, but the shard holds %d objects",
+			vectors, objects), nil
+	}
+
+	return "", nil
+}
+
+// rebuildVectorIndex empties the vector index and adds the vector of every
+// object in bolt again. progress is called after every page with the number
+// of objects processed so far. An interrupted rebuild leaves an incomplete
+// index behind, which is detected and rebuilt again on the next startup.
+func (s *Shard) rebuildVectorIndex(ctx context.Context,
+	progress func(processed int)) error {
+	if err := s.vectorIndex.Reset(); err != nil {
+		return errors.Wrap(err, "reset vector index")
+	}
+
+	processed := 0
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, last, err := s.objectListPage(after, streamPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range page {
+			if len(obj.Vector) == 0 {
+				continue
+			}
+
+			if err := s.vectorIndex.Add(int(obj.IndexID()), obj.Vector); err != nil {
+				return errors.Wrapf(err, "add vector of object %s", obj.ID())
+			}
+		}
+
+		processed += len(page)
+		progress(processed)
+
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = last
+	}
+}
+
+// recoverVectorIndexes rebuilds the vector index of every shard of the index
+// which needs it according to the configured recovery mode. The rebuild is
+// recorded in the startup progress, so it is reported while waiting for
+// startup.
+func (d *DB) recoverVectorIndexes(ctx context.Context, idx *Index,
+	progress *startupProgress) error {
+	for _, shard := range idx.Shards {
+		reason, err := shard.vectorIndexRebuildReason(ctx, d.config.VectorIndexRecovery)
+		if err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		if reason == "" {
+			continue
+		}
+
+		total, err := shard.objectCount(ctx, nil)
+		if err != nil {
+			return errors.Wrapf(err, "shard %s: count objects", shard.ID())
+		}
+
+		d.logger.
+			WithField("action", "vector_index_recovery").
+			WithField("shard", shard.ID()).
+			WithField("objects", total).
+			Warnf("rebuilding vector index of shard %s from stored objects: %s", shard.ID(), reason)
+
+		progress.start(fmt.Sprintf("vector index of shard %q (0 of %d objects)", shard.ID(), total))
+		err = shard.rebuildVectorIndex(ctx, func(processed int) {
+			progress.start(fmt.Sprintf("vector index of shard %q (%d of %d objects)",
+				shard.ID(), processed, total))
+		})
+		if err != nil {
+			return errors.Wrapf(err, "rebuild vector index of shard %s", shard.ID())
+		}
+
+		d.logger.
+			WithField("action", "vector_index_recovery").
+			WithField("shard", shard.ID()).
+			Infof("rebuilt vector index of shard %s", shard.ID())
+	}
+
+	return nil
+}
@@ -0,0 +1,504 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/indexcounter"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// a shard snapshot is a tar stream with one entry per file of the shard
+const (
+	snapshotEntryDB         = "shard.db"
+	snapshotEntryCommitLog  = "hnsw.commitlog"
+	snapshotEntryIndexCount = "indexcount"
+)
+
+// while a restore replaces the files of a shard, the restored files have the
+// restore suffix and the files they replace the previous suffix. The marker
+// exists from the moment the restore has to be completed after a restart.
+const (
+	restoreFileSuffix   = ".restore"
+	previousFileSuffix  = ".previous"
+	restoreMarkerSuffix = ".restoring"
+)
+
+// SnapshotShard writes a consistent point-in-time snapshot of the shard to w.
+// Writes to the shard are paused only until the snapshot has been taken, the
+// (possibly slow) copy to w runs while writes continue. The snapshot can be
+// loaded back with RestoreShard.
+func (d *DB) SnapshotShard(ctx context.Context, k kind.Kind, className,
+	shardName string, w io.Writer) error {
+	shard, err := d.shardByName(k, className, shardName)
+	if err != nil {
+		return err
+	}
+
+	if err := shard.snapshot(ctx, w); err != nil {
+		return errors.Wrapf(err, "snapshot shard %q", shard.ID())
+	}
+
+	return nil
+}
+
+// RestoreShard replaces the contents of the shard with a snapshot which was
+// taken with SnapshotShard. Writes to the shard wait until the restore is
+// done. The shard is left untouched if the snapshot cannot be read or the
+// restored files cannot be opened.
+func (d *DB) RestoreShard(ctx context.Context, k kind.Kind, className,
+	shardName string, r io.Reader) error {
+	shard, err := d.shardByName(k, className, shardName)
+	if err != nil {
+		return err
+	}
+
+	if err := shard.restore(ctx, r); err != nil {
+		return errors.Wrapf(err, "restore shard %q", shard.ID())
+	}
+
+	return nil
+}
+
+func (d *DB) shardByName(k kind.Kind, className, shardName string) (*Shard, error) {
+	idx := d.GetIndex(k, schema.ClassName(className))
+	if idx == nil {
+		return nil, kinds.NewErrNotFound("no index for %s/%s", k, className)
+	}
+
+	shard, ok := idx.Shards[shardName]
+	if !ok {
+		return nil, kinds.NewErrNotFound("no shard %q for %s/%s", shardName, k, className)
+	}
+
+	return shard, nil
+}
+
+// currentVectorIndex returns the vector index for reads which do not hold
+// the write lock, a restore swaps it for the restored index
+func (s *Shard) currentVectorIndex() VectorIndex {
+	s.dbLock.RLock()
+	defer s.dbLock.RUnlock()
+
+	return s.vectorIndex
+}
+
+func (s *Shard) indexCountPath() string {
+	return fmt.Sprintf("%s/%s.indexcount", s.index.Config.RootPath, s.ID())
+}
+
+// snapshot pauses writes only for as long as it takes to open a bolt read
+// transaction and to record the length of the vector index commit log and
+// the index counter. All of them describe the same state, as every write
+// completes both its bolt transaction and its vector index update while it
+// holds the write lock.
+func (s *Shard) snapshot(ctx context.Context, w io.Writer) error {
+	s.writeLock.Lock()
+	db, release := s.readDB()
+	defer release()
+
+	tx, err := db.Begin(false)
+	if err != nil {
+		s.writeLock.Unlock()
+		return errors.Wrap(err, "begin read tx")
+	}
+	defer tx.Rollback()
+
+	commitLogPath, commitLogSize, err := s.vectorIndex.CommitLogSnapshot()
+	if err != nil {
+		s.writeLock.Unlock()
+		return errors.Wrap(err, "vector index")
+	}
+
+	count := s.counter.Get()
+	s.writeLock.Unlock()
+
+	tw := tar.NewWriter(w)
+	if err := writeSnapshotEntry(ctx, tw, snapshotEntryDB, tx.Size(),
+		func(w io.Writer) error {
+			_, err := tx.WriteTo(w)
+			return err
+		}); err != nil {
+		return err
+	}
+
+	if err := writeSnapshotEntry(ctx, tw, snapshotEntryCommitLog, commitLogSize,
+		func(w io.Writer) error {
+			if commitLogSize == 0 {
+				return nil
+			}
+
+			f, err := os.Open(commitLogPath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			// anything beyond the recorded size was logged after the snapshot
+			_, err = io.CopyN(w, f, commitLogSize)
+			return err
+		}); err != nil {
+		return err
+	}
+
+	if err := writeSnapshotEntry(ctx, tw, snapshotEntryIndexCount, 4,
+		func(w io.Writer) error {
+			return binary.Write(w, binary.LittleEndian, &count)
+		}); err != nil {
+		return err
+	}
+
+	return errors.Wrap(tw.Close(), "finish snapshot stream")
+}
+
+func writeSnapshotEntry(ctx context.Context, tw *tar.Writer, name string,
+	size int64, write func(w io.Writer) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: size,
+	}); err != nil {
+		return errors.Wrapf(err, "write header of %s", name)
+	}
+
+	if err := write(tw); err != nil {
+		return errors.Wrapf(err, "write %s", name)
+	}
+
+	return nil
+}
+
+// restore writes the files of the snapshot next to the shard's files and
+// only replaces them once the snapshot has been read completely. The restore
+// is committed on disk with a marker file before any file is replaced, so
+// that a restart completes an interrupted restore instead of starting with a
+// mix of restored and previous files, see recoverRestore. The previous files
+// are kept until the restored files have been opened, the restore is rolled
+// back if any of them can't be opened. The bolt file is swapped in the same
+// way as for a compaction, so reads which started before the restore can
+// finish on the previous file.
+func (s *Shard) restore(ctx context.Context, r io.Reader) error {
+	if s.index.Config.BoltOptions.ReadOnly {
+		return errors.New("shard is opened read-only")
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	targets := s.restoreTargets()
+	if err := readSnapshot(ctx, r, targets); err != nil {
+		removeRestoreFiles(targets)
+		return err
+	}
+
+	if err := writeRestoreMarker(s.restoreMarkerPath()); err != nil {
+		removeRestoreFiles(targets)
+		return errors.Wrap(err, "commit restore")
+	}
+
+	if err := replaceWithRestoreFiles(targets); err != nil {
+		return s.rollbackRestore(targets, errors.Wrap(err, "replace shard files"))
+	}
+
+	restored, err := s.openRestored()
+	if err != nil {
+		return s.rollbackRestore(targets, err)
+	}
+
+	s.dbLock.Lock()
+	previous, previousReaders := s.db, s.dbReaders
+	previousVectorIndex, previousCounter := s.vectorIndex, s.counter
+	s.db, s.dbReaders = restored.db, &sync.WaitGroup{}
+	s.vectorIndex, s.vectorIndexCorrupt = restored.vectorIndex, restored.vectorIndexCorrupt
+	s.counter = restored.counter
+	s.dbLock.Unlock()
+
+	if err := finishRestore(targets, true); err != nil {
+		return errors.Wrap(err, "remove previous shard files")
+	}
+
+	if err := os.Remove(s.restoreMarkerPath()); err != nil {
+		return errors.Wrap(err, "remove restore marker")
+	}
+
+	// searches which are still running on the previous vector index can
+	// finish, only the changes to it are stopped
+	if err := previousVectorIndex.Shutdown(); err != nil {
+		return errors.Wrap(err, "shut down previous vector index")
+	}
+
+	if err := previousCounter.Close(); err != nil {
+		return errors.Wrap(err, "close previous index counter")
+	}
+
+	previousReaders.Wait()
+	if err := previous.Close(); err != nil {
+		return errors.Wrap(err, "close previous shard file")
+	}
+
+	return nil
+}
+
+func (s *Shard) restoreTargets() map[string]string {
+	return map[string]string{
+		snapshotEntryDB:         s.DBPath(),
+		snapshotEntryCommitLog:  hnsw.CommitLogFileName(s.index.Config.RootPath, s.ID()),
+		snapshotEntryIndexCount: s.indexCountPath(),
+	}
+}
+
+func (s *Shard) restoreMarkerPath() string {
+	return fmt.Sprintf("%s/%s%s", s.index.Config.RootPath, s.ID(), restoreMarkerSuffix)
+}
+
+type restoredShard struct {
+	db                 *bolt.DB
+	vectorIndex        VectorIndex
+	vectorIndexCorrupt bool
+	counter            *indexcounter.Counter
+}
+
+// openRestored opens the restored files, either all of them are opened or
+// none
+func (s *Shard) openRestored() (*restoredShard, error) {
+	vectorIndex, corrupt, err := s.newVectorIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "vector index")
+	}
+
+	db, err := s.openBolt()
+	if err != nil {
+		vectorIndex.Shutdown()
+		return nil, errors.Wrapf(err, "open bolt at %s", s.DBPath())
+	}
+
+	counter, err := s.openIndexCounter()
+	if err != nil {
+		vectorIndex.Shutdown()
+		db.Close()
+		return nil, errors.Wrap(err, "index counter")
+	}
+
+	return &restoredShard{
+		db:                 db,
+		vectorIndex:        vectorIndex,
+		vectorIndexCorrupt: corrupt,
+		counter:            counter,
+	}, nil
+}
+
+// rollbackRestore puts the previous files of the shard back in place, the
+// shard still has them open. The marker is removed first, so that a restart
+// rolls back as well if the rollback is interrupted.
+func (s *Shard) rollbackRestore(targets map[string]string, cause error) error {
+	if err := os.Remove(s.restoreMarkerPath()); err != nil {
+		return errors.Wrapf(cause, "roll back restore: remove marker: %v", err)
+	}
+
+	if err := finishRestore(targets, false); err != nil {
+		return errors.Wrapf(cause, "roll back restore: %v", err)
+	}
+
+	return cause
+}
+
+// recoverRestore completes a restore which was interrupted after it had been
+// committed, or rolls back one which was interrupted before. It has to run
+// before any of the shard's files are opened.
+func (s *Shard) recoverRestore() error {
+	committed := true
+	if _, err := os.Stat(s.restoreMarkerPath()); err != nil {
+		if !os.IsNotExist(err) {
+			return errors.Wrap(err, "check for restore marker")
+		}
+		committed = false
+	}
+
+	if err := finishRestore(s.restoreTargets(), committed); err != nil {
+		return err
+	}
+
+	if !committed {
+		return nil
+	}
+
+	return os.Remove(s.restoreMarkerPath())
+}
+
+// replaceWithRestoreFiles moves every target aside and the restored file in
+// its place
+func replaceWithRestoreFiles(targets map[string]string) error {
+	for _, target := range targets {
+		if err := renameIfExists(target, target+previousFileSuffix); err != nil {
+			return err
+		}
+
+		if err := os.Rename(target+restoreFileSuffix, target); err != nil {
+			return err
+		}
+	}
+
+	return syncDirOf(targets)
+}
+
+// finishRestore completes a restore by moving any restored file which is
+// still left into place and removing the previous files. Otherwise it rolls
+// the restore back by moving the previous files back into place and removing
+// the restored files. Both can be repeated if they are interrupted.
+func finishRestore(targets map[string]string, complete bool) error {
+	for _, target := range targets {
+		from, discard := target+previousFileSuffix, target+restoreFileSuffix
+		if complete {
+			from, discard = discard, from
+		}
+
+		if err := renameIfExists(from, target); err != nil {
+			return err
+		}
+
+		if err := os.Remove(discard); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return syncDirOf(targets)
+}
+
+func removeRestoreFiles(targets map[string]string) {
+	for _, target := range targets {
+		os.Remove(target + restoreFileSuffix)
+	}
+}
+
+func renameIfExists(from, to string) error {
+	err := os.Rename(from, to)
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+func writeRestoreMarker(path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDirOf fsyncs the directory of the targets, so that renames within it
+// are persisted. All targets of a shard are in the same directory.
+func syncDirOf(targets map[string]string) error {
+	for _, target := range targets {
+		return syncDir(filepath.Dir(target))
+	}
+
+	return nil
+}
+
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	if err := dir.Sync(); err != nil {
+		dir.Close()
+		return err
+	}
+
+	return dir.Close()
+}
+
+// readSnapshot writes every entry of the snapshot to its target path with
+// the restore suffix. It fails unless all entries are present, a malformed
+// snapshot is reported as an ErrInvalidUserInput.
+func readSnapshot(ctx context.Context, r io.Reader, targets map[string]string) error {
+	found := map[string]bool{}
+	tr := tar.NewReader(r)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return kinds.NewErrInvalidUserInput("read snapshot stream: %v", err)
+		}
+
+		target, ok := targets[hdr.Name]
+		if !ok {
+			return kinds.NewErrInvalidUserInput("unexpected entry %q in snapshot", hdr.Name)
+		}
+
+		if err := writeRestoreFile(target+restoreFileSuffix, tr); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return kinds.NewErrInvalidUserInput("snapshot entry %q is incomplete", hdr.Name)
+			}
+			return errors.Wrapf(err, "restore %s", hdr.Name)
+		}
+		found[hdr.Name] = true
+	}
+
+	for name := range targets {
+		if !found[name] {
+			return kinds.NewErrInvalidUserInput("snapshot is missing entry %q", name)
+		}
+	}
+
+	return nil
+}
+
+func writeRestoreFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
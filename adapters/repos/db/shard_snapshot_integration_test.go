@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardSnapshot(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "SnapshotClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	put := func(t *testing.T, i int, name string) strfmt.UUID {
+		id := strfmt.UUID(fmt.Sprintf("5a9e3c2b-1f4d-4e6a-9b8c-%012d", i))
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     id,
+			Class:  class.Class,
+			Schema: map[string]interface{}{"name": name},
+		}, []float32{1, float32(i), 0})
+		require.Nil(t, err)
+		return id
+	}
+
+	count := func(t *testing.T, name string) int64 {
+		res, err := repo.Count(context.Background(), kind.Thing, class.Class,
+			&filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "name",
+					},
+					Value: &filters.Value{
+						Value: name,
+						Type:  schema.DataTypeString,
+					},
+				},
+			})
+		require.Nil(t, err)
+		return res
+	}
+
+	vectorSearch := func(t *testing.T) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			SearchVector: []float32{1, 0, 0},
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 100},
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	var before []strfmt.UUID
+	t.Run("importing things", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			before = append(before, put(t, i, "alice"))
+		}
+	})
+
+	snapshot := &bytes.Buffer{}
+	t.Run("taking a snapshot", func(t *testing.T) {
+		err := repo.SnapshotShard(context.Background(), kind.Thing, class.Class,
+			"single", snapshot)
+		require.Nil(t, err)
+	})
+
+	t.Run("taking a snapshot of a shard that doesn't exist", func(t *testing.T) {
+		err := repo.SnapshotShard(context.Background(), kind.Thing, class.Class,
+			"not-there", &bytes.Buffer{})
+		assert.IsType(t, kinds.ErrNotFound{}, errors.Cause(err))
+	})
+
+	var deleted strfmt.UUID
+	t.Run("changing the shard after the snapshot", func(t *testing.T) {
+		for i := 10; i < 15; i++ {
+			put(t, i, "bob")
+		}
+
+		deleted = before[0]
+		require.Nil(t, repo.DeleteThing(context.Background(), class.Class, deleted))
+
+		assert.Equal(t, int64(5), count(t, "bob"))
+		assert.Len(t, vectorSearch(t), 14)
+	})
+
+	t.Run("restoring an incomplete snapshot", func(t *testing.T) {
+		incomplete := bytes.NewReader(snapshot.Bytes()[:snapshot.Len()/2])
+		err := repo.RestoreShard(context.Background(), kind.Thing, class.Class,
+			"single", incomplete)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, errors.Cause(err))
+
+		// the shard is untouched
+		assert.Equal(t, int64(5), count(t, "bob"))
+	})
+
+	t.Run("restoring a snapshot whose shard file can't be opened", func(t *testing.T) {
+		invalid := &bytes.Buffer{}
+		tw := tar.NewWriter(invalid)
+		entries := map[string][]byte{
+			snapshotEntryDB:         bytes.Repeat([]byte{0xff}, 8192),
+			snapshotEntryCommitLog:  {},
+			snapshotEntryIndexCount: {0, 0, 0, 0},
+		}
+		for name, content := range entries {
+			require.Nil(t, writeSnapshotEntry(context.Background(), tw, name,
+				int64(len(content)), func(w io.Writer) error {
+					_, err := w.Write(content)
+					return err
+				}))
+		}
+		require.Nil(t, tw.Close())
+
+		err := repo.RestoreShard(context.Background(), kind.Thing, class.Class,
+			"single", invalid)
+		assert.NotNil(t, err)
+
+		// the previous files are back in place and still in use
+		assert.Equal(t, int64(5), count(t, "bob"))
+		assert.Len(t, vectorSearch(t), 14)
+		assertNoRestoreFiles(t, dirName)
+	})
+
+	t.Run("restoring the snapshot", func(t *testing.T) {
+		err := repo.RestoreShard(context.Background(), kind.Thing, class.Class,
+			"single", bytes.NewReader(snapshot.Bytes()))
+		require.Nil(t, err)
+	})
+
+	t.Run("the shard is back at the state of the snapshot", func(t *testing.T) {
+		assert.Equal(t, int64(10), count(t, "alice"))
+		assert.Equal(t, int64(0), count(t, "bob"))
+
+		res, err := repo.ThingByID(context.Background(), deleted, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.NotNil(t, res)
+
+		assert.ElementsMatch(t, before, vectorSearch(t))
+	})
+
+	t.Run("the restored shard accepts new objects", func(t *testing.T) {
+		id := put(t, 20, "carol")
+		assert.Equal(t, int64(1), count(t, "carol"))
+		assert.Contains(t, vectorSearch(t), id)
+		assert.Len(t, vectorSearch(t), 11)
+		assertNoRestoreFiles(t, dirName)
+	})
+
+	t.Run("a restore which was interrupted after it was committed", func(t *testing.T) {
+		shard := repo.GetIndex(kind.Thing, schema.ClassName(class.Class)).
+			Shards["single"]
+		committed := &bytes.Buffer{}
+		require.Nil(t, shard.snapshot(context.Background(), committed))
+		put(t, 21, "dave")
+
+		// the process stops after the marker has been written and only the
+		// shard file has been replaced
+		targets := shard.restoreTargets()
+		require.Nil(t, readSnapshot(context.Background(),
+			bytes.NewReader(committed.Bytes()), targets))
+		require.Nil(t, writeRestoreMarker(shard.restoreMarkerPath()))
+		require.Nil(t, replaceWithRestoreFiles(map[string]string{
+			snapshotEntryDB: targets[snapshotEntryDB],
+		}))
+		require.Nil(t, shard.vectorIndex.Shutdown())
+		require.Nil(t, shard.db.Close())
+
+		repo = New(logger, Config{RootPath: dirName})
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+
+		// the restore is completed on startup
+		assert.Equal(t, int64(1), count(t, "carol"))
+		assert.Equal(t, int64(0), count(t, "dave"))
+		assert.Len(t, vectorSearch(t), 11)
+		assertNoRestoreFiles(t, dirName)
+	})
+}
+
+func assertNoRestoreFiles(t *testing.T, dirName string) {
+	for _, suffix := range []string{restoreFileSuffix, previousFileSuffix,
+		restoreMarkerSuffix} {
+		files, err := filepath.Glob(filepath.Join(dirName, "*"+suffix))
+		require.Nil(t, err)
+		assert.Empty(t, files)
+	}
+}
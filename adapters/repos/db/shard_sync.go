@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+)
+
+// SyncPolicy controls when a shard's bolt file is fsynced to disk. It trades
+// durability for write throughput. A crash of only the weaviate process never
+// loses committed writes, as they have already been handed to the OS. What
+// can be lost below applies to a crash of the OS or a power loss:
+//
+// SyncAlways fsyncs every write transaction before it is acknowledged.
+// Nothing which was acknowledged can be lost. This is the default.
+//
+// SyncEveryN fsyncs only after every N-th write. The writes in between are
+// not fsynced at all and the OS may flush their pages in any order. A crash
+// before the next fsync can therefore lose more than the up to N-1
+// acknowledged writes since the last fsync: the whole file may be left
+// corrupted. Only suited for bulk imports into shards which can be rebuilt
+// from scratch.
+//
+// SyncNever never fsyncs explicitly and leaves flushing to the OS. Everything
+// written since the OS last flushed its page cache can be lost and the file
+// may be left corrupted. Only use it for data which can be fully rebuilt.
+type SyncPolicy string
+
+const (
+	SyncAlways SyncPolicy = "always"
+	SyncEveryN SyncPolicy = "everyN"
+	SyncNever  SyncPolicy = "never"
+)
+
+func (p SyncPolicy) noSync() bool {
+	return p == SyncEveryN || p == SyncNever
+}
+
+// openBolt opens the shard's bolt file with the configured BoltOptions and
+// applies the configured sync policy
+func (s *Shard) openBolt() (*bolt.DB, error) {
+	boltdb, err := bolt.Open(s.DBPath(), 0600, s.index.Config.BoltOptions.boltOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	boltdb.NoSync = s.index.Config.SyncPolicy.noSync()
+	return boltdb, nil
+}
+
+// afterWrite has to be called after every successful write transaction on the
+// shard's bolt db. With SyncEveryN it fsyncs the file once enough writes have
+// piled up.
+func (s *Shard) afterWrite() error {
+	if s.index.Config.SyncPolicy != SyncEveryN {
+		return nil
+	}
+
+	n := s.index.Config.SyncEveryN
+	if n < 1 {
+		n = 1
+	}
+
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+
+	s.unsyncedWrites++
+	if s.unsyncedWrites < n {
+		return nil
+	}
+
+	if err := s.db.Sync(); err != nil {
+		return errors.Wrap(err, "fsync shard db")
+	}
+
+	s.unsyncedWrites = 0
+	return nil
+}
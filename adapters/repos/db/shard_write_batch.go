@@ -22,10 +22,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
 )
 
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) map[int]error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
 	maxPerTransaction := 30
 
 	m := &sync.Mutex{}
@@ -44,13 +48,43 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 		go func(i int, batch []*storobj.Object) {
 			defer wg.Done()
 			var affectedIndices []int
+			var rejected map[int]error
+			span, _ := tracing.StartSpanFromContext(ctx, "db.putObjectInTx")
+			span.SetTag("objects", len(batch))
+			defer span.Finish()
+			expectedVersions := make([]int64, len(batch))
+			for j, object := range batch {
+				expectedVersions[j] = object.Version()
+			}
+
+			if err := ctx.Err(); err != nil {
+				m.Lock()
+				err = errors.Wrap(err, "put object batch")
+				for j := range batch {
+					errs[i+j] = err
+				}
+				m.Unlock()
+				return
+			}
+
 			if err := s.db.Batch(func(tx *bolt.Tx) error {
+				// bolt might run this func more than once
+				rejected = map[int]error{}
+
 				for j := range batch {
 					// so we can reference potential errors
 					affectedIndices = append(affectedIndices, i+j)
 				}
 
-				for _, object := range batch {
+				for j, object := range batch {
+					if err := s.validateVectorDimensions(object.Vector,
+						object.Class().String()); err != nil {
+						// nothing has been written for this object yet, so only this
+						// object fails instead of the whole tx
+						rejected[i+j] = err
+						continue
+					}
+
 					uuidParsed, err := uuid.Parse(object.ID().String())
 					if err != nil {
 						return errors.Wrap(err, "invalid id")
@@ -61,8 +95,17 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 						return err
 					}
 
+					object.SetVersion(expectedVersions[j])
 					status, err := s.putObjectInTx(tx, object, idBytes)
 					if err != nil {
+						switch err.(type) {
+						case kinds.ErrAlreadyExists, kinds.ErrVersionConflict,
+							kinds.ErrInvalidUserInput:
+							// nothing has been written for this object yet, so only this
+							// object fails instead of the whole tx
+							rejected[i+j] = err
+							continue
+						}
 						return err
 					}
 
@@ -78,7 +121,23 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 					errs[affected] = err
 				}
 				m.Unlock()
+				return
+			}
+
+			if err := s.afterWrite(); err != nil {
+				m.Lock()
+				for _, affected := range affectedIndices {
+					errs[affected] = err
+				}
+				m.Unlock()
+				return
+			}
+
+			m.Lock()
+			for index, err := range rejected {
+				errs[index] = err
 			}
+			m.Unlock()
 		}(i, batch)
 
 	}
@@ -98,6 +157,8 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 		go func(object *storobj.Object, docID int, index int) {
 			defer wg.Done()
 
+			span, _ := tracing.StartSpanFromContext(ctx, "db.vectorIndex.Add")
+			defer span.Finish()
 			if err := s.vectorIndex.Add(docID, object.Vector); err != nil {
 				m.Lock()
 				errs[index] = errors.Wrap(err, "insert to vector index")
@@ -113,6 +174,9 @@ func (s *Shard) putObjectBatch(ctx context.Context, objects []*storobj.Object) m
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) addReferencesBatch(ctx context.Context,
 	refs kinds.BatchReferences) map[int]error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
 	maxPerTransaction := 30
 
 	m := &sync.Mutex{}
@@ -130,13 +194,17 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 		go func(i int, batch kinds.BatchReferences) {
 			defer wg.Done()
 			var affectedIndices []int
+			var rejected map[int]error
 			if err := s.db.Batch(func(tx *bolt.Tx) error {
+				// bolt might run this func more than once
+				rejected = map[int]error{}
+
 				for j := range batch {
 					// so we can reference potential errors
 					affectedIndices = append(affectedIndices, i+j)
 				}
 
-				for _, ref := range batch {
+				for j, ref := range batch {
 					uuidParsed, err := uuid.Parse(ref.From.TargetID.String())
 					if err != nil {
 						return errors.Wrap(err, "invalid id")
@@ -150,6 +218,12 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 					mergeDoc := mergeDocFromBatchReference(ref)
 					_, err = s.mergeObjectInTx(tx, mergeDoc, idBytes)
 					if err != nil {
+						if _, ok := err.(kinds.ErrInvalidUserInput); ok {
+							// nothing has been written for this reference yet, so only
+							// this reference fails instead of the whole tx
+							rejected[i+j] = err
+							continue
+						}
 						return err
 					}
 
@@ -164,7 +238,23 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 					errs[affected] = err
 				}
 				m.Unlock()
+				return
+			}
+
+			if err := s.afterWrite(); err != nil {
+				m.Lock()
+				for _, affected := range affectedIndices {
+					errs[affected] = err
+				}
+				m.Unlock()
+				return
+			}
+
+			m.Lock()
+			for index, err := range rejected {
+				errs[index] = err
 			}
+			m.Unlock()
 		}(i, batch)
 
 	}
@@ -26,6 +26,9 @@ import (
 )
 
 func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -62,6 +65,16 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 			return errors.Wrap(err, "delete pointers from inverted index")
 		}
 
+		class, err := s.objectClass(oldObj)
+		if err != nil {
+			return errors.Wrap(err, "get class of object")
+		}
+
+		err = s.deleteFromUniqueIndex(tx, class, oldObj, docID)
+		if err != nil {
+			return errors.Wrap(err, "delete values from unique index")
+		}
+
 		err = bucket.Delete(idBytes)
 		if err != nil {
 			return errors.Wrap(err, "delete object from bucket")
@@ -77,6 +90,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "bolt batch tx")
 	}
 
+	if err := s.afterWrite(); err != nil {
+		return err
+	}
+
 	if err := s.vectorIndex.Delete(int(docID)); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
@@ -29,18 +29,20 @@ import (
 )
 
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, error) {
-	if object.Schema() == nil {
-		return nil, nil
+	analyzer := inverted.NewAnalyzer()
+
+	// the creation time is indexed regardless of the schema, so that objects
+	// without any properties can still be filtered and sorted by it
+	creationTime, err := analyzer.CreationTime(object.CreationTimeUnix())
+	if err != nil {
+		return nil, err
 	}
 
-	var schemaModel *models.Schema
-	if object.Kind == kind.Thing {
-		schemaModel = s.index.getSchema.GetSchemaSkipAuth().Things
-	} else {
-		schemaModel = s.index.getSchema.GetSchemaSkipAuth().Actions
+	if object.Schema() == nil {
+		return []inverted.Property{creationTime}, nil
 	}
 
-	c, err := schema.GetClassByName(schemaModel, object.Class().String())
+	c, err := s.objectClass(object)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +52,23 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, erro
 		return nil, fmt.Errorf("expected schema to be map, but got %T", object.Schema())
 	}
 
-	return inverted.NewAnalyzer().Object(schemaMap, c.Properties)
+	props, err := analyzer.Object(schemaMap, c.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(props, creationTime), nil
+}
+
+func (s *Shard) objectClass(object *storobj.Object) (*models.Class, error) {
+	var schemaModel *models.Schema
+	if object.Kind == kind.Thing {
+		schemaModel = s.index.getSchema.GetSchemaSkipAuth().Things
+	} else {
+		schemaModel = s.index.getSchema.GetSchemaSkipAuth().Actions
+	}
+
+	return schema.GetClassByName(schemaModel, object.Class().String())
 }
 
 func (s *Shard) extendInvertedIndices(tx *bolt.Tx, props []inverted.Property,
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
@@ -24,6 +25,15 @@ import (
 )
 
 func (s *Shard) mergeObject(ctx context.Context, merge kinds.MergeDocument) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
+	if merge.Vector != nil {
+		if err := s.validateVectorDimensions(merge.Vector, merge.Class); err != nil {
+			return err
+		}
+	}
+
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -43,7 +53,11 @@ func (s *Shard) mergeObject(ctx context.Context, merge kinds.MergeDocument) erro
 		return errors.Wrap(err, "bolt batch tx")
 	}
 
-	if err := s.updateVectorIndex(merge.Vector, status); err != nil {
+	if err := s.afterWrite(); err != nil {
+		return err
+	}
+
+	if err := s.updateVectorIndex(ctx, merge.Vector, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
@@ -60,6 +74,22 @@ func (s *Shard) mergeObjectInTx(tx *bolt.Tx, merge kinds.MergeDocument,
 		return objectInsertStatus{}, errors.Wrap(err, "merge object data")
 	}
 
+	version, err := nextVersion(merge.ID, nextObj.Version(), merge.ExpectedVersion)
+	if err != nil {
+		// not wrapped, so that callers can tell a conflict apart
+		return objectInsertStatus{}, err
+	}
+	nextObj.SetVersion(version)
+
+	// not wrapped, so that callers can tell a violation apart
+	if err := s.checkReferenceCounts(nextObj); err != nil {
+		return objectInsertStatus{}, err
+	}
+
+	if err := s.checkUniqueConstraints(tx, nextObj, previous); err != nil {
+		return objectInsertStatus{}, err
+	}
+
 	status, err := s.determineInsertStatus(previous, nextObj)
 	if err != nil {
 		return status, errors.Wrap(err, "check insert/update status")
@@ -86,6 +116,10 @@ func (s *Shard) mergeObjectInTx(tx *bolt.Tx, merge kinds.MergeDocument,
 		return status, errors.Wrap(err, "udpate inverted indices")
 	}
 
+	if err := s.updateUniqueIndex(tx, nextObj, status, previous); err != nil {
+		return status, errors.Wrap(err, "update unique indices")
+	}
+
 	return status, nil
 }
 
@@ -131,7 +165,11 @@ func mergeProps(previous *storobj.Object,
 		if !ok {
 			propParsed = models.MultipleRef{}
 		}
-		propParsed = append(propParsed, ref.To.SingleRef())
+		single := ref.To.SingleRef()
+		if merge.UniqueReferences && containsBeacon(propParsed, single.Beacon) {
+			continue
+		}
+		propParsed = append(propParsed, single)
 		schema[propName] = propParsed
 	}
 
@@ -143,3 +181,13 @@ func mergeProps(previous *storobj.Object,
 
 	return &next
 }
+
+func containsBeacon(refs models.MultipleRef, beacon strfmt.URI) bool {
+	for _, ref := range refs {
+		if ref.Beacon == beacon {
+			return true
+		}
+	}
+
+	return false
+}
@@ -16,24 +16,56 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
 )
 
-func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
+// putObject stores the object. If create is set, it is only stored if there
+// is no object with the same id in the shard yet.
+func (s *Shard) putObject(ctx context.Context, object *storobj.Object,
+	create bool) error {
+	s.writeLock.RLock()
+	defer s.writeLock.RUnlock()
+
+	// the request might have timed out while waiting for the write lock, there
+	// is no point in writing an object nobody is waiting for anymore
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "put object")
+	}
+
+	if err := s.validateVectorDimensions(object.Vector,
+		object.Class().String()); err != nil {
+		return err
+	}
+
 	idBytes, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
 
 	var status objectInsertStatus
+	expectedVersion := object.Version()
 
+	span, _ := tracing.StartSpanFromContext(ctx, "db.putObjectInTx")
 	if err := s.db.Batch(func(tx *bolt.Tx) error {
+		// bolt might run this func more than once, but putObjectInTx replaces
+		// the expected version with the next one
+		object.SetVersion(expectedVersion)
+
+		if create && tx.Bucket(helpers.ObjectsBucket).Get(idBytes) != nil {
+			// not wrapped, so that callers can tell a conflict apart
+			return kinds.NewErrAlreadyExists("id '%s' already exists", object.ID())
+		}
+
 		s, err := s.putObjectInTx(tx, object, idBytes)
 		if err != nil {
 			return err
@@ -42,17 +74,53 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		status = s
 		return nil
 	}); err != nil {
+		span.Finish()
 		return errors.Wrap(err, "bolt batch tx")
 	}
+	span.Finish()
+
+	if err := s.afterWrite(); err != nil {
+		return err
+	}
 
-	if err := s.updateVectorIndex(object.Vector, status); err != nil {
+	if err := s.updateVectorIndex(ctx, object.Vector, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
 	return nil
 }
 
-func (s *Shard) updateVectorIndex(vector []float32,
+// validateVectorDimensions makes sure the vector matches the dimensions of
+// the vectors already present in the shard's vector index. It must be called
+// before anything is written, so that a mismatched object leaves no trace in
+// either the object store or the vector index. As long as the vector index
+// has no dimensions, the first vector which is validated sets them.
+func (s *Shard) validateVectorDimensions(vector []float32,
+	className string) error {
+	expected := s.vectorIndex.Dimensions()
+	if expected == 0 {
+		atomic.CompareAndSwapInt32(&s.vectorDimensions, 0, int32(len(vector)))
+		expected = int(atomic.LoadInt32(&s.vectorDimensions))
+	}
+
+	if len(vector) != expected {
+		return kinds.NewErrInvalidUserInput("vector has %d dimensions, but "+
+			"the vectors of class %s have %d dimensions", len(vector), className,
+			expected)
+	}
+
+	return nil
+}
+
+// checkReferenceCounts makes sure no reference property of the object holds
+// more references than its maximum. It runs within the write tx, so that
+// concurrent writes to the same object can not exceed the maximum together.
+func (s *Shard) checkReferenceCounts(object *storobj.Object) error {
+	return kinds.CheckReferenceCounts(s.index.getSchema.GetSchemaSkipAuth(),
+		object.Kind, object.Class().String(), object.Schema())
+}
+
+func (s *Shard) updateVectorIndex(ctx context.Context, vector []float32,
 	status objectInsertStatus) error {
 
 	if status.isUpdate && !status.docIDChanged {
@@ -68,6 +136,8 @@ func (s *Shard) updateVectorIndex(vector []float32,
 
 	}
 
+	span, _ := tracing.StartSpanFromContext(ctx, "db.vectorIndex.Add")
+	defer span.Finish()
 	if err := s.vectorIndex.Add(int(status.docID), vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %q to vector index", status.docID)
 	}
@@ -80,6 +150,29 @@ func (s *Shard) putObjectInTx(tx *bolt.Tx, object *storobj.Object,
 	bucket := tx.Bucket(helpers.ObjectsBucket)
 	previous := bucket.Get([]byte(idBytes))
 
+	previousVersion, err := versionFromBinary(previous)
+	if err != nil {
+		return objectInsertStatus{}, err
+	}
+
+	// the version of the incoming object is the one the write is based on
+	version, err := nextVersion(object.ID(), previousVersion, object.Version())
+	if err != nil {
+		// not wrapped, so that callers can tell a conflict apart
+		return objectInsertStatus{}, err
+	}
+	object.SetVersion(version)
+
+	// not wrapped, so that callers can tell a violation apart
+	if err := s.checkReferenceCounts(object); err != nil {
+		return objectInsertStatus{}, err
+	}
+
+	// not wrapped, so that callers can tell a violation apart
+	if err := s.checkUniqueConstraints(tx, object, previous); err != nil {
+		return objectInsertStatus{}, err
+	}
+
 	status, err := s.determineInsertStatus(previous, object)
 	if err != nil {
 		return status, errors.Wrap(err, "check insert/update status")
@@ -103,9 +196,39 @@ func (s *Shard) putObjectInTx(tx *bolt.Tx, object *storobj.Object,
 		return status, errors.Wrap(err, "udpate inverted indices")
 	}
 
+	if err := s.updateUniqueIndex(tx, object, status, previous); err != nil {
+		return status, errors.Wrap(err, "update unique indices")
+	}
+
 	return status, nil
 }
 
+// versionFromBinary returns the version of a stored object, 0 if there is no
+// stored object
+func versionFromBinary(data []byte) (int64, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return 0, errors.Wrap(err, "unmarshal previous object")
+	}
+
+	return obj.Version(), nil
+}
+
+// nextVersion returns the version an object gets on a write. If expected is
+// set, the write only succeeds if the object still has the expected version.
+func nextVersion(id strfmt.UUID, current, expected int64) (int64, error) {
+	if expected != 0 && expected != current {
+		return 0, kinds.NewErrVersionConflict("object %s has been changed: expected "+
+			"version %d, but the current version is %d", id, expected, current)
+	}
+
+	return current + 1, nil
+}
+
 type objectInsertStatus struct {
 	docID        uint32
 	isUpdate     bool
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// startupProgress is shared between the goroutine loading the indexes and
+// WaitForStartup, so the latter can report how far loading has come
+type startupProgress struct {
+	sync.Mutex
+	total   int
+	loaded  int
+	current string
+}
+
+func (p *startupProgress) setTotal(total int) {
+	p.Lock()
+	defer p.Unlock()
+	p.total = total
+}
+
+func (p *startupProgress) start(current string) {
+	p.Lock()
+	defer p.Unlock()
+	p.current = current
+}
+
+func (p *startupProgress) finishIndex() {
+	p.Lock()
+	defer p.Unlock()
+	p.loaded++
+}
+
+func (p *startupProgress) state() (loaded, total int, current string) {
+	p.Lock()
+	defer p.Unlock()
+	return p.loaded, p.total, p.current
+}
+
+func (p *startupProgress) percent() int {
+	loaded, total, _ := p.state()
+	if total == 0 {
+		return 0
+	}
+
+	return loaded * 100 / total
+}
+
+// WaitForStartup loads all indexes from disk. The progress is logged
+// periodically while loading. If loading does not complete within
+// maxWaitTime, an error naming what is still being loaded is returned.
+func (d *DB) WaitForStartup(maxWaitTime time.Duration) error {
+	progress := &startupProgress{}
+	// cancelling stops a vector index recovery which is still running when
+	// the startup times out
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- d.init(ctx, progress)
+	}()
+
+	timeout := time.NewTimer(maxWaitTime)
+	defer timeout.Stop()
+	ticker := time.NewTicker(d.startupProgressInterval)
+	defer ticker.Stop()
+
+	d.logger.
+		WithField("action", "startup").
+		WithField("maxWaitTime", maxWaitTime).
+		Infof("loading indexes (maximum %s)", maxWaitTime)
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			loaded, total, current := progress.state()
+			d.logger.
+				WithField("action", "startup_index_loading").
+				WithField("loaded", loaded).
+				WithField("total", total).
+				WithField("current", current).
+				Infof("index loading, %d%% complete", progress.percent())
+		case <-timeout.C:
+			loaded, total, current := progress.state()
+			return fmt.Errorf("db didn't start up within %s: still waiting for %s "+
+				"(%d of %d indexes loaded)", maxWaitTime, current, loaded, total)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartup_ReportsProgress(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{
+		schema: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{
+					&models.Class{
+						Class: "StartupTestThing",
+						Properties: []*models.Property{
+							&models.Property{
+								Name:     "name",
+								DataType: []string{string(schema.DataTypeString)},
+							},
+						},
+					},
+				},
+			},
+			Actions: &models.Schema{
+				Classes: []*models.Class{
+					&models.Class{
+						Class: "StartupTestAction",
+						Properties: []*models.Property{
+							&models.Property{
+								Name:     "name",
+								DataType: []string{string(schema.DataTypeString)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("loading all indexes records the progress", func(t *testing.T) {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0777)
+		defer func() {
+			err := os.RemoveAll(dirName)
+			fmt.Println(err)
+		}()
+
+		repo := New(logger, Config{RootPath: dirName})
+		repo.SetSchemaGetter(schemaGetter)
+
+		progress := &startupProgress{}
+		require.Nil(t, repo.init(context.Background(), progress))
+
+		loaded, total, current := progress.state()
+		assert.Equal(t, 2, loaded)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, 100, progress.percent())
+		assert.Equal(t, `index "action_startuptestaction"`, current)
+	})
+
+	t.Run("waiting for startup with progress reporting", func(t *testing.T) {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0777)
+		defer func() {
+			err := os.RemoveAll(dirName)
+			fmt.Println(err)
+		}()
+
+		repo := New(logger, Config{RootPath: dirName})
+		repo.SetSchemaGetter(schemaGetter)
+		repo.startupProgressInterval = time.Millisecond
+
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		assert.NotNil(t, repo.GetIndex(kind.Thing, "StartupTestThing"))
+		assert.NotNil(t, repo.GetIndex(kind.Action, "StartupTestAction"))
+	})
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package storobj
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// intPropNames returns the sorted names of all top-level props of the schema
+// which hold an integer. Only int props are stored as integers, every other
+// number is a float64.
+func intPropNames(schema models.PropertySchema) []string {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for name, value := range schemaMap {
+		switch value.(type) {
+		case int, int32, int64:
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// unmarshalSchema decodes the listed int props as int64 without a detour
+// through float64 which can't represent integers above 2^53 exactly. All
+// other numbers are decoded as float64 just like with json.Unmarshal.
+func unmarshalSchema(data []byte, intProps []string) (map[string]interface{}, error) {
+	var schema map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&schema); err != nil {
+		return nil, err
+	}
+
+	isInt := make(map[string]bool, len(intProps))
+	for _, name := range intProps {
+		isInt[name] = true
+	}
+
+	for name, value := range schema {
+		if number, ok := value.(json.Number); ok && isInt[name] {
+			asInt, err := number.Int64()
+			if err != nil {
+				return nil, err
+			}
+
+			schema[name] = asInt
+			continue
+		}
+
+		converted, err := numbersToFloat(value)
+		if err != nil {
+			return nil, err
+		}
+
+		schema[name] = converted
+	}
+
+	return schema, nil
+}
+
+func numbersToFloat(value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case json.Number:
+		return typed.Float64()
+	case map[string]interface{}:
+		for key, elem := range typed {
+			converted, err := numbersToFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			typed[key] = converted
+		}
+		return typed, nil
+	case []interface{}:
+		for i, elem := range typed {
+			converted, err := numbersToFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = converted
+		}
+		return typed, nil
+	default:
+		return value, nil
+	}
+}
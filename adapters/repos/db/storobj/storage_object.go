@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/go-openapi/strfmt"
@@ -86,6 +87,12 @@ func (ko *Object) SetIndexID(id uint32) {
 	ko.indexID = id
 }
 
+// IndexID is the doc id under which the vector of the object is stored in
+// the vector index
+func (ko *Object) IndexID() uint32 {
+	return ko.indexID
+}
+
 func (ko *Object) CreationTimeUnix() int64 {
 	switch ko.Kind {
 	case kind.Thing:
@@ -139,6 +146,49 @@ func (ko *Object) LastUpdateTimeUnix() int64 {
 		panic("impossible kind")
 	}
 }
+
+// Version is incremented on every change of the object. It is 0 for objects
+// which were stored before versions were introduced.
+func (ko *Object) Version() int64 {
+	switch ko.Kind {
+	case kind.Thing:
+		return ko.Thing.Version
+	case kind.Action:
+		return ko.Action.Version
+	default:
+		panic("impossible kind")
+	}
+}
+
+func (ko *Object) SetVersion(version int64) {
+	switch ko.Kind {
+	case kind.Thing:
+		ko.Thing.Version = version
+	case kind.Action:
+		ko.Action.Version = version
+	default:
+		panic("impossible kind")
+	}
+}
+
+// ExpiryTimeUnix is 0 for objects which never expire
+func (ko *Object) ExpiryTimeUnix() int64 {
+	switch ko.Kind {
+	case kind.Thing:
+		return ko.Thing.ExpiryTimeUnix
+	case kind.Action:
+		return ko.Action.ExpiryTimeUnix
+	default:
+		panic("impossible kind")
+	}
+}
+
+// Expired is true if the object has an expiry time which is not after now
+// (milliseconds since epoch)
+func (ko *Object) Expired(now int64) bool {
+	expiry := ko.ExpiryTimeUnix()
+	return expiry != 0 && expiry <= now
+}
 func (ko *Object) Meta() *models.UnderscoreProperties {
 	return ko.UnderscoreProperties()
 }
@@ -203,6 +253,8 @@ func (ko *Object) SearchResult() *search.Result {
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
+		Expiry:               ko.ExpiryTimeUnix(),
+		Version:              ko.Version(),
 		UnderscoreProperties: ko.UnderscoreProperties(),
 		Score:                1, // TODO: actuallly score
 		// TODO: Beacon?
@@ -242,6 +294,62 @@ func DocIDFromBinary(in []byte) (uint32, error) {
 	return indexID, err
 }
 
+// ExpiryFromBinary reads only the expiry time of a marshalled object, none of
+// the rest is decoded. It is 0 for objects which never expire, which includes
+// older objects without an expiry time.
+func ExpiryFromBinary(in []byte) (int64, error) {
+	var version uint8
+	r := bytes.NewReader(in)
+	le := binary.LittleEndian
+	if err := binary.Read(r, le, &version); err != nil {
+		return 0, err
+	}
+
+	if version != 1 {
+		return 0, fmt.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	// index id, kind, uuid, create and update time
+	if _, err := r.Seek(4+1+16+8+8, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+
+	var vectorLength uint16
+	if err := binary.Read(r, le, &vectorLength); err != nil {
+		return 0, err
+	}
+	if _, err := r.Seek(int64(vectorLength)*4, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+
+	var classNameLength uint16
+	if err := binary.Read(r, le, &classNameLength); err != nil {
+		return 0, err
+	}
+	if _, err := r.Seek(int64(classNameLength), io.SeekCurrent); err != nil {
+		return 0, err
+	}
+
+	// schema, meta and vector weights
+	for i := 0; i < 3; i++ {
+		var length uint32
+		if err := binary.Read(r, le, &length); err != nil {
+			return 0, err
+		}
+		if _, err := r.Seek(int64(length), io.SeekCurrent); err != nil {
+			return 0, err
+		}
+	}
+
+	if r.Len() < 8 {
+		return 0, nil
+	}
+
+	var expiry int64
+	err := binary.Read(r, le, &expiry)
+	return expiry, err
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -265,6 +373,15 @@ func DocIDFromBinary(in []byte) (uint32, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 8          | int64     | expiry time, 0 = never, absent in older objects
+// 2          | uint16    | no. of int props, absent in older objects
+// n*(2+m)    | []string  | per int prop: uint16 length m, followed by name
+// 8          | int64     | version, absent in older objects
+//
+// Numbers in the schema json are decoded as float64, except for the listed
+// int props which are decoded as int64, so that they survive a round trip
+// without losing precision. Objects without the list decode every number as
+// float64.
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, fmt.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -301,6 +418,7 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	intProps := intPropNames(ko.Schema())
 
 	ec := &errorCompounder{}
 	buf := bytes.NewBuffer(nil)
@@ -326,6 +444,14 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	ec.add(binary.Write(buf, le, vectorWeightsLength))
 	_, err = buf.Write(vectorWeights)
 	ec.add(err)
+	ec.add(binary.Write(buf, le, ko.ExpiryTimeUnix()))
+	ec.add(binary.Write(buf, le, uint16(len(intProps))))
+	for _, name := range intProps {
+		ec.add(binary.Write(buf, le, uint16(len(name))))
+		_, err = buf.WriteString(name)
+		ec.add(err)
+	}
+	ec.add(binary.Write(buf, le, ko.Version()))
 
 	return buf.Bytes(), ec.toError()
 }
@@ -351,6 +477,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		uuidBytes           = make([]byte, 16)
 		createTime          int64
 		updateTime          int64
+		expiryTime          int64
 		vectorLength        uint16
 		classNameLength     uint16
 		schemaLength        uint32
@@ -384,6 +511,29 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	vectorWeights := make([]byte, vectorWeightsLength)
 	_, err = r.Read(vectorWeights)
 	ec.add(err)
+	if r.Len() >= 8 {
+		ec.add(binary.Read(r, le, &expiryTime))
+	}
+
+	var intProps []string
+	if r.Len() >= 2 {
+		var intPropsCount uint16
+		ec.add(binary.Read(r, le, &intPropsCount))
+		intProps = make([]string, intPropsCount)
+		for i := range intProps {
+			var nameLength uint16
+			ec.add(binary.Read(r, le, &nameLength))
+			name := make([]byte, nameLength)
+			_, err = r.Read(name)
+			ec.add(err)
+			intProps[i] = string(name)
+		}
+	}
+
+	var objectVersion int64
+	if r.Len() >= 8 {
+		ec.add(binary.Read(r, le, &objectVersion))
+	}
 
 	if ec.toError() != nil {
 		return err
@@ -400,22 +550,29 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		ko.Kind = kind.Action
 	}
 
-	return ko.parseKind(
+	if err := ko.parseKind(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
 		updateTime,
+		expiryTime,
 		string(className),
 		schema,
 		meta,
 		vectorWeights,
-	)
+		intProps,
+	); err != nil {
+		return err
+	}
+
+	ko.SetVersion(objectVersion)
+	return nil
 }
 
-func (ko *Object) parseKind(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, underscoreB []byte, vectorWeightsB []byte) error {
+func (ko *Object) parseKind(uuid strfmt.UUID, create, update, expiry int64, className string,
+	schemaB []byte, underscoreB []byte, vectorWeightsB []byte, intProps []string) error {
 
-	var schema map[string]interface{}
-	if err := json.Unmarshal(schemaB, &schema); err != nil {
+	schema, err := unmarshalSchema(schemaB, intProps)
+	if err != nil {
 		return err
 	}
 
@@ -439,6 +596,7 @@ func (ko *Object) parseKind(uuid strfmt.UUID, create, update int64, className st
 			Class:              className,
 			CreationTimeUnix:   create,
 			LastUpdateTimeUnix: update,
+			ExpiryTimeUnix:     expiry,
 			ID:                 uuid,
 			Schema:             schema,
 			Meta:               underscore,
@@ -449,6 +607,7 @@ func (ko *Object) parseKind(uuid strfmt.UUID, create, update int64, className st
 			Class:              className,
 			CreationTimeUnix:   create,
 			LastUpdateTimeUnix: update,
+			ExpiryTimeUnix:     expiry,
 			ID:                 uuid,
 			Schema:             schema,
 			Meta:               underscore,
@@ -61,6 +61,126 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectExpiry(t *testing.T) {
+	before := FromAction(
+		&models.Action{
+			Class:            "MyFavoriteClass",
+			CreationTimeUnix: 123456,
+			ExpiryTimeUnix:   223456,
+			ID:               strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Schema:           map[string]interface{}{},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("the expiry time survives marshalling", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(223456), after.ExpiryTimeUnix())
+		assert.False(t, after.Expired(223455))
+		assert.True(t, after.Expired(223456))
+	})
+
+	t.Run("the expiry time can be read on its own", func(t *testing.T) {
+		expiry, err := ExpiryFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(223456), expiry)
+	})
+
+	t.Run("objects stored without an expiry time never expire", func(t *testing.T) {
+		// without the expiry time, the (empty) list of int props and the version
+		legacy := asBinary[:len(asBinary)-18]
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), after.ExpiryTimeUnix())
+		assert.False(t, after.Expired(223456))
+
+		expiry, err := ExpiryFromBinary(legacy)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), expiry)
+	})
+}
+
+func TestStorageObjectIntPrecision(t *testing.T) {
+	before := FromThing(
+		&models.Thing{
+			Class:            "MyFavoriteClass",
+			CreationTimeUnix: 123456,
+			ID:               strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Schema: map[string]interface{}{
+				"bigInt": int64(9007199254740993),
+				"number": float64(9007199254740993),
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(1.5),
+					Longitude: ptFloat32(2.5),
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("int props keep their exact value", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		schema := after.Schema().(map[string]interface{})
+		assert.Equal(t, int64(9007199254740993), schema["bigInt"])
+		assert.Equal(t, float64(9007199254740992), schema["number"],
+			"other numbers are still float64")
+		assert.Equal(t, &models.GeoCoordinates{
+			Latitude:  ptFloat32(1.5),
+			Longitude: ptFloat32(2.5),
+		}, schema["location"])
+	})
+
+	t.Run("objects stored without the list of int props", func(t *testing.T) {
+		// the list of int props is "bigInt" only: 2 bytes count, 2 bytes length
+		// and 6 bytes name, followed by the 8 bytes version
+		legacy := asBinary[:len(asBinary)-18]
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+
+		schema := after.Schema().(map[string]interface{})
+		assert.Equal(t, float64(9007199254740992), schema["bigInt"])
+	})
+}
+
+func TestStorageObjectVersion(t *testing.T) {
+	before := FromThing(
+		&models.Thing{
+			Class:            "MyFavoriteClass",
+			CreationTimeUnix: 123456,
+			ID:               strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Schema:           map[string]interface{}{},
+			Version:          7,
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("the version survives marshalling", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(7), after.Version())
+		assert.Equal(t, int64(7), after.SearchResult().Version)
+	})
+
+	t.Run("objects stored without a version", func(t *testing.T) {
+		legacy := asBinary[:len(asBinary)-8]
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), after.Version())
+	})
+}
+
 func TestNewStorageObject(t *testing.T) {
 	t.Run("things", func(t *testing.T) {
 		so := New(kind.Thing, 12)
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncPolicies(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "SyncPolicyThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("3b2b5c8a-8d27-4fd6-a3bc-1c7e6f%06d", i))
+	}
+
+	startRepo := func(t *testing.T, dirName string, config Config) *DB {
+		config.RootPath = dirName
+		schemaGetter := &fakeSchemaGetter{}
+		repo := New(logger, config)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+
+		if repo.GetIndex(kind.Thing, schema.ClassName(thingclass.Class)) == nil {
+			require.Nil(t, NewMigrator(repo).AddClass(context.Background(),
+				kind.Thing, thingclass))
+		}
+		schemaGetter.schema = schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{thingclass},
+			},
+		}
+		return repo
+	}
+
+	shardOf := func(repo *DB) *Shard {
+		return repo.GetIndex(kind.Thing, schema.ClassName(thingclass.Class)).
+			Shards["single"]
+	}
+
+	// crash simulates a kill of the weaviate process: the files are copied
+	// while the repo is still running, so that nothing a shutdown would do
+	// gets the chance to run. The copy holds exactly what the process had
+	// handed to the OS at that point. An OS crash or a power loss can't be
+	// simulated, see SyncPolicy for what they can lose.
+	crash := func(t *testing.T, dirName string) string {
+		crashDir := dirName + "_crashed"
+		require.Nil(t, os.MkdirAll(crashDir, 0777))
+
+		files, err := ioutil.ReadDir(dirName)
+		require.Nil(t, err)
+		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
+
+			content, err := ioutil.ReadFile(filepath.Join(dirName, file.Name()))
+			require.Nil(t, err)
+			require.Nil(t, ioutil.WriteFile(filepath.Join(crashDir, file.Name()),
+				content, 0600))
+		}
+
+		return crashDir
+	}
+
+	tests := []struct {
+		name           string
+		config         Config
+		expectedNoSync bool
+		// expectedUnsynced is the amount of acknowledged writes which would be
+		// lost on an OS crash or power loss right after the import
+		expectedUnsynced int
+	}{
+		{
+			name:             "default policy",
+			config:           Config{},
+			expectedNoSync:   false,
+			expectedUnsynced: 0,
+		},
+		{
+			name:             "always",
+			config:           Config{SyncPolicy: SyncAlways},
+			expectedNoSync:   false,
+			expectedUnsynced: 0,
+		},
+		{
+			name:             "every 3 writes",
+			config:           Config{SyncPolicy: SyncEveryN, SyncEveryN: 3},
+			expectedNoSync:   true,
+			expectedUnsynced: 1, // 1 add property + 6 puts = 7 writes, 7 % 3
+		},
+		{
+			name:             "never",
+			config:           Config{SyncPolicy: SyncNever},
+			expectedNoSync:   true,
+			expectedUnsynced: 0, // not tracked, the window is unbounded
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+			os.MkdirAll(dirName, 0777)
+			defer func() {
+				err := os.RemoveAll(dirName)
+				fmt.Println(err)
+			}()
+
+			repo := startRepo(t, dirName, test.config)
+			shard := shardOf(repo)
+			assert.Equal(t, test.expectedNoSync, shard.db.NoSync)
+
+			for i := 0; i < 6; i++ {
+				err := repo.PutThing(context.Background(), &models.Thing{
+					ID:     id(i),
+					Class:  thingclass.Class,
+					Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+				}, []float32{1, 2, float32(i)})
+				require.Nil(t, err)
+			}
+
+			assert.Equal(t, test.expectedUnsynced, shard.unsyncedWrites)
+
+			crashDir := crash(t, dirName)
+			defer os.RemoveAll(crashDir)
+			defer shard.db.Close()
+
+			t.Run("all acknowledged writes survive a process crash", func(t *testing.T) {
+				restarted := startRepo(t, crashDir, test.config)
+				defer shardOf(restarted).db.Close()
+
+				for i := 0; i < 6; i++ {
+					res, err := restarted.ThingByID(context.Background(), id(i),
+						traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+					require.Nil(t, err)
+					require.NotNil(t, res, "thing %d must survive the crash", i)
+				}
+			})
+		})
+	}
+}
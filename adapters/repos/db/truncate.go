@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// TruncateClass removes all objects of the class, but keeps the index with
+// its configuration, so that new objects can be imported right away. It
+// returns the number of objects which were removed.
+func (d *DB) TruncateClass(ctx context.Context, k kind.Kind,
+	className string) (int64, error) {
+	idx := d.GetIndex(k, schema.ClassName(className))
+	if idx == nil {
+		return 0, fmt.Errorf("truncate non-existing index for %s/%s", k, className)
+	}
+
+	var count int64
+	for _, shard := range idx.Shards {
+		res, err := shard.truncate(ctx)
+		if err != nil {
+			return count, errors.Wrapf(err, "truncate shard %s", shard.ID())
+		}
+
+		count += res
+	}
+
+	return count, nil
+}
+
+// truncate empties every bucket of the shard in a single transaction and
+// resets the vector index. The buckets themselves are recreated, so that the
+// properties of the class remain indexable. Writes to the shard wait until
+// the truncation is done.
+func (s *Shard) truncate(ctx context.Context) (int64, error) {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	var count int64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		count = int64(tx.Bucket(helpers.ObjectsBucket).Stats().KeyN)
+
+		var names [][]byte
+		if err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			// names are only valid for the lifetime of the transaction
+			names = append(names, append([]byte{}, name...))
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if err := tx.DeleteBucket(name); err != nil {
+				return errors.Wrapf(err, "delete bucket %q", string(name))
+			}
+
+			if _, err := tx.CreateBucket(name); err != nil {
+				return errors.Wrapf(err, "recreate bucket %q", string(name))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "bolt update tx")
+	}
+
+	if err := s.vectorIndex.Reset(); err != nil {
+		return 0, errors.Wrap(err, "reset vector index")
+	}
+	atomic.StoreInt32(&s.vectorDimensions, 0)
+
+	return count, s.afterWrite()
+}
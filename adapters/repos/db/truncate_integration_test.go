@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateClass(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	truncated := &models.Class{
+		Class: "TruncatedClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	untouched := &models.Class{
+		Class: "UntouchedClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, truncated))
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, untouched))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{truncated, untouched},
+		},
+	}
+
+	total := 20
+	ids := make([]strfmt.UUID, total)
+	nameFilter := func(className, name string) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: "name",
+				},
+				Value: &filters.Value{
+					Value: name,
+					Type:  schema.DataTypeString,
+				},
+			},
+		}
+	}
+
+	t.Run("importing things", func(t *testing.T) {
+		for i := range ids {
+			ids[i] = strfmt.UUID(fmt.Sprintf("7b2c4e1a-3d5f-4a6b-8c9d-%012d", i))
+			for _, className := range []string{truncated.Class, untouched.Class} {
+				id := ids[i]
+				if className == untouched.Class {
+					id = strfmt.UUID(fmt.Sprintf("7b2c4e1a-3d5f-4a6b-8c9e-%012d", i))
+				}
+
+				err := repo.PutThing(context.Background(), &models.Thing{
+					ID:     id,
+					Class:  className,
+					Schema: map[string]interface{}{"name": "alice"},
+				}, []float32{1, 0, 0})
+				require.Nil(t, err)
+			}
+		}
+	})
+
+	t.Run("truncating a class that doesn't exist", func(t *testing.T) {
+		_, err := repo.TruncateClass(context.Background(), kind.Thing, "NotThere")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("truncating the class", func(t *testing.T) {
+		count, err := repo.TruncateClass(context.Background(), kind.Thing, truncated.Class)
+		require.Nil(t, err)
+		assert.Equal(t, int64(total), count)
+	})
+
+	t.Run("the truncated class is empty", func(t *testing.T) {
+		count, err := repo.Count(context.Background(), kind.Thing, truncated.Class, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), count)
+
+		res, err := repo.ThingByID(context.Background(), ids[0], nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		count, err = repo.Count(context.Background(), kind.Thing, truncated.Class,
+			nameFilter(truncated.Class, "alice"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), count)
+
+		found, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			SearchVector: []float32{1, 0, 0},
+			Kind:         kind.Thing,
+			ClassName:    truncated.Class,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		assert.Len(t, found, 0)
+	})
+
+	t.Run("the other class is untouched", func(t *testing.T) {
+		count, err := repo.Count(context.Background(), kind.Thing, untouched.Class,
+			nameFilter(untouched.Class, "alice"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(total), count)
+	})
+
+	t.Run("the truncated class accepts new objects", func(t *testing.T) {
+		// the vector index was reset, so vectors of a different length are
+		// accepted as well
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     ids[0],
+			Class:  truncated.Class,
+			Schema: map[string]interface{}{"name": "bob"},
+		}, []float32{0, 1})
+		require.Nil(t, err)
+
+		count, err := repo.Count(context.Background(), kind.Thing, truncated.Class,
+			nameFilter(truncated.Class, "bob"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), count)
+
+		found, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			SearchVector: []float32{0, 1},
+			Kind:         kind.Thing,
+			ClassName:    truncated.Class,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, ids[0], found[0].ID)
+	})
+}
@@ -0,0 +1,315 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// uniqueValue is the value of a property with a unique constraint. data is
+// used as the key in the value->docID lookup of the property.
+type uniqueValue struct {
+	prop    string
+	data    []byte
+	display interface{}
+}
+
+func hasUniqueProps(class *models.Class) bool {
+	for _, prop := range class.Properties {
+		if prop.Unique {
+			return true
+		}
+	}
+
+	return false
+}
+
+func uniqueValues(class *models.Class, object *storobj.Object) ([]uniqueValue, error) {
+	if object.Schema() == nil {
+		return nil, nil
+	}
+
+	schemaMap, ok := object.Schema().(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected schema to be map, but got %T", object.Schema())
+	}
+
+	var out []uniqueValue
+	for _, prop := range class.Properties {
+		if !prop.Unique {
+			continue
+		}
+
+		value, ok := schemaMap[prop.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		data, display, err := uniqueValueData(schema.DataType(prop.DataType[0]), value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unique prop %q", prop.Name)
+		}
+
+		out = append(out, uniqueValue{prop: prop.Name, data: data, display: display})
+	}
+
+	return out, nil
+}
+
+// uniqueValueData turns the value into a comparable byte slice. Values which
+// have been read back from disk have a different type than the ones of a new
+// object, e.g. ints become float64 and dates become strings, so both are
+// accepted.
+func uniqueValueData(dataType schema.DataType, value interface{}) ([]byte, interface{}, error) {
+	switch dataType {
+	case schema.DataTypeString, schema.DataTypeText:
+		if asString, ok := value.(string); ok {
+			return []byte(asString), asString, nil
+		}
+	case schema.DataTypeInt:
+		switch v := value.(type) {
+		case int64:
+			data, err := inverted.LexicographicallySortableInt64(v)
+			return data, v, err
+		case float64:
+			data, err := inverted.LexicographicallySortableInt64(int64(v))
+			return data, int64(v), err
+		}
+	case schema.DataTypeNumber:
+		if asFloat, ok := value.(float64); ok {
+			data, err := inverted.LexicographicallySortableFloat64(asFloat)
+			return data, asFloat, err
+		}
+	case schema.DataTypeDate:
+		var date time.Time
+		switch v := value.(type) {
+		case time.Time:
+			date = v
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "parse date")
+			}
+			date = parsed
+		default:
+			return nil, nil, fmt.Errorf("unexpected type %T for data type %s", value, dataType)
+		}
+
+		data, err := inverted.LexicographicallySortableInt64(date.UnixNano())
+		return data, date.Format(time.RFC3339), err
+	default:
+		return nil, nil, fmt.Errorf("unique is not supported for data type %s", dataType)
+	}
+
+	return nil, nil, fmt.Errorf("unexpected type %T for data type %s", value, dataType)
+}
+
+func uniqueViolation(value uniqueValue) error {
+	return kinds.NewErrAlreadyExists("property '%s' must be unique, but another object "+
+		"already has the value '%v'", value.prop, value.display)
+}
+
+// checkUniqueConstraints must be called before anything is written for the
+// object, so that a violation does not leave partial writes behind in a tx
+// which is shared with other objects. previous is the stored version of the
+// object if it exists, an update may of course keep its own values.
+func (s *Shard) checkUniqueConstraints(tx *bolt.Tx, object *storobj.Object,
+	previous []byte) error {
+	class, err := s.objectClass(object)
+	if err != nil {
+		return err
+	}
+
+	if !hasUniqueProps(class) {
+		return nil
+	}
+
+	values, err := uniqueValues(class, object)
+	if err != nil {
+		return err
+	}
+
+	var ownDocID *uint32
+	if previous != nil {
+		docID, err := storobj.DocIDFromBinary(previous)
+		if err != nil {
+			return errors.Wrap(err, "get previous doc id from object binary")
+		}
+		ownDocID = &docID
+	}
+
+	for _, value := range values {
+		b := tx.Bucket(helpers.UniqueBucketFromPropName(value.prop))
+		if b == nil {
+			return fmt.Errorf("no unique bucket for prop '%s' found", value.prop)
+		}
+
+		owner := b.Get(value.data)
+		if owner == nil {
+			continue
+		}
+
+		if ownDocID != nil && binary.LittleEndian.Uint32(owner) == *ownDocID {
+			continue
+		}
+
+		return uniqueViolation(value)
+	}
+
+	return nil
+}
+
+func (s *Shard) updateUniqueIndex(tx *bolt.Tx, object *storobj.Object,
+	status objectInsertStatus, previous []byte) error {
+	class, err := s.objectClass(object)
+	if err != nil {
+		return err
+	}
+
+	if !hasUniqueProps(class) {
+		return nil
+	}
+
+	if status.isUpdate {
+		previousObject, err := storobj.FromBinary(previous)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal previous object")
+		}
+
+		if err := s.deleteFromUniqueIndex(tx, class, previousObject,
+			status.oldDocID); err != nil {
+			return err
+		}
+	}
+
+	values, err := uniqueValues(class, object)
+	if err != nil {
+		return err
+	}
+
+	keyBuf := bytes.NewBuffer(nil)
+	binary.Write(keyBuf, binary.LittleEndian, &status.docID)
+	docID := keyBuf.Bytes()
+
+	for _, value := range values {
+		b := tx.Bucket(helpers.UniqueBucketFromPropName(value.prop))
+		if b == nil {
+			return fmt.Errorf("no unique bucket for prop '%s' found", value.prop)
+		}
+
+		if err := b.Put(value.data, docID); err != nil {
+			return errors.Wrapf(err, "store unique value of prop '%s'", value.prop)
+		}
+	}
+
+	return nil
+}
+
+// deleteFromUniqueIndex removes the values of the object, unless they are
+// owned by another doc id
+func (s *Shard) deleteFromUniqueIndex(tx *bolt.Tx, class *models.Class,
+	object *storobj.Object, docID uint32) error {
+	values, err := uniqueValues(class, object)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		b := tx.Bucket(helpers.UniqueBucketFromPropName(value.prop))
+		if b == nil {
+			return fmt.Errorf("no unique bucket for prop '%s' found", value.prop)
+		}
+
+		owner := b.Get(value.data)
+		if owner == nil || binary.LittleEndian.Uint32(owner) != docID {
+			continue
+		}
+
+		if err := b.Delete(value.data); err != nil {
+			return errors.Wrapf(err, "delete unique value of prop '%s'", value.prop)
+		}
+	}
+
+	return nil
+}
+
+// uniqueCollisionsInBatch finds objects within the same batch which have the
+// same value for a unique property. Neither of them can be imported, as there
+// is no way to tell which one is supposed to win. The returned errors are
+// keyed by the position in the batch.
+func (i *Index) uniqueCollisionsInBatch(objects []*storobj.Object) map[int]error {
+	errs := map[int]error{}
+
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.Kind, i.Config.ClassName)
+	if class == nil || !hasUniqueProps(class) {
+		return errs
+	}
+
+	type occurrence struct {
+		pos   int
+		id    strfmt.UUID
+		value uniqueValue
+	}
+
+	byValue := map[string][]occurrence{}
+	for pos, object := range objects {
+		values, err := uniqueValues(class, object)
+		if err != nil {
+			errs[pos] = err
+			continue
+		}
+
+		for _, value := range values {
+			key := value.prop + "/" + string(value.data)
+			byValue[key] = append(byValue[key], occurrence{pos, object.ID(), value})
+		}
+	}
+
+	for _, occurrences := range byValue {
+		for _, occ := range occurrences {
+			var others []string
+			for _, other := range occurrences {
+				// the same object twice in a batch is an update, not a collision
+				if other.id != occ.id {
+					others = append(others, other.id.String())
+				}
+			}
+
+			if len(others) == 0 || errs[occ.pos] != nil {
+				continue
+			}
+
+			sort.Strings(others)
+			errs[occ.pos] = kinds.NewErrAlreadyExists("property '%s' must be unique, "+
+				"but the value '%v' is also used by %s in the same batch", occ.value.prop,
+				occ.value.display, strings.Join(others, ", "))
+		}
+	}
+
+	return errs
+}
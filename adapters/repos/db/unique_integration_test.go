@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	libschema "github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniqueProperties(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	class := &models.Class{
+		Class: "UniqueTestClass",
+		Properties: []*models.Property{
+			{
+				Name:     "email",
+				DataType: []string{string(libschema.DataTypeString)},
+				Unique:   true,
+			},
+			{
+				Name:     "name",
+				DataType: []string{string(libschema.DataTypeString)},
+			},
+		},
+	}
+
+	t.Run("add schema", func(t *testing.T) {
+		err := migrator.AddClass(context.Background(), kind.Thing, class)
+		require.Nil(t, err)
+	})
+	schemaGetter.schema = libschema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	thing := func(id strfmt.UUID, email string) *models.Thing {
+		return &models.Thing{
+			ID:     id,
+			Class:  "UniqueTestClass",
+			Schema: map[string]interface{}{"email": email, "name": "some name"},
+		}
+	}
+
+	id1 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c001")
+	id2 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c002")
+	id3 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c003")
+	id4 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c004")
+	id5 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c005")
+	id6 := strfmt.UUID("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c006")
+	// every object keeps its vector on updates, so that its doc id does not
+	// change
+	vectors := map[strfmt.UUID][]float32{}
+	vector := func(id strfmt.UUID) []float32 {
+		if _, ok := vectors[id]; !ok {
+			vectors[id] = []float32{rand.Float32(), rand.Float32(), rand.Float32()}
+		}
+		return vectors[id]
+	}
+
+	t.Run("importing an object without a value", func(t *testing.T) {
+		// also serves as a stable entrypoint of the vector index
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     "7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c000",
+			Class:  "UniqueTestClass",
+			Schema: map[string]interface{}{"name": "no email"},
+		}, vector("7a4c4c1c-4d36-4d5e-8f3a-1fd3b8d2c000"))
+		require.Nil(t, err)
+	})
+
+	t.Run("importing an object with a new value", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id1, "alice@example.com"), vector(id1))
+		require.Nil(t, err)
+	})
+
+	t.Run("importing another object with the same value", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id2, "alice@example.com"), vector(id2))
+		assert.IsType(t, kinds.ErrAlreadyExists{}, err)
+	})
+
+	t.Run("updating the owner while keeping the value", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id1, "alice@example.com"), vector(id1))
+		require.Nil(t, err)
+	})
+
+	t.Run("updating the owner to a new value frees the old one", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id1, "alice@example.org"), vector(id1))
+		require.Nil(t, err)
+
+		err = repo.PutThing(context.Background(), thing(id2, "alice@example.com"), vector(id2))
+		require.Nil(t, err)
+	})
+
+	t.Run("merging a taken value into another object", func(t *testing.T) {
+		err := repo.Merge(context.Background(), kinds.MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "UniqueTestClass",
+			ID:              id2,
+			PrimitiveSchema: map[string]interface{}{"email": "alice@example.org"},
+			Vector:          vector(id2),
+		})
+		assert.IsType(t, kinds.ErrAlreadyExists{}, err)
+	})
+
+	t.Run("deleting the owner frees the value", func(t *testing.T) {
+		err := repo.DeleteThing(context.Background(), "UniqueTestClass", id1)
+		require.Nil(t, err)
+
+		err = repo.PutThing(context.Background(), thing(id3, "alice@example.org"), vector(id3))
+		require.Nil(t, err)
+	})
+
+	t.Run("batch importing with conflicts", func(t *testing.T) {
+		batch := kinds.BatchThings{
+			// conflicts with an existing object
+			{OriginalIndex: 0, UUID: id4, Thing: thing(id4, "alice@example.com"), Vector: vector(id4)},
+			// conflicts with each other
+			{OriginalIndex: 1, UUID: id6, Thing: thing(id6, "bob@example.com"), Vector: vector(id6)},
+			{OriginalIndex: 2, UUID: id5, Thing: thing(id5, "bob@example.com"), Vector: vector(id5)},
+			// valid
+			{OriginalIndex: 3, UUID: id1, Thing: thing(id1, "carol@example.com"), Vector: vector(id1)},
+		}
+
+		res, err := repo.BatchPutThings(context.Background(), batch)
+		require.Nil(t, err)
+
+		assert.IsType(t, kinds.ErrAlreadyExists{}, res[0].Err)
+		require.NotNil(t, res[1].Err)
+		assert.Contains(t, res[1].Err.Error(), id5.String())
+		require.NotNil(t, res[2].Err)
+		assert.Contains(t, res[2].Err.Error(), id6.String())
+		assert.Nil(t, res[3].Err)
+
+		imported, err := repo.ThingByID(context.Background(), id1, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, imported)
+
+		rejected, err := repo.ThingByID(context.Background(), id5, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, rejected)
+	})
+}
@@ -19,18 +19,23 @@ import (
 	"os"
 )
 
+// CommitLogFileName is the path of the commit log of the index with the
+// given name.
+//
 // TODO: adjust file path, it needs to contain timestamps. Possibly use a
 // directory as helpers
-func commitLogFileName(rootPath, name string) string {
+func CommitLogFileName(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog", rootPath, name)
 }
 
 func NewCommitLogger(rootPath, name string) *hnswCommitLogger {
 	l := &hnswCommitLogger{
-		events: make(chan []byte),
+		events:    make(chan []byte),
+		flushes:   make(chan chan error),
+		shutdowns: make(chan chan error),
 	}
 
-	fd, err := os.OpenFile(commitLogFileName(rootPath, name), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	fd, err := os.OpenFile(CommitLogFileName(rootPath, name), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
 	if err != nil {
 		panic(err)
 	}
@@ -41,8 +46,10 @@ func NewCommitLogger(rootPath, name string) *hnswCommitLogger {
 }
 
 type hnswCommitLogger struct {
-	events  chan []byte
-	logFile *os.File
+	events    chan []byte
+	flushes   chan chan error
+	shutdowns chan chan error
+	logFile   *os.File
 }
 
 type hnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -149,15 +156,48 @@ func (l *hnswCommitLogger) Reset() error {
 	return nil
 }
 
+// Flush returns once every event which was logged before the call has been
+// written to the commit log file and the file has been fsynced
+func (l *hnswCommitLogger) Flush() error {
+	done := make(chan error)
+	l.flushes <- done
+	return <-done
+}
+
+// Shutdown writes and fsyncs every event which was logged before the call,
+// closes the commit log file and stops the logger. Nothing can be logged
+// afterwards.
+func (l *hnswCommitLogger) Shutdown() error {
+	done := make(chan error)
+	l.shutdowns <- done
+	return <-done
+}
+
 func (l *hnswCommitLogger) StartLogging() {
 	go func() {
 		for {
-			event := <-l.events
-			l.logFile.Write(event)
+			select {
+			case event := <-l.events:
+				l.logFile.Write(event)
+			case done := <-l.flushes:
+				done <- l.logFile.Sync()
+			case done := <-l.shutdowns:
+				done <- l.close()
+				return
+			}
 		}
 	}()
 }
 
+func (l *hnswCommitLogger) close() error {
+	if err := l.logFile.Sync(); err != nil {
+		l.logFile.Close()
+		return err
+	}
+
+	return l.logFile.Close()
+}
+
 func (l *hnswCommitLogger) writeUint32(w io.Writer, in uint32) error {
 	err := binary.Write(w, binary.LittleEndian, &in)
 	if err != nil {
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+// NewNoopCommitLogger returns a commit logger which discards everything, the
+// index is then only held in memory. It never touches the commit log file, so
+// it can be used on storage which must not be written.
+func NewNoopCommitLogger() CommitLogger {
+	return &noopCommitLogger{}
+}
+
+type noopCommitLogger struct{}
+
+func (n *noopCommitLogger) AddNode(node *vertex) error {
+	return nil
+}
+func (n *noopCommitLogger) SetEntryPointWithMaxLayer(id int, level int) error {
+	return nil
+}
+func (n *noopCommitLogger) AddLinkAtLevel(nodeid int, level int, target uint32) error {
+	return nil
+}
+func (n *noopCommitLogger) ReplaceLinksAtLevel(nodeid int, level int, targets []uint32) error {
+	return nil
+}
+
+func (n *noopCommitLogger) AddTombstone(nodeid int) error {
+	return nil
+}
+
+func (n *noopCommitLogger) RemoveTombstone(nodeid int) error {
+	return nil
+}
+
+func (n *noopCommitLogger) DeleteNode(nodeid int) error {
+	return nil
+}
+
+func (n *noopCommitLogger) ClearLinks(nodeid int) error {
+	return nil
+}
+
+func (n *noopCommitLogger) Reset() error {
+	return nil
+}
+
+func (n *noopCommitLogger) Flush() error {
+	return nil
+}
+
+func (n *noopCommitLogger) Shutdown() error {
+	return nil
+}
@@ -18,6 +18,21 @@ import (
 	"time"
 )
 
+const (
+	// DefaultMaximumConnections is used if a class does not configure its own
+	// maximum connections
+	DefaultMaximumConnections = 60
+
+	// DefaultEFConstruction is used if a class does not configure its own
+	// efConstruction
+	DefaultEFConstruction = 128
+
+	// defaultEFFactor determines the ef at query time if neither the index nor
+	// the query set one. The ef is then this factor times the number of
+	// requested results.
+	defaultEFFactor = 8
+)
+
 // Config for a new HSNW index
 type Config struct {
 	RootPath              string
@@ -27,6 +42,10 @@ type Config struct {
 	EFConstruction        int
 	VectorForIDThunk      VectorForID
 
+	// Optional, ef used at query time, unless the query sets its own. If not
+	// set, the ef is derived from the number of requested results.
+	EF int
+
 	// Optional, no period clean up will be scheduled if interval is not set
 	TombstoneCleanupInterval time.Duration
 }
@@ -50,6 +69,10 @@ func (c Config) Validate() error {
 		ec.addf("efConstruction must be greater than 0")
 	}
 
+	if c.EF < 0 {
+		ec.addf("ef must not be negative")
+	}
+
 	if c.MakeCommitLoggerThunk == nil {
 		ec.addf("makeCommitLoggerThunk cannot be nil")
 	}
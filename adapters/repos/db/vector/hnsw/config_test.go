@@ -63,6 +63,14 @@ func Test_InValidConfig(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("efConstruction must be greater than 0"),
 		},
+		test{
+			config: func() Config {
+				v := validConfig()
+				v.EF = -1
+				return v
+			},
+			expectedErr: fmt.Errorf("ef must not be negative"),
+		},
 		test{
 			config: func() Config {
 				v := validConfig()
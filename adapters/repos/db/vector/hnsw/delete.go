@@ -29,6 +29,9 @@ func (h *hnsw) Delete(id int) error {
 // CleanUpTombstonedNodes removes nodes with a tombstone and reassignes edges
 // that were previously pointing to the tombstoned nodes
 func (h *hnsw) CleanUpTombstonedNodes() error {
+	h.cleanupLock.Lock()
+	defer h.cleanupLock.Unlock()
+
 	deleteList := inverted.AllowList{}
 
 	h.RLock()
@@ -62,7 +62,7 @@ func TestDelete_WithoutCleaningUpTombstones(t *testing.T) {
 			allowList.Insert(uint32(i))
 		}
 
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		control = res
@@ -80,7 +80,7 @@ func TestDelete_WithoutCleaningUpTombstones(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -137,7 +137,7 @@ func TestDelete_WithCleaningUpTombstonesOnce(t *testing.T) {
 			allowList.Insert(uint32(i))
 		}
 
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		control = res
@@ -161,7 +161,7 @@ func TestDelete_WithCleaningUpTombstonesOnce(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -222,7 +222,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 			allowList.Insert(uint32(i))
 		}
 
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		control = res
@@ -251,7 +251,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -288,7 +288,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 			require.Nil(t, err)
 		}
 
-		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, 0, nil)
 		require.Nil(t, err)
 		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, res)
 	})
@@ -74,6 +74,7 @@ func (c *deserializer) Do(fd *os.File) (*deserializationResult, error) {
 			out.entrypoint = 0
 			out.level = 0
 			out.nodes = make([]*vertex, importLimit) // TODO: make variable
+			out.tombstones = make(map[int]struct{})
 		default:
 			err = fmt.Errorf("unrecognized commit type %d", ct)
 		}
@@ -18,6 +18,7 @@ import (
 	"math/rand"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -49,6 +50,10 @@ type hnsw struct {
 	// ef parameter used in construction phases, should be higher than ef during querying
 	efConstruction int
 
+	// ef parameter used at query time if the query does not set its own, 0
+	// means derived from the number of requested results
+	ef int
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -68,6 +73,25 @@ type hnsw struct {
 
 	id       string
 	rootPath string
+
+	cache *vectorCache
+
+	// computing the stats requires a full iteration over all nodes, so they
+	// are cached for a short amount of time
+	statsCache cachedStats
+
+	// dimensions of the vectors in the index, 0 if not known yet. All vectors
+	// in the index must have the same dimensions.
+	dimensions int32
+
+	// cleanupLock is held for the duration of a tombstone cleanup, so that a
+	// snapshot never contains only half of one
+	cleanupLock sync.Mutex
+
+	// shutdown stops the periodic tombstone cleanup, cleanupDone is closed
+	// once it has stopped. cleanupDone is nil without a periodic cleanup.
+	shutdown    chan struct{}
+	cleanupDone chan struct{}
 }
 
 type CommitLogger interface {
@@ -80,6 +104,8 @@ type CommitLogger interface {
 	DeleteNode(nodeid int) error
 	ClearLinks(nodeid int) error
 	Reset() error
+	Flush() error
+	Shutdown() error
 }
 
 type MakeCommitLogger func() CommitLogger
@@ -107,11 +133,14 @@ func New(cfg Config) (*hnsw, error) {
 		// inspired by c++ implementation
 		levelNormalizer: 1 / math.Log(float64(cfg.MaximumConnections)),
 		efConstruction:  cfg.EFConstruction,
+		ef:              cfg.EF,
 		nodes:           make([]*vertex, importLimit), // TODO: grow variably rather than fixed length
 		vectorForID:     vectorCache.get,
 		id:              cfg.ID,
 		rootPath:        cfg.RootPath,
 		tombstones:      map[int]struct{}{},
+		cache:           vectorCache,
+		shutdown:        make(chan struct{}),
 	}
 
 	if err := index.restoreFromDisk(); err != nil {
@@ -128,7 +157,7 @@ func New(cfg Config) (*hnsw, error) {
 // if a commit log is already present it will be read into memory, if not we
 // start with an empty model
 func (h *hnsw) restoreFromDisk() error {
-	fileName := commitLogFileName(h.rootPath, h.id)
+	fileName := CommitLogFileName(h.rootPath, h.id)
 	if _, err := os.Stat(fileName); err != nil {
 		if os.IsNotExist(err) {
 			// nothing to do here we can return
@@ -168,18 +197,46 @@ func (h *hnsw) registerTombstoneCleanup(cfg Config) {
 		return
 	}
 
+	h.cleanupDone = make(chan struct{})
 	go func() {
+		defer close(h.cleanupDone)
+
+		ticker := time.NewTicker(cfg.TombstoneCleanupInterval)
+		defer ticker.Stop()
+
 		for {
-			time.Sleep(cfg.TombstoneCleanupInterval)
-			err := h.CleanUpTombstonedNodes()
-			if err != nil {
-				// TODO: log properly
-				fmt.Printf("tombstone cleanup errored: %v\n", err)
+			select {
+			case <-h.shutdown:
+				return
+			case <-ticker.C:
+				err := h.CleanUpTombstonedNodes()
+				if err != nil {
+					// TODO: log properly
+					fmt.Printf("tombstone cleanup errored: %v\n", err)
+				}
 			}
 		}
 	}()
 }
 
+// Shutdown stops the periodic tombstone cleanup and closes the commit log,
+// once every change which was logged so far has been written. The index can
+// still be searched afterwards, but it must not be changed anymore. Shutdown
+// must only be called once.
+func (h *hnsw) Shutdown() error {
+	close(h.shutdown)
+	if h.cleanupDone != nil {
+		// a cleanup which is already running still logs its changes
+		<-h.cleanupDone
+	}
+
+	if err := h.commitLog.Shutdown(); err != nil {
+		return errors.Wrap(err, "shut down commit log")
+	}
+
+	return nil
+}
+
 // TODO: use this for incoming replication
 // func (h *hnsw) insertFromExternal(nodeId, targetLevel int, neighborsAtLevel map[int][]uint32) {
 // 	defer m.addBuildingReplication(time.Now())
@@ -288,7 +345,38 @@ func (h *hnsw) Add(id int, vector []float32) error {
 		id: id,
 	}
 
-	return h.insert(node, vector)
+	if err := h.insert(node, vector); err != nil {
+		return err
+	}
+
+	atomic.CompareAndSwapInt32(&h.dimensions, 0, int32(len(vector)))
+	return nil
+}
+
+// Dimensions returns the dimensions of the vectors in the index. It returns 0
+// if the index is empty or the dimensions can not be determined, e.g.
+// because the vector of the entrypoint was deleted since the index was
+// restored from disk.
+func (h *hnsw) Dimensions() int {
+	if dims := atomic.LoadInt32(&h.dimensions); dims > 0 {
+		return int(dims)
+	}
+
+	if h.isEmpty() {
+		return 0
+	}
+
+	h.RLock()
+	entryPointID := h.entryPointID
+	h.RUnlock()
+
+	vec, err := h.vectorForID(context.Background(), int32(entryPointID))
+	if err != nil || len(vec) == 0 {
+		return 0
+	}
+
+	atomic.CompareAndSwapInt32(&h.dimensions, 0, int32(len(vec)))
+	return len(vec)
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -319,6 +407,12 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	currentMaximumLayer := h.currentMaximumLayer
 
 	targetLevel := int(math.Floor(-math.Log(rand.Float64()*h.levelNormalizer))) - 1
+	if targetLevel < 0 {
+		// with a small number of maximumConnections the normalizer is large
+		// enough to produce negative levels, a node on such a level would never
+		// be connected to the graph
+		targetLevel = 0
+	}
 
 	// before = time.Now()
 	node.Lock()
@@ -561,32 +655,26 @@ func (h *hnsw) distBetweenNodeAndVec(node int, vecB []float32) (float32, error)
 	return cosineDist(vecA, vecB)
 }
 
-func (h *hnsw) Stats() {
-	fmt.Printf("levels: %d\n", h.currentMaximumLayer)
-
-	perLevelCount := map[int]uint{}
-
-	for _, node := range h.nodes {
-		if node == nil {
-			continue
-		}
-		l := node.level
-		if l == 0 && len(node.connections) == 0 {
-			// filter out allocated space without nodes
-			continue
-		}
-		c, ok := perLevelCount[l]
-		if !ok {
-			perLevelCount[l] = 0
-		}
-
-		perLevelCount[l] = c + 1
+// Reset removes all nodes from the index, so it can be rebuilt from scratch.
+// The reset is written to the commit log, so the removed nodes do not come
+// back when the index is restored from disk.
+func (h *hnsw) Reset() error {
+	h.Lock()
+	h.nodes = make([]*vertex, importLimit) // TODO: make variable
+	h.tombstones = map[int]struct{}{}
+	h.entryPointID = 0
+	h.currentMaximumLayer = 0
+	h.Unlock()
+	atomic.StoreInt32(&h.dimensions, 0)
+	if h.cache != nil {
+		h.cache.drop()
 	}
 
-	for level, count := range perLevelCount {
-		fmt.Printf("unique count on level %d: %d\n", level, count)
-	}
+	h.statsCache.Lock()
+	h.statsCache.computedAt = time.Time{}
+	h.statsCache.Unlock()
 
+	return h.commitLog.Reset()
 }
 
 func (h *hnsw) isEmpty() bool {
@@ -87,38 +87,3 @@ func TestHnswIndex(t *testing.T) {
 	})
 
 }
-
-type noopCommitLogger struct{}
-
-func (n *noopCommitLogger) AddNode(node *vertex) error {
-	return nil
-}
-func (n *noopCommitLogger) SetEntryPointWithMaxLayer(id int, level int) error {
-	return nil
-}
-func (n *noopCommitLogger) AddLinkAtLevel(nodeid int, level int, target uint32) error {
-	return nil
-}
-func (n *noopCommitLogger) ReplaceLinksAtLevel(nodeid int, level int, targets []uint32) error {
-	return nil
-}
-
-func (n *noopCommitLogger) AddTombstone(nodeid int) error {
-	return nil
-}
-
-func (n *noopCommitLogger) RemoveTombstone(nodeid int) error {
-	return nil
-}
-
-func (n *noopCommitLogger) DeleteNode(nodeid int) error {
-	return nil
-}
-
-func (n *noopCommitLogger) ClearLinks(nodeid int) error {
-	return nil
-}
-
-func (n *noopCommitLogger) Reset() error {
-	return nil
-}
@@ -110,7 +110,7 @@ func TestRecall(t *testing.T) {
 
 		for i := 0; i < queries; i++ {
 			controlList := bruteForce(vectors, queryVectors[i], k)
-			results, err := vectorIndex.SearchByVector(queryVectors[i], k, nil)
+			results, err := vectorIndex.SearchByVector(queryVectors[i], k, 0, nil)
 			require.Nil(t, err)
 
 			retrieved += k
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswIndexReset(t *testing.T) {
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: func() CommitLogger { return &noopCommitLogger{} },
+		MaximumConnections:    30,
+		EFConstruction:        60,
+		VectorForIDThunk:      testVectorForID,
+	})
+	require.Nil(t, err)
+
+	for i, vec := range testVectors {
+		require.Nil(t, index.Add(i, vec))
+	}
+	require.Nil(t, index.Delete(0))
+	require.Equal(t, len(testVectors), index.Stats().VectorCount)
+
+	require.Nil(t, index.Reset())
+
+	t.Run("the index is empty", func(t *testing.T) {
+		stats := index.Stats()
+		assert.Equal(t, 0, stats.VectorCount)
+		assert.Equal(t, 0, stats.TombstoneCount)
+		assert.True(t, index.isEmpty())
+	})
+
+	t.Run("searching the empty index", func(t *testing.T) {
+		res, err := index.knnSearchByVector(testVectors[0], 10, 36, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("the index can be rebuilt", func(t *testing.T) {
+		for i := 3; i < 6; i++ {
+			require.Nil(t, index.Add(i, testVectors[i]))
+		}
+
+		res, err := index.knnSearchByVector(testVectors[0], 10, 36, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []int{3, 4, 5}, res)
+	})
+}
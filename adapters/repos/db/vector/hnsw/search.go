@@ -19,13 +19,33 @@ import (
 )
 
 func (h *hnsw) SearchByID(id int, k int) ([]int, error) {
-	// TODO: make ef configurable
-	return h.knnSearch(id, k, 8*k)
+	return h.knnSearch(id, k, h.searchEF(k, 0))
 }
 
-func (h *hnsw) SearchByVector(vector []float32, k int, allowList inverted.AllowList) ([]int, error) {
-	// TODO: make ef configurable
-	return h.knnSearchByVector(vector, k, k*8, allowList)
+// SearchByVector returns the ids of the k nearest neighbors of the vector. An
+// ef of 0 falls back to the ef configured on the index.
+func (h *hnsw) SearchByVector(vector []float32, k int, ef int,
+	allowList inverted.AllowList) ([]int, error) {
+	return h.knnSearchByVector(vector, k, h.searchEF(k, ef), allowList)
+}
+
+// searchEF determines the ef for a query. The query's ef takes precedence
+// over the index's ef. If neither is set, ef is derived from k. The ef can
+// never be smaller than k, as this would limit the number of results.
+func (h *hnsw) searchEF(k int, ef int) int {
+	if ef <= 0 {
+		ef = h.ef
+	}
+
+	if ef <= 0 {
+		ef = defaultEFFactor * k
+	}
+
+	if ef < k {
+		ef = k
+	}
+
+	return ef
 }
 
 func (h *hnsw) knnSearch(queryNodeID int, k int, ef int) ([]int, error) {
@@ -248,8 +268,19 @@ func (h *hnsw) distanceToNode(distancer *reusableDistancer,
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList inverted.AllowList) ([]int, error) {
+	h.RLock()
+	empty := h.nodes[h.entryPointID] == nil
+	h.RUnlock()
+	if empty {
+		// there is no entrypoint to start the search from, e.g. after a reset
+		return nil, nil
+	}
 
 	entryPointID := h.entryPointID
+	if err := h.validateSearchVectorDimensions(entryPointID, searchVec); err != nil {
+		return nil, errors.Wrap(err, "knn search")
+	}
+
 	entryPointDistance, err := h.distBetweenNodeAndVec(entryPointID, searchVec)
 	if err != nil {
 		return nil, errors.Wrap(err, "knn search: distance between entrypint and query node")
@@ -324,3 +355,21 @@ func (h *hnsw) selectNeighborsSimpleFromId(nodeId int, ids []uint32,
 
 	return h.selectNeighborsSimple(*bst, max, denyList), nil
 }
+
+// validateSearchVectorDimensions compares the search vector against the
+// vector of the entrypoint. All vectors in the index have the same
+// dimensions, so a mismatch would otherwise only surface as a generic error
+// of the distance function.
+func (h *hnsw) validateSearchVectorDimensions(entryPointID int, searchVec []float32) error {
+	entryPointVec, err := h.vectorForID(context.Background(), int32(entryPointID))
+	if err != nil {
+		return errors.Wrapf(err, "could not get vector of entrypoint at docID %d", entryPointID)
+	}
+
+	if len(entryPointVec) > 0 && len(searchVec) != len(entryPointVec) {
+		return errors.Errorf("search vector has %d dimensions, but the vectors in the index have %d",
+			len(searchVec), len(entryPointVec))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SearchEF(t *testing.T) {
+	type test struct {
+		name       string
+		indexEF    int
+		queryEF    int
+		k          int
+		expectedEF int
+	}
+
+	tests := []test{
+		{name: "nothing set", indexEF: 0, queryEF: 0, k: 10, expectedEF: 80},
+		{name: "set on the index", indexEF: 100, queryEF: 0, k: 10, expectedEF: 100},
+		{name: "set on the query", indexEF: 0, queryEF: 50, k: 10, expectedEF: 50},
+		{name: "query overrides index", indexEF: 100, queryEF: 50, k: 10, expectedEF: 50},
+		{name: "smaller than k", indexEF: 0, queryEF: 5, k: 10, expectedEF: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index := &hnsw{ef: test.indexEF}
+			assert.Equal(t, test.expectedEF, index.searchEF(test.k, test.queryEF))
+		})
+	}
+}
+
+func Test_SearchByVectorWithWrongDimensions(t *testing.T) {
+	vectors := [][]float32{
+		{0.1, 0.2, 0.3},
+		{0.3, 0.2, 0.1},
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "search-dimensions-test",
+		MakeCommitLoggerThunk: func() CommitLogger { return &noopCommitLogger{} },
+		MaximumConnections:    30,
+		EFConstruction:        128,
+		VectorForIDThunk: func(ctx context.Context, id int32) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(i, vec))
+	}
+
+	t.Run("with matching dimensions", func(t *testing.T) {
+		res, err := index.SearchByVector([]float32{0.1, 0.2, 0.3}, 1, 0, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []int{0}, res)
+	})
+
+	t.Run("with too few dimensions", func(t *testing.T) {
+		_, err := index.SearchByVector([]float32{0.1, 0.2}, 1, 0, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(),
+			"search vector has 2 dimensions, but the vectors in the index have 3")
+	})
+}
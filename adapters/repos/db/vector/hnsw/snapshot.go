@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// CommitLogSnapshot flushes the commit log and returns its path and current
+// size. The commit log is only ever appended to, so its first size bytes
+// describe the index as of this call, even if the index changes afterwards.
+// The caller has to make sure that no Add or Delete is in progress.
+func (h *hnsw) CommitLogSnapshot() (string, int64, error) {
+	h.cleanupLock.Lock()
+	defer h.cleanupLock.Unlock()
+
+	if err := h.commitLog.Flush(); err != nil {
+		return "", 0, errors.Wrap(err, "flush commit log")
+	}
+
+	fileName := CommitLogFileName(h.rootPath, h.id)
+	info, err := os.Stat(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fileName, 0, nil
+		}
+		return "", 0, errors.Wrapf(err, "stat commit log %q", fileName)
+	}
+
+	return fileName, info.Size(), nil
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// statsTTL is the time after which cached stats are considered stale
+const statsTTL = 10 * time.Second
+
+// Rough per-item overhead estimates used to approximate the memory usage,
+// they are not meant to be exact, but to give an idea of the order of
+// magnitude
+const (
+	bytesPerNodePointer    = 8
+	bytesPerVertex         = 64
+	bytesPerLevel          = 72 // map entry plus slice header
+	bytesPerConnection     = 4
+	bytesPerCacheEntry     = 72 // map entry plus slice header
+	bytesPerVectorPosition = 4
+)
+
+// Stats describe the size and the build parameters of an index
+type Stats struct {
+	VectorCount          int
+	TombstoneCount       int
+	CachedVectors        int
+	MaximumLayer         int
+	EstimatedMemoryBytes int64
+	MaximumConnections   int
+	EFConstruction       int
+}
+
+type cachedStats struct {
+	sync.Mutex
+	stats      Stats
+	computedAt time.Time
+}
+
+// Stats returns the current stats of the index. As they require iterating
+// over every node, they are only computed once every statsTTL.
+func (h *hnsw) Stats() Stats {
+	h.statsCache.Lock()
+	defer h.statsCache.Unlock()
+
+	if !h.statsCache.computedAt.IsZero() &&
+		time.Since(h.statsCache.computedAt) < statsTTL {
+		return h.statsCache.stats
+	}
+
+	h.statsCache.stats = h.computeStats()
+	h.statsCache.computedAt = time.Now()
+	return h.statsCache.stats
+}
+
+func (h *hnsw) computeStats() Stats {
+	h.RLock()
+	defer h.RUnlock()
+
+	stats := Stats{
+		TombstoneCount:     len(h.tombstones),
+		MaximumLayer:       h.currentMaximumLayer,
+		MaximumConnections: h.maximumConnections,
+		EFConstruction:     h.efConstruction,
+	}
+
+	memory := int64(cap(h.nodes)) * bytesPerNodePointer
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		stats.VectorCount++
+		memory += bytesPerVertex
+
+		node.RLock()
+		for _, conns := range node.connections {
+			memory += bytesPerLevel + int64(cap(conns))*bytesPerConnection
+		}
+		node.RUnlock()
+	}
+
+	if h.cache != nil {
+		cached, dims := h.cache.size()
+		stats.CachedVectors = cached
+		memory += int64(cached) * (bytesPerCacheEntry + int64(dims)*bytesPerVectorPosition)
+	}
+
+	stats.EstimatedMemoryBytes = memory
+	return stats
+}
+
+// size returns the number of cached vectors as well as the dimensions of
+// the vectors, assuming all vectors have the same dimensions
+func (c *vectorCache) size() (int, int) {
+	dims := 0
+	c.cache.Range(func(key, value interface{}) bool {
+		dims = len(value.([]float32))
+		return false
+	})
+
+	return int(atomic.LoadInt32(&c.count)), dims
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswStats(t *testing.T) {
+	cl := &noopCommitLogger{}
+	makeCL := func() CommitLogger {
+		return cl
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: makeCL,
+		MaximumConnections:    30,
+		EFConstruction:        60,
+		VectorForIDThunk:      testVectorForID,
+	})
+	require.Nil(t, err)
+
+	t.Run("on an empty index", func(t *testing.T) {
+		stats := index.Stats()
+		assert.Equal(t, 0, stats.VectorCount)
+		assert.Equal(t, 30, stats.MaximumConnections)
+		assert.Equal(t, 60, stats.EFConstruction)
+	})
+
+	t.Run("stats are cached", func(t *testing.T) {
+		for i, vec := range testVectors {
+			err := index.Add(i, vec)
+			require.Nil(t, err)
+		}
+
+		stats := index.Stats()
+		assert.Equal(t, 0, stats.VectorCount)
+	})
+
+	t.Run("after the cache expired", func(t *testing.T) {
+		index.statsCache.computedAt = time.Now().Add(-2 * statsTTL)
+		_, err := index.vectorForID(context.Background(), 0)
+		require.Nil(t, err)
+
+		before := index.Stats()
+		assert.Equal(t, len(testVectors), before.VectorCount)
+		assert.True(t, before.CachedVectors > 0)
+		assert.True(t, before.EstimatedMemoryBytes > 0)
+
+		err = index.Delete(0)
+		require.Nil(t, err)
+		index.statsCache.computedAt = time.Time{}
+
+		after := index.Stats()
+		assert.Equal(t, 1, after.TombstoneCount)
+	})
+}
@@ -65,3 +65,13 @@ func (c *vectorCache) get(ctx context.Context, id int32) ([]float32, error) {
 
 	return vec.([]float32), nil
 }
+
+// drop removes all vectors from the cache, e.g. after the index was reset
+func (c *vectorCache) drop() {
+	c.cache.Range(func(key, value interface{}) bool {
+		c.cache.Delete(key)
+		atomic.AddInt32(&c.count, -1)
+
+		return true
+	})
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorDimensions(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "DimensionsThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	id1 := strfmt.UUID("5d3a2c0e-0f4b-4b53-9a1e-000000000001")
+	id2 := strfmt.UUID("5d3a2c0e-0f4b-4b53-9a1e-000000000002")
+	id3 := strfmt.UUID("5d3a2c0e-0f4b-4b53-9a1e-000000000003")
+	id4 := strfmt.UUID("5d3a2c0e-0f4b-4b53-9a1e-000000000004")
+	thing := func(id strfmt.UUID) *models.Thing {
+		return &models.Thing{
+			Class:  "DimensionsThingClass",
+			ID:     id,
+			Schema: map[string]interface{}{"name": id.String()},
+		}
+	}
+
+	t.Run("the first vector determines the dimensions", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id1), []float32{1, 2, 3})
+		require.Nil(t, err)
+	})
+
+	t.Run("a vector with mismatched dimensions is rejected", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing(id2), []float32{1, 2, 3, 4})
+		require.NotNil(t, err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+		assert.Equal(t, "vector has 4 dimensions, but the vectors of class "+
+			"DimensionsThingClass have 3 dimensions", err.Error())
+
+		res, err := repo.ThingByID(context.Background(), id2,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, res, "nothing was written")
+	})
+
+	t.Run("a batch rejects only the mismatched objects", func(t *testing.T) {
+		batch := kinds.BatchThings{
+			{OriginalIndex: 0, UUID: id3, Thing: thing(id3), Vector: []float32{4, 5, 6}},
+			{OriginalIndex: 1, UUID: id4, Thing: thing(id4), Vector: []float32{4, 5}},
+		}
+
+		res, err := repo.BatchPutThings(context.Background(), batch)
+		require.Nil(t, err)
+
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, res[1].Err)
+
+		found, err := repo.ThingByID(context.Background(), id3,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.NotNil(t, found)
+
+		found, err = repo.ThingByID(context.Background(), id4,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("a merge with a mismatched vector is rejected", func(t *testing.T) {
+		err := repo.Merge(context.Background(), kinds.MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "DimensionsThingClass",
+			ID:              id1,
+			PrimitiveSchema: map[string]interface{}{"name": "updated"},
+			Vector:          []float32{1, 2},
+		})
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("the dimensions are known after a restart", func(t *testing.T) {
+		shard := repo.GetIndex(kind.Thing, "DimensionsThingClass").Shards["single"]
+		require.Nil(t, shard.db.Close())
+
+		restarted := New(logger, Config{RootPath: dirName})
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(30*time.Second))
+
+		err := restarted.PutThing(context.Background(), thing(id2), []float32{1, 2, 3, 4})
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+
+		err = restarted.PutThing(context.Background(), thing(id2), []float32{1, 2, 4})
+		assert.Nil(t, err)
+	})
+}
+
+func TestVectorDimensionsOfAnEmptyClass(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	var classes []*models.Class
+	addClass := func(t *testing.T, name string) {
+		class := &models.Class{
+			Class: name,
+			Properties: []*models.Property{
+				&models.Property{
+					Name:     "name",
+					DataType: []string{string(schema.DataTypeString)},
+				},
+			},
+		}
+		require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+		classes = append(classes, class)
+		schemaGetter.schema = schema.Schema{
+			Things: &models.Schema{Classes: classes},
+		}
+	}
+
+	thing := func(className string, i int) *models.Thing {
+		return &models.Thing{
+			Class:  className,
+			ID:     strfmt.UUID(fmt.Sprintf("6e4b3d1f-1a5c-4c64-8b2f-%012d", i)),
+			Schema: map[string]interface{}{"name": "thing"},
+		}
+	}
+	vector := func(i int) []float32 {
+		if i%2 == 0 {
+			return []float32{1, 2, 3}
+		}
+		return []float32{1, 2, 3, 4}
+	}
+
+	t.Run("a first batch with mixed dimensions", func(t *testing.T) {
+		addClass(t, "FirstBatchClass")
+
+		var batch kinds.BatchThings
+		for i := 0; i < 4; i++ {
+			obj := thing("FirstBatchClass", i)
+			batch = append(batch, kinds.BatchThing{OriginalIndex: i, UUID: obj.ID,
+				Thing: obj, Vector: vector(i)})
+		}
+
+		res, err := repo.BatchPutThings(context.Background(), batch)
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		assert.Nil(t, res[0].Err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, res[1].Err)
+		assert.Nil(t, res[2].Err)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, res[3].Err)
+	})
+
+	t.Run("concurrent first writes with different dimensions", func(t *testing.T) {
+		addClass(t, "ConcurrentFirstClass")
+
+		writes := 16
+		errs := make([]error, writes)
+		wg := &sync.WaitGroup{}
+		for i := 0; i < writes; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repo.PutThing(context.Background(),
+					thing("ConcurrentFirstClass", i), vector(i))
+			}(i)
+		}
+		wg.Wait()
+
+		dimensions := map[int]bool{}
+		for i, err := range errs {
+			if err == nil {
+				dimensions[len(vector(i))] = true
+				continue
+			}
+
+			assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+		}
+		assert.Len(t, dimensions, 1, "all imported vectors have the same dimensions")
+	})
+
+	t.Run("a truncated class accepts new dimensions", func(t *testing.T) {
+		_, err := repo.TruncateClass(context.Background(), kind.Thing, "FirstBatchClass")
+		require.Nil(t, err)
+
+		err = repo.PutThing(context.Background(), thing("FirstBatchClass", 0),
+			[]float32{1, 2, 3, 4, 5})
+		assert.Nil(t, err)
+	})
+}
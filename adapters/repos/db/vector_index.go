@@ -11,7 +11,10 @@
 
 package db
 
-import "github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+)
 
 // VectorIndex is anything that indexes vectors effieciently. For an example
 // look at ./vector/hsnw/index.go
@@ -19,5 +22,43 @@ type VectorIndex interface {
 	Add(id int, vector []float32) error // TODO: make id uint32
 	Delete(id int) error
 	SearchByID(id int, k int) ([]int, error)
-	SearchByVector(vector []float32, k int, allow inverted.AllowList) ([]int, error)
+	SearchByVector(vector []float32, k int, ef int, allow inverted.AllowList) ([]int, error)
+	Stats() hnsw.Stats
+	Reset() error
+	Dimensions() int
+	CommitLogSnapshot() (string, int64, error)
+	Shutdown() error
+}
+
+// vectorIndexMaxConnections is the maximum number of connections configured
+// for the class or the hnsw default
+func (s *Shard) vectorIndexMaxConnections() int {
+	cfg := s.index.Config.VectorIndexConfig
+	if cfg == nil || cfg.MaxConnections == 0 {
+		return hnsw.DefaultMaximumConnections
+	}
+
+	return int(cfg.MaxConnections)
+}
+
+// vectorIndexEFConstruction is the efConstruction configured for the class or
+// the hnsw default
+func (s *Shard) vectorIndexEFConstruction() int {
+	cfg := s.index.Config.VectorIndexConfig
+	if cfg == nil || cfg.EfConstruction == 0 {
+		return hnsw.DefaultEFConstruction
+	}
+
+	return int(cfg.EfConstruction)
+}
+
+// vectorIndexEF is the query time ef configured for the class, 0 lets the
+// hnsw index derive it from the number of requested results
+func (s *Shard) vectorIndexEF() int {
+	cfg := s.index.Config.VectorIndexConfig
+	if cfg == nil {
+		return 0
+	}
+
+	return int(cfg.Ef)
 }
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexConfig(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class: "VectorIndexConfigClass",
+		VectorIndexConfig: &models.VectorIndexConfig{
+			EfConstruction: 16,
+			MaxConnections: 8,
+			Ef:             32,
+		},
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"6c4b1f9e-2a3d-4e5f-8a7b-1c2d3e4f5a01",
+		"6c4b1f9e-2a3d-4e5f-8a7b-1c2d3e4f5a02",
+		"6c4b1f9e-2a3d-4e5f-8a7b-1c2d3e4f5a03",
+	}
+
+	t.Run("importing things", func(t *testing.T) {
+		for i, id := range ids {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				ID:     id,
+				Class:  class.Class,
+				Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+			}, []float32{1, float32(i), 3})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("the shard uses the configured parameters", func(t *testing.T) {
+		idx := repo.GetIndex(kind.Thing, schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		for _, shard := range idx.Shards {
+			assert.Equal(t, 8, shard.vectorIndexMaxConnections())
+			assert.Equal(t, 16, shard.vectorIndexEFConstruction())
+			assert.Equal(t, 32, shard.vectorIndexEF())
+		}
+	})
+
+	t.Run("searching with the class ef", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 3},
+			Pagination:   &filters.Pagination{Limit: 3},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+
+	t.Run("searching with an ef overridden per query", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 3},
+			Pagination:   &filters.Pagination{Limit: 1},
+			Explore:      &traverser.ExploreParams{EF: 2},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+}
@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexRecovery(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "RecoveryThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	// more than one page, so the rebuild has to continue after the first one
+	objects := streamPageSize + 20
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("6a7c2f1e-93b4-4c0d-8e25-0f3d9b%06d", i))
+	}
+
+	schemaGetter := func() *fakeSchemaGetter {
+		return &fakeSchemaGetter{schema: schema.Schema{
+			Things: &models.Schema{Classes: []*models.Class{thingclass}},
+		}}
+	}
+
+	// setup imports the objects and closes the bolt file afterwards, so that
+	// the same files can be loaded by a restarted repo
+	setup := func(t *testing.T) string {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0777)
+
+		repo := New(logger, Config{RootPath: dirName})
+		getter := &fakeSchemaGetter{}
+		repo.SetSchemaGetter(getter)
+		require.Nil(t, repo.WaitForStartup(30*time.Second))
+		require.Nil(t, NewMigrator(repo).AddClass(context.Background(),
+			kind.Thing, thingclass))
+		getter.schema = schemaGetter().schema
+
+		for i := 0; i < objects; i++ {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				ID:     id(i),
+				Class:  thingclass.Class,
+				Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+
+		require.Nil(t, repo.GetIndex(kind.Thing, schema.ClassName(thingclass.Class)).
+			Shards["single"].db.Close())
+		return dirName
+	}
+
+	restart := func(t *testing.T, dirName string, mode VectorIndexRecovery) (*DB, error) {
+		repo := New(logger, Config{RootPath: dirName, VectorIndexRecovery: mode})
+		repo.SetSchemaGetter(schemaGetter())
+		return repo, repo.WaitForStartup(30 * time.Second)
+	}
+
+	shardOf := func(repo *DB) *Shard {
+		return repo.GetIndex(kind.Thing, schema.ClassName(thingclass.Class)).
+			Shards["single"]
+	}
+
+	commitLog := func(dirName string) string {
+		return hnsw.CommitLogFileName(dirName, "thing_recoverythingclass_single")
+	}
+
+	assertAllVectorsSearchable := func(t *testing.T, repo *DB) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			SearchVector: []float32{1, 2, 3},
+			Kind:         kind.Thing,
+			ClassName:    thingclass.Class,
+			Pagination:   &filters.Pagination{Limit: objects},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, objects)
+	}
+
+	t.Run("with a missing commit log", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+		require.Nil(t, os.Remove(commitLog(dirName)))
+
+		repo, err := restart(t, dirName, RecoveryAuto)
+		require.Nil(t, err)
+		defer shardOf(repo).db.Close()
+
+		assertAllVectorsSearchable(t, repo)
+	})
+
+	t.Run("with a corrupt commit log", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+		require.Nil(t, ioutil.WriteFile(commitLog(dirName), []byte{0xff, 0xff, 0xff}, 0666))
+
+		repo, err := restart(t, dirName, RecoveryAuto)
+		require.Nil(t, err)
+		defer shardOf(repo).db.Close()
+
+		assertAllVectorsSearchable(t, repo)
+		_, err = os.Stat(commitLog(dirName) + ".corrupt")
+		assert.Nil(t, err, "the corrupt commit log is kept for inspection")
+	})
+
+	t.Run("with a corrupt commit log and recovery turned off", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+		require.Nil(t, ioutil.WriteFile(commitLog(dirName), []byte{0xff, 0xff, 0xff}, 0666))
+
+		_, err := restart(t, dirName, RecoveryOff)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "hnsw index")
+	})
+
+	t.Run("forcing a rebuild", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+
+		repo, err := restart(t, dirName, RecoveryForce)
+		require.Nil(t, err)
+
+		assertAllVectorsSearchable(t, repo)
+		assert.Equal(t, objects, shardOf(repo).vectorIndex.Stats().VectorCount)
+		require.Nil(t, shardOf(repo).db.Close())
+
+		t.Run("the rebuilt index is restored on the next start", func(t *testing.T) {
+			// give the commit logger time to write the rebuilt index
+			time.Sleep(100 * time.Millisecond)
+
+			repo, err := restart(t, dirName, RecoveryOff)
+			require.Nil(t, err)
+			defer shardOf(repo).db.Close()
+
+			assertAllVectorsSearchable(t, repo)
+		})
+	})
+
+	t.Run("the rebuild progress is recorded", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+		require.Nil(t, os.Remove(commitLog(dirName)))
+
+		repo := New(logger, Config{RootPath: dirName, VectorIndexRecovery: RecoveryAuto})
+		repo.SetSchemaGetter(schemaGetter())
+		progress := &startupProgress{}
+		require.Nil(t, repo.init(context.Background(), progress))
+		defer shardOf(repo).db.Close()
+
+		_, _, current := progress.state()
+		assert.Equal(t, fmt.Sprintf(`vector index of shard "thing_recoverythingclass_single" `+
+			"(%d of %d objects)", objects, objects), current)
+	})
+
+	t.Run("an interrupted rebuild is started over on the next start", func(t *testing.T) {
+		dirName := setup(t)
+		defer os.RemoveAll(dirName)
+		require.Nil(t, os.Remove(commitLog(dirName)))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		repo := New(logger, Config{RootPath: dirName, VectorIndexRecovery: RecoveryAuto})
+		repo.SetSchemaGetter(schemaGetter())
+		err := repo.init(ctx, &startupProgress{})
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), context.Canceled.Error()))
+		require.Nil(t, shardOf(repo).db.Close())
+
+		restarted, err := restart(t, dirName, RecoveryAuto)
+		require.Nil(t, err)
+		defer shardOf(restarted).db.Close()
+
+		assertAllVectorsSearchable(t, restarted)
+	})
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// VectorIndexStats reports the size and build parameters of the vector index
+// of every shard, ordered by class and shard name
+func (d *DB) VectorIndexStats(ctx context.Context) ([]*models.VectorIndexStats, error) {
+	type statsTarget struct {
+		index *Index
+		name  string
+		shard *Shard
+	}
+
+	// stats are requested without the connector lock, so the indices can
+	// change in the meantime
+	var targets []statsTarget
+	d.indexLock.RLock()
+	for _, index := range d.indices {
+		for name, shard := range index.Shards {
+			targets = append(targets, statsTarget{index, name, shard})
+		}
+	}
+	d.indexLock.RUnlock()
+
+	var out []*models.VectorIndexStats
+	for _, target := range targets {
+		stats := target.shard.currentVectorIndex().Stats()
+		out = append(out, &models.VectorIndexStats{
+			Class:                target.index.Config.ClassName.String(),
+			Kind:                 target.index.Config.Kind.Name(),
+			Shard:                target.name,
+			VectorCount:          int64(stats.VectorCount),
+			TombstoneCount:       int64(stats.TombstoneCount),
+			CachedVectors:        int64(stats.CachedVectors),
+			MaximumLayer:         int64(stats.MaximumLayer),
+			EstimatedMemoryBytes: stats.EstimatedMemoryBytes,
+			MaximumConnections:   int64(stats.MaximumConnections),
+			EfConstruction:       int64(stats.EFConstruction),
+		})
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Class != out[b].Class {
+			return out[a].Class < out[b].Class
+		}
+
+		return out[a].Shard < out[b].Shard
+	})
+
+	return out, nil
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexStats(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "StatsThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	actionclass := &models.Class{
+		Class: "StatsActionClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Action, actionclass))
+	schemaGetter.schema = schema.Schema{
+		Actions: &models.Schema{
+			Classes: []*models.Class{actionclass},
+		},
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	t.Run("importing things", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			err := repo.PutThing(context.Background(), &models.Thing{
+				ID:     strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f506ca%d", i)),
+				Class:  thingclass.Class,
+				Schema: map[string]interface{}{"name": "foo"},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("retrieving the stats", func(t *testing.T) {
+		stats, err := repo.VectorIndexStats(context.Background())
+		require.Nil(t, err)
+		require.Len(t, stats, 2)
+
+		assert.Equal(t, "StatsActionClass", stats[0].Class)
+		assert.Equal(t, "action", stats[0].Kind)
+		assert.Equal(t, int64(0), stats[0].VectorCount)
+
+		assert.Equal(t, "StatsThingClass", stats[1].Class)
+		assert.Equal(t, "thing", stats[1].Kind)
+		assert.Equal(t, "single", stats[1].Shard)
+		assert.Equal(t, int64(3), stats[1].VectorCount)
+		assert.True(t, stats[1].EstimatedMemoryBytes > 0)
+		assert.True(t, stats[1].MaximumConnections > 0)
+		assert.True(t, stats[1].EfConstruction > 0)
+	})
+
+	t.Run("retrieving the stats while classes are added", func(t *testing.T) {
+		done := make(chan error)
+		go func() {
+			for i := 0; i < 20; i++ {
+				err := migrator.AddClass(context.Background(), kind.Thing, &models.Class{
+					Class: fmt.Sprintf("StatsWhileAdded%d", i),
+				})
+				if err != nil {
+					done <- err
+					return
+				}
+			}
+			done <- nil
+		}()
+
+		for {
+			select {
+			case err := <-done:
+				require.Nil(t, err)
+				return
+			default:
+			}
+
+			_, err := repo.VectorIndexStats(context.Background())
+			require.Nil(t, err)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectVersions(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	thingclass := &models.Class{
+		Class: "VersionedThingClass",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(30 * time.Second)
+	require.Nil(t, err)
+	migrator := NewMigrator(repo)
+
+	require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, thingclass))
+	schemaGetter.schema = schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	id := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	vector := []float32{1, 2, 3}
+	thing := func(name string, version int64) *models.Thing {
+		return &models.Thing{
+			Class:   "VersionedThingClass",
+			ID:      id,
+			Version: version,
+			Schema:  map[string]interface{}{"name": name},
+		}
+	}
+	versionOf := func(t *testing.T) int64 {
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		return res.Thing().Version
+	}
+
+	t.Run("a new object starts with version 1", func(t *testing.T) {
+		created := thing("first", 0)
+		require.Nil(t, repo.PutThing(context.Background(), created, vector))
+
+		assert.Equal(t, int64(1), created.Version)
+		assert.Equal(t, int64(1), versionOf(t))
+	})
+
+	t.Run("an update without a version always succeeds", func(t *testing.T) {
+		updated := thing("second", 0)
+		require.Nil(t, repo.PutThing(context.Background(), updated, vector))
+
+		assert.Equal(t, int64(2), updated.Version)
+		assert.Equal(t, int64(2), versionOf(t))
+	})
+
+	t.Run("an update with the current version succeeds", func(t *testing.T) {
+		updated := thing("third", 2)
+		require.Nil(t, repo.PutThing(context.Background(), updated, vector))
+
+		assert.Equal(t, int64(3), updated.Version)
+		assert.Equal(t, int64(3), versionOf(t))
+	})
+
+	t.Run("an update with a stale version is rejected", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), thing("lost", 2), vector)
+		require.NotNil(t, err)
+		assert.IsType(t, kinds.ErrVersionConflict{}, err)
+
+		res, err := repo.ThingByID(context.Background(), id, nil,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		assert.Equal(t, "third", res.Schema.(map[string]interface{})["name"])
+		assert.Equal(t, int64(3), res.Thing().Version)
+	})
+
+	t.Run("a merge with a stale version is rejected", func(t *testing.T) {
+		err := repo.Merge(context.Background(), kinds.MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "VersionedThingClass",
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "lost"},
+			ExpectedVersion: 2,
+		})
+		assert.IsType(t, kinds.ErrVersionConflict{}, err)
+		assert.Equal(t, int64(3), versionOf(t))
+	})
+
+	t.Run("a merge with the current version succeeds", func(t *testing.T) {
+		err := repo.Merge(context.Background(), kinds.MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "VersionedThingClass",
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "fourth"},
+			ExpectedVersion: 3,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(4), versionOf(t))
+	})
+
+	t.Run("concurrent updates with the same version", func(t *testing.T) {
+		errs := make(chan error, 10)
+		for i := 0; i < 10; i++ {
+			go func(i int) {
+				errs <- repo.PutThing(context.Background(),
+					thing(fmt.Sprintf("concurrent %d", i), 4), vector)
+			}(i)
+		}
+
+		succeeded := 0
+		for i := 0; i < 10; i++ {
+			err := <-errs
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.IsType(t, kinds.ErrVersionConflict{}, err)
+		}
+
+		assert.Equal(t, 1, succeeded, "exactly one update wins")
+		assert.Equal(t, int64(5), versionOf(t))
+	})
+}
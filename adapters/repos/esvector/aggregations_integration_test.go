@@ -42,7 +42,7 @@ func Test_Aggregations(t *testing.T) {
 	schemaGetter := &fakeSchemaGetter{
 		schema: schema.Schema{
 			Things: &models.Schema{
-				Classes: []*models.Class{productClass},
+				Classes: []*models.Class{productClass, companyClass},
 			},
 		},
 	}
@@ -59,6 +59,9 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("numerical aggregations without grouping (formerly Meta)",
 		testNumericalAggregationsWithoutGrouping(repo))
 
+	t.Run("facets",
+		testFacets(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 
@@ -610,3 +613,87 @@ func testNumericalAggregationsWithoutGrouping(repo *Repo) func(t *testing.T) {
 func ptInt(in int) *int {
 	return &in
 }
+
+func testFacets(repo *Repo) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Run("on a string prop", func(t *testing.T) {
+			res, err := repo.Facet(context.Background(), traverser.FacetParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Property:  "sector",
+				Limit:     10,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, &aggregation.Facet{
+				Values: []aggregation.FacetValue{
+					{Value: "Food", Count: 6},
+					{Value: "Financials", Count: 3},
+				},
+				Cardinality: 2,
+				OtherCount:  0,
+			}, res)
+		})
+
+		t.Run("on an int prop with a limit", func(t *testing.T) {
+			res, err := repo.Facet(context.Background(), traverser.FacetParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Property:  "price",
+				Limit:     1,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, &aggregation.Facet{
+				Values: []aggregation.FacetValue{
+					{Value: int64(70), Count: 2},
+				},
+				Cardinality: 8,
+				OtherCount:  7,
+			}, res)
+		})
+
+		t.Run("on a boolean prop", func(t *testing.T) {
+			res, err := repo.Facet(context.Background(), traverser.FacetParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Property:  "listedInIndex",
+				Limit:     10,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, []aggregation.FacetValue{
+				{Value: true, Count: 8},
+				{Value: false, Count: 1},
+			}, res.Values)
+		})
+
+		t.Run("with a filter", func(t *testing.T) {
+			res, err := repo.Facet(context.Background(), traverser.FacetParams{
+				Kind:      kind.Thing,
+				ClassName: schema.ClassName(companyClass.Class),
+				Property:  "location",
+				Limit:     10,
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						On: &filters.Path{
+							Class:    schema.ClassName(companyClass.Class),
+							Property: "sector",
+						},
+						Value: &filters.Value{
+							Value: "Financials",
+							Type:  schema.DataTypeString,
+						},
+					},
+				},
+			})
+			require.Nil(t, err)
+			assert.Equal(t, &aggregation.Facet{
+				Values: []aggregation.FacetValue{
+					{Value: "New York", Count: 2},
+					{Value: "San Francisco", Count: 1},
+				},
+				Cardinality: 2,
+				OtherCount:  0,
+			}, res)
+		})
+	}
+}
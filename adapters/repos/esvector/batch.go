@@ -25,6 +25,7 @@ import (
 type bulkControlObject struct {
 	Index  *bulkID `json:"index,omitempty"`
 	Update *bulkID `json:"update,omitempty"`
+	Delete *bulkID `json:"delete,omitempty"`
 }
 
 type bulkID struct {
@@ -42,6 +43,7 @@ type bulkIndexResponse struct {
 type bulkItem struct {
 	Index  *bulkIndexItem `json:"index"`
 	Update *bulkIndexItem `json:"update"`
+	Delete *bulkIndexItem `json:"delete"`
 }
 
 type bulkIndexItem struct {
@@ -89,7 +91,8 @@ func (r Repo) encodeBatchActions(enc *json.Encoder, batch kinds.BatchActions) er
 			vectorWeights = a.VectorWeights.(map[string]string)
 		}
 		bucket := r.objectBucket(kind.Action, a.ID.String(), a.Class, a.Schema,
-			a.Meta, vectorWeights, single.Vector, a.CreationTimeUnix, a.LastUpdateTimeUnix)
+			a.Meta, vectorWeights, single.Vector, a.CreationTimeUnix, a.LastUpdateTimeUnix,
+			a.ExpiryTimeUnix)
 
 		index := classIndexFromClassName(kind.Action, a.Class)
 		control := r.bulkIndexControlObject(index, a.ID.String())
@@ -178,7 +181,8 @@ func (r Repo) encodeBatchThings(enc *json.Encoder, batch kinds.BatchThings) erro
 			vectorWeights = t.VectorWeights.(map[string]string)
 		}
 		bucket := r.objectBucket(kind.Thing, t.ID.String(), t.Class, t.Schema,
-			t.Meta, vectorWeights, single.Vector, t.CreationTimeUnix, t.LastUpdateTimeUnix)
+			t.Meta, vectorWeights, single.Vector, t.CreationTimeUnix, t.LastUpdateTimeUnix,
+			t.ExpiryTimeUnix)
 
 		index := classIndexFromClassName(kind.Thing, t.Class)
 		control := r.bulkIndexControlObject(index, t.ID.String())
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// Count uses the count API of elasticsearch, so that the matching documents
+// are never returned. If no class name is set, the documents of all classes
+// of the kind are counted. Expired documents are not counted.
+func (r *Repo) Count(ctx context.Context, k kind.Kind, className string,
+	filters *filters.LocalFilter) (int64, error) {
+	index := allThingIndices
+	if k == kind.Action {
+		index = allActionIndices
+	}
+	if className != "" {
+		index = classIndexFromClassName(k, className)
+	}
+
+	query, err := r.queryFromFilter(ctx, filters)
+	if err != nil {
+		if _, ok := err.(SubQueryNoResultsErr); ok {
+			// a sub-query without results means no document can match
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"query": withoutExpired(query),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count: encode json: %v", err)
+	}
+
+	res, err := r.client.Count(
+		r.client.Count.WithContext(ctx),
+		r.client.Count.WithIndex(index),
+		r.client.Count.WithBody(&buf),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("count: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return 0, fmt.Errorf("count: %v", err)
+	}
+
+	var cr struct {
+		Count int64 `json:"count"`
+	}
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&cr); err != nil {
+		return 0, fmt.Errorf("count: decode json: %v", err)
+	}
+
+	return cr.Count, nil
+}
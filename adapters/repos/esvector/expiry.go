@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// expiredQuery matches all objects with an expiry time which is not after
+// now. Objects without an expiry time have no _expiry field at all.
+func expiredQuery(now int64) map[string]interface{} {
+	return map[string]interface{}{
+		"range": map[string]interface{}{
+			keyExpiry.String(): map[string]interface{}{
+				"gt":  0,
+				"lte": now,
+			},
+		},
+	}
+}
+
+// withoutExpired wraps a query so that objects which have expired, but have
+// not been removed by the sweeper yet, are not served.
+func withoutExpired(query map[string]interface{}) map[string]interface{} {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must":     query,
+			"must_not": expiredQuery(now),
+		},
+	}
+}
+
+// ExpiredObjects returns up to limit objects of any class whose expiry time
+// is not after now. The results only contain kind, class and id, which is
+// all that's needed to delete them.
+func (r *Repo) ExpiredObjects(ctx context.Context, now int64,
+	limit int) ([]search.Result, error) {
+	body := map[string]interface{}{
+		"query":   expiredQuery(now),
+		"size":    limit,
+		"_source": []string{keyKind.String(), keyClassName.String(), keyID.String()},
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return nil, fmt.Errorf("expired objects: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(strings.Join([]string{allThingIndices, allActionIndices}, ",")),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("expired objects: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("expired objects: %v", err)
+	}
+
+	var sr searchResponse
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&sr)
+	if err != nil {
+		return nil, fmt.Errorf("expired objects: decode json: %v", err)
+	}
+
+	out := make([]search.Result, len(sr.Hits.Hits))
+	for i, hit := range sr.Hits.Hits {
+		kindName, _ := hit.Source[keyKind.String()].(string)
+		k, err := kind.Parse(kindName)
+		if err != nil {
+			return nil, fmt.Errorf("expired objects: result %d: parse kind: %v", i, err)
+		}
+
+		className, _ := hit.Source[keyClassName.String()].(string)
+		id, _ := hit.Source[keyID.String()].(string)
+		out[i] = search.Result{
+			Kind:      k,
+			ClassName: className,
+			ID:        strfmt.UUID(id),
+		}
+	}
+
+	return out, nil
+}
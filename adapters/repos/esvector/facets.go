@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+const (
+	facetValuesAgg      = "values"
+	facetCardinalityAgg = "cardinality"
+)
+
+// Facet uses a terms aggregation to find the most common values of the
+// property and a cardinality aggregation to estimate the number of distinct
+// values overall
+func (r *Repo) Facet(ctx context.Context,
+	params traverser.FacetParams) (*aggregation.Facet, error) {
+	query, err := r.queryFromFilter(ctx, params.Filters)
+	if err != nil {
+		if _, ok := err.(SubQueryNoResultsErr); ok {
+			// a sub-query without results means no document can match
+			return &aggregation.Facet{Values: []aggregation.FacetValue{}}, nil
+		}
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(facetBody(query, params))
+	if err != nil {
+		return nil, fmt.Errorf("facet: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(classIndexFromClassName(params.Kind, params.ClassName.String())),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("facet: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("facet: %v", err)
+	}
+
+	var fr facetResponse
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&fr); err != nil {
+		return nil, fmt.Errorf("facet: decode json: %v", err)
+	}
+
+	return fr.facet(r.facetDataType(params))
+}
+
+func facetBody(query map[string]interface{},
+	params traverser.FacetParams) map[string]interface{} {
+	return map[string]interface{}{
+		"query": query,
+		"size":  0,
+		"aggs": map[string]interface{}{
+			facetValuesAgg: map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": params.Property,
+					"size":  params.Limit,
+				},
+			},
+			facetCardinalityAgg: map[string]interface{}{
+				"cardinality": map[string]interface{}{
+					"field": params.Property,
+				},
+			},
+		},
+	}
+}
+
+// facetDataType is only used to present the bucket keys in the right type,
+// the traverser has already made sure that the property exists
+func (r *Repo) facetDataType(params traverser.FacetParams) schema.DataType {
+	sch := r.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(params.Kind, params.ClassName)
+	if class == nil {
+		return ""
+	}
+
+	prop, err := schema.GetPropertyByName(class, params.Property.String())
+	if err != nil {
+		return ""
+	}
+
+	dt, err := sch.FindPropertyDataType(prop.DataType)
+	if err != nil || !dt.IsPrimitive() {
+		return ""
+	}
+
+	return dt.AsPrimitive()
+}
+
+type facetResponse struct {
+	Aggregations struct {
+		Values struct {
+			SumOtherDocCount int           `json:"sum_other_doc_count"`
+			Buckets          []facetBucket `json:"buckets"`
+		} `json:"values"`
+		Cardinality struct {
+			Value int `json:"value"`
+		} `json:"cardinality"`
+	} `json:"aggregations"`
+}
+
+type facetBucket struct {
+	Key         interface{} `json:"key"`
+	KeyAsString *string     `json:"key_as_string"`
+	DocCount    int         `json:"doc_count"`
+}
+
+func (fr facetResponse) facet(dt schema.DataType) (*aggregation.Facet, error) {
+	buckets := fr.Aggregations.Values.Buckets
+	values := make([]aggregation.FacetValue, len(buckets))
+	for i, bucket := range buckets {
+		v, err := bucket.value(dt)
+		if err != nil {
+			return nil, fmt.Errorf("facet: bucket %d: %v", i, err)
+		}
+
+		values[i] = aggregation.FacetValue{Value: v, Count: bucket.DocCount}
+	}
+
+	return &aggregation.Facet{
+		Values:      values,
+		Cardinality: fr.Aggregations.Cardinality.Value,
+		OtherCount:  fr.Aggregations.Values.SumOtherDocCount,
+	}, nil
+}
+
+// value turns the bucket key into the type of the property. Elasticsearch
+// returns booleans as 0/1 and dates as epoch millis, both with a formatted
+// key_as_string, all other numbers are returned as floats.
+func (b facetBucket) value(dt schema.DataType) (interface{}, error) {
+	switch dt {
+	case schema.DataTypeBoolean, schema.DataTypeDate:
+		if b.KeyAsString == nil {
+			return nil, fmt.Errorf("expected key_as_string for %s bucket", dt)
+		}
+
+		if dt == schema.DataTypeDate {
+			return *b.KeyAsString, nil
+		}
+
+		return *b.KeyAsString == "true", nil
+
+	case schema.DataTypeInt:
+		f, ok := b.Key.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected numerical key for int bucket, got %T", b.Key)
+		}
+
+		return int64(f), nil
+
+	default:
+		return b.Key, nil
+	}
+}
@@ -41,4 +41,7 @@ const (
 
 	// GeoPoint indexes a geo point
 	GeoPoint FieldType = "geo_point"
+
+	// Binary stores a base64 encoded value, it is never indexed
+	Binary FieldType = "binary"
 )
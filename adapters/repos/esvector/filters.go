@@ -47,7 +47,8 @@ func (r *Repo) singleQueryFromClause(ctx context.Context, clause *filters.Clause
 		return nil, err
 	}
 
-	if clause.Operator == filters.OperatorNotEqual {
+	if clause.Operator == filters.OperatorNotEqual ||
+		clause.Operator == filters.OperatorIsNull {
 		filter = negateFilter(filter)
 	}
 
@@ -61,6 +62,9 @@ func (r *Repo) singleQueryFromClause(ctx context.Context, clause *filters.Clause
 }
 
 func (r *Repo) filterFromClause(ctx context.Context, clause *filters.Clause) (map[string]interface{}, error) {
+	if clause.Operator.WithoutValue() {
+		return r.existsFilterFromClause(clause)
+	}
 
 	if clause.On.Child != nil {
 		sqb := newSubQueryBuilder(r)
@@ -111,6 +115,60 @@ func (r *Repo) propertyOfClauseIsReference(on *filters.Path) bool {
 	return dt.IsReference()
 }
 
+// existsFilterFromClause matches all objects which have a value for the
+// property, IsNull is the negation of it. As in the standalone db, a string
+// or text property only has a value if it contains at least one word. An
+// empty string, a string of only whitespace and a text of only punctuation
+// count as null.
+func (r *Repo) existsFilterFromClause(clause *filters.Clause) (map[string]interface{}, error) {
+	if clause.On.Child != nil {
+		return nil, fmt.Errorf("operator %s not supported on a nested path",
+			clause.Operator.Name())
+	}
+
+	field := clause.On.Property.String()
+	if r.propertyOfClauseIsReference(clause.On) {
+		field = fmt.Sprintf("%s.beacon", field)
+	}
+
+	if r.propertyOfClauseIsWordIndexed(clause.On) {
+		// a text is analyzed into words, so any of its terms is a word. A
+		// string is a single keyword term, which must not be blank.
+		return map[string]interface{}{
+			"regexp": map[string]interface{}{
+				field: nonBlankTermRegexp,
+			},
+		}, nil
+	}
+
+	return map[string]interface{}{
+		"exists": map[string]interface{}{
+			"field": field,
+		},
+	}, nil
+}
+
+// nonBlankTermRegexp matches a term with at least one character which is not
+// whitespace. The whitespace characters are the ones unicode.IsSpace reports
+// in the Latin-1 range. They are part of the pattern as they are, as the
+// regular expressions of elasticsearch have no escapes for them.
+const nonBlankTermRegexp = ".*[^ \t\n\v\f\r\u0085\u00a0].*"
+
+func (r *Repo) propertyOfClauseIsWordIndexed(on *filters.Path) bool {
+	sch := r.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(on.Class)
+	if class == nil {
+		return false
+	}
+
+	prop, err := schema.GetPropertyByName(class, on.Property.String())
+	if err != nil {
+		return false
+	}
+
+	return schema.IsWordIndexed(prop.DataType)
+}
+
 func geoFilterFromClause(clause *filters.Clause) (map[string]interface{}, error) {
 	geoRange, ok := clause.Value.Value.(filters.GeoRange)
 	if !ok {
@@ -134,8 +192,11 @@ func primitiveFilterFromClause(clause *filters.Clause) (map[string]interface{},
 		return nil, err
 	}
 
-	if clause.On.Property == "uuid" {
+	switch clause.On.Property {
+	case "uuid":
 		clause.On.Property = "_id"
+	case filters.InternalPropCreationTimeUnix:
+		clause.On.Property = schema.PropertyName(keyCreated)
 	}
 
 	return map[string]interface{}{
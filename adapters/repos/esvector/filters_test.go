@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullFilters(t *testing.T) {
+	repo := &Repo{schemaGetter: &fakeSchemaGetter{
+		schema: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Car",
+						Properties: []*models.Property{
+							{Name: "modelName", DataType: []string{"string"}},
+							{Name: "description", DataType: []string{"text"}},
+							{Name: "horsepower", DataType: []string{"int"}},
+							{Name: "ownedBy", DataType: []string{"Person"}},
+						},
+					},
+					{
+						Class: "Person",
+					},
+				},
+			},
+		},
+	}}
+
+	nullFilter := func(prop string, op filters.Operator) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: op,
+			On: &filters.Path{
+				Class:    "Car",
+				Property: schema.PropertyName(prop),
+			},
+		}}
+	}
+
+	exists := func(field string) map[string]interface{} {
+		return map[string]interface{}{
+			"exists": map[string]interface{}{
+				"field": field,
+			},
+		}
+	}
+
+	nonBlank := func(field string) map[string]interface{} {
+		return map[string]interface{}{
+			"regexp": map[string]interface{}{
+				field: nonBlankTermRegexp,
+			},
+		}
+	}
+
+	t.Run("IsNotNull on a primitive prop", func(t *testing.T) {
+		q, err := repo.queryFromFilter(context.Background(),
+			nullFilter("horsepower", filters.OperatorIsNotNull))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": exists("horsepower"),
+			},
+		}, q)
+	})
+
+	t.Run("IsNull on a primitive prop", func(t *testing.T) {
+		q, err := repo.queryFromFilter(context.Background(),
+			nullFilter("horsepower", filters.OperatorIsNull))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": negateFilter(exists("horsepower")),
+			},
+		}, q)
+	})
+
+	// the same as in the standalone db: only a string or text with at least
+	// one word has a value
+	t.Run("IsNull on a string prop", func(t *testing.T) {
+		q, err := repo.queryFromFilter(context.Background(),
+			nullFilter("modelName", filters.OperatorIsNull))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": negateFilter(nonBlank("modelName")),
+			},
+		}, q)
+	})
+
+	t.Run("IsNotNull on a text prop", func(t *testing.T) {
+		q, err := repo.queryFromFilter(context.Background(),
+			nullFilter("description", filters.OperatorIsNotNull))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": nonBlank("description"),
+			},
+		}, q)
+	})
+
+	t.Run("blank terms don't count as a value", func(t *testing.T) {
+		pattern := regexp.MustCompile("^" + nonBlankTermRegexp + "$")
+		for _, blank := range []string{"", " ", " \t\n ", "\u00a0"} {
+			assert.False(t, pattern.MatchString(blank), "%q", blank)
+		}
+		for _, word := range []string{"a", " polo ", "?!"} {
+			assert.True(t, pattern.MatchString(word), "%q", word)
+		}
+	})
+
+	t.Run("IsNull on a reference prop", func(t *testing.T) {
+		q, err := repo.queryFromFilter(context.Background(),
+			nullFilter("ownedBy", filters.OperatorIsNull))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": negateFilter(exists("ownedBy.beacon")),
+			},
+		}, q)
+	})
+
+	t.Run("IsNotNull on a nested path", func(t *testing.T) {
+		filter := nullFilter("ownedBy", filters.OperatorIsNotNull)
+		filter.Root.On.Child = &filters.Path{Class: "Person", Property: "name"}
+		_, err := repo.queryFromFilter(context.Background(), filter)
+		assert.NotNil(t, err)
+	})
+}
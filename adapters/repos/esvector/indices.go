@@ -22,7 +22,11 @@ import (
 
 // PutIndex idempotently creates an index
 func (r *Repo) PutIndex(ctx context.Context, index string) error {
+	return r.putIndex(ctx, index, r.numberOfShards, r.autoExpandReplicas)
+}
 
+func (r *Repo) putIndex(ctx context.Context, index string, numberOfShards int,
+	autoExpandReplicas string) error {
 	ok, err := r.indexExists(ctx, index)
 	if err != nil {
 		return fmt.Errorf("create index: %v", err)
@@ -35,8 +39,8 @@ func (r *Repo) PutIndex(ctx context.Context, index string) error {
 	body := map[string]interface{}{
 		"settings": map[string]interface{}{
 			// "index.mapping.single_type": true,
-			"index.number_of_shards":     r.numberOfShards,
-			"index.auto_expand_replicas": r.autoExpandReplicas,
+			"index.number_of_shards":     numberOfShards,
+			"index.auto_expand_replicas": autoExpandReplicas,
 		},
 	}
 
@@ -63,10 +67,16 @@ func (r *Repo) PutIndex(ctx context.Context, index string) error {
 	return nil
 }
 
-// DeleteIndex idempotently creates an index
+// DeleteIndex deletes an index. If index is the alias of a resharded class,
+// the index behind the alias is deleted.
 func (r *Repo) DeleteIndex(ctx context.Context, index string) error {
+	concrete, err := r.concreteIndex(ctx, index)
+	if err != nil {
+		return fmt.Errorf("delete index: %v", err)
+	}
+
 	req := esapi.IndicesDeleteRequest{
-		Index: []string{index},
+		Index: []string{concrete},
 	}
 
 	res, err := req.Do(ctx, r.client)
@@ -81,6 +91,208 @@ func (r *Repo) DeleteIndex(ctx context.Context, index string) error {
 	return nil
 }
 
+// removeFieldAttempts is how often RemoveField retries documents which were
+// changed concurrently and could therefore not be updated.
+const removeFieldAttempts = 3
+
+// RemoveField removes a field from all documents of an index and only returns
+// once all documents have been updated, so that the old values can't
+// resurface if a field with the same name is added again. The mapping of the
+// field is kept, as mappings cannot be deleted in elasticsearch.
+func (r *Repo) RemoveField(ctx context.Context, index, field string) error {
+	for attempt := 1; attempt <= removeFieldAttempts; attempt++ {
+		conflicts, err := r.removeField(ctx, index, field)
+		if err != nil {
+			return fmt.Errorf("remove field: %v", err)
+		}
+
+		if conflicts == 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("remove field: documents still had version conflicts "+
+		"after %d attempts", removeFieldAttempts)
+}
+
+// removeField runs a single update by query and returns the number of
+// documents which were skipped, because they were changed in the meantime.
+func (r *Repo) removeField(ctx context.Context, index, field string) (int, error) {
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"exists": map[string]interface{}{
+				"field": field,
+			},
+		},
+		"script": map[string]interface{}{
+			"source": "ctx._source.remove(params.field)",
+			"lang":   "painless",
+			"params": map[string]interface{}{
+				"field": field,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return 0, err
+	}
+
+	refresh := true
+	waitForCompletion := true
+	req := esapi.UpdateByQueryRequest{
+		Index:             []string{index},
+		Body:              &buf,
+		Conflicts:         "proceed",
+		Refresh:           &refresh,
+		WaitForCompletion: &waitForCompletion,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return 0, err
+	}
+
+	var parsed struct {
+		VersionConflicts int `json:"version_conflicts"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return 0, fmt.Errorf("decode response: %v", err)
+	}
+
+	return parsed.VersionConflicts, nil
+}
+
+// RenameIndex copies all documents into a new index with identical mappings,
+// sets their class name and deletes the old index afterwards. Every step is
+// idempotent, so a rename which was interrupted can be retried.
+func (r *Repo) RenameIndex(ctx context.Context, from, to, className string) error {
+	ok, err := r.indexExists(ctx, from)
+	if err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	if !ok {
+		ok, err := r.indexExists(ctx, to)
+		if err != nil {
+			return fmt.Errorf("rename index: %v", err)
+		}
+
+		if ok {
+			// a previous attempt has already completed
+			return nil
+		}
+
+		return fmt.Errorf("rename index: index %s does not exist", from)
+	}
+
+	props, err := r.getMappings(ctx, from)
+	if err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	if err := r.PutIndex(ctx, to); err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	if err := r.SetMappings(ctx, to, props); err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	if err := r.reindex(ctx, from, to, className); err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	if err := r.DeleteIndex(ctx, from); err != nil {
+		return fmt.Errorf("rename index: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) getMappings(ctx context.Context, index string) (map[string]interface{}, error) {
+	req := esapi.IndicesGetMappingRequest{
+		Index: []string{index},
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("get mappings: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("get mappings: %v", err)
+	}
+
+	var parsed map[string]struct {
+		Mappings struct {
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"mappings"`
+	}
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("get mappings: decode json: %v", err)
+	}
+
+	// the response is keyed by the concrete index, which differs from index if
+	// index is an alias
+	for _, mappings := range parsed {
+		return mappings.Mappings.Properties, nil
+	}
+
+	return nil, fmt.Errorf("get mappings: no mappings for index %s", index)
+}
+
+func (r *Repo) reindex(ctx context.Context, from, to, className string) error {
+	body := map[string]interface{}{
+		"source": map[string]interface{}{
+			"index": from,
+		},
+		"dest": map[string]interface{}{
+			"index": to,
+		},
+		"script": map[string]interface{}{
+			"source": "ctx._source[params.key] = params.className",
+			"lang":   "painless",
+			"params": map[string]interface{}{
+				"key":       keyClassName.String(),
+				"className": className,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return fmt.Errorf("reindex: %v", err)
+	}
+
+	refresh := true
+	waitForCompletion := true
+	req := esapi.ReindexRequest{
+		Body:              &buf,
+		Refresh:           &refresh,
+		WaitForCompletion: &waitForCompletion,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("reindex: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("reindex: %v", err)
+	}
+
+	return nil
+}
+
 func (r *Repo) indexExists(ctx context.Context, index string) (bool, error) {
 	req := esapi.IndicesExistsRequest{
 		Index: []string{index},
@@ -122,6 +334,10 @@ func (r *Repo) SetMappings(ctx context.Context, index string,
 		"type": "date",
 	}
 
+	props[keyExpiry.String()] = map[string]interface{}{
+		"type": "date",
+	}
+
 	props[keyID.String()] = map[string]interface{}{
 		"type": "keyword",
 	}
@@ -19,10 +19,14 @@ import (
 	"strings"
 
 	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
 )
 
 func (r *Repo) Merge(ctx context.Context, merge kinds.MergeDocument) error {
+	if err := validateNoExpectedVersion(merge.ExpectedVersion); err != nil {
+		return err
+	}
 
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
@@ -40,7 +44,7 @@ func (r *Repo) Merge(ctx context.Context, merge kinds.MergeDocument) error {
 	}
 
 	if err := errorResToErr(res, r.logger); err != nil {
-		return fmt.Errorf("merge: %v", err)
+		return keepUnavailable(err, "merge")
 	}
 
 	return r.errorsInBulkResponse(res)
@@ -62,6 +66,9 @@ func (r *Repo) errorsInBulkResponse(res *esapi.Response) error {
 	for _, item := range parsed.Items {
 		err := item.Update.Error
 		if err != nil {
+			if blocked := writeBlockedErr(err); blocked != nil {
+				return blocked
+			}
 			errors = append(errors, fmt.Sprintf("%v", err))
 		}
 	}
@@ -114,7 +121,51 @@ func (r *Repo) encodeMergePrimitive(enc *json.Encoder, merge kinds.MergeDocument
 }
 
 func (r *Repo) encodeMergeRefs(enc *json.Encoder, merge kinds.MergeDocument) error {
-	return r.encodeBatchReferences(enc, merge.References)
+	if !merge.UniqueReferences {
+		return r.encodeBatchReferences(enc, merge.References)
+	}
+
+	for _, single := range merge.References {
+		bucket := r.upsertUniqueReferenceBucket(single.From.Property.String(), single.To.SingleRef())
+		index := classIndexFromClassName(single.From.Kind, single.From.Class.String())
+		control := r.bulkUpdateControlObject(index, single.From.TargetID.String())
+
+		if err := enc.Encode(control); err != nil {
+			return err
+		}
+
+		if err := enc.Encode(bucket); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertUniqueReferenceBucket only adds the reference if no reference with the
+// same beacon is present yet. As the check happens in the update script, it
+// is safe against concurrent updates of the same document.
+func (r *Repo) upsertUniqueReferenceBucket(refProp string, ref *models.SingleRef) map[string]interface{} {
+	return map[string]interface{}{
+		"upsert": map[string]interface{}{
+			refProp: []interface{}{},
+		},
+		"script": map[string]interface{}{
+			"source": fmt.Sprintf(`
+				if (!ctx._source.containsKey("%s")) {
+					ctx._source.%s = [params.refs]
+				} else if (!ctx._source.%s.stream().anyMatch(r -> r.beacon == params.refs.beacon)) {
+					ctx._source.%s.add(params.refs)
+				} else {
+					ctx.op = "none"
+				}
+			`, refProp, refProp, refProp, refProp),
+			"lang": "painless",
+			"params": map[string]interface{}{
+				"refs": ref,
+			},
+		},
+	}
 }
 
 func (r *Repo) primitiveUpsertBucket(schema map[string]interface{}) map[string]interface{} {
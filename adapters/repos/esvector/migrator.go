@@ -59,12 +59,18 @@ func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className stri
 	return nil
 }
 
-// UpdateClass does nothing if the keywords should be changed and errors if the
-// className should be changed - the class name must be immutable when the
-// esvector index is active
+// UpdateClass does nothing if the keywords should be changed. If the
+// className should be changed, the class index is copied into a new index
+// under the new name
 func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className string, newClassName *string, newKeywords *models.Keywords) error {
-	if newClassName != nil {
-		return fmt.Errorf("esvector does not support renaming of classes")
+	if newClassName == nil {
+		return nil
+	}
+
+	err := m.repo.RenameIndex(ctx, classIndexFromClassName(kind, className),
+		classIndexFromClassName(kind, *newClassName), *newClassName)
+	if err != nil {
+		return fmt.Errorf("rename class %s to %s: %v", className, *newClassName, err)
 	}
 
 	return nil
@@ -84,10 +90,16 @@ func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className st
 	return nil
 }
 
-// DropProperty has no effect since mapped property types cannot be deleted in
-// elasticsearch
+// DropProperty removes the stored values of the property in the background.
+// The mapped property type itself cannot be deleted in elasticsearch.
 func (m *Migrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propertyName string) error {
-	// ignore but don't error
+	index := classIndexFromClassName(kind, className)
+	err := m.repo.RemoveField(ctx, index, propertyName)
+	if err != nil {
+		return fmt.Errorf("drop property %s from class %s: %v",
+			propertyName, className, err)
+	}
+
 	return nil
 }
 
@@ -119,6 +131,18 @@ func classIndexFromClassName(kind kind.Kind, className string) string {
 		indexPrefix, kind.Name(), strings.ToLower(className))
 }
 
+// classIndicesFromClassNames is a comma-separated list of index patterns
+// which match the indices of the classes regardless of their kind. Class
+// names cannot contain an underscore, so the pattern matches no other class.
+func classIndicesFromClassNames(classNames []string) string {
+	patterns := make([]string, len(classNames))
+	for i, className := range classNames {
+		patterns[i] = fmt.Sprintf("%s*_%s", indexPrefix, strings.ToLower(className))
+	}
+
+	return strings.Join(patterns, ",")
+}
+
 const allThingIndices = indexPrefix + "thing_*"
 const allActionIndices = indexPrefix + "action_*"
 const allClassIndices = indexPrefix + "*"
@@ -138,7 +162,7 @@ func (m *Migrator) esPropsFromClassProps(props []*models.Property, depth int) (m
 
 	for _, prop := range props {
 		// index everything unless explicitly turned off
-		index := true
+		index := schema.HasInvertedIndex(prop)
 		if prop.Index != nil && *prop.Index == false {
 			index = false
 		}
@@ -160,6 +184,11 @@ func (m *Migrator) esPropsFromClassProps(props []*models.Property, depth int) (m
 			esProperties[prop.Name] = typeMap(GeoPoint, index)
 		case string(schema.DataTypePhoneNumber):
 			esProperties[prop.Name] = typeMapPhoneNumber(index)
+		case string(schema.DataTypeBlob):
+			// binary fields are never searchable
+			esProperties[prop.Name] = map[string]interface{}{
+				"type": Binary,
+			}
 		default:
 			// must be a ref
 
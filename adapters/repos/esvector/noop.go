@@ -38,7 +38,7 @@ func (r *NoOpRepo) VectorClassSearch(ctx context.Context, kind kind.Kind,
 
 // VectorSearch panics
 func (r *NoOpRepo) VectorSearch(ctx context.Context, vector []float32, limit int,
-	filters *filters.LocalFilter) ([]search.Result, error) {
+	filters *filters.LocalFilter, classNames []string) ([]search.Result, error) {
 	panic("no op repo: not implemented")
 }
 func (r *NoOpRepo) ThingByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties) (*search.Result, error) {
@@ -61,12 +61,22 @@ func (r *NoOpRepo) Aggregate(ctx context.Context, params traverser.AggregatePara
 	panic("no op repo: not implemented")
 }
 
+func (r *NoOpRepo) Facet(ctx context.Context, params traverser.FacetParams) (*aggregation.Facet, error) {
+	panic("no op repo: not implemented")
+}
+
 // PutThing does nothing, but doesn't error either
 func (r *NoOpRepo) PutThing(ctx context.Context,
 	concept *models.Thing, vector []float32) error {
 	return nil
 }
 
+// CreateThing does nothing, but doesn't error either
+func (r *NoOpRepo) CreateThing(ctx context.Context,
+	concept *models.Thing, vector []float32) error {
+	return nil
+}
+
 func (r *NoOpRepo) BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kinds.BatchThings, error) {
 	return nil, nil
 }
@@ -82,6 +92,12 @@ func (r *NoOpRepo) PutAction(ctx context.Context,
 	return nil
 }
 
+// CreateAction does nothing, but doesn't error either
+func (r *NoOpRepo) CreateAction(ctx context.Context,
+	concept *models.Action, vector []float32) error {
+	return nil
+}
+
 func (r *NoOpRepo) BatchPutActions(ctx context.Context, batch kinds.BatchActions) (kinds.BatchActions, error) {
 	return nil, nil
 }
@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// ReindexClass is not supported by the esvector repo, vectors can only be
+// replaced by updating the objects themselves
+func (r *Repo) ReindexClass(ctx context.Context, k kind.Kind, className string,
+	resume bool, vectorize func(*search.Result) ([]float32, error),
+	progress func(processed int)) error {
+	return fmt.Errorf("reindexing %s/%s: not supported by the esvector repo", k, className)
+}
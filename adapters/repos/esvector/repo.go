@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -46,6 +48,7 @@ const (
 	keyClassName internalKey = "_class_name"
 	keyCreated   internalKey = "_created"
 	keyUpdated   internalKey = "_updated"
+	keyExpiry    internalKey = "_expiry"
 
 	// meta in references
 	keyMeta                              internalKey = "meta"
@@ -121,17 +124,25 @@ func (r *Repo) WaitForStartup(maxWaitTime time.Duration) error {
 		Infof("waiting for es vector to start up (maximum %s)", maxWaitTime)
 
 	var lastErr error
+	start := time.Now()
+	attempts := 0
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return fmt.Errorf("esvector didn't start up in time: %v, last error: %v", err, lastErr)
+			return fmt.Errorf("esvector didn't start up in time: still waiting for "+
+				"elasticsearch to respond after %d attempts: %v, last error: %v",
+				attempts, err, lastErr)
 		}
 
+		attempts++
 		_, err := r.client.Info()
 		if err != nil {
 			lastErr = err
 			r.logger.WithError(err).WithField("action", "esvector_startup_cycle").
-				Debug("esvector not ready yet, trying again in 1s")
+				WithField("attempts", attempts).
+				WithField("elapsed", time.Since(start)).
+				Infof("waiting for elasticsearch to respond, %d%% of maximum wait time elapsed",
+					time.Since(start)*100/maxWaitTime)
 		} else {
 			return nil
 		}
@@ -140,9 +151,40 @@ func (r *Repo) WaitForStartup(maxWaitTime time.Duration) error {
 	}
 }
 
+// Ping checks whether elasticsearch is reachable and responds without errors
+func (r *Repo) Ping(ctx context.Context) error {
+	res, err := r.client.Info(r.client.Info.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("esvector: ping: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("esvector: ping: status %s", res.Status())
+	}
+
+	return nil
+}
+
 // PutThing idempotently adds a Thing with its vector representation
 func (r *Repo) PutThing(ctx context.Context,
 	object *models.Thing, vector []float32) error {
+	return r.putThing(ctx, object, vector, false)
+}
+
+// CreateThing adds a Thing with its vector representation, unless there is
+// already a document with its id in the class index
+func (r *Repo) CreateThing(ctx context.Context,
+	object *models.Thing, vector []float32) error {
+	return r.putThing(ctx, object, vector, true)
+}
+
+func (r *Repo) putThing(ctx context.Context,
+	object *models.Thing, vector []float32, create bool) error {
+	if err := validateNoExpectedVersion(object.Version); err != nil {
+		return err
+	}
+
 	var vectorWeights map[string]string
 	if object.VectorWeights != nil {
 		vectorWeights = object.VectorWeights.(map[string]string)
@@ -150,9 +192,12 @@ func (r *Repo) PutThing(ctx context.Context,
 
 	err := r.putObject(ctx, kind.Thing, object.ID.String(),
 		object.Class, object.Schema, object.Meta, vectorWeights,
-		vector, object.CreationTimeUnix, object.LastUpdateTimeUnix)
+		vector, object.CreationTimeUnix, object.LastUpdateTimeUnix, object.ExpiryTimeUnix, create)
 	if err != nil {
-		return fmt.Errorf("put thing: %v", err)
+		if _, ok := err.(kinds.ErrAlreadyExists); ok {
+			return err
+		}
+		return keepUnavailable(err, "put thing")
 	}
 
 	return nil
@@ -161,6 +206,22 @@ func (r *Repo) PutThing(ctx context.Context,
 // PutAction idempotently adds a Action with its vector representation
 func (r *Repo) PutAction(ctx context.Context,
 	object *models.Action, vector []float32) error {
+	return r.putAction(ctx, object, vector, false)
+}
+
+// CreateAction adds a Action with its vector representation, unless there is
+// already a document with its id in the class index
+func (r *Repo) CreateAction(ctx context.Context,
+	object *models.Action, vector []float32) error {
+	return r.putAction(ctx, object, vector, true)
+}
+
+func (r *Repo) putAction(ctx context.Context,
+	object *models.Action, vector []float32, create bool) error {
+	if err := validateNoExpectedVersion(object.Version); err != nil {
+		return err
+	}
+
 	var vectorWeights map[string]string
 	if object.VectorWeights != nil {
 		vectorWeights = object.VectorWeights.(map[string]string)
@@ -168,9 +229,12 @@ func (r *Repo) PutAction(ctx context.Context,
 
 	err := r.putObject(ctx, kind.Action, object.ID.String(),
 		object.Class, object.Schema, object.Meta, vectorWeights, vector,
-		object.CreationTimeUnix, object.LastUpdateTimeUnix)
+		object.CreationTimeUnix, object.LastUpdateTimeUnix, object.ExpiryTimeUnix, create)
 	if err != nil {
-		return fmt.Errorf("put action: %v", err)
+		if _, ok := err.(kinds.ErrAlreadyExists); ok {
+			return err
+		}
+		return keepUnavailable(err, "put action")
 	}
 
 	return nil
@@ -178,7 +242,7 @@ func (r *Repo) PutAction(ctx context.Context,
 
 func (r *Repo) objectBucket(k kind.Kind, id, className string, props models.PropertySchema,
 	meta *models.UnderscoreProperties, vectorWeights map[string]string, vector []float32,
-	createTime, updateTime int64) map[string]interface{} {
+	createTime, updateTime, expiryTime int64) map[string]interface{} {
 
 	bucket := map[string]interface{}{
 		keyKind.String():                 k.Name(),
@@ -191,6 +255,10 @@ func (r *Repo) objectBucket(k kind.Kind, id, className string, props models.Prop
 		keyVectorWeights.String():        vectorWeights,
 	}
 
+	if expiryTime != 0 {
+		bucket[keyExpiry.String()] = expiryTime
+	}
+
 	ex := r.addPropsToBucket(bucket, props)
 	return ex
 }
@@ -198,9 +266,10 @@ func (r *Repo) objectBucket(k kind.Kind, id, className string, props models.Prop
 func (r *Repo) putObject(ctx context.Context,
 	k kind.Kind, id, className string, props models.PropertySchema,
 	meta *models.UnderscoreProperties, vectorWeights map[string]string, vector []float32,
-	createTime, updateTime int64) error {
+	createTime, updateTime, expiryTime int64, create bool) error {
 
-	bucket := r.objectBucket(k, id, className, props, meta, vectorWeights, vector, createTime, updateTime)
+	bucket := r.objectBucket(k, id, className, props, meta, vectorWeights, vector,
+		createTime, updateTime, expiryTime)
 
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(bucket)
@@ -213,12 +282,20 @@ func (r *Repo) putObject(ctx context.Context,
 		DocumentID: id,
 		Body:       &buf,
 	}
+	if create {
+		// es rejects the request atomically if the document exists already
+		req.OpType = "create"
+	}
 
 	res, err := req.Do(ctx, r.client)
 	if err != nil {
 		return fmt.Errorf("index request: %v", err)
 	}
 
+	if create && res.StatusCode == http.StatusConflict {
+		return kinds.NewErrAlreadyExists("id '%s' already exists", id)
+	}
+
 	if err := errorResToErr(res, r.logger); err != nil {
 		r.logger.WithField("action", "vector_index_put_concept").
 			WithError(err).
@@ -228,7 +305,7 @@ func (r *Repo) putObject(ctx context.Context,
 			WithField("body", buf.String()).
 			Errorf("put concept failed")
 
-		return fmt.Errorf("index request: %v", err)
+		return keepUnavailable(err, "index request")
 	}
 
 	return nil
@@ -246,7 +323,7 @@ func (r *Repo) DeleteThing(ctx context.Context, className string, id strfmt.UUID
 	}
 
 	if err := errorResToErr(res, r.logger); err != nil {
-		return fmt.Errorf("delete thing: %v", err)
+		return keepUnavailable(err, "delete thing")
 	}
 
 	return nil
@@ -264,7 +341,7 @@ func (r *Repo) DeleteAction(ctx context.Context, className string, id strfmt.UUI
 	}
 
 	if err := errorResToErr(res, r.logger); err != nil {
-		return fmt.Errorf("delete action: %v", err)
+		return keepUnavailable(err, "delete action")
 	}
 
 	return nil
@@ -330,6 +407,10 @@ func errorResToErr(res *esapi.Response, logger logrus.FieldLogger) error {
 		return fmt.Errorf("request is error: status: %s", res.Status())
 	}
 
+	if err := writeBlockedErr(e["error"]); err != nil {
+		return err
+	}
+
 	logger.WithField("error", e).Error("error response from es")
 
 	shardInfo := extractShardInfoFromError(e["error"].(map[string]interface{}))
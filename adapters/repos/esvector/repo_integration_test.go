@@ -154,7 +154,7 @@ func TestEsVectorRepo(t *testing.T) {
 		// somewhat far from the thing. So it should match the action closer
 		searchVector := []float32{2.9, 1.1, 0.5, 8.01}
 
-		res, err := repo.VectorSearch(context.Background(), searchVector, 10, nil)
+		res, err := repo.VectorSearch(context.Background(), searchVector, 10, nil, nil)
 
 		require.Nil(t, err)
 		require.Equal(t, true, len(res) >= 2)
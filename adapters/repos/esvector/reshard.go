@@ -0,0 +1,604 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// reshardIndexPrefix must not start with indexPrefix, otherwise the wildcard
+// patterns matching all class indices would also match a half-copied index
+const reshardIndexPrefix = "resharded_"
+
+const reshardPageSize = 1000
+
+var reshardPollInterval = 1 * time.Second
+
+// reshardRetryAfter is how long a client is asked to wait before it retries a
+// write which was rejected during the catch-up of a reshard. The catch-up only
+// copies the changes which were made during the copy, so it is short.
+var reshardRetryAfter = 5 * time.Second
+
+// ReshardClass moves all objects of the class into a new index with the
+// specified number of shards and replicas. The class stays queryable
+// throughout: the objects are copied in the background while searches and
+// writes are still served by the current index. Only for the final catch-up,
+// which copies the changes made during the copy, writes to the class are
+// blocked, they fail with a kinds.ErrUnavailable. Afterwards the class index name becomes an alias of the new index
+// and the previous index is deleted in the same atomic step.
+//
+// The new index is named after its configuration, so calling ReshardClass
+// with the same configuration after an interruption picks up the partial
+// copy. Objects which were already copied are skipped, as the copy keeps the
+// versions of the documents.
+func (r *Repo) ReshardClass(ctx context.Context, k kind.Kind, className string,
+	numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	alias := classIndexFromClassName(k, className)
+	source, err := r.concreteIndex(ctx, alias)
+	if err != nil {
+		return fmt.Errorf("reshard %s/%s: %v", k, className, err)
+	}
+
+	target := reshardIndexName(alias, numberOfShards, autoExpandReplicas)
+	if source == target {
+		// a previous attempt has already completed
+		return nil
+	}
+
+	if err := r.reshard(ctx, alias, source, target, numberOfShards,
+		autoExpandReplicas, progress); err != nil {
+		return fmt.Errorf("reshard %s/%s: %v", k, className, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) reshard(ctx context.Context, alias, source, target string,
+	numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	err := r.copyAndSwap(ctx, alias, source, target, numberOfShards,
+		autoExpandReplicas, progress)
+	if err == nil {
+		return nil
+	}
+
+	// the source index is still in use, it must not stay read-only. It may
+	// also still be blocked by an attempt which was interrupted by a restart.
+	if unblockErr := r.setWriteBlock(ctx, source, false); unblockErr != nil {
+		r.logger.WithField("action", "esvector_reshard").
+			WithField("index", source).WithError(unblockErr).
+			Error("could not remove write block after failed reshard")
+	}
+
+	return err
+}
+
+func (r *Repo) copyAndSwap(ctx context.Context, alias, source, target string,
+	numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	if err := r.putIndex(ctx, target, numberOfShards, autoExpandReplicas); err != nil {
+		return err
+	}
+
+	if err := r.syncMappings(ctx, source, target); err != nil {
+		return err
+	}
+
+	if err := r.copyDocuments(ctx, source, target, progress); err != nil {
+		return err
+	}
+
+	if err := r.setWriteBlock(ctx, source, true); err != nil {
+		return err
+	}
+
+	if err := r.catchUp(ctx, source, target, progress); err != nil {
+		return err
+	}
+
+	// the write block does not prevent mapping changes, so properties which
+	// were added during the catch-up have to be copied as well
+	if err := r.syncMappings(ctx, source, target); err != nil {
+		return err
+	}
+
+	return r.swapAlias(ctx, alias, source, target)
+}
+
+// syncMappings copies the mappings of source to target, including those of
+// properties which were added since the last sync
+func (r *Repo) syncMappings(ctx context.Context, source, target string) error {
+	props, err := r.getMappings(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	return r.SetMappings(ctx, target, props)
+}
+
+func reshardIndexName(alias string, numberOfShards int, autoExpandReplicas string) string {
+	return fmt.Sprintf("%s%s_%d_%s", reshardIndexPrefix, alias, numberOfShards,
+		strings.ToLower(autoExpandReplicas))
+}
+
+// catchUp copies all changes made to source during the copy. It must only be
+// called while source is blocked for writes.
+func (r *Repo) catchUp(ctx context.Context, source, target string,
+	progress func(total, processed int)) error {
+	req := esapi.IndicesRefreshRequest{
+		Index: []string{source},
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("refresh source index: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("refresh source index: %v", err)
+	}
+
+	// documents which were written during the copy may contain properties
+	// which were added after the target's mappings were set
+	if err := r.syncMappings(ctx, source, target); err != nil {
+		return err
+	}
+
+	if err := r.copyDocuments(ctx, source, target, progress); err != nil {
+		return err
+	}
+
+	return r.removeDeleted(ctx, source, target)
+}
+
+// copyDocuments starts a reindex task and polls it until it is done. The
+// versions of the source documents are kept, so documents which already
+// exist in target with the same version are skipped.
+func (r *Repo) copyDocuments(ctx context.Context, source, target string,
+	progress func(total, processed int)) error {
+	body := map[string]interface{}{
+		"conflicts": "proceed",
+		"source": map[string]interface{}{
+			"index": source,
+		},
+		"dest": map[string]interface{}{
+			"index":        target,
+			"version_type": "external",
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return fmt.Errorf("copy documents: %v", err)
+	}
+
+	refresh := true
+	waitForCompletion := false
+	req := esapi.ReindexRequest{
+		Body:              &buf,
+		Refresh:           &refresh,
+		WaitForCompletion: &waitForCompletion,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("copy documents: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("copy documents: %v", err)
+	}
+
+	var started struct {
+		Task string `json:"task"`
+	}
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&started); err != nil {
+		return fmt.Errorf("copy documents: decode json: %v", err)
+	}
+
+	for {
+		status, err := r.reindexTaskStatus(ctx, started.Task)
+		if err != nil {
+			return fmt.Errorf("copy documents: %v", err)
+		}
+
+		progress(status.Task.Status.Total, status.Task.Status.processed())
+
+		if status.Completed {
+			return status.err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("copy documents: %v", ctx.Err())
+		case <-time.After(reshardPollInterval):
+		}
+	}
+}
+
+type reindexTask struct {
+	Completed bool `json:"completed"`
+	Task      struct {
+		Status reindexTaskStatus `json:"status"`
+	} `json:"task"`
+	Error    map[string]interface{} `json:"error"`
+	Response struct {
+		Failures []interface{} `json:"failures"`
+	} `json:"response"`
+}
+
+type reindexTaskStatus struct {
+	Total            int `json:"total"`
+	Created          int `json:"created"`
+	Updated          int `json:"updated"`
+	VersionConflicts int `json:"version_conflicts"`
+}
+
+// processed includes the documents which were skipped because they had
+// already been copied
+func (s reindexTaskStatus) processed() int {
+	return s.Created + s.Updated + s.VersionConflicts
+}
+
+func (t reindexTask) err() error {
+	if t.Error != nil {
+		return fmt.Errorf("reindex task failed: %v", t.Error["reason"])
+	}
+
+	if len(t.Response.Failures) > 0 {
+		return fmt.Errorf("reindex task failed for %d documents, first failure: %v",
+			len(t.Response.Failures), t.Response.Failures[0])
+	}
+
+	return nil
+}
+
+func (r *Repo) reindexTaskStatus(ctx context.Context, taskID string) (*reindexTask, error) {
+	req := esapi.TasksGetRequest{
+		TaskID: taskID,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("get task %s: %v", taskID, err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("get task %s: %v", taskID, err)
+	}
+
+	var task reindexTask
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("get task %s: decode json: %v", taskID, err)
+	}
+
+	return &task, nil
+}
+
+// removeDeleted deletes all documents from target which no longer exist in
+// source, because they were deleted during the copy
+func (r *Repo) removeDeleted(ctx context.Context, source, target string) error {
+	after := ""
+	for {
+		ids, err := r.idsAfter(ctx, target, after, reshardPageSize)
+		if err != nil {
+			return fmt.Errorf("remove deleted: %v", err)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		existing, err := r.existingIDs(ctx, source, ids)
+		if err != nil {
+			return fmt.Errorf("remove deleted: %v", err)
+		}
+
+		var deleted []string
+		for _, id := range ids {
+			if !existing[id] {
+				deleted = append(deleted, id)
+			}
+		}
+
+		if err := r.bulkDelete(ctx, target, deleted); err != nil {
+			return fmt.Errorf("remove deleted: %v", err)
+		}
+
+		if len(ids) < reshardPageSize {
+			return nil
+		}
+
+		after = ids[len(ids)-1]
+	}
+}
+
+type idsResponse struct {
+	Hits struct {
+		Hits []struct {
+			ID string `json:"_id"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// idsAfter returns the ids of up to limit documents which follow after,
+// ordered by id
+func (r *Repo) idsAfter(ctx context.Context, index, after string,
+	limit int) ([]string, error) {
+	query := map[string]interface{}{
+		"match_all": map[string]interface{}{},
+	}
+	if after != "" {
+		query = map[string]interface{}{
+			"range": map[string]interface{}{
+				keyID.String(): map[string]interface{}{
+					"gt": after,
+				},
+			},
+		}
+	}
+
+	return r.searchIDs(ctx, index, map[string]interface{}{
+		"query": query,
+		"sort": []interface{}{
+			map[string]interface{}{keyID.String(): "asc"},
+		},
+		"size":    limit,
+		"_source": false,
+	})
+}
+
+func (r *Repo) existingIDs(ctx context.Context, index string,
+	ids []string) (map[string]bool, error) {
+	found, err := r.searchIDs(ctx, index, map[string]interface{}{
+		"query": map[string]interface{}{
+			"ids": map[string]interface{}{
+				"values": ids,
+			},
+		},
+		"size":    len(ids),
+		"_source": false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]bool{}
+	for _, id := range found {
+		out[id] = true
+	}
+
+	return out, nil
+}
+
+func (r *Repo) searchIDs(ctx context.Context, index string,
+	body map[string]interface{}) ([]string, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, fmt.Errorf("search ids: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(index),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search ids: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("search ids: %v", err)
+	}
+
+	var parsed idsResponse
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("search ids: decode json: %v", err)
+	}
+
+	out := make([]string, len(parsed.Hits.Hits))
+	for i, hit := range parsed.Hits.Hits {
+		out[i] = hit.ID
+	}
+
+	return out, nil
+}
+
+func (r *Repo) bulkDelete(ctx context.Context, index string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, id := range ids {
+		err := enc.Encode(bulkControlObject{
+			Delete: &bulkID{
+				Index: index,
+				ID:    id,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("bulk delete: %v", err)
+		}
+	}
+
+	req := esapi.BulkRequest{
+		Body:    &buf,
+		Refresh: "true",
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("bulk delete: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("bulk delete: %v", err)
+	}
+
+	var parsed bulkIndexResponse
+	defer res.Body.Close()
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return fmt.Errorf("bulk delete: decode json: %v", err)
+	}
+
+	for _, item := range parsed.Items {
+		if item.Delete != nil && item.Delete.Error != nil {
+			return fmt.Errorf("bulk delete: %v", item.Delete.Error)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repo) setWriteBlock(ctx context.Context, index string, blocked bool) error {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"index.blocks.write": blocked,
+	})
+	if err != nil {
+		return fmt.Errorf("set write block: %v", err)
+	}
+
+	req := esapi.IndicesPutSettingsRequest{
+		Index: []string{index},
+		Body:  &buf,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("set write block: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("set write block: %v", err)
+	}
+
+	return nil
+}
+
+// swapAlias points alias to target and deletes source in a single atomic
+// step. If source is the original index of the class, its name is the name
+// of the alias, so it has to be deleted for the alias to be created.
+func (r *Repo) swapAlias(ctx context.Context, alias, source, target string) error {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"actions": []interface{}{
+			map[string]interface{}{
+				"add": map[string]interface{}{
+					"index": target,
+					"alias": alias,
+				},
+			},
+			map[string]interface{}{
+				"remove_index": map[string]interface{}{
+					"index": source,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("swap alias: %v", err)
+	}
+
+	req := esapi.IndicesUpdateAliasesRequest{
+		Body: &buf,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("swap alias: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("swap alias: %v", err)
+	}
+
+	return nil
+}
+
+// concreteIndex returns the index behind the alias of a resharded class. If
+// the class was never resharded, the class index name is returned as is.
+func (r *Repo) concreteIndex(ctx context.Context, index string) (string, error) {
+	req := esapi.IndicesGetAliasRequest{
+		Name: []string{index},
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return "", fmt.Errorf("get alias: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return index, nil
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return "", fmt.Errorf("get alias: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("get alias: decode json: %v", err)
+	}
+
+	if len(parsed) != 1 {
+		return "", fmt.Errorf("get alias: expected alias %s to point to exactly one "+
+			"index, got %d", index, len(parsed))
+	}
+
+	for concrete := range parsed {
+		return concrete, nil
+	}
+
+	return index, nil
+}
+
+// writeBlockedErr turns the error elasticsearch returns for a write to an
+// index with a write block into a kinds.ErrUnavailable, so that the client
+// retries the write. Apart from the catch-up of a reshard, elasticsearch
+// blocks writes for example when the disk is almost full.
+func writeBlockedErr(cause interface{}) error {
+	asMap, ok := cause.(map[string]interface{})
+	if !ok || asMap["type"] != "cluster_block_exception" {
+		return nil
+	}
+
+	return kinds.NewErrUnavailable(reshardRetryAfter, "the class does not accept "+
+		"writes at the moment, for example because it is being resharded, retry "+
+		"later: %v", asMap["reason"])
+}
+
+// keepUnavailable adds context to err, unless it is a kinds.ErrUnavailable
+// which has to reach the client as it is
+func keepUnavailable(err error, context string) error {
+	if _, ok := err.(kinds.ErrUnavailable); ok {
+		return err
+	}
+
+	return fmt.Errorf("%s: %v", context, err)
+}
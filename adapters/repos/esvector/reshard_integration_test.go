@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v5"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEsVectorReshard(t *testing.T) {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{"http://localhost:9201"},
+	})
+	require.Nil(t, err)
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := NewRepo(client, logger, schemaGetter, 1, "0-1")
+	waitForEsToBeReady(t, repo)
+	migrator := NewMigrator(repo)
+	ctx := context.Background()
+
+	className := "ReshardedClass"
+	index := classIndexFromClassName(kind.Thing, className)
+	ids := make([]strfmt.UUID, 25)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("3f7ea1a5-7d7b-4d6b-9e8e-%012d", i))
+	}
+
+	t.Run("creating the class", func(t *testing.T) {
+		class := &models.Class{
+			Class: className,
+			Properties: []*models.Property{
+				&models.Property{
+					Name:     "name",
+					DataType: []string{string(schema.DataTypeString)},
+				},
+			},
+		}
+
+		require.Nil(t, migrator.AddClass(ctx, kind.Thing, class))
+	})
+
+	t.Run("importing things", func(t *testing.T) {
+		for i, id := range ids {
+			err := repo.PutThing(ctx, &models.Thing{
+				Class:  className,
+				ID:     id,
+				Schema: map[string]interface{}{"name": fmt.Sprintf("thing %d", i)},
+			}, []float32{1, 2, 3})
+			require.Nil(t, err)
+		}
+	})
+
+	var lastTotal, lastProcessed int
+	progress := func(total, processed int) {
+		lastTotal = total
+		lastProcessed = processed
+	}
+
+	t.Run("resharding the class", func(t *testing.T) {
+		err := repo.ReshardClass(ctx, kind.Thing, className, 3, "0-all", progress)
+		require.Nil(t, err)
+
+		assert.Equal(t, len(ids), lastTotal)
+		assert.Equal(t, len(ids), lastProcessed)
+	})
+
+	t.Run("the class index is an alias of the new index", func(t *testing.T) {
+		concrete, err := repo.concreteIndex(ctx, index)
+		require.Nil(t, err)
+		assert.Equal(t, reshardIndexName(index, 3, "0-all"), concrete)
+	})
+
+	t.Run("all things can still be found", func(t *testing.T) {
+		count, err := repo.Count(ctx, kind.Thing, className, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(ids)), count)
+
+		res, err := repo.ThingByID(ctx, ids[7], traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "thing 7", res.Schema.(map[string]interface{})["name"])
+	})
+
+	t.Run("the class is writable after resharding", func(t *testing.T) {
+		err := repo.PutThing(ctx, &models.Thing{
+			Class:  className,
+			ID:     ids[0],
+			Schema: map[string]interface{}{"name": "updated"},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+	})
+
+	t.Run("resharding again with the same configuration", func(t *testing.T) {
+		lastTotal, lastProcessed = 0, 0
+		err := repo.ReshardClass(ctx, kind.Thing, className, 3, "0-all", progress)
+		require.Nil(t, err)
+		assert.Equal(t, 0, lastProcessed, "nothing is left to do")
+	})
+
+	t.Run("resharding a resharded class", func(t *testing.T) {
+		err := repo.ReshardClass(ctx, kind.Thing, className, 2, "false", progress)
+		require.Nil(t, err)
+
+		concrete, err := repo.concreteIndex(ctx, index)
+		require.Nil(t, err)
+		assert.Equal(t, reshardIndexName(index, 2, "false"), concrete)
+
+		ok, err := repo.indexExists(ctx, reshardIndexName(index, 3, "0-all"))
+		require.Nil(t, err)
+		assert.False(t, ok, "the previous index was deleted")
+
+		count, err := repo.Count(ctx, kind.Thing, className, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(ids)), count)
+	})
+
+	t.Run("dropping the resharded class", func(t *testing.T) {
+		require.Nil(t, migrator.DropClass(ctx, kind.Thing, className))
+
+		ok, err := repo.indexExists(ctx, reshardIndexName(index, 2, "false"))
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteBlockedErrors(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	response := func(status int, body string) *esapi.Response {
+		return &esapi.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	t.Run("a write to a blocked index", func(t *testing.T) {
+		err := errorResToErr(response(403, `{"error":{"type":"cluster_block_exception",`+
+			`"reason":"blocked by: [FORBIDDEN/8/index write (api)];"}}`), logger)
+		require.IsType(t, kinds.ErrUnavailable{}, err)
+		assert.Equal(t, reshardRetryAfter, err.(kinds.ErrUnavailable).RetryAfter)
+		assert.Contains(t, err.Error(), "FORBIDDEN/8/index write")
+
+		// the error keeps its type, so that the client is asked to retry
+		assert.Equal(t, err, keepUnavailable(err, "put thing"))
+	})
+
+	t.Run("any other error", func(t *testing.T) {
+		err := errorResToErr(response(400, `{"error":{"type":"mapper_parsing_exception",`+
+			`"reason":"failed to parse"}}`), logger)
+		require.NotNil(t, err)
+		assert.False(t, isUnavailable(err))
+		assert.Contains(t, keepUnavailable(err, "put thing").Error(), "put thing: ")
+	})
+
+	t.Run("a blocked item of a bulk response", func(t *testing.T) {
+		repo := &Repo{logger: logger}
+		err := repo.errorsInBulkResponse(response(200, `{"errors":true,"items":[`+
+			`{"update":{"error":{"type":"cluster_block_exception","reason":"blocked"}}}]}`))
+		assert.True(t, isUnavailable(err), fmt.Sprintf("%T", err))
+	})
+}
+
+func isUnavailable(err error) bool {
+	_, ok := err.(kinds.ErrUnavailable)
+	return ok
+}
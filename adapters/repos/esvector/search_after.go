@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// ObjectsAfter returns up to limit objects of the class whose id follows
+// after, ordered by id. The ids are stored as keywords, so their sort order
+// matches the byte order used by the standalone repo. An empty cursor means
+// the end of the class was reached.
+func (r *Repo) ObjectsAfter(ctx context.Context, k kind.Kind, className string,
+	after strfmt.UUID, limit int) ([]search.Result, strfmt.UUID, error) {
+	query := map[string]interface{}{
+		"match_all": map[string]interface{}{},
+	}
+	if after != "" {
+		query = map[string]interface{}{
+			"range": map[string]interface{}{
+				keyID.String(): map[string]interface{}{
+					"gt": after.String(),
+				},
+			},
+		}
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"query": withoutExpired(query),
+		"sort": []interface{}{
+			map[string]interface{}{keyID.String(): "asc"},
+		},
+		"size": limit,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("objects after: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(classIndexFromClassName(k, className)),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("objects after: %v", err)
+	}
+
+	results, err := r.searchResponse(ctx, res, traverser.SelectProperties{},
+		traverser.UnderscoreProperties{})
+	if err != nil {
+		return nil, "", fmt.Errorf("objects after: %v", err)
+	}
+
+	if len(results) < limit || len(results) == 0 {
+		return results, "", nil
+	}
+
+	return results, results[len(results)-1].ID, nil
+}
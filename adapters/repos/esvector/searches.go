@@ -60,6 +60,20 @@ func (r *Repo) ActionByID(ctx context.Context, id strfmt.UUID,
 	return r.searchByID(ctx, allActionIndices, id, params, underscore)
 }
 
+// ThingsByIDs extracts the results matching the IDs in the order of the IDs.
+// IDs without a match have a nil entry.
+func (r *Repo) ThingsByIDs(ctx context.Context, ids []strfmt.UUID,
+	params traverser.SelectProperties, underscore traverser.UnderscoreProperties) ([]*search.Result, error) {
+	return r.searchByIDs(ctx, allThingIndices, ids, params, underscore)
+}
+
+// ActionsByIDs extracts the results matching the IDs in the order of the IDs.
+// IDs without a match have a nil entry.
+func (r *Repo) ActionsByIDs(ctx context.Context, ids []strfmt.UUID,
+	params traverser.SelectProperties, underscore traverser.UnderscoreProperties) ([]*search.Result, error) {
+	return r.searchByIDs(ctx, allActionIndices, ids, params, underscore)
+}
+
 // Exists checks if an object with the id exists, if not, it forces a refresh
 // and retries once.
 func (r *Repo) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
@@ -90,6 +104,89 @@ func (r *Repo) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	return res != nil, err
 }
 
+// ObjectClass returns the name of the class of the object with the given id.
+// Only the class name is read from the source document, so the object and
+// its vector are never transferred. Just like Exists, it forces a refresh
+// and retries once if there is no match.
+func (r *Repo) ObjectClass(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (string, bool, error) {
+	className, ok, err := r.objectClass(ctx, k, id)
+	if err != nil {
+		return "", false, fmt.Errorf("object class: %v", err)
+	}
+
+	if ok {
+		return className, true, nil
+	}
+
+	err = r.forceRefresh(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("object class: force refresh: %v", err)
+	}
+
+	className, ok, err = r.objectClass(ctx, k, id)
+	if err != nil {
+		return "", false, fmt.Errorf("object class: after forced refresh: %v", err)
+	}
+
+	return className, ok, nil
+}
+
+func (r *Repo) objectClass(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (string, bool, error) {
+	index := allThingIndices
+	if k == kind.Action {
+		index = allActionIndices
+	}
+
+	body := map[string]interface{}{
+		"query": withoutExpired(map[string]interface{}{
+			"term": map[string]interface{}{
+				keyID.String(): id,
+			},
+		}),
+		"size":    2,
+		"_source": []string{keyClassName.String()},
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return "", false, fmt.Errorf("encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(index),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return "", false, err
+	}
+
+	var sr searchResponse
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&sr)
+	if err != nil {
+		return "", false, fmt.Errorf("decode json: %v", err)
+	}
+
+	switch len(sr.Hits.Hits) {
+	case 0:
+		return "", false, nil
+	case 1:
+		className, _ := sr.Hits.Hits[0].Source[keyClassName.String()].(string)
+		return className, true, nil
+	default:
+		return "", false, fmt.Errorf("invalid number of results (%d) for id '%s'",
+			len(sr.Hits.Hits), id)
+	}
+}
+
 func (r *Repo) forceRefresh(ctx context.Context) error {
 	req := esapi.IndicesRefreshRequest{
 		Index: []string{allClassIndices},
@@ -139,6 +236,47 @@ func (r *Repo) searchByID(ctx context.Context, index string, id strfmt.UUID,
 	}
 }
 
+func (r *Repo) searchByIDs(ctx context.Context, index string, ids []strfmt.UUID,
+	properties traverser.SelectProperties, underscore traverser.UnderscoreProperties) ([]*search.Result, error) {
+	out := make([]*search.Result, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	operands := make([]filters.Clause, len(ids))
+	for i, id := range ids {
+		operands[i] = filters.Clause{
+			On:       &filters.Path{Property: schema.PropertyName(keyID)},
+			Value:    &filters.Value{Value: id},
+			Operator: filters.OperatorEqual,
+		}
+	}
+	filters := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: operands,
+		},
+	}
+	res, err := r.search(ctx, index, nil, len(ids), filters, traverser.GetParams{
+		Properties:           properties,
+		UnderscoreProperties: underscore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[strfmt.UUID]*search.Result{}
+	for i := range res {
+		byID[res[i].ID] = &res[i]
+	}
+
+	for i, id := range ids {
+		out[i] = byID[id]
+	}
+
+	return out, nil
+}
+
 type counterImpl struct {
 	sync.Mutex
 	count int
@@ -177,6 +315,38 @@ func (r *Repo) ClassSearch(ctx context.Context, params traverser.GetParams) ([]s
 	return res, err
 }
 
+// StreamSearch calls fn for every result of a class search, or of a search
+// across all classes of the kind if no class name is set. Elasticsearch
+// returns the results as a single page, so this does not reduce the memory
+// usage compared to ClassSearch, it only provides the same streaming
+// interface as the standalone repo.
+func (r *Repo) StreamSearch(ctx context.Context, params traverser.GetParams,
+	fn func(search.Result) error) error {
+	var res []search.Result
+	var err error
+	switch {
+	case params.ClassName != "":
+		res, err = r.ClassSearch(ctx, params)
+	case params.Kind == kind.Thing:
+		res, err = r.ThingSearch(ctx, params.Pagination.Limit, params.Filters,
+			params.UnderscoreProperties)
+	default:
+		res, err = r.ActionSearch(ctx, params.Pagination.Limit, params.Filters,
+			params.UnderscoreProperties)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range res {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // VectorClassSearch limits the vector search to a specific class (and kind)
 func (r *Repo) VectorClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error) {
 	ctx, cancel := limitUnlimitedContext(ctx)
@@ -196,10 +366,17 @@ func (r *Repo) VectorClassSearch(ctx context.Context, params traverser.GetParams
 	return res, err
 }
 
-// VectorSearch retrives the closest concepts by vector distance
+// VectorSearch retrives the closest concepts by vector distance. If class
+// names are set, only the indices of these classes are searched.
 func (r *Repo) VectorSearch(ctx context.Context, vector []float32,
-	limit int, filters *filters.LocalFilter) ([]search.Result, error) {
-	return r.search(ctx, "*", vector, limit, filters, traverser.GetParams{})
+	limit int, filters *filters.LocalFilter,
+	classNames []string) ([]search.Result, error) {
+	index := "*"
+	if len(classNames) > 0 {
+		index = classIndicesFromClassNames(classNames)
+	}
+
+	return r.search(ctx, index, vector, limit, filters, traverser.GetParams{})
 }
 
 func (r *Repo) search(ctx context.Context, index string,
@@ -228,7 +405,7 @@ func (r *Repo) search(ctx context.Context, index string,
 		return nil, err
 	}
 
-	body := r.buildSearchBody(query, vector, limit)
+	body := r.buildSearchBody(query, vector, limit, params.Sort)
 
 	err = json.NewEncoder(&buf).Encode(body)
 	if err != nil {
@@ -247,8 +424,10 @@ func (r *Repo) search(ctx context.Context, index string,
 	return r.searchResponse(ctx, res, params.Properties, params.UnderscoreProperties)
 }
 
-func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32, limit int) map[string]interface{} {
+func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32,
+	limit int, sort *filters.Sort) map[string]interface{} {
 	var query map[string]interface{}
+	filterQuery = withoutExpired(filterQuery)
 
 	if vector == nil {
 		query = filterQuery
@@ -276,10 +455,24 @@ func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []floa
 		}
 	}
 
-	return map[string]interface{}{
+	body := map[string]interface{}{
 		"query": query,
 		"size":  limit,
 	}
+
+	if sort != nil {
+		// creation time is the only property that can be sorted on, see
+		// filters.ValidateSort
+		body["sort"] = []interface{}{
+			map[string]interface{}{
+				keyCreated.String(): map[string]interface{}{
+					"order": string(sort.Order),
+				},
+			},
+		}
+	}
+
+	return body
 }
 
 type searchResponse struct {
@@ -358,6 +551,7 @@ func (sr searchResponse) toResults(r *Repo, properties traverser.SelectPropertie
 
 		created := parseFloat64(hit.Source, keyCreated.String())
 		updated := parseFloat64(hit.Source, keyUpdated.String())
+		expiry := parseFloat64(hit.Source, keyExpiry.String())
 
 		output[i] = search.Result{
 			ClassName:     hit.Source[keyClassName.String()].(string),
@@ -368,6 +562,7 @@ func (sr searchResponse) toResults(r *Repo, properties traverser.SelectPropertie
 			Schema:        schema,
 			Created:       int64(created),
 			Updated:       int64(updated),
+			Expiry:        int64(expiry),
 			VectorWeights: weights,
 		}
 		if underscoreProps.Classification ||
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"io"
+
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// SnapshotShard is not supported by the esvector repo, use the snapshot API
+// of elasticsearch instead
+func (r *Repo) SnapshotShard(ctx context.Context, k kind.Kind, className,
+	shardName string, w io.Writer) error {
+	return kinds.NewErrNotImplemented("shard snapshots are not supported by the esvector repo")
+}
+
+// RestoreShard is not supported by the esvector repo, use the snapshot API
+// of elasticsearch instead
+func (r *Repo) RestoreShard(ctx context.Context, k kind.Kind, className,
+	shardName string, rd io.Reader) error {
+	return kinds.NewErrNotImplemented("shard snapshots are not supported by the esvector repo")
+}
@@ -0,0 +1,26 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// TruncateClass is not supported by the esvector repo, the class has to be
+// deleted and recreated instead
+func (r *Repo) TruncateClass(ctx context.Context, k kind.Kind,
+	className string) (int64, error) {
+	return 0, fmt.Errorf("truncating %s/%s: not supported by the esvector repo", k, className)
+}
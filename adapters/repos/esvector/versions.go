@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// validateNoExpectedVersion rejects writes which rely on an object version.
+// The esvector repo does not store versions, so it could not tell whether
+// the object has changed in the meantime. Accepting the write anyway would
+// silently drop the check the client asked for.
+func validateNoExpectedVersion(expected int64) error {
+	if expected == 0 {
+		return nil
+	}
+
+	return kinds.NewErrInvalidUserInput("object versions are not supported by the " +
+		"esvector storage, retry the write without If-Match")
+}
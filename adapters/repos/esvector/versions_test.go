@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritesWithExpectedVersion(t *testing.T) {
+	// the writes are rejected before ES is contacted, so no client is needed
+	repo := &Repo{}
+	ctx := context.Background()
+
+	t.Run("putting a thing", func(t *testing.T) {
+		err := repo.PutThing(ctx, &models.Thing{Class: "Car", Version: 3}, nil)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("putting an action", func(t *testing.T) {
+		err := repo.PutAction(ctx, &models.Action{Class: "Drive", Version: 3}, nil)
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("merging", func(t *testing.T) {
+		err := repo.Merge(ctx, kinds.MergeDocument{Class: "Car", ExpectedVersion: 3})
+		assert.IsType(t, kinds.ErrInvalidUserInput{}, err)
+	})
+}
@@ -76,6 +76,47 @@ func (r *ClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*models.C
 	}
 }
 
+// List returns all classifications which have been stored so far
+func (r *ClassificationRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	res, err := r.client.Get(ctx, ClassificationStorageKey+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve keys with prefix '%s' from etcd: %v",
+			ClassificationStorageKey, err)
+	}
+
+	out := make([]*models.Classification, len(res.Kvs))
+	for i, kv := range res.Kvs {
+		out[i], err = r.unmarshalClassification(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// Watch notifies about every change of the specified classification. The
+// notification does not contain the new state, it must be retrieved using
+// Get. The channel is closed once the context expires.
+func (r *ClassificationRepo) Watch(ctx context.Context, id strfmt.UUID) <-chan struct{} {
+	out := make(chan struct{}, 1)
+	watch := r.client.Watch(clientv3.WithRequireLeader(ctx), classificationKeyFromID(id))
+
+	go func() {
+		defer close(out)
+		for range watch {
+			select {
+			case out <- struct{}{}:
+			default:
+				// a notification is already pending, the receiver will see the
+				// latest state anyway
+			}
+		}
+	}()
+
+	return out
+}
+
 func (r *ClassificationRepo) unmarshalClassification(bytes []byte) (*models.Classification, error) {
 	var class models.Classification
 	err := json.Unmarshal(bytes, &class)
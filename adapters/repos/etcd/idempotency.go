@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// IdempotencyStorageKey is the etcd key prefix used to store the results of
+// batch requests with an idempotency key
+const IdempotencyStorageKey = "/weaviate/idempotency"
+
+func idempotencyKey(key string) string {
+	return fmt.Sprintf("%s/%s", IdempotencyStorageKey, key)
+}
+
+// IdempotencyRepo is an etcd-based store for idempotency keys. Every entry is
+// attached to a lease, so etcd removes it once the ttl has passed.
+type IdempotencyRepo struct {
+	client *clientv3.Client
+}
+
+// NewIdempotencyRepo based on etcd
+func NewIdempotencyRepo(client *clientv3.Client) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		client: client,
+	}
+}
+
+// Reserve stores the pending record unless the key is already present. In
+// the latter case the present record is returned. The pending record is
+// removed once the lease has passed, unless it was completed before.
+func (r *IdempotencyRepo) Reserve(ctx context.Context, key string, pending []byte,
+	leaseDuration time.Duration) ([]byte, error) {
+	lease, err := r.client.Grant(ctx, ttlSeconds(leaseDuration))
+	if err != nil {
+		return nil, fmt.Errorf("could not grant lease in etcd: %v", err)
+	}
+
+	k := idempotencyKey(key)
+	res, err := r.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(k), "=", 0)).
+		Then(clientv3.OpPut(k, string(pending), clientv3.WithLease(lease.ID))).
+		Else(clientv3.OpGet(k)).
+		Commit()
+	if err != nil {
+		return nil, fmt.Errorf("could not reserve key '%s' in etcd: %v", k, err)
+	}
+
+	if res.Succeeded {
+		return nil, nil
+	}
+
+	// the lease is not needed, as the key was already present
+	r.client.Revoke(ctx, lease.ID)
+
+	kvs := res.Responses[0].GetResponseRange().Kvs
+	if len(kvs) != 1 {
+		return nil, fmt.Errorf("unexpected number of results for key '%s', "+
+			"expected to have 1, but got %d", k, len(kvs))
+	}
+
+	return kvs[0].Value, nil
+}
+
+// Complete overwrites the record of the key and restarts its ttl
+func (r *IdempotencyRepo) Complete(ctx context.Context, key string, record []byte,
+	ttl time.Duration) error {
+	lease, err := r.client.Grant(ctx, ttlSeconds(ttl))
+	if err != nil {
+		return fmt.Errorf("could not grant lease in etcd: %v", err)
+	}
+
+	_, err = r.client.Put(ctx, idempotencyKey(key), string(record), clientv3.WithLease(lease.ID))
+	if err != nil {
+		return fmt.Errorf("could not store key '%s' in etcd: %v", idempotencyKey(key), err)
+	}
+
+	return nil
+}
+
+// Release removes the key
+func (r *IdempotencyRepo) Release(ctx context.Context, key string) error {
+	_, err := r.client.Delete(ctx, idempotencyKey(key))
+	if err != nil {
+		return fmt.Errorf("could not delete key '%s' from etcd: %v", idempotencyKey(key), err)
+	}
+
+	return nil
+}
+
+func ttlSeconds(ttl time.Duration) int64 {
+	seconds := int64(ttl / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+
+	return seconds
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ReshardStorageKey is the etcd key used to store the status of reshards
+const ReshardStorageKey = "/weaviate/reshards"
+
+func reshardKeyFromClassName(className string) string {
+	return fmt.Sprintf("%s/%s", ReshardStorageKey, className)
+}
+
+// ReshardRepo is an etcd-based repo to persist the status of the most recent
+// reshard of each class
+type ReshardRepo struct {
+	client *clientv3.Client
+}
+
+// NewReshardRepo based on etcd
+func NewReshardRepo(client *clientv3.Client) *ReshardRepo {
+	return &ReshardRepo{
+		client: client,
+	}
+}
+
+// Put the reshard status in the remote repository, it replaces the status of
+// any previous reshard of the same class
+func (r *ReshardRepo) Put(ctx context.Context, status models.ReshardStatus) error {
+	statusBytes, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("could not marshal reshard status to json: %s", err)
+	}
+
+	_, err = r.client.Put(ctx, reshardKeyFromClassName(status.Class), string(statusBytes))
+	if err != nil {
+		return fmt.Errorf("could not store reshard status in etcd: %s", err)
+	}
+
+	return nil
+}
+
+// Get returns the status of the most recent reshard of the class, or nil if
+// the class has not been resharded
+func (r *ReshardRepo) Get(ctx context.Context, className string) (*models.ReshardStatus, error) {
+	res, err := r.client.Get(ctx, reshardKeyFromClassName(className))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve key '%s' from etcd: %v",
+			reshardKeyFromClassName(className), err)
+	}
+
+	switch k := len(res.Kvs); {
+	case k == 0:
+		return nil, nil
+	case k == 1:
+		return r.unmarshalStatus(res.Kvs[0].Value)
+	default:
+		return nil, fmt.Errorf("unexpected number of results for key '%s', "+
+			"expected to have 0 or 1, but got %d: %#v", reshardKeyFromClassName(className),
+			len(res.Kvs), res.Kvs)
+	}
+}
+
+// List returns the status of the most recent reshard of every class
+func (r *ReshardRepo) List(ctx context.Context) ([]*models.ReshardStatus, error) {
+	res, err := r.client.Get(ctx, ReshardStorageKey+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve keys with prefix '%s' from etcd: %v",
+			ReshardStorageKey, err)
+	}
+
+	out := make([]*models.ReshardStatus, len(res.Kvs))
+	for i, kv := range res.Kvs {
+		out[i], err = r.unmarshalStatus(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+func (r *ReshardRepo) unmarshalStatus(bytes []byte) (*models.ReshardStatus, error) {
+	var status models.ReshardStatus
+	err := json.Unmarshal(bytes, &status)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the reshard status: %s", err)
+	}
+
+	return &status, nil
+}
@@ -71,6 +71,17 @@ func (r *SchemaRepo) LoadSchema(ctx context.Context) (*schema.State, error) {
 	}
 }
 
+// Ping makes sure etcd can be reached by reading the schema key without
+// retrieving its value
+func (r *SchemaRepo) Ping(ctx context.Context) error {
+	_, err := r.client.Get(ctx, SchemaStateStorageKey, clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("could not reach etcd: %v", err)
+	}
+
+	return nil
+}
+
 func (r *SchemaRepo) unmarshalSchema(bytes []byte) (*schema.State, error) {
 	var state schema.State
 	err := json.Unmarshal(bytes, &state)
@@ -38,20 +38,28 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ActionsCorpus(params *ActionsCorpusParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsCorpusOK, error)
+
 	ActionsCreate(params *ActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsCreateOK, error)
 
 	ActionsDelete(params *ActionsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsDeleteNoContent, error)
 
+	ActionsFacets(params *ActionsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsFacetsOK, error)
+
 	ActionsGet(params *ActionsGetParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsGetOK, error)
 
 	ActionsList(params *ActionsListParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsListOK, error)
 
 	ActionsPatch(params *ActionsPatchParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsPatchNoContent, error)
 
+	ActionsReferencesBulkUpdate(params *ActionsReferencesBulkUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesBulkUpdateOK, error)
+
 	ActionsReferencesCreate(params *ActionsReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesCreateOK, error)
 
 	ActionsReferencesDelete(params *ActionsReferencesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesDeleteNoContent, error)
 
+	ActionsReferencesList(params *ActionsReferencesListParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesListOK, error)
+
 	ActionsReferencesUpdate(params *ActionsReferencesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesUpdateOK, error)
 
 	ActionsUpdate(params *ActionsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsUpdateOK, error)
@@ -61,6 +69,43 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  ActionsCorpus gets the vectorizer corpus of a action
+
+  Returns the exact text the vectorizer builds from a Action to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Action, it is only available if debug mode is enabled.
+*/
+func (a *Client) ActionsCorpus(params *ActionsCorpusParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsCorpusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewActionsCorpusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "actions.corpus",
+		Method:             "GET",
+		PathPattern:        "/actions/{id}/corpus",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ActionsCorpusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ActionsCorpusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for actions.corpus: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ActionsCreate creates actions between two things object and subject
 
@@ -135,6 +180,43 @@ func (a *Client) ActionsDelete(params *ActionsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+  ActionsFacets gets the distribution of the values of a property
+
+  Returns the most common distinct values of a property of a Action class, each with the number of Actions that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.
+*/
+func (a *Client) ActionsFacets(params *ActionsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsFacetsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewActionsFacetsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "actions.facets",
+		Method:             "GET",
+		PathPattern:        "/actions/facets/{className}/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ActionsFacetsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ActionsFacetsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for actions.facets: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ActionsGet gets a specific action based on its UUID and a thing UUID also available as websocket bus
 
@@ -175,7 +257,7 @@ func (a *Client) ActionsGet(params *ActionsGetParams, authInfo runtime.ClientAut
 /*
   ActionsList gets a list of actions
 
-  Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.
+  Lists all Actions in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Action per line.
 */
 func (a *Client) ActionsList(params *ActionsListParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsListOK, error) {
 	// TODO: Validate the params before sending
@@ -187,7 +269,7 @@ func (a *Client) ActionsList(params *ActionsListParams, authInfo runtime.ClientA
 		ID:                 "actions.list",
 		Method:             "GET",
 		PathPattern:        "/actions",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
@@ -212,7 +294,7 @@ func (a *Client) ActionsList(params *ActionsListParams, authInfo runtime.ClientA
 /*
   ActionsPatch updates an action based on its UUID using patch semantics
 
-  Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+  Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.
 */
 func (a *Client) ActionsPatch(params *ActionsPatchParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsPatchNoContent, error) {
 	// TODO: Validate the params before sending
@@ -246,6 +328,43 @@ func (a *Client) ActionsPatch(params *ActionsPatchParams, authInfo runtime.Clien
 	panic(msg)
 }
 
+/*
+  ActionsReferencesBulkUpdate replaces all references of several class properties
+
+  Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.
+*/
+func (a *Client) ActionsReferencesBulkUpdate(params *ActionsReferencesBulkUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesBulkUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewActionsReferencesBulkUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "actions.references.bulkUpdate",
+		Method:             "PUT",
+		PathPattern:        "/actions/{id}/references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ActionsReferencesBulkUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ActionsReferencesBulkUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for actions.references.bulkUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ActionsReferencesCreate adds a single reference to a class property
 
@@ -320,6 +439,43 @@ func (a *Client) ActionsReferencesDelete(params *ActionsReferencesDeleteParams,
 	panic(msg)
 }
 
+/*
+  ActionsReferencesList lists the references of a action
+
+  Lists only the reference properties of a Action, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.
+*/
+func (a *Client) ActionsReferencesList(params *ActionsReferencesListParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsReferencesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewActionsReferencesListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "actions.references.list",
+		Method:             "GET",
+		PathPattern:        "/actions/{id}/references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ActionsReferencesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ActionsReferencesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for actions.references.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ActionsReferencesUpdate replaces all references to a class property
 
@@ -360,7 +516,7 @@ func (a *Client) ActionsReferencesUpdate(params *ActionsReferencesUpdateParams,
 /*
   ActionsUpdate updates an action based on its UUID
 
-  Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+  Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Action still has the given version.
 */
 func (a *Client) ActionsUpdate(params *ActionsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsUpdateOK, error) {
 	// TODO: Validate the params before sending
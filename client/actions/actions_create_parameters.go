@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -75,6 +76,21 @@ type ActionsCreateParams struct {
 
 	/*Body*/
 	Body *models.Action
+	/*Consistency
+	  Consistency of reads after this write. With 'eventual' the request returns as soon as the object was accepted and an immediate read might not find it yet, e.g. before the next elasticsearch refresh. With 'strong' the request only returns once the object can be read back, which adds the propagation delay to the latency of the request. If it is still not readable after the configured timeout, the request succeeds nonetheless, as the object was written. Defaults to 'eventual'.
+
+	*/
+	Consistency *string
+	/*IfNotExists
+	  If an object with the same id already exists, return the existing object instead of failing with 409 Conflict. Defaults to false.
+
+	*/
+	IfNotExists *bool
+	/*TTL
+	  Time to live of the created object in seconds. Once expired the object is no longer returned and is eventually deleted in the background.
+
+	*/
+	TTL *int64
 
 	timeout    time.Duration
 	Context    context.Context
@@ -125,6 +141,39 @@ func (o *ActionsCreateParams) SetBody(body *models.Action) {
 	o.Body = body
 }
 
+// WithConsistency adds the consistency to the actions create params
+func (o *ActionsCreateParams) WithConsistency(consistency *string) *ActionsCreateParams {
+	o.SetConsistency(consistency)
+	return o
+}
+
+// SetConsistency adds the consistency to the actions create params
+func (o *ActionsCreateParams) SetConsistency(consistency *string) {
+	o.Consistency = consistency
+}
+
+// WithIfNotExists adds the ifNotExists to the actions create params
+func (o *ActionsCreateParams) WithIfNotExists(ifNotExists *bool) *ActionsCreateParams {
+	o.SetIfNotExists(ifNotExists)
+	return o
+}
+
+// SetIfNotExists adds the ifNotExists to the actions create params
+func (o *ActionsCreateParams) SetIfNotExists(ifNotExists *bool) {
+	o.IfNotExists = ifNotExists
+}
+
+// WithTTL adds the tTL to the actions create params
+func (o *ActionsCreateParams) WithTTL(tTL *int64) *ActionsCreateParams {
+	o.SetTTL(tTL)
+	return o
+}
+
+// SetTTL adds the tTL to the actions create params
+func (o *ActionsCreateParams) SetTTL(tTL *int64) {
+	o.TTL = tTL
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ActionsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -139,6 +188,47 @@ func (o *ActionsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.Consistency != nil {
+
+		// query param consistency
+		var qrConsistency string
+		if o.Consistency != nil {
+			qrConsistency = *o.Consistency
+		}
+		qConsistency := qrConsistency
+		if qConsistency != "" {
+			if err := r.SetQueryParam("consistency", qConsistency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.IfNotExists != nil {
+
+		// query param ifNotExists
+		var qrIfNotExists bool
+		if o.IfNotExists != nil {
+			qrIfNotExists = *o.IfNotExists
+		}
+		qIfNotExists := swag.FormatBool(qrIfNotExists)
+		if qIfNotExists != "" {
+			if err := r.SetQueryParam("ifNotExists", qIfNotExists); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.TTL != nil {
+
+		// header param TTL
+		if err := r.SetHeaderParam("TTL", swag.FormatInt64(*o.TTL)); err != nil {
+			return err
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -52,12 +54,24 @@ func (o *ActionsCreateReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewActionsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewActionsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewActionsCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewActionsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -157,6 +171,39 @@ func (o *ActionsCreateForbidden) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewActionsCreateConflict creates a ActionsCreateConflict with default headers values
+func NewActionsCreateConflict() *ActionsCreateConflict {
+	return &ActionsCreateConflict{}
+}
+
+/*ActionsCreateConflict handles this case with default header values.
+
+An object with the same id already exists.
+*/
+type ActionsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /actions][%d] actionsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ActionsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsCreateUnprocessableEntity creates a ActionsCreateUnprocessableEntity with default headers values
 func NewActionsCreateUnprocessableEntity() *ActionsCreateUnprocessableEntity {
 	return &ActionsCreateUnprocessableEntity{}
@@ -190,6 +237,50 @@ func (o *ActionsCreateUnprocessableEntity) readResponse(response runtime.ClientR
 	return nil
 }
 
+// NewActionsCreateTooManyRequests creates a ActionsCreateTooManyRequests with default headers values
+func NewActionsCreateTooManyRequests() *ActionsCreateTooManyRequests {
+	return &ActionsCreateTooManyRequests{}
+}
+
+/*ActionsCreateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ActionsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /actions][%d] actionsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ActionsCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsCreateInternalServerError creates a ActionsCreateInternalServerError with default headers values
 func NewActionsCreateInternalServerError() *ActionsCreateInternalServerError {
 	return &ActionsCreateInternalServerError{}
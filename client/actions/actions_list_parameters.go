@@ -72,11 +72,31 @@ for the actions list operation typically these are written to a http.Request
 */
 type ActionsListParams struct {
 
+	/*After
+	  Iterate over all objects of the class in id order, starting after the object with this id. Use the nil uuid (00000000-0000-0000-0000-000000000000) to start at the beginning and the nextCursor of the response to request the following page. Requires the class to be set and can not be combined with a where filter.
+
+	*/
+	After *strfmt.UUID
+	/*Class
+	  Restrict the list to objects of this class. Required when a 'where' filter is set.
+
+	*/
+	Class *string
+	/*Count
+	  Only count the objects matching the class and where filter instead of returning them. The count is set as totalResults and the list of objects is empty. The limit does not apply to the count. Defaults to false.
+
+	*/
+	Count *bool
 	/*Include
-	  Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
+	  Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)
 
 	*/
 	Include *string
+	/*IncludeTotal
+	  Set to false to omit totalResults from the response. Computing the total requires counting all objects matching the class and where filter, which can be expensive. Defaults to true.
+
+	*/
+	IncludeTotal *bool
 	/*Limit
 	  The maximum number of items to be returned per page. Default value is set in Weaviate config.
 
@@ -87,6 +107,11 @@ type ActionsListParams struct {
 
 	*/
 	Meta *bool
+	/*Where
+	  JSON-encoded filter using the same grammar as the 'where' argument of the GraphQL Get query, e.g. '{"operator":"Equal","path":["name"],"valueString":"foo"}'. Paths are relative to the class set with the 'class' parameter.
+
+	*/
+	Where *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -126,6 +151,39 @@ func (o *ActionsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the actions list params
+func (o *ActionsListParams) WithAfter(after *strfmt.UUID) *ActionsListParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the actions list params
+func (o *ActionsListParams) SetAfter(after *strfmt.UUID) {
+	o.After = after
+}
+
+// WithClass adds the class to the actions list params
+func (o *ActionsListParams) WithClass(class *string) *ActionsListParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the actions list params
+func (o *ActionsListParams) SetClass(class *string) {
+	o.Class = class
+}
+
+// WithCount adds the count to the actions list params
+func (o *ActionsListParams) WithCount(count *bool) *ActionsListParams {
+	o.SetCount(count)
+	return o
+}
+
+// SetCount adds the count to the actions list params
+func (o *ActionsListParams) SetCount(count *bool) {
+	o.Count = count
+}
+
 // WithInclude adds the include to the actions list params
 func (o *ActionsListParams) WithInclude(include *string) *ActionsListParams {
 	o.SetInclude(include)
@@ -137,6 +195,17 @@ func (o *ActionsListParams) SetInclude(include *string) {
 	o.Include = include
 }
 
+// WithIncludeTotal adds the includeTotal to the actions list params
+func (o *ActionsListParams) WithIncludeTotal(includeTotal *bool) *ActionsListParams {
+	o.SetIncludeTotal(includeTotal)
+	return o
+}
+
+// SetIncludeTotal adds the includeTotal to the actions list params
+func (o *ActionsListParams) SetIncludeTotal(includeTotal *bool) {
+	o.IncludeTotal = includeTotal
+}
+
 // WithLimit adds the limit to the actions list params
 func (o *ActionsListParams) WithLimit(limit *int64) *ActionsListParams {
 	o.SetLimit(limit)
@@ -159,6 +228,17 @@ func (o *ActionsListParams) SetMeta(meta *bool) {
 	o.Meta = meta
 }
 
+// WithWhere adds the where to the actions list params
+func (o *ActionsListParams) WithWhere(where *string) *ActionsListParams {
+	o.SetWhere(where)
+	return o
+}
+
+// SetWhere adds the where to the actions list params
+func (o *ActionsListParams) SetWhere(where *string) {
+	o.Where = where
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ActionsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -167,6 +247,54 @@ func (o *ActionsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter strfmt.UUID
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter.String()
+		if qAfter != "" {
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Class != nil {
+
+		// query param class
+		var qrClass string
+		if o.Class != nil {
+			qrClass = *o.Class
+		}
+		qClass := qrClass
+		if qClass != "" {
+			if err := r.SetQueryParam("class", qClass); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Count != nil {
+
+		// query param count
+		var qrCount bool
+		if o.Count != nil {
+			qrCount = *o.Count
+		}
+		qCount := swag.FormatBool(qrCount)
+		if qCount != "" {
+			if err := r.SetQueryParam("count", qCount); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Include != nil {
 
 		// query param include
@@ -183,6 +311,22 @@ func (o *ActionsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 
 	}
 
+	if o.IncludeTotal != nil {
+
+		// query param includeTotal
+		var qrIncludeTotal bool
+		if o.IncludeTotal != nil {
+			qrIncludeTotal = *o.IncludeTotal
+		}
+		qIncludeTotal := swag.FormatBool(qrIncludeTotal)
+		if qIncludeTotal != "" {
+			if err := r.SetQueryParam("includeTotal", qIncludeTotal); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Limit != nil {
 
 		// query param limit
@@ -215,6 +359,22 @@ func (o *ActionsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 
 	}
 
+	if o.Where != nil {
+
+		// query param where
+		var qrWhere string
+		if o.Where != nil {
+			qrWhere = *o.Where
+		}
+		qWhere := qrWhere
+		if qWhere != "" {
+			if err := r.SetQueryParam("where", qWhere); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
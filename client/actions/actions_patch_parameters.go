@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -83,6 +84,16 @@ type ActionsPatchParams struct {
 
 	*/
 	ID strfmt.UUID
+	/*IfMatch
+	  The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.
+
+	*/
+	IfMatch *int64
+	/*UniqueReferences
+	  References in the patch are appended to the existing references of a property. If true, references with a beacon that is already present on the property are skipped instead of being added a second time. Defaults to false.
+
+	*/
+	UniqueReferences *bool
 
 	timeout    time.Duration
 	Context    context.Context
@@ -144,6 +155,28 @@ func (o *ActionsPatchParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the actions patch params
+func (o *ActionsPatchParams) WithIfMatch(ifMatch *int64) *ActionsPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the actions patch params
+func (o *ActionsPatchParams) SetIfMatch(ifMatch *int64) {
+	o.IfMatch = ifMatch
+}
+
+// WithUniqueReferences adds the uniqueReferences to the actions patch params
+func (o *ActionsPatchParams) WithUniqueReferences(uniqueReferences *bool) *ActionsPatchParams {
+	o.SetUniqueReferences(uniqueReferences)
+	return o
+}
+
+// SetUniqueReferences adds the uniqueReferences to the actions patch params
+func (o *ActionsPatchParams) SetUniqueReferences(uniqueReferences *bool) {
+	o.UniqueReferences = uniqueReferences
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ActionsPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -163,6 +196,31 @@ func (o *ActionsPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", swag.FormatInt64(*o.IfMatch)); err != nil {
+			return err
+		}
+
+	}
+
+	if o.UniqueReferences != nil {
+
+		// query param uniqueReferences
+		var qrUniqueReferences bool
+		if o.UniqueReferences != nil {
+			qrUniqueReferences = *o.UniqueReferences
+		}
+		qUniqueReferences := swag.FormatBool(qrUniqueReferences)
+		if qUniqueReferences != "" {
+			if err := r.SetQueryParam("uniqueReferences", qUniqueReferences); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
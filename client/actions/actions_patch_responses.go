@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -64,12 +66,24 @@ func (o *ActionsPatchReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewActionsPatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewActionsPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewActionsPatchTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewActionsPatchInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -199,6 +213,39 @@ func (o *ActionsPatchNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewActionsPatchConflict creates a ActionsPatchConflict with default headers values
+func NewActionsPatchConflict() *ActionsPatchConflict {
+	return &ActionsPatchConflict{}
+}
+
+/*ActionsPatchConflict handles this case with default header values.
+
+Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.
+*/
+type ActionsPatchConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsPatchConflict) Error() string {
+	return fmt.Sprintf("[PATCH /actions/{id}][%d] actionsPatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *ActionsPatchConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsPatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsPatchUnprocessableEntity creates a ActionsPatchUnprocessableEntity with default headers values
 func NewActionsPatchUnprocessableEntity() *ActionsPatchUnprocessableEntity {
 	return &ActionsPatchUnprocessableEntity{}
@@ -232,6 +279,50 @@ func (o *ActionsPatchUnprocessableEntity) readResponse(response runtime.ClientRe
 	return nil
 }
 
+// NewActionsPatchTooManyRequests creates a ActionsPatchTooManyRequests with default headers values
+func NewActionsPatchTooManyRequests() *ActionsPatchTooManyRequests {
+	return &ActionsPatchTooManyRequests{}
+}
+
+/*ActionsPatchTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ActionsPatchTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsPatchTooManyRequests) Error() string {
+	return fmt.Sprintf("[PATCH /actions/{id}][%d] actionsPatchTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ActionsPatchTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsPatchTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsPatchInternalServerError creates a ActionsPatchInternalServerError with default headers values
 func NewActionsPatchInternalServerError() *ActionsPatchInternalServerError {
 	return &ActionsPatchInternalServerError{}
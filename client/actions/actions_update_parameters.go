@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -75,11 +76,21 @@ type ActionsUpdateParams struct {
 
 	/*Body*/
 	Body *models.Action
+	/*ChangedOnly
+	  Only return the fields which were changed by the update, as well as the class and id of the object, instead of the full object. Properties which were removed by the update are returned as null. This avoids re-sending large unchanged properties. Defaults to false.
+
+	*/
+	ChangedOnly *bool
 	/*ID
 	  Unique ID of the Action.
 
 	*/
 	ID strfmt.UUID
+	/*IfMatch
+	  The version the object is expected to have. If set, the request fails with a 409 Conflict if the object has been changed in the meantime, i.e. its current version differs. Only supported by the standalone storage, otherwise the request fails with a 422.
+
+	*/
+	IfMatch *int64
 
 	timeout    time.Duration
 	Context    context.Context
@@ -130,6 +141,17 @@ func (o *ActionsUpdateParams) SetBody(body *models.Action) {
 	o.Body = body
 }
 
+// WithChangedOnly adds the changedOnly to the actions update params
+func (o *ActionsUpdateParams) WithChangedOnly(changedOnly *bool) *ActionsUpdateParams {
+	o.SetChangedOnly(changedOnly)
+	return o
+}
+
+// SetChangedOnly adds the changedOnly to the actions update params
+func (o *ActionsUpdateParams) SetChangedOnly(changedOnly *bool) {
+	o.ChangedOnly = changedOnly
+}
+
 // WithID adds the id to the actions update params
 func (o *ActionsUpdateParams) WithID(id strfmt.UUID) *ActionsUpdateParams {
 	o.SetID(id)
@@ -141,6 +163,17 @@ func (o *ActionsUpdateParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the actions update params
+func (o *ActionsUpdateParams) WithIfMatch(ifMatch *int64) *ActionsUpdateParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the actions update params
+func (o *ActionsUpdateParams) SetIfMatch(ifMatch *int64) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ActionsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -155,11 +188,36 @@ func (o *ActionsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.ChangedOnly != nil {
+
+		// query param changedOnly
+		var qrChangedOnly bool
+		if o.ChangedOnly != nil {
+			qrChangedOnly = *o.ChangedOnly
+		}
+		qChangedOnly := swag.FormatBool(qrChangedOnly)
+		if qChangedOnly != "" {
+			if err := r.SetQueryParam("changedOnly", qChangedOnly); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", swag.FormatInt64(*o.IfMatch)); err != nil {
+			return err
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -58,12 +60,24 @@ func (o *ActionsUpdateReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewActionsUpdateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewActionsUpdateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewActionsUpdateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewActionsUpdateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -184,6 +198,39 @@ func (o *ActionsUpdateNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewActionsUpdateConflict creates a ActionsUpdateConflict with default headers values
+func NewActionsUpdateConflict() *ActionsUpdateConflict {
+	return &ActionsUpdateConflict{}
+}
+
+/*ActionsUpdateConflict handles this case with default header values.
+
+Another object already has the same value for a unique property, or the Action has been changed since the version given in the If-Match header.
+*/
+type ActionsUpdateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsUpdateConflict) Error() string {
+	return fmt.Sprintf("[PUT /actions/{id}][%d] actionsUpdateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ActionsUpdateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsUpdateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsUpdateUnprocessableEntity creates a ActionsUpdateUnprocessableEntity with default headers values
 func NewActionsUpdateUnprocessableEntity() *ActionsUpdateUnprocessableEntity {
 	return &ActionsUpdateUnprocessableEntity{}
@@ -217,6 +264,50 @@ func (o *ActionsUpdateUnprocessableEntity) readResponse(response runtime.ClientR
 	return nil
 }
 
+// NewActionsUpdateTooManyRequests creates a ActionsUpdateTooManyRequests with default headers values
+func NewActionsUpdateTooManyRequests() *ActionsUpdateTooManyRequests {
+	return &ActionsUpdateTooManyRequests{}
+}
+
+/*ActionsUpdateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ActionsUpdateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsUpdateTooManyRequests) Error() string {
+	return fmt.Sprintf("[PUT /actions/{id}][%d] actionsUpdateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ActionsUpdateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsUpdateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsUpdateInternalServerError creates a ActionsUpdateInternalServerError with default headers values
 func NewActionsUpdateInternalServerError() *ActionsUpdateInternalServerError {
 	return &ActionsUpdateInternalServerError{}
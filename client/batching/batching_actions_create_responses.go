@@ -45,6 +45,12 @@ func (o *BatchingActionsCreateReader) ReadResponse(response runtime.ClientRespon
 			return nil, err
 		}
 		return result, nil
+	case 207:
+		result := NewBatchingActionsCreateMultiStatus()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewBatchingActionsCreateUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -57,12 +63,24 @@ func (o *BatchingActionsCreateReader) ReadResponse(response runtime.ClientRespon
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewBatchingActionsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewBatchingActionsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewBatchingActionsCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchingActionsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -82,9 +100,33 @@ func NewBatchingActionsCreateOK() *BatchingActionsCreateOK {
 
 /*BatchingActionsCreateOK handles this case with default header values.
 
-Request succeeded, see response body to get detailed information about each batched item.
+Request succeeded and every batched item was created, see response body to get detailed information about each batched item.
 */
 type BatchingActionsCreateOK struct {
+	/*Number of items which failed.
+	 */
+	XBatchFailed int64
+
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+	 */
+	XBatchFailedConflict int64
+
+	/*Number of failed items which could not be imported because of a server-side error.
+	 */
+	XBatchFailedInternal int64
+
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+	 */
+	XBatchFailedValidation int64
+
+	/*Number of items which were imported successfully.
+	 */
+	XBatchSucceeded int64
+
+	/*Number of items in the batch.
+	 */
+	XBatchTotal int64
+
 	Payload []*models.ActionsGetResponse
 }
 
@@ -98,6 +140,145 @@ func (o *BatchingActionsCreateOK) GetPayload() []*models.ActionsGetResponse {
 
 func (o *BatchingActionsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Batch-Failed
+	xBatchFailed, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed", "header", "int64", response.GetHeader("X-Batch-Failed"))
+	}
+	o.XBatchFailed = xBatchFailed
+
+	// response header X-Batch-Failed-Conflict
+	xBatchFailedConflict, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Conflict"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Conflict", "header", "int64", response.GetHeader("X-Batch-Failed-Conflict"))
+	}
+	o.XBatchFailedConflict = xBatchFailedConflict
+
+	// response header X-Batch-Failed-Internal
+	xBatchFailedInternal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Internal"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Internal", "header", "int64", response.GetHeader("X-Batch-Failed-Internal"))
+	}
+	o.XBatchFailedInternal = xBatchFailedInternal
+
+	// response header X-Batch-Failed-Validation
+	xBatchFailedValidation, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Validation"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Validation", "header", "int64", response.GetHeader("X-Batch-Failed-Validation"))
+	}
+	o.XBatchFailedValidation = xBatchFailedValidation
+
+	// response header X-Batch-Succeeded
+	xBatchSucceeded, err := swag.ConvertInt64(response.GetHeader("X-Batch-Succeeded"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Succeeded", "header", "int64", response.GetHeader("X-Batch-Succeeded"))
+	}
+	o.XBatchSucceeded = xBatchSucceeded
+
+	// response header X-Batch-Total
+	xBatchTotal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Total"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Total", "header", "int64", response.GetHeader("X-Batch-Total"))
+	}
+	o.XBatchTotal = xBatchTotal
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsCreateMultiStatus creates a BatchingActionsCreateMultiStatus with default headers values
+func NewBatchingActionsCreateMultiStatus() *BatchingActionsCreateMultiStatus {
+	return &BatchingActionsCreateMultiStatus{}
+}
+
+/*BatchingActionsCreateMultiStatus handles this case with default header values.
+
+Request succeeded, but at least one of the batched actions failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+*/
+type BatchingActionsCreateMultiStatus struct {
+	/*Number of items which failed.
+	 */
+	XBatchFailed int64
+
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+	 */
+	XBatchFailedConflict int64
+
+	/*Number of failed items which could not be imported because of a server-side error.
+	 */
+	XBatchFailedInternal int64
+
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+	 */
+	XBatchFailedValidation int64
+
+	/*Number of items which were imported successfully.
+	 */
+	XBatchSucceeded int64
+
+	/*Number of items in the batch.
+	 */
+	XBatchTotal int64
+
+	Payload []*models.ActionsGetResponse
+}
+
+func (o *BatchingActionsCreateMultiStatus) Error() string {
+	return fmt.Sprintf("[POST /batching/actions][%d] batchingActionsCreateMultiStatus  %+v", 207, o.Payload)
+}
+
+func (o *BatchingActionsCreateMultiStatus) GetPayload() []*models.ActionsGetResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsCreateMultiStatus) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header X-Batch-Failed
+	xBatchFailed, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed", "header", "int64", response.GetHeader("X-Batch-Failed"))
+	}
+	o.XBatchFailed = xBatchFailed
+
+	// response header X-Batch-Failed-Conflict
+	xBatchFailedConflict, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Conflict"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Conflict", "header", "int64", response.GetHeader("X-Batch-Failed-Conflict"))
+	}
+	o.XBatchFailedConflict = xBatchFailedConflict
+
+	// response header X-Batch-Failed-Internal
+	xBatchFailedInternal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Internal"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Internal", "header", "int64", response.GetHeader("X-Batch-Failed-Internal"))
+	}
+	o.XBatchFailedInternal = xBatchFailedInternal
+
+	// response header X-Batch-Failed-Validation
+	xBatchFailedValidation, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Validation"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Validation", "header", "int64", response.GetHeader("X-Batch-Failed-Validation"))
+	}
+	o.XBatchFailedValidation = xBatchFailedValidation
+
+	// response header X-Batch-Succeeded
+	xBatchSucceeded, err := swag.ConvertInt64(response.GetHeader("X-Batch-Succeeded"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Succeeded", "header", "int64", response.GetHeader("X-Batch-Succeeded"))
+	}
+	o.XBatchSucceeded = xBatchSucceeded
+
+	// response header X-Batch-Total
+	xBatchTotal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Total"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Total", "header", "int64", response.GetHeader("X-Batch-Total"))
+	}
+	o.XBatchTotal = xBatchTotal
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -160,6 +341,39 @@ func (o *BatchingActionsCreateForbidden) readResponse(response runtime.ClientRes
 	return nil
 }
 
+// NewBatchingActionsCreateConflict creates a BatchingActionsCreateConflict with default headers values
+func NewBatchingActionsCreateConflict() *BatchingActionsCreateConflict {
+	return &BatchingActionsCreateConflict{}
+}
+
+/*BatchingActionsCreateConflict handles this case with default header values.
+
+A request with the same idempotency key is still being executed.
+*/
+type BatchingActionsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /batching/actions][%d] batchingActionsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *BatchingActionsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingActionsCreateUnprocessableEntity creates a BatchingActionsCreateUnprocessableEntity with default headers values
 func NewBatchingActionsCreateUnprocessableEntity() *BatchingActionsCreateUnprocessableEntity {
 	return &BatchingActionsCreateUnprocessableEntity{}
@@ -193,6 +407,50 @@ func (o *BatchingActionsCreateUnprocessableEntity) readResponse(response runtime
 	return nil
 }
 
+// NewBatchingActionsCreateTooManyRequests creates a BatchingActionsCreateTooManyRequests with default headers values
+func NewBatchingActionsCreateTooManyRequests() *BatchingActionsCreateTooManyRequests {
+	return &BatchingActionsCreateTooManyRequests{}
+}
+
+/*BatchingActionsCreateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+*/
+type BatchingActionsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /batching/actions][%d] batchingActionsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *BatchingActionsCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingActionsCreateInternalServerError creates a BatchingActionsCreateInternalServerError with default headers values
 func NewBatchingActionsCreateInternalServerError() *BatchingActionsCreateInternalServerError {
 	return &BatchingActionsCreateInternalServerError{}
@@ -38,11 +38,17 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	BatchingActionsCreate(params *BatchingActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsCreateOK, error)
+	BatchingActionsCreate(params *BatchingActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsCreateOK, *BatchingActionsCreateMultiStatus, error)
 
-	BatchingReferencesCreate(params *BatchingReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesCreateOK, error)
+	BatchingActionsGet(params *BatchingActionsGetParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsGetOK, error)
 
-	BatchingThingsCreate(params *BatchingThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsCreateOK, error)
+	BatchingReferencesCreate(params *BatchingReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesCreateOK, *BatchingReferencesCreateMultiStatus, error)
+
+	BatchingReferencesResolve(params *BatchingReferencesResolveParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesResolveOK, error)
+
+	BatchingThingsCreate(params *BatchingThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsCreateOK, *BatchingThingsCreateMultiStatus, error)
+
+	BatchingThingsGet(params *BatchingThingsGetParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsGetOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -52,7 +58,7 @@ type ClientService interface {
 
   Register new Actions in bulk. Given meta-data and schema values are validated.
 */
-func (a *Client) BatchingActionsCreate(params *BatchingActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsCreateOK, error) {
+func (a *Client) BatchingActionsCreate(params *BatchingActionsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsCreateOK, *BatchingActionsCreateMultiStatus, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewBatchingActionsCreateParams()
@@ -71,25 +77,63 @@ func (a *Client) BatchingActionsCreate(params *BatchingActionsCreateParams, auth
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *BatchingActionsCreateOK:
+		return value, nil, nil
+	case *BatchingActionsCreateMultiStatus:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.actions.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  BatchingActionsGet gets multiple actions based on their uui ds
+
+  Retrieve multiple Actions by their UUIDs in a single request. UUIDs for which no Action exists are listed separately.
+*/
+func (a *Client) BatchingActionsGet(params *BatchingActionsGetParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingActionsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.actions.get",
+		Method:             "POST",
+		PathPattern:        "/batching/actions/get",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingActionsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*BatchingActionsCreateOK)
+	success, ok := result.(*BatchingActionsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for batching.actions.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for batching.actions.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
   BatchingReferencesCreate creates new cross references between arbitrary classes in bulk
 
-  Register cross-references between any class items (things or actions) in bulk.
+  Register cross-references between any class items (things or actions) in bulk. With validateOnly, every reference is only validated without adding it: the source and target objects must exist, the property must be a reference property of the source class and the target must be of one of the classes the property can point to.
 */
-func (a *Client) BatchingReferencesCreate(params *BatchingReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesCreateOK, error) {
+func (a *Client) BatchingReferencesCreate(params *BatchingReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesCreateOK, *BatchingReferencesCreateMultiStatus, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewBatchingReferencesCreateParams()
@@ -108,16 +152,54 @@ func (a *Client) BatchingReferencesCreate(params *BatchingReferencesCreateParams
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *BatchingReferencesCreateOK:
+		return value, nil, nil
+	case *BatchingReferencesCreateMultiStatus:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.references.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  BatchingReferencesResolve resolves multiple beacons to their objects
+
+  Resolve a list of beacons to the objects they point to in a single request. Every beacon has its own status, so that beacons which could not be resolved do not fail the whole request.
+*/
+func (a *Client) BatchingReferencesResolve(params *BatchingReferencesResolveParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingReferencesResolveOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingReferencesResolveParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.references.resolve",
+		Method:             "POST",
+		PathPattern:        "/batching/references/resolve",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingReferencesResolveReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*BatchingReferencesCreateOK)
+	success, ok := result.(*BatchingReferencesResolveOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for batching.references.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for batching.references.resolve: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
@@ -126,7 +208,7 @@ func (a *Client) BatchingReferencesCreate(params *BatchingReferencesCreateParams
 
   Register new Things in bulk. Provided meta-data and schema values are validated.
 */
-func (a *Client) BatchingThingsCreate(params *BatchingThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsCreateOK, error) {
+func (a *Client) BatchingThingsCreate(params *BatchingThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsCreateOK, *BatchingThingsCreateMultiStatus, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewBatchingThingsCreateParams()
@@ -145,16 +227,54 @@ func (a *Client) BatchingThingsCreate(params *BatchingThingsCreateParams, authIn
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *BatchingThingsCreateOK:
+		return value, nil, nil
+	case *BatchingThingsCreateMultiStatus:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.things.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  BatchingThingsGet gets multiple things based on their uui ds
+
+  Retrieve multiple Things by their UUIDs in a single request. UUIDs for which no Thing exists are listed separately.
+*/
+func (a *Client) BatchingThingsGet(params *BatchingThingsGetParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingThingsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.things.get",
+		Method:             "POST",
+		PathPattern:        "/batching/things/get",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingThingsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*BatchingThingsCreateOK)
+	success, ok := result.(*BatchingThingsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for batching.things.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for batching.things.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
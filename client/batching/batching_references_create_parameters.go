@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -78,6 +79,11 @@ type BatchingReferencesCreateParams struct {
 
 	*/
 	Body []*models.BatchReference
+	/*ValidateOnly
+	  Only validate the objects in the batch without storing them. The response contains the validation result of each object.
+
+	*/
+	ValidateOnly *bool
 
 	timeout    time.Duration
 	Context    context.Context
@@ -128,6 +134,17 @@ func (o *BatchingReferencesCreateParams) SetBody(body []*models.BatchReference)
 	o.Body = body
 }
 
+// WithValidateOnly adds the validateOnly to the batching references create params
+func (o *BatchingReferencesCreateParams) WithValidateOnly(validateOnly *bool) *BatchingReferencesCreateParams {
+	o.SetValidateOnly(validateOnly)
+	return o
+}
+
+// SetValidateOnly adds the validateOnly to the batching references create params
+func (o *BatchingReferencesCreateParams) SetValidateOnly(validateOnly *bool) {
+	o.ValidateOnly = validateOnly
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchingReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -142,6 +159,22 @@ func (o *BatchingReferencesCreateParams) WriteToRequest(r runtime.ClientRequest,
 		}
 	}
 
+	if o.ValidateOnly != nil {
+
+		// query param validateOnly
+		var qrValidateOnly bool
+		if o.ValidateOnly != nil {
+			qrValidateOnly = *o.ValidateOnly
+		}
+		qValidateOnly := swag.FormatBool(qrValidateOnly)
+		if qValidateOnly != "" {
+			if err := r.SetQueryParam("validateOnly", qValidateOnly); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
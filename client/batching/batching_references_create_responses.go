@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -40,6 +42,12 @@ func (o *BatchingReferencesCreateReader) ReadResponse(response runtime.ClientRes
 			return nil, err
 		}
 		return result, nil
+	case 207:
+		result := NewBatchingReferencesCreateMultiStatus()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewBatchingReferencesCreateUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -58,6 +66,12 @@ func (o *BatchingReferencesCreateReader) ReadResponse(response runtime.ClientRes
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewBatchingReferencesCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchingReferencesCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -77,7 +91,7 @@ func NewBatchingReferencesCreateOK() *BatchingReferencesCreateOK {
 
 /*BatchingReferencesCreateOK handles this case with default header values.
 
-Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.
+Request succeeded and every batched reference was created (or is valid with validateOnly), see response body to get detailed information about each batched reference.
 */
 type BatchingReferencesCreateOK struct {
 	Payload []*models.BatchReferenceResponse
@@ -101,6 +115,37 @@ func (o *BatchingReferencesCreateOK) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewBatchingReferencesCreateMultiStatus creates a BatchingReferencesCreateMultiStatus with default headers values
+func NewBatchingReferencesCreateMultiStatus() *BatchingReferencesCreateMultiStatus {
+	return &BatchingReferencesCreateMultiStatus{}
+}
+
+/*BatchingReferencesCreateMultiStatus handles this case with default header values.
+
+Request succeeded, but at least one of the batched references failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+*/
+type BatchingReferencesCreateMultiStatus struct {
+	Payload []*models.BatchReferenceResponse
+}
+
+func (o *BatchingReferencesCreateMultiStatus) Error() string {
+	return fmt.Sprintf("[POST /batching/references][%d] batchingReferencesCreateMultiStatus  %+v", 207, o.Payload)
+}
+
+func (o *BatchingReferencesCreateMultiStatus) GetPayload() []*models.BatchReferenceResponse {
+	return o.Payload
+}
+
+func (o *BatchingReferencesCreateMultiStatus) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingReferencesCreateUnauthorized creates a BatchingReferencesCreateUnauthorized with default headers values
 func NewBatchingReferencesCreateUnauthorized() *BatchingReferencesCreateUnauthorized {
 	return &BatchingReferencesCreateUnauthorized{}
@@ -188,6 +233,50 @@ func (o *BatchingReferencesCreateUnprocessableEntity) readResponse(response runt
 	return nil
 }
 
+// NewBatchingReferencesCreateTooManyRequests creates a BatchingReferencesCreateTooManyRequests with default headers values
+func NewBatchingReferencesCreateTooManyRequests() *BatchingReferencesCreateTooManyRequests {
+	return &BatchingReferencesCreateTooManyRequests{}
+}
+
+/*BatchingReferencesCreateTooManyRequests handles this case with default header values.
+
+Too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+*/
+type BatchingReferencesCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingReferencesCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /batching/references][%d] batchingReferencesCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *BatchingReferencesCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingReferencesCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingReferencesCreateInternalServerError creates a BatchingReferencesCreateInternalServerError with default headers values
 func NewBatchingReferencesCreateInternalServerError() *BatchingReferencesCreateInternalServerError {
 	return &BatchingReferencesCreateInternalServerError{}
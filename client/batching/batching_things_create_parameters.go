@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewBatchingThingsCreateParams creates a new BatchingThingsCreateParams object
@@ -74,6 +75,24 @@ type BatchingThingsCreateParams struct {
 	/*Body*/
 	Body BatchingThingsCreateBody
 
+	/*IdempotencyKey
+	  A unique key chosen by the client to safely retry the request. If a request with the same key has already been executed, its original result is returned instead of executing it again. Keys expire after a configurable time.
+
+	*/
+	IdempotencyKey *string
+
+	/*ValidateOnly
+	  Only validate the objects in the batch without storing them. The response contains the validation result of each object.
+
+	*/
+	ValidateOnly *bool
+
+	/*Vectorize
+	  In combination with validateOnly, also vectorize each object to detect vectorization errors. Ignored otherwise. Defaults to false.
+
+	*/
+	Vectorize *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -123,6 +142,39 @@ func (o *BatchingThingsCreateParams) SetBody(body BatchingThingsCreateBody) {
 	o.Body = body
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the batching things create params
+func (o *BatchingThingsCreateParams) WithIdempotencyKey(idempotencyKey *string) *BatchingThingsCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the batching things create params
+func (o *BatchingThingsCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithValidateOnly adds the validateOnly to the batching things create params
+func (o *BatchingThingsCreateParams) WithValidateOnly(validateOnly *bool) *BatchingThingsCreateParams {
+	o.SetValidateOnly(validateOnly)
+	return o
+}
+
+// SetValidateOnly adds the validateOnly to the batching things create params
+func (o *BatchingThingsCreateParams) SetValidateOnly(validateOnly *bool) {
+	o.ValidateOnly = validateOnly
+}
+
+// WithVectorize adds the vectorize to the batching things create params
+func (o *BatchingThingsCreateParams) WithVectorize(vectorize *bool) *BatchingThingsCreateParams {
+	o.SetVectorize(vectorize)
+	return o
+}
+
+// SetVectorize adds the vectorize to the batching things create params
+func (o *BatchingThingsCreateParams) SetVectorize(vectorize *bool) {
+	o.Vectorize = vectorize
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchingThingsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -135,6 +187,47 @@ func (o *BatchingThingsCreateParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+
+	}
+
+	if o.ValidateOnly != nil {
+
+		// query param validateOnly
+		var qrValidateOnly bool
+		if o.ValidateOnly != nil {
+			qrValidateOnly = *o.ValidateOnly
+		}
+		qValidateOnly := swag.FormatBool(qrValidateOnly)
+		if qValidateOnly != "" {
+			if err := r.SetQueryParam("validateOnly", qValidateOnly); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Vectorize != nil {
+
+		// query param vectorize
+		var qrVectorize bool
+		if o.Vectorize != nil {
+			qrVectorize = *o.Vectorize
+		}
+		qVectorize := swag.FormatBool(qrVectorize)
+		if qVectorize != "" {
+			if err := r.SetQueryParam("vectorize", qVectorize); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -45,6 +45,12 @@ func (o *BatchingThingsCreateReader) ReadResponse(response runtime.ClientRespons
 			return nil, err
 		}
 		return result, nil
+	case 207:
+		result := NewBatchingThingsCreateMultiStatus()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewBatchingThingsCreateUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -57,12 +63,24 @@ func (o *BatchingThingsCreateReader) ReadResponse(response runtime.ClientRespons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewBatchingThingsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewBatchingThingsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewBatchingThingsCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchingThingsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -82,9 +100,33 @@ func NewBatchingThingsCreateOK() *BatchingThingsCreateOK {
 
 /*BatchingThingsCreateOK handles this case with default header values.
 
-Request succeeded, see response body to get detailed information about each batched item.
+Request succeeded and every batched item was created, see response body to get detailed information about each batched item.
 */
 type BatchingThingsCreateOK struct {
+	/*Number of items which failed.
+	 */
+	XBatchFailed int64
+
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+	 */
+	XBatchFailedConflict int64
+
+	/*Number of failed items which could not be imported because of a server-side error.
+	 */
+	XBatchFailedInternal int64
+
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+	 */
+	XBatchFailedValidation int64
+
+	/*Number of items which were imported successfully.
+	 */
+	XBatchSucceeded int64
+
+	/*Number of items in the batch.
+	 */
+	XBatchTotal int64
+
 	Payload []*models.ThingsGetResponse
 }
 
@@ -98,6 +140,145 @@ func (o *BatchingThingsCreateOK) GetPayload() []*models.ThingsGetResponse {
 
 func (o *BatchingThingsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Batch-Failed
+	xBatchFailed, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed", "header", "int64", response.GetHeader("X-Batch-Failed"))
+	}
+	o.XBatchFailed = xBatchFailed
+
+	// response header X-Batch-Failed-Conflict
+	xBatchFailedConflict, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Conflict"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Conflict", "header", "int64", response.GetHeader("X-Batch-Failed-Conflict"))
+	}
+	o.XBatchFailedConflict = xBatchFailedConflict
+
+	// response header X-Batch-Failed-Internal
+	xBatchFailedInternal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Internal"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Internal", "header", "int64", response.GetHeader("X-Batch-Failed-Internal"))
+	}
+	o.XBatchFailedInternal = xBatchFailedInternal
+
+	// response header X-Batch-Failed-Validation
+	xBatchFailedValidation, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Validation"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Validation", "header", "int64", response.GetHeader("X-Batch-Failed-Validation"))
+	}
+	o.XBatchFailedValidation = xBatchFailedValidation
+
+	// response header X-Batch-Succeeded
+	xBatchSucceeded, err := swag.ConvertInt64(response.GetHeader("X-Batch-Succeeded"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Succeeded", "header", "int64", response.GetHeader("X-Batch-Succeeded"))
+	}
+	o.XBatchSucceeded = xBatchSucceeded
+
+	// response header X-Batch-Total
+	xBatchTotal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Total"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Total", "header", "int64", response.GetHeader("X-Batch-Total"))
+	}
+	o.XBatchTotal = xBatchTotal
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsCreateMultiStatus creates a BatchingThingsCreateMultiStatus with default headers values
+func NewBatchingThingsCreateMultiStatus() *BatchingThingsCreateMultiStatus {
+	return &BatchingThingsCreateMultiStatus{}
+}
+
+/*BatchingThingsCreateMultiStatus handles this case with default header values.
+
+Request succeeded, but at least one of the batched things failed. The response body lists the result of every batched item at its position in the request, with status FAILED and the errors for each item which failed.
+*/
+type BatchingThingsCreateMultiStatus struct {
+	/*Number of items which failed.
+	 */
+	XBatchFailed int64
+
+	/*Number of failed items which conflicted with an existing object, e.g. because the id was already taken.
+	 */
+	XBatchFailedConflict int64
+
+	/*Number of failed items which could not be imported because of a server-side error.
+	 */
+	XBatchFailedInternal int64
+
+	/*Number of failed items which were rejected as invalid, e.g. because of an unknown class or a wrongly typed property.
+	 */
+	XBatchFailedValidation int64
+
+	/*Number of items which were imported successfully.
+	 */
+	XBatchSucceeded int64
+
+	/*Number of items in the batch.
+	 */
+	XBatchTotal int64
+
+	Payload []*models.ThingsGetResponse
+}
+
+func (o *BatchingThingsCreateMultiStatus) Error() string {
+	return fmt.Sprintf("[POST /batching/things][%d] batchingThingsCreateMultiStatus  %+v", 207, o.Payload)
+}
+
+func (o *BatchingThingsCreateMultiStatus) GetPayload() []*models.ThingsGetResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsCreateMultiStatus) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header X-Batch-Failed
+	xBatchFailed, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed", "header", "int64", response.GetHeader("X-Batch-Failed"))
+	}
+	o.XBatchFailed = xBatchFailed
+
+	// response header X-Batch-Failed-Conflict
+	xBatchFailedConflict, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Conflict"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Conflict", "header", "int64", response.GetHeader("X-Batch-Failed-Conflict"))
+	}
+	o.XBatchFailedConflict = xBatchFailedConflict
+
+	// response header X-Batch-Failed-Internal
+	xBatchFailedInternal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Internal"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Internal", "header", "int64", response.GetHeader("X-Batch-Failed-Internal"))
+	}
+	o.XBatchFailedInternal = xBatchFailedInternal
+
+	// response header X-Batch-Failed-Validation
+	xBatchFailedValidation, err := swag.ConvertInt64(response.GetHeader("X-Batch-Failed-Validation"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Failed-Validation", "header", "int64", response.GetHeader("X-Batch-Failed-Validation"))
+	}
+	o.XBatchFailedValidation = xBatchFailedValidation
+
+	// response header X-Batch-Succeeded
+	xBatchSucceeded, err := swag.ConvertInt64(response.GetHeader("X-Batch-Succeeded"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Succeeded", "header", "int64", response.GetHeader("X-Batch-Succeeded"))
+	}
+	o.XBatchSucceeded = xBatchSucceeded
+
+	// response header X-Batch-Total
+	xBatchTotal, err := swag.ConvertInt64(response.GetHeader("X-Batch-Total"))
+	if err != nil {
+		return errors.InvalidType("X-Batch-Total", "header", "int64", response.GetHeader("X-Batch-Total"))
+	}
+	o.XBatchTotal = xBatchTotal
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -160,6 +341,39 @@ func (o *BatchingThingsCreateForbidden) readResponse(response runtime.ClientResp
 	return nil
 }
 
+// NewBatchingThingsCreateConflict creates a BatchingThingsCreateConflict with default headers values
+func NewBatchingThingsCreateConflict() *BatchingThingsCreateConflict {
+	return &BatchingThingsCreateConflict{}
+}
+
+/*BatchingThingsCreateConflict handles this case with default header values.
+
+A request with the same idempotency key is still being executed.
+*/
+type BatchingThingsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /batching/things][%d] batchingThingsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *BatchingThingsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingThingsCreateUnprocessableEntity creates a BatchingThingsCreateUnprocessableEntity with default headers values
 func NewBatchingThingsCreateUnprocessableEntity() *BatchingThingsCreateUnprocessableEntity {
 	return &BatchingThingsCreateUnprocessableEntity{}
@@ -193,6 +407,50 @@ func (o *BatchingThingsCreateUnprocessableEntity) readResponse(response runtime.
 	return nil
 }
 
+// NewBatchingThingsCreateTooManyRequests creates a BatchingThingsCreateTooManyRequests with default headers values
+func NewBatchingThingsCreateTooManyRequests() *BatchingThingsCreateTooManyRequests {
+	return &BatchingThingsCreateTooManyRequests{}
+}
+
+/*BatchingThingsCreateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded, or too many batch requests are processed at the same time. Retry after the time given in the Retry-After header.
+*/
+type BatchingThingsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /batching/things][%d] batchingThingsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *BatchingThingsCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchingThingsCreateInternalServerError creates a BatchingThingsCreateInternalServerError with default headers values
 func NewBatchingThingsCreateInternalServerError() *BatchingThingsCreateInternalServerError {
 	return &BatchingThingsCreateInternalServerError{}
@@ -42,6 +42,8 @@ type ClientService interface {
 
 	ClassificationsPost(params *ClassificationsPostParams, authInfo runtime.ClientAuthInfoWriter) (*ClassificationsPostCreated, error)
 
+	ClassificationsPreview(params *ClassificationsPreviewParams, authInfo runtime.ClientAuthInfoWriter) (*ClassificationsPreviewOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -119,6 +121,43 @@ func (a *Client) ClassificationsPost(params *ClassificationsPostParams, authInfo
 	panic(msg)
 }
 
+/*
+  ClassificationsPreview previews a classification of a single object
+
+  Predicts the reference targets of a single object the same way a kNN classification does. Nothing is stored, so this can be used to tune the parameters before running a classification.
+*/
+func (a *Client) ClassificationsPreview(params *ClassificationsPreviewParams, authInfo runtime.ClientAuthInfoWriter) (*ClassificationsPreviewOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClassificationsPreviewParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "classifications.preview",
+		Method:             "POST",
+		PathPattern:        "/classifications/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClassificationsPreviewReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClassificationsPreviewOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for classifications.preview: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
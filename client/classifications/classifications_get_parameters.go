@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewClassificationsGetParams creates a new ClassificationsGetParams object
@@ -76,6 +77,11 @@ type ClassificationsGetParams struct {
 
 	*/
 	ID string
+	/*WaitTimeoutSeconds
+	  If set, the request blocks until the classification is no longer running, but at most for the specified number of seconds (capped at 300). The classification is returned in its state at that time, so a status of 'running' indicates the wait timed out.
+
+	*/
+	WaitTimeoutSeconds *int64
 
 	timeout    time.Duration
 	Context    context.Context
@@ -126,6 +132,17 @@ func (o *ClassificationsGetParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithWaitTimeoutSeconds adds the waitTimeoutSeconds to the classifications get params
+func (o *ClassificationsGetParams) WithWaitTimeoutSeconds(waitTimeoutSeconds *int64) *ClassificationsGetParams {
+	o.SetWaitTimeoutSeconds(waitTimeoutSeconds)
+	return o
+}
+
+// SetWaitTimeoutSeconds adds the waitTimeoutSeconds to the classifications get params
+func (o *ClassificationsGetParams) SetWaitTimeoutSeconds(waitTimeoutSeconds *int64) {
+	o.WaitTimeoutSeconds = waitTimeoutSeconds
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ClassificationsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -139,6 +156,22 @@ func (o *ClassificationsGetParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.WaitTimeoutSeconds != nil {
+
+		// query param waitTimeoutSeconds
+		var qrWaitTimeoutSeconds int64
+		if o.WaitTimeoutSeconds != nil {
+			qrWaitTimeoutSeconds = *o.WaitTimeoutSeconds
+		}
+		qWaitTimeoutSeconds := swag.FormatInt64(qrWaitTimeoutSeconds)
+		if qWaitTimeoutSeconds != "" {
+			if err := r.SetQueryParam("waitTimeoutSeconds", qWaitTimeoutSeconds); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -58,6 +58,12 @@ func (o *ClassificationsGetReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewClassificationsGetUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewClassificationsGetInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -178,6 +184,39 @@ func (o *ClassificationsGetNotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewClassificationsGetUnprocessableEntity creates a ClassificationsGetUnprocessableEntity with default headers values
+func NewClassificationsGetUnprocessableEntity() *ClassificationsGetUnprocessableEntity {
+	return &ClassificationsGetUnprocessableEntity{}
+}
+
+/*ClassificationsGetUnprocessableEntity handles this case with default header values.
+
+Invalid waitTimeoutSeconds.
+*/
+type ClassificationsGetUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsGetUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /classifications/{id}][%d] classificationsGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClassificationsGetUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsGetUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewClassificationsGetInternalServerError creates a ClassificationsGetInternalServerError with default headers values
 func NewClassificationsGetInternalServerError() *ClassificationsGetInternalServerError {
 	return &ClassificationsGetInternalServerError{}
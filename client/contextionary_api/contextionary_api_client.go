@@ -44,6 +44,8 @@ type ClientService interface {
 
 	C11yExtensions(params *C11yExtensionsParams, authInfo runtime.ClientAuthInfoWriter) (*C11yExtensionsOK, error)
 
+	C11yInspect(params *C11yInspectParams, authInfo runtime.ClientAuthInfoWriter) (*C11yInspectOK, error)
+
 	C11yWords(params *C11yWordsParams, authInfo runtime.ClientAuthInfoWriter) (*C11yWordsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -153,6 +155,43 @@ func (a *Client) C11yExtensions(params *C11yExtensionsParams, authInfo runtime.C
 	panic(msg)
 }
 
+/*
+  C11yInspect inspects the vector and the nearest neighbors of a single word
+
+  Shows the vector of a single word and the words closest to it in the contextionary, ordered by their distance. Words that are not part of the contextionary result in a 404.
+*/
+func (a *Client) C11yInspect(params *C11yInspectParams, authInfo runtime.ClientAuthInfoWriter) (*C11yInspectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewC11yInspectParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "c11y.inspect",
+		Method:             "GET",
+		PathPattern:        "/c11y/inspect/{word}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &C11yInspectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*C11yInspectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for c11y.inspect: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   C11yWords checks if a word or word string is part of the contextionary
 
@@ -42,6 +42,10 @@ type ClientService interface {
 
 	GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlPostOK, error)
 
+	GraphqlQueriesCancel(params *GraphqlQueriesCancelParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlQueriesCancelNoContent, error)
+
+	GraphqlQueriesList(params *GraphqlQueriesListParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlQueriesListOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -119,6 +123,80 @@ func (a *Client) GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+  GraphqlQueriesCancel cancels a running graph ql query
+
+  Cancels a running GraphQL query. The query ends with an error as soon as the cancellation is noticed.
+*/
+func (a *Client) GraphqlQueriesCancel(params *GraphqlQueriesCancelParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlQueriesCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlQueriesCancelParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "graphql.queries.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/graphql/queries/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlQueriesCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlQueriesCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.queries.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GraphqlQueriesList lists the running graph ql queries
+
+  Lists all GraphQL queries which are currently running, the longest running first.
+*/
+func (a *Client) GraphqlQueriesList(params *GraphqlQueriesListParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlQueriesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlQueriesListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "graphql.queries.list",
+		Method:             "GET",
+		PathPattern:        "/graphql/queries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlQueriesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlQueriesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.queries.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
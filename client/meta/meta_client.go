@@ -18,6 +18,7 @@ package meta
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -38,11 +39,56 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	MetaCompact(params *MetaCompactParams, authInfo runtime.ClientAuthInfoWriter) (*MetaCompactOK, error)
+
 	MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoWriter) (*MetaGetOK, error)
 
+	MetaShardRestore(params *MetaShardRestoreParams, authInfo runtime.ClientAuthInfoWriter) (*MetaShardRestoreOK, error)
+
+	MetaShardSnapshot(params *MetaShardSnapshotParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer) (*MetaShardSnapshotOK, error)
+
+	MetaVectorIndexStats(params *MetaVectorIndexStatsParams, authInfo runtime.ClientAuthInfoWriter) (*MetaVectorIndexStatsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  MetaCompact compacts the storage files of the current weaviate instance
+
+  Rewrites the storage file of every shard into a fresh file and reports the reclaimed disk space. Space of deleted and overwritten objects is otherwise never released. Reads continue during the compaction, writes to the shard which is being compacted wait until it is done. The compaction is throttled according to the server configuration. Closing the request cancels the compaction, shards which were already compacted stay compacted. Only available with the standalone storage.
+*/
+func (a *Client) MetaCompact(params *MetaCompactParams, authInfo runtime.ClientAuthInfoWriter) (*MetaCompactOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMetaCompactParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "meta.compact",
+		Method:             "POST",
+		PathPattern:        "/meta/compaction",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &MetaCompactReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MetaCompactOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for meta.compact: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   MetaGet returns meta information of the current weaviate instance
 
@@ -80,6 +126,117 @@ func (a *Client) MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoW
 	panic(msg)
 }
 
+/*
+  MetaShardRestore restores a shard of the current weaviate instance from a snapshot
+
+  Replaces the contents of a single shard of the class with a snapshot which was taken with a GET on the same path. Writes to the shard wait until the restore is done. The shard is left untouched if the snapshot is malformed or cannot be loaded. Only available with the standalone storage.
+*/
+func (a *Client) MetaShardRestore(params *MetaShardRestoreParams, authInfo runtime.ClientAuthInfoWriter) (*MetaShardRestoreOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMetaShardRestoreParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "meta.shardRestore",
+		Method:             "PUT",
+		PathPattern:        "/meta/snapshots/{className}/{shardName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/x-tar"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &MetaShardRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MetaShardRestoreOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for meta.shardRestore: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  MetaShardSnapshot takes a snapshot of a shard of the current weaviate instance
+
+  Streams a point-in-time snapshot of a single shard of the class as a tar archive. Writes to the shard are paused only until the snapshot has been taken, not while it is downloaded. The snapshot can be loaded back with a PUT on the same path. Only available with the standalone storage.
+*/
+func (a *Client) MetaShardSnapshot(params *MetaShardSnapshotParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer) (*MetaShardSnapshotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMetaShardSnapshotParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "meta.shardSnapshot",
+		Method:             "GET",
+		PathPattern:        "/meta/snapshots/{className}/{shardName}",
+		ProducesMediaTypes: []string{"application/json", "application/x-tar"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &MetaShardSnapshotReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MetaShardSnapshotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for meta.shardSnapshot: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  MetaVectorIndexStats returns statistics of the vector indices of the current weaviate instance
+
+  Reports the size and build parameters of the vector index of every shard. Only available with the standalone storage.
+*/
+func (a *Client) MetaVectorIndexStats(params *MetaVectorIndexStatsParams, authInfo runtime.ClientAuthInfoWriter) (*MetaVectorIndexStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMetaVectorIndexStatsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "meta.vectorIndexStats",
+		Method:             "GET",
+		PathPattern:        "/meta/vector-index",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &MetaVectorIndexStatsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MetaVectorIndexStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for meta.vectorIndexStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
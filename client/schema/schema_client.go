@@ -42,16 +42,46 @@ type ClientService interface {
 
 	SchemaActionsDelete(params *SchemaActionsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsDeleteOK, error)
 
+	SchemaActionsFreeze(params *SchemaActionsFreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsFreezeOK, error)
+
 	SchemaActionsPropertiesAdd(params *SchemaActionsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesAddOK, error)
 
+	SchemaActionsPropertiesBatchAdd(params *SchemaActionsPropertiesBatchAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesBatchAddOK, error)
+
+	SchemaActionsPropertiesDelete(params *SchemaActionsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesDeleteOK, error)
+
+	SchemaActionsUnfreeze(params *SchemaActionsUnfreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsUnfreezeOK, error)
+
+	SchemaCounts(params *SchemaCountsParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaCountsOK, error)
+
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaDumpOK, error)
 
+	SchemaExport(params *SchemaExportParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaExportOK, error)
+
+	SchemaImport(params *SchemaImportParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaImportOK, error)
+
+	SchemaReindex(params *SchemaReindexParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexAccepted, error)
+
+	SchemaReindexGet(params *SchemaReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexGetOK, error)
+
+	SchemaShardsGet(params *SchemaShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsGetOK, error)
+
+	SchemaShardsUpdate(params *SchemaShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsUpdateAccepted, error)
+
 	SchemaThingsCreate(params *SchemaThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsCreateOK, error)
 
 	SchemaThingsDelete(params *SchemaThingsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsDeleteOK, error)
 
+	SchemaThingsFreeze(params *SchemaThingsFreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsFreezeOK, error)
+
 	SchemaThingsPropertiesAdd(params *SchemaThingsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesAddOK, error)
 
+	SchemaThingsPropertiesBatchAdd(params *SchemaThingsPropertiesBatchAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesBatchAddOK, error)
+
+	SchemaThingsPropertiesDelete(params *SchemaThingsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesDeleteOK, error)
+
+	SchemaThingsUnfreeze(params *SchemaThingsUnfreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsUnfreezeOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -125,6 +155,43 @@ func (a *Client) SchemaActionsDelete(params *SchemaActionsDeleteParams, authInfo
 	panic(msg)
 }
 
+/*
+  SchemaActionsFreeze freezes a action class
+
+  Makes the Action class read-only, e.g. for the duration of a migration. Creating, updating or deleting Actions of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.
+*/
+func (a *Client) SchemaActionsFreeze(params *SchemaActionsFreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsFreezeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsFreezeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.freeze",
+		Method:             "PUT",
+		PathPattern:        "/schema/actions/{className}/frozen",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsFreezeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsFreezeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.freeze: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaActionsPropertiesAdd adds a property to an action class
 */
@@ -160,6 +227,154 @@ func (a *Client) SchemaActionsPropertiesAdd(params *SchemaActionsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+  SchemaActionsPropertiesBatchAdd adds multiple properties to an action class at once
+
+  Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.
+*/
+func (a *Client) SchemaActionsPropertiesBatchAdd(params *SchemaActionsPropertiesBatchAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesBatchAddOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsPropertiesBatchAddParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.properties.batchAdd",
+		Method:             "POST",
+		PathPattern:        "/schema/actions/{className}/properties/batch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsPropertiesBatchAddReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsPropertiesBatchAddOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.properties.batchAdd: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaActionsPropertiesDelete removes a property from an action class
+
+  Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.
+*/
+func (a *Client) SchemaActionsPropertiesDelete(params *SchemaActionsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsPropertiesDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/actions/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaActionsUnfreeze unfreezes a action class
+
+  Makes a frozen Action class writable again. Unfreezing a class which is not frozen has no effect.
+*/
+func (a *Client) SchemaActionsUnfreeze(params *SchemaActionsUnfreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsUnfreezeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsUnfreezeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.unfreeze",
+		Method:             "DELETE",
+		PathPattern:        "/schema/actions/{className}/frozen",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsUnfreezeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsUnfreezeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.unfreeze: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaCounts lists all classes with their object counts
+
+  Returns every Thing and Action class of the schema together with the number of objects it holds. The counts are determined without loading any objects and are cached for a few seconds.
+*/
+func (a *Client) SchemaCounts(params *SchemaCountsParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaCountsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaCountsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.counts",
+		Method:             "GET",
+		PathPattern:        "/schema/counts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaCountsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaCountsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.counts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaDump dumps the current the database schema
 */
@@ -195,6 +410,224 @@ func (a *Client) SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+  SchemaExport exports the complete schema as a single document
+
+  Exports all thing and action classes including their properties and configuration. The document can be imported into another instance with a POST to /schema/import.
+*/
+func (a *Client) SchemaExport(params *SchemaExportParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaExportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.export",
+		Method:             "GET",
+		PathPattern:        "/schema/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaExportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaImport imports a previously exported schema
+
+  Creates all classes of the document which don't exist yet. Classes which already exist with an identical definition are left untouched, so the import can safely be repeated. If any class already exists with a different definition, nothing is created and the conflicts are reported instead.
+*/
+func (a *Client) SchemaImport(params *SchemaImportParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaImportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.import",
+		Method:             "POST",
+		PathPattern:        "/schema/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaImportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.import: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaReindex res vectorize all objects of a class
+
+  Re-vectorizes every object of the class with the current vectorizer configuration and replaces the vectors in the vector index. The reindex runs in the background, its progress can be retrieved with a GET on the same path. Starting a reindex of a class whose previous reindex was interrupted continues where it stopped.
+*/
+func (a *Client) SchemaReindex(params *SchemaReindexParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaReindexParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.reindex",
+		Method:             "POST",
+		PathPattern:        "/schema/reindex/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaReindexReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaReindexAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.reindex: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaReindexGet gets the progress of the reindex of a class
+*/
+func (a *Client) SchemaReindexGet(params *SchemaReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaReindexGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.reindex.get",
+		Method:             "GET",
+		PathPattern:        "/schema/reindex/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaReindexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaReindexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.reindex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaShardsGet gets the progress of the reshard of a class
+*/
+func (a *Client) SchemaShardsGet(params *SchemaShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaShardsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.shards.get",
+		Method:             "GET",
+		PathPattern:        "/schema/shards/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaShardsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaShardsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaShardsUpdate changes the shard configuration of a class
+
+  Moves all objects of the class to a new index with the specified number of shards and replicas. The objects are copied in the background while the class stays queryable, only during the final catch-up writes to the class are rejected. The progress can be retrieved with a GET on the same path. Starting a reshard with the same configuration as an interrupted one resumes it. Only supported by the elasticsearch vector index.
+*/
+func (a *Client) SchemaShardsUpdate(params *SchemaShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsUpdateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaShardsUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.shards.update",
+		Method:             "POST",
+		PathPattern:        "/schema/shards/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaShardsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaShardsUpdateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaThingsCreate creates a new thing class in the schema
 */
@@ -265,6 +698,43 @@ func (a *Client) SchemaThingsDelete(params *SchemaThingsDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+  SchemaThingsFreeze freezes a thing class
+
+  Makes the Thing class read-only, e.g. for the duration of a migration. Creating, updating or deleting Things of a frozen class and changing the class in the schema are rejected with 403 Forbidden, reading still works. Freezing a frozen class has no effect.
+*/
+func (a *Client) SchemaThingsFreeze(params *SchemaThingsFreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsFreezeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsFreezeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.freeze",
+		Method:             "PUT",
+		PathPattern:        "/schema/things/{className}/frozen",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsFreezeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsFreezeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.freeze: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaThingsPropertiesAdd adds a property to a thing class
 */
@@ -300,6 +770,117 @@ func (a *Client) SchemaThingsPropertiesAdd(params *SchemaThingsPropertiesAddPara
 	panic(msg)
 }
 
+/*
+  SchemaThingsPropertiesBatchAdd adds multiple properties to a thing class at once
+
+  Adds all properties in one operation. The whole set is validated first, if any of the properties is invalid, none of them are added.
+*/
+func (a *Client) SchemaThingsPropertiesBatchAdd(params *SchemaThingsPropertiesBatchAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesBatchAddOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsPropertiesBatchAddParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.properties.batchAdd",
+		Method:             "POST",
+		PathPattern:        "/schema/things/{className}/properties/batch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsPropertiesBatchAddReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsPropertiesBatchAddOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.properties.batchAdd: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaThingsPropertiesDelete removes a property from a thing class
+
+  Removes the property from the class definition. Values already stored for this property are no longer returned and are dropped lazily. A property cannot be removed while it is used by a running classification.
+*/
+func (a *Client) SchemaThingsPropertiesDelete(params *SchemaThingsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsPropertiesDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/things/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaThingsUnfreeze unfreezes a thing class
+
+  Makes a frozen Thing class writable again. Unfreezing a class which is not frozen has no effect.
+*/
+func (a *Client) SchemaThingsUnfreeze(params *SchemaThingsUnfreezeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsUnfreezeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsUnfreezeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.unfreeze",
+		Method:             "DELETE",
+		PathPattern:        "/schema/things/{className}/frozen",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsUnfreezeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsUnfreezeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.unfreeze: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
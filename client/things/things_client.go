@@ -38,20 +38,28 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ThingsCorpus(params *ThingsCorpusParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsCorpusOK, error)
+
 	ThingsCreate(params *ThingsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsCreateOK, error)
 
 	ThingsDelete(params *ThingsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsDeleteNoContent, error)
 
+	ThingsFacets(params *ThingsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsFacetsOK, error)
+
 	ThingsGet(params *ThingsGetParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsGetOK, error)
 
 	ThingsList(params *ThingsListParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsListOK, error)
 
 	ThingsPatch(params *ThingsPatchParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsPatchNoContent, error)
 
+	ThingsReferencesBulkUpdate(params *ThingsReferencesBulkUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesBulkUpdateOK, error)
+
 	ThingsReferencesCreate(params *ThingsReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesCreateOK, error)
 
 	ThingsReferencesDelete(params *ThingsReferencesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesDeleteNoContent, error)
 
+	ThingsReferencesList(params *ThingsReferencesListParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesListOK, error)
+
 	ThingsReferencesUpdate(params *ThingsReferencesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesUpdateOK, error)
 
 	ThingsUpdate(params *ThingsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsUpdateOK, error)
@@ -61,6 +69,43 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  ThingsCorpus gets the vectorizer corpus of a thing
+
+  Returns the exact text the vectorizer builds from a Thing to determine its vector position, i.e. the concatenated and weighted property values after preprocessing. Use it to debug unexpected vector positions. As this exposes the full contents of the Thing, it is only available if debug mode is enabled.
+*/
+func (a *Client) ThingsCorpus(params *ThingsCorpusParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsCorpusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThingsCorpusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "things.corpus",
+		Method:             "GET",
+		PathPattern:        "/things/{id}/corpus",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ThingsCorpusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThingsCorpusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for things.corpus: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ThingsCreate creates a new thing based on a thing template
 
@@ -135,6 +180,43 @@ func (a *Client) ThingsDelete(params *ThingsDeleteParams, authInfo runtime.Clien
 	panic(msg)
 }
 
+/*
+  ThingsFacets gets the distribution of the values of a property
+
+  Returns the most common distinct values of a property of a Thing class, each with the number of Things that have this value, as well as the approximate number of distinct values overall. Use it to build faceted navigation. Only primitive properties are supported.
+*/
+func (a *Client) ThingsFacets(params *ThingsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsFacetsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThingsFacetsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "things.facets",
+		Method:             "GET",
+		PathPattern:        "/things/facets/{className}/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ThingsFacetsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThingsFacetsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for things.facets: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ThingsGet gets a thing based on its UUID
 
@@ -175,7 +257,7 @@ func (a *Client) ThingsGet(params *ThingsGetParams, authInfo runtime.ClientAuthI
 /*
   ThingsList gets a list of things
 
-  Lists all Things in reverse order of creation, owned by the user that belongs to the used token.
+  Lists all Things in reverse order of creation, owned by the user that belongs to the used token. Set the Accept header to application/x-ndjson to stream the results as one Thing per line.
 */
 func (a *Client) ThingsList(params *ThingsListParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsListOK, error) {
 	// TODO: Validate the params before sending
@@ -187,7 +269,7 @@ func (a *Client) ThingsList(params *ThingsListParams, authInfo runtime.ClientAut
 		ID:                 "things.list",
 		Method:             "GET",
 		PathPattern:        "/things",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
@@ -212,7 +294,7 @@ func (a *Client) ThingsList(params *ThingsListParams, authInfo runtime.ClientAut
 /*
   ThingsPatch updates a thing based on its UUID using patch semantics
 
-  Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+  Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.
 */
 func (a *Client) ThingsPatch(params *ThingsPatchParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsPatchNoContent, error) {
 	// TODO: Validate the params before sending
@@ -246,6 +328,43 @@ func (a *Client) ThingsPatch(params *ThingsPatchParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+  ThingsReferencesBulkUpdate replaces all references of several class properties
+
+  Replace all references of every class-property listed in the body. All properties are updated at once, so either all or none of them are changed.
+*/
+func (a *Client) ThingsReferencesBulkUpdate(params *ThingsReferencesBulkUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesBulkUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThingsReferencesBulkUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "things.references.bulkUpdate",
+		Method:             "PUT",
+		PathPattern:        "/things/{id}/references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ThingsReferencesBulkUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThingsReferencesBulkUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for things.references.bulkUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ThingsReferencesCreate adds a single reference to a class property
 
@@ -320,6 +439,43 @@ func (a *Client) ThingsReferencesDelete(params *ThingsReferencesDeleteParams, au
 	panic(msg)
 }
 
+/*
+  ThingsReferencesList lists the references of a thing
+
+  Lists only the reference properties of a Thing, without its other properties and vector. The beacons can optionally be parsed into the kind and id of their target, or resolved to the target objects.
+*/
+func (a *Client) ThingsReferencesList(params *ThingsReferencesListParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsReferencesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThingsReferencesListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "things.references.list",
+		Method:             "GET",
+		PathPattern:        "/things/{id}/references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ThingsReferencesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThingsReferencesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for things.references.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ThingsReferencesUpdate replaces all references to a class property
 
@@ -360,7 +516,7 @@ func (a *Client) ThingsReferencesUpdate(params *ThingsReferencesUpdateParams, au
 /*
   ThingsUpdate updates a thing based on its UUID
 
-  Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+  Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called. If the If-Match header is set, the update only succeeds if the Thing still has the given version.
 */
 func (a *Client) ThingsUpdate(params *ThingsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsUpdateOK, error) {
 	// TODO: Validate the params before sending
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -52,12 +54,24 @@ func (o *ThingsCreateReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewThingsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewThingsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewThingsCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewThingsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -157,6 +171,39 @@ func (o *ThingsCreateForbidden) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewThingsCreateConflict creates a ThingsCreateConflict with default headers values
+func NewThingsCreateConflict() *ThingsCreateConflict {
+	return &ThingsCreateConflict{}
+}
+
+/*ThingsCreateConflict handles this case with default header values.
+
+An object with the same id already exists.
+*/
+type ThingsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /things][%d] thingsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ThingsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsCreateUnprocessableEntity creates a ThingsCreateUnprocessableEntity with default headers values
 func NewThingsCreateUnprocessableEntity() *ThingsCreateUnprocessableEntity {
 	return &ThingsCreateUnprocessableEntity{}
@@ -190,6 +237,50 @@ func (o *ThingsCreateUnprocessableEntity) readResponse(response runtime.ClientRe
 	return nil
 }
 
+// NewThingsCreateTooManyRequests creates a ThingsCreateTooManyRequests with default headers values
+func NewThingsCreateTooManyRequests() *ThingsCreateTooManyRequests {
+	return &ThingsCreateTooManyRequests{}
+}
+
+/*ThingsCreateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ThingsCreateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /things][%d] thingsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ThingsCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsCreateInternalServerError creates a ThingsCreateInternalServerError with default headers values
 func NewThingsCreateInternalServerError() *ThingsCreateInternalServerError {
 	return &ThingsCreateInternalServerError{}
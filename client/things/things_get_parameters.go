@@ -72,13 +72,23 @@ for the things get operation typically these are written to a http.Request
 */
 type ThingsGetParams struct {
 
+	/*Depth
+	  How many levels of references listed in 'expand' should be resolved. Defaults to 1, the maximum is 3.
+
+	*/
+	Depth *int64
+	/*Expand
+	  Comma-separated list of reference properties which should be resolved inline, e.g. 'writtenBy,inCity'. The properties of the referenced objects are returned instead of just their beacons.
+
+	*/
+	Expand *string
 	/*ID
 	  Unique ID of the Thing.
 
 	*/
 	ID strfmt.UUID
 	/*Include
-	  Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation
+	  Include additional information, such as classification infos. Allowed values include: classification, _classification, vector, _vector, interpretation, _interpretation, nearestNeighbors, _nearestNeighbors, featureProjection, _featureProjection. The feature projection can be configured with arguments, e.g. _featureProjection(dimensions:3,iterations:200,perplexity:5,learningRate:25)
 
 	*/
 	Include *string
@@ -126,6 +136,28 @@ func (o *ThingsGetParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithDepth adds the depth to the things get params
+func (o *ThingsGetParams) WithDepth(depth *int64) *ThingsGetParams {
+	o.SetDepth(depth)
+	return o
+}
+
+// SetDepth adds the depth to the things get params
+func (o *ThingsGetParams) SetDepth(depth *int64) {
+	o.Depth = depth
+}
+
+// WithExpand adds the expand to the things get params
+func (o *ThingsGetParams) WithExpand(expand *string) *ThingsGetParams {
+	o.SetExpand(expand)
+	return o
+}
+
+// SetExpand adds the expand to the things get params
+func (o *ThingsGetParams) SetExpand(expand *string) {
+	o.Expand = expand
+}
+
 // WithID adds the id to the things get params
 func (o *ThingsGetParams) WithID(id strfmt.UUID) *ThingsGetParams {
 	o.SetID(id)
@@ -167,6 +199,38 @@ func (o *ThingsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 	}
 	var res []error
 
+	if o.Depth != nil {
+
+		// query param depth
+		var qrDepth int64
+		if o.Depth != nil {
+			qrDepth = *o.Depth
+		}
+		qDepth := swag.FormatInt64(qrDepth)
+		if qDepth != "" {
+			if err := r.SetQueryParam("depth", qDepth); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Expand != nil {
+
+		// query param expand
+		var qrExpand string
+		if o.Expand != nil {
+			qrExpand = *o.Expand
+		}
+		qExpand := qrExpand
+		if qExpand != "" {
+			if err := r.SetQueryParam("expand", qExpand); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -64,12 +66,24 @@ func (o *ThingsPatchReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewThingsPatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewThingsPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewThingsPatchTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewThingsPatchInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -199,6 +213,39 @@ func (o *ThingsPatchNotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewThingsPatchConflict creates a ThingsPatchConflict with default headers values
+func NewThingsPatchConflict() *ThingsPatchConflict {
+	return &ThingsPatchConflict{}
+}
+
+/*ThingsPatchConflict handles this case with default header values.
+
+Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.
+*/
+type ThingsPatchConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsPatchConflict) Error() string {
+	return fmt.Sprintf("[PATCH /things/{id}][%d] thingsPatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *ThingsPatchConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsPatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsPatchUnprocessableEntity creates a ThingsPatchUnprocessableEntity with default headers values
 func NewThingsPatchUnprocessableEntity() *ThingsPatchUnprocessableEntity {
 	return &ThingsPatchUnprocessableEntity{}
@@ -232,6 +279,50 @@ func (o *ThingsPatchUnprocessableEntity) readResponse(response runtime.ClientRes
 	return nil
 }
 
+// NewThingsPatchTooManyRequests creates a ThingsPatchTooManyRequests with default headers values
+func NewThingsPatchTooManyRequests() *ThingsPatchTooManyRequests {
+	return &ThingsPatchTooManyRequests{}
+}
+
+/*ThingsPatchTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ThingsPatchTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsPatchTooManyRequests) Error() string {
+	return fmt.Sprintf("[PATCH /things/{id}][%d] thingsPatchTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ThingsPatchTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsPatchTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsPatchInternalServerError creates a ThingsPatchInternalServerError with default headers values
 func NewThingsPatchInternalServerError() *ThingsPatchInternalServerError {
 	return &ThingsPatchInternalServerError{}
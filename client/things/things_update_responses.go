@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -58,12 +60,24 @@ func (o *ThingsUpdateReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewThingsUpdateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewThingsUpdateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewThingsUpdateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewThingsUpdateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -184,6 +198,39 @@ func (o *ThingsUpdateNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewThingsUpdateConflict creates a ThingsUpdateConflict with default headers values
+func NewThingsUpdateConflict() *ThingsUpdateConflict {
+	return &ThingsUpdateConflict{}
+}
+
+/*ThingsUpdateConflict handles this case with default header values.
+
+Another object already has the same value for a unique property, or the Thing has been changed since the version given in the If-Match header.
+*/
+type ThingsUpdateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsUpdateConflict) Error() string {
+	return fmt.Sprintf("[PUT /things/{id}][%d] thingsUpdateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ThingsUpdateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsUpdateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsUpdateUnprocessableEntity creates a ThingsUpdateUnprocessableEntity with default headers values
 func NewThingsUpdateUnprocessableEntity() *ThingsUpdateUnprocessableEntity {
 	return &ThingsUpdateUnprocessableEntity{}
@@ -217,6 +264,50 @@ func (o *ThingsUpdateUnprocessableEntity) readResponse(response runtime.ClientRe
 	return nil
 }
 
+// NewThingsUpdateTooManyRequests creates a ThingsUpdateTooManyRequests with default headers values
+func NewThingsUpdateTooManyRequests() *ThingsUpdateTooManyRequests {
+	return &ThingsUpdateTooManyRequests{}
+}
+
+/*ThingsUpdateTooManyRequests handles this case with default header values.
+
+Too many objects have been written recently, the write rate limit of the class or the global write rate limit is exceeded. Retry after the time given in the Retry-After header.
+*/
+type ThingsUpdateTooManyRequests struct {
+	/*Number of seconds after which the request is expected to be admitted.
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsUpdateTooManyRequests) Error() string {
+	return fmt.Sprintf("[PUT /things/{id}][%d] thingsUpdateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *ThingsUpdateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsUpdateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Retry-After
+	retryAfter, err := swag.ConvertInt64(response.GetHeader("Retry-After"))
+	if err != nil {
+		return errors.InvalidType("Retry-After", "header", "int64", response.GetHeader("Retry-After"))
+	}
+	o.RetryAfter = retryAfter
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsUpdateInternalServerError creates a ThingsUpdateInternalServerError with default headers values
 func NewThingsUpdateInternalServerError() *ThingsUpdateInternalServerError {
 	return &ThingsUpdateInternalServerError{}
@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregation
+
+// Facet is the distribution of the values of a single property
+type Facet struct {
+	// Values are the most common values, ordered by their count descending
+	Values []FacetValue
+
+	// Cardinality is the approximate number of distinct values
+	Cardinality int
+
+	// OtherCount is the number of objects whose value was cut off by the limit
+	OtherCount int
+}
+
+type FacetValue struct {
+	Value interface{}
+	Count int
+}
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseDate parses an RFC3339 date as used in valueDate filters. The date is
+// normalized to UTC, so that dates given in different timezones compare by
+// the instant they describe.
+func ParseDate(in string) (time.Time, error) {
+	date, err := time.Parse(time.RFC3339Nano, in)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("'%s' is not a valid RFC3339 date, such as "+
+			"2006-01-02T15:04:05Z07:00", in)
+	}
+
+	return date.UTC(), nil
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	t.Run("in utc", func(t *testing.T) {
+		date, err := ParseDate("2020-06-01T10:00:00Z")
+		require.Nil(t, err)
+		assert.Equal(t, time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC), date)
+	})
+
+	t.Run("with an offset and fractional seconds", func(t *testing.T) {
+		date, err := ParseDate("2020-06-01T12:00:00.5+02:00")
+		require.Nil(t, err)
+		assert.Equal(t, time.Date(2020, 6, 1, 10, 0, 0, 5e8, time.UTC), date)
+		assert.Equal(t, time.UTC, date.Location())
+	})
+
+	t.Run("with an invalid date", func(t *testing.T) {
+		_, err := ParseDate("yesterday")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "'yesterday' is not a valid RFC3339 date")
+	})
+
+	t.Run("without a timezone", func(t *testing.T) {
+		_, err := ParseDate("2020-06-01T10:00:00")
+		assert.NotNil(t, err)
+	})
+}
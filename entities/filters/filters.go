@@ -30,6 +30,8 @@ const (
 	OperatorNot              Operator = 9
 	OperatorWithinGeoRange   Operator = 10
 	OperatorLike             Operator = 11
+	OperatorIsNull           Operator = 12
+	OperatorIsNotNull        Operator = 13
 )
 
 func (o Operator) OnValue() bool {
@@ -41,13 +43,21 @@ func (o Operator) OnValue() bool {
 		OperatorLessThan,
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
-		OperatorLike:
+		OperatorLike,
+		OperatorIsNull,
+		OperatorIsNotNull:
 		return true
 	default:
 		return false
 	}
 }
 
+// WithoutValue is true for the operators which only check whether the
+// property at the path has a value at all. They must not be given a value.
+func (o Operator) WithoutValue() bool {
+	return o == OperatorIsNull || o == OperatorIsNotNull
+}
+
 func (o Operator) Name() string {
 	switch o {
 	case OperatorEqual:
@@ -72,6 +82,10 @@ func (o Operator) Name() string {
 		return "WithinGeoRange"
 	case OperatorLike:
 		return "Like"
+	case OperatorIsNull:
+		return "IsNull"
+	case OperatorIsNotNull:
+		return "IsNotNull"
 	default:
 		panic("Unknown operator")
 	}
@@ -18,6 +18,11 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
+// InternalPropCreationTimeUnix is the creation time of an object in
+// milliseconds since the epoch. It is not part of the class schema, but can be
+// used as the property of a filter path and to sort the results of a search.
+const InternalPropCreationTimeUnix = "_creationTimeUnix"
+
 // Represents the path in a filter.
 // Either RelationProperty or PrimitiveProperty must be empty (e.g. "").
 type Path struct {
@@ -116,6 +121,19 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 			return nil, fmt.Errorf("Expected a valid class name in 'path' field for the filter but got '%s'", rawClassName)
 		}
 
+		if rawPropertyName == InternalPropCreationTimeUnix {
+			if lengthRemaining > 2 {
+				return nil, fmt.Errorf("'%s' cannot be followed by further path elements",
+					InternalPropCreationTimeUnix)
+			}
+
+			current.Child = &Path{
+				Class:    className,
+				Property: schema.PropertyName(rawPropertyName),
+			}
+			break
+		}
+
 		propertyName, err := schema.ValidatePropertyName(rawPropertyName)
 
 		// Invalid property name?
@@ -64,6 +64,28 @@ func Test_ParsePath(t *testing.T) {
 
 		// Print Slice
 	})
+
+	t.Run("with the creation time", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"_creationTimeUnix"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "_creationTimeUnix",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with the creation time followed by further elements", func(t *testing.T) {
+		segments := []interface{}{"_creationTimeUnix", "Country", "name"}
+
+		_, err := ParsePath(segments, "City")
+
+		assert.NotNil(t, err, "should error")
+	})
 }
 
 func Test_SlicePath(t *testing.T) {
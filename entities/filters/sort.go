@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import "fmt"
+
+// SortOrder is the direction in which the results are sorted
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Sort orders the results of a class search by a property. At the moment
+// only the creation time of the objects (InternalPropCreationTimeUnix) can be
+// sorted on, as it is the only property with an index that is ordered.
+type Sort struct {
+	Property string
+	Order    SortOrder
+}
+
+// ValidateSort checks that the property can be sorted on and that the order
+// is either ascending or descending. A nil sort is valid.
+func ValidateSort(s *Sort) error {
+	if s == nil {
+		return nil
+	}
+
+	if s.Property != InternalPropCreationTimeUnix {
+		return fmt.Errorf("sorting is only possible on '%s', but got '%s'",
+			InternalPropCreationTimeUnix, s.Property)
+	}
+
+	switch s.Order {
+	case SortOrderAsc, SortOrderDesc:
+		return nil
+	default:
+		return fmt.Errorf("sort order must be '%s' or '%s', but got '%s'",
+			SortOrderAsc, SortOrderDesc, s.Order)
+	}
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSort(t *testing.T) {
+	tests := []struct {
+		name        string
+		sort        *Sort
+		expectedErr bool
+	}{
+		{
+			name: "no sort",
+		},
+		{
+			name: "creation time ascending",
+			sort: &Sort{Property: InternalPropCreationTimeUnix, Order: SortOrderAsc},
+		},
+		{
+			name: "creation time descending",
+			sort: &Sort{Property: InternalPropCreationTimeUnix, Order: SortOrderDesc},
+		},
+		{
+			name:        "a regular property",
+			sort:        &Sort{Property: "name", Order: SortOrderAsc},
+			expectedErr: true,
+		},
+		{
+			name:        "an invalid order",
+			sort:        &Sort{Property: InternalPropCreationTimeUnix, Order: "newest"},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSort(test.sort)
+			assert.Equal(t, test.expectedErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// ValidateClause checks the structure of a (nested) clause: Operators on
+// values need a path and a value, but no operands. IsNull and IsNotNull need
+// a path, but neither a value nor operands. And and Or need at least
+// one operand, Not needs exactly one. Operands may be nested arbitrarily.
+func ValidateClause(c *Clause) error {
+	if c == nil {
+		return fmt.Errorf("clause must not be empty")
+	}
+
+	if c.Operator.OnValue() {
+		if len(c.Operands) > 0 {
+			return fmt.Errorf("operator '%s' does not accept operands", c.Operator.Name())
+		}
+
+		if c.On == nil {
+			return fmt.Errorf("operator '%s' requires a path", c.Operator.Name())
+		}
+
+		if c.Operator.WithoutValue() {
+			if c.Value != nil {
+				return fmt.Errorf("operator '%s' does not accept a value", c.Operator.Name())
+			}
+
+			return nil
+		}
+
+		if c.Value == nil {
+			return fmt.Errorf("operator '%s' requires a value", c.Operator.Name())
+		}
+
+		return nil
+	}
+
+	switch c.Operator {
+	case OperatorAnd, OperatorOr:
+		if len(c.Operands) == 0 {
+			return fmt.Errorf("operator '%s' requires at least one operand", c.Operator.Name())
+		}
+	case OperatorNot:
+		if len(c.Operands) != 1 {
+			return fmt.Errorf("operator 'Not' requires exactly one operand, got %d",
+				len(c.Operands))
+		}
+	default:
+		return fmt.Errorf("unknown operator %d", c.Operator)
+	}
+
+	if c.On != nil || c.Value != nil {
+		return fmt.Errorf("operator '%s' does not accept a path or value, only operands",
+			c.Operator.Name())
+	}
+
+	for i := range c.Operands {
+		if err := ValidateClause(&c.Operands[i]); err != nil {
+			return fmt.Errorf("operand %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateInvertedIndex makes sure that every property the filter is on has
+// an inverted index. A filter on a property without one can't be answered by
+// any backend. Classes and properties which don't exist are not checked,
+// reporting them is up to the search.
+func ValidateInvertedIndex(sch schema.Schema, filter *LocalFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	return validateInvertedIndexClause(sch, filter.Root)
+}
+
+func validateInvertedIndexClause(sch schema.Schema, c *Clause) error {
+	if c == nil {
+		return nil
+	}
+
+	for path := c.On; path != nil; path = path.Child {
+		class := sch.FindClassByName(path.Class)
+		if class == nil {
+			continue
+		}
+
+		prop, err := schema.GetPropertyByName(class, path.Property.String())
+		if err != nil {
+			continue
+		}
+
+		if !schema.HasInvertedIndex(prop) {
+			return fmt.Errorf("property '%s' of class '%s' has no inverted index and "+
+				"cannot be used in a filter, it is turned off by indexInverted, "+
+				"indexFilterable or indexSearchable", prop.Name, class.Class)
+		}
+	}
+
+	for i := range c.Operands {
+		if err := validateInvertedIndexClause(sch, &c.Operands[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateClause(t *testing.T) {
+	valueClause := func() Clause {
+		return Clause{
+			Operator: OperatorEqual,
+			On: &Path{
+				Class:    schema.ClassName("Car"),
+				Property: schema.PropertyName("horsepower"),
+			},
+			Value: &Value{Value: 42, Type: schema.DataTypeInt},
+		}
+	}
+
+	type test struct {
+		name        string
+		clause      *Clause
+		expectedErr bool
+	}
+
+	tests := []test{
+		test{
+			name:        "a single value operator",
+			clause:      func() *Clause { c := valueClause(); return &c }(),
+			expectedErr: false,
+		},
+		test{
+			name: "not with a nested and",
+			clause: &Clause{
+				Operator: OperatorNot,
+				Operands: []Clause{
+					Clause{
+						Operator: OperatorAnd,
+						Operands: []Clause{valueClause(), valueClause()},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		test{
+			name: "not without operands",
+			clause: &Clause{
+				Operator: OperatorNot,
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "not with two operands",
+			clause: &Clause{
+				Operator: OperatorNot,
+				Operands: []Clause{valueClause(), valueClause()},
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "and without operands",
+			clause: &Clause{
+				Operator: OperatorAnd,
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "malformed not nested deeply",
+			clause: &Clause{
+				Operator: OperatorOr,
+				Operands: []Clause{
+					valueClause(),
+					Clause{Operator: OperatorNot},
+				},
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "value operator with operands",
+			clause: func() *Clause {
+				c := valueClause()
+				c.Operands = []Clause{valueClause()}
+				return &c
+			}(),
+			expectedErr: true,
+		},
+		test{
+			name: "value operator without a value",
+			clause: &Clause{
+				Operator: OperatorEqual,
+				On:       valueClause().On,
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "is null without a value",
+			clause: &Clause{
+				Operator: OperatorIsNull,
+				On:       valueClause().On,
+			},
+			expectedErr: false,
+		},
+		test{
+			name: "is not null with a value",
+			clause: &Clause{
+				Operator: OperatorIsNotNull,
+				On:       valueClause().On,
+				Value:    valueClause().Value,
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "is null without a path",
+			clause: &Clause{
+				Operator: OperatorIsNull,
+			},
+			expectedErr: true,
+		},
+		test{
+			name: "compound operator with a value",
+			clause: &Clause{
+				Operator: OperatorAnd,
+				Value:    valueClause().Value,
+				Operands: []Clause{valueClause()},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateClause(test.clause)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateInvertedIndex(t *testing.T) {
+	vFalse := false
+	sch := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+						{Name: "notes", DataType: []string{"text"}, IndexSearchable: &vFalse},
+						{Name: "madeBy", DataType: []string{"Manufacturer"}},
+					},
+				},
+				{
+					Class: "Manufacturer",
+					Properties: []*models.Property{
+						{Name: "revenue", DataType: []string{"int"}, IndexFilterable: &vFalse},
+					},
+				},
+			},
+		},
+	}
+
+	clause := func(path *Path) Clause {
+		return Clause{
+			Operator: OperatorEqual,
+			On:       path,
+			Value:    &Value{Value: "foo", Type: schema.DataTypeString},
+		}
+	}
+
+	indexed := clause(&Path{Class: "Car", Property: "name"})
+	notIndexed := clause(&Path{Class: "Car", Property: "notes"})
+	notIndexedChild := clause(&Path{Class: "Car", Property: "madeBy",
+		Child: &Path{Class: "Manufacturer", Property: "revenue"}})
+	unknown := clause(&Path{Class: "Car", Property: "doesNotExist"})
+
+	tests := []struct {
+		name        string
+		filter      *LocalFilter
+		expectedErr string
+	}{
+		{
+			name:   "without a filter",
+			filter: nil,
+		},
+		{
+			name:   "on an indexed property",
+			filter: &LocalFilter{Root: &indexed},
+		},
+		{
+			name:   "on a property which does not exist",
+			filter: &LocalFilter{Root: &unknown},
+		},
+		{
+			name:   "on a property without an index",
+			filter: &LocalFilter{Root: &notIndexed},
+			expectedErr: "property 'notes' of class 'Car' has no inverted index and " +
+				"cannot be used in a filter, it is turned off by indexInverted, " +
+				"indexFilterable or indexSearchable",
+		},
+		{
+			name: "on a property without an index in a nested operand",
+			filter: &LocalFilter{Root: &Clause{
+				Operator: OperatorAnd,
+				Operands: []Clause{indexed, notIndexed},
+			}},
+			expectedErr: "property 'notes' of class 'Car' has no inverted index",
+		},
+		{
+			name:        "on a property without an index behind a reference",
+			filter:      &LocalFilter{Root: &notIndexedChild},
+			expectedErr: "property 'revenue' of class 'Manufacturer' has no inverted index",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateInvertedIndex(sch, test.filter)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), test.expectedErr)
+			}
+		})
+	}
+}
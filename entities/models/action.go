@@ -49,6 +49,9 @@ type Action struct {
 	// Timestamp of creation of this Action in milliseconds since epoch UTC.
 	CreationTimeUnix int64 `json:"creationTimeUnix,omitempty"`
 
+	// Timestamp in milliseconds since epoch UTC after which this Action expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.
+	ExpiryTimeUnix int64 `json:"expiryTimeUnix,omitempty"`
+
 	// ID of the Action.
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
@@ -64,6 +67,9 @@ type Action struct {
 
 	// vector weights
 	VectorWeights VectorWeights `json:"vectorWeights,omitempty"`
+
+	// Version of this Action, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Action has not been changed in the meantime. Ignored in request bodies.
+	Version int64 `json:"version,omitempty"`
 }
 
 // Validate validates this action
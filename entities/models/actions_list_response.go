@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ActionsListResponse List of Actions.
@@ -35,14 +36,25 @@ type ActionsListResponse struct {
 	// deprecations
 	Deprecations []*Deprecation `json:"deprecations"`
 
-	// The total number of Actions for the query. The number of items in a response may be smaller due to paging.
-	TotalResults int64 `json:"totalResults,omitempty"`
+	// The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.
+	Limit int64 `json:"limit,omitempty"`
+
+	// Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.
+	// Format: uuid
+	NextCursor strfmt.UUID `json:"nextCursor,omitempty"`
+
+	// The total number of Actions matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.
+	TotalResults *int64 `json:"totalResults,omitempty"`
 }
 
 // Validate validates this actions list response
 func (m *ActionsListResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNextCursor(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateActions(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +69,19 @@ func (m *ActionsListResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ActionsListResponse) validateNextCursor(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextCursor) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nextCursor", "body", "uuid", m.NextCursor.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ActionsListResponse) validateActions(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Actions) { // not required
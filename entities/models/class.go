@@ -35,12 +35,27 @@ type Class struct {
 	// Description of the class.
 	Description string `json:"description,omitempty"`
 
+	// A frozen class is read-only, its objects and its schema can not be changed. Use the frozen endpoints of the class to change this.
+	Frozen bool `json:"frozen,omitempty"`
+
+	// Name of a string, text or int property which identifies the objects of this class. Objects created without an id get a deterministic id derived from the class name and the value of this property (UUIDv5), so creating an object with the same key twice targets the same id. The property must be set on every object.
+	KeyProperty string `json:"keyProperty,omitempty"`
+
 	// keywords
 	Keywords Keywords `json:"keywords,omitempty"`
 
+	// Free-form metadata of the class. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.
+	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// text normalization
+	TextNormalization *TextNormalization `json:"textNormalization,omitempty"`
+
+	// vector index config
+	VectorIndexConfig *VectorIndexConfig `json:"vectorIndexConfig,omitempty"`
+
 	// Set this to true if the object vector should include the class name in calculating the overall vector position
 	VectorizeClassName *bool `json:"vectorizeClassName,omitempty"`
 }
@@ -57,6 +72,14 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTextNormalization(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorIndexConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +127,42 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateTextNormalization(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TextNormalization) { // not required
+		return nil
+	}
+
+	if m.TextNormalization != nil {
+		if err := m.TextNormalization.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("textNormalization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateVectorIndexConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VectorIndexConfig) { // not required
+		return nil
+	}
+
+	if m.VectorIndexConfig != nil {
+		if err := m.VectorIndexConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vectorIndexConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -29,6 +29,9 @@ import (
 // swagger:model Interpretation
 type Interpretation struct {
 
+	// The concepts of the source which contributed most to matching the search, only set on vector searches
+	Contributions []*InterpretationContribution `json:"contributions"`
+
 	// The input that was used to vectorize this object
 	Source []*InterpretationSource `json:"source"`
 }
@@ -37,6 +40,10 @@ type Interpretation struct {
 func (m *Interpretation) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateContributions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSource(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +54,31 @@ func (m *Interpretation) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Interpretation) validateContributions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Contributions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Contributions); i++ {
+		if swag.IsZero(m.Contributions[i]) { // not required
+			continue
+		}
+
+		if m.Contributions[i] != nil {
+			if err := m.Contributions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("contributions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Interpretation) validateSource(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Source) { // not required
@@ -17,6 +17,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -26,6 +27,9 @@ import (
 // swagger:model Meta
 type Meta struct {
 
+	// Statistics of the local word vector cache in front of the contextionary
+	ContextionaryCache *ContextionaryCacheStats `json:"contextionaryCache,omitempty"`
+
 	// Version of the contextionary service connected to weaviate
 	ContextionaryVersion string `json:"contextionaryVersion,omitempty"`
 
@@ -41,6 +45,33 @@ type Meta struct {
 
 // Validate validates this meta
 func (m *Meta) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateContextionaryCache(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Meta) validateContextionaryCache(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ContextionaryCache) { // not required
+		return nil
+	}
+
+	if m.ContextionaryCache != nil {
+		if err := m.ContextionaryCache.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("contextionaryCache")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -17,9 +17,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Property property
@@ -39,12 +42,52 @@ type Property struct {
 	// Optional. By default each property is fully indexed both for full-text, as well as vector-search. You can ignore properties in searches by explicitly setting index to false. Not set is the same as true
 	Index *bool `json:"index,omitempty"`
 
+	// Optional. Set to false to skip the inverted index which is used to filter by the value of an int, number, boolean, date or reference property. Not supported for string and text properties, see indexSearchable. Not set is the same as true.
+	IndexFilterable *bool `json:"indexFilterable,omitempty"`
+
+	// Optional. Set to false to skip building the inverted index for this property. The property can then no longer be used in where filters, but it is still stored and vectorized. Not set is the same as true.
+	IndexInverted *bool `json:"indexInverted,omitempty"`
+
+	// Optional. Set to false to skip the inverted index of the words of a string or text property, which is used to filter by its value. Only supported for string and text properties. Not set is the same as true.
+	IndexSearchable *bool `json:"indexSearchable,omitempty"`
+
 	// keywords
 	Keywords Keywords `json:"keywords,omitempty"`
 
+	// Optional. The maximum number of characters of a string or text property.
+	MaxLength *int64 `json:"maxLength,omitempty"`
+
+	// Optional. The maximum number of references a reference property can hold on a single object. Not set means unlimited.
+	MaxReferences *int64 `json:"maxReferences,omitempty"`
+
+	// Optional. The largest value allowed for an int or number property.
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	// Optional. The minimum number of characters of a string or text property.
+	MinLength *int64 `json:"minLength,omitempty"`
+
+	// Optional. The smallest value allowed for an int or number property.
+	Minimum *float64 `json:"minimum,omitempty"`
+
+	// Free-form metadata of the property. It is stored with the schema and returned unchanged, but not interpreted by Weaviate.
+	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
+
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
+	// Optional. A regular expression (RE2 syntax) which every value of a string or text property must match. Use ^ and $ to match the whole value.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Optional. Controls how the value of a string or text property is split into words before it is vectorized. 'word' (default) splits at every non-alphanumeric character, 'whitespace' only splits at whitespace and keeps the rest of a word together, 'field' passes the whole value on as a single lowercase phrase, so that it is vectorized as one concept if the contextionary knows it as a compound word, e.g. 'New York' or a concept added through an extension, which is useful for ids or categories. The setting only applies to the vectorization, it does not change how filters match the value.
+	// Enum: [word whitespace field]
+	Tokenization string `json:"tokenization,omitempty"`
+
+	// Optional. If set to true, no two objects of the class may have the same value for this property. Only supported for the data types string, text, int, number and date. Defaults to false.
+	Unique bool `json:"unique,omitempty"`
+
+	// Optional. Relative weight of this property when the property vectors are combined into the object vector, must not be negative. A weight of 0 excludes the property from vectorization. Not set is the same as 1.
+	VectorWeight *float32 `json:"vectorWeight,omitempty"`
+
 	// Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.
 	VectorizePropertyName bool `json:"vectorizePropertyName,omitempty"`
 }
@@ -57,6 +100,10 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTokenization(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -79,6 +126,52 @@ func (m *Property) validateKeywords(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeTokenizationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["word","whitespace","field"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeTokenizationPropEnum = append(propertyTypeTokenizationPropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyTokenizationWord captures enum value "word"
+	PropertyTokenizationWord string = "word"
+
+	// PropertyTokenizationWhitespace captures enum value "whitespace"
+	PropertyTokenizationWhitespace string = "whitespace"
+
+	// PropertyTokenizationField captures enum value "field"
+	PropertyTokenizationField string = "field"
+)
+
+// prop value enum
+func (m *Property) validateTokenizationEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeTokenizationPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateTokenization(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tokenization) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTokenizationEnum("tokenization", "body", m.Tokenization); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Property) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -49,6 +49,9 @@ type Thing struct {
 	// Timestamp of creation of this Thing in milliseconds since epoch UTC.
 	CreationTimeUnix int64 `json:"creationTimeUnix,omitempty"`
 
+	// Timestamp in milliseconds since epoch UTC after which this Thing expires. Expired objects are no longer returned and are eventually deleted. Not set for objects which never expire.
+	ExpiryTimeUnix int64 `json:"expiryTimeUnix,omitempty"`
+
 	// ID of the Thing.
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
@@ -64,6 +67,9 @@ type Thing struct {
 
 	// vector weights
 	VectorWeights VectorWeights `json:"vectorWeights,omitempty"`
+
+	// Version of this Thing, incremented on every change. Pass it in the If-Match header of an update to only apply the update if the Thing has not been changed in the meantime. Ignored in request bodies.
+	Version int64 `json:"version,omitempty"`
 }
 
 // Validate validates this thing
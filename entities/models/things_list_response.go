@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ThingsListResponse List of Things.
@@ -32,11 +33,18 @@ type ThingsListResponse struct {
 	// deprecations
 	Deprecations []*Deprecation `json:"deprecations"`
 
+	// The limit that was effectively applied to the query. This is the default limit if none was requested, or the maximum limit if a larger one was requested.
+	Limit int64 `json:"limit,omitempty"`
+
+	// Only set when iterating with a cursor. The id to pass as 'after' to request the next page. Empty once all objects were listed.
+	// Format: uuid
+	NextCursor strfmt.UUID `json:"nextCursor,omitempty"`
+
 	// The actual list of Things.
 	Things []*Thing `json:"things"`
 
-	// The total number of Things for the query. The number of items in a response may be smaller due to paging.
-	TotalResults int64 `json:"totalResults,omitempty"`
+	// The total number of Things matching the class and where filter of the query. The number of items in a response may be smaller due to paging. Omitted if includeTotal is set to false.
+	TotalResults *int64 `json:"totalResults,omitempty"`
 }
 
 // Validate validates this things list response
@@ -47,6 +55,10 @@ func (m *ThingsListResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNextCursor(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateThings(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +94,19 @@ func (m *ThingsListResponse) validateDeprecations(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ThingsListResponse) validateNextCursor(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextCursor) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nextCursor", "body", "uuid", m.NextCursor.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ThingsListResponse) validateThings(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Things) { // not required
@@ -27,9 +27,15 @@ import (
 // swagger:model UnderscoreProperties
 type UnderscoreProperties struct {
 
+	// The certainty of the match between the search vector and the result, which is 1 minus the distance. This is the value the certainty argument of a search is compared against. Only on 'explore' searches
+	Certainty *float32 `json:"certainty,omitempty"`
+
 	// If this object was subject of a classificiation, additional meta info about this classification is available here
 	Classification *UnderscorePropertiesClassification `json:"classification,omitempty"`
 
+	// The normalized distance between the search vector and the result, between 0 (identical vectors) and 1 (perfect opposite). Results are ranked by this distance. Only on 'explore' searches
+	Distance *float32 `json:"distance,omitempty"`
+
 	// The concepts vector projected into a lower dimensional space (for visualization purposes)
 	FeatureProjection *FeatureProjection `json:"featureProjection,omitempty"`
 
@@ -34,8 +34,8 @@ type WhereFilter struct {
 	// combine multiple where filters, requires 'And' or 'Or' operator
 	Operands []*WhereFilter `json:"operands"`
 
-	// operator to use
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange]
+	// operator to use. IsNull and IsNotNull match objects without or with a value for the property at the path, they require no value. An empty string, a string of only whitespace and a text without any words count as null.
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull IsNotNull]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -114,7 +114,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","IsNotNull"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -156,6 +156,12 @@ const (
 
 	// WhereFilterOperatorWithinGeoRange captures enum value "WithinGeoRange"
 	WhereFilterOperatorWithinGeoRange string = "WithinGeoRange"
+
+	// WhereFilterOperatorIsNull captures enum value "IsNull"
+	WhereFilterOperatorIsNull string = "IsNull"
+
+	// WhereFilterOperatorIsNotNull captures enum value "IsNotNull"
+	WhereFilterOperatorIsNotNull string = "IsNotNull"
 )
 
 // prop value enum
@@ -125,6 +125,8 @@ func GetValueDataTypeFromString(dt string) (*DataType, error) {
 			returnDataType = DataTypeGeoCoordinates
 		} else if dt == string(DataTypePhoneNumber) {
 			returnDataType = DataTypePhoneNumber
+		} else if dt == string(DataTypeBlob) {
+			returnDataType = DataTypeBlob
 		}
 	} else {
 		return nil, errors_.New(ErrorNoSuchDatatype)
@@ -144,7 +146,8 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeBoolean),
 		string(DataTypeDate),
 		string(DataTypeGeoCoordinates),
-		string(DataTypePhoneNumber):
+		string(DataTypePhoneNumber),
+		string(DataTypeBlob):
 		return true
 	}
 	return false
@@ -35,14 +35,18 @@ type Ref struct {
 }
 
 // Parse is a safe way to generate a Ref, as it will error if any of the input
-// parameters are not as expected.
+// parameters are not as expected. Parse is tolerant of common variations in
+// hand-crafted beacons: surrounding whitespace, trailing slashes and the
+// casing of the scheme, localhost, the kind and the uuid are all normalized.
+// Use ParseStrict if the beacon must be in the exact format produced by
+// Ref.String().
 func Parse(uriString string) (*Ref, error) {
-	uri, err := url.Parse(uriString)
+	uri, err := url.Parse(strings.TrimSpace(uriString))
 	if err != nil {
 		return nil, fmt.Errorf("invalid cref URI: %s", err)
 	}
 
-	pathSegments := strings.Split(uri.Path, "/")
+	pathSegments := strings.Split(strings.TrimRight(uri.Path, "/"), "/")
 	if len(pathSegments) != 3 {
 		return nil, fmt.Errorf(
 			"invalid cref URI: path must be of format '/{things,actions}/<uuid>', but got '%s'",
@@ -54,19 +58,42 @@ func Parse(uriString string) (*Ref, error) {
 			pathSegments[2])
 	}
 
-	k, err := parseKind(pathSegments[1])
+	k, err := parseKind(strings.ToLower(pathSegments[1]))
 	if err != nil {
 		return nil, fmt.Errorf("invalid cref URI: %s", err)
 	}
 
+	peerName := uri.Host
+	local := strings.EqualFold(peerName, "localhost")
+	if local {
+		peerName = "localhost"
+	}
+
 	return &Ref{
-		Local:    (uri.Host == "localhost"),
-		PeerName: uri.Host,
-		TargetID: strfmt.UUID(pathSegments[2]),
+		Local:    local,
+		PeerName: peerName,
+		TargetID: strfmt.UUID(strings.ToLower(pathSegments[2])),
 		Kind:     k,
 	}, nil
 }
 
+// ParseStrict only accepts beacons in the exact format produced by
+// Ref.String(), such as weaviate://localhost/things/<uuid>. Any variation
+// that Parse would silently normalize leads to an error instead.
+func ParseStrict(uriString string) (*Ref, error) {
+	ref, err := Parse(uriString)
+	if err != nil {
+		return nil, err
+	}
+
+	if canonical := ref.String(); canonical != uriString {
+		return nil, fmt.Errorf("invalid cref URI: must be exactly '%s', but got '%s'",
+			canonical, uriString)
+	}
+
+	return ref, nil
+}
+
 // ParseSingleRef is a safe way to generate a Ref from a models.SingleRef, a
 // helper construct that represents the API structure. It will error if any of
 // the input parameters are not as expected.
@@ -130,6 +130,113 @@ func Test_ParsingFromString(t *testing.T) {
 	})
 }
 
+func Test_ParsingTolerantForms(t *testing.T) {
+	type testCase struct {
+		name          string
+		uri           string
+		expectedLocal bool
+		expectedPeer  string
+		expectedKind  kind.Kind
+	}
+
+	tests := []testCase{
+		{
+			name:          "with a trailing slash",
+			uri:           "weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d/",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Thing,
+		},
+		{
+			name:          "with an uppercase scheme",
+			uri:           "WEAVIATE://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Thing,
+		},
+		{
+			name:          "with mixed kind casing",
+			uri:           "weaviate://localhost/Actions/c2cd3f91-0160-477e-869a-8da8829e0a4d",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Action,
+		},
+		{
+			name:          "with an uppercase localhost",
+			uri:           "weaviate://LocalHost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Thing,
+		},
+		{
+			name:          "with an uppercase uuid",
+			uri:           "weaviate://localhost/things/C2CD3F91-0160-477E-869A-8DA8829E0A4D",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Thing,
+		},
+		{
+			name:          "with surrounding whitespace",
+			uri:           "  weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d\n",
+			expectedLocal: true,
+			expectedPeer:  "localhost",
+			expectedKind:  kind.Thing,
+		},
+		{
+			name:          "with a network ref and all variations combined",
+			uri:           "Weaviate://another-weaviate/THINGS/C2CD3F91-0160-477E-869A-8DA8829E0A4D//",
+			expectedLocal: false,
+			expectedPeer:  "another-weaviate",
+			expectedKind:  kind.Thing,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ref, err := Parse(test.uri)
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedLocal, ref.Local)
+			assert.Equal(t, test.expectedPeer, ref.PeerName)
+			assert.Equal(t, test.expectedKind, ref.Kind)
+			assert.Equal(t, strfmt.UUID("c2cd3f91-0160-477e-869a-8da8829e0a4d"), ref.TargetID)
+
+			t.Run("is rejected by the strict parser", func(t *testing.T) {
+				_, err := ParseStrict(test.uri)
+				assert.NotNil(t, err)
+			})
+		})
+	}
+}
+
+func Test_ParsingStrict(t *testing.T) {
+	t.Run("with a well-formed ref", func(t *testing.T) {
+		uri := "weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d"
+		ref, err := ParseStrict(uri)
+		require.Nil(t, err)
+		assert.Equal(t, uri, ref.String())
+	})
+
+	t.Run("with a trailing slash", func(t *testing.T) {
+		uri := "weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d/"
+		_, err := ParseStrict(uri)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(),
+			"must be exactly 'weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d'")
+	})
+
+	t.Run("with an invalid kind", func(t *testing.T) {
+		uri := "weaviate://localhost/humans/c2cd3f91-0160-477e-869a-8da8829e0a4d"
+		_, err := ParseStrict(uri)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a different scheme", func(t *testing.T) {
+		uri := "http://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d"
+		_, err := ParseStrict(uri)
+		assert.NotNil(t, err)
+	})
+}
+
 func Test_ParsingFromSingleRef(t *testing.T) {
 	t.Run("from a local thing ref that is well-formed", func(t *testing.T) {
 		uri := strfmt.URI("weaviate://localhost/things/c2cd3f91-0160-477e-869a-8da8829e0a4d")
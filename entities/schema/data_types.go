@@ -39,9 +39,12 @@ const (
 	DataTypeGeoCoordinates DataType = "geoCoordinates"
 	// DataTypePhoneNumber represents a parsed/to-be-parsed phone number
 	DataTypePhoneNumber DataType = "phoneNumber"
+	// DataTypeBlob is a base64 encoded binary value, it is neither vectorized
+	// nor indexed
+	DataTypeBlob DataType = "blob"
 )
 
-var PrimitiveDataTypes []DataType = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeGeoCoordinates, DataTypePhoneNumber}
+var PrimitiveDataTypes []DataType = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeGeoCoordinates, DataTypePhoneNumber, DataTypeBlob}
 
 type PropertyKind int
 
@@ -124,7 +127,7 @@ func (s *Schema) FindPropertyDataType(dataType []string) (PropertyDataType, erro
 			case string(DataTypeString), string(DataTypeText),
 				string(DataTypeInt), string(DataTypeNumber),
 				string(DataTypeBoolean), string(DataTypeDate), string(DataTypeGeoCoordinates),
-				string(DataTypePhoneNumber):
+				string(DataTypePhoneNumber), string(DataTypeBlob):
 				return &propertyDataType{
 					kind:          PropertyKindPrimitive,
 					primitiveType: DataType(someDataType),
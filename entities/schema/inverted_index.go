@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import "github.com/semi-technologies/weaviate/entities/models"
+
+// IsWordIndexed is true for the data types whose values are split into
+// words before they are added to the inverted index
+func IsWordIndexed(dt []string) bool {
+	if len(dt) != 1 {
+		return false
+	}
+
+	return dt[0] == string(DataTypeString) || dt[0] == string(DataTypeText)
+}
+
+// HasInvertedIndex reports whether an inverted index is built for the
+// property, which is required to filter by it. indexInverted turns it off for
+// every data type, indexSearchable only for string and text properties and
+// indexFilterable only for all others. Flags which are not set count as true.
+func HasInvertedIndex(prop *models.Property) bool {
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return false
+	}
+
+	if IsWordIndexed(prop.DataType) {
+		return prop.IndexSearchable == nil || *prop.IndexSearchable
+	}
+
+	return prop.IndexFilterable == nil || *prop.IndexFilterable
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasInvertedIndex(t *testing.T) {
+	vTrue := true
+	vFalse := false
+
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		expected bool
+	}{
+		{
+			name:     "nothing set",
+			prop:     &models.Property{DataType: []string{"text"}},
+			expected: true,
+		},
+		{
+			name:     "indexInverted off",
+			prop:     &models.Property{DataType: []string{"int"}, IndexInverted: &vFalse},
+			expected: false,
+		},
+		{
+			name: "indexInverted off wins over the specific flags",
+			prop: &models.Property{DataType: []string{"string"}, IndexInverted: &vFalse,
+				IndexSearchable: &vTrue},
+			expected: false,
+		},
+		{
+			name:     "indexSearchable off on text",
+			prop:     &models.Property{DataType: []string{"text"}, IndexSearchable: &vFalse},
+			expected: false,
+		},
+		{
+			name:     "indexFilterable off on text",
+			prop:     &models.Property{DataType: []string{"text"}, IndexFilterable: &vFalse},
+			expected: true,
+		},
+		{
+			name:     "indexFilterable off on date",
+			prop:     &models.Property{DataType: []string{"date"}, IndexFilterable: &vFalse},
+			expected: false,
+		},
+		{
+			name:     "indexFilterable off on a reference",
+			prop:     &models.Property{DataType: []string{"City"}, IndexFilterable: &vFalse},
+			expected: false,
+		},
+		{
+			name:     "indexSearchable off on int",
+			prop:     &models.Property{DataType: []string{"int"}, IndexSearchable: &vFalse},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, HasInvertedIndex(test.prop))
+		})
+	}
+}
@@ -30,9 +30,12 @@ type Result struct {
 	Vector               []float32
 	Beacon               string
 	Certainty            float32
+	Distance             float32
 	Schema               models.PropertySchema
 	Created              int64
 	Updated              int64
+	Expiry               int64
+	Version              int64
 	UnderscoreProperties *models.UnderscoreProperties
 	VectorWeights        map[string]string
 }
@@ -51,6 +54,8 @@ func (r Result) Thing() *models.Thing {
 		Schema:             schema,
 		CreationTimeUnix:   r.Created,
 		LastUpdateTimeUnix: r.Updated,
+		ExpiryTimeUnix:     r.Expiry,
+		Version:            r.Version,
 		Meta:               r.UnderscoreProperties,
 		VectorWeights:      r.VectorWeights,
 	}
@@ -84,6 +89,8 @@ func (r Result) Action() *models.Action {
 		Schema:             schema,
 		CreationTimeUnix:   r.Created,
 		LastUpdateTimeUnix: r.Updated,
+		ExpiryTimeUnix:     r.Expiry,
+		Version:            r.Version,
 		Meta:               r.UnderscoreProperties,
 		VectorWeights:      r.VectorWeights,
 	}
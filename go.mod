@@ -43,6 +43,7 @@ require (
 	go.mongodb.org/mongo-driver v1.4.1 // indirect
 	go.uber.org/atomic v1.5.0
 	golang.org/x/net v0.0.0-20200822124328-c89045814202
+	golang.org/x/text v0.3.3
 	golang.org/x/tools v0.0.0-20200925191224-5d1fdd8fa346 // indirect
 	gonum.org/v1/gonum v0.7.0
 	google.golang.org/grpc v1.24.0
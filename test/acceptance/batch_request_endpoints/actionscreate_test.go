@@ -59,8 +59,8 @@ func TestBatchActionsCreateResultsOrder(t *testing.T) {
 	})
 
 	// perform the request
-	resp, err := helper.BatchingClient(t).BatchingActionsCreate(params, nil)
-	// ensure that the response is OK
+	_, resp, err := helper.BatchingClient(t).BatchingActionsCreate(params, nil)
+	// both actions fail validation, so the response is a multi-status
 	helper.AssertRequestOk(t, resp, err, func() {
 
 		actionsCreateResponse := resp.Payload
@@ -56,9 +56,9 @@ func TestBatchThingsCreateResultsOrder(t *testing.T) {
 	})
 
 	// perform the request
-	resp, err := helper.BatchingClient(t).BatchingThingsCreate(params, nil)
+	_, resp, err := helper.BatchingClient(t).BatchingThingsCreate(params, nil)
 
-	// ensure that the response is OK
+	// both things fail validation, so the response is a multi-status
 	helper.AssertRequestOk(t, resp, err, func() {
 		thingsCreateResponse := resp.Payload
 
@@ -91,13 +91,29 @@ func creatingThings(t *testing.T) {
 
 		resp, err = helper.Client(t).Things.ThingsCreate(params, nil)
 		helper.AssertRequestFail(t, resp, err, func() {
-			errResponse, ok := err.(*things.ThingsCreateUnprocessableEntity)
+			errResponse, ok := err.(*things.ThingsCreateConflict)
 			if !ok {
-				t.Fatalf("Did not get not found response, but %#v", err)
+				t.Fatalf("Did not get conflict response, but %#v", err)
 			}
 
 			assert.Equal(t, fmt.Sprintf("id '%s' already exists", id), errResponse.Payload.Error[0].Message)
 		})
+
+		// With ifNotExists the existing thing is returned instead
+		params = things.NewThingsCreateParams().WithBody(
+			&models.Thing{
+				ID:    id,
+				Class: "TestThing",
+				Schema: map[string]interface{}{
+					"testString": "some other value",
+				},
+			}).WithIfNotExists(ptBool(true))
+
+		resp, err = helper.Client(t).Things.ThingsCreate(params, nil)
+		helper.AssertRequestOk(t, resp, err, func() {
+			assert.Equal(t, id, resp.Payload.ID)
+			assert.Equal(t, thingTestString, resp.Payload.Schema.(map[string]interface{})["testString"])
+		})
 	})
 
 	// Check if we can create a Thing, and that it's properties are stored correctly.
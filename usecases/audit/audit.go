@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package audit records who changed which thing or action. The kinds manager
+// writes an Entry for every create, update, delete and reference change,
+// including the failed ones, to a Sink.
+package audit
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// Operation is the type of change which was attempted
+type Operation string
+
+const (
+	OperationCreate           Operation = "create"
+	OperationUpdate           Operation = "update"
+	OperationMerge            Operation = "merge"
+	OperationDelete           Operation = "delete"
+	OperationAddReference     Operation = "add_reference"
+	OperationUpdateReferences Operation = "update_references"
+	OperationDeleteReference  Operation = "delete_reference"
+	OperationExpire           Operation = "expire"
+	OperationTruncate         Operation = "truncate"
+	OperationRestore          Operation = "restore"
+)
+
+// AnonymousUsername is recorded if the request was not authenticated
+const AnonymousUsername = "anonymous"
+
+// Entry describes a single attempted change of a thing or action
+type Entry struct {
+	// Timestamp of the change in ms since epoch
+	Timestamp int64 `json:"timestamp"`
+
+	Username  string      `json:"username"`
+	Groups    []string    `json:"groups,omitempty"`
+	Operation Operation   `json:"operation"`
+	Kind      kind.Kind   `json:"kind"`
+	Class     string      `json:"class,omitempty"`
+	ID        strfmt.UUID `json:"id,omitempty"`
+
+	// RequestID is the id of the HTTP request which attempted the change
+	RequestID string `json:"requestId,omitempty"`
+
+	// Error is set if the change failed, the change was not applied then
+	Error string `json:"error,omitempty"`
+}
+
+// NewEntry for the specified principal, a nil principal is recorded as
+// anonymous
+func NewEntry(principal *models.Principal, op Operation, k kind.Kind,
+	className string, id strfmt.UUID, err error) Entry {
+	entry := Entry{
+		Username:  AnonymousUsername,
+		Operation: op,
+		Kind:      k,
+		Class:     className,
+		ID:        id,
+	}
+
+	if principal != nil {
+		entry.Username = principal.Username
+		entry.Groups = principal.Groups
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	return entry
+}
+
+// Sink persists audit entries. Write is called synchronously as part of the
+// audited request, so it should be fast.
+type Sink interface {
+	Write(entry Entry) error
+}
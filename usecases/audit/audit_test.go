@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntry(t *testing.T) {
+	t.Run("with a principal and an error", func(t *testing.T) {
+		entry := NewEntry(&models.Principal{Username: "jane", Groups: []string{"editors"}},
+			OperationCreate, kind.Thing, "Foo", "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			errors.New("oops"))
+
+		assert.Equal(t, Entry{
+			Username:  "jane",
+			Groups:    []string{"editors"},
+			Operation: OperationCreate,
+			Kind:      kind.Thing,
+			Class:     "Foo",
+			ID:        "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			Error:     "oops",
+		}, entry)
+	})
+
+	t.Run("without a principal", func(t *testing.T) {
+		entry := NewEntry(nil, OperationDelete, kind.Action, "Bar", "", nil)
+
+		assert.Equal(t, AnonymousUsername, entry.Username)
+		assert.Equal(t, "", entry.Error)
+	})
+}
+
+func TestLoggerSink(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	sink := NewLoggerSink(logger)
+
+	err := sink.Write(Entry{Username: "jane", Operation: OperationDelete, Kind: kind.Thing,
+		Class: "Foo", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc", Error: "oops"})
+
+	require.Nil(t, err)
+	require.Len(t, hook.AllEntries(), 1)
+	logged := hook.LastEntry()
+	assert.Equal(t, "audit", logged.Data["action"])
+	assert.Equal(t, "jane", logged.Data["username"])
+	assert.Equal(t, "oops", logged.Data["error"])
+	assert.Equal(t, logrus.WarnLevel, logged.Level)
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	sink, err := NewFileSink(path)
+	require.Nil(t, err)
+
+	first := Entry{Timestamp: 1, Username: "jane", Operation: OperationCreate,
+		Kind: kind.Thing, Class: "Foo", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"}
+	second := Entry{Timestamp: 2, Username: "jane", Operation: OperationDelete,
+		Kind: kind.Thing, Class: "Foo", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"}
+	require.Nil(t, sink.Write(first))
+	require.Nil(t, sink.Write(second))
+	require.Nil(t, sink.Close())
+
+	contents, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	require.Len(t, lines, 2)
+
+	var parsed Entry
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &parsed))
+	assert.Equal(t, second, parsed)
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LoggerSink writes every entry as a log line with the field action=audit
+// to the regular logger, so the entries can be separated from all other logs
+// downstream
+type LoggerSink struct {
+	logger logrus.FieldLogger
+}
+
+func NewLoggerSink(logger logrus.FieldLogger) *LoggerSink {
+	return &LoggerSink{logger: logger}
+}
+
+func (s *LoggerSink) Write(entry Entry) error {
+	l := s.logger.WithField("action", "audit").
+		WithField("timestamp_ms", entry.Timestamp).
+		WithField("username", entry.Username).
+		WithField("groups", entry.Groups).
+		WithField("operation", entry.Operation).
+		WithField("kind", entry.Kind).
+		WithField("class", entry.Class).
+		WithField("id", entry.ID)
+
+	if entry.Error != "" {
+		l.WithField("error", entry.Error).
+			Warnf("%s of %s %s failed", entry.Operation, entry.Kind, entry.ID)
+		return nil
+	}
+
+	l.Infof("%s of %s %s", entry.Operation, entry.Kind, entry.ID)
+	return nil
+}
+
+// FileSink appends every entry as a single line of JSON to a file
+type FileSink struct {
+	sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens or creates the file at path for appending
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log file: %v", err)
+	}
+
+	return &FileSink{file: f}, nil
+}
+
+func (s *FileSink) Write(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %v", err)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write audit entry: %v", err)
+	}
+
+	return nil
+}
+
+// Close the underlying file
+func (s *FileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.file.Close()
+}
@@ -40,6 +40,8 @@ type Classifier struct {
 	distancer    distancer
 	vectorizer   vectorizer
 	logger       logrus.FieldLogger
+
+	objectVectorizer objectVectorizer
 }
 
 type vectorizer interface {
@@ -50,20 +52,30 @@ type vectorizer interface {
 	VectorForCorpi(ctx context.Context, corpi []string, overrides map[string]string) ([]float32, []libvectorizer.InputElement, error)
 }
 
+// objectVectorizer vectorizes objects the same way they are vectorized on
+// import, see vectorizer.Module
+type objectVectorizer interface {
+	Thing(ctx context.Context, object *models.Thing) ([]float32, []libvectorizer.InputElement, error)
+	Action(ctx context.Context, object *models.Action) ([]float32, []libvectorizer.InputElement, error)
+	Corpi(ctx context.Context, corpi []string) ([]float32, error)
+}
+
 type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
 func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizer,
-	vectorizer vectorizer, logger logrus.FieldLogger) *Classifier {
+	vectorizer vectorizer, objectVectorizer objectVectorizer,
+	logger logrus.FieldLogger) *Classifier {
 	return &Classifier{
-		logger:       logger,
-		schemaGetter: sg,
-		repo:         cr,
-		vectorRepo:   vr,
-		authorizer:   authorizer,
-		distancer:    libvectorizer.NormalizedDistance,
-		vectorizer:   vectorizer,
+		logger:           logger,
+		schemaGetter:     sg,
+		repo:             cr,
+		vectorRepo:       vr,
+		authorizer:       authorizer,
+		distancer:        libvectorizer.NormalizedDistance,
+		vectorizer:       vectorizer,
+		objectVectorizer: objectVectorizer,
 	}
 }
 
@@ -72,6 +84,9 @@ func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizer
 type Repo interface {
 	Put(ctx context.Context, classification models.Classification) error
 	Get(ctx context.Context, id strfmt.UUID) (*models.Classification, error)
+
+	// Watch notifies about changes of the classification until ctx expires
+	Watch(ctx context.Context, id strfmt.UUID) <-chan struct{}
 }
 
 type VectorRepo interface {
@@ -87,6 +102,10 @@ type vectorRepo interface {
 	VectorRepo
 	PutThing(ctx context.Context, thing *models.Thing, vector []float32) error
 	PutAction(ctx context.Context, action *models.Action, vector []float32) error
+	ThingByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties,
+		underscore traverser.UnderscoreProperties) (*search.Result, error)
+	ActionByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties,
+		underscore traverser.UnderscoreProperties) (*search.Result, error)
 }
 
 // NeighborRef is the result of an aggregation of the ref properties of k neighbors
@@ -197,6 +216,51 @@ func (c *Classifier) Get(ctx context.Context, principal *models.Principal, id st
 	return c.repo.Get(ctx, id)
 }
 
+// MaxWaitTimeout limits how long WaitForCompletion blocks
+const MaxWaitTimeout = 5 * time.Minute
+
+// WaitForCompletion blocks until the classification is no longer running,
+// the timeout (capped at MaxWaitTimeout) has passed or ctx is cancelled. The
+// classification is returned in its latest known state in any case, so a
+// returned status of running indicates that the wait ended early.
+func (c *Classifier) WaitForCompletion(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, timeout time.Duration) (*models.Classification, error) {
+	err := c.authorizer.Authorize(principal, "get", "classifications/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if timeout > MaxWaitTimeout {
+		timeout = MaxWaitTimeout
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// subscribe before the first read, so that no change can be missed
+	changes := c.repo.Watch(waitCtx, id)
+
+	for {
+		res, err := c.repo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if res == nil || res.Status != models.ClassificationStatusRunning {
+			return res, nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return res, nil
+		case _, ok := <-changes:
+			if !ok {
+				return res, nil
+			}
+		}
+	}
+}
+
 func (c *Classifier) setDefaultValuesForOptionalFields(params *models.Classification) {
 	if params.Type == nil {
 		defaultType := "knn"
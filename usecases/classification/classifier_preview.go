@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+const (
+	defaultPreviewK = 3
+	// maxPreviewK limits the size of the neighbor search of a single preview
+	maxPreviewK = 100
+)
+
+// ErrInvalidPreview indicates that the preview params can't be used, as
+// opposed to an error while predicting
+type ErrInvalidPreview struct {
+	msg string
+}
+
+func (e ErrInvalidPreview) Error() string {
+	return e.msg
+}
+
+func newErrInvalidPreview(format string, args ...interface{}) ErrInvalidPreview {
+	return ErrInvalidPreview{msg: fmt.Sprintf(format, args...)}
+}
+
+// Preview predicts the reference targets of a single object with the same
+// neighbor aggregation a knn classification uses, so the prediction matches
+// what a classification would set. Nothing is stored.
+//
+// The object is either an existing one (params.ID), an unsaved one
+// (params.Schema) which is vectorized like an import, or just a vector. An
+// existing object must not have any of the classify properties set yet, as a
+// classification would neither classify it, nor could it be excluded from
+// the training set.
+func (c *Classifier) Preview(ctx context.Context, principal *models.Principal,
+	params models.ClassificationPreviewParams) (*models.ClassificationPreview, error) {
+	err := c.authorizer.Authorize(principal, "get", "classifications/*")
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := c.validatePreview(params)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := c.getKind(models.Classification{Class: params.Class})
+	// the preview reads the training objects of the class
+	err = c.authorizer.Authorize(principal, "get", fmt.Sprintf("%ss/*", kind.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := c.previewVector(ctx, kind, params)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingSet, err := filterext.Parse(params.TrainingSetWhere)
+	if err != nil {
+		return nil, newErrInvalidPreview("field 'trainingSetWhere': %v", err)
+	}
+
+	res, err := c.vectorRepo.AggregateNeighbors(ctx, vector, kind, params.Class,
+		params.ClassifyProperties, k, trainingSet)
+	if err != nil {
+		return nil, fmt.Errorf("preview: aggregate neighbors: %v", err)
+	}
+
+	byProp := map[string]NeighborRef{}
+	for _, agg := range res {
+		byProp[agg.Property] = agg
+	}
+
+	out := &models.ClassificationPreview{}
+	for _, propName := range params.ClassifyProperties {
+		agg, ok := byProp[propName]
+		if !ok {
+			// there is no training data for this property
+			continue
+		}
+
+		var losingDistance *float64
+		if agg.LosingDistance != nil {
+			d := float64(*agg.LosingDistance)
+			losingDistance = &d
+		}
+
+		out.Properties = append(out.Properties, &models.ClassificationPreviewProperty{
+			Property:        propName,
+			Beacon:          agg.Beacon,
+			Count:           int64(agg.Count),
+			Confidence:      float64(agg.Count) / float64(k),
+			WinningDistance: float64(agg.WinningDistance),
+			LosingDistance:  losingDistance,
+		})
+	}
+
+	return out, nil
+}
+
+// validatePreview returns the k to use
+func (c *Classifier) validatePreview(params models.ClassificationPreviewParams) (int, error) {
+	v := NewValidator(c.schemaGetter, models.Classification{
+		Class:              params.Class,
+		ClassifyProperties: params.ClassifyProperties,
+	})
+	v.validatePreview()
+	if err := v.errors.toError(); err != nil {
+		return 0, newErrInvalidPreview("invalid classification preview: %v", err)
+	}
+
+	set := 0
+	if params.ID != "" {
+		set++
+	}
+	if params.Schema != nil {
+		set++
+	}
+	if len(params.Vector) > 0 {
+		set++
+	}
+	if set != 1 {
+		return 0, newErrInvalidPreview("invalid classification preview: " +
+			"exactly one of 'id', 'schema' or 'vector' must be set")
+	}
+
+	if params.K == nil {
+		return defaultPreviewK, nil
+	}
+
+	if *params.K < 1 || *params.K > maxPreviewK {
+		return 0, newErrInvalidPreview("invalid classification preview: "+
+			"k must be between 1 and %d, got %d", maxPreviewK, *params.K)
+	}
+
+	return int(*params.K), nil
+}
+
+func (c *Classifier) previewVector(ctx context.Context, k kind.Kind,
+	params models.ClassificationPreviewParams) ([]float32, error) {
+	switch {
+	case params.ID != "":
+		return c.previewVectorOfExisting(ctx, k, params)
+	case params.Schema != nil:
+		return c.previewVectorOfUnsaved(ctx, k, params)
+	default:
+		return c.validatePreviewVector(ctx, params)
+	}
+}
+
+func (c *Classifier) previewVectorOfExisting(ctx context.Context, k kind.Kind,
+	params models.ClassificationPreviewParams) ([]float32, error) {
+	var res *search.Result
+	var err error
+	if k == kind.Action {
+		res, err = c.vectorRepo.ActionByID(ctx, params.ID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+	} else {
+		res, err = c.vectorRepo.ThingByID(ctx, params.ID,
+			traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("preview: find object: %v", err)
+	}
+
+	if res == nil || res.ClassName != params.Class {
+		return nil, newErrInvalidPreview("no object with id '%s' in class '%s'",
+			params.ID, params.Class)
+	}
+
+	schemaMap, _ := res.Schema.(map[string]interface{})
+	for _, propName := range params.ClassifyProperties {
+		if refs, ok := schemaMap[propName].(models.MultipleRef); ok && len(refs) > 0 {
+			return nil, newErrInvalidPreview("object '%s' already has property '%s' set, "+
+				"a classification only predicts objects without any of the classify properties",
+				params.ID, propName)
+		}
+	}
+
+	return res.Vector, nil
+}
+
+func (c *Classifier) previewVectorOfUnsaved(ctx context.Context, k kind.Kind,
+	params models.ClassificationPreviewParams) ([]float32, error) {
+	var vector []float32
+	var err error
+	if k == kind.Action {
+		vector, _, err = c.objectVectorizer.Action(ctx,
+			&models.Action{Class: params.Class, Schema: params.Schema})
+	} else {
+		vector, _, err = c.objectVectorizer.Thing(ctx,
+			&models.Thing{Class: params.Class, Schema: params.Schema})
+	}
+	if err != nil {
+		return nil, newErrInvalidPreview("could not vectorize object: %v", err)
+	}
+
+	return vector, nil
+}
+
+// validatePreviewVector makes sure a user-specified vector has the
+// dimensions of the vectors of the class. These are always produced by the
+// vectorizer, so the class name is vectorized to find out what they are.
+func (c *Classifier) validatePreviewVector(ctx context.Context,
+	params models.ClassificationPreviewParams) ([]float32, error) {
+	reference, err := c.objectVectorizer.Corpi(ctx, []string{params.Class})
+	if err != nil {
+		return nil, fmt.Errorf("preview: determine vector dimensions: %v", err)
+	}
+
+	if len(params.Vector) != len(reference) {
+		return nil, newErrInvalidPreview("vector has %d dimensions, but the vectors "+
+			"of class '%s' have %d", len(params.Vector), params.Class, len(reference))
+	}
+
+	return params.Vector, nil
+}
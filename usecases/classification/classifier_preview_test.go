@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Classifier_Preview(t *testing.T) {
+	newClassifier := func(authorizer authorizer) *Classifier {
+		sg := &fakeSchemaGetter{testSchema()}
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		return New(sg, newFakeClassificationRepo(), vectorRepo, authorizer, nil,
+			&fakeObjectVectorizer{vector: []float32{0, 0.1, 0.9}}, newNullLogger())
+	}
+
+	one := int32(1)
+
+	t.Run("with a vector", func(t *testing.T) {
+		res, err := newClassifier(&fakeAuthorizer{}).Preview(context.Background(), nil,
+			models.ClassificationPreviewParams{
+				Class:              "Article",
+				ClassifyProperties: []string{"exactCategory", "mainCategory"},
+				Vector:             []float32{0.9, 0.1, 0},
+				K:                  &one,
+			})
+		require.Nil(t, err)
+		require.Len(t, res.Properties, 2)
+
+		assert.Equal(t, "exactCategory", res.Properties[0].Property)
+		assert.Equal(t, beaconRef(idCategoryPolitics).Beacon, res.Properties[0].Beacon)
+		assert.Equal(t, int64(1), res.Properties[0].Count)
+		assert.Equal(t, float64(1), res.Properties[0].Confidence)
+		assert.Nil(t, res.Properties[0].LosingDistance)
+
+		assert.Equal(t, "mainCategory", res.Properties[1].Property)
+		assert.Equal(t, beaconRef(idMainCategoryPoliticsAndSociety).Beacon,
+			res.Properties[1].Beacon)
+	})
+
+	t.Run("with an unsaved object", func(t *testing.T) {
+		res, err := newClassifier(&fakeAuthorizer{}).Preview(context.Background(), nil,
+			models.ClassificationPreviewParams{
+				Class:              "Article",
+				ClassifyProperties: []string{"exactCategory"},
+				Schema:             map[string]interface{}{"description": "about food"},
+				K:                  &one,
+			})
+		require.Nil(t, err)
+		require.Len(t, res.Properties, 1)
+		assert.Equal(t, beaconRef(idCategoryFoodAndDrink).Beacon, res.Properties[0].Beacon)
+	})
+
+	t.Run("with the id of an unclassified object", func(t *testing.T) {
+		res, err := newClassifier(&fakeAuthorizer{}).Preview(context.Background(), nil,
+			models.ClassificationPreviewParams{
+				Class:              "Article",
+				ClassifyProperties: []string{"exactCategory"},
+				ID:                 "75ba35af-6a08-40ae-b442-3bec69b355f9",
+				K:                  &one,
+			})
+		require.Nil(t, err)
+		require.Len(t, res.Properties, 1)
+		assert.Equal(t, beaconRef(idCategoryPolitics).Beacon, res.Properties[0].Beacon)
+	})
+
+	t.Run("the kind is authorized as well", func(t *testing.T) {
+		authorizer := &recordingAuthorizer{}
+		_, err := newClassifier(authorizer).Preview(context.Background(), nil,
+			models.ClassificationPreviewParams{
+				Class:              "Article",
+				ClassifyProperties: []string{"exactCategory"},
+				Vector:             []float32{0.9, 0.1, 0},
+				K:                  &one,
+			})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"get classifications/*", "get things/*"}, authorizer.calls)
+	})
+
+	t.Run("with invalid params", func(t *testing.T) {
+		zero := int32(0)
+		tooLarge := int32(maxPreviewK + 1)
+		tests := []struct {
+			name   string
+			params models.ClassificationPreviewParams
+		}{
+			{
+				name: "neither id, schema nor vector",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+				},
+			},
+			{
+				name: "both id and vector",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					ID:                 "75ba35af-6a08-40ae-b442-3bec69b355f9",
+					Vector:             []float32{1, 0, 0},
+				},
+			},
+			{
+				name: "a class which does not exist",
+				params: models.ClassificationPreviewParams{
+					Class:              "Foo",
+					ClassifyProperties: []string{"exactCategory"},
+					Vector:             []float32{1, 0, 0},
+				},
+			},
+			{
+				name: "a primitive classify property",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"description"},
+					Vector:             []float32{1, 0, 0},
+				},
+			},
+			{
+				name: "k below 1",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					Vector:             []float32{1, 0, 0},
+					K:                  &zero,
+				},
+			},
+			{
+				name: "k above the maximum",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					Vector:             []float32{1, 0, 0},
+					K:                  &tooLarge,
+				},
+			},
+			{
+				name: "a vector with the wrong dimensions",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					Vector:             []float32{1, 0},
+					K:                  &one,
+				},
+			},
+			{
+				name: "an id which does not exist",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					ID:                 "d5f5b1e4-1e2b-4a4f-9c57-4e6f1a7c3a11",
+					K:                  &one,
+				},
+			},
+			{
+				name: "the id of an already classified object",
+				params: models.ClassificationPreviewParams{
+					Class:              "Article",
+					ClassifyProperties: []string{"exactCategory"},
+					ID:                 "8aeecd06-55a0-462c-9853-81b31a284d80",
+					K:                  &one,
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := newClassifier(&fakeAuthorizer{}).Preview(context.Background(),
+					nil, test.params)
+				require.NotNil(t, err)
+				_, ok := err.(ErrInvalidPreview)
+				assert.True(t, ok, "should be an ErrInvalidPreview, got %T: %v", err, err)
+			})
+		}
+	})
+}
+
+type fakeObjectVectorizer struct {
+	vector []float32
+}
+
+func (f *fakeObjectVectorizer) Thing(ctx context.Context,
+	object *models.Thing) ([]float32, []libvectorizer.InputElement, error) {
+	return f.vector, nil, nil
+}
+
+func (f *fakeObjectVectorizer) Action(ctx context.Context,
+	object *models.Action) ([]float32, []libvectorizer.InputElement, error) {
+	return f.vector, nil, nil
+}
+
+func (f *fakeObjectVectorizer) Corpi(ctx context.Context,
+	corpi []string) ([]float32, error) {
+	return f.vector, nil
+}
+
+type recordingAuthorizer struct {
+	calls []string
+}
+
+func (a *recordingAuthorizer) Authorize(principal *models.Principal, verb,
+	resource string) error {
+	a.calls = append(a.calls, verb+" "+resource)
+	return nil
+}
@@ -140,6 +140,9 @@ func (c *Classifier) failRunWithError(params models.Classification, err error) {
 func (c *Classifier) store(item search.Result) error {
 	ctx, cancel := contextWithTimeout(2 * time.Second)
 	defer cancel()
+	// the classification must not fail on versions, so the version of the item
+	// is not used as the expected one
+	item.Version = 0
 	switch item.Kind {
 	case kind.Thing:
 		return c.vectorRepo.PutThing(ctx, item.Thing(), item.Vector)
@@ -37,7 +37,7 @@ func newNullLogger() *logrus.Logger {
 func Test_Classifier_KNN(t *testing.T) {
 	t.Run("with invalid data", func(t *testing.T) {
 		sg := &fakeSchemaGetter{testSchema()}
-		_, err := New(sg, nil, nil, &fakeAuthorizer{}, nil, newNullLogger()).
+		_, err := New(sg, nil, nil, &fakeAuthorizer{}, nil, nil, newNullLogger()).
 			Schedule(context.Background(), nil, models.Classification{})
 		assert.NotNil(t, err, "should error with invalid user input")
 	})
@@ -50,7 +50,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		repo := newFakeClassificationRepo()
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
-		classifier := New(sg, repo, vectorRepo, authorizer, nil, newNullLogger())
+		classifier := New(sg, repo, vectorRepo, authorizer, nil, nil, newNullLogger())
 
 		k := int32(1)
 		params := models.Classification{
@@ -125,7 +125,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
 		vectorRepo.errorOnAggregate = errors.New("something went wrong")
-		classifier := New(sg, repo, vectorRepo, authorizer, nil, newNullLogger())
+		classifier := New(sg, repo, vectorRepo, authorizer, nil, nil, newNullLogger())
 
 		k := int32(1)
 		params := models.Classification{
@@ -173,7 +173,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		repo := newFakeClassificationRepo()
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(nil, testDataAlreadyClassified())
-		classifier := New(sg, repo, vectorRepo, authorizer, nil, newNullLogger())
+		classifier := New(sg, repo, vectorRepo, authorizer, nil, nil, newNullLogger())
 
 		k := int32(1)
 		params := models.Classification{
@@ -218,7 +218,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 		vectorRepo := newFakeVectorRepoContextual(testDataToBeClassified(), testDataPossibleTargets())
 		logger, _ := test.NewNullLogger()
 		vectorizer := &fakeVectorizer{words: testDataVectors()}
-		classifier := New(sg, repo, vectorRepo, authorizer, vectorizer, logger)
+		classifier := New(sg, repo, vectorRepo, authorizer, vectorizer, nil, logger)
 
 		contextual := "contextual"
 		params := models.Classification{
@@ -292,7 +292,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
 		vectorRepo.errorOnAggregate = errors.New("something went wrong")
 		logger, _ := test.NewNullLogger()
-		classifier := New(sg, repo, vectorRepo, authorizer, nil, logger)
+		classifier := New(sg, repo, vectorRepo, authorizer, nil, nil, logger)
 
 		k := int32(1)
 		params := models.Classification{
@@ -339,7 +339,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(nil, testDataAlreadyClassified())
 		logger, _ := test.NewNullLogger()
-		classifier := New(sg, repo, vectorRepo, authorizer, nil, logger)
+		classifier := New(sg, repo, vectorRepo, authorizer, nil, nil, logger)
 
 		k := int32(1)
 		params := models.Classification{
@@ -467,3 +467,76 @@ func (f *fakeVectorizer) centroid(in [][]float32, words []string) ([]float32, []
 
 	return out, nil, nil
 }
+
+func Test_Classifier_WaitForCompletion(t *testing.T) {
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	t.Run("a classification which is done is returned immediately", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		repo.Put(context.Background(), models.Classification{ID: id,
+			Status: models.ClassificationStatusCompleted})
+		classifier := New(nil, repo, nil, &fakeAuthorizer{}, nil, nil, newNullLogger())
+
+		res, err := classifier.WaitForCompletion(context.Background(), nil, id, time.Minute)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+	})
+
+	t.Run("an unknown classification is returned as nil", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		classifier := New(nil, repo, nil, &fakeAuthorizer{}, nil, nil, newNullLogger())
+
+		res, err := classifier.WaitForCompletion(context.Background(), nil, id, time.Minute)
+
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("a running classification is returned once it completes", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		repo.Put(context.Background(), models.Classification{ID: id,
+			Status: models.ClassificationStatusRunning})
+		classifier := New(nil, repo, nil, &fakeAuthorizer{}, nil, nil, newNullLogger())
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			repo.Put(context.Background(), models.Classification{ID: id,
+				Status: models.ClassificationStatusFailed, Error: "oops"})
+		}()
+
+		res, err := classifier.WaitForCompletion(context.Background(), nil, id, time.Minute)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusFailed, res.Status)
+		assert.Equal(t, "oops", res.Error)
+	})
+
+	t.Run("a running classification is returned after the timeout", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		repo.Put(context.Background(), models.Classification{ID: id,
+			Status: models.ClassificationStatusRunning})
+		classifier := New(nil, repo, nil, &fakeAuthorizer{}, nil, nil, newNullLogger())
+
+		before := time.Now()
+		res, err := classifier.WaitForCompletion(context.Background(), nil, id, 50*time.Millisecond)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusRunning, res.Status)
+		assert.True(t, time.Since(before) < time.Second)
+	})
+
+	t.Run("waiting ends when the client goes away", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		repo.Put(context.Background(), models.Classification{ID: id,
+			Status: models.ClassificationStatusRunning})
+		classifier := New(nil, repo, nil, &fakeAuthorizer{}, nil, nil, newNullLogger())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := classifier.WaitForCompletion(ctx, nil, id, time.Minute)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusRunning, res.Status)
+	})
+}
@@ -37,12 +37,14 @@ func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 
 type fakeClassificationRepo struct {
 	sync.Mutex
-	db map[strfmt.UUID]models.Classification
+	db       map[strfmt.UUID]models.Classification
+	watchers map[strfmt.UUID][]chan struct{}
 }
 
 func newFakeClassificationRepo() *fakeClassificationRepo {
 	return &fakeClassificationRepo{
-		db: map[strfmt.UUID]models.Classification{},
+		db:       map[strfmt.UUID]models.Classification{},
+		watchers: map[strfmt.UUID][]chan struct{}{},
 	}
 }
 
@@ -51,9 +53,24 @@ func (f *fakeClassificationRepo) Put(ctx context.Context, class models.Classific
 	defer f.Unlock()
 
 	f.db[class.ID] = class
+	for _, watcher := range f.watchers[class.ID] {
+		select {
+		case watcher <- struct{}{}:
+		default:
+		}
+	}
 	return nil
 }
 
+func (f *fakeClassificationRepo) Watch(ctx context.Context, id strfmt.UUID) <-chan struct{} {
+	f.Lock()
+	defer f.Unlock()
+
+	watcher := make(chan struct{}, 1)
+	f.watchers[id] = append(f.watchers[id], watcher)
+	return watcher
+}
+
 func (f *fakeClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*models.Classification, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -157,6 +174,27 @@ func (f *fakeVectorRepoKNN) VectorClassSearch(ctx context.Context,
 	return nil, fmt.Errorf("vector class search not implemented in fake")
 }
 
+func (f *fakeVectorRepoKNN) ThingByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) (*search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, res := range append(f.unclassified, f.classified...) {
+		if res.ID == id {
+			return &res, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *fakeVectorRepoKNN) ActionByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) (*search.Result, error) {
+	return nil, fmt.Errorf("action by id not implemented in fake")
+}
+
 func (f *fakeVectorRepoKNN) PutThing(ctx context.Context, thing *models.Thing, vector []float32) error {
 	f.Lock()
 	defer f.Unlock()
@@ -199,6 +237,18 @@ type fakeVectorRepoContextual struct {
 	errorOnAggregate error
 }
 
+func (f *fakeVectorRepoContextual) ThingByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) (*search.Result, error) {
+	return nil, fmt.Errorf("thing by id not implemented in fake")
+}
+
+func (f *fakeVectorRepoContextual) ActionByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties,
+	underscore traverser.UnderscoreProperties) (*search.Result, error) {
+	return nil, fmt.Errorf("action by id not implemented in fake")
+}
+
 func (f *fakeVectorRepoContextual) get(id strfmt.UUID) (*models.Thing, bool) {
 	f.Lock()
 	defer f.Unlock()
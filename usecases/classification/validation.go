@@ -66,6 +66,23 @@ func (v *Validator) validate() {
 	v.classifyProperties(class)
 }
 
+// validatePreview only covers the class and the classify properties, as a
+// preview has no based on properties and is always of type knn
+func (v *Validator) validatePreview() {
+	if v.subject.Class == "" {
+		v.errors.add(fmt.Errorf("class must be set"))
+		return
+	}
+
+	class := v.schema.FindClassByName(schema.ClassName(v.subject.Class))
+	if class == nil {
+		v.errors.addf("class '%s' not found in schema", v.subject.Class)
+		return
+	}
+
+	v.classifyProperties(class)
+}
+
 func (v *Validator) contextualTypeFeasibility() {
 	if !v.typeContextual() {
 		return
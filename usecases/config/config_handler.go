@@ -33,20 +33,39 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                 string          `json:"name" yaml:"name"`
-	AnalyticsEngine      AnalyticsEngine `json:"analytics_engine" yaml:"analytics_engine"`
-	Database             Database        `json:"database" yaml:"database"`
-	Network              *Network        `json:"network" yaml:"network"`
-	Debug                bool            `json:"debug" yaml:"debug"`
-	QueryDefaults        QueryDefaults   `json:"query_defaults" yaml:"query_defaults"`
-	Contextionary        Contextionary   `json:"contextionary" yaml:"contextionary"`
-	ConfigurationStorage ConfigStore     `json:"configuration_storage" yaml:"configuration_storage"`
-	Authentication       Authentication  `json:"authentication" yaml:"authentication"`
-	Authorization        Authorization   `json:"authorization" yaml:"authorization"`
-	VectorIndex          VectorIndex     `json:"vector_index" yaml:"vector_index"`
-	Standalone           bool            `json:"standalone_mode" yaml:"standalone_mode"`
-	Origin               string          `json:"origin" yaml:"origin"`
-	Persistence          Persistence     `json:"persistence" yaml:"persistence"`
+	Name                 string            `json:"name" yaml:"name"`
+	AnalyticsEngine      AnalyticsEngine   `json:"analytics_engine" yaml:"analytics_engine"`
+	Database             Database          `json:"database" yaml:"database"`
+	Network              *Network          `json:"network" yaml:"network"`
+	Debug                bool              `json:"debug" yaml:"debug"`
+	QueryDefaults        QueryDefaults     `json:"query_defaults" yaml:"query_defaults"`
+	Contextionary        Contextionary     `json:"contextionary" yaml:"contextionary"`
+	ConfigurationStorage ConfigStore       `json:"configuration_storage" yaml:"configuration_storage"`
+	Authentication       Authentication    `json:"authentication" yaml:"authentication"`
+	Authorization        Authorization     `json:"authorization" yaml:"authorization"`
+	VectorIndex          VectorIndex       `json:"vector_index" yaml:"vector_index"`
+	Standalone           bool              `json:"standalone_mode" yaml:"standalone_mode"`
+	Origin               string            `json:"origin" yaml:"origin"`
+	Persistence          Persistence       `json:"persistence" yaml:"persistence"`
+	Events               Events            `json:"events" yaml:"events"`
+	Tracing              Tracing           `json:"tracing" yaml:"tracing"`
+	BatchIdempotency     BatchIdempotency  `json:"batch_idempotency" yaml:"batch_idempotency"`
+	Startup              Startup           `json:"startup" yaml:"startup"`
+	Ingest               Ingest            `json:"ingest" yaml:"ingest"`
+	Consistency          Consistency       `json:"consistency" yaml:"consistency"`
+	Audit                Audit             `json:"audit" yaml:"audit"`
+	Expiry               Expiry            `json:"expiry" yaml:"expiry"`
+	Batch                Batch             `json:"batch" yaml:"batch"`
+	QueryCache           QueryCache        `json:"query_cache" yaml:"query_cache"`
+	WriteRateLimit       WriteRateLimit    `json:"write_rate_limit" yaml:"write_rate_limit"`
+	Errors               Errors            `json:"errors" yaml:"errors"`
+	TextNormalization    TextNormalization `json:"text_normalization" yaml:"text_normalization"`
+	Vectorizer           Vectorizer        `json:"vectorizer" yaml:"vectorizer"`
+	AutoSchema           AutoSchema        `json:"auto_schema" yaml:"auto_schema"`
+	RequestTimeout       RequestTimeout    `json:"request_timeout" yaml:"request_timeout"`
+	SlowQueryLog         SlowQueryLog      `json:"slow_query_log" yaml:"slow_query_log"`
+	ObjectSize           ObjectSize        `json:"object_size" yaml:"object_size"`
+	RequestBody          RequestBody       `json:"request_body" yaml:"request_body"`
 }
 
 // Validate the non-nested parameters. Nested objects must provide their own
@@ -59,10 +78,634 @@ func (c Config) Validate() error {
 // QueryDefaults for optional parameters
 type QueryDefaults struct {
 	Limit int64 `json:"limit" yaml:"limit"`
+
+	// MaxLimit is the largest limit a single list or search request may use.
+	// If not set, Limit is also the maximum.
+	MaxLimit int64 `json:"maxLimit" yaml:"maxLimit"`
+
+	// RejectExceedingLimit makes requests with a limit above the maximum fail
+	// instead of clamping their limit to the maximum
+	RejectExceedingLimit bool `json:"rejectExceedingLimit" yaml:"rejectExceedingLimit"`
+
+	// InterpretationContributions is the maximum number of contributing
+	// concepts returned as part of the _interpretation of a vector search
+	// result
+	InterpretationContributions *int `json:"interpretationContributions" yaml:"interpretationContributions"`
+}
+
+func (q *QueryDefaults) SetDefaults() {
+	if q.InterpretationContributions == nil {
+		q.InterpretationContributions = ptInt(5)
+	}
+}
+
+// MaximumLimit is the largest limit a request may use, 0 means there is no
+// maximum
+func (q QueryDefaults) MaximumLimit() int64 {
+	if q.MaxLimit > 0 {
+		return q.MaxLimit
+	}
+
+	return q.Limit
+}
+
+// EffectiveLimit is the limit to apply to a request. If none was requested,
+// the default limit is used. A negative limit is always rejected, a requested
+// limit above the maximum is either clamped to the maximum or rejected,
+// depending on RejectExceedingLimit.
+func (q QueryDefaults) EffectiveLimit(requested *int64) (int64, error) {
+	if requested == nil {
+		return q.Limit, nil
+	}
+
+	if *requested < 0 {
+		return 0, fmt.Errorf("limit must not be negative, got %d", *requested)
+	}
+
+	max := q.MaximumLimit()
+	if max == 0 || *requested <= max {
+		return *requested, nil
+	}
+
+	if q.RejectExceedingLimit {
+		return 0, fmt.Errorf("limit %d exceeds the maximum limit of %d", *requested, max)
+	}
+
+	return max, nil
 }
 
 type Contextionary struct {
 	URL string `json:"url" yaml:"url"`
+
+	// MaxRetries is the number of times a call to the contextionary is retried
+	// if it failed with a transient (connection-related) error
+	MaxRetries *int `json:"maxRetries" yaml:"maxRetries"`
+
+	// RetryBackoffMS is the initial wait time between two retries, it doubles
+	// with every subsequent retry
+	RetryBackoffMS *int `json:"retryBackoffMs" yaml:"retryBackoffMs"`
+
+	// BreakerThreshold is the number of consecutive failed calls after which
+	// the circuit breaker opens. While open, calls fail immediately without
+	// contacting the contextionary.
+	BreakerThreshold *int `json:"breakerThreshold" yaml:"breakerThreshold"`
+
+	// BreakerCooldownSeconds is the time the breaker stays open before a
+	// single trial call is let through again
+	BreakerCooldownSeconds *int `json:"breakerCooldownSeconds" yaml:"breakerCooldownSeconds"`
+
+	// StartupMaxWaitSeconds is the total time weaviate keeps trying to reach
+	// the contextionary on startup before it gives up and exits
+	StartupMaxWaitSeconds *int `json:"startupMaxWaitSeconds" yaml:"startupMaxWaitSeconds"`
+
+	// TLS secures the connection to the contextionary, it is unencrypted if
+	// no TLS setting is present
+	TLS ContextionaryTLS `json:"tls" yaml:"tls"`
+
+	// Cache holds word vectors locally, so that frequently used words don't
+	// need to be requested from the contextionary over and over again
+	Cache ContextionaryCache `json:"cache" yaml:"cache"`
+}
+
+// ContextionaryCache limits the local word vector cache
+type ContextionaryCache struct {
+	// MaxEntries is the number of word vectors kept, the least recently used
+	// ones are evicted first. Set to 0 to disable the cache.
+	MaxEntries *int `json:"maxEntries" yaml:"maxEntries"`
+
+	// TTLSeconds is the time a word vector is served from the cache, 0 means
+	// vectors are only removed through eviction
+	TTLSeconds *int `json:"ttlSeconds" yaml:"ttlSeconds"`
+
+	// VersionCheckSeconds is the interval in which the contextionary version
+	// is verified, the cache is purged if the version changed
+	VersionCheckSeconds *int `json:"versionCheckSeconds" yaml:"versionCheckSeconds"`
+}
+
+func (c *ContextionaryCache) SetDefaults() {
+	if c.MaxEntries == nil {
+		c.MaxEntries = ptInt(10000)
+	}
+
+	if c.TTLSeconds == nil {
+		c.TTLSeconds = ptInt(0)
+	}
+
+	if c.VersionCheckSeconds == nil {
+		c.VersionCheckSeconds = ptInt(60)
+	}
+}
+
+// ContextionaryTLS holds the paths to PEM-encoded files used to connect to
+// the contextionary via TLS, optionally with a client certificate (mTLS)
+type ContextionaryTLS struct {
+	// CAFile is the bundle of certificate authorities the server certificate
+	// is verified against, the system's root certificates are used if empty
+	CAFile string `json:"caFile" yaml:"caFile"`
+
+	// CertFile is the client certificate presented to the contextionary, it
+	// is only needed for mTLS and requires KeyFile to be set as well
+	CertFile string `json:"certFile" yaml:"certFile"`
+
+	// KeyFile is the private key of the client certificate
+	KeyFile string `json:"keyFile" yaml:"keyFile"`
+
+	// ServerName overrides the host name the server certificate is verified
+	// against, it defaults to the host of the contextionary url
+	ServerName string `json:"serverName" yaml:"serverName"`
+}
+
+func (c *Contextionary) SetDefaults() {
+	if c.MaxRetries == nil {
+		c.MaxRetries = ptInt(3)
+	}
+
+	if c.RetryBackoffMS == nil {
+		c.RetryBackoffMS = ptInt(100)
+	}
+
+	if c.BreakerThreshold == nil {
+		c.BreakerThreshold = ptInt(5)
+	}
+
+	if c.BreakerCooldownSeconds == nil {
+		c.BreakerCooldownSeconds = ptInt(10)
+	}
+
+	if c.StartupMaxWaitSeconds == nil {
+		c.StartupMaxWaitSeconds = ptInt(5 * 60)
+	}
+
+	(&c.Cache).SetDefaults()
+}
+
+// Events configures the emission of change events for things and actions.
+// No events are emitted unless a WebhookURL is set.
+type Events struct {
+	WebhookURL string `json:"webhookUrl" yaml:"webhookUrl"`
+
+	// QueueSize is the maximum number of events waiting to be delivered, once
+	// exceeded new events are dropped
+	QueueSize *int `json:"queueSize" yaml:"queueSize"`
+
+	// MaxRetries is the number of times a failed delivery is retried
+	MaxRetries *int `json:"maxRetries" yaml:"maxRetries"`
+
+	// RetryBackoffMS is the initial wait time between two delivery attempts,
+	// it doubles with every subsequent retry
+	RetryBackoffMS *int `json:"retryBackoffMs" yaml:"retryBackoffMs"`
+
+	// TimeoutMS limits a single delivery attempt
+	TimeoutMS *int `json:"timeoutMs" yaml:"timeoutMs"`
+}
+
+func (e *Events) SetDefaults() {
+	if e.QueueSize == nil {
+		e.QueueSize = ptInt(10000)
+	}
+
+	if e.MaxRetries == nil {
+		e.MaxRetries = ptInt(5)
+	}
+
+	if e.RetryBackoffMS == nil {
+		e.RetryBackoffMS = ptInt(500)
+	}
+
+	if e.TimeoutMS == nil {
+		e.TimeoutMS = ptInt(5000)
+	}
+}
+
+// Tracing selects the tracer for request-scoped spans. Tracing is disabled
+// unless a Tracer other than "noop" is set.
+type Tracing struct {
+	Tracer string `json:"tracer" yaml:"tracer"`
+}
+
+// BatchIdempotency configures how long the results of batch requests sent
+// with an Idempotency-Key header are kept for retries.
+type BatchIdempotency struct {
+	TTLSeconds *int `json:"ttlSeconds" yaml:"ttlSeconds"`
+
+	// LeaseSeconds is how long a key stays reserved for a request which has
+	// not completed yet. If the server dies in the meantime, retries are
+	// rejected until the lease runs out, so it should only cover the
+	// duration of a slow batch rather than the whole TTL.
+	LeaseSeconds *int `json:"leaseSeconds" yaml:"leaseSeconds"`
+}
+
+func (b *BatchIdempotency) SetDefaults() {
+	if b.TTLSeconds == nil {
+		b.TTLSeconds = ptInt(24 * 60 * 60)
+	}
+
+	if b.LeaseSeconds == nil {
+		b.LeaseSeconds = ptInt(5 * 60)
+	}
+}
+
+// Startup configures how long weaviate waits for the vector repo on startup.
+// For the standalone db this is the time to load all indexes from disk, for
+// esvector it is the time until elasticsearch responds.
+type Startup struct {
+	TimeoutSeconds *int `json:"timeoutSeconds" yaml:"timeoutSeconds"`
+}
+
+func (s *Startup) SetDefaults() {
+	if s.TimeoutSeconds == nil {
+		s.TimeoutSeconds = ptInt(2 * 60)
+	}
+}
+
+func (s Startup) Validate() error {
+	if s.TimeoutSeconds != nil && *s.TimeoutSeconds <= 0 {
+		return fmt.Errorf("startup.timeoutSeconds must be greater than 0")
+	}
+
+	return nil
+}
+
+// Consistency configures writes which are requested with strong consistency.
+// Such a write only returns once the object can be read back, but at most
+// StrongTimeoutSeconds. On esvector this adds up to the elasticsearch refresh
+// interval to every such write.
+type Consistency struct {
+	StrongTimeoutSeconds *int `json:"strongTimeoutSeconds" yaml:"strongTimeoutSeconds"`
+}
+
+func (c *Consistency) SetDefaults() {
+	if c.StrongTimeoutSeconds == nil {
+		c.StrongTimeoutSeconds = ptInt(10)
+	}
+}
+
+// Batch configures the processing of batch imports. VectorizationConcurrency
+// limits how many objects of a single batch are validated and vectorized in
+// parallel, it should be matched to the capacity of the contextionary.
+// MaxConcurrentRequests limits how many batch requests are processed at the
+// same time across the whole server, 0 disables the limit. Requests beyond
+// the limit wait up to QueueTimeoutSeconds for a free slot and are rejected
+// with a 429 afterwards, a timeout of 0 rejects them right away.
+// DuplicateIDs controls how a batch which contains the same id more than once
+// is imported in standalone mode, "mark" fails all but the first occurrence,
+// "reject" fails the whole batch. See db.DuplicateIDPolicy.
+type Batch struct {
+	VectorizationConcurrency *int   `json:"vectorizationConcurrency" yaml:"vectorizationConcurrency"`
+	MaxConcurrentRequests    *int   `json:"maxConcurrentRequests" yaml:"maxConcurrentRequests"`
+	QueueTimeoutSeconds      *int   `json:"queueTimeoutSeconds" yaml:"queueTimeoutSeconds"`
+	DuplicateIDs             string `json:"duplicateIDs" yaml:"duplicateIDs"`
+}
+
+func (b *Batch) SetDefaults() {
+	if b.VectorizationConcurrency == nil {
+		b.VectorizationConcurrency = ptInt(4)
+	}
+
+	if b.MaxConcurrentRequests == nil {
+		b.MaxConcurrentRequests = ptInt(0)
+	}
+
+	if b.QueueTimeoutSeconds == nil {
+		b.QueueTimeoutSeconds = ptInt(10)
+	}
+
+	if b.DuplicateIDs == "" {
+		b.DuplicateIDs = "mark"
+	}
+}
+
+func (b Batch) Validate() error {
+	if b.MaxConcurrentRequests != nil && *b.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("batch.maxConcurrentRequests must not be negative")
+	}
+
+	if b.QueueTimeoutSeconds != nil && *b.QueueTimeoutSeconds < 0 {
+		return fmt.Errorf("batch.queueTimeoutSeconds must not be negative")
+	}
+
+	switch b.DuplicateIDs {
+	case "", "mark", "reject":
+	default:
+		return fmt.Errorf("batch.duplicateIDs must be one of 'mark', 'reject', got '%s'",
+			b.DuplicateIDs)
+	}
+
+	return nil
+}
+
+// WriteRateLimit limits how many things and actions can be created, updated
+// or imported per second. ObjectsPerSecond applies to all classes together,
+// PerClassObjectsPerSecond to every class on its own and ClassOverrides
+// replace the per-class limit of individual classes. A limit of 0 disables
+// it, which is the default.
+type WriteRateLimit struct {
+	ObjectsPerSecond         *int           `json:"objectsPerSecond" yaml:"objectsPerSecond"`
+	PerClassObjectsPerSecond *int           `json:"perClassObjectsPerSecond" yaml:"perClassObjectsPerSecond"`
+	ClassOverrides           map[string]int `json:"classOverrides" yaml:"classOverrides"`
+}
+
+func (w *WriteRateLimit) SetDefaults() {
+	if w.ObjectsPerSecond == nil {
+		w.ObjectsPerSecond = ptInt(0)
+	}
+
+	if w.PerClassObjectsPerSecond == nil {
+		w.PerClassObjectsPerSecond = ptInt(0)
+	}
+}
+
+func (w WriteRateLimit) Validate() error {
+	if w.ObjectsPerSecond != nil && *w.ObjectsPerSecond < 0 {
+		return fmt.Errorf("write_rate_limit.objectsPerSecond must not be negative")
+	}
+
+	if w.PerClassObjectsPerSecond != nil && *w.PerClassObjectsPerSecond < 0 {
+		return fmt.Errorf("write_rate_limit.perClassObjectsPerSecond must not be negative")
+	}
+
+	for className, limit := range w.ClassOverrides {
+		if limit < 0 {
+			return fmt.Errorf("write_rate_limit.classOverrides: limit of class '%s' "+
+				"must not be negative", className)
+		}
+	}
+
+	return nil
+}
+
+// Enabled is true if at least one limit is set. SetDefaults must have been
+// called before.
+func (w WriteRateLimit) Enabled() bool {
+	if *w.ObjectsPerSecond > 0 || *w.PerClassObjectsPerSecond > 0 {
+		return true
+	}
+
+	for _, limit := range w.ClassOverrides {
+		if limit > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultMaxDecompressedBodyBytes is used if RequestBody.MaxDecompressedBytes
+// is not set. It leaves room for large batch imports, which are the main
+// reason to compress a request.
+const DefaultMaxDecompressedBodyBytes = 512 * 1024 * 1024
+
+// RequestBody limits the size of gzip compressed request bodies after they
+// have been decompressed, so that a small request can't expand into an
+// arbitrarily large one. Requests beyond MaxDecompressedBytes are rejected
+// with a 413. A limit of 0 disables it.
+type RequestBody struct {
+	MaxDecompressedBytes *int `json:"maxDecompressedBytes" yaml:"maxDecompressedBytes"`
+}
+
+func (r *RequestBody) SetDefaults() {
+	if r.MaxDecompressedBytes == nil {
+		r.MaxDecompressedBytes = ptInt(DefaultMaxDecompressedBodyBytes)
+	}
+}
+
+func (r RequestBody) Validate() error {
+	if r.MaxDecompressedBytes != nil && *r.MaxDecompressedBytes < 0 {
+		return fmt.Errorf("request_body.maxDecompressedBytes must not be negative")
+	}
+
+	return nil
+}
+
+// RequestTimeout limits how long a single request may take. Requests which
+// exceed their deadline are answered with a 503 and their downstream work,
+// such as vectorization, is cancelled. BatchSeconds applies to the batch
+// endpoints, DefaultSeconds to all others. RouteOverrides replace the timeout
+// of individual routes and are keyed by their operation id, such as
+// "things.create". A timeout of 0 disables it.
+type RequestTimeout struct {
+	DefaultSeconds *int           `json:"defaultSeconds" yaml:"defaultSeconds"`
+	BatchSeconds   *int           `json:"batchSeconds" yaml:"batchSeconds"`
+	RouteOverrides map[string]int `json:"routeOverrides" yaml:"routeOverrides"`
+}
+
+func (r *RequestTimeout) SetDefaults() {
+	if r.DefaultSeconds == nil {
+		r.DefaultSeconds = ptInt(60)
+	}
+
+	if r.BatchSeconds == nil {
+		r.BatchSeconds = ptInt(600)
+	}
+}
+
+func (r RequestTimeout) Validate() error {
+	if r.DefaultSeconds != nil && *r.DefaultSeconds < 0 {
+		return fmt.Errorf("request_timeout.defaultSeconds must not be negative")
+	}
+
+	if r.BatchSeconds != nil && *r.BatchSeconds < 0 {
+		return fmt.Errorf("request_timeout.batchSeconds must not be negative")
+	}
+
+	for route, timeout := range r.RouteOverrides {
+		if timeout < 0 {
+			return fmt.Errorf("request_timeout.routeOverrides: timeout of route '%s' "+
+				"must not be negative", route)
+		}
+	}
+
+	return nil
+}
+
+// SlowQueryLog logs every traverser query which takes longer than
+// ThresholdMilliseconds at WARN level. Only the shape of the query is logged,
+// never filter values or the contents of the results. A threshold of 0
+// disables the log.
+type SlowQueryLog struct {
+	ThresholdMilliseconds int `json:"thresholdMilliseconds" yaml:"thresholdMilliseconds"`
+}
+
+func (s SlowQueryLog) Validate() error {
+	if s.ThresholdMilliseconds < 0 {
+		return fmt.Errorf("slow_query_log.thresholdMilliseconds must not be negative")
+	}
+
+	return nil
+}
+
+// ObjectSize limits the size of a single thing or action, measured as its
+// JSON serialization after parsing. It applies per object, so in a batch only
+// the oversized objects are rejected. A MaxBytes of 0 disables the limit.
+type ObjectSize struct {
+	MaxBytes int `json:"maxBytes" yaml:"maxBytes"`
+}
+
+func (o ObjectSize) Validate() error {
+	if o.MaxBytes < 0 {
+		return fmt.Errorf("object_size.maxBytes must not be negative")
+	}
+
+	return nil
+}
+
+// Expiry configures how often things and actions whose expiry time has
+// passed are deleted. Expired objects are hidden from reads right away. A
+// SweepIntervalSeconds of 0 disables the deletion.
+type Expiry struct {
+	SweepIntervalSeconds *int `json:"sweepIntervalSeconds" yaml:"sweepIntervalSeconds"`
+}
+
+func (e *Expiry) SetDefaults() {
+	if e.SweepIntervalSeconds == nil {
+		e.SweepIntervalSeconds = ptInt(60)
+	}
+}
+
+// QueryCache configures caching of identical Get queries. Entries are
+// invalidated as soon as an object of one of the queried classes is written.
+// It is only available in standalone mode.
+type QueryCache struct {
+	Enabled    bool `json:"enabled" yaml:"enabled"`
+	TTLSeconds *int `json:"ttlSeconds" yaml:"ttlSeconds"`
+	MaxEntries *int `json:"maxEntries" yaml:"maxEntries"`
+}
+
+func (q *QueryCache) SetDefaults() {
+	if q.TTLSeconds == nil {
+		q.TTLSeconds = ptInt(60)
+	}
+
+	if q.MaxEntries == nil {
+		q.MaxEntries = ptInt(1000)
+	}
+}
+
+// TextNormalization configures the steps which are applied to the values of
+// string and text properties before they are vectorized. Each class can
+// override every step. Only Lowercase is enabled by default, which is what
+// the vectorizer always did.
+type TextNormalization struct {
+	Lowercase        *bool `json:"lowercase" yaml:"lowercase"`
+	StripPunctuation bool  `json:"stripPunctuation" yaml:"stripPunctuation"`
+	UnicodeNFC       bool  `json:"unicodeNFC" yaml:"unicodeNFC"`
+	RemoveStopwords  bool  `json:"removeStopwords" yaml:"removeStopwords"`
+}
+
+func (t *TextNormalization) SetDefaults() {
+	if t.Lowercase == nil {
+		t.Lowercase = ptBool(true)
+	}
+}
+
+// Vectorizer selects the module which turns objects and search concepts into
+// vectors. The contextionary module is used if no Module is set.
+type Vectorizer struct {
+	Module string `json:"module" yaml:"module"`
+}
+
+// AutoSchema lets imports of single things and actions add properties which
+// are missing from their class. The data type of such a property is inferred
+// from the submitted value.
+type AutoSchema struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+const (
+	// AuditSinkLogger writes audit entries to the regular log output with the
+	// field action=audit
+	AuditSinkLogger = "logger"
+	// AuditSinkFile appends audit entries as JSON lines to Audit.FilePath
+	AuditSinkFile = "file"
+)
+
+// Audit configures the audit log of all changes to things and actions. No
+// entries are written unless a Sink is set.
+type Audit struct {
+	Sink     string `json:"sink" yaml:"sink"`
+	FilePath string `json:"filePath" yaml:"filePath"`
+}
+
+func (a Audit) Validate() error {
+	switch a.Sink {
+	case "", AuditSinkLogger:
+		return nil
+	case AuditSinkFile:
+		if a.FilePath == "" {
+			return fmt.Errorf("audit.filePath is required for audit.sink '%s'", AuditSinkFile)
+		}
+		return nil
+	default:
+		return fmt.Errorf("audit.sink must be one of '%s' or '%s', got '%s'",
+			AuditSinkLogger, AuditSinkFile, a.Sink)
+	}
+}
+
+const (
+	// ErrorDetailsDebug returns the full error message of internal server
+	// errors to the client. This is the default.
+	ErrorDetailsDebug = "debug"
+	// ErrorDetailsSafe replaces the message of internal server errors with a
+	// generic one and a correlation id. The full error is only logged. This
+	// includes the internal errors of single batch items and of GraphQL
+	// resolvers, which are returned with a successful status.
+	ErrorDetailsSafe = "safe"
+)
+
+// Errors configures how much detail of internal server errors is exposed to
+// clients
+type Errors struct {
+	Details string `json:"details" yaml:"details"`
+}
+
+func (e Errors) Validate() error {
+	switch e.Details {
+	case "", ErrorDetailsDebug, ErrorDetailsSafe:
+		return nil
+	default:
+		return fmt.Errorf("errors.details must be one of '%s' or '%s', got '%s'",
+			ErrorDetailsDebug, ErrorDetailsSafe, e.Details)
+	}
+}
+
+// Safe is true if internal server errors must not be returned to clients
+func (e Errors) Safe() bool {
+	return e.Details == ErrorDetailsSafe
+}
+
+// DefaultMaxBlobSizeBytes is used if Ingest.MaxBlobSizeBytes is not set
+const DefaultMaxBlobSizeBytes = 1024 * 1024
+
+const (
+	// TypeCoercionStrict rejects property values which do not match the data
+	// type of the property. This is the default.
+	TypeCoercionStrict = "strict"
+	// TypeCoercionLenient converts compatible property values to the data type
+	// of the property, e.g. the string "42" for an int property.
+	TypeCoercionLenient = "lenient"
+)
+
+// Ingest configures how the properties of incoming objects are validated.
+// MaxBlobSizeBytes limits the decoded size of every blob property.
+type Ingest struct {
+	TypeCoercion     string `json:"typeCoercion" yaml:"typeCoercion"`
+	MaxBlobSizeBytes *int   `json:"maxBlobSizeBytes" yaml:"maxBlobSizeBytes"`
+}
+
+func (i *Ingest) SetDefaults() {
+	if i.MaxBlobSizeBytes == nil {
+		i.MaxBlobSizeBytes = ptInt(DefaultMaxBlobSizeBytes)
+	}
+}
+
+func (i Ingest) Validate() error {
+	switch i.TypeCoercion {
+	case "", TypeCoercionStrict, TypeCoercionLenient:
+		return nil
+	default:
+		return fmt.Errorf("ingest.typeCoercion must be one of '%s' or '%s', got '%s'",
+			TypeCoercionStrict, TypeCoercionLenient, i.TypeCoercion)
+	}
 }
 
 type VectorIndex struct {
@@ -70,10 +713,56 @@ type VectorIndex struct {
 	URL                string  `json:"url" yaml:"url"`
 	NumberOfShards     *int    `json:"numberOfShards" yaml:"numberOfShards"`
 	AutoExpandReplicas *string `json:"autoExpandReplicas" yaml:"autoExpandReplicas"`
+
+	// MaxDimensions is the largest number of dimensions a vector may have to
+	// be imported
+	MaxDimensions *int `json:"maxDimensions" yaml:"maxDimensions"`
 }
 
+// Persistence configures the standalone storage. SyncPolicy controls when
+// writes are fsynced to disk, see db.SyncPolicy for what a crash can lose
+// with each mode. SyncEveryNWrites is only used with the "everyN" policy.
+// VectorIndexRecovery controls when vector indexes are rebuilt from the
+// stored objects on startup, see db.VectorIndexRecovery.
+// CompactionMaxBytesPerSecond throttles a compaction of the storage files, 0
+// disables the throttling. InitialMmapSizeBytes, OpenTimeoutSeconds and
+// ReadOnly control how the storage files are opened, see db.BoltOptions for
+// when to change them.
 type Persistence struct {
-	DataPath string `json:"dataPath" yaml:"dataPath"`
+	DataPath                    string `json:"dataPath" yaml:"dataPath"`
+	SyncPolicy                  string `json:"syncPolicy" yaml:"syncPolicy"`
+	SyncEveryNWrites            *int   `json:"syncEveryNWrites" yaml:"syncEveryNWrites"`
+	VectorIndexRecovery         string `json:"vectorIndexRecovery" yaml:"vectorIndexRecovery"`
+	CompactionMaxBytesPerSecond *int   `json:"compactionMaxBytesPerSecond" yaml:"compactionMaxBytesPerSecond"`
+	InitialMmapSizeBytes        *int   `json:"initialMmapSizeBytes" yaml:"initialMmapSizeBytes"`
+	OpenTimeoutSeconds          *int   `json:"openTimeoutSeconds" yaml:"openTimeoutSeconds"`
+	ReadOnly                    bool   `json:"readOnly" yaml:"readOnly"`
+}
+
+func (p *Persistence) SetDefaults() {
+	if p.SyncPolicy == "" {
+		p.SyncPolicy = "always"
+	}
+
+	if p.VectorIndexRecovery == "" {
+		p.VectorIndexRecovery = "auto"
+	}
+
+	if p.SyncEveryNWrites == nil {
+		p.SyncEveryNWrites = ptInt(100)
+	}
+
+	if p.CompactionMaxBytesPerSecond == nil {
+		p.CompactionMaxBytesPerSecond = ptInt(32 * 1024 * 1024)
+	}
+
+	if p.InitialMmapSizeBytes == nil {
+		p.InitialMmapSizeBytes = ptInt(0)
+	}
+
+	if p.OpenTimeoutSeconds == nil {
+		p.OpenTimeoutSeconds = ptInt(0)
+	}
 }
 
 func (p Persistence) Validate() error {
@@ -81,6 +770,36 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	switch p.SyncPolicy {
+	case "", "always", "everyN", "never":
+	default:
+		return fmt.Errorf("persistence.syncPolicy must be one of "+
+			"'always', 'everyN', 'never', got '%s'", p.SyncPolicy)
+	}
+
+	if p.SyncEveryNWrites != nil && *p.SyncEveryNWrites < 1 {
+		return fmt.Errorf("persistence.syncEveryNWrites must be at least 1")
+	}
+
+	switch p.VectorIndexRecovery {
+	case "", "off", "auto", "force":
+	default:
+		return fmt.Errorf("persistence.vectorIndexRecovery must be one of "+
+			"'off', 'auto', 'force', got '%s'", p.VectorIndexRecovery)
+	}
+
+	if p.CompactionMaxBytesPerSecond != nil && *p.CompactionMaxBytesPerSecond < 0 {
+		return fmt.Errorf("persistence.compactionMaxBytesPerSecond must not be negative")
+	}
+
+	if p.InitialMmapSizeBytes != nil && *p.InitialMmapSizeBytes < 0 {
+		return fmt.Errorf("persistence.initialMmapSizeBytes must not be negative")
+	}
+
+	if p.OpenTimeoutSeconds != nil && *p.OpenTimeoutSeconds < 0 {
+		return fmt.Errorf("persistence.openTimeoutSeconds must not be negative")
+	}
+
 	return nil
 }
 
@@ -89,11 +808,23 @@ func (v *VectorIndex) SetDefaults() {
 		v.NumberOfShards = ptInt(3)
 	}
 
+	if v.MaxDimensions == nil {
+		v.MaxDimensions = ptInt(65536)
+	}
+
 	if v.AutoExpandReplicas == nil {
 		v.AutoExpandReplicas = ptString("0-2")
 	}
 }
 
+func (v VectorIndex) Validate() error {
+	if v.MaxDimensions != nil && *v.MaxDimensions < 1 {
+		return fmt.Errorf("vector_index.maxDimensions must be at least 1")
+	}
+
+	return nil
+}
+
 // AnalyticsEngine represents an external analytics engine, such as Spark for
 // Janusgraph
 type AnalyticsEngine struct {
@@ -203,7 +934,66 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return fmt.Errorf("invalid config: %v", err)
 	}
 
+	if err := f.Config.Ingest.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.Audit.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.WriteRateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.Batch.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.RequestBody.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.RequestTimeout.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.Startup.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.SlowQueryLog.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.ObjectSize.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.Errors.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	if err := f.Config.VectorIndex.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
 	(&f.Config.VectorIndex).SetDefaults()
+	(&f.Config.Contextionary).SetDefaults()
+	(&f.Config.Events).SetDefaults()
+	(&f.Config.BatchIdempotency).SetDefaults()
+	(&f.Config.Startup).SetDefaults()
+	(&f.Config.Consistency).SetDefaults()
+	(&f.Config.Expiry).SetDefaults()
+	(&f.Config.Batch).SetDefaults()
+	(&f.Config.Ingest).SetDefaults()
+	(&f.Config.QueryDefaults).SetDefaults()
+	(&f.Config.QueryCache).SetDefaults()
+	(&f.Config.WriteRateLimit).SetDefaults()
+	(&f.Config.RequestTimeout).SetDefaults()
+	(&f.Config.RequestBody).SetDefaults()
+	(&f.Config.Persistence).SetDefaults()
+	(&f.Config.TextNormalization).SetDefaults()
 
 	if f.Config.Standalone {
 		if err := f.Config.Persistence.Validate(); err != nil {
@@ -241,6 +1031,10 @@ func (f *WeaviateConfig) parseConfigFile(file []byte, name string) (Config, erro
 	return config, nil
 }
 
+func ptBool(in bool) *bool {
+	return &in
+}
+
 func ptInt(in int) *int {
 	return &in
 }
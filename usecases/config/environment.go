@@ -12,6 +12,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -68,6 +69,38 @@ func FromEnv(config *Config) error {
 		if v := os.Getenv("PERSISTENCE_DATA_PATH"); v != "" {
 			config.Persistence.DataPath = v
 		}
+
+		if v := os.Getenv("PERSISTENCE_SYNC_POLICY"); v != "" {
+			config.Persistence.SyncPolicy = v
+		}
+
+		if err := parseOptionalInt("PERSISTENCE_SYNC_EVERY_N_WRITES",
+			&config.Persistence.SyncEveryNWrites); err != nil {
+			return err
+		}
+
+		if v := os.Getenv("PERSISTENCE_VECTOR_INDEX_RECOVERY"); v != "" {
+			config.Persistence.VectorIndexRecovery = v
+		}
+
+		if err := parseOptionalInt("PERSISTENCE_COMPACTION_MAX_BYTES_PER_SECOND",
+			&config.Persistence.CompactionMaxBytesPerSecond); err != nil {
+			return err
+		}
+
+		if err := parseOptionalInt("PERSISTENCE_INITIAL_MMAP_SIZE_BYTES",
+			&config.Persistence.InitialMmapSizeBytes); err != nil {
+			return err
+		}
+
+		if err := parseOptionalInt("PERSISTENCE_OPEN_TIMEOUT_SECONDS",
+			&config.Persistence.OpenTimeoutSeconds); err != nil {
+			return err
+		}
+
+		if enabled(os.Getenv("PERSISTENCE_READ_ONLY")) {
+			config.Persistence.ReadOnly = true
+		}
 	}
 
 	if v := os.Getenv("CONFIGURATION_STORAGE_URL"); v != "" {
@@ -82,6 +115,231 @@ func FromEnv(config *Config) error {
 		config.Contextionary.URL = v
 	}
 
+	if v := os.Getenv("CONTEXTIONARY_TLS_CA_FILE"); v != "" {
+		config.Contextionary.TLS.CAFile = v
+	}
+
+	if v := os.Getenv("CONTEXTIONARY_TLS_CERT_FILE"); v != "" {
+		config.Contextionary.TLS.CertFile = v
+	}
+
+	if v := os.Getenv("CONTEXTIONARY_TLS_KEY_FILE"); v != "" {
+		config.Contextionary.TLS.KeyFile = v
+	}
+
+	if v := os.Getenv("CONTEXTIONARY_TLS_SERVER_NAME"); v != "" {
+		config.Contextionary.TLS.ServerName = v
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_MAX_RETRIES",
+		&config.Contextionary.MaxRetries); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_RETRY_BACKOFF_MS",
+		&config.Contextionary.RetryBackoffMS); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_BREAKER_THRESHOLD",
+		&config.Contextionary.BreakerThreshold); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_BREAKER_COOLDOWN_SECONDS",
+		&config.Contextionary.BreakerCooldownSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_STARTUP_MAX_WAIT_SECONDS",
+		&config.Contextionary.StartupMaxWaitSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_CACHE_MAX_ENTRIES",
+		&config.Contextionary.Cache.MaxEntries); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_CACHE_TTL_SECONDS",
+		&config.Contextionary.Cache.TTLSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONTEXTIONARY_CACHE_VERSION_CHECK_SECONDS",
+		&config.Contextionary.Cache.VersionCheckSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("INGEST_MAX_BLOB_SIZE_BYTES",
+		&config.Ingest.MaxBlobSizeBytes); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("BATCH_VECTORIZATION_CONCURRENCY",
+		&config.Batch.VectorizationConcurrency); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("BATCH_MAX_CONCURRENT_REQUESTS",
+		&config.Batch.MaxConcurrentRequests); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("BATCH_QUEUE_TIMEOUT_SECONDS",
+		&config.Batch.QueueTimeoutSeconds); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("BATCH_DUPLICATE_IDS"); v != "" {
+		config.Batch.DuplicateIDs = v
+	}
+
+	if err := parseOptionalInt("EXPIRY_SWEEP_INTERVAL_SECONDS",
+		&config.Expiry.SweepIntervalSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("BATCH_IDEMPOTENCY_TTL_SECONDS",
+		&config.BatchIdempotency.TTLSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("BATCH_IDEMPOTENCY_LEASE_SECONDS",
+		&config.BatchIdempotency.LeaseSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("STARTUP_TIMEOUT_SECONDS",
+		&config.Startup.TimeoutSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("CONSISTENCY_STRONG_TIMEOUT_SECONDS",
+		&config.Consistency.StrongTimeoutSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("WRITE_RATE_LIMIT_OBJECTS_PER_SECOND",
+		&config.WriteRateLimit.ObjectsPerSecond); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("WRITE_RATE_LIMIT_PER_CLASS_OBJECTS_PER_SECOND",
+		&config.WriteRateLimit.PerClassObjectsPerSecond); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("WRITE_RATE_LIMIT_CLASS_OVERRIDES"); v != "" {
+		overrides, err := parseNamedLimits(v, "class")
+		if err != nil {
+			return errors.Wrapf(err, "parse WRITE_RATE_LIMIT_CLASS_OVERRIDES")
+		}
+
+		config.WriteRateLimit.ClassOverrides = overrides
+	}
+
+	if err := parseOptionalInt("REQUEST_TIMEOUT_DEFAULT_SECONDS",
+		&config.RequestTimeout.DefaultSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("REQUEST_TIMEOUT_BATCH_SECONDS",
+		&config.RequestTimeout.BatchSeconds); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("REQUEST_TIMEOUT_ROUTE_OVERRIDES"); v != "" {
+		overrides, err := parseNamedLimits(v, "route")
+		if err != nil {
+			return errors.Wrapf(err, "parse REQUEST_TIMEOUT_ROUTE_OVERRIDES")
+		}
+
+		config.RequestTimeout.RouteOverrides = overrides
+	}
+
+	if err := parseOptionalInt("REQUEST_BODY_MAX_DECOMPRESSED_BYTES",
+		&config.RequestBody.MaxDecompressedBytes); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("SLOW_QUERY_LOG_THRESHOLD_MS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse SLOW_QUERY_LOG_THRESHOLD_MS as int")
+		}
+
+		config.SlowQueryLog.ThresholdMilliseconds = asInt
+	}
+
+	if v := os.Getenv("OBJECT_SIZE_MAX_BYTES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse OBJECT_SIZE_MAX_BYTES as int")
+		}
+
+		config.ObjectSize.MaxBytes = asInt
+	}
+
+	if enabled(os.Getenv("QUERY_CACHE_ENABLED")) {
+		config.QueryCache.Enabled = true
+	}
+
+	if err := parseOptionalInt("QUERY_CACHE_TTL_SECONDS",
+		&config.QueryCache.TTLSeconds); err != nil {
+		return err
+	}
+
+	if err := parseOptionalInt("QUERY_CACHE_MAX_ENTRIES",
+		&config.QueryCache.MaxEntries); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("AUDIT_SINK"); v != "" {
+		config.Audit.Sink = v
+	}
+
+	if v := os.Getenv("AUDIT_FILE_PATH"); v != "" {
+		config.Audit.FilePath = v
+	}
+
+	if v := os.Getenv("ERRORS_DETAILS"); v != "" {
+		config.Errors.Details = v
+	}
+
+	if err := parseOptionalBool("TEXT_NORMALIZATION_LOWERCASE",
+		&config.TextNormalization.Lowercase); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("TEXT_NORMALIZATION_STRIP_PUNCTUATION")) {
+		config.TextNormalization.StripPunctuation = true
+	}
+
+	if enabled(os.Getenv("TEXT_NORMALIZATION_UNICODE_NFC")) {
+		config.TextNormalization.UnicodeNFC = true
+	}
+
+	if enabled(os.Getenv("TEXT_NORMALIZATION_REMOVE_STOPWORDS")) {
+		config.TextNormalization.RemoveStopwords = true
+	}
+
+	if v := os.Getenv("VECTORIZER_MODULE"); v != "" {
+		config.Vectorizer.Module = v
+	}
+
+	if enabled(os.Getenv("AUTOSCHEMA_ENABLED")) {
+		config.AutoSchema.Enabled = true
+	}
+
+	if v := os.Getenv("INGEST_TYPE_COERCION"); v != "" {
+		config.Ingest.TypeCoercion = v
+	}
+
+	if v := os.Getenv("TRACING_TRACER"); v != "" {
+		config.Tracing.Tracer = v
+	}
+
 	if v := os.Getenv("QUERY_DEFAULTS_LIMIT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -91,6 +349,24 @@ func FromEnv(config *Config) error {
 		config.QueryDefaults.Limit = int64(asInt)
 	}
 
+	if v := os.Getenv("QUERY_DEFAULTS_MAX_LIMIT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_DEFAULTS_MAX_LIMIT as int")
+		}
+
+		config.QueryDefaults.MaxLimit = int64(asInt)
+	}
+
+	if enabled(os.Getenv("QUERY_DEFAULTS_REJECT_EXCEEDING_LIMIT")) {
+		config.QueryDefaults.RejectExceedingLimit = true
+	}
+
+	if err := parseOptionalInt("QUERY_DEFAULTS_INTERPRETATION_CONTRIBUTIONS",
+		&config.QueryDefaults.InterpretationContributions); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("ESVECTOR_URL"); v != "" {
 		config.VectorIndex.URL = v
 
@@ -108,9 +384,68 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if err := parseOptionalInt("VECTOR_INDEX_MAX_DIMENSIONS",
+		&config.VectorIndex.MaxDimensions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseOptionalInt sets target only if the env var is present
+func parseOptionalInt(envName string, target **int) error {
+	v := os.Getenv(envName)
+	if v == "" {
+		return nil
+	}
+
+	asInt, err := strconv.Atoi(v)
+	if err != nil {
+		return errors.Wrapf(err, "parse %s as int", envName)
+	}
+
+	*target = &asInt
+	return nil
+}
+
+// parseOptionalBool sets target only if the env var is present
+func parseOptionalBool(envName string, target **bool) error {
+	v := os.Getenv(envName)
+	if v == "" {
+		return nil
+	}
+
+	asBool, err := strconv.ParseBool(v)
+	if err != nil {
+		return errors.Wrapf(err, "parse %s as bool", envName)
+	}
+
+	*target = &asBool
 	return nil
 }
 
+// parseNamedLimits parses a comma-separated list of name:limit pairs, such
+// as "Article:100,Paragraph:500". kind describes what the names are, such as
+// "class", and is used in errors.
+func parseNamedLimits(value, kind string) (map[string]int, error) {
+	limits := map[string]int{}
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("expected '%s:limit', got '%s'", kind, pair)
+		}
+
+		limit, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "limit of %s '%s'", kind, parts[0])
+		}
+
+		limits[parts[0]] = limit
+	}
+
+	return limits, nil
+}
+
 func enabled(value string) bool {
 	if value == "" {
 		return false
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Config controls delivery of events
+type Config struct {
+	// QueueSize is the maximum number of events waiting for (re-)delivery.
+	// Once the queue is full, new events are dropped.
+	QueueSize int
+
+	// MaxRetries is the number of additional delivery attempts after the
+	// first one failed
+	MaxRetries int
+
+	// InitialBackoff is the wait time before the first retry, it doubles with
+	// every subsequent retry
+	InitialBackoff time.Duration
+
+	// SendTimeout limits a single delivery attempt
+	SendTimeout time.Duration
+}
+
+type pending struct {
+	event   Event
+	attempt int
+}
+
+// Dispatcher delivers events asynchronously to a Sink. Emitting never
+// blocks the caller. Failed deliveries are retried with an exponential
+// backoff, so every event is delivered at least once, unless the queue
+// overflows or all retries are exhausted, both of which are logged.
+type Dispatcher struct {
+	sink   Sink
+	config Config
+	logger logrus.FieldLogger
+	queue  chan pending
+
+	// after schedules a retry, it can be replaced in tests
+	after func(d time.Duration, f func())
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	stopped  chan struct{}
+}
+
+// NewDispatcher for the specified sink, call Start to begin delivering
+func NewDispatcher(sink Sink, config Config, logger logrus.FieldLogger) *Dispatcher {
+	return &Dispatcher{
+		sink:   sink,
+		config: config,
+		logger: logger,
+		queue:  make(chan pending, config.QueueSize),
+		after: func(d time.Duration, f func()) {
+			time.AfterFunc(d, f)
+		},
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// Start delivering events in the background
+func (d *Dispatcher) Start() {
+	go d.work()
+}
+
+// Stop delivering events. Events which are still queued are discarded.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+	})
+	<-d.stopped
+}
+
+// Emit queues the event for delivery and returns immediately
+func (d *Dispatcher) Emit(event Event) {
+	d.enqueue(pending{event: event})
+}
+
+func (d *Dispatcher) enqueue(p pending) {
+	select {
+	case d.queue <- p:
+	default:
+		d.logger.WithField("action", "events_queue_full").
+			WithField("event", p.event).
+			Errorf("event queue is full, dropping %s event for %s", p.event.Operation, p.event.ID)
+	}
+}
+
+func (d *Dispatcher) work() {
+	defer close(d.stopped)
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case p := <-d.queue:
+			d.deliver(p)
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(p pending) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.SendTimeout)
+	defer cancel()
+
+	err := d.sink.Send(ctx, p.event)
+	if err == nil {
+		return
+	}
+
+	if p.attempt >= d.config.MaxRetries {
+		d.logger.WithField("action", "events_delivery_failed").
+			WithField("event", p.event).
+			WithError(err).
+			Errorf("giving up on delivering %s event for %s after %d attempt(s)",
+				p.event.Operation, p.event.ID, p.attempt+1)
+		return
+	}
+
+	backoff := d.config.InitialBackoff << uint(p.attempt)
+	d.logger.WithField("action", "events_delivery_retry").
+		WithField("event", p.event).
+		WithError(err).
+		Warnf("delivering %s event for %s failed, retrying in %s",
+			p.event.Operation, p.event.ID, backoff)
+
+	p.attempt++
+	d.after(backoff, func() {
+		d.enqueue(p)
+	})
+}
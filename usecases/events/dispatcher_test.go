@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher(t *testing.T) {
+	event := Event{
+		Kind:      "thing",
+		Class:     "Car",
+		ID:        "6b4b8e5c-4d3f-4b0a-a5f5-3b1b4bd1d0a1",
+		Operation: OperationCreate,
+		Timestamp: 1000,
+	}
+
+	newTestDispatcher := func(sink Sink, queueSize int) *Dispatcher {
+		logger, _ := test.NewNullLogger()
+		d := NewDispatcher(sink, Config{
+			QueueSize:      queueSize,
+			MaxRetries:     2,
+			InitialBackoff: time.Millisecond,
+			SendTimeout:    time.Second,
+		}, logger)
+		d.after = func(_ time.Duration, f func()) { f() }
+		return d
+	}
+
+	t.Run("an event is delivered", func(t *testing.T) {
+		sink := newFakeSink(0)
+		d := newTestDispatcher(sink, 10)
+		d.Start()
+		defer d.Stop()
+
+		d.Emit(event)
+
+		assert.Equal(t, event, sink.next(t))
+	})
+
+	t.Run("a failed delivery is retried", func(t *testing.T) {
+		sink := newFakeSink(2)
+		d := newTestDispatcher(sink, 10)
+		d.Start()
+		defer d.Stop()
+
+		d.Emit(event)
+
+		assert.Equal(t, event, sink.next(t))
+		assert.Equal(t, 3, sink.attempts())
+	})
+
+	t.Run("delivery is given up after all retries", func(t *testing.T) {
+		sink := newFakeSink(3)
+		d := newTestDispatcher(sink, 10)
+		d.Start()
+		defer d.Stop()
+
+		d.Emit(event)
+
+		assert.Eventually(t, func() bool { return sink.attempts() == 3 },
+			time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, 3, sink.attempts(), "no further attempts are made")
+		assert.Len(t, sink.delivered, 0)
+	})
+
+	t.Run("emitting does not block when the queue is full", func(t *testing.T) {
+		sink := newFakeSink(0)
+		// not started, so nothing is consumed from the queue
+		d := newTestDispatcher(sink, 1)
+
+		done := make(chan struct{})
+		go func() {
+			d.Emit(event)
+			d.Emit(event)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("emit blocked")
+		}
+		assert.Len(t, d.queue, 1)
+	})
+}
+
+type fakeSink struct {
+	sync.Mutex
+	failures  int
+	calls     int
+	delivered chan Event
+}
+
+func newFakeSink(failures int) *fakeSink {
+	return &fakeSink{failures: failures, delivered: make(chan Event, 10)}
+}
+
+func (f *fakeSink) Send(ctx context.Context, event Event) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.calls++
+	if f.calls <= f.failures {
+		return errors.New("downstream unavailable")
+	}
+
+	f.delivered <- event
+	return nil
+}
+
+func (f *fakeSink) attempts() int {
+	f.Lock()
+	defer f.Unlock()
+	return f.calls
+}
+
+func (f *fakeSink) next(t *testing.T) Event {
+	select {
+	case e := <-f.delivered:
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "no event delivered")
+		return Event{}
+	}
+}
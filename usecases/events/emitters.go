@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package events
+
+// Emitter receives events as they occur. Emit must not block the write
+// which lead to the event.
+type Emitter interface {
+	Emit(event Event)
+}
+
+// Emitters passes every event on to all of its members, in order
+type Emitters []Emitter
+
+// Emit the event to every member
+func (e Emitters) Emit(event Event) {
+	for _, emitter := range e {
+		emitter.Emit(event)
+	}
+}
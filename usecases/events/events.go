@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package events allows for changes to things and actions to be mirrored
+// into external systems. The kinds manager emits an Event for every
+// successful write, the Dispatcher delivers them asynchronously to a Sink.
+package events
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// Operation is the type of change which lead to an event
+type Operation string
+
+const (
+	// OperationCreate is emitted when a new thing or action was added
+	OperationCreate Operation = "create"
+	// OperationUpdate is emitted when an existing thing or action was
+	// replaced or merged
+	OperationUpdate Operation = "update"
+	// OperationDelete is emitted when a thing or action was deleted
+	OperationDelete Operation = "delete"
+	// OperationTruncate is emitted when all objects of a class were removed at
+	// once, the ID of the event is not set
+	OperationTruncate Operation = "truncate"
+)
+
+// Event describes a single change of a thing or action
+type Event struct {
+	Kind      kind.Kind   `json:"kind"`
+	Class     string      `json:"class"`
+	ID        strfmt.UUID `json:"id"`
+	Operation Operation   `json:"operation"`
+
+	// Timestamp of the change in ms since epoch
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Sink delivers a single event to a downstream system. Returning an error
+// marks the delivery as failed, it will then be retried. As a consequence a
+// sink may receive the same event more than once.
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
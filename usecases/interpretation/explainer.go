@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package interpretation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+// DefaultLimit is the number of contributing concepts per result, if no
+// explicit limit is configured
+const DefaultLimit = 5
+
+// Explainer adds the concepts which contributed most to a search result
+// matching the search vector
+type Explainer struct {
+	c11y  c11y
+	limit int
+}
+
+type c11y interface {
+	// MultiVectorForWord must keep order, if an item cannot be vectorized, the
+	// element should be explicit nil, not skipped
+	MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error)
+}
+
+// New Explainer which returns up to limit concepts per result. A limit
+// smaller than 1 falls back to DefaultLimit
+func New(c11y c11y, limit int) *Explainer {
+	if limit < 1 {
+		limit = DefaultLimit
+	}
+
+	return &Explainer{c11y: c11y, limit: limit}
+}
+
+// Explain scores every source concept of each result's interpretation by its
+// similarity to the search vector multiplied with its weight in the
+// vectorization of the object. The highest scoring concepts are set as the
+// interpretation's contributions. Results without an interpretation source
+// are left unchanged.
+func (e *Explainer) Explain(ctx context.Context, in []search.Result,
+	searchVector []float32) ([]search.Result, error) {
+	vectors, err := e.vectorsForSourceConcepts(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, res := range in {
+		if !hasSource(res) {
+			continue
+		}
+
+		contributions, err := e.contributions(res.UnderscoreProperties.Interpretation.Source,
+			vectors, searchVector)
+		if err != nil {
+			return nil, fmt.Errorf("result %d: %v", i, err)
+		}
+
+		in[i].UnderscoreProperties.Interpretation.Contributions = contributions
+	}
+
+	return in, nil
+}
+
+// vectorsForSourceConcepts retrieves the vectors for all concepts across all
+// results in a single call to the contextionary
+func (e *Explainer) vectorsForSourceConcepts(ctx context.Context,
+	in []search.Result) (map[string][]float32, error) {
+	var words []string
+	seen := map[string]struct{}{}
+	for _, res := range in {
+		if !hasSource(res) {
+			continue
+		}
+
+		for _, source := range res.UnderscoreProperties.Interpretation.Source {
+			if source == nil {
+				continue
+			}
+
+			if _, ok := seen[source.Concept]; ok {
+				continue
+			}
+
+			seen[source.Concept] = struct{}{}
+			words = append(words, source.Concept)
+		}
+	}
+
+	out := map[string][]float32{}
+	if len(words) == 0 {
+		return out, nil
+	}
+
+	vectors, err := e.c11y.MultiVectorForWord(ctx, words)
+	if err != nil {
+		return nil, fmt.Errorf("vectorize source concepts: %v", err)
+	}
+
+	if len(vectors) != len(words) {
+		return nil, fmt.Errorf("vectorize source concepts: expected %d vectors, got %d",
+			len(words), len(vectors))
+	}
+
+	for i, word := range words {
+		if vectors[i] == nil {
+			// not present in the contextionary, this concept cannot be scored
+			continue
+		}
+
+		out[word] = vectors[i]
+	}
+
+	return out, nil
+}
+
+func (e *Explainer) contributions(sources []*models.InterpretationSource,
+	vectors map[string][]float32,
+	searchVector []float32) ([]*models.InterpretationContribution, error) {
+	out := make([]*models.InterpretationContribution, 0, len(sources))
+	for _, source := range sources {
+		if source == nil {
+			continue
+		}
+
+		vector, ok := vectors[source.Concept]
+		if !ok {
+			continue
+		}
+
+		dist, err := libvectorizer.NormalizedDistance(vector, searchVector)
+		if err != nil {
+			return nil, fmt.Errorf("concept '%s': %v", source.Concept, err)
+		}
+
+		out = append(out, &models.InterpretationContribution{
+			Concept: source.Concept,
+			Weight:  float64(1-dist) * source.Weight,
+		})
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return out[a].Weight > out[b].Weight
+	})
+
+	if len(out) > e.limit {
+		out = out[:e.limit]
+	}
+
+	return out, nil
+}
+
+func hasSource(res search.Result) bool {
+	return res.UnderscoreProperties != nil &&
+		res.UnderscoreProperties.Interpretation != nil &&
+		len(res.UnderscoreProperties.Interpretation.Source) > 0
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package interpretation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainer(t *testing.T) {
+	c11y := &fakeC11y{
+		vectors: map[string][]float32{
+			"car":    {1, 0},
+			"engine": {0.9, 0.1},
+			"banana": {0, 1},
+		},
+	}
+
+	searchVector := []float32{1, 0}
+
+	t.Run("with a limit larger than the sources", func(t *testing.T) {
+		res, err := New(c11y, 5).Explain(context.Background(), inputResults(), searchVector)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		contributions := res[0].UnderscoreProperties.Interpretation.Contributions
+		require.Len(t, contributions, 3, "unknown concept is skipped")
+		assert.Equal(t, "engine", contributions[0].Concept)
+		assert.Equal(t, "car", contributions[1].Concept)
+		assert.Equal(t, "banana", contributions[2].Concept)
+		assert.InDelta(t, 1.5, contributions[1].Weight, 0.0001)
+		assert.InDelta(t, 1.0, contributions[2].Weight, 0.0001)
+
+		assert.Nil(t, res[1].UnderscoreProperties, "result without source is untouched")
+	})
+
+	t.Run("with a limit smaller than the sources", func(t *testing.T) {
+		res, err := New(c11y, 1).Explain(context.Background(), inputResults(), searchVector)
+		require.Nil(t, err)
+
+		contributions := res[0].UnderscoreProperties.Interpretation.Contributions
+		require.Len(t, contributions, 1)
+		assert.Equal(t, "engine", contributions[0].Concept)
+	})
+
+	t.Run("without a limit", func(t *testing.T) {
+		e := New(c11y, 0)
+		assert.Equal(t, DefaultLimit, e.limit)
+	})
+}
+
+func inputResults() []search.Result {
+	return []search.Result{
+		search.Result{
+			ClassName: "Car",
+			UnderscoreProperties: &models.UnderscoreProperties{
+				Interpretation: &models.Interpretation{
+					Source: []*models.InterpretationSource{
+						{Concept: "car", Weight: 1.5},
+						{Concept: "banana", Weight: 2},
+						{Concept: "engine", Weight: 3},
+						{Concept: "unknownword", Weight: 1},
+					},
+				},
+			},
+		},
+		search.Result{
+			ClassName: "Car",
+		},
+	}
+}
+
+type fakeC11y struct {
+	vectors map[string][]float32
+}
+
+func (f *fakeC11y) MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error) {
+	out := make([][]float32, len(words))
+	for i, word := range words {
+		out[i] = f.vectors[word]
+	}
+
+	return out, nil
+}
@@ -20,6 +20,9 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
@@ -36,6 +39,7 @@ type addRepo interface {
 
 type schemaManager interface {
 	UpdatePropertyAddDataType(context.Context, *models.Principal, kind.Kind, string, string, string) error
+	UpdateClassAddProperty(context.Context, *models.Principal, kind.Kind, string, *models.Property) error
 	GetSchema(principal *models.Principal) (schema.Schema, error)
 }
 
@@ -56,7 +60,9 @@ func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.addActionToConnectorAndSchema(ctx, principal, class)
+	action, err := m.addActionToConnectorAndSchema(ctx, principal, class)
+	m.audit(ctx, principal, audit.OperationCreate, kind.Action, class.Class, class.ID, err)
+	return action, err
 }
 
 func (m *Manager) checkIDOrAssignNew(ctx context.Context, kind kind.Kind,
@@ -70,8 +76,8 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, kind kind.Kind,
 	}
 
 	// only validate ID uniqueness if explicitly set
-	if ok, err := m.exists(ctx, kind, id); ok {
-		return "", NewErrInvalidUserInput("id '%s' already exists", id)
+	if ok, err := m.vectorRepo.Exists(ctx, id); ok {
+		return "", NewErrAlreadyExists("id '%s' already exists", id)
 	} else if err != nil {
 		return "", NewErrInternal(err.Error())
 	}
@@ -80,12 +86,37 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, kind kind.Kind,
 
 func (m *Manager) addActionToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	class *models.Action) (*models.Action, error) {
+	if err := m.checkNotFrozen(principal, kind.Action, class.Class); err != nil {
+		return nil, err
+	}
+
+	if err := validateObjectSize(m.config, class); err != nil {
+		return nil, err
+	}
+
+	if err := m.rateLimiter.Take(map[string]int{class.Class: 1}); err != nil {
+		return nil, err
+	}
+
+	if class.ID == "" {
+		id, err := m.idFromKeyProperty(principal, kind.Action, class.Class, class.Schema)
+		if err != nil {
+			return nil, err
+		}
+		class.ID = id
+	}
+
 	id, err := m.checkIDOrAssignNew(ctx, kind.Action, class.ID)
 	if err != nil {
 		return nil, err
 	}
 	class.ID = id
 
+	err = m.addAutoSchemaProperties(ctx, principal, kind.Action, class.Class, class.Schema)
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.validateAction(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid action: %v", err)
@@ -97,33 +128,72 @@ func (m *Manager) addActionToConnectorAndSchema(ctx context.Context, principal *
 	}
 
 	now := m.timeSource.Now()
+	if err := validateExpiry(class.ExpiryTimeUnix, now); err != nil {
+		return nil, err
+	}
 	class.CreationTimeUnix = now
 	class.LastUpdateTimeUnix = now
+	// the version is assigned by the repo, a version in the request is ignored
+	class.Version = 0
 
-	err = m.vectorizeAndPutAction(ctx, class)
+	err = m.vectorizeAndPutAction(ctx, class, nil)
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return nil, err
+		}
 		return nil, NewErrInternal("add action: %v", err)
 	}
 
+	m.emitEvent(kind.Action, class.Class, class.ID, events.OperationCreate)
+
 	class.Meta = nil
 	return class, nil
 }
 
-func (m *Manager) vectorizeAndPutAction(ctx context.Context, class *models.Action) error {
-	v, source, err := m.vectorizer.Action(ctx, class)
-	if err != nil {
-		return fmt.Errorf("vectorize: %v", err)
-	}
-
+// vectorizeAndPutAction stores the action along with its vector. On an update,
+// the previous version is passed in, so that its vector can be reused if
+// nothing that is vectorized has changed. Without a previous version the action
+// is created, which fails if its id has been taken in the meantime.
+func (m *Manager) vectorizeAndPutAction(ctx context.Context, class *models.Action,
+	previous *search.Result) error {
 	if class.Meta == nil {
 		class.Meta = &models.UnderscoreProperties{}
 	}
-	class.Meta.Interpretation = &models.Interpretation{
-		Source: sourceFromInputElements(source),
+
+	v := m.previousActionVector(ctx, previous, class)
+	if v != nil {
+		class.Meta.Interpretation = previousInterpretation(previous)
+	} else {
+		vector, source, err := m.vectorizer.Action(ctx, class)
+		if err != nil {
+			return fmt.Errorf("vectorize: %v", err)
+		}
+
+		if err := validateVectorDimensions(m.config, vector); err != nil {
+			return err
+		}
+
+		v = vector
+		class.Meta.Interpretation = &models.Interpretation{
+			Source: sourceFromInputElements(source),
+		}
 	}
 
-	err = m.vectorRepo.PutAction(ctx, class, v)
+	var err error
+	if previous == nil {
+		err = m.vectorRepo.CreateAction(ctx, class, v)
+	} else {
+		err = m.vectorRepo.PutAction(ctx, class, v)
+	}
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			// the id or a unique property is taken, the vector does not fit the class,
+			// the object has been changed since the expected version or the
+			// class doesn't accept writes right now
+			return err
+		}
 		return fmt.Errorf("store: %v", err)
 	}
 
@@ -157,8 +227,8 @@ func (m *Manager) validateAction(ctx context.Context, principal *models.Principa
 	return validation.New(s, m.exists, m.network, m.config).Action(ctx, class)
 }
 
-func (m *Manager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (bool, error) {
-	return m.vectorRepo.Exists(ctx, id)
+func (m *Manager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (string, bool, error) {
+	return m.vectorRepo.ObjectClass(ctx, k, id)
 }
 
 // AddThing Class Instance to the connected DB. If the class contains a network
@@ -178,17 +248,44 @@ func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.addThingToConnectorAndSchema(ctx, principal, class)
+	thing, err := m.addThingToConnectorAndSchema(ctx, principal, class)
+	m.audit(ctx, principal, audit.OperationCreate, kind.Thing, class.Class, class.ID, err)
+	return thing, err
 }
 
 func (m *Manager) addThingToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	class *models.Thing) (*models.Thing, error) {
+	if err := m.checkNotFrozen(principal, kind.Thing, class.Class); err != nil {
+		return nil, err
+	}
+
+	if err := validateObjectSize(m.config, class); err != nil {
+		return nil, err
+	}
+
+	if err := m.rateLimiter.Take(map[string]int{class.Class: 1}); err != nil {
+		return nil, err
+	}
+
+	if class.ID == "" {
+		id, err := m.idFromKeyProperty(principal, kind.Thing, class.Class, class.Schema)
+		if err != nil {
+			return nil, err
+		}
+		class.ID = id
+	}
+
 	id, err := m.checkIDOrAssignNew(ctx, kind.Thing, class.ID)
 	if err != nil {
 		return nil, err
 	}
 	class.ID = id
 
+	err = m.addAutoSchemaProperties(ctx, principal, kind.Thing, class.Class, class.Schema)
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.validateThing(ctx, principal, class)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid thing: %v", err)
@@ -200,33 +297,72 @@ func (m *Manager) addThingToConnectorAndSchema(ctx context.Context, principal *m
 	}
 
 	now := m.timeSource.Now()
+	if err := validateExpiry(class.ExpiryTimeUnix, now); err != nil {
+		return nil, err
+	}
 	class.CreationTimeUnix = now
 	class.LastUpdateTimeUnix = now
+	// the version is assigned by the repo, a version in the request is ignored
+	class.Version = 0
 
-	err = m.vectorizeAndPutThing(ctx, class)
+	err = m.vectorizeAndPutThing(ctx, class, nil)
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return nil, err
+		}
 		return nil, NewErrInternal("add thing: %v", err)
 	}
 
+	m.emitEvent(kind.Thing, class.Class, class.ID, events.OperationCreate)
+
 	class.Meta = nil
 	return class, nil
 }
 
-func (m *Manager) vectorizeAndPutThing(ctx context.Context, class *models.Thing) error {
-	v, source, err := m.vectorizer.Thing(ctx, class)
-	if err != nil {
-		return fmt.Errorf("vectorize: %v", err)
-	}
-
+// vectorizeAndPutThing stores the thing along with its vector. On an update,
+// the previous version is passed in, so that its vector can be reused if
+// nothing that is vectorized has changed. Without a previous version the thing
+// is created, which fails if its id has been taken in the meantime.
+func (m *Manager) vectorizeAndPutThing(ctx context.Context, class *models.Thing,
+	previous *search.Result) error {
 	if class.Meta == nil {
 		class.Meta = &models.UnderscoreProperties{}
 	}
-	class.Meta.Interpretation = &models.Interpretation{
-		Source: sourceFromInputElements(source),
+
+	v := m.previousThingVector(ctx, previous, class)
+	if v != nil {
+		class.Meta.Interpretation = previousInterpretation(previous)
+	} else {
+		vector, source, err := m.vectorizer.Thing(ctx, class)
+		if err != nil {
+			return fmt.Errorf("vectorize: %v", err)
+		}
+
+		if err := validateVectorDimensions(m.config, vector); err != nil {
+			return err
+		}
+
+		v = vector
+		class.Meta.Interpretation = &models.Interpretation{
+			Source: sourceFromInputElements(source),
+		}
 	}
 
-	err = m.vectorRepo.PutThing(ctx, class, v)
+	var err error
+	if previous == nil {
+		err = m.vectorRepo.CreateThing(ctx, class, v)
+	} else {
+		err = m.vectorRepo.PutThing(ctx, class, v)
+	}
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			// the id or a unique property is taken, the vector does not fit the class,
+			// the object has been changed since the expected version or the
+			// class doesn't accept writes right now
+			return err
+		}
 		return fmt.Errorf("store: %v", err)
 	}
 
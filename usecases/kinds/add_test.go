@@ -42,7 +42,7 @@ func Test_Add_Action(t *testing.T) {
 
 	reset := func() {
 		vectorRepo = &fakeVectorRepo{}
-		vectorRepo.On("PutAction", mock.Anything, mock.Anything).Return(nil).Once()
+		vectorRepo.On("CreateAction", mock.Anything, mock.Anything).Return(nil).Once()
 		schemaManager := &fakeSchemaManager{
 			GetSchemaResponse: schema,
 		}
@@ -106,7 +106,7 @@ func Test_Add_Action(t *testing.T) {
 		vectorRepo.On("Exists", id).Return(true, nil).Once()
 
 		_, err := manager.AddAction(ctx, nil, class)
-		assert.Equal(t, NewErrInvalidUserInput("id '%s' already exists", id), err)
+		assert.Equal(t, NewErrAlreadyExists("id '%s' already exists", id), err)
 	})
 
 	t.Run("with a uuid that's malformed", func(t *testing.T) {
@@ -144,7 +144,7 @@ func Test_Add_Thing(t *testing.T) {
 
 	reset := func() {
 		vectorRepo = &fakeVectorRepo{}
-		vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil).Once()
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(nil).Once()
 		schemaManager := &fakeSchemaManager{
 			GetSchemaResponse: schema,
 		}
@@ -208,7 +208,27 @@ func Test_Add_Thing(t *testing.T) {
 		vectorRepo.On("Exists", id).Return(true, nil).Once()
 
 		_, err := manager.AddThing(ctx, nil, class)
-		assert.Equal(t, NewErrInvalidUserInput("id '%s' already exists", id), err)
+		assert.Equal(t, NewErrAlreadyExists("id '%s' already exists", id), err)
+	})
+
+	t.Run("with a uuid that's taken by a concurrent create", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		class := &models.Thing{
+			ID:    id,
+			Class: "Foo",
+		}
+
+		vectorRepo.On("Exists", id).Return(false, nil).Once()
+		vectorRepo.ExpectedCalls = vectorRepo.ExpectedCalls[1:]
+		taken := NewErrAlreadyExists("id '%s' already exists", id)
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(taken).Once()
+
+		_, err := manager.AddThing(ctx, nil, class)
+		assert.Equal(t, taken, err)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
 	})
 
 	t.Run("with a uuid that's malformed", func(t *testing.T) {
@@ -226,4 +246,17 @@ func Test_Add_Thing(t *testing.T) {
 		_, err := manager.AddThing(ctx, nil, class)
 		assert.Equal(t, NewErrInvalidUserInput("invalid thing: uuid: incorrect UUID length: %s", id), err)
 	})
+
+	t.Run("with a value that violates a unique property", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		violation := NewErrAlreadyExists("property 'email' must be unique, but another " +
+			"object already has the value 'alice@example.com'")
+		vectorRepo.ExpectedCalls = nil
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(violation).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "Foo"})
+		assert.Equal(t, violation, err)
+	})
 }
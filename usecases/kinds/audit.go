@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/sirupsen/logrus"
+)
+
+type auditSink interface {
+	Write(entry audit.Entry) error
+}
+
+type noopAuditSink struct{}
+
+func (n noopAuditSink) Write(entry audit.Entry) error { return nil }
+
+// SetAuditSink enables audit logging of every authorized create, update,
+// delete or reference change of a thing or action, regardless of whether it
+// succeeded
+func (m *Manager) SetAuditSink(sink auditSink) {
+	m.auditSink = sink
+}
+
+// audit must only be called once the principal was authorized for the
+// operation. A failing sink does not fail the request, but is logged.
+func (m *Manager) audit(ctx context.Context, principal *models.Principal,
+	op audit.Operation, k kind.Kind, className string, id strfmt.UUID, opErr error) {
+	entry := audit.NewEntry(principal, op, k, className, id, opErr)
+	writeAuditEntry(ctx, m.auditSink, m.timeSource, m.logger, entry)
+}
+
+// SetAuditSink enables audit logging of every item of an authorized batch
+// import or batch reference add, regardless of whether it succeeded
+func (b *BatchManager) SetAuditSink(sink auditSink) {
+	b.auditSink = sink
+}
+
+// auditThings writes one entry per item of the batch. If batchErr is set the
+// whole batch failed and it is recorded for every item instead of item.Err.
+func (b *BatchManager) auditThings(ctx context.Context, principal *models.Principal,
+	classes []*models.Thing, items BatchThings, batchErr error) {
+	for _, item := range items {
+		b.audit(ctx, principal, audit.OperationCreate, kind.Thing,
+			classes[item.OriginalIndex].Class, item.UUID, itemOrBatchErr(item.Err, batchErr))
+	}
+}
+
+func (b *BatchManager) auditActions(ctx context.Context, principal *models.Principal,
+	classes []*models.Action, items BatchActions, batchErr error) {
+	for _, item := range items {
+		b.audit(ctx, principal, audit.OperationCreate, kind.Action,
+			classes[item.OriginalIndex].Class, item.UUID, itemOrBatchErr(item.Err, batchErr))
+	}
+}
+
+// auditReferences records the source object of each reference. A reference
+// whose source could not be parsed has no source and is recorded without it.
+func (b *BatchManager) auditReferences(ctx context.Context, principal *models.Principal,
+	items BatchReferences, batchErr error) {
+	for _, item := range items {
+		var (
+			k         kind.Kind
+			className string
+			id        strfmt.UUID
+		)
+		if item.From != nil {
+			k, className, id = item.From.Kind, item.From.Class.String(), item.From.TargetID
+		}
+
+		b.audit(ctx, principal, audit.OperationAddReference, k, className, id,
+			itemOrBatchErr(item.Err, batchErr))
+	}
+}
+
+func (b *BatchManager) audit(ctx context.Context, principal *models.Principal,
+	op audit.Operation, k kind.Kind, className string, id strfmt.UUID, opErr error) {
+	entry := audit.NewEntry(principal, op, k, className, id, opErr)
+	writeAuditEntry(ctx, b.auditSink, b.timeSource, b.logger, entry)
+}
+
+func itemOrBatchErr(itemErr, batchErr error) error {
+	if batchErr != nil {
+		return batchErr
+	}
+
+	return itemErr
+}
+
+func writeAuditEntry(ctx context.Context, sink auditSink, ts timeSource,
+	logger logrus.FieldLogger, entry audit.Entry) {
+	entry.Timestamp = ts.Now()
+	entry.RequestID = requestid.FromContext(ctx)
+
+	if err := sink.Write(entry); err != nil {
+		requestid.Logger(ctx, logger).WithField("action", "audit_write_failed").
+			WithField("entry", entry).
+			WithError(err).
+			Errorf("could not write audit entry for %s of %s %s",
+				entry.Operation, entry.Kind, entry.ID)
+	}
+}
@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuditLog(t *testing.T) {
+	var (
+		manager    *Manager
+		vectorRepo *fakeVectorRepo
+		sink       *fakeAuditSink
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	principal := &models.Principal{Username: "jane", Groups: []string{"editors"}}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "MyThing",
+		}, nil).Once()
+		schemaManager := &fakeSchemaManager{}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer := &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer, vectorizer, vectorRepo, extender, projector)
+		manager.timeSource = fakeTimeSource{}
+		sink = &fakeAuditSink{}
+		manager.SetAuditSink(sink)
+	}
+
+	t.Run("a successful delete is audited", func(t *testing.T) {
+		reset()
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(nil).Once()
+
+		err := manager.DeleteThing(context.Background(), principal, id)
+
+		assert.Nil(t, err)
+		expected := []audit.Entry{{
+			Timestamp: fakeTimeSource{}.Now(),
+			Username:  "jane",
+			Groups:    []string{"editors"},
+			Operation: audit.OperationDelete,
+			Kind:      kind.Thing,
+			Class:     "MyThing",
+			ID:        id,
+		}}
+		assert.Equal(t, expected, sink.written)
+	})
+
+	t.Run("a failed delete is audited with the error", func(t *testing.T) {
+		reset()
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(errors.New("oops")).Once()
+
+		err := manager.DeleteThing(context.Background(), nil, id)
+
+		assert.NotNil(t, err)
+		expected := []audit.Entry{{
+			Timestamp: fakeTimeSource{}.Now(),
+			Username:  audit.AnonymousUsername,
+			Operation: audit.OperationDelete,
+			Kind:      kind.Thing,
+			Class:     "MyThing",
+			ID:        id,
+			Error:     "could not delete thing from vector repo: oops",
+		}}
+		assert.Equal(t, expected, sink.written)
+	})
+
+	t.Run("a rejected reference update is audited", func(t *testing.T) {
+		reset()
+
+		err := manager.BulkUpdateThingReferences(context.Background(), principal, id,
+			models.PropertyReferences{"hasFriends": models.MultipleRef{}})
+
+		assert.NotNil(t, err)
+		assert.Len(t, sink.written, 1)
+		assert.Equal(t, audit.OperationUpdateReferences, sink.written[0].Operation)
+		assert.Equal(t, "MyThing", sink.written[0].Class)
+		assert.Equal(t, err.Error(), sink.written[0].Error)
+	})
+
+	t.Run("the request id is audited", func(t *testing.T) {
+		reset()
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(nil).Once()
+
+		ctx := requestid.ContextWithID(context.Background(), "import-42")
+		err := manager.DeleteThing(ctx, principal, id)
+
+		assert.Nil(t, err)
+		assert.Len(t, sink.written, 1)
+		assert.Equal(t, "import-42", sink.written[0].RequestID)
+	})
+
+	t.Run("a failing sink does not fail the request", func(t *testing.T) {
+		reset()
+		sink.err = errors.New("disk full")
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(nil).Once()
+
+		err := manager.DeleteThing(context.Background(), principal, id)
+
+		assert.Nil(t, err)
+	})
+}
+
+func Test_AuditLog_Batch(t *testing.T) {
+	var (
+		manager    *BatchManager
+		vectorRepo *fakeVectorRepo
+		sink       *fakeAuditSink
+	)
+
+	principal := &models.Principal{Username: "jane"}
+	fooSchema := zooAnimalSchemaForTest()
+	fooSchema.Things.Classes = append(fooSchema.Things.Classes, &models.Class{Class: "Foo"})
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: fooSchema}, nil,
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+		manager.timeSource = fakeTimeSource{}
+		sink = &fakeAuditSink{}
+		manager.SetAuditSink(sink)
+	}
+
+	things := func() []*models.Thing {
+		return []*models.Thing{
+			{Class: "Foo", ID: "2d3942c3-b412-4d80-9dfa-99a646629cd2"},
+			{Class: "Bar", ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff6"},
+		}
+	}
+
+	t.Run("every item of a batch import is audited", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectClass", mock.Anything, mock.Anything).Return("", false, nil)
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddThings(context.Background(), principal, things(), nil)
+
+		assert.Nil(t, err)
+		assert.Len(t, sink.written, 2)
+		for i, entry := range sink.written {
+			assert.Equal(t, audit.OperationCreate, entry.Operation)
+			assert.Equal(t, kind.Thing, entry.Kind)
+			assert.Equal(t, "jane", entry.Username)
+			assert.Equal(t, things()[res[i].OriginalIndex].Class, entry.Class)
+			assert.Equal(t, res[i].UUID, entry.ID)
+			if res[i].Err != nil {
+				assert.Equal(t, res[i].Err.Error(), entry.Error)
+			} else {
+				assert.Empty(t, entry.Error)
+			}
+		}
+		require.Len(t, res, 2)
+		assert.True(t, res[0].Err != nil || res[1].Err != nil,
+			"the item with the unknown class is audited with its error")
+	})
+
+	t.Run("a failed batch import is audited for every item", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectClass", mock.Anything, mock.Anything).Return("", false, nil)
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(errors.New("oops")).Once()
+
+		_, err := manager.AddThings(context.Background(), principal, things(), nil)
+
+		assert.NotNil(t, err)
+		assert.Len(t, sink.written, 2)
+		for _, entry := range sink.written {
+			assert.Equal(t, err.Error(), entry.Error)
+		}
+	})
+
+	t.Run("a failed batch reference add is audited for every reference", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectClass", kind.Thing, mock.Anything).Return("Animal", true, nil)
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(errors.New("oops")).Once()
+		source := strfmt.UUID("3a3f6cd5-5e4a-4d45-9a2e-c3b1bd5c2f10")
+
+		_, err := manager.AddReferences(context.Background(), principal,
+			[]*models.BatchReference{{
+				From: strfmt.URI("weaviate://localhost/things/Zoo/" + source + "/hasAnimals"),
+				To:   "weaviate://localhost/things/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+			}})
+
+		assert.NotNil(t, err)
+		expected := []audit.Entry{{
+			Timestamp: fakeTimeSource{}.Now(),
+			Username:  "jane",
+			Operation: audit.OperationAddReference,
+			Kind:      kind.Thing,
+			Class:     "Zoo",
+			ID:        source,
+			Error:     err.Error(),
+		}}
+		assert.Equal(t, expected, sink.written)
+	})
+}
+
+type fakeAuditSink struct {
+	written []audit.Entry
+	err     error
+}
+
+func (f *fakeAuditSink) Write(entry audit.Entry) error {
+	f.written = append(f.written, entry)
+	return f.err
+}
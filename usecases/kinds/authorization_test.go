@@ -12,6 +12,7 @@
 package kinds
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
@@ -67,13 +69,25 @@ func Test_Kinds_Authorization(t *testing.T) {
 		},
 		testCase{
 			methodName:       "GetThing",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}, ExpandParams{}},
 			expectedVerb:     "get",
 			expectedResource: "things/foo",
 		},
+		testCase{
+			methodName:       "GetThingReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), false},
+			expectedVerb:     "get",
+			expectedResource: "things/foo",
+		},
+		testCase{
+			methodName:       "GetActionReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), false},
+			expectedVerb:     "get",
+			expectedResource: "actions/foo",
+		},
 		testCase{
 			methodName:       "GetAction",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.UnderscoreProperties{}, ExpandParams{}},
 			expectedVerb:     "get",
 			expectedResource: "actions/foo",
 		},
@@ -97,7 +111,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 		},
 		testCase{
 			methodName:       "MergeThing",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil), false},
 			expectedVerb:     "update",
 			expectedResource: "things/foo",
 		},
@@ -108,26 +122,112 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResource: "actions/foo",
 		},
 		testCase{
-			methodName:       "MergeAction",
+			methodName:       "UpdateThingChangedFields",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
+			expectedVerb:     "update",
+			expectedResource: "things/foo",
+		},
+		testCase{
+			methodName:       "UpdateActionChangedFields",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil)},
 			expectedVerb:     "update",
 			expectedResource: "actions/foo",
 		},
+		testCase{
+			methodName:       "MergeAction",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil), false},
+			expectedVerb:     "update",
+			expectedResource: "actions/foo",
+		},
 
 		// list kinds
 		testCase{
 			methodName:       "GetThings",
-			additionalArgs:   []interface{}{(*int64)(nil), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{(*int64)(nil), "", (*filters.LocalFilter)(nil), traverser.UnderscoreProperties{}},
 			expectedVerb:     "list",
 			expectedResource: "things",
 		},
 		testCase{
 			methodName:       "GetActions",
-			additionalArgs:   []interface{}{(*int64)(nil), traverser.UnderscoreProperties{}},
+			additionalArgs:   []interface{}{(*int64)(nil), "", (*filters.LocalFilter)(nil), traverser.UnderscoreProperties{}},
+			expectedVerb:     "list",
+			expectedResource: "actions",
+		},
+		testCase{
+			methodName: "StreamThings",
+			additionalArgs: []interface{}{(*int64)(nil), "", (*filters.LocalFilter)(nil),
+				traverser.UnderscoreProperties{}, (func(*models.Thing) error)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "things",
+		},
+		testCase{
+			methodName: "StreamActions",
+			additionalArgs: []interface{}{(*int64)(nil), "", (*filters.LocalFilter)(nil),
+				traverser.UnderscoreProperties{}, (func(*models.Action) error)(nil)},
 			expectedVerb:     "list",
 			expectedResource: "actions",
 		},
 
+		testCase{
+			methodName:       "CountThings",
+			additionalArgs:   []interface{}{"", (*filters.LocalFilter)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "things",
+		},
+		testCase{
+			methodName:       "CountActions",
+			additionalArgs:   []interface{}{"", (*filters.LocalFilter)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "actions",
+		},
+		testCase{
+			methodName:       "GetThingsAfter",
+			additionalArgs:   []interface{}{(*int64)(nil), "", strfmt.UUID("")},
+			expectedVerb:     "list",
+			expectedResource: "things",
+		},
+		testCase{
+			methodName:       "GetActionsAfter",
+			additionalArgs:   []interface{}{(*int64)(nil), "", strfmt.UUID("")},
+			expectedVerb:     "list",
+			expectedResource: "actions",
+		},
+
+		// batch get kinds by ids
+		testCase{
+			methodName:       "GetThingsByIDs",
+			additionalArgs:   []interface{}{[]strfmt.UUID{"foo"}, traverser.UnderscoreProperties{}, BatchExpandParams{}},
+			expectedVerb:     "get",
+			expectedResource: "things/foo",
+		},
+		testCase{
+			methodName:       "GetActionsByIDs",
+			additionalArgs:   []interface{}{[]strfmt.UUID{"foo"}, traverser.UnderscoreProperties{}, BatchExpandParams{}},
+			expectedVerb:     "get",
+			expectedResource: "actions/foo",
+		},
+		testCase{
+			methodName:       "AwaitThing",
+			additionalArgs:   []interface{}{strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")},
+			expectedVerb:     "get",
+			expectedResource: "things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+		},
+		testCase{
+			methodName:       "AwaitAction",
+			additionalArgs:   []interface{}{strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")},
+			expectedVerb:     "get",
+			expectedResource: "actions/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+		},
+		testCase{
+			methodName: "ResolveBeacons",
+			additionalArgs: []interface{}{
+				[]strfmt.URI{"weaviate://localhost/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+				traverser.UnderscoreProperties{},
+			},
+			expectedVerb:     "get",
+			expectedResource: "things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+		},
+
 		// reference on kinds
 		testCase{
 			methodName:       "AddThingReference",
@@ -165,6 +265,88 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "actions/foo",
 		},
+		testCase{
+			methodName:       "BulkUpdateThingReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (models.PropertyReferences)(nil)},
+			expectedVerb:     "update",
+			expectedResource: "things/foo",
+		},
+		testCase{
+			methodName:       "BulkUpdateActionReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (models.PropertyReferences)(nil)},
+			expectedVerb:     "update",
+			expectedResource: "actions/foo",
+		},
+		testCase{
+			methodName:       "ReplaceThingReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (models.PropertyReferences)(nil), false},
+			expectedVerb:     "update",
+			expectedResource: "things/foo",
+		},
+		testCase{
+			methodName:       "ReplaceActionReferences",
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), (models.PropertyReferences)(nil), false},
+			expectedVerb:     "update",
+			expectedResource: "actions/foo",
+		},
+
+		// reindex
+		testCase{
+			methodName:       "ReindexClass",
+			additionalArgs:   []interface{}{"Foo", false},
+			expectedVerb:     "update",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "GetReindexStatus",
+			additionalArgs:   []interface{}{"Foo"},
+			expectedVerb:     "get",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "GetClassCounts",
+			additionalArgs:   []interface{}{},
+			expectedVerb:     "get",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "TruncateClass",
+			additionalArgs:   []interface{}{"Foo"},
+			expectedVerb:     "delete",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "CompactStorage",
+			additionalArgs:   []interface{}{},
+			expectedVerb:     "update",
+			expectedResource: "storage/*",
+		},
+		testCase{
+			methodName:       "SnapshotShard",
+			additionalArgs:   []interface{}{"Foo", "single", &bytes.Buffer{}},
+			expectedVerb:     "get",
+			expectedResource: "storage/*",
+		},
+		testCase{
+			methodName:       "RestoreShard",
+			additionalArgs:   []interface{}{"Foo", "single", &bytes.Buffer{}},
+			expectedVerb:     "update",
+			expectedResource: "storage/*",
+		},
+
+		// reshard
+		testCase{
+			methodName:       "ReshardClass",
+			additionalArgs:   []interface{}{"Foo", models.ShardingConfig{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "GetReshardStatus",
+			additionalArgs:   []interface{}{"Foo"},
+			expectedVerb:     "get",
+			expectedResource: "schema/*",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -174,6 +356,12 @@ func Test_Kinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Manager{}) {
+			switch method {
+			case "SetEventEmitter", "SetAuditSink", "SetWriteRateLimiter", "SetStorageCompactor",
+				"SetReshardRepo", "ResumeReshards":
+				// not user facing, only called during startup
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -230,12 +418,47 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedResource: "batch/things",
 		},
 
+		testCase{
+			methodName:       "AddActionsWithIdempotencyKey",
+			additionalArgs:   []interface{}{[]*models.Action{}, []*string{}, "key"},
+			expectedVerb:     "create",
+			expectedResource: "batch/actions",
+		},
+
+		testCase{
+			methodName:       "AddThingsWithIdempotencyKey",
+			additionalArgs:   []interface{}{[]*models.Thing{}, []*string{}, "key"},
+			expectedVerb:     "create",
+			expectedResource: "batch/things",
+		},
+
+		testCase{
+			methodName:       "ValidateActions",
+			additionalArgs:   []interface{}{[]*models.Action{}, []*string{}, false},
+			expectedVerb:     "validate",
+			expectedResource: "batch/actions",
+		},
+
+		testCase{
+			methodName:       "ValidateThings",
+			additionalArgs:   []interface{}{[]*models.Thing{}, []*string{}, false},
+			expectedVerb:     "validate",
+			expectedResource: "batch/things",
+		},
+
 		testCase{
 			methodName:       "AddReferences",
 			additionalArgs:   []interface{}{[]*models.BatchReference{}},
 			expectedVerb:     "update",
 			expectedResource: "batch/*",
 		},
+
+		testCase{
+			methodName:       "ValidateReferences",
+			additionalArgs:   []interface{}{[]*models.BatchReference{}},
+			expectedVerb:     "validate",
+			expectedResource: "batch/references",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -245,6 +468,11 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&BatchManager{}) {
+			switch method {
+			case "SetIdempotencyStore", "SetEventEmitter", "SetWriteRateLimiter", "SetAuditSink":
+				// not user facing, only called during startup
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
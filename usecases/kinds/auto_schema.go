@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// addAutoSchemaProperties adds every property of the object which is missing
+// from its class, if auto schema is enabled. The data type of a new property
+// is inferred from its value. Make sure this is only called while the schema
+// lock is held, so that concurrent imports can not add conflicting properties.
+func (m *Manager) addAutoSchemaProperties(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, props interface{}) error {
+	if m.config == nil || !m.config.Config.AutoSchema.Enabled || props == nil {
+		return nil
+	}
+
+	propsMap, ok := props.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return NewErrInternal("auto schema: %v", err)
+	}
+
+	class := s.GetClass(k, schema.ClassName(className))
+	if class == nil {
+		// the class must exist already, validation will report it
+		return nil
+	}
+
+	for _, propName := range sortedPropNames(propsMap) {
+		if _, err := schema.GetPropertyByName(class, propName); err == nil {
+			continue
+		}
+
+		dataType, err := m.inferDataType(ctx, propsMap[propName])
+		if err != nil {
+			return NewErrInvalidUserInput("auto schema: property '%s': %v", propName, err)
+		}
+
+		err = m.schemaManager.UpdateClassAddProperty(ctx, principal, k, className,
+			&models.Property{Name: propName, DataType: dataType})
+		if err != nil {
+			return NewErrInvalidUserInput("auto schema: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) inferDataType(ctx context.Context, value interface{}) ([]string, error) {
+	switch typed := value.(type) {
+	case string:
+		if _, err := time.Parse(time.RFC3339, typed); err == nil {
+			return []string{string(schema.DataTypeDate)}, nil
+		}
+		return []string{string(schema.DataTypeString)}, nil
+	case float64, json.Number, int, int64:
+		return []string{string(schema.DataTypeNumber)}, nil
+	case bool:
+		return []string{string(schema.DataTypeBoolean)}, nil
+	case []interface{}:
+		return m.inferReferenceDataType(ctx, typed)
+	default:
+		return nil, fmt.Errorf("can not infer a data type from a value of type %T", value)
+	}
+}
+
+// inferReferenceDataType uses the classes of all referenced objects as the
+// data type of the reference property
+func (m *Manager) inferReferenceDataType(ctx context.Context,
+	refs []interface{}) ([]string, error) {
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("can not infer a data type from an empty list")
+	}
+
+	var classes []string
+	for _, ref := range refs {
+		refMap, ok := ref.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("can not infer a data type from a list of %T", ref)
+		}
+
+		beacon, ok := refMap["beacon"].(string)
+		if !ok {
+			return nil, fmt.Errorf("reference has no beacon")
+		}
+
+		parsed, err := crossref.Parse(beacon)
+		if err != nil {
+			return nil, err
+		}
+
+		if !parsed.Local {
+			return nil, fmt.Errorf("can not infer the class of network reference '%s'", beacon)
+		}
+
+		className, ok, err := m.vectorRepo.ObjectClass(ctx, parsed.Kind, parsed.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("find referenced %s: %v", parsed.Kind.Name(), err)
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("referenced %s '%s' does not exist",
+				parsed.Kind.Name(), parsed.TargetID)
+		}
+
+		if !dataTypeContains(classes, className) {
+			classes = append(classes, className)
+		}
+	}
+
+	sort.Strings(classes)
+	return classes, nil
+}
+
+func dataTypeContains(haystack []string, needle string) bool {
+	for _, hay := range haystack {
+		if hay == needle {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedPropNames(props map[string]interface{}) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AutoSchema(t *testing.T) {
+	var (
+		vectorRepo    *fakeVectorRepo
+		schemaManager *fakeSchemaManager
+		manager       *Manager
+	)
+
+	friendID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	reset := func(enabled bool) {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(nil).Once()
+		vectorRepo.On("Exists", friendID).Return(true, nil)
+		vectorRepo.On("ObjectClass", kind.Thing, friendID).Return("Person", true, nil)
+		schemaManager = &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class: "Person",
+							Properties: []*models.Property{
+								{Name: "name", DataType: []string{"string"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		cfg := &config.WeaviateConfig{
+			Config: config.Config{AutoSchema: config.AutoSchema{Enabled: enabled}},
+		}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{}, cfg, logger,
+			&fakeAuthorizer{}, vectorizer, vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	t.Run("missing properties are added with inferred data types", func(t *testing.T) {
+		reset(true)
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class: "Person",
+			Schema: map[string]interface{}{
+				"name":   "John",
+				"age":    float64(42),
+				"active": true,
+				"born":   "1978-06-01T00:00:00Z",
+				"friends": []interface{}{
+					map[string]interface{}{
+						"beacon": "weaviate://localhost/things/" + friendID.String(),
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []*models.Property{
+			{Name: "active", DataType: []string{"boolean"}},
+			{Name: "age", DataType: []string{"number"}},
+			{Name: "born", DataType: []string{"date"}},
+			{Name: "friends", DataType: []string{"Person"}},
+		}, schemaManager.AddedProperties)
+	})
+
+	t.Run("a conflicting data type is rejected", func(t *testing.T) {
+		reset(true)
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Person",
+			Schema: map[string]interface{}{"age": float64(42)},
+		})
+		require.Nil(t, err)
+
+		_, err = manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Person",
+			Schema: map[string]interface{}{"age": "forty-two"},
+		})
+		require.NotNil(t, err)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok)
+		assert.Len(t, schemaManager.AddedProperties, 1)
+	})
+
+	t.Run("a value without a recognizable data type", func(t *testing.T) {
+		reset(true)
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class: "Person",
+			Schema: map[string]interface{}{
+				"location": map[string]interface{}{"latitude": 52.0},
+			},
+		})
+
+		assert.Equal(t, NewErrInvalidUserInput("auto schema: property 'location': "+
+			"can not infer a data type from a value of type map[string]interface {}"), err)
+	})
+
+	t.Run("with auto schema turned off", func(t *testing.T) {
+		reset(false)
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Person",
+			Schema: map[string]interface{}{"age": float64(42)},
+		})
+
+		assert.NotNil(t, err)
+		assert.Len(t, schemaManager.AddedProperties, 0)
+	})
+}
@@ -13,7 +13,6 @@ package kinds
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -24,7 +23,6 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
-	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // AddActions Class Instances in batch to the connected DB
@@ -52,16 +50,33 @@ func (b *BatchManager) addActions(ctx context.Context, principal *models.Princip
 		return nil, NewErrInvalidUserInput("invalid param 'actions': %v", err)
 	}
 
-	batchActions := b.validateActionsConcurrently(ctx, principal, classes, fields)
+	classNames := make([]string, len(classes))
+	for i, class := range classes {
+		classNames[i] = class.Class
+	}
+	if err := b.checkBatchNotFrozen(principal, kind.Action, classNames); err != nil {
+		return nil, err
+	}
+
+	if err := b.rateLimiter.Take(countClassNames(classNames)); err != nil {
+		return nil, err
+	}
+
+	batchActions := b.validateActionsConcurrently(ctx, principal, classes, fields, true)
 
 	var (
 		res BatchActions
 		err error
 	)
 	if res, err = b.vectorRepo.BatchPutActions(ctx, batchActions); err != nil {
-		return nil, NewErrInternal("batch actions: %#v", err)
+		err = NewErrInternal("batch actions: %#v", err)
+		b.auditActions(ctx, principal, classes, batchActions, err)
+		return nil, err
 	}
 
+	b.auditActions(ctx, principal, classes, res, nil)
+	b.emitActionEvents(classes, res)
+
 	return res, nil
 }
 
@@ -74,25 +89,38 @@ func (b *BatchManager) validateActionForm(classes []*models.Action) error {
 }
 
 func (b *BatchManager) validateActionsConcurrently(ctx context.Context, principal *models.Principal,
-	classes []*models.Action, fields []*string) BatchActions {
+	classes []*models.Action, fields []*string, vectorize bool) BatchActions {
 	fieldsToKeep := determineResponseFields(fields)
 	c := make(chan BatchAction, len(classes))
 
 	wg := new(sync.WaitGroup)
+	workers := make(chan struct{}, b.vectorizationConcurrency)
 
-	// Generate a goroutine for each separate request
+	// Generate a goroutine for each separate request, but never run more than
+	// the configured amount at once
 	for i, action := range classes {
 		wg.Add(1)
-		b.validateAction(ctx, principal, wg, action, i, &c, fieldsToKeep)
+		workers <- struct{}{}
+		go func(i int, action *models.Action) {
+			defer func() { <-workers }()
+			b.validateAction(ctx, principal, wg, action, i, &c, fieldsToKeep, vectorize)
+		}(i, action)
 	}
 
 	wg.Wait()
 	close(c)
-	return actionsChanToSlice(c)
+	batch := actionsChanToSlice(c)
+
+	if vectorize {
+		b.vectorizeActions(ctx, batch)
+	}
+
+	return batch
 }
 
 func (b *BatchManager) validateAction(ctx context.Context, principal *models.Principal,
-	wg *sync.WaitGroup, concept *models.Action, originalIndex int, resultsC *chan BatchAction, fieldsToKeep map[string]int) {
+	wg *sync.WaitGroup, concept *models.Action, originalIndex int, resultsC *chan BatchAction, fieldsToKeep map[string]int,
+	vectorize bool) {
 	defer wg.Done()
 
 	var (
@@ -101,21 +129,26 @@ func (b *BatchManager) validateAction(ctx context.Context, principal *models.Pri
 
 	ec := &errorCompounder{}
 
+	// Validate schema given in body with the weaviate schema
+	s, err := b.schemaManager.GetSchema(principal)
+	ec.addInternal(err)
+
 	if concept.ID == "" {
-		// Generate UUID for the new object
-		uuid, err := generateUUID()
-		id = uuid
+		// Derive the id from the key property if the class has one, otherwise
+		// generate a random UUID for the new object
+		derived, err := idFromKeyProperty(s, kind.Action, concept.Class, concept.Schema)
 		ec.add(err)
+		if derived == "" && err == nil {
+			derived, err = generateUUID()
+			ec.addInternal(err)
+		}
+		id = derived
 	} else {
 		_, err := uuid.FromString(concept.ID.String())
 		ec.add(err)
 		id = concept.ID
 	}
 
-	// Validate schema given in body with the weaviate schema
-	s, err := b.schemaManager.GetSchema(principal)
-	ec.add(err)
-
 	// Create Action object
 	action := &models.Action{}
 	action.LastUpdateTimeUnix = 0
@@ -130,39 +163,64 @@ func (b *BatchManager) validateAction(ctx context.Context, principal *models.Pri
 	if _, ok := fieldsToKeep["creationtimeunix"]; ok {
 		action.CreationTimeUnix = unixNow()
 	}
+	action.ExpiryTimeUnix = concept.ExpiryTimeUnix
+	ec.add(validateExpiry(concept.ExpiryTimeUnix, unixNow()))
 
-	err = validation.New(s, b.exists, b.network, b.config).Action(ctx, action)
-	ec.add(err)
+	// an oversized object only fails on its own, the rest of the batch is
+	// still imported
+	ec.add(validateObjectSize(b.config, concept))
 
-	vector, source, err := b.vectorizer.Action(ctx, action)
+	err = validation.New(s, b.exists, b.network, b.config).Action(ctx, action)
 	ec.add(err)
 
-	if action.Meta == nil {
-		action.Meta = &models.UnderscoreProperties{}
-	}
-	action.Meta.Interpretation = &models.Interpretation{
-		Source: sourceFromInputElements(source),
-	}
-
 	*resultsC <- BatchAction{
 		UUID:          id,
 		Action:        action,
 		Err:           ec.toError(),
 		OriginalIndex: originalIndex,
-		Vector:        vector,
 	}
 }
 
-func (b *BatchManager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (bool, error) {
-	switch k {
-	case kind.Thing:
-		res, err := b.vectorRepo.ThingByID(ctx, id, traverser.SelectProperties{}, traverser.UnderscoreProperties{})
-		return res != nil, err
-	case kind.Action:
-		res, err := b.vectorRepo.ActionByID(ctx, id, traverser.SelectProperties{}, traverser.UnderscoreProperties{})
-		return res != nil, err
-	default:
-		panic("impossible kind")
+func (b *BatchManager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (string, bool, error) {
+	return b.vectorRepo.ObjectClass(ctx, k, id)
+}
+
+// vectorizeActions vectorizes all valid actions of the batch at once, the
+// invalid ones are not vectorized, as that would be wasted effort
+func (b *BatchManager) vectorizeActions(ctx context.Context, batch BatchActions) {
+	var positions []int
+	var objects []*models.Action
+	for i, item := range batch {
+		if item.Err != nil {
+			continue
+		}
+
+		positions = append(positions, i)
+		objects = append(objects, item.Action)
+	}
+
+	if len(objects) == 0 {
+		return
+	}
+
+	results := b.vectorizer.BatchActions(ctx, objects)
+	for i, pos := range positions {
+		res := results[i]
+		ec := &errorCompounder{}
+		ec.addInternal(res.Err)
+		if res.Err == nil {
+			ec.add(validateVectorDimensions(b.config, res.Vector))
+			batch[pos].Vector = res.Vector
+		}
+		batch[pos].Err = ec.toError()
+
+		object := batch[pos].Action
+		if object.Meta == nil {
+			object.Meta = &models.UnderscoreProperties{}
+		}
+		object.Meta.Interpretation = &models.Interpretation{
+			Source: sourceFromInputElements(res.Source),
+		}
 	}
 }
 
@@ -199,16 +257,33 @@ func (b *BatchManager) addThings(ctx context.Context, principal *models.Principa
 		return nil, NewErrInvalidUserInput("invalid param 'things': %v", err)
 	}
 
-	batchThings := b.validateThingsConcurrently(ctx, principal, classes, fields)
+	classNames := make([]string, len(classes))
+	for i, class := range classes {
+		classNames[i] = class.Class
+	}
+	if err := b.checkBatchNotFrozen(principal, kind.Thing, classNames); err != nil {
+		return nil, err
+	}
+
+	if err := b.rateLimiter.Take(countClassNames(classNames)); err != nil {
+		return nil, err
+	}
+
+	batchThings := b.validateThingsConcurrently(ctx, principal, classes, fields, true)
 
 	var (
 		res BatchThings
 		err error
 	)
 	if res, err = b.vectorRepo.BatchPutThings(ctx, batchThings); err != nil {
-		return nil, NewErrInternal("batch things: %#v", err)
+		err = NewErrInternal("batch things: %#v", err)
+		b.auditThings(ctx, principal, classes, batchThings, err)
+		return nil, err
 	}
 
+	b.auditThings(ctx, principal, classes, res, nil)
+	b.emitThingEvents(classes, res)
+
 	return res, nil
 }
 
@@ -221,25 +296,38 @@ func (b *BatchManager) validateThingForm(classes []*models.Thing) error {
 }
 
 func (b *BatchManager) validateThingsConcurrently(ctx context.Context, principal *models.Principal,
-	classes []*models.Thing, fields []*string) BatchThings {
+	classes []*models.Thing, fields []*string, vectorize bool) BatchThings {
 	fieldsToKeep := determineResponseFields(fields)
 	c := make(chan BatchThing, len(classes))
 
 	wg := new(sync.WaitGroup)
+	workers := make(chan struct{}, b.vectorizationConcurrency)
 
-	// Generate a goroutine for each separate request
+	// Generate a goroutine for each separate request, but never run more than
+	// the configured amount at once
 	for i, thing := range classes {
 		wg.Add(1)
-		b.validateThing(ctx, principal, wg, thing, i, &c, fieldsToKeep)
+		workers <- struct{}{}
+		go func(i int, thing *models.Thing) {
+			defer func() { <-workers }()
+			b.validateThing(ctx, principal, wg, thing, i, &c, fieldsToKeep, vectorize)
+		}(i, thing)
 	}
 
 	wg.Wait()
 	close(c)
-	return thingsChanToSlice(c)
+	batch := thingsChanToSlice(c)
+
+	if vectorize {
+		b.vectorizeThings(ctx, batch)
+	}
+
+	return batch
 }
 
 func (b *BatchManager) validateThing(ctx context.Context, principal *models.Principal,
-	wg *sync.WaitGroup, concept *models.Thing, originalIndex int, resultsC *chan BatchThing, fieldsToKeep map[string]int) {
+	wg *sync.WaitGroup, concept *models.Thing, originalIndex int, resultsC *chan BatchThing, fieldsToKeep map[string]int,
+	vectorize bool) {
 	defer wg.Done()
 
 	var (
@@ -248,21 +336,26 @@ func (b *BatchManager) validateThing(ctx context.Context, principal *models.Prin
 
 	ec := &errorCompounder{}
 
+	// Validate schema given in body with the weaviate schema
+	s, err := b.schemaManager.GetSchema(principal)
+	ec.addInternal(err)
+
 	if concept.ID == "" {
-		// Generate UUID for the new object
-		uuid, err := generateUUID()
-		id = uuid
+		// Derive the id from the key property if the class has one, otherwise
+		// generate a random UUID for the new object
+		derived, err := idFromKeyProperty(s, kind.Thing, concept.Class, concept.Schema)
 		ec.add(err)
+		if derived == "" && err == nil {
+			derived, err = generateUUID()
+			ec.addInternal(err)
+		}
+		id = derived
 	} else {
 		_, err := uuid.FromString(concept.ID.String())
 		ec.add(err)
 		id = concept.ID
 	}
 
-	// Validate schema given in body with the weaviate schema
-	s, err := b.schemaManager.GetSchema(principal)
-	ec.add(err)
-
 	// Create Thing object
 	thing := &models.Thing{}
 	thing.LastUpdateTimeUnix = 0
@@ -276,28 +369,62 @@ func (b *BatchManager) validateThing(ctx context.Context, principal *models.Prin
 	if _, ok := fieldsToKeep["creationtimeunix"]; ok {
 		thing.CreationTimeUnix = unixNow()
 	}
+	thing.ExpiryTimeUnix = concept.ExpiryTimeUnix
+	ec.add(validateExpiry(concept.ExpiryTimeUnix, unixNow()))
+
+	// an oversized object only fails on its own, the rest of the batch is
+	// still imported
+	ec.add(validateObjectSize(b.config, concept))
 
 	thing.ID = id
 
 	err = validation.New(s, b.exists, b.network, b.config).Thing(ctx, thing)
 	ec.add(err)
 
-	vector, source, err := b.vectorizer.Thing(ctx, thing)
-	ec.add(err)
-
-	if thing.Meta == nil {
-		thing.Meta = &models.UnderscoreProperties{}
-	}
-	thing.Meta.Interpretation = &models.Interpretation{
-		Source: sourceFromInputElements(source),
-	}
-
 	*resultsC <- BatchThing{
 		UUID:          id,
 		Thing:         thing,
 		Err:           ec.toError(),
 		OriginalIndex: originalIndex,
-		Vector:        vector,
+	}
+}
+
+// vectorizeThings vectorizes all valid things of the batch at once, the
+// invalid ones are not vectorized, as that would be wasted effort
+func (b *BatchManager) vectorizeThings(ctx context.Context, batch BatchThings) {
+	var positions []int
+	var objects []*models.Thing
+	for i, item := range batch {
+		if item.Err != nil {
+			continue
+		}
+
+		positions = append(positions, i)
+		objects = append(objects, item.Thing)
+	}
+
+	if len(objects) == 0 {
+		return
+	}
+
+	results := b.vectorizer.BatchThings(ctx, objects)
+	for i, pos := range positions {
+		res := results[i]
+		ec := &errorCompounder{}
+		ec.addInternal(res.Err)
+		if res.Err == nil {
+			ec.add(validateVectorDimensions(b.config, res.Vector))
+			batch[pos].Vector = res.Vector
+		}
+		batch[pos].Err = ec.toError()
+
+		object := batch[pos].Thing
+		if object.Meta == nil {
+			object.Meta = &models.UnderscoreProperties{}
+		}
+		object.Meta.Interpretation = &models.Interpretation{
+			Source: sourceFromInputElements(res.Source),
+		}
 	}
 }
 
@@ -310,8 +437,12 @@ func thingsChanToSlice(c chan BatchThing) BatchThings {
 	return result
 }
 
+// errorCompounder collects all errors of a single batch item. The combined
+// error is an ErrInvalidUserInput, unless at least one of the errors was
+// caused by the server, in which case it is an ErrInternal.
 type errorCompounder struct {
-	errors []error
+	errors   []error
+	internal bool
 }
 
 func (ec *errorCompounder) add(err error) {
@@ -320,6 +451,13 @@ func (ec *errorCompounder) add(err error) {
 	}
 }
 
+func (ec *errorCompounder) addInternal(err error) {
+	if err != nil {
+		ec.errors = append(ec.errors, err)
+		ec.internal = true
+	}
+}
+
 func (ec *errorCompounder) toError() error {
 	if len(ec.errors) == 0 {
 		return nil
@@ -334,7 +472,11 @@ func (ec *errorCompounder) toError() error {
 		msg.WriteString(err.Error())
 	}
 
-	return errors.New(msg.String())
+	if ec.internal {
+		return NewErrInternal("%s", msg.String())
+	}
+
+	return NewErrInvalidUserInput("%s", msg.String())
 }
 
 func unixNow() int64 {
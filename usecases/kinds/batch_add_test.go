@@ -13,12 +13,15 @@ package kinds
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -146,11 +149,21 @@ func Test_BatchManager_AddThings(t *testing.T) {
 		manager    *BatchManager
 	)
 
+	ptFloat64 := func(in float64) *float64 { return &in }
+
 	schema := schema.Schema{
 		Things: &models.Schema{
 			Classes: []*models.Class{
 				{
 					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:     "age",
+							DataType: []string{"int"},
+							Minimum:  ptFloat64(0),
+							Maximum:  ptFloat64(150),
+						},
+					},
 				},
 			},
 		},
@@ -251,6 +264,122 @@ func Test_BatchManager_AddThings(t *testing.T) {
 		assert.Nil(t, err)
 		require.Len(t, vectorRepoCalledWithThings, 2)
 		assert.Equal(t, vectorRepoCalledWithThings[0].Err.Error(), "uuid: incorrect UUID length: invalid")
+		assert.IsType(t, ErrInvalidUserInput{}, vectorRepoCalledWithThings[0].Err)
 		assert.Equal(t, id2, vectorRepoCalledWithThings[1].UUID, "the user-specified uuid was used")
 	})
+
+	t.Run("with a thing violating a property constraint", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+		things := []*models.Thing{
+			&models.Thing{
+				Class:  "Foo",
+				Schema: map[string]interface{}{"age": json.Number("150")},
+			},
+			&models.Thing{
+				Class:  "Foo",
+				Schema: map[string]interface{}{"age": json.Number("151")},
+			},
+		}
+
+		_, err := manager.AddThings(ctx, nil, things, []*string{})
+		vectorRepoCalledWithThings := vectorRepo.Calls[0].Arguments[0].(BatchThings)
+
+		assert.Nil(t, err)
+		require.Len(t, vectorRepoCalledWithThings, 2)
+		assert.Nil(t, vectorRepoCalledWithThings[0].Err)
+		assert.Equal(t, 1, vectorRepoCalledWithThings[1].OriginalIndex)
+		assert.Equal(t, "invalid property 'age' on class 'Foo': value 151 is greater "+
+			"than the maximum of 150", vectorRepoCalledWithThings[1].Err.Error())
+	})
+}
+
+func Test_BatchManager_Vectorization(t *testing.T) {
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+				},
+			},
+		},
+	}
+	failingID := strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6")
+
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+	logger, _ := test.NewNullLogger()
+	vectorizer := &batchRecordingVectorizer{failFor: failingID}
+	manager := NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+		&fakeSchemaManager{GetSchemaResponse: schema}, nil, &config.WeaviateConfig{},
+		logger, &fakeAuthorizer{})
+
+	things := make([]*models.Thing, 20)
+	for i := range things {
+		things[i] = &models.Thing{Class: "Foo"}
+	}
+	things[7].ID = failingID
+	things[12].Class = "Bar"
+
+	_, err := manager.AddThings(context.Background(), nil, things, []*string{})
+	require.Nil(t, err)
+	vectorRepoCalledWithThings := vectorRepo.Calls[0].Arguments[0].(BatchThings)
+
+	t.Run("all valid things are vectorized in a single batch", func(t *testing.T) {
+		assert.Equal(t, []int{19}, vectorizer.batchSizes)
+	})
+
+	t.Run("the vectorization error belongs to the right object", func(t *testing.T) {
+		require.Len(t, vectorRepoCalledWithThings, len(things))
+		for i, thing := range vectorRepoCalledWithThings {
+			assert.Equal(t, i, thing.OriginalIndex)
+			switch i {
+			case 7:
+				require.NotNil(t, thing.Err)
+				assert.Equal(t, "c11y unavailable", thing.Err.Error())
+				assert.IsType(t, ErrInternal{}, thing.Err)
+				assert.Nil(t, thing.Vector)
+			case 12:
+				assert.IsType(t, ErrInvalidUserInput{}, thing.Err)
+				assert.Nil(t, thing.Vector)
+			default:
+				assert.Nil(t, thing.Err)
+				assert.Equal(t, []float32{0, 1, 2}, thing.Vector)
+			}
+		}
+	})
+}
+
+// batchRecordingVectorizer records the size of every batch it vectorizes
+type batchRecordingVectorizer struct {
+	batchSizes []int
+	failFor    strfmt.UUID
+}
+
+func (v *batchRecordingVectorizer) Thing(ctx context.Context,
+	thing *models.Thing) ([]float32, []vectorizer.InputElement, error) {
+	if thing.ID == v.failFor {
+		return nil, nil, errors.New("c11y unavailable")
+	}
+	return []float32{0, 1, 2}, nil, nil
+}
+
+func (v *batchRecordingVectorizer) Action(ctx context.Context,
+	action *models.Action) ([]float32, []vectorizer.InputElement, error) {
+	panic("not implemented")
+}
+
+func (v *batchRecordingVectorizer) BatchThings(ctx context.Context,
+	things []*models.Thing) []vectorizer.BatchResult {
+	v.batchSizes = append(v.batchSizes, len(things))
+	out := make([]vectorizer.BatchResult, len(things))
+	for i, thing := range things {
+		out[i].Vector, out[i].Source, out[i].Err = v.Thing(ctx, thing)
+	}
+	return out
+}
+
+func (v *batchRecordingVectorizer) BatchActions(ctx context.Context,
+	actions []*models.Action) []vectorizer.BatchResult {
+	panic("not implemented")
 }
@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+)
+
+// IdempotencyStore persists the outcome of batch requests which were sent
+// with an idempotency key, so that a retry with the same key can be answered
+// without executing the batch again. Entries expire after the ttl.
+type IdempotencyStore interface {
+	// Reserve claims the key by storing the pending record for the duration
+	// of the lease. If the key had already been claimed, the stored record is
+	// returned and the pending record is discarded. A nil return value means
+	// the key is now reserved.
+	Reserve(ctx context.Context, key string, pending []byte, lease time.Duration) ([]byte, error)
+
+	// Complete replaces the pending record with the final one
+	Complete(ctx context.Context, key string, record []byte, ttl time.Duration) error
+
+	// Release removes a reservation, so the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+type idempotencyRecord struct {
+	Fingerprint string          `json:"fingerprint"`
+	Completed   bool            `json:"completed"`
+	Result      json.RawMessage `json:"result,omitempty"`
+}
+
+type storedBatchItem struct {
+	OriginalIndex int            `json:"originalIndex"`
+	Err           string         `json:"error,omitempty"`
+	ErrCategory   string         `json:"errorCategory,omitempty"`
+	UUID          strfmt.UUID    `json:"uuid"`
+	Thing         *models.Thing  `json:"thing,omitempty"`
+	Action        *models.Action `json:"action,omitempty"`
+}
+
+// SetIdempotencyStore enables idempotency keys for batch imports. Without a
+// store, idempotency keys are ignored. Results are kept for the ttl, while a
+// request which is still running only holds its key for the lease, so that a
+// crashed request does not block retries for the whole ttl.
+func (b *BatchManager) SetIdempotencyStore(store IdempotencyStore, ttl time.Duration,
+	lease time.Duration) {
+	b.idempotency = store
+	b.idempotencyTTL = ttl
+	b.idempotencyLease = lease
+}
+
+// AddThingsWithIdempotencyKey behaves like AddThings, but if a previous
+// request with the same key has already completed, its result is returned
+// instead of importing the things again. An empty key disables the check.
+func (b *BatchManager) AddThingsWithIdempotencyKey(ctx context.Context, principal *models.Principal,
+	classes []*models.Thing, fields []*string, key string) (BatchThings, error) {
+	if key == "" || b.idempotency == nil {
+		return b.AddThings(ctx, principal, classes, fields)
+	}
+
+	err := b.authorizer.Authorize(principal, "create", "batch/things")
+	if err != nil {
+		return nil, err
+	}
+
+	// fingerprint the request before importing, as the import alters the
+	// classes
+	fingerprint, err := batchFingerprint(classes, fields)
+	if err != nil {
+		return nil, NewErrInternal("idempotency key '%s': %v", key, err)
+	}
+
+	storeKey := idempotencyStoreKey("things", principal, key)
+	previous, err := b.reserveIdempotencyKey(ctx, storeKey, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil {
+		var items []storedBatchItem
+		if err := json.Unmarshal(previous, &items); err != nil {
+			return nil, NewErrInternal("idempotency key '%s': parse stored result: %v", key, err)
+		}
+
+		out := make(BatchThings, len(items))
+		for i, item := range items {
+			out[i] = BatchThing{OriginalIndex: item.OriginalIndex, Err: storedErr(item.Err, item.ErrCategory),
+				UUID: item.UUID, Thing: item.Thing}
+		}
+		return out, nil
+	}
+
+	res, err := b.AddThings(ctx, principal, classes, fields)
+	if err != nil {
+		b.releaseIdempotencyKey(ctx, storeKey)
+		return nil, err
+	}
+
+	items := make([]storedBatchItem, len(res))
+	for i, thing := range res {
+		items[i] = storedBatchItem{OriginalIndex: thing.OriginalIndex, Err: errString(thing.Err),
+			ErrCategory: errCategory(thing.Err), UUID: thing.UUID, Thing: thing.Thing}
+	}
+	b.completeIdempotencyKey(ctx, storeKey, fingerprint, items)
+
+	return res, nil
+}
+
+// AddActionsWithIdempotencyKey behaves like AddActions, but if a previous
+// request with the same key has already completed, its result is returned
+// instead of importing the actions again. An empty key disables the check.
+func (b *BatchManager) AddActionsWithIdempotencyKey(ctx context.Context, principal *models.Principal,
+	classes []*models.Action, fields []*string, key string) (BatchActions, error) {
+	if key == "" || b.idempotency == nil {
+		return b.AddActions(ctx, principal, classes, fields)
+	}
+
+	err := b.authorizer.Authorize(principal, "create", "batch/actions")
+	if err != nil {
+		return nil, err
+	}
+
+	// fingerprint the request before importing, as the import alters the
+	// classes
+	fingerprint, err := batchFingerprint(classes, fields)
+	if err != nil {
+		return nil, NewErrInternal("idempotency key '%s': %v", key, err)
+	}
+
+	storeKey := idempotencyStoreKey("actions", principal, key)
+	previous, err := b.reserveIdempotencyKey(ctx, storeKey, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil {
+		var items []storedBatchItem
+		if err := json.Unmarshal(previous, &items); err != nil {
+			return nil, NewErrInternal("idempotency key '%s': parse stored result: %v", key, err)
+		}
+
+		out := make(BatchActions, len(items))
+		for i, item := range items {
+			out[i] = BatchAction{OriginalIndex: item.OriginalIndex, Err: storedErr(item.Err, item.ErrCategory),
+				UUID: item.UUID, Action: item.Action}
+		}
+		return out, nil
+	}
+
+	res, err := b.AddActions(ctx, principal, classes, fields)
+	if err != nil {
+		b.releaseIdempotencyKey(ctx, storeKey)
+		return nil, err
+	}
+
+	items := make([]storedBatchItem, len(res))
+	for i, action := range res {
+		items[i] = storedBatchItem{OriginalIndex: action.OriginalIndex, Err: errString(action.Err),
+			ErrCategory: errCategory(action.Err), UUID: action.UUID, Action: action.Action}
+	}
+	b.completeIdempotencyKey(ctx, storeKey, fingerprint, items)
+
+	return res, nil
+}
+
+// reserveIdempotencyKey returns the stored result of a completed request with
+// the same key, or nil if the key was reserved for the current request
+func (b *BatchManager) reserveIdempotencyKey(ctx context.Context, storeKey string,
+	fingerprint string) (json.RawMessage, error) {
+	pending, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, NewErrInternal("idempotency key: %v", err)
+	}
+
+	existing, err := b.idempotency.Reserve(ctx, storeKey, pending, b.idempotencyLease)
+	if err != nil {
+		return nil, NewErrInternal("idempotency key: %v", err)
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal(existing, &record); err != nil {
+		return nil, NewErrInternal("idempotency key: parse stored record: %v", err)
+	}
+
+	if record.Fingerprint != fingerprint {
+		return nil, NewErrInvalidUserInput("idempotency key has already been used " +
+			"for a different request")
+	}
+
+	if !record.Completed {
+		return nil, NewErrAlreadyExists("a request with the same idempotency key " +
+			"is still being executed")
+	}
+
+	return record.Result, nil
+}
+
+func (b *BatchManager) completeIdempotencyKey(ctx context.Context, storeKey string,
+	fingerprint string, items []storedBatchItem) {
+	result, err := json.Marshal(items)
+	if err != nil {
+		b.logIdempotencyError(ctx, storeKey, err)
+		b.releaseIdempotencyKey(ctx, storeKey)
+		return
+	}
+
+	record, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint,
+		Completed: true, Result: result})
+	if err != nil {
+		b.logIdempotencyError(ctx, storeKey, err)
+		b.releaseIdempotencyKey(ctx, storeKey)
+		return
+	}
+
+	// the batch has already been imported at this point, failing to store the
+	// result only means that a retry would import it again
+	if err := b.idempotency.Complete(ctx, storeKey, record, b.idempotencyTTL); err != nil {
+		b.logIdempotencyError(ctx, storeKey, err)
+		b.releaseIdempotencyKey(ctx, storeKey)
+	}
+}
+
+func (b *BatchManager) releaseIdempotencyKey(ctx context.Context, storeKey string) {
+	// use a fresh context, the request context might already be cancelled
+	ctx, cancel := context.WithTimeout(requestid.Detach(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := b.idempotency.Release(ctx, storeKey); err != nil {
+		b.logIdempotencyError(ctx, storeKey, err)
+	}
+}
+
+func (b *BatchManager) logIdempotencyError(ctx context.Context, storeKey string, err error) {
+	if b.logger == nil {
+		return
+	}
+
+	requestid.Logger(ctx, b.logger).WithField("action", "batch_idempotency").
+		WithField("key", storeKey).
+		WithError(err).
+		Error("could not persist idempotency key")
+}
+
+// idempotencyStoreKey scopes the client-provided key to the resource and the
+// user, so that different users cannot see each other's results
+func idempotencyStoreKey(resource string, principal *models.Principal, key string) string {
+	username := "anonymous"
+	if principal != nil {
+		username = principal.Username
+	}
+
+	return fmt.Sprintf("%s/%s/%s", resource, username, key)
+}
+
+// batchFingerprint identifies the request body, so that reusing a key for a
+// different request can be detected
+func batchFingerprint(classes interface{}, fields []*string) (string, error) {
+	body, err := json.Marshal(struct {
+		Classes interface{} `json:"classes"`
+		Fields  []*string   `json:"fields"`
+	}{classes, fields})
+	if err != nil {
+		return "", fmt.Errorf("fingerprint request: %v", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(body)), nil
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+func errCategory(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return string(CategorizeBatchError(err))
+}
+
+// storedErr restores an error of a previously stored batch result. The
+// category is used to recreate the original error type, so that the replayed
+// result is categorized the same way as the original one. Results stored
+// before categories were recorded are treated as internal errors.
+func storedErr(msg, category string) error {
+	if msg == "" {
+		return nil
+	}
+
+	switch BatchErrorCategory(category) {
+	case BatchErrorValidation:
+		return NewErrInvalidUserInput("%s", msg)
+	case BatchErrorConflict:
+		return NewErrAlreadyExists("%s", msg)
+	default:
+		return errors.New(msg)
+	}
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_Idempotency(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		store      *fakeIdempotencyStore
+		manager    *BatchManager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{{Class: "Foo"}},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{{Class: "Bar"}},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		store = newFakeIdempotencyStore()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		vectorizer.On("Action", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		manager = NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+			schemaManager, nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+		manager.SetIdempotencyStore(store, time.Hour, time.Minute)
+	}
+
+	things := func() []*models.Thing {
+		return []*models.Thing{
+			{ID: "2d3942c3-b412-4d80-9dfa-99a646629cd2", Class: "Foo"},
+			{ID: "invalid", Class: "Foo"},
+		}
+	}
+
+	ctx := context.Background()
+
+	t.Run("retrying a completed thing batch", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		first, err := manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		require.Nil(t, err)
+		second, err := manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		require.Nil(t, err)
+
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutThings", 1)
+		require.Len(t, second, 2)
+		assert.Equal(t, first[0].UUID, second[0].UUID)
+		assert.Equal(t, first[0].Thing.Class, second[0].Thing.Class)
+		assert.Nil(t, second[0].Err)
+		assert.Equal(t, first[1].Err.Error(), second[1].Err.Error())
+		assert.Equal(t, first.Summary(), second.Summary())
+	})
+
+	t.Run("retrying a completed action batch", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutActions", mock.Anything).Return(nil).Once()
+		actions := []*models.Action{{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff6", Class: "Bar"}}
+
+		_, err := manager.AddActionsWithIdempotencyKey(ctx, nil, actions, nil, "my-key")
+		require.Nil(t, err)
+		res, err := manager.AddActionsWithIdempotencyKey(ctx, nil, actions, nil, "my-key")
+		require.Nil(t, err)
+
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutActions", 1)
+		require.Len(t, res, 1)
+		assert.Equal(t, strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6"), res[0].UUID)
+	})
+
+	t.Run("without a key every request is executed", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Twice()
+
+		_, err := manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "")
+		require.Nil(t, err)
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "")
+		require.Nil(t, err)
+
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutThings", 2)
+		assert.Len(t, store.records, 0)
+	})
+
+	t.Run("keys are scoped to the user", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Twice()
+
+		_, err := manager.AddThingsWithIdempotencyKey(ctx, &models.Principal{Username: "alice"},
+			things(), nil, "my-key")
+		require.Nil(t, err)
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, &models.Principal{Username: "bob"},
+			things(), nil, "my-key")
+		require.Nil(t, err)
+
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutThings", 2)
+	})
+
+	t.Run("reusing a key for a different request", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		require.Nil(t, err)
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, nil, things()[:1], nil, "my-key")
+
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutThings", 1)
+	})
+
+	t.Run("retrying while the original request is still running", func(t *testing.T) {
+		reset()
+		fingerprint, err := batchFingerprint(things(), nil)
+		require.Nil(t, err)
+		_, err = manager.reserveIdempotencyKey(ctx, idempotencyStoreKey("things", nil, "my-key"),
+			fingerprint)
+		require.Nil(t, err)
+
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+
+		assert.IsType(t, ErrAlreadyExists{}, err)
+		vectorRepo.AssertNotCalled(t, "BatchPutThings", mock.Anything)
+	})
+
+	t.Run("a pending key only holds the lease, a completed one the ttl", func(t *testing.T) {
+		reset()
+		storeKey := idempotencyStoreKey("things", nil, "my-key")
+		fingerprint, err := batchFingerprint(things(), nil)
+		require.Nil(t, err)
+		_, err = manager.reserveIdempotencyKey(ctx, storeKey, fingerprint)
+		require.Nil(t, err)
+		assert.Equal(t, time.Minute, store.ttls[storeKey])
+
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		require.Nil(t, err)
+		assert.Equal(t, time.Hour, store.ttls[storeKey])
+	})
+
+	t.Run("a failed request releases the key", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(errors.New("oops")).Once()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		assert.IsType(t, ErrInternal{}, err)
+		assert.Len(t, store.records, 0)
+
+		_, err = manager.AddThingsWithIdempotencyKey(ctx, nil, things(), nil, "my-key")
+		assert.Nil(t, err)
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutThings", 2)
+	})
+}
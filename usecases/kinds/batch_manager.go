@@ -13,6 +13,7 @@ package kinds
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -31,6 +32,19 @@ type BatchManager struct {
 	authorizer    authorizer
 	vectorRepo    BatchVectorRepo
 	vectorizer    Vectorizer
+
+	// vectorizationConcurrency limits how many objects of a single batch are
+	// validated in parallel, the vectorization itself is up to the vectorizer
+	vectorizationConcurrency int
+
+	idempotency      IdempotencyStore
+	idempotencyTTL   time.Duration
+	idempotencyLease time.Duration
+
+	events      eventEmitter
+	timeSource  timeSource
+	rateLimiter *WriteRateLimiter
+	auditSink   auditSink
 }
 
 type BatchVectorRepo interface {
@@ -60,5 +74,20 @@ func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer Vectorizer, locks lo
 		vectorRepo:    vectorRepo,
 		vectorizer:    vectorizer,
 		authorizer:    authorizer,
+
+		vectorizationConcurrency: vectorizationConcurrency(config),
+
+		events:     noopEmitter{},
+		timeSource: defaultTimeSource{},
+		auditSink:  noopAuditSink{},
 	}
 }
+
+func vectorizationConcurrency(cfg *config.WeaviateConfig) int {
+	if cfg == nil || cfg.Config.Batch.VectorizationConcurrency == nil ||
+		*cfg.Config.Batch.VectorizationConcurrency < 1 {
+		return 1
+	}
+
+	return *cfg.Config.Batch.VectorizationConcurrency
+}
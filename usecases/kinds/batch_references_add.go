@@ -19,6 +19,7 @@ import (
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // AddReferences Class Instances in batch to the connected DB
@@ -36,21 +37,41 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	}
 	defer unlock()
 
-	return b.addReferences(ctx, refs)
+	return b.addReferences(ctx, principal, refs)
 }
 
-func (b *BatchManager) addReferences(ctx context.Context, refs []*models.BatchReference) (BatchReferences, error) {
+func (b *BatchManager) addReferences(ctx context.Context, principal *models.Principal,
+	refs []*models.BatchReference) (BatchReferences, error) {
 
 	if err := b.validateReferenceForm(refs); err != nil {
 		return nil, NewErrInvalidUserInput("invalid params: %v", err)
 	}
 
+	if err := b.checkReferenceSourcesNotFrozen(principal, refs); err != nil {
+		return nil, err
+	}
+
 	batchReferences := b.validateReferencesConcurrently(refs)
-	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences); err != nil {
-		return nil, NewErrInternal("could not add batch request to connector: %v", err)
-	} else {
-		return res, nil
+	if err := b.validateReferenceTargetClasses(ctx, principal, batchReferences); err != nil {
+		b.auditReferences(ctx, principal, batchReferences, err)
+		return nil, err
 	}
+
+	if err := b.validateReferenceCounts(ctx, principal, batchReferences); err != nil {
+		b.auditReferences(ctx, principal, batchReferences, err)
+		return nil, err
+	}
+
+	res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences)
+	if err != nil {
+		err = NewErrInternal("could not add batch request to connector: %v", err)
+		b.auditReferences(ctx, principal, batchReferences, err)
+		return nil, err
+	}
+
+	b.auditReferences(ctx, principal, res, nil)
+	b.emitReferenceEvents(res)
+	return res, nil
 }
 
 func (b *BatchManager) validateReferenceForm(refs []*models.BatchReference) error {
@@ -111,6 +132,48 @@ func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchRe
 	}
 }
 
+// validateReferenceTargetClasses marks every reference whose target is of a
+// class the property can't point to. The class of each target is only looked
+// up once. References to objects which don't exist and to properties which
+// aren't reference properties are left to the repo, just like before.
+func (b *BatchManager) validateReferenceTargetClasses(ctx context.Context,
+	principal *models.Principal, refs BatchReferences) error {
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	lookup := newReferencedObjects(b.vectorRepo)
+	for i, ref := range refs {
+		if ref.Err != nil {
+			continue
+		}
+
+		prop, err := s.GetProperty(ref.From.Kind, ref.From.Class, ref.From.Property)
+		if err != nil {
+			continue
+		}
+
+		dataType, err := s.FindPropertyDataType(prop.DataType)
+		if err != nil || !dataType.IsReference() {
+			continue
+		}
+
+		targetClass, err := lookup.className(ctx, ref.To.Kind, ref.To.TargetID)
+		if err != nil {
+			return err
+		}
+
+		if targetClass == "" {
+			continue
+		}
+
+		refs[i].Err = validateReferenceTargetClass(prop, dataType, ref, targetClass)
+	}
+
+	return nil
+}
+
 func referencesChanToSlice(c chan BatchReference) BatchReferences {
 	result := make([]BatchReference, len(c), len(c))
 	for reference := range c {
@@ -134,3 +197,26 @@ func joinErrors(errors []error) error {
 
 	return fmt.Errorf(strings.Join(errorStrings, ", "))
 }
+
+// checkReferenceSourcesNotFrozen only considers sources which can be parsed,
+// malformed sources are reported per reference by the regular validation.
+func (b *BatchManager) checkReferenceSourcesNotFrozen(principal *models.Principal,
+	refs []*models.BatchReference) error {
+	classNames := map[kind.Kind][]string{}
+	for _, ref := range refs {
+		source, err := crossref.ParseSource(string(ref.From))
+		if err != nil {
+			continue
+		}
+
+		classNames[source.Kind] = append(classNames[source.Kind], source.Class.String())
+	}
+
+	for k, names := range classNames {
+		if err := b.checkBatchNotFrozen(principal, k, names); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
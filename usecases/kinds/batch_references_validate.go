@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// ValidateReferences runs every reference of the batch through the same
+// validation as AddReferences without adding anything. In addition, the
+// source and target objects must exist, the property must be a reference
+// property of the source class and the class of the target must be one of
+// the classes the property may point to. The result has the same shape as
+// the result of AddReferences.
+func (b *BatchManager) ValidateReferences(ctx context.Context, principal *models.Principal,
+	refs []*models.BatchReference) (BatchReferences, error) {
+	err := b.authorizer.Authorize(principal, "validate", "batch/references")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := b.validateReferenceForm(refs); err != nil {
+		return nil, NewErrInvalidUserInput("invalid params: %v", err)
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	batchReferences := b.validateReferencesConcurrently(refs)
+	lookup := newReferencedObjects(b.vectorRepo)
+	for i, ref := range batchReferences {
+		if ref.Err != nil {
+			continue
+		}
+
+		err := validateReferenceAgainstSchema(ctx, s, lookup, ref)
+		if _, ok := err.(ErrInternal); ok {
+			return nil, err
+		}
+
+		batchReferences[i].Err = err
+	}
+
+	if err := b.validateReferenceCounts(ctx, principal, batchReferences); err != nil {
+		return nil, err
+	}
+
+	return batchReferences, nil
+}
+
+// validateReferenceAgainstSchema returns why the reference is invalid. If the
+// referenced objects could not be looked up, an ErrInternal is returned
+// instead.
+func validateReferenceAgainstSchema(ctx context.Context, s schema.Schema,
+	lookup *referencedObjects, ref BatchReference) error {
+	prop, err := s.GetProperty(ref.From.Kind, ref.From.Class, ref.From.Property)
+	if err != nil {
+		return err
+	}
+
+	dataType, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return fmt.Errorf("property '%s': %v", prop.Name, err)
+	}
+
+	if !dataType.IsReference() {
+		return fmt.Errorf("property '%s' of class '%s' is not a reference property",
+			prop.Name, ref.From.Class)
+	}
+
+	sourceClass, err := lookup.className(ctx, ref.From.Kind, ref.From.TargetID)
+	if err != nil {
+		return err
+	}
+
+	if sourceClass == "" {
+		return fmt.Errorf("source %s %s not found", ref.From.Kind.Name(), ref.From.TargetID)
+	}
+
+	if sourceClass != ref.From.Class.String() {
+		return fmt.Errorf("source %s %s is of class '%s', not '%s'", ref.From.Kind.Name(),
+			ref.From.TargetID, sourceClass, ref.From.Class)
+	}
+
+	targetClass, err := lookup.className(ctx, ref.To.Kind, ref.To.TargetID)
+	if err != nil {
+		return err
+	}
+
+	if targetClass == "" {
+		return fmt.Errorf("target %s %s not found", ref.To.Kind.Name(), ref.To.TargetID)
+	}
+
+	return validateReferenceTargetClass(prop, dataType, ref, targetClass)
+}
+
+// validateReferenceTargetClass returns an error if the property of the
+// reference can't point to an object of the target class
+func validateReferenceTargetClass(prop *models.Property, dataType schema.PropertyDataType,
+	ref BatchReference, targetClass string) error {
+	if !dataType.ContainsClass(schema.ClassName(targetClass)) {
+		return fmt.Errorf("target %s %s is of class '%s', but property '%s' of class '%s' "+
+			"can only point to %v", ref.To.Kind.Name(), ref.To.TargetID, targetClass,
+			prop.Name, ref.From.Class, dataType.Classes())
+	}
+
+	return nil
+}
+
+type referencedObject struct {
+	kind kind.Kind
+	id   strfmt.UUID
+}
+
+// referencedObjects looks up the class of every object only once, as a batch
+// typically contains many references from or to the same object
+type referencedObjects struct {
+	repo    VectorRepo
+	classes map[referencedObject]string
+}
+
+func newReferencedObjects(repo VectorRepo) *referencedObjects {
+	return &referencedObjects{repo: repo, classes: map[referencedObject]string{}}
+}
+
+// className returns the name of the class of the object, or an empty string
+// if there is no such object
+func (r *referencedObjects) className(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (string, error) {
+	key := referencedObject{k, id}
+	if className, ok := r.classes[key]; ok {
+		return className, nil
+	}
+
+	className, _, err := r.repo.ObjectClass(ctx, k, id)
+	if err != nil {
+		return "", NewErrInternal("validate references: get %s %s: %v", k.Name(), id, err)
+	}
+
+	r.classes[key] = className
+	return className, nil
+}
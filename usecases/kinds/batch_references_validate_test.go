@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_ValidateReferences(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Source",
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{"string"},
+						},
+						{
+							Name:     "toTarget",
+							DataType: []string{"Target"},
+						},
+					},
+				},
+				{
+					Class: "Target",
+				},
+				{
+					Class: "Other",
+				},
+			},
+		},
+	}
+
+	sourceID := strfmt.UUID("6d5e2a41-1a2c-4c1e-9d3a-1f3c4ef5a101")
+	targetID := strfmt.UUID("6d5e2a41-1a2c-4c1e-9d3a-1f3c4ef5a102")
+	otherID := strfmt.UUID("6d5e2a41-1a2c-4c1e-9d3a-1f3c4ef5a103")
+	missingID := strfmt.UUID("6d5e2a41-1a2c-4c1e-9d3a-1f3c4ef5a104")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ObjectClass", kind.Thing, sourceID).Return("Source", true, nil)
+		vectorRepo.On("ObjectClass", kind.Thing, targetID).Return("Target", true, nil)
+		vectorRepo.On("ObjectClass", kind.Thing, otherID).Return("Other", true, nil)
+		vectorRepo.On("ObjectClass", kind.Thing, missingID).Return("", false, nil)
+
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		manager = NewBatchManager(vectorRepo, &fakeVectorizer{}, locks,
+			schemaManager, nil, config, logger, authorizer)
+	}
+
+	ref := func(fromID strfmt.UUID, prop string, toID strfmt.UUID) *models.BatchReference {
+		return &models.BatchReference{
+			From: strfmt.URI("weaviate://localhost/things/Source/" + fromID.String() + "/" + prop),
+			To:   strfmt.URI("weaviate://localhost/things/" + toID.String()),
+		}
+	}
+
+	ctx := context.Background()
+
+	t.Run("without any references", func(t *testing.T) {
+		reset()
+		_, err := manager.ValidateReferences(ctx, nil, []*models.BatchReference{})
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("with valid and invalid references", func(t *testing.T) {
+		reset()
+		res, err := manager.ValidateReferences(ctx, nil, []*models.BatchReference{
+			ref(sourceID, "toTarget", targetID),
+			ref(sourceID, "notAProp", targetID),
+			ref(sourceID, "name", targetID),
+			ref(sourceID, "toTarget", missingID),
+			ref(sourceID, "toTarget", otherID),
+			ref(missingID, "toTarget", targetID),
+			{From: "not a beacon", To: strfmt.URI("weaviate://localhost/things/" + targetID.String())},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 7)
+
+		assert.Nil(t, res[0].Err)
+		require.NotNil(t, res[1].Err)
+		assert.Contains(t, res[1].Err.Error(), "notAProp")
+		require.NotNil(t, res[2].Err)
+		assert.Contains(t, res[2].Err.Error(), "is not a reference property")
+		require.NotNil(t, res[3].Err)
+		assert.Contains(t, res[3].Err.Error(), "target thing "+missingID.String()+" not found")
+		require.NotNil(t, res[4].Err)
+		assert.Contains(t, res[4].Err.Error(), "is of class 'Other', but property "+
+			"'toTarget' of class 'Source' can only point to [Target]")
+		require.NotNil(t, res[5].Err)
+		assert.Contains(t, res[5].Err.Error(), "source thing "+missingID.String()+" not found")
+		assert.NotNil(t, res[6].Err)
+
+		for i, r := range res {
+			assert.Equal(t, i, r.OriginalIndex)
+		}
+
+		// every object is only looked up once and nothing is written
+		vectorRepo.AssertNumberOfCalls(t, "ObjectClass", 4)
+		vectorRepo.AssertNotCalled(t, "AddBatchReferences", mock.Anything)
+	})
+
+	t.Run("when the objects cannot be looked up", func(t *testing.T) {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ObjectClass", mock.Anything, mock.Anything).
+			Return("", false, errors.New("oops"))
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, &fakeVectorizer{}, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: schema}, nil, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{})
+
+		_, err := manager.ValidateReferences(ctx, nil, []*models.BatchReference{
+			ref(sourceID, "toTarget", targetID),
+		})
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInternal{}, err)
+	})
+}
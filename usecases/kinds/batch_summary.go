@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+// BatchErrorCategory classifies why a single item of a batch failed
+type BatchErrorCategory string
+
+const (
+	// BatchErrorValidation means the item itself was invalid, e.g. an unknown
+	// class or a property of the wrong type
+	BatchErrorValidation BatchErrorCategory = "validation"
+	// BatchErrorConflict means the item clashed with an existing object, e.g.
+	// an id or key property that is already taken
+	BatchErrorConflict BatchErrorCategory = "conflict"
+	// BatchErrorInternal means the item could not be imported because of a
+	// server-side problem, e.g. an unavailable vectorizer or a storage error
+	BatchErrorInternal BatchErrorCategory = "internal"
+)
+
+// CategorizeBatchError maps the error of a batch item to its category. Errors
+// that are not one of the known user-facing error types are considered
+// internal.
+func CategorizeBatchError(err error) BatchErrorCategory {
+	switch err.(type) {
+	case ErrInvalidUserInput:
+		return BatchErrorValidation
+	case ErrAlreadyExists:
+		return BatchErrorConflict
+	default:
+		return BatchErrorInternal
+	}
+}
+
+// BatchSummary counts the outcomes of all items in a batch
+type BatchSummary struct {
+	Total            int
+	Succeeded        int
+	Failed           int
+	FailedValidation int
+	FailedConflict   int
+	FailedInternal   int
+}
+
+func (s *BatchSummary) add(err error) {
+	s.Total++
+	if err == nil {
+		s.Succeeded++
+		return
+	}
+
+	s.Failed++
+	switch CategorizeBatchError(err) {
+	case BatchErrorValidation:
+		s.FailedValidation++
+	case BatchErrorConflict:
+		s.FailedConflict++
+	default:
+		s.FailedInternal++
+	}
+}
+
+// Summary counts the outcomes of all things in the batch
+func (b BatchThings) Summary() BatchSummary {
+	var s BatchSummary
+	for _, thing := range b {
+		s.add(thing.Err)
+	}
+
+	return s
+}
+
+// Summary counts the outcomes of all actions in the batch
+func (b BatchActions) Summary() BatchSummary {
+	var s BatchSummary
+	for _, action := range b {
+		s.add(action.Err)
+	}
+
+	return s
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSummary(t *testing.T) {
+	t.Run("things", func(t *testing.T) {
+		things := BatchThings{
+			{OriginalIndex: 0},
+			{OriginalIndex: 1, Err: NewErrInvalidUserInput("invalid class")},
+			{OriginalIndex: 2, Err: NewErrAlreadyExists("id already taken")},
+			{OriginalIndex: 3},
+			{OriginalIndex: 4, Err: NewErrInternal("c11y unavailable")},
+			{OriginalIndex: 5, Err: errors.New("shard foo: disk full")},
+		}
+
+		expected := BatchSummary{
+			Total:            6,
+			Succeeded:        2,
+			Failed:           4,
+			FailedValidation: 1,
+			FailedConflict:   1,
+			FailedInternal:   2,
+		}
+		assert.Equal(t, expected, things.Summary())
+	})
+
+	t.Run("actions", func(t *testing.T) {
+		actions := BatchActions{
+			{OriginalIndex: 0},
+			{OriginalIndex: 1, Err: NewErrInvalidUserInput("invalid class")},
+		}
+
+		expected := BatchSummary{
+			Total:            2,
+			Succeeded:        1,
+			Failed:           1,
+			FailedValidation: 1,
+		}
+		assert.Equal(t, expected, actions.Summary())
+	})
+
+	t.Run("an empty batch", func(t *testing.T) {
+		assert.Equal(t, BatchSummary{}, BatchThings{}.Summary())
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ValidateThings runs every thing of the batch through the same validation
+// as AddThings without storing anything. Vectorization is skipped unless
+// explicitly requested, as it is the most expensive part of the validation.
+// The result has the same shape as the result of AddThings.
+func (b *BatchManager) ValidateThings(ctx context.Context, principal *models.Principal,
+	classes []*models.Thing, fields []*string, vectorize bool) (BatchThings, error) {
+	err := b.authorizer.Authorize(principal, "validate", "batch/things")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := b.validateThingForm(classes); err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'things': %v", err)
+	}
+
+	return b.validateThingsConcurrently(ctx, principal, classes, fields, vectorize), nil
+}
+
+// ValidateActions runs every action of the batch through the same validation
+// as AddActions without storing anything. Vectorization is skipped unless
+// explicitly requested, as it is the most expensive part of the validation.
+// The result has the same shape as the result of AddActions.
+func (b *BatchManager) ValidateActions(ctx context.Context, principal *models.Principal,
+	classes []*models.Action, fields []*string, vectorize bool) (BatchActions, error) {
+	err := b.authorizer.Authorize(principal, "validate", "batch/actions")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := b.validateActionForm(classes); err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'actions': %v", err)
+	}
+
+	return b.validateActionsConcurrently(ctx, principal, classes, fields, vectorize), nil
+}
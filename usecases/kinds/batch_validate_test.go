@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_ValidateThings(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *BatchManager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{"string"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		vectorizer = &fakeVectorizer{}
+		manager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, nil, config, logger, authorizer)
+	}
+
+	ctx := context.Background()
+	things := []*models.Thing{
+		&models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"name": "valid"},
+		},
+		&models.Thing{
+			Class: "NotAClass",
+		},
+		&models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"notAProp": "invalid"},
+		},
+	}
+
+	t.Run("without any things", func(t *testing.T) {
+		reset()
+		expectedErr := NewErrInvalidUserInput("invalid param 'things': cannot be empty, need at least" +
+			" one thing for batching")
+
+		_, err := manager.ValidateThings(ctx, nil, []*models.Thing{}, []*string{}, false)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("without vectorization", func(t *testing.T) {
+		reset()
+
+		res, err := manager.ValidateThings(ctx, nil, things, []*string{}, false)
+
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.NotNil(t, res[1].Err)
+		assert.NotNil(t, res[2].Err)
+		assert.Nil(t, res[0].Vector)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertNotCalled(t, "BatchPutThings", mock.Anything)
+	})
+
+	t.Run("with vectorization", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+
+		res, err := manager.ValidateThings(ctx, nil, things[:1], []*string{}, true)
+
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, []float32{0, 1, 2}, res[0].Vector)
+		vectorRepo.AssertNotCalled(t, "BatchPutThings", mock.Anything)
+	})
+}
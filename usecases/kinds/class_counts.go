@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// classCountsTTL is the time for which the class counts are served from the
+// cache, so that an overview which is polled frequently doesn't count every
+// class on each request
+const classCountsTTL = 10 * time.Second
+
+type classCountsCache struct {
+	sync.Mutex
+	counts     *models.ClassCounts
+	computedAt int64
+}
+
+// GetClassCounts returns every class of the schema with the number of objects
+// it holds. The objects are counted without loading them. The result is
+// cached for classCountsTTL, so classes which were added in the meantime may
+// be missing for that long.
+func (m *Manager) GetClassCounts(ctx context.Context,
+	principal *models.Principal) (*models.ClassCounts, error) {
+	err := m.authorizer.Authorize(principal, "get", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	m.classCounts.Lock()
+	defer m.classCounts.Unlock()
+
+	now := m.timeSource.Now()
+	if m.classCounts.counts != nil &&
+		now-m.classCounts.computedAt < int64(classCountsTTL/time.Millisecond) {
+		return m.classCounts.counts, nil
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	things, err := m.countClasses(ctx, kind.Thing, s.Things)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := m.countClasses(ctx, kind.Action, s.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	m.classCounts.counts = &models.ClassCounts{Things: things, Actions: actions}
+	m.classCounts.computedAt = now
+	return m.classCounts.counts, nil
+}
+
+func (m *Manager) countClasses(ctx context.Context, k kind.Kind,
+	s *models.Schema) ([]*models.ClassCount, error) {
+	out := []*models.ClassCount{}
+	if s == nil {
+		return out, nil
+	}
+
+	for _, class := range s.Classes {
+		count, err := m.vectorRepo.Count(ctx, k, class.Class, nil)
+		if err != nil {
+			return nil, NewErrInternal("count %s class %s: %v", k.Name(), class.Class, err)
+		}
+
+		out = append(out, &models.ClassCount{Class: class.Class, Count: count})
+	}
+
+	return out, nil
+}
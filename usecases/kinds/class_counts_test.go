@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetClassCounts(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		clock      *manualTimeSource
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		clock = &manualTimeSource{now: 1000}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Foo"},
+						&models.Class{Class: "Bar"},
+					},
+				},
+				Actions: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Baz"},
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		manager.timeSource = clock
+	}
+
+	expected := &models.ClassCounts{
+		Things: []*models.ClassCount{
+			&models.ClassCount{Class: "Foo", Count: 7},
+			&models.ClassCount{Class: "Bar", Count: 0},
+		},
+		Actions: []*models.ClassCount{
+			&models.ClassCount{Class: "Baz", Count: 3},
+		},
+	}
+
+	t.Run("counting every class in schema order", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Count", kind.Thing, "Foo", (*filters.LocalFilter)(nil)).Return(int64(7), nil).Once()
+		vectorRepo.On("Count", kind.Thing, "Bar", (*filters.LocalFilter)(nil)).Return(int64(0), nil).Once()
+		vectorRepo.On("Count", kind.Action, "Baz", (*filters.LocalFilter)(nil)).Return(int64(3), nil).Once()
+
+		res, err := manager.GetClassCounts(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("serving the counts from the cache until they expire", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Count", kind.Thing, "Foo", (*filters.LocalFilter)(nil)).Return(int64(7), nil).Once()
+		vectorRepo.On("Count", kind.Thing, "Bar", (*filters.LocalFilter)(nil)).Return(int64(0), nil).Once()
+		vectorRepo.On("Count", kind.Action, "Baz", (*filters.LocalFilter)(nil)).Return(int64(3), nil).Once()
+
+		_, err := manager.GetClassCounts(context.Background(), nil)
+		require.Nil(t, err)
+
+		clock.now += 5000
+		res, err := manager.GetClassCounts(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		vectorRepo.AssertNumberOfCalls(t, "Count", 3)
+
+		vectorRepo.On("Count", kind.Thing, "Foo", (*filters.LocalFilter)(nil)).Return(int64(8), nil).Once()
+		vectorRepo.On("Count", kind.Thing, "Bar", (*filters.LocalFilter)(nil)).Return(int64(0), nil).Once()
+		vectorRepo.On("Count", kind.Action, "Baz", (*filters.LocalFilter)(nil)).Return(int64(3), nil).Once()
+
+		clock.now += 5000
+		res, err = manager.GetClassCounts(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(8), res.Things[0].Count)
+		vectorRepo.AssertNumberOfCalls(t, "Count", 6)
+	})
+
+	t.Run("a failing count", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Count", kind.Thing, "Foo", (*filters.LocalFilter)(nil)).
+			Return(int64(0), errors.New("oops")).Once()
+
+		_, err := manager.GetClassCounts(context.Background(), nil)
+		assert.Equal(t, NewErrInternal("count thing class Foo: oops"), err)
+	})
+}
+
+type manualTimeSource struct {
+	now int64
+}
+
+func (m *manualTimeSource) Now() int64 {
+	return m.now
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// storageCompactor is only implemented by the standalone storage
+type storageCompactor interface {
+	Compact(ctx context.Context) ([]*models.ShardCompaction, error)
+}
+
+// SetStorageCompactor enables CompactStorage. Without a compactor,
+// CompactStorage fails with an ErrNotImplemented.
+func (m *Manager) SetStorageCompactor(compactor storageCompactor) {
+	m.compactor = compactor
+}
+
+// CompactStorage rewrites the storage files of all shards to release the
+// space of deleted and overwritten objects. It blocks until the compaction is
+// done or ctx is canceled. Only one compaction can run at a time. No global
+// lock is held, the storage only blocks writes to the shard which is
+// currently being compacted.
+func (m *Manager) CompactStorage(ctx context.Context,
+	principal *models.Principal) ([]*models.ShardCompaction, error) {
+	err := m.authorizer.Authorize(principal, "update", "storage/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if m.compactor == nil {
+		return nil, NewErrNotImplemented("compaction is only supported by the standalone storage")
+	}
+
+	if !atomic.CompareAndSwapInt32(&m.compacting, 0, 1) {
+		return nil, NewErrAlreadyExists("a compaction is already running")
+	}
+	defer atomic.StoreInt32(&m.compacting, 0)
+
+	res, err := m.compactor.Compact(ctx)
+	if err != nil {
+		return nil, NewErrInternal("compact storage: %v", err)
+	}
+
+	return res, nil
+}
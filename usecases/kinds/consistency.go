@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+const (
+	// ConsistencyEventual returns from a write as soon as the repo accepted
+	// the object. An immediate read might not find it yet, e.g. on esvector
+	// before the next index refresh. This is the default.
+	ConsistencyEventual = "eventual"
+	// ConsistencyStrong additionally waits until the object can be read back,
+	// so the propagation delay of the repo is added to the latency of the
+	// write. If it is still not readable after the timeout, the write is
+	// reported as successful nonetheless, as it can't be undone.
+	ConsistencyStrong = "strong"
+)
+
+const awaitPollInterval = 50 * time.Millisecond
+
+// AwaitThing blocks until the thing with the specified id can be read back
+// from the repo or the configured strong consistency timeout has passed. It
+// is meant to be called after a successful AddThing if the user requested
+// ConsistencyStrong. An error only means the object is not readable yet, the
+// write itself is not affected.
+func (m *Manager) AwaitThing(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) error {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("things/%s", id.String()))
+	if err != nil {
+		return err
+	}
+
+	return m.awaitReadable(ctx, "thing", id, m.vectorRepo.ThingByID)
+}
+
+// AwaitAction blocks until the action with the specified id can be read back
+// from the repo or the configured strong consistency timeout has passed. It
+// is meant to be called after a successful AddAction if the user requested
+// ConsistencyStrong. An error only means the object is not readable yet, the
+// write itself is not affected.
+func (m *Manager) AwaitAction(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) error {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("actions/%s", id.String()))
+	if err != nil {
+		return err
+	}
+
+	return m.awaitReadable(ctx, "action", id, m.vectorRepo.ActionByID)
+}
+
+type byIDFn func(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties,
+	underscores traverser.UnderscoreProperties) (*search.Result, error)
+
+func (m *Manager) awaitReadable(ctx context.Context, kindName string,
+	id strfmt.UUID, byID byIDFn) error {
+	timeout := time.Duration(*m.config.Config.Consistency.StrongTimeoutSeconds) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(awaitPollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := byID(ctx, id, traverser.SelectProperties{}, traverser.UnderscoreProperties{})
+		if err != nil && ctx.Err() == nil {
+			return NewErrInternal("repo: %s by id: %v", kindName, err)
+		}
+
+		if res != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return NewErrInternal("%s '%s' was written, but is still not readable after %s",
+				kindName, id, timeout)
+		case <-ticker.C:
+		}
+	}
+}
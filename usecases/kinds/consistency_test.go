@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_AwaitThing(t *testing.T) {
+	var (
+		manager    *Manager
+		vectorRepo *fakeVectorRepo
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		timeout := 1
+		cfg.Config.Consistency.StrongTimeoutSeconds = &timeout
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{}, cfg,
+			logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo, &fakeExtender{},
+			&fakeProjector{})
+	}
+
+	t.Run("when the thing becomes readable after a while", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return((*search.Result)(nil), nil).Twice()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return(&search.Result{ClassName: "Foo"}, nil).Once()
+
+		err := manager.AwaitThing(context.Background(), nil, id)
+
+		assert.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the thing never becomes readable", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return((*search.Result)(nil), nil)
+
+		err := manager.AwaitThing(context.Background(), nil, id)
+
+		assert.IsType(t, ErrInternal{}, err)
+		assert.Contains(t, err.Error(), "still not readable after 1s")
+	})
+}
+
+func Test_AwaitAction(t *testing.T) {
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	vectorRepo := &fakeVectorRepo{}
+	cfg := &config.WeaviateConfig{}
+	timeout := 1
+	cfg.Config.Consistency.StrongTimeoutSeconds = &timeout
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{}, cfg,
+		logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo, &fakeExtender{},
+		&fakeProjector{})
+
+	vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).
+		Return(&search.Result{ClassName: "Foo"}, nil).Once()
+
+	err := manager.AwaitAction(context.Background(), nil, id)
+
+	assert.Nil(t, err)
+	vectorRepo.AssertExpectations(t)
+}
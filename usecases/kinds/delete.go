@@ -17,6 +17,9 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -43,22 +46,34 @@ func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.deleteActionFromRepo(ctx, id)
+	className, err := m.deleteActionFromRepo(ctx, principal, id)
+	m.audit(ctx, principal, audit.OperationDelete, kind.Action, className, id, err)
+	return err
 }
 
-func (m *Manager) deleteActionFromRepo(ctx context.Context, id strfmt.UUID) error {
+func (m *Manager) deleteActionFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (string, error) {
 	actionRes, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	action := actionRes.Action()
+	if err := m.checkNotFrozen(principal, kind.Action, action.Class); err != nil {
+		return action.Class, err
+	}
+
 	err = m.vectorRepo.DeleteAction(ctx, action.Class, id)
 	if err != nil {
-		return NewErrInternal("could not delete action from vector repo: %v", err)
+		if _, ok := err.(ErrUnavailable); ok {
+			return action.Class, err
+		}
+		return action.Class, NewErrInternal("could not delete action from vector repo: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Action, action.Class, id, events.OperationDelete)
+
+	return action.Class, nil
 }
 
 // DeleteThing Class Instance from the conncected DB
@@ -74,21 +89,33 @@ func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.deleteThingFromRepo(ctx, id)
+	className, err := m.deleteThingFromRepo(ctx, principal, id)
+	m.audit(ctx, principal, audit.OperationDelete, kind.Thing, className, id, err)
+	return err
 }
 
-func (m *Manager) deleteThingFromRepo(ctx context.Context, id strfmt.UUID) error {
+func (m *Manager) deleteThingFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (string, error) {
 
 	thingRes, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	thing := thingRes.Thing()
+	if err := m.checkNotFrozen(principal, kind.Thing, thing.Class); err != nil {
+		return thing.Class, err
+	}
+
 	err = m.vectorRepo.DeleteThing(ctx, thing.Class, id)
 	if err != nil {
-		return NewErrInternal("could not delete thing from vector repo: %v", err)
+		if _, ok := err.(ErrUnavailable); ok {
+			return thing.Class, err
+		}
+		return thing.Class, NewErrInternal("could not delete thing from vector repo: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Thing, thing.Class, id, events.OperationDelete)
+
+	return thing.Class, nil
 }
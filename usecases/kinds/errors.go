@@ -11,7 +11,10 @@
 
 package kinds
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
@@ -54,3 +57,93 @@ func (e ErrNotFound) Error() string {
 func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
+
+// ErrAlreadyExists indicates an object with the same id is already present
+type ErrAlreadyExists struct {
+	msg string
+}
+
+func (e ErrAlreadyExists) Error() string {
+	return e.msg
+}
+
+// NewErrAlreadyExists with Errorf signature
+func NewErrAlreadyExists(format string, args ...interface{}) ErrAlreadyExists {
+	return ErrAlreadyExists{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrVersionConflict indicates the object has been changed since the version
+// an update was based on
+type ErrVersionConflict struct {
+	msg string
+}
+
+func (e ErrVersionConflict) Error() string {
+	return e.msg
+}
+
+// NewErrVersionConflict with Errorf signature
+func NewErrVersionConflict(format string, args ...interface{}) ErrVersionConflict {
+	return ErrVersionConflict{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrReadOnly indicates the targeted class is frozen and rejects writes
+type ErrReadOnly struct {
+	msg string
+}
+
+func (e ErrReadOnly) Error() string {
+	return e.msg
+}
+
+// NewErrReadOnly with Errorf signature
+func NewErrReadOnly(format string, args ...interface{}) ErrReadOnly {
+	return ErrReadOnly{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrNotImplemented indicates the operation is not supported by the
+// configured storage
+type ErrNotImplemented struct {
+	msg string
+}
+
+func (e ErrNotImplemented) Error() string {
+	return e.msg
+}
+
+// NewErrNotImplemented with Errorf signature
+func NewErrNotImplemented(format string, args ...interface{}) ErrNotImplemented {
+	return ErrNotImplemented{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrRateLimited indicates the write rate limit was exceeded. RetryAfter is
+// the time after which the write is expected to be admitted.
+type ErrRateLimited struct {
+	msg        string
+	RetryAfter time.Duration
+}
+
+func (e ErrRateLimited) Error() string {
+	return e.msg
+}
+
+// NewErrRateLimited with Errorf signature
+func NewErrRateLimited(retryAfter time.Duration, format string, args ...interface{}) ErrRateLimited {
+	return ErrRateLimited{msg: fmt.Sprintf(format, args...), RetryAfter: retryAfter}
+}
+
+// ErrUnavailable indicates the write can't be accepted right now, but is
+// expected to succeed if it is retried after RetryAfter.
+type ErrUnavailable struct {
+	msg        string
+	RetryAfter time.Duration
+}
+
+func (e ErrUnavailable) Error() string {
+	return e.msg
+}
+
+// NewErrUnavailable with Errorf signature
+func NewErrUnavailable(retryAfter time.Duration, format string, args ...interface{}) ErrUnavailable {
+	return ErrUnavailable{msg: fmt.Sprintf(format, args...), RetryAfter: retryAfter}
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/events"
+)
+
+type eventEmitter interface {
+	// Emit must not block, delivery happens asynchronously
+	Emit(event events.Event)
+}
+
+type noopEmitter struct{}
+
+func (n noopEmitter) Emit(event events.Event) {}
+
+// SetEventEmitter enables the emission of an event for every successful
+// create, update or delete of a thing or action
+func (m *Manager) SetEventEmitter(emitter eventEmitter) {
+	m.events = emitter
+}
+
+func (m *Manager) emitEvent(k kind.Kind, className string, id strfmt.UUID,
+	op events.Operation) {
+	m.events.Emit(events.Event{
+		Kind:      k,
+		Class:     className,
+		ID:        id,
+		Operation: op,
+		Timestamp: m.timeSource.Now(),
+	})
+}
+
+// SetEventEmitter enables the emission of an event for every thing, action
+// or reference source which was successfully written as part of a batch
+func (b *BatchManager) SetEventEmitter(emitter eventEmitter) {
+	b.events = emitter
+}
+
+func (b *BatchManager) emitThingEvents(classes []*models.Thing, res BatchThings) {
+	for _, item := range res {
+		if item.Err != nil {
+			continue
+		}
+
+		b.emitEvent(kind.Thing, classes[item.OriginalIndex].Class, item.UUID)
+	}
+}
+
+func (b *BatchManager) emitActionEvents(classes []*models.Action, res BatchActions) {
+	for _, item := range res {
+		if item.Err != nil {
+			continue
+		}
+
+		b.emitEvent(kind.Action, classes[item.OriginalIndex].Class, item.UUID)
+	}
+}
+
+func (b *BatchManager) emitReferenceEvents(res BatchReferences) {
+	for _, item := range res {
+		if item.Err != nil || item.From == nil {
+			continue
+		}
+
+		b.events.Emit(events.Event{
+			Kind:      item.From.Kind,
+			Class:     item.From.Class.String(),
+			ID:        item.From.TargetID,
+			Operation: events.OperationUpdate,
+			Timestamp: b.timeSource.Now(),
+		})
+	}
+}
+
+// emitEvent for a batch import, which creates the object or replaces it if
+// it already existed
+func (b *BatchManager) emitEvent(k kind.Kind, className string, id strfmt.UUID) {
+	b.events.Emit(events.Event{
+		Kind:      k,
+		Class:     className,
+		ID:        id,
+		Operation: events.OperationCreate,
+		Timestamp: b.timeSource.Now(),
+	})
+}
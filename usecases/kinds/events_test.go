@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/events"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_EmitEvents(t *testing.T) {
+	var (
+		manager    *Manager
+		vectorRepo *fakeVectorRepo
+		emitter    *fakeEmitter
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "MyThing",
+		}, nil).Once()
+		schemaManager := &fakeSchemaManager{}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer := &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer, vectorizer, vectorRepo, extender, projector)
+		manager.timeSource = fakeTimeSource{}
+		emitter = &fakeEmitter{}
+		manager.SetEventEmitter(emitter)
+	}
+
+	t.Run("a successful delete emits an event", func(t *testing.T) {
+		reset()
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(nil).Once()
+
+		err := manager.DeleteThing(context.Background(), nil, id)
+
+		assert.Nil(t, err)
+		expected := []events.Event{{
+			Kind:      kind.Thing,
+			Class:     "MyThing",
+			ID:        id,
+			Operation: events.OperationDelete,
+			Timestamp: fakeTimeSource{}.Now(),
+		}}
+		assert.Equal(t, expected, emitter.emitted)
+	})
+
+	t.Run("a failed delete does not emit an event", func(t *testing.T) {
+		reset()
+		vectorRepo.On("DeleteThing", "MyThing", id).Return(errors.New("oops")).Once()
+
+		err := manager.DeleteThing(context.Background(), nil, id)
+
+		assert.NotNil(t, err)
+		assert.Len(t, emitter.emitted, 0)
+	})
+}
+
+func Test_BatchEmitEvents(t *testing.T) {
+	var (
+		manager    *BatchManager
+		vectorRepo *fakeVectorRepo
+		emitter    *fakeEmitter
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{{Class: "MyThing"}},
+			},
+		}}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		manager = NewBatchManager(vectorRepo, vectorizer, &fakeLocks{}, schemaManager,
+			nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+		manager.timeSource = fakeTimeSource{}
+		emitter = &fakeEmitter{}
+		manager.SetEventEmitter(emitter)
+	}
+
+	t.Run("a successfully imported thing emits an event", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThings(context.Background(), nil, []*models.Thing{
+			{ID: id, Class: "MyThing"},
+		}, []*string{})
+
+		assert.Nil(t, err)
+		expected := []events.Event{{
+			Kind:      kind.Thing,
+			Class:     "MyThing",
+			ID:        id,
+			Operation: events.OperationCreate,
+			Timestamp: fakeTimeSource{}.Now(),
+		}}
+		assert.Equal(t, expected, emitter.emitted)
+	})
+
+	t.Run("a thing which failed validation does not emit an event", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThings(context.Background(), nil, []*models.Thing{
+			{ID: id, Class: "NotAClass"},
+		}, []*string{})
+
+		assert.Nil(t, err)
+		assert.Len(t, emitter.emitted, 0)
+	})
+}
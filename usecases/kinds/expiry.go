@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
+	"github.com/sirupsen/logrus"
+)
+
+// expirySweepPageSize is the maximum amount of expired objects deleted in a
+// single round trip to the repo
+const expirySweepPageSize = 100
+
+// ExpiredObjectsLister finds objects whose expiry time is not after now
+type ExpiredObjectsLister interface {
+	ExpiredObjects(ctx context.Context, now int64, limit int) ([]search.Result, error)
+}
+
+// validateExpiry makes sure an expiry time is either unset or in the future
+func validateExpiry(expiry, now int64) error {
+	if expiry != 0 && expiry <= now {
+		return NewErrInvalidUserInput("expiryTimeUnix %d is not in the future", expiry)
+	}
+
+	return nil
+}
+
+// deleteExpired removes an expired object. It is not triggered by a user,
+// so there is no principal to authorize. Frozen classes are not protected,
+// as the expiry was set when the object was written.
+func (m *Manager) deleteExpired(ctx context.Context, k kind.Kind, className string,
+	id strfmt.UUID) error {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	switch k {
+	case kind.Thing:
+		err = m.vectorRepo.DeleteThing(ctx, className, id)
+	case kind.Action:
+		err = m.vectorRepo.DeleteAction(ctx, className, id)
+	default:
+		err = NewErrInternal("impossible kind: %v", k)
+	}
+	if err != nil {
+		err = NewErrInternal("could not delete expired %s from vector repo: %v", k.Name(), err)
+	} else {
+		m.emitEvent(k, className, id, events.OperationDelete)
+	}
+
+	m.audit(ctx, nil, audit.OperationExpire, k, className, id, err)
+	return err
+}
+
+// ExpirySweeper periodically deletes all things and actions whose expiry
+// time has passed. Until then, expired objects are already hidden from
+// reads by the repo.
+type ExpirySweeper struct {
+	manager  *Manager
+	lister   ExpiredObjectsLister
+	interval time.Duration
+	logger   logrus.FieldLogger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	stopped  chan struct{}
+}
+
+// NewExpirySweeper which runs every interval, call Start to begin sweeping
+func NewExpirySweeper(manager *Manager, lister ExpiredObjectsLister,
+	interval time.Duration, logger logrus.FieldLogger) *ExpirySweeper {
+	return &ExpirySweeper{
+		manager:  manager,
+		lister:   lister,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Start sweeping in the background
+func (s *ExpirySweeper) Start() {
+	go s.work()
+}
+
+// Stop sweeping. A sweep which is currently running is cancelled.
+func (s *ExpirySweeper) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	<-s.stopped
+}
+
+func (s *ExpirySweeper) work() {
+	defer close(s.stopped)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-s.stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+// sweep deletes expired objects page by page. If a deletion fails, the
+// sweep ends, so that the same failing objects aren't listed over and
+// over. They are retried in the next sweep.
+func (s *ExpirySweeper) sweep(ctx context.Context) int {
+	deleted := 0
+	for {
+		now := s.manager.timeSource.Now()
+		expired, err := s.lister.ExpiredObjects(ctx, now, expirySweepPageSize)
+		if err != nil {
+			s.logger.WithField("action", "expiry_sweep_list_failed").
+				WithError(err).
+				Error("could not list expired objects")
+			return deleted
+		}
+
+		for _, obj := range expired {
+			err := s.manager.deleteExpired(ctx, obj.Kind, obj.ClassName, obj.ID)
+			if err != nil {
+				s.logger.WithField("action", "expiry_sweep_delete_failed").
+					WithField("kind", obj.Kind).
+					WithField("class", obj.ClassName).
+					WithField("id", obj.ID).
+					WithError(err).
+					Errorf("could not delete expired %s %s", obj.Kind.Name(), obj.ID)
+				return deleted
+			}
+
+			deleted++
+		}
+
+		if len(expired) < expirySweepPageSize {
+			if deleted > 0 {
+				s.logger.WithField("action", "expiry_sweep_completed").
+					WithField("deleted", deleted).
+					Debugf("deleted %d expired object(s)", deleted)
+			}
+			return deleted
+		}
+	}
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ExpirySweeper(t *testing.T) {
+	var (
+		manager    *Manager
+		vectorRepo *fakeVectorRepo
+		sink       *fakeAuditSink
+		lister     *fakeExpiredObjectsLister
+		sweeper    *ExpirySweeper
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		manager.timeSource = fakeTimeSource{}
+		sink = &fakeAuditSink{}
+		manager.SetAuditSink(sink)
+		lister = &fakeExpiredObjectsLister{}
+		sweeper = NewExpirySweeper(manager, lister, 0, logger)
+	}
+
+	thingID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	actionID := strfmt.UUID("6a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	t.Run("expired things and actions are deleted", func(t *testing.T) {
+		reset()
+		lister.pages = [][]search.Result{{
+			{Kind: kind.Thing, ClassName: "MyThing", ID: thingID},
+			{Kind: kind.Action, ClassName: "MyAction", ID: actionID},
+		}}
+		vectorRepo.On("DeleteThing", "MyThing", thingID).Return(nil).Once()
+		vectorRepo.On("DeleteAction", "MyAction", actionID).Return(nil).Once()
+
+		deleted := sweeper.sweep(context.Background())
+
+		assert.Equal(t, 2, deleted)
+		assert.Equal(t, []int64{fakeTimeSource{}.Now()}, lister.calledWith)
+		vectorRepo.AssertExpectations(t)
+		expected := []audit.Entry{{
+			Timestamp: fakeTimeSource{}.Now(),
+			Username:  audit.AnonymousUsername,
+			Operation: audit.OperationExpire,
+			Kind:      kind.Thing,
+			Class:     "MyThing",
+			ID:        thingID,
+		}, {
+			Timestamp: fakeTimeSource{}.Now(),
+			Username:  audit.AnonymousUsername,
+			Operation: audit.OperationExpire,
+			Kind:      kind.Action,
+			Class:     "MyAction",
+			ID:        actionID,
+		}}
+		assert.Equal(t, expected, sink.written)
+	})
+
+	t.Run("full pages are followed by another page", func(t *testing.T) {
+		reset()
+		fullPage := make([]search.Result, expirySweepPageSize)
+		for i := range fullPage {
+			id := strfmt.UUID(fmt.Sprintf("5a1cd361-1e0d-42ae-bd52-%012d", i))
+			fullPage[i] = search.Result{Kind: kind.Thing, ClassName: "MyThing", ID: id}
+		}
+		lister.pages = [][]search.Result{fullPage, {
+			{Kind: kind.Action, ClassName: "MyAction", ID: actionID},
+		}}
+		vectorRepo.On("DeleteThing", "MyThing", mock.Anything).Return(nil)
+		vectorRepo.On("DeleteAction", "MyAction", actionID).Return(nil).Once()
+
+		deleted := sweeper.sweep(context.Background())
+
+		assert.Equal(t, expirySweepPageSize+1, deleted)
+		assert.Len(t, lister.calledWith, 2)
+	})
+
+	t.Run("a failed delete ends the sweep", func(t *testing.T) {
+		reset()
+		lister.pages = [][]search.Result{{
+			{Kind: kind.Thing, ClassName: "MyThing", ID: thingID},
+			{Kind: kind.Action, ClassName: "MyAction", ID: actionID},
+		}}
+		vectorRepo.On("DeleteThing", "MyThing", thingID).Return(errors.New("oops")).Once()
+
+		deleted := sweeper.sweep(context.Background())
+
+		assert.Equal(t, 0, deleted)
+		vectorRepo.AssertNotCalled(t, "DeleteAction", mock.Anything, mock.Anything)
+		assert.Len(t, sink.written, 1)
+		assert.Equal(t, "could not delete expired thing from vector repo: oops",
+			sink.written[0].Error)
+	})
+
+	t.Run("a failed listing ends the sweep", func(t *testing.T) {
+		reset()
+		lister.err = errors.New("oops")
+
+		deleted := sweeper.sweep(context.Background())
+
+		assert.Equal(t, 0, deleted)
+		assert.Len(t, sink.written, 0)
+	})
+}
+
+func Test_ExpiryValidation(t *testing.T) {
+	vectorRepo := &fakeVectorRepo{}
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+		Things: &models.Schema{Classes: []*models.Class{{Class: "Foo"}}},
+	}}
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+		&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+		vectorRepo, &fakeExtender{}, &fakeProjector{})
+	manager.timeSource = fakeTimeSource{}
+
+	_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+		Class:          "Foo",
+		ExpiryTimeUnix: fakeTimeSource{}.Now(),
+	})
+
+	assert.Equal(t, NewErrInvalidUserInput("expiryTimeUnix 12345 is not in the future"), err)
+	vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+}
+
+type fakeExpiredObjectsLister struct {
+	pages      [][]search.Result
+	err        error
+	calledWith []int64
+}
+
+func (f *fakeExpiredObjectsLister) ExpiredObjects(ctx context.Context, now int64,
+	limit int) ([]search.Result, error) {
+	f.calledWith = append(f.calledWith, now)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if len(f.pages) == 0 {
+		return nil, nil
+	}
+
+	page := f.pages[0]
+	f.pages = f.pages[1:]
+	return page, nil
+}
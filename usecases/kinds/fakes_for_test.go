@@ -13,6 +13,9 @@ package kinds
 
 import (
 	"context"
+	"io"
+	"sort"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -20,6 +23,8 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
 	"github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
@@ -35,6 +40,7 @@ type fakeSchemaManager struct {
 		toClass   string
 	}
 	GetSchemaResponse schema.Schema
+	AddedProperties   []*models.Property
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -53,6 +59,14 @@ func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, princ
 	return nil
 }
 
+func (f *fakeSchemaManager) UpdateClassAddProperty(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, prop *models.Property) error {
+	class := f.GetSchemaResponse.GetClass(k, schema.ClassName(className))
+	class.Properties = append(class.Properties, prop)
+	f.AddedProperties = append(f.AddedProperties, prop)
+	return nil
+}
+
 func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schema, error) {
 	return f.GetSchemaResponse, nil
 }
@@ -67,6 +81,26 @@ func (f *fakeLocks) LockSchema() (func() error, error) {
 	return func() error { return nil }, nil
 }
 
+// fakeHeldLocks counts how often the connector lock is acquired and how many
+// of those locks are currently held
+type fakeHeldLocks struct {
+	acquired int
+	held     int
+}
+
+func (f *fakeHeldLocks) LockConnector() (func() error, error) {
+	f.acquired++
+	f.held++
+	return func() error {
+		f.held--
+		return nil
+	}, nil
+}
+
+func (f *fakeHeldLocks) LockSchema() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
 type fakeVectorizer struct {
 	mock.Mock
 }
@@ -81,6 +115,54 @@ func (f *fakeVectorizer) Action(ctx context.Context, action *models.Action) ([]f
 	return args.Get(0).([]float32), nil, args.Error(1)
 }
 
+func (f *fakeVectorizer) BatchThings(ctx context.Context,
+	things []*models.Thing) []vectorizer.BatchResult {
+	out := make([]vectorizer.BatchResult, len(things))
+	for i, thing := range things {
+		out[i].Vector, out[i].Source, out[i].Err = f.Thing(ctx, thing)
+	}
+	return out
+}
+
+func (f *fakeVectorizer) BatchActions(ctx context.Context,
+	actions []*models.Action) []vectorizer.BatchResult {
+	out := make([]vectorizer.BatchResult, len(actions))
+	for i, action := range actions {
+		out[i].Vector, out[i].Source, out[i].Err = f.Action(ctx, action)
+	}
+	return out
+}
+
+// ThingCorpus treats every string property as vectorized, like the real
+// vectorizer does with its default settings
+func (f *fakeVectorizer) ThingCorpus(ctx context.Context,
+	thing *models.Thing) ([]vectorizer.WeightedCorpus, error) {
+	return fakeCorpus(thing.Class, thing.Schema), nil
+}
+
+func (f *fakeVectorizer) ActionCorpus(ctx context.Context,
+	action *models.Action) ([]vectorizer.WeightedCorpus, error) {
+	return fakeCorpus(action.Class, action.Schema), nil
+}
+
+func fakeCorpus(className string, schema models.PropertySchema) []vectorizer.WeightedCorpus {
+	out := []vectorizer.WeightedCorpus{{Corpus: className, Weight: 1}}
+	props, _ := schema.(map[string]interface{})
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value, ok := props[key].(string); ok {
+			out = append(out, vectorizer.WeightedCorpus{Corpus: key + " " + value, Weight: 1})
+		}
+	}
+
+	return out
+}
+
 func (f *fakeVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
 	panic("not implemented")
 }
@@ -115,6 +197,19 @@ func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource s
 	return nil
 }
 
+// denyingAuthorizer forbids every request on one of the denied resources
+type denyingAuthorizer struct {
+	denied map[string]bool
+}
+
+func (a *denyingAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if a.denied[resource] {
+		return autherrs.NewForbidden(principal, verb, resource)
+	}
+
+	return nil
+}
+
 type fakeC11y struct{}
 
 func (f *fakeC11y) IsWordPresent(ctx context.Context, word string) (bool, error) {
@@ -134,6 +229,12 @@ func (f *fakeVectorRepo) Exists(ctx context.Context,
 	return args.Bool(0), args.Error(1)
 }
 
+func (f *fakeVectorRepo) ObjectClass(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (string, bool, error) {
+	args := f.Called(k, id)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
 func (f *fakeVectorRepo) ThingByID(ctx context.Context,
 	id strfmt.UUID, props traverser.SelectProperties, underscores traverser.UnderscoreProperties) (*search.Result, error) {
 	args := f.Called(id, props, underscores)
@@ -146,6 +247,18 @@ func (f *fakeVectorRepo) ActionByID(ctx context.Context,
 	return args.Get(0).(*search.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) ThingsByIDs(ctx context.Context,
+	ids []strfmt.UUID, props traverser.SelectProperties, underscores traverser.UnderscoreProperties) ([]*search.Result, error) {
+	args := f.Called(ids, props, underscores)
+	return args.Get(0).([]*search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ActionsByIDs(ctx context.Context,
+	ids []strfmt.UUID, props traverser.SelectProperties, underscores traverser.UnderscoreProperties) ([]*search.Result, error) {
+	args := f.Called(ids, props, underscores)
+	return args.Get(0).([]*search.Result), args.Error(1)
+}
+
 func (f *fakeVectorRepo) ThingSearch(ctx context.Context, limit int,
 	filters *filters.LocalFilter, underscores traverser.UnderscoreProperties) (search.Results, error) {
 	args := f.Called(limit, filters, underscores)
@@ -158,6 +271,35 @@ func (f *fakeVectorRepo) ActionSearch(ctx context.Context, limit int,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) ClassSearch(ctx context.Context,
+	params traverser.GetParams) ([]search.Result, error) {
+	args := f.Called(params)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) StreamSearch(ctx context.Context,
+	params traverser.GetParams, fn func(search.Result) error) error {
+	args := f.Called(params)
+	for _, res := range args.Get(0).([]search.Result) {
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (f *fakeVectorRepo) Count(ctx context.Context, k kind.Kind, className string,
+	filters *filters.LocalFilter) (int64, error) {
+	args := f.Called(k, className, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ObjectsAfter(ctx context.Context, k kind.Kind,
+	className string, after strfmt.UUID, limit int) ([]search.Result, strfmt.UUID, error) {
+	args := f.Called(k, className, after, limit)
+	return args.Get(0).([]search.Result), args.Get(1).(strfmt.UUID), args.Error(2)
+}
+
 func (f *fakeVectorRepo) PutThing(ctx context.Context,
 	concept *models.Thing, vector []float32) error {
 	args := f.Called(concept, vector)
@@ -169,6 +311,18 @@ func (f *fakeVectorRepo) PutAction(ctx context.Context,
 	return args.Error(0)
 }
 
+func (f *fakeVectorRepo) CreateThing(ctx context.Context,
+	concept *models.Thing, vector []float32) error {
+	args := f.Called(concept, vector)
+	return args.Error(0)
+}
+
+func (f *fakeVectorRepo) CreateAction(ctx context.Context,
+	concept *models.Action, vector []float32) error {
+	args := f.Called(concept, vector)
+	return args.Error(0)
+}
+
 func (f *fakeVectorRepo) BatchPutThings(ctx context.Context, batch BatchThings) (BatchThings, error) {
 	args := f.Called(batch)
 	return batch, args.Error(0)
@@ -208,6 +362,48 @@ func (f *fakeVectorRepo) AddReference(ctx context.Context, kind kind.Kind,
 	return args.Error(0)
 }
 
+func (f *fakeVectorRepo) ReindexClass(ctx context.Context, k kind.Kind,
+	className string, resume bool, vectorize func(*search.Result) ([]float32, error),
+	progress func(processed int)) error {
+	args := f.Called(k, className)
+	for _, res := range args.Get(0).([]*search.Result) {
+		if _, err := vectorize(res); err != nil {
+			return err
+		}
+		progress(1)
+	}
+	return args.Error(1)
+}
+
+func (f *fakeVectorRepo) ReshardClass(ctx context.Context, k kind.Kind,
+	className string, numberOfShards int, autoExpandReplicas string,
+	progress func(total, processed int)) error {
+	args := f.Called(k, className, numberOfShards, autoExpandReplicas)
+	total := args.Int(0)
+	for processed := 1; processed <= total; processed++ {
+		progress(total, processed)
+	}
+	return args.Error(1)
+}
+
+func (f *fakeVectorRepo) TruncateClass(ctx context.Context, k kind.Kind,
+	className string) (int64, error) {
+	args := f.Called(k, className)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeVectorRepo) SnapshotShard(ctx context.Context, k kind.Kind,
+	className, shardName string, w io.Writer) error {
+	args := f.Called(k, className, shardName)
+	return args.Error(0)
+}
+
+func (f *fakeVectorRepo) RestoreShard(ctx context.Context, k kind.Kind,
+	className, shardName string, r io.Reader) error {
+	args := f.Called(k, className, shardName)
+	return args.Error(0)
+}
+
 type fakeExtender struct {
 	single *search.Result
 	multi  []search.Result
@@ -228,3 +424,47 @@ type fakeProjector struct {
 func (f *fakeProjector) Reduce(in []search.Result, params *projector.Params) ([]search.Result, error) {
 	return f.multi, nil
 }
+
+type fakeEmitter struct {
+	emitted []events.Event
+}
+
+func (f *fakeEmitter) Emit(event events.Event) {
+	f.emitted = append(f.emitted, event)
+}
+
+type fakeIdempotencyStore struct {
+	records map[string][]byte
+	ttls    map[string]time.Duration
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{
+		records: map[string][]byte{},
+		ttls:    map[string]time.Duration{},
+	}
+}
+
+func (f *fakeIdempotencyStore) Reserve(ctx context.Context, key string,
+	pending []byte, lease time.Duration) ([]byte, error) {
+	if existing, ok := f.records[key]; ok {
+		return existing, nil
+	}
+
+	f.records[key] = pending
+	f.ttls[key] = lease
+	return nil, nil
+}
+
+func (f *fakeIdempotencyStore) Complete(ctx context.Context, key string,
+	record []byte, ttl time.Duration) error {
+	f.records[key] = record
+	f.ttls[key] = ttl
+	return nil
+}
+
+func (f *fakeIdempotencyStore) Release(ctx context.Context, key string) error {
+	delete(f.records, key)
+	delete(f.ttls, key)
+	return nil
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// checkNotFrozen returns an ErrReadOnly if the class has been frozen in the
+// schema. Unknown classes are left to the regular validation.
+func (m *Manager) checkNotFrozen(principal *models.Principal, k kind.Kind,
+	className string) error {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	return checkClassNotFrozen(s, k, className)
+}
+
+func checkClassNotFrozen(s schema.Schema, k kind.Kind, className string) error {
+	class := s.GetClass(k, schema.ClassName(className))
+	if class != nil && class.Frozen {
+		return NewErrReadOnly("class '%s' is frozen and does not accept writes", className)
+	}
+
+	return nil
+}
+
+// checkBatchNotFrozen fails the whole batch as soon as one of the targeted
+// classes is frozen, rather than rejecting the objects one by one.
+func (b *BatchManager) checkBatchNotFrozen(principal *models.Principal, k kind.Kind,
+	classNames []string) error {
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	for _, className := range classNames {
+		if err := checkClassNotFrozen(s, k, className); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
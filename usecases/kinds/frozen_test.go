@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_FrozenClass(t *testing.T) {
+	var (
+		manager      *Manager
+		batchManager *BatchManager
+		vectorRepo   *fakeVectorRepo
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	frozenSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:  "FrozenThing",
+					Frozen: true,
+				},
+			},
+		},
+	}
+	expectedErr := NewErrReadOnly("class 'FrozenThing' is frozen and does not accept writes")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: frozenSchema}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer := &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer,
+			vectorizer, vectorRepo, extender, projector)
+		batchManager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, network, cfg, logger, authorizer)
+	}
+
+	ctx := context.Background()
+
+	t.Run("adding a thing is rejected", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "FrozenThing"})
+
+		assert.Equal(t, expectedErr, err)
+		vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleting a thing is rejected", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "FrozenThing",
+		}, nil).Once()
+
+		err := manager.DeleteThing(ctx, nil, id)
+
+		assert.Equal(t, expectedErr, err)
+		vectorRepo.AssertNotCalled(t, "DeleteThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reading a thing still works", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "FrozenThing",
+			ID:        id,
+		}, nil).Once()
+
+		thing, err := manager.GetThing(ctx, nil, id, traverser.UnderscoreProperties{}, ExpandParams{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, id, thing.ID)
+	})
+
+	t.Run("a batch fails fast", func(t *testing.T) {
+		reset()
+
+		_, err := batchManager.AddThings(ctx, nil, []*models.Thing{
+			{Class: "FrozenThing"},
+		}, nil)
+
+		assert.Equal(t, expectedErr, err)
+		vectorRepo.AssertNotCalled(t, "BatchPutThings", mock.Anything)
+	})
+}
@@ -17,15 +17,18 @@ import (
 	"math"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
-	"github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // GetThing Class from the connected DB
 func (m *Manager) GetThing(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, underscore traverser.UnderscoreProperties) (*models.Thing, error) {
+	id strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand ExpandParams) (*models.Thing, error) {
 	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("things/%s", id.String()))
 	if err != nil {
 		return nil, err
@@ -46,12 +49,17 @@ func (m *Manager) GetThing(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := m.expandReferences(ctx, principal, res, expand); err != nil {
+		return nil, err
+	}
+
 	return res.Thing(), nil
 }
 
 // GetThings Class from the connected DB
 func (m *Manager) GetThings(ctx context.Context, principal *models.Principal,
-	limit *int64, underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
+	limit *int64, className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
 	err := m.authorizer.Authorize(principal, "list", "things")
 	if err != nil {
 		return nil, err
@@ -63,12 +71,86 @@ func (m *Manager) GetThings(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.getThingsFromRepo(ctx, limit, underscore)
+	if err := m.validateListFilters(principal, kind.Thing, className, where); err != nil {
+		return nil, err
+	}
+
+	return m.getThingsFromRepo(ctx, limit, className, where, underscore)
+}
+
+// CountThings returns the number of things of the class which match the
+// where filter. Authorization and filter validation are the same as for
+// GetThings, but no objects are loaded.
+func (m *Manager) CountThings(ctx context.Context, principal *models.Principal,
+	className string, where *filters.LocalFilter) (int64, error) {
+	err := m.authorizer.Authorize(principal, "list", "things")
+	if err != nil {
+		return 0, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return 0, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := m.validateListFilters(principal, kind.Thing, className, where); err != nil {
+		return 0, err
+	}
+
+	count, err := m.vectorRepo.Count(ctx, kind.Thing, className, where)
+	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return 0, err
+		}
+		return 0, NewErrInternal("count things: %v", err)
+	}
+
+	return count, nil
+}
+
+// GetThingsAfter lists the things of a class in id order, starting after the
+// cursor. Other than GetThings this reads the objects directly from the
+// object store, so it is suitable to iterate over all things of a class,
+// e.g. for an export. The returned cursor is used to request the next page,
+// it is empty once all things were listed.
+func (m *Manager) GetThingsAfter(ctx context.Context, principal *models.Principal,
+	limit *int64, className string, after strfmt.UUID) ([]*models.Thing, strfmt.UUID, error) {
+	err := m.authorizer.Authorize(principal, "list", "things")
+	if err != nil {
+		return nil, "", err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, "", NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if className == "" {
+		return nil, "", NewErrInvalidUserInput("a cursor requires a class to be set")
+	}
+
+	if err := m.validateListFilters(principal, kind.Thing, className, nil); err != nil {
+		return nil, "", err
+	}
+
+	res, next, err := m.vectorRepo.ObjectsAfter(ctx, kind.Thing, className, after,
+		m.localLimitOrGlobalLimit(limit))
+	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return nil, "", err
+		}
+		return nil, "", NewErrInternal("list things after cursor: %v", err)
+	}
+
+	return search.Results(res).Things(), next, nil
 }
 
 // GetAction Class from connected DB
 func (m *Manager) GetAction(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, underscore traverser.UnderscoreProperties) (*models.Action, error) {
+	id strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand ExpandParams) (*models.Action, error) {
 	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("actions/%s", id.String()))
 	if err != nil {
 		return nil, err
@@ -89,12 +171,17 @@ func (m *Manager) GetAction(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := m.expandReferences(ctx, principal, action, expand); err != nil {
+		return nil, err
+	}
+
 	return action.Action(), nil
 }
 
 // GetActions Class from connected DB
 func (m *Manager) GetActions(ctx context.Context, principal *models.Principal,
-	limit *int64, underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
+	limit *int64, className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
 	err := m.authorizer.Authorize(principal, "list", "actions")
 	if err != nil {
 		return nil, err
@@ -106,7 +193,191 @@ func (m *Manager) GetActions(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.getActionsFromRepo(ctx, limit, underscore)
+	if err := m.validateListFilters(principal, kind.Action, className, where); err != nil {
+		return nil, err
+	}
+
+	return m.getActionsFromRepo(ctx, limit, className, where, underscore)
+}
+
+// CountActions returns the number of actions of the class which match the
+// where filter. Authorization and filter validation are the same as for
+// GetActions, but no objects are loaded.
+func (m *Manager) CountActions(ctx context.Context, principal *models.Principal,
+	className string, where *filters.LocalFilter) (int64, error) {
+	err := m.authorizer.Authorize(principal, "list", "actions")
+	if err != nil {
+		return 0, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return 0, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := m.validateListFilters(principal, kind.Action, className, where); err != nil {
+		return 0, err
+	}
+
+	count, err := m.vectorRepo.Count(ctx, kind.Action, className, where)
+	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return 0, err
+		}
+		return 0, NewErrInternal("count actions: %v", err)
+	}
+
+	return count, nil
+}
+
+// GetActionsAfter lists the actions of a class in id order, starting after the
+// cursor. Other than GetActions this reads the objects directly from the
+// object store, so it is suitable to iterate over all actions of a class,
+// e.g. for an export. The returned cursor is used to request the next page,
+// it is empty once all actions were listed.
+func (m *Manager) GetActionsAfter(ctx context.Context, principal *models.Principal,
+	limit *int64, className string, after strfmt.UUID) ([]*models.Action, strfmt.UUID, error) {
+	err := m.authorizer.Authorize(principal, "list", "actions")
+	if err != nil {
+		return nil, "", err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, "", NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if className == "" {
+		return nil, "", NewErrInvalidUserInput("a cursor requires a class to be set")
+	}
+
+	if err := m.validateListFilters(principal, kind.Action, className, nil); err != nil {
+		return nil, "", err
+	}
+
+	res, next, err := m.vectorRepo.ObjectsAfter(ctx, kind.Action, className, after,
+		m.localLimitOrGlobalLimit(limit))
+	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return nil, "", err
+		}
+		return nil, "", NewErrInternal("list actions after cursor: %v", err)
+	}
+
+	return search.Results(res).Actions(), next, nil
+}
+
+// GetThingsByIDs from the connected DB. The things are returned in the order
+// of the ids, ids which could not be found are returned separately.
+func (m *Manager) GetThingsByIDs(ctx context.Context, principal *models.Principal,
+	ids []strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand BatchExpandParams) ([]*models.Thing, []strfmt.UUID, error) {
+	if err := m.authorizeByIDs(principal, "things", ids); err != nil {
+		return nil, nil, err
+	}
+
+	if underscore.FeatureProjection != nil {
+		return nil, nil, NewErrInvalidUserInput("feature projection is not possible on a batch get request")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	res, err := m.vectorRepo.ThingsByIDs(ctx, ids, traverser.SelectProperties{}, underscore)
+	if err != nil {
+		return nil, nil, NewErrInternal("repo: things by ids: %v", err)
+	}
+
+	found, notFound, err := m.splitFoundByIDs(ctx, ids, res, underscore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.expandBatchReferences(ctx, principal, found, expand); err != nil {
+		return nil, nil, err
+	}
+
+	return found.Things(), notFound, nil
+}
+
+// GetActionsByIDs from the connected DB. The actions are returned in the
+// order of the ids, ids which could not be found are returned separately.
+func (m *Manager) GetActionsByIDs(ctx context.Context, principal *models.Principal,
+	ids []strfmt.UUID, underscore traverser.UnderscoreProperties,
+	expand BatchExpandParams) ([]*models.Action, []strfmt.UUID, error) {
+	if err := m.authorizeByIDs(principal, "actions", ids); err != nil {
+		return nil, nil, err
+	}
+
+	if underscore.FeatureProjection != nil {
+		return nil, nil, NewErrInvalidUserInput("feature projection is not possible on a batch get request")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	res, err := m.vectorRepo.ActionsByIDs(ctx, ids, traverser.SelectProperties{}, underscore)
+	if err != nil {
+		return nil, nil, NewErrInternal("repo: actions by ids: %v", err)
+	}
+
+	found, notFound, err := m.splitFoundByIDs(ctx, ids, res, underscore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.expandBatchReferences(ctx, principal, found, expand); err != nil {
+		return nil, nil, err
+	}
+
+	return found.Actions(), notFound, nil
+}
+
+// authorizeByIDs checks every single object rather than the whole resource
+// type, so that a principal can only batch get what it could also get
+// individually
+func (m *Manager) authorizeByIDs(principal *models.Principal, resource string,
+	ids []strfmt.UUID) error {
+	for _, id := range ids {
+		err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("%s/%s", resource, id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) splitFoundByIDs(ctx context.Context, ids []strfmt.UUID,
+	res []*search.Result, underscore traverser.UnderscoreProperties) (search.Results, []strfmt.UUID, error) {
+	var found search.Results
+	var notFound []strfmt.UUID
+	for i, obj := range res {
+		if obj == nil {
+			notFound = append(notFound, ids[i])
+			continue
+		}
+
+		found = append(found, *obj)
+	}
+
+	if underscore.NearestNeighbors && len(found) > 0 {
+		var err error
+		found, err = m.nnExtender.Multi(ctx, found, nil)
+		if err != nil {
+			return nil, nil, NewErrInternal("extend nearest neighbors: %v", err)
+		}
+	}
+
+	return found, notFound, nil
 }
 
 func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID,
@@ -131,11 +402,27 @@ func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID,
 }
 
 func (m *Manager) getThingsFromRepo(ctx context.Context, limit *int64,
+	className string, where *filters.LocalFilter,
 	underscore traverser.UnderscoreProperties) ([]*models.Thing, error) {
 	smartLimit := m.localLimitOrGlobalLimit(limit)
 
-	res, err := m.vectorRepo.ThingSearch(ctx, smartLimit, nil, underscore)
+	var res search.Results
+	var err error
+	if className == "" {
+		res, err = m.vectorRepo.ThingSearch(ctx, smartLimit, nil, underscore)
+	} else {
+		res, err = m.vectorRepo.ClassSearch(ctx, traverser.GetParams{
+			Kind:                 kind.Thing,
+			ClassName:            className,
+			Filters:              where,
+			Pagination:           &filters.Pagination{Limit: smartLimit},
+			UnderscoreProperties: underscore,
+		})
+	}
 	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return nil, err
+		}
 		return nil, NewErrInternal("list things: %v", err)
 	}
 
@@ -147,7 +434,7 @@ func (m *Manager) getThingsFromRepo(ctx context.Context, limit *int64,
 	}
 
 	if underscore.FeatureProjection != nil {
-		res, err = m.projector.Reduce(res, &projector.Params{Enabled: true})
+		res, err = m.projector.Reduce(res, underscore.FeatureProjection)
 		if err != nil {
 			return nil, NewErrInternal("perform feature projection: %v", err)
 		}
@@ -178,11 +465,27 @@ func (m *Manager) getActionFromRepo(ctx context.Context, id strfmt.UUID,
 }
 
 func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64,
+	className string, where *filters.LocalFilter,
 	underscore traverser.UnderscoreProperties) ([]*models.Action, error) {
 	smartLimit := m.localLimitOrGlobalLimit(limit)
 
-	res, err := m.vectorRepo.ActionSearch(ctx, smartLimit, nil, underscore)
+	var res search.Results
+	var err error
+	if className == "" {
+		res, err = m.vectorRepo.ActionSearch(ctx, smartLimit, nil, underscore)
+	} else {
+		res, err = m.vectorRepo.ClassSearch(ctx, traverser.GetParams{
+			Kind:                 kind.Action,
+			ClassName:            className,
+			Filters:              where,
+			Pagination:           &filters.Pagination{Limit: smartLimit},
+			UnderscoreProperties: underscore,
+		})
+	}
 	if err != nil {
+		if _, ok := err.(ErrInvalidUserInput); ok {
+			return nil, err
+		}
 		return nil, NewErrInternal("list actions: %v", err)
 	}
 
@@ -194,7 +497,7 @@ func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64,
 	}
 
 	if underscore.FeatureProjection != nil {
-		res, err = m.projector.Reduce(res, &projector.Params{Enabled: true})
+		res, err = m.projector.Reduce(res, underscore.FeatureProjection)
 		if err != nil {
 			return nil, NewErrInternal("perform feature projection: %v", err)
 		}
@@ -203,6 +506,35 @@ func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64,
 	return res.Actions(), nil
 }
 
+// validateListFilters makes sure a where filter is always scoped to a single
+// class, that this class exists for the specified kind and that every
+// property the filter is on has an inverted index
+func (m *Manager) validateListFilters(principal *models.Principal, k kind.Kind,
+	className string, where *filters.LocalFilter) error {
+	if className == "" {
+		if where != nil {
+			return NewErrInvalidUserInput("a where filter requires a class to be set")
+		}
+
+		return nil
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	if s.GetClass(k, schema.ClassName(className)) == nil {
+		return NewErrInvalidUserInput("class '%s' does not exist for kind %s", className, k.Name())
+	}
+
+	if err := filters.ValidateInvertedIndex(s, where); err != nil {
+		return NewErrInvalidUserInput("invalid where filter: %v", err)
+	}
+
+	return nil
+}
+
 func (m *Manager) localLimitOrGlobalLimit(paramMaxResults *int64) int {
 	maxResults := m.config.Config.QueryDefaults.Limit
 	// Get the max results from params, if exists
@@ -210,6 +542,8 @@ func (m *Manager) localLimitOrGlobalLimit(paramMaxResults *int64) int {
 		maxResults = *paramMaxResults
 	}
 
-	// Max results form URL, otherwise max = config.Limit.
-	return int(math.Min(float64(maxResults), float64(m.config.Config.QueryDefaults.Limit)))
+	// Max results form URL, otherwise max = config.MaxLimit (or config.Limit
+	// if no separate maximum is set).
+	return int(math.Min(float64(maxResults),
+		float64(m.config.Config.QueryDefaults.MaximumLimit())))
 }
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// streamPageSize is the number of results which are read from the repo under
+// a single connector lock, before they are handed on
+const streamPageSize = 100
+
+// StreamThings behaves like GetThings, but instead of returning a list, fn is
+// called for every thing as soon as it is read from the repo. Feature
+// projections need all results at once and are therefore not supported.
+func (m *Manager) StreamThings(ctx context.Context, principal *models.Principal,
+	limit *int64, className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties, fn func(*models.Thing) error) error {
+	err := m.authorizer.Authorize(principal, "list", "things")
+	if err != nil {
+		return err
+	}
+
+	return m.streamFromRepo(ctx, principal, kind.Thing, limit, className, where,
+		underscore, func(res search.Result) error {
+			return fn(res.Thing())
+		})
+}
+
+// StreamActions behaves like GetActions, but instead of returning a list, fn
+// is called for every action as soon as it is read from the repo. Feature
+// projections need all results at once and are therefore not supported.
+func (m *Manager) StreamActions(ctx context.Context, principal *models.Principal,
+	limit *int64, className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties, fn func(*models.Action) error) error {
+	err := m.authorizer.Authorize(principal, "list", "actions")
+	if err != nil {
+		return err
+	}
+
+	return m.streamFromRepo(ctx, principal, kind.Action, limit, className, where,
+		underscore, func(res search.Result) error {
+			return fn(res.Action())
+		})
+}
+
+func (m *Manager) validateStreamParams(principal *models.Principal, k kind.Kind,
+	className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties) error {
+	if underscore.FeatureProjection != nil {
+		return NewErrInvalidUserInput("feature projection is not possible on a streamed list")
+	}
+
+	return m.validateListFilters(principal, k, className, where)
+}
+
+// streamFromRepo reads the results in pages. The connector lock is only held
+// while a page is read, never while the results are handed to fn, which
+// might have to wait for a slow client. Otherwise a single stream could
+// block all schema changes and creates for as long as the client reads.
+func (m *Manager) streamFromRepo(ctx context.Context, principal *models.Principal,
+	k kind.Kind, limit *int64, className string, where *filters.LocalFilter,
+	underscore traverser.UnderscoreProperties, fn func(search.Result) error) error {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	locked := true
+	defer func() {
+		if locked {
+			unlock()
+		}
+	}()
+
+	if err := m.validateStreamParams(principal, k, className, where,
+		underscore); err != nil {
+		return err
+	}
+
+	page := make([]search.Result, 0, streamPageSize)
+	flush := func() error {
+		unlock()
+		locked = false
+
+		for _, res := range page {
+			if err := fn(res); err != nil {
+				return err
+			}
+		}
+		page = page[:0]
+
+		return nil
+	}
+
+	err = m.vectorRepo.StreamSearch(ctx, traverser.GetParams{
+		Kind:                 k,
+		ClassName:            className,
+		Filters:              where,
+		Pagination:           &filters.Pagination{Limit: m.localLimitOrGlobalLimit(limit)},
+		UnderscoreProperties: underscore,
+	}, func(res search.Result) error {
+		if underscore.NearestNeighbors {
+			extended, err := m.nnExtender.Single(ctx, &res, nil)
+			if err != nil {
+				return fmt.Errorf("extend nearest neighbors: %v", err)
+			}
+
+			res = *extended
+		}
+
+		page = append(page, res)
+		if len(page) < streamPageSize {
+			return nil
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+
+		unlock, err = m.locks.LockConnector()
+		if err != nil {
+			return fmt.Errorf("aquire lock: %v", err)
+		}
+		locked = true
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		return NewErrInternal("stream %s: %v", k.Name(), err)
+	}
+
+	return nil
+}
@@ -14,11 +14,14 @@ package kinds
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/projector"
@@ -70,7 +73,7 @@ func Test_GetAction(t *testing.T) {
 
 		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetAction(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
+		_, err := manager.GetAction(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{}, ExpandParams{})
 		assert.Equal(t, NewErrNotFound("no action with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -92,7 +95,7 @@ func Test_GetAction(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetAction(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
+		res, err := manager.GetAction(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{}, ExpandParams{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -119,7 +122,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetActions(context.Background(), &models.Principal{}, nil, traverser.UnderscoreProperties{})
+		res, err := manager.GetActions(context.Background(), &models.Principal{}, nil, "", nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -139,7 +142,7 @@ func Test_GetAction(t *testing.T) {
 				_, err := manager.GetAction(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
-					})
+					}, ExpandParams{})
 				assert.Equal(t, errors.New("feature projection is not possible on a non-list request"), err)
 			})
 
@@ -189,7 +192,7 @@ func Test_GetAction(t *testing.T) {
 				res, err := manager.GetAction(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
-					})
+					}, ExpandParams{})
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -245,7 +248,7 @@ func Test_GetAction(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10), "", nil,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
 					})
@@ -292,7 +295,7 @@ func Test_GetAction(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetActions(context.Background(), &models.Principal{}, ptInt64(10), "", nil,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
 					})
@@ -311,11 +314,15 @@ func Test_GetThing(t *testing.T) {
 		projectorFake *fakeProjector
 	)
 
+	vFalse := false
 	schema := schema.Schema{
 		Things: &models.Schema{
 			Classes: []*models.Class{
 				{
 					Class: "ThingClass",
+					Properties: []*models.Property{
+						{Name: "notIndexed", DataType: []string{"int"}, IndexInverted: &vFalse},
+					},
 				},
 			},
 		},
@@ -344,7 +351,7 @@ func Test_GetThing(t *testing.T) {
 
 		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetThing(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
+		_, err := manager.GetThing(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{}, ExpandParams{})
 		assert.Equal(t, NewErrNotFound("no thing with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -366,9 +373,50 @@ func Test_GetThing(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetThing(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{})
+		res, err := manager.GetThing(context.Background(), &models.Principal{}, id, traverser.UnderscoreProperties{}, ExpandParams{})
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("get things by ids", func(t *testing.T) {
+		reset()
+		found := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+		missing := strfmt.UUID("0f3c5b8e-4b2a-4c7e-9d41-3a1f2e8b6c10")
+		ids := []strfmt.UUID{missing, found}
+
+		results := []*search.Result{
+			nil,
+			&search.Result{
+				ID:        found,
+				ClassName: "ThingClass",
+				Schema:    map[string]interface{}{"foo": "bar"},
+			},
+		}
+		vectorRepo.On("ThingsByIDs", ids, mock.Anything, mock.Anything).Return(results, nil).Once()
+
+		expected := []*models.Thing{
+			&models.Thing{
+				ID:            found,
+				Class:         "ThingClass",
+				Schema:        map[string]interface{}{"foo": "bar"},
+				VectorWeights: (map[string]string)(nil),
+			},
+		}
+
+		res, notFound, err := manager.GetThingsByIDs(context.Background(), &models.Principal{}, ids,
+			traverser.UnderscoreProperties{}, BatchExpandParams{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
+		assert.Equal(t, []strfmt.UUID{missing}, notFound)
+	})
+
+	t.Run("get things by ids with a feature projection", func(t *testing.T) {
+		reset()
+		ids := []strfmt.UUID{"99ee9968-22ec-416a-9032-cff80f2f7fdf"}
+
+		_, _, err := manager.GetThingsByIDs(context.Background(), &models.Principal{}, ids,
+			traverser.UnderscoreProperties{FeatureProjection: &projector.Params{}}, BatchExpandParams{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
 	})
 
 	t.Run("list all existing things", func(t *testing.T) {
@@ -393,11 +441,229 @@ func Test_GetThing(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetThings(context.Background(), &models.Principal{}, nil, traverser.UnderscoreProperties{})
+		res, err := manager.GetThings(context.Background(), &models.Principal{}, nil, "", nil, traverser.UnderscoreProperties{})
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("list things of a class matching a where filter", func(t *testing.T) {
+		reset()
+		manager.config.Config.QueryDefaults.Limit = 20
+		id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+		where := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "ThingClass", Property: "foo"},
+			Value:    &filters.Value{Value: "bar", Type: "string"},
+		}}
+
+		results := []search.Result{
+			search.Result{
+				ID:        id,
+				ClassName: "ThingClass",
+				Schema:    map[string]interface{}{"foo": "bar"},
+			},
+		}
+		vectorRepo.On("ClassSearch", traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "ThingClass",
+			Filters:    where,
+			Pagination: &filters.Pagination{Limit: 10},
+		}).Return(results, nil).Once()
+
+		res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+			"ThingClass", where, traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("list things with a where filter, but without a class", func(t *testing.T) {
+		reset()
+		where := &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorEqual}}
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, nil,
+			"", where, traverser.UnderscoreProperties{})
+		assert.Equal(t, NewErrInvalidUserInput("a where filter requires a class to be set"), err)
+	})
+
+	t.Run("count things of a class matching a where filter", func(t *testing.T) {
+		reset()
+		where := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "ThingClass", Property: "foo"},
+			Value:    &filters.Value{Value: "bar", Type: "string"},
+		}}
+
+		vectorRepo.On("Count", kind.Thing, "ThingClass", where).Return(int64(7), nil).Once()
+
+		count, err := manager.CountThings(context.Background(), &models.Principal{},
+			"ThingClass", where)
+		require.Nil(t, err)
+		assert.Equal(t, int64(7), count)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("count things with a where filter, but without a class", func(t *testing.T) {
+		reset()
+		where := &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorEqual}}
+
+		_, err := manager.CountThings(context.Background(), &models.Principal{}, "", where)
+		assert.Equal(t, NewErrInvalidUserInput("a where filter requires a class to be set"), err)
+	})
+
+	t.Run("count things filtered on a property without an inverted index", func(t *testing.T) {
+		reset()
+		where := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "ThingClass", Property: "notIndexed"},
+			Value:    &filters.Value{Value: 7, Type: "int"},
+		}}
+
+		_, err := manager.CountThings(context.Background(), &models.Principal{},
+			"ThingClass", where)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "property 'notIndexed' of class 'ThingClass' "+
+			"has no inverted index")
+		vectorRepo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("list things of a non-existing class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetThings(context.Background(), &models.Principal{}, nil,
+			"ActionClass", nil, traverser.UnderscoreProperties{})
+		assert.Equal(t, NewErrInvalidUserInput("class 'ActionClass' does not exist for kind thing"), err)
+	})
+
+	t.Run("list things of a class after a cursor", func(t *testing.T) {
+		reset()
+		manager.config.Config.QueryDefaults.Limit = 20
+		after := strfmt.UUID("00000000-0000-0000-0000-000000000000")
+		next := strfmt.UUID("6dde1a39-4a9e-4e8b-8a74-8bbc4f4e8e6a")
+
+		results := []search.Result{
+			search.Result{ID: "1dde1a39-4a9e-4e8b-8a74-8bbc4f4e8e6a", ClassName: "ThingClass"},
+			search.Result{ID: next, ClassName: "ThingClass"},
+		}
+		vectorRepo.On("ObjectsAfter", kind.Thing, "ThingClass", after, 2).
+			Return(results, next, nil).Once()
+
+		limit := int64(2)
+		things, cursor, err := manager.GetThingsAfter(context.Background(),
+			&models.Principal{}, &limit, "ThingClass", after)
+		require.Nil(t, err)
+		require.Len(t, things, 2)
+		assert.Equal(t, results[0].ID, things[0].ID)
+		assert.Equal(t, next, cursor)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("list things after a malformed cursor", func(t *testing.T) {
+		reset()
+		after := strfmt.UUID("not-a-uuid")
+		vectorRepo.On("ObjectsAfter", kind.Thing, "ThingClass", after, mock.Anything).
+			Return([]search.Result(nil), strfmt.UUID(""),
+				NewErrInvalidUserInput("invalid cursor")).Once()
+
+		_, _, err := manager.GetThingsAfter(context.Background(),
+			&models.Principal{}, nil, "ThingClass", after)
+		assert.Equal(t, NewErrInvalidUserInput("invalid cursor"), err)
+	})
+
+	t.Run("list things after a cursor, but without a class", func(t *testing.T) {
+		reset()
+
+		_, _, err := manager.GetThingsAfter(context.Background(), &models.Principal{},
+			nil, "", "")
+		assert.Equal(t, NewErrInvalidUserInput("a cursor requires a class to be set"), err)
+	})
+
+	t.Run("stream things of a class", func(t *testing.T) {
+		reset()
+		manager.config.Config.QueryDefaults.Limit = 20
+
+		results := []search.Result{
+			search.Result{
+				ID:        "99ee9968-22ec-416a-9032-cff80f2f7fdf",
+				ClassName: "ThingClass",
+			},
+			search.Result{
+				ID:        "6dde1a39-4a9e-4e8b-8a74-8bbc4f4e8e6a",
+				ClassName: "ThingClass",
+			},
+		}
+		vectorRepo.On("StreamSearch", traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "ThingClass",
+			Pagination: &filters.Pagination{Limit: 20},
+		}).Return(results, nil).Once()
+
+		var streamed []strfmt.UUID
+		err := manager.StreamThings(context.Background(), &models.Principal{}, nil,
+			"ThingClass", nil, traverser.UnderscoreProperties{}, func(thing *models.Thing) error {
+				streamed = append(streamed, thing.ID)
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{results[0].ID, results[1].ID}, streamed)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("stream things with a feature projection", func(t *testing.T) {
+		reset()
+
+		err := manager.StreamThings(context.Background(), &models.Principal{}, nil,
+			"", nil, traverser.UnderscoreProperties{FeatureProjection: &projector.Params{}},
+			func(thing *models.Thing) error { return nil })
+		assert.Equal(t, NewErrInvalidUserInput("feature projection is not possible on a streamed list"), err)
+	})
+
+	t.Run("stream things aborted by the consumer", func(t *testing.T) {
+		reset()
+
+		results := []search.Result{
+			search.Result{ID: "99ee9968-22ec-416a-9032-cff80f2f7fdf", ClassName: "ThingClass"},
+			search.Result{ID: "6dde1a39-4a9e-4e8b-8a74-8bbc4f4e8e6a", ClassName: "ThingClass"},
+		}
+		vectorRepo.On("StreamSearch", mock.Anything).Return(results, nil).Once()
+
+		calls := 0
+		err := manager.StreamThings(context.Background(), &models.Principal{}, nil,
+			"", nil, traverser.UnderscoreProperties{}, func(thing *models.Thing) error {
+				calls++
+				return errors.New("client went away")
+			})
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stream things without holding the lock for the consumer", func(t *testing.T) {
+		reset()
+		locks := &fakeHeldLocks{}
+		manager.locks = locks
+
+		results := make([]search.Result, streamPageSize+1)
+		for i := range results {
+			results[i] = search.Result{ID: strfmt.UUID(fmt.Sprintf(
+				"99ee9968-22ec-416a-9032-%012d", i)), ClassName: "ThingClass"}
+		}
+		vectorRepo.On("StreamSearch", mock.Anything).Return(results, nil).Once()
+
+		streamed := 0
+		err := manager.StreamThings(context.Background(), &models.Principal{}, nil,
+			"", nil, traverser.UnderscoreProperties{}, func(thing *models.Thing) error {
+				assert.Equal(t, 0, locks.held)
+				streamed++
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, len(results), streamed)
+		assert.Equal(t, 2, locks.acquired)
+		assert.Equal(t, 0, locks.held)
+	})
+
 	t.Run("underscore props", func(t *testing.T) {
 		t.Run("on get single requests", func(t *testing.T) {
 			t.Run("feature projection", func(t *testing.T) {
@@ -413,7 +679,7 @@ func Test_GetThing(t *testing.T) {
 				_, err := manager.GetThing(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
-					})
+					}, ExpandParams{})
 				assert.Equal(t, errors.New("feature projection is not possible on a non-list request"), err)
 			})
 
@@ -463,7 +729,7 @@ func Test_GetThing(t *testing.T) {
 				res, err := manager.GetThing(context.Background(), &models.Principal{}, id,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
-					})
+					}, ExpandParams{})
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -519,7 +785,7 @@ func Test_GetThing(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10), "", nil,
 					traverser.UnderscoreProperties{
 						NearestNeighbors: true,
 					})
@@ -566,7 +832,7 @@ func Test_GetThing(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10),
+				res, err := manager.GetThings(context.Background(), &models.Principal{}, ptInt64(10), "", nil,
 					traverser.UnderscoreProperties{
 						FeatureProjection: &projector.Params{},
 					})
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	uuid "github.com/satori/go.uuid"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// keyPropertyNamespace is the UUIDv5 namespace of all ids derived from key
+// properties. It must never change, otherwise the same key would lead to a
+// different id than before.
+var keyPropertyNamespace = uuid.Must(uuid.FromString("d2a6a5b4-5f3e-4a8e-9f0c-8d3a1b7e6c25"))
+
+// idFromKeyProperty derives a deterministic id from the class name and the
+// value of the key property of the class. If the class has no key property
+// an empty id is returned, so that a random one can be assigned instead.
+func idFromKeyProperty(s schema.Schema, k kind.Kind, className string,
+	props interface{}) (strfmt.UUID, error) {
+	class := s.GetClass(k, schema.ClassName(className))
+	if class == nil || class.KeyProperty == "" {
+		// a non-existing class is caught by the regular validation
+		return "", nil
+	}
+
+	propsMap, _ := props.(map[string]interface{})
+	value, ok := propsMap[class.KeyProperty]
+	if !ok || value == nil {
+		return "", NewErrInvalidUserInput("class '%s' derives ids from its key property '%s', "+
+			"so it must be set if no id is given", className, class.KeyProperty)
+	}
+
+	key, err := keyPropertyValue(value)
+	if err != nil {
+		return "", NewErrInvalidUserInput("key property '%s': %v", class.KeyProperty, err)
+	}
+
+	return strfmt.UUID(uuid.NewV5(keyPropertyNamespace, className+"/"+key).String()), nil
+}
+
+// keyPropertyValue turns the value into its canonical string form. Integers
+// can arrive in different types depending on the client, they all lead to
+// the same key.
+func keyPropertyValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return "", fmt.Errorf("must not be empty")
+		}
+		return v, nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return "", fmt.Errorf("must be an integer, got %s", v)
+		}
+		return strconv.FormatInt(i, 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		if v != float64(int64(v)) {
+			return "", fmt.Errorf("must be an integer, got %v", v)
+		}
+		return strconv.FormatInt(int64(v), 10), nil
+	default:
+		return "", fmt.Errorf("must be a string or an integer, got %T", value)
+	}
+}
+
+func (m *Manager) idFromKeyProperty(principal *models.Principal, k kind.Kind,
+	className string, props interface{}) (strfmt.UUID, error) {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return "", err
+	}
+
+	return idFromKeyProperty(s, k, className, props)
+}
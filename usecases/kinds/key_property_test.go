@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func keyPropertySchemaForTest() schema.Schema {
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:       "Book",
+					KeyProperty: "isbn",
+					Properties: []*models.Property{
+						{Name: "isbn", DataType: []string{"string"}},
+						{Name: "title", DataType: []string{"string"}},
+					},
+				},
+				{
+					Class:       "Edition",
+					KeyProperty: "number",
+					Properties: []*models.Property{
+						{Name: "number", DataType: []string{"int"}},
+					},
+				},
+				{
+					Class: "Magazine",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"string"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_IDFromKeyProperty(t *testing.T) {
+	s := keyPropertySchemaForTest()
+
+	t.Run("class without a key property", func(t *testing.T) {
+		id, err := idFromKeyProperty(s, kind.Thing, "Magazine",
+			map[string]interface{}{"title": "foo"})
+		require.Nil(t, err)
+		assert.Equal(t, "", id.String())
+	})
+
+	t.Run("the same key leads to the same id", func(t *testing.T) {
+		first, err := idFromKeyProperty(s, kind.Thing, "Book",
+			map[string]interface{}{"isbn": "978-3-16", "title": "foo"})
+		require.Nil(t, err)
+		second, err := idFromKeyProperty(s, kind.Thing, "Book",
+			map[string]interface{}{"isbn": "978-3-16", "title": "bar"})
+		require.Nil(t, err)
+
+		assert.Len(t, first, 36)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("different keys lead to different ids", func(t *testing.T) {
+		first, err := idFromKeyProperty(s, kind.Thing, "Book",
+			map[string]interface{}{"isbn": "978-3-16"})
+		require.Nil(t, err)
+		second, err := idFromKeyProperty(s, kind.Thing, "Book",
+			map[string]interface{}{"isbn": "978-3-17"})
+		require.Nil(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("int keys are independent of their representation", func(t *testing.T) {
+		values := []interface{}{json.Number("7"), int64(7), float64(7), "7"}
+		expected, err := idFromKeyProperty(s, kind.Thing, "Edition",
+			map[string]interface{}{"number": 7})
+		require.Nil(t, err)
+
+		for _, value := range values {
+			id, err := idFromKeyProperty(s, kind.Thing, "Edition",
+				map[string]interface{}{"number": value})
+			require.Nil(t, err)
+			assert.Equal(t, expected, id)
+		}
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		props := []interface{}{
+			nil,
+			map[string]interface{}{},
+			map[string]interface{}{"isbn": nil},
+			map[string]interface{}{"isbn": ""},
+			map[string]interface{}{"isbn": 7.5},
+			map[string]interface{}{"isbn": true},
+		}
+
+		for _, prop := range props {
+			_, err := idFromKeyProperty(s, kind.Thing, "Book", prop)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+		}
+	})
+}
+
+func Test_Add_Thing_WithKeyProperty(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(nil).Once()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: keyPropertySchemaForTest(),
+		}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer, vectorizer, vectorRepo, extender, projector)
+	}
+
+	expectedID, err := idFromKeyProperty(keyPropertySchemaForTest(), kind.Thing, "Book",
+		map[string]interface{}{"isbn": "978-3-16"})
+	require.Nil(t, err)
+
+	t.Run("without an id set", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Exists", expectedID).Return(false, nil).Once()
+
+		res, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Book",
+			Schema: map[string]interface{}{"isbn": "978-3-16"},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, expectedID, res.ID)
+	})
+
+	t.Run("with the key already taken", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Exists", expectedID).Return(true, nil).Once()
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Book",
+			Schema: map[string]interface{}{"isbn": "978-3-16"},
+		})
+
+		assert.Equal(t, NewErrAlreadyExists("id '%s' already exists", expectedID), err)
+	})
+
+	t.Run("with an explicit id set", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		vectorRepo.On("Exists", id).Return(false, nil).Once()
+
+		res, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			ID:     id,
+			Class:  "Book",
+			Schema: map[string]interface{}{"isbn": "978-3-16"},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, id, res.ID)
+	})
+
+	t.Run("without the key property set", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class:  "Book",
+			Schema: map[string]interface{}{"title": "foo"},
+		})
+
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
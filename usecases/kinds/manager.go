@@ -18,6 +18,7 @@ package kinds
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"time"
 
@@ -49,6 +50,15 @@ type Manager struct {
 	timeSource    timeSource
 	nnExtender    nnExtender
 	projector     featureProjector
+	events        eventEmitter
+	auditSink     auditSink
+	reindexes     *reindexTracker
+	reshards      *reshardTracker
+	reshardRepo   reshardRepo
+	rateLimiter   *WriteRateLimiter
+	compactor     storageCompactor
+	compacting    int32
+	classCounts   classCountsCache
 }
 
 type nnExtender interface {
@@ -67,6 +77,8 @@ type timeSource interface {
 type Vectorizer interface {
 	Thing(ctx context.Context, concept *models.Thing) ([]float32, []vectorizer.InputElement, error)
 	Action(ctx context.Context, concept *models.Action) ([]float32, []vectorizer.InputElement, error)
+	BatchThings(ctx context.Context, concepts []*models.Thing) []vectorizer.BatchResult
+	BatchActions(ctx context.Context, concepts []*models.Action) []vectorizer.BatchResult
 }
 
 type locks interface {
@@ -85,6 +97,10 @@ type network interface {
 type VectorRepo interface {
 	PutThing(ctx context.Context, concept *models.Thing, vector []float32) error
 	PutAction(ctx context.Context, concept *models.Action, vector []float32) error
+	// CreateThing and CreateAction only store the object if there is no object
+	// with its id yet, otherwise they return an ErrAlreadyExists
+	CreateThing(ctx context.Context, concept *models.Thing, vector []float32) error
+	CreateAction(ctx context.Context, concept *models.Action, vector []float32) error
 
 	DeleteAction(ctx context.Context, className string, id strfmt.UUID) error
 	DeleteThing(ctx context.Context, className string, id strfmt.UUID) error
@@ -93,17 +109,43 @@ type VectorRepo interface {
 		underscore traverser.UnderscoreProperties) (*search.Result, error)
 	ActionByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties,
 		underscore traverser.UnderscoreProperties) (*search.Result, error)
+	ThingsByIDs(ctx context.Context, ids []strfmt.UUID, props traverser.SelectProperties,
+		underscore traverser.UnderscoreProperties) ([]*search.Result, error)
+	ActionsByIDs(ctx context.Context, ids []strfmt.UUID, props traverser.SelectProperties,
+		underscore traverser.UnderscoreProperties) ([]*search.Result, error)
 
 	ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 		underscore traverser.UnderscoreProperties) (search.Results, error)
 	ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 		underscore traverser.UnderscoreProperties) (search.Results, error)
+	ClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error)
+	StreamSearch(ctx context.Context, params traverser.GetParams,
+		fn func(search.Result) error) error
+	Count(ctx context.Context, k kind.Kind, className string,
+		filters *filters.LocalFilter) (int64, error)
+	ObjectsAfter(ctx context.Context, k kind.Kind, className string,
+		after strfmt.UUID, limit int) ([]search.Result, strfmt.UUID, error)
 
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
+	// ObjectClass returns the class name of the object of kind k with the
+	// given id, it does not need to load the object itself
+	ObjectClass(ctx context.Context, k kind.Kind, id strfmt.UUID) (string, bool, error)
 
 	AddReference(ctx context.Context, kind kind.Kind, className string,
 		source strfmt.UUID, propName string, ref *models.SingleRef) error
 	Merge(ctx context.Context, merge MergeDocument) error
+
+	ReindexClass(ctx context.Context, k kind.Kind, className string,
+		resume bool, vectorize func(*search.Result) ([]float32, error),
+		progress func(processed int)) error
+	ReshardClass(ctx context.Context, k kind.Kind, className string,
+		numberOfShards int, autoExpandReplicas string,
+		progress func(total, processed int)) error
+	TruncateClass(ctx context.Context, k kind.Kind, className string) (int64, error)
+	SnapshotShard(ctx context.Context, k kind.Kind, className, shardName string,
+		w io.Writer) error
+	RestoreShard(ctx context.Context, k kind.Kind, className, shardName string,
+		r io.Reader) error
 }
 
 // NewManager creates a new manager
@@ -123,6 +165,11 @@ func NewManager(locks locks, schemaManager schemaManager,
 		nnExtender:    nnExtender,
 		timeSource:    defaultTimeSource{},
 		projector:     projector,
+		events:        noopEmitter{},
+		auditSink:     noopAuditSink{},
+		reindexes:     newReindexTracker(),
+		reshards:      newReshardTracker(),
+		reshardRepo:   noopReshardRepo{},
 	}
 }
 
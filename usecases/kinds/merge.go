@@ -21,6 +21,8 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -33,16 +35,44 @@ type MergeDocument struct {
 	Vector               []float32
 	UpdateTime           int64
 	UnderscoreProperties models.UnderscoreProperties
+
+	// UniqueReferences skips all References whose beacon is already present
+	// on the property, rather than appending a duplicate
+	UniqueReferences bool
+
+	// ExpectedVersion makes the merge fail with an ErrVersionConflict if the
+	// stored object has a different version, 0 skips the check
+	ExpectedVersion int64
 }
 
+// MergeAction merges the updated properties into the existing action. References
+// are appended to the existing references of a property, with uniqueRefs set
+// references that are already present are not added again.
+// If updated.Version is set, the merge fails with an ErrVersionConflict
+// unless the stored action still has this version.
 func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, updated *models.Action) error {
+	id strfmt.UUID, updated *models.Action, uniqueRefs bool) error {
 
 	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
+	err = m.mergeActionIntoRepo(ctx, principal, id, updated, uniqueRefs)
+	m.audit(ctx, principal, audit.OperationMerge, kind.Action, updated.Class, id, err)
+	return err
+}
+
+func (m *Manager) mergeActionIntoRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, updated *models.Action, uniqueRefs bool) error {
+	if err := m.checkNotFrozen(principal, kind.Action, updated.Class); err != nil {
+		return err
+	}
+
+	if err := m.rateLimiter.Take(map[string]int{updated.Class: 1}); err != nil {
+		return err
+	}
+
 	previous, err := m.retrievePreviousAndValidateMergeAction(ctx, principal, id, updated)
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
@@ -50,11 +80,28 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Action)
 
-	vector, source, err := m.mergeActionSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	if err := m.validateMergedReferenceCounts(principal, kind.Action, previous, refs,
+		uniqueRefs); err != nil {
+		return err
+	}
+
+	if err := validateObjectSize(m.config, &models.Action{
+		Class:  updated.Class,
+		ID:     id,
+		Schema: mergedObjectSchema(previous.Schema, updated.Schema.(map[string]interface{})),
+	}); err != nil {
+		return err
+	}
+
+	vector, interpretation, err := m.mergeActionSchemasAndVectorize(ctx, previous, primitive)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
 
+	if err := validateVectorDimensions(m.config, vector); err != nil {
+		return err
+	}
+
 	err = m.vectorRepo.Merge(ctx, MergeDocument{
 		Kind:            kind.Action,
 		Class:           updated.Class,
@@ -64,15 +111,20 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 		Vector:          vector,
 		UpdateTime:      m.timeSource.Now(),
 		UnderscoreProperties: models.UnderscoreProperties{
-			Interpretation: &models.Interpretation{
-				Source: source,
-			},
+			Interpretation: interpretation,
 		},
+		UniqueReferences: uniqueRefs,
+		ExpectedVersion:  updated.Version,
 	})
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return err
+		}
 		return NewErrInternal("repo: %v", err)
 	}
 
+	m.emitEvent(kind.Action, updated.Class, id, events.OperationUpdate)
 	return nil
 }
 
@@ -106,40 +158,65 @@ func (m *Manager) retrievePreviousAndValidateMergeAction(ctx context.Context, pr
 	return action, nil
 }
 
-func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, []*models.InterpretationSource, error) {
-	var merged map[string]interface{}
-	if old == nil {
-		merged = new
-	} else {
-		oldMap, ok := old.(map[string]interface{})
+func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, previous *search.Result,
+	new map[string]interface{}) ([]float32, *models.Interpretation, error) {
+	merged := map[string]interface{}{}
+	if previous.Schema != nil {
+		oldMap, ok := previous.Schema.(map[string]interface{})
 		if !ok {
-			return nil, nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
+			return nil, nil, fmt.Errorf("expected previous schema to be map, but got %#v", previous.Schema)
 		}
 
-		for key, value := range new {
-			oldMap[key] = value
+		for key, value := range oldMap {
+			merged[key] = value
 		}
+	}
+
+	for key, value := range new {
+		merged[key] = value
+	}
 
-		merged = oldMap
+	updated := &models.Action{Class: previous.ClassName, Schema: merged}
+	if v := m.previousActionVector(ctx, previous, updated); v != nil {
+		return v, previousInterpretation(previous), nil
 	}
 
-	v, source, err := m.vectorizer.Action(ctx, &models.Action{Class: className, Schema: merged})
+	v, source, err := m.vectorizer.Action(ctx, updated)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return v, sourceFromInputElements(source), nil
+	return v, &models.Interpretation{Source: sourceFromInputElements(source)}, nil
 }
 
+// MergeThing merges the updated properties into the existing thing. References
+// are appended to the existing references of a property, with uniqueRefs set
+// references that are already present are not added again.
+// If updated.Version is set, the merge fails with an ErrVersionConflict
+// unless the stored thing still has this version.
 func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, updated *models.Thing) error {
+	id strfmt.UUID, updated *models.Thing, uniqueRefs bool) error {
 
 	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
+	err = m.mergeThingIntoRepo(ctx, principal, id, updated, uniqueRefs)
+	m.audit(ctx, principal, audit.OperationMerge, kind.Thing, updated.Class, id, err)
+	return err
+}
+
+func (m *Manager) mergeThingIntoRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, updated *models.Thing, uniqueRefs bool) error {
+	if err := m.checkNotFrozen(principal, kind.Thing, updated.Class); err != nil {
+		return err
+	}
+
+	if err := m.rateLimiter.Take(map[string]int{updated.Class: 1}); err != nil {
+		return err
+	}
+
 	previous, err := m.retrievePreviousAndValidateMergeThing(ctx, principal, id, updated)
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
@@ -147,11 +224,28 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Thing)
 
-	vector, source, err := m.mergeThingSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	if err := m.validateMergedReferenceCounts(principal, kind.Thing, previous, refs,
+		uniqueRefs); err != nil {
+		return err
+	}
+
+	if err := validateObjectSize(m.config, &models.Thing{
+		Class:  updated.Class,
+		ID:     id,
+		Schema: mergedObjectSchema(previous.Schema, updated.Schema.(map[string]interface{})),
+	}); err != nil {
+		return err
+	}
+
+	vector, interpretation, err := m.mergeThingSchemasAndVectorize(ctx, previous, primitive)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
 
+	if err := validateVectorDimensions(m.config, vector); err != nil {
+		return err
+	}
+
 	err = m.vectorRepo.Merge(ctx, MergeDocument{
 		Kind:            kind.Thing,
 		Class:           updated.Class,
@@ -161,15 +255,20 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 		Vector:          vector,
 		UpdateTime:      m.timeSource.Now(),
 		UnderscoreProperties: models.UnderscoreProperties{
-			Interpretation: &models.Interpretation{
-				Source: source,
-			},
+			Interpretation: interpretation,
 		},
+		UniqueReferences: uniqueRefs,
+		ExpectedVersion:  updated.Version,
 	})
 	if err != nil {
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return err
+		}
 		return NewErrInternal("repo: %v", err)
 	}
 
+	m.emitEvent(kind.Thing, updated.Class, id, events.OperationUpdate)
 	return nil
 }
 
@@ -203,30 +302,35 @@ func (m *Manager) retrievePreviousAndValidateMergeThing(ctx context.Context, pri
 	return thing, nil
 }
 
-func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, []*models.InterpretationSource, error) {
-	var merged map[string]interface{}
-	if old == nil {
-		merged = new
-	} else {
-		oldMap, ok := old.(map[string]interface{})
+func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, previous *search.Result,
+	new map[string]interface{}) ([]float32, *models.Interpretation, error) {
+	merged := map[string]interface{}{}
+	if previous.Schema != nil {
+		oldMap, ok := previous.Schema.(map[string]interface{})
 		if !ok {
-			return nil, nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
+			return nil, nil, fmt.Errorf("expected previous schema to be map, but got %#v", previous.Schema)
 		}
 
-		for key, value := range new {
-			oldMap[key] = value
+		for key, value := range oldMap {
+			merged[key] = value
 		}
+	}
+
+	for key, value := range new {
+		merged[key] = value
+	}
 
-		merged = oldMap
+	updated := &models.Thing{Class: previous.ClassName, Schema: merged}
+	if v := m.previousThingVector(ctx, previous, updated); v != nil {
+		return v, previousInterpretation(previous), nil
 	}
 
-	v, source, err := m.vectorizer.Thing(ctx, &models.Thing{Class: className, Schema: merged})
+	v, source, err := m.vectorizer.Thing(ctx, updated)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return v, sourceFromInputElements(source), nil
+	return v, &models.Interpretation{Source: sourceFromInputElements(source)}, nil
 }
 
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
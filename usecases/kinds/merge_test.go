@@ -40,6 +40,8 @@ func Test_MergeAction(t *testing.T) {
 		expectedOutput       *MergeDocument
 		id                   strfmt.UUID
 		vectorizerCalledWith *models.Action
+		uniqueRefs           bool
+		mergeErr             error
 	}
 
 	tests := []testCase{
@@ -196,6 +198,85 @@ func Test_MergeAction(t *testing.T) {
 				},
 			},
 		},
+		testCase{
+			id:   "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name: "with an expected version",
+			previous: &models.Action{
+				Class:  "ZooAction",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Action{
+				Class:   "ZooAction",
+				Version: 3,
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedErr: nil,
+			vectorizerCalledWith: &models.Action{
+				Class: "ZooAction",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      12345,
+				Kind:            kind.Action,
+				Class:           "ZooAction",
+				ID:              "dd59815b-142b-4c54-9b12-482434bd54ca",
+				Vector:          []float32{1, 2, 3},
+				ExpectedVersion: 3,
+				UnderscoreProperties: models.UnderscoreProperties{
+					Interpretation: &models.Interpretation{
+						Source: []*models.InterpretationSource{},
+					},
+				},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+		},
+		testCase{
+			id:   "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name: "with a version conflict",
+			previous: &models.Action{
+				Class:  "ZooAction",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Action{
+				Class:   "ZooAction",
+				Version: 3,
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			mergeErr: NewErrVersionConflict("object dd59815b-142b-4c54-9b12-482434bd54ca has been changed: " +
+				"expected version 3, but the current version is 4"),
+			expectedErr: NewErrVersionConflict("object dd59815b-142b-4c54-9b12-482434bd54ca has been changed: " +
+				"expected version 3, but the current version is 4"),
+			vectorizerCalledWith: &models.Action{
+				Class: "ZooAction",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      12345,
+				Kind:            kind.Action,
+				Class:           "ZooAction",
+				ID:              "dd59815b-142b-4c54-9b12-482434bd54ca",
+				Vector:          []float32{1, 2, 3},
+				ExpectedVersion: 3,
+				UnderscoreProperties: models.UnderscoreProperties{
+					Interpretation: &models.Interpretation{
+						Source: []*models.InterpretationSource{},
+					},
+				},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -228,15 +309,16 @@ func Test_MergeAction(t *testing.T) {
 			}
 
 			if test.expectedOutput != nil {
-				vectorRepo.On("Merge", *test.expectedOutput).Return(nil)
+				vectorRepo.On("Merge", *test.expectedOutput).Return(test.mergeErr)
 				vectorizer.On("Action", test.vectorizerCalledWith).Return([]float32{1, 2, 3}, nil)
 			}
 
 			// only for validation of cross-refs. Maybe indicates that if this call
 			// doesn't happen the test won't fail
-			vectorRepo.On("Exists", mock.Anything).Maybe().Return(true, nil)
+			vectorRepo.On("ObjectClass", mock.Anything, mock.Anything).Maybe().
+				Return("AnimalAction", true, nil)
 
-			err := manager.MergeAction(context.Background(), nil, test.id, test.updated)
+			err := manager.MergeAction(context.Background(), nil, test.id, test.updated, test.uniqueRefs)
 			assert.Equal(t, test.expectedErr, err)
 
 			vectorRepo.AssertExpectations(t)
@@ -256,6 +338,8 @@ func Test_MergeThing(t *testing.T) {
 		expectedOutput       *MergeDocument
 		id                   strfmt.UUID
 		vectorizerCalledWith *models.Thing
+		uniqueRefs           bool
+		mergeErr             error
 	}
 
 	tests := []testCase{
@@ -412,6 +496,129 @@ func Test_MergeThing(t *testing.T) {
 				},
 			},
 		},
+		testCase{
+			id:         "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name:       "appending a ref with unique references",
+			uniqueRefs: true,
+			previous: &models.Thing{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Thing{
+				Class: "Zoo",
+				Schema: map[string]interface{}{
+					"hasAnimals": []interface{}{
+						map[string]interface{}{
+							"beacon": "weaviate://localhost/things/a8ffc82c-9845-4014-876c-11369353c33c",
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+			vectorizerCalledWith: &models.Thing{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      12345,
+				Kind:            kind.Thing,
+				Class:           "Zoo",
+				ID:              "dd59815b-142b-4c54-9b12-482434bd54ca",
+				PrimitiveSchema: map[string]interface{}{},
+				Vector:          []float32{1, 2, 3},
+				UnderscoreProperties: models.UnderscoreProperties{
+					Interpretation: &models.Interpretation{
+						Source: []*models.InterpretationSource{},
+					},
+				},
+				References: BatchReferences{
+					BatchReference{
+						From: crossrefMustParseSource("weaviate://localhost/things/Zoo/dd59815b-142b-4c54-9b12-482434bd54ca/hasAnimals"),
+						To:   crossrefMustParse("weaviate://localhost/things/a8ffc82c-9845-4014-876c-11369353c33c"),
+					},
+				},
+				UniqueReferences: true,
+			},
+		},
+		testCase{
+			id:   "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name: "with an expected version",
+			previous: &models.Thing{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Thing{
+				Class:   "Zoo",
+				Version: 3,
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedErr: nil,
+			vectorizerCalledWith: &models.Thing{
+				Class: "Zoo",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      12345,
+				Kind:            kind.Thing,
+				Class:           "Zoo",
+				ID:              "dd59815b-142b-4c54-9b12-482434bd54ca",
+				Vector:          []float32{1, 2, 3},
+				ExpectedVersion: 3,
+				UnderscoreProperties: models.UnderscoreProperties{
+					Interpretation: &models.Interpretation{
+						Source: []*models.InterpretationSource{},
+					},
+				},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+		},
+		testCase{
+			id:   "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name: "with a version conflict",
+			previous: &models.Thing{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Thing{
+				Class:   "Zoo",
+				Version: 3,
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			mergeErr: NewErrVersionConflict("object dd59815b-142b-4c54-9b12-482434bd54ca has been changed: " +
+				"expected version 3, but the current version is 4"),
+			expectedErr: NewErrVersionConflict("object dd59815b-142b-4c54-9b12-482434bd54ca has been changed: " +
+				"expected version 3, but the current version is 4"),
+			vectorizerCalledWith: &models.Thing{
+				Class: "Zoo",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      12345,
+				Kind:            kind.Thing,
+				Class:           "Zoo",
+				ID:              "dd59815b-142b-4c54-9b12-482434bd54ca",
+				Vector:          []float32{1, 2, 3},
+				ExpectedVersion: 3,
+				UnderscoreProperties: models.UnderscoreProperties{
+					Interpretation: &models.Interpretation{
+						Source: []*models.InterpretationSource{},
+					},
+				},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -444,15 +651,16 @@ func Test_MergeThing(t *testing.T) {
 			}
 
 			if test.expectedOutput != nil {
-				vectorRepo.On("Merge", *test.expectedOutput).Return(nil)
+				vectorRepo.On("Merge", *test.expectedOutput).Return(test.mergeErr)
 				vectorizer.On("Thing", test.vectorizerCalledWith).Return([]float32{1, 2, 3}, nil)
 			}
 
 			// only for validation of cross-refs. Maybe indicates that if this call
 			// doesn't happen the test won't fail
-			vectorRepo.On("Exists", mock.Anything).Maybe().Return(true, nil)
+			vectorRepo.On("ObjectClass", mock.Anything, mock.Anything).Maybe().
+				Return("Animal", true, nil)
 
-			err := manager.MergeThing(context.Background(), nil, test.id, test.updated)
+			err := manager.MergeThing(context.Background(), nil, test.id, test.updated, test.uniqueRefs)
 			assert.Equal(t, test.expectedErr, err)
 
 			vectorRepo.AssertExpectations(t)
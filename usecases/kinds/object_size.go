@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// validateObjectSize rejects things and actions whose JSON serialization
+// exceeds the configured maximum size. It runs before the object is
+// vectorized or stored, so an oversized object never reaches the
+// contextionary or the repo. The object is passed in as parsed from the
+// request, so the size is only approximately that of the request body. On a
+// merge the merged object is checked, so that repeated merges can't grow an
+// object past the limit.
+func validateObjectSize(cfg *config.WeaviateConfig, object interface{}) error {
+	if cfg == nil || cfg.Config.ObjectSize.MaxBytes == 0 {
+		return nil
+	}
+
+	serialized, err := json.Marshal(object)
+	if err != nil {
+		return NewErrInvalidUserInput("determine object size: %v", err)
+	}
+
+	max := cfg.Config.ObjectSize.MaxBytes
+	if len(serialized) > max {
+		return NewErrInvalidUserInput("object is too large: it is about %s, the maximum is %s",
+			formatObjectSize(len(serialized)), formatObjectSize(max))
+	}
+
+	return nil
+}
+
+// mergedObjectSchema approximates the schema of an object after new
+// properties were merged into it. References are appended on a merge rather
+// than replaced, so for those the previous and the new references are kept.
+func mergedObjectSchema(previous interface{},
+	updated map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	if previousMap, ok := previous.(map[string]interface{}); ok {
+		for key, value := range previousMap {
+			merged[key] = value
+		}
+	}
+
+	for key, value := range updated {
+		if refs, ok := value.(models.MultipleRef); ok && merged[key] != nil {
+			merged[key] = []interface{}{merged[key], refs}
+			continue
+		}
+		merged[key] = value
+	}
+
+	return merged
+}
+
+func formatObjectSize(bytes int) string {
+	switch {
+	case bytes < 1024:
+		return fmt.Sprintf("%d bytes", bytes)
+	case bytes < 1024*1024:
+		return fmt.Sprintf("%.1f KiB", float64(bytes)/1024)
+	default:
+		return fmt.Sprintf("%.1f MiB", float64(bytes)/(1024*1024))
+	}
+}
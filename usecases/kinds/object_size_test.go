@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ObjectSizeLimit(t *testing.T) {
+	fooSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{Name: "text", DataType: []string{"text"}},
+					},
+				},
+			},
+		},
+	}
+
+	twoPropSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"text"}},
+						{Name: "text", DataType: []string{"text"}},
+					},
+				},
+			},
+		},
+	}
+
+	cfg := &config.WeaviateConfig{
+		Config: config.Config{ObjectSize: config.ObjectSize{MaxBytes: 1024}},
+	}
+
+	small := func() *models.Thing {
+		return &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"text": "small"},
+		}
+	}
+	large := func() *models.Thing {
+		return &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"text": strings.Repeat("a", 2048)},
+		}
+	}
+
+	t.Run("adding an oversized thing", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorizer := &fakeVectorizer{}
+		logger, _ := test.NewNullLogger()
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: fooSchema},
+			&fakeNetwork{}, cfg, logger, &fakeAuthorizer{}, vectorizer, vectorRepo,
+			&fakeExtender{}, &fakeProjector{})
+
+		_, err := manager.AddThing(context.Background(), nil, large())
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Equal(t, "object is too large: it is about 2.0 KiB, the maximum is 1.0 KiB",
+			err.Error())
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a batch with one oversized thing", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+		vectorizer := &fakeVectorizer{}
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		logger, _ := test.NewNullLogger()
+		manager := NewBatchManager(vectorRepo, vectorizer, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: fooSchema}, nil, cfg, logger,
+			&fakeAuthorizer{})
+
+		_, err := manager.AddThings(context.Background(), nil,
+			[]*models.Thing{small(), large(), small()}, []*string{})
+		require.Nil(t, err)
+
+		batch := vectorRepo.Calls[0].Arguments[0].(BatchThings)
+		require.Len(t, batch, 3)
+		for _, thing := range batch {
+			if thing.OriginalIndex == 1 {
+				assert.IsType(t, ErrInvalidUserInput{}, thing.Err)
+				assert.Contains(t, thing.Err.Error(), "about 2.0 KiB")
+				assert.Nil(t, thing.Vector)
+			} else {
+				assert.Nil(t, thing.Err)
+				assert.Equal(t, []float32{0, 1, 2}, thing.Vector)
+			}
+		}
+		vectorizer.AssertNumberOfCalls(t, "Thing", 2)
+	})
+
+	t.Run("a merge which grows a thing past the limit", func(t *testing.T) {
+		id := strfmt.UUID("5b6b3e9a-5f4a-4a62-9bb3-ff1b0fbb6f0b")
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "Foo",
+			Schema:    map[string]interface{}{"title": strings.Repeat("a", 600)},
+		}, nil)
+		vectorizer := &fakeVectorizer{}
+		logger, _ := test.NewNullLogger()
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: twoPropSchema},
+			&fakeNetwork{}, cfg, logger, &fakeAuthorizer{}, vectorizer, vectorRepo,
+			&fakeExtender{}, &fakeProjector{})
+
+		// each part is below the limit, only the merged thing exceeds it
+		err := manager.MergeThing(context.Background(), nil, id, &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"text": strings.Repeat("b", 600)},
+		}, false)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "object is too large")
+		vectorRepo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("without a limit", func(t *testing.T) {
+		assert.Nil(t, validateObjectSize(&config.WeaviateConfig{}, large()))
+	})
+}
+
+func Test_FormatObjectSize(t *testing.T) {
+	assert.Equal(t, "512 bytes", formatObjectSize(512))
+	assert.Equal(t, "1.5 KiB", formatObjectSize(1536))
+	assert.Equal(t, "10.0 MiB", formatObjectSize(10*1024*1024))
+}
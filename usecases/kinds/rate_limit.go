@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// WriteRateLimiter limits how many objects can be written per second, both
+// in total and per class, so that a heavy import into a single class cannot
+// starve the writes to all others. The token buckets count objects rather
+// than requests, so a batch of 100 objects costs 100 tokens.
+//
+// A bucket holds at most one second worth of tokens. A batch which is larger
+// than that is admitted as soon as the bucket is full and leaves it in debt,
+// so oversized batches are slowed down, but never rejected forever.
+type WriteRateLimiter struct {
+	sync.Mutex
+	global    *tokenBucket
+	perClass  int
+	overrides map[string]int
+	classes   map[string]*tokenBucket
+	now       func() time.Time
+}
+
+// NewWriteRateLimiter limits writes to globalPerSecond objects in total and
+// to perClassPerSecond objects for each individual class. Overrides set the
+// per-class limit of specific classes. A limit of 0 disables the respective
+// bucket.
+func NewWriteRateLimiter(globalPerSecond, perClassPerSecond int,
+	overrides map[string]int) *WriteRateLimiter {
+	l := &WriteRateLimiter{
+		perClass:  perClassPerSecond,
+		overrides: overrides,
+		classes:   map[string]*tokenBucket{},
+		now:       time.Now,
+	}
+
+	if globalPerSecond > 0 {
+		l.global = newTokenBucket(globalPerSecond, l.now())
+	}
+
+	return l
+}
+
+// Take reserves the tokens for the objects per class. Either all tokens are
+// taken or none are, in which case an ErrRateLimited is returned which
+// contains the time until the write would be admitted. A nil limiter admits
+// every write.
+func (l *WriteRateLimiter) Take(objectsPerClass map[string]int) error {
+	if l == nil {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	total := 0
+	var retryAfter time.Duration
+	var exceeded string
+
+	// iterate in a stable order, so the error message is deterministic
+	for _, className := range sortedClassNames(objectsPerClass) {
+		n := objectsPerClass[className]
+		total += n
+
+		bucket := l.classBucket(className, now)
+		if bucket == nil {
+			continue
+		}
+
+		if wait := bucket.wait(n, now); wait > retryAfter {
+			retryAfter = wait
+			exceeded = className
+		}
+	}
+
+	if l.global != nil {
+		if wait := l.global.wait(total, now); wait > retryAfter {
+			retryAfter = wait
+			exceeded = ""
+		}
+	}
+
+	if retryAfter > 0 {
+		if exceeded != "" {
+			return NewErrRateLimited(retryAfter, "write rate limit of class '%s' exceeded, "+
+				"retry after %s", exceeded, retryAfter)
+		}
+
+		return NewErrRateLimited(retryAfter, "global write rate limit exceeded, "+
+			"retry after %s", retryAfter)
+	}
+
+	for className, n := range objectsPerClass {
+		if bucket := l.classes[className]; bucket != nil {
+			bucket.take(n)
+		}
+	}
+
+	if l.global != nil {
+		l.global.take(total)
+	}
+
+	return nil
+}
+
+func (l *WriteRateLimiter) classBucket(className string, now time.Time) *tokenBucket {
+	if bucket, ok := l.classes[className]; ok {
+		return bucket
+	}
+
+	limit := l.perClass
+	if override, ok := l.overrides[className]; ok {
+		limit = override
+	}
+
+	if limit <= 0 {
+		return nil
+	}
+
+	bucket := newTokenBucket(limit, now)
+	l.classes[className] = bucket
+	return bucket
+}
+
+func sortedClassNames(objectsPerClass map[string]int) []string {
+	names := make([]string, 0, len(objectsPerClass))
+	for name := range objectsPerClass {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type tokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	updated  time.Time
+}
+
+func newTokenBucket(perSecond int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:     float64(perSecond),
+		capacity: float64(perSecond),
+		tokens:   float64(perSecond),
+		updated:  now,
+	}
+}
+
+// wait refills the bucket and returns how long it takes until n tokens can be
+// taken, 0 if they can be taken right away
+func (b *tokenBucket) wait(n int, now time.Time) time.Duration {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.rate)
+		b.updated = now
+	}
+
+	needed := math.Min(float64(n), b.capacity)
+	if b.tokens >= needed {
+		return 0
+	}
+
+	return time.Duration(math.Ceil((needed - b.tokens) / b.rate * float64(time.Second)))
+}
+
+func (b *tokenBucket) take(n int) {
+	b.tokens -= float64(n)
+}
+
+// SetWriteRateLimiter limits the rate at which things and actions can be
+// created, updated or merged. The limiter may be shared with the
+// BatchManager, so that single and batch writes count towards the same
+// limits.
+func (m *Manager) SetWriteRateLimiter(limiter *WriteRateLimiter) {
+	m.rateLimiter = limiter
+}
+
+// SetWriteRateLimiter limits the rate at which things and actions can be
+// imported in batches. Every object of a batch counts towards the limits.
+func (b *BatchManager) SetWriteRateLimiter(limiter *WriteRateLimiter) {
+	b.rateLimiter = limiter
+}
+
+func countClassNames(classNames []string) map[string]int {
+	counts := map[string]int{}
+	for _, className := range classNames {
+		counts[className]++
+	}
+	return counts
+}
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriteRateLimiter(t *testing.T) {
+	start := time.Unix(1000, 0)
+	now := start
+	newLimiter := func(global, perClass int, overrides map[string]int) *WriteRateLimiter {
+		now = start
+		l := NewWriteRateLimiter(global, perClass, overrides)
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	t.Run("a nil limiter admits every write", func(t *testing.T) {
+		var l *WriteRateLimiter
+		assert.Nil(t, l.Take(map[string]int{"Foo": 1000}))
+	})
+
+	t.Run("objects are counted rather than requests", func(t *testing.T) {
+		l := newLimiter(0, 10, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 6}))
+		err := l.Take(map[string]int{"Foo": 6})
+
+		require.IsType(t, ErrRateLimited{}, err)
+		assert.Equal(t, 200*time.Millisecond, err.(ErrRateLimited).RetryAfter)
+		assert.Equal(t, "write rate limit of class 'Foo' exceeded, retry after 200ms",
+			err.Error())
+	})
+
+	t.Run("tokens are refilled over time", func(t *testing.T) {
+		l := newLimiter(0, 10, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 10}))
+		require.NotNil(t, l.Take(map[string]int{"Foo": 5}))
+
+		now = now.Add(500 * time.Millisecond)
+		assert.Nil(t, l.Take(map[string]int{"Foo": 5}))
+	})
+
+	t.Run("classes are limited independently", func(t *testing.T) {
+		l := newLimiter(0, 10, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 10}))
+		assert.Nil(t, l.Take(map[string]int{"Bar": 10}))
+		assert.NotNil(t, l.Take(map[string]int{"Foo": 1}))
+	})
+
+	t.Run("overrides replace the per-class limit", func(t *testing.T) {
+		l := newLimiter(0, 10, map[string]int{"Foo": 100, "Unlimited": 0})
+
+		assert.Nil(t, l.Take(map[string]int{"Foo": 100}))
+		assert.Nil(t, l.Take(map[string]int{"Unlimited": 1000}))
+		require.Nil(t, l.Take(map[string]int{"Bar": 10}))
+		assert.NotNil(t, l.Take(map[string]int{"Bar": 1}))
+	})
+
+	t.Run("the global limit applies to all classes together", func(t *testing.T) {
+		l := newLimiter(10, 0, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 5, "Bar": 5}))
+		err := l.Take(map[string]int{"Baz": 1})
+
+		require.IsType(t, ErrRateLimited{}, err)
+		assert.Equal(t, 100*time.Millisecond, err.(ErrRateLimited).RetryAfter)
+		assert.Equal(t, "global write rate limit exceeded, retry after 100ms", err.Error())
+	})
+
+	t.Run("a rejected write does not take any tokens", func(t *testing.T) {
+		l := newLimiter(10, 8, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 8}))
+		require.NotNil(t, l.Take(map[string]int{"Foo": 1, "Bar": 1}))
+		assert.Nil(t, l.Take(map[string]int{"Bar": 2}))
+	})
+
+	t.Run("a batch larger than the limit is delayed, but admitted", func(t *testing.T) {
+		l := newLimiter(0, 10, nil)
+
+		require.Nil(t, l.Take(map[string]int{"Foo": 25}))
+
+		// the bucket is 15 objects in debt and needs 2.5s to be full again
+		err := l.Take(map[string]int{"Foo": 25})
+		require.IsType(t, ErrRateLimited{}, err)
+		assert.Equal(t, 2500*time.Millisecond, err.(ErrRateLimited).RetryAfter)
+
+		now = now.Add(2500 * time.Millisecond)
+		assert.Nil(t, l.Take(map[string]int{"Foo": 25}))
+	})
+}
+
+func Test_WriteRateLimitedManagers(t *testing.T) {
+	var (
+		manager      *Manager
+		batchManager *BatchManager
+		vectorRepo   *fakeVectorRepo
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{}}
+		locks := &fakeLocks{}
+		network := &fakeNetwork{}
+		cfg := &config.WeaviateConfig{}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		manager = NewManager(locks, schemaManager, network, cfg, logger, authorizer,
+			vectorizer, vectorRepo, &fakeExtender{}, &fakeProjector{})
+		batchManager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, network, cfg, logger, authorizer)
+
+		// a shared limiter whose bucket for the class is already exhausted
+		limiter := NewWriteRateLimiter(0, 10, nil)
+		require.Nil(t, limiter.Take(map[string]int{"Foo": 10}))
+		manager.SetWriteRateLimiter(limiter)
+		batchManager.SetWriteRateLimiter(limiter)
+	}
+
+	ctx := context.Background()
+
+	t.Run("adding a thing is rejected", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{Class: "Foo"})
+
+		assert.IsType(t, ErrRateLimited{}, err)
+		vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("merging into an action is rejected", func(t *testing.T) {
+		reset()
+
+		err := manager.MergeAction(ctx, nil, "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			&models.Action{Class: "Foo"}, false)
+
+		assert.IsType(t, ErrRateLimited{}, err)
+		vectorRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a batch is rejected as a whole", func(t *testing.T) {
+		reset()
+
+		_, err := batchManager.AddThings(ctx, nil, []*models.Thing{
+			{Class: "Foo"}, {Class: "Foo"},
+		}, nil)
+
+		assert.IsType(t, ErrRateLimited{}, err)
+		vectorRepo.AssertNotCalled(t, "BatchPutThings", mock.Anything, mock.Anything)
+	})
+}
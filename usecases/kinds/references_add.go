@@ -19,6 +19,8 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
@@ -40,43 +42,52 @@ func (m *Manager) AddActionReference(ctx context.Context, principal *models.Prin
 	}
 	defer unlock()
 
-	return m.addActionReferenceToConnectorAndSchema(ctx, principal, id, propertyName, property)
+	className, err := m.addActionReferenceToConnectorAndSchema(ctx, principal, id, propertyName, property)
+	m.audit(ctx, principal, audit.OperationAddReference, kind.Action, className, id, err)
+	return err
 }
 
 func (m *Manager) addActionReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (string, error) {
 
 	// get action to see if it exists
 	actionRes, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	action := actionRes.Action()
 
-	err = m.validateReference(ctx, property)
+	err = m.validateReference(ctx, principal, kind.Action, action.Class, propertyName, property)
 	if err != nil {
-		return err
+		return action.Class, err
 	}
 
 	err = m.validateCanModifyReference(principal, kind.Action, action.Class, propertyName)
 	if err != nil {
-		return err
+		return action.Class, err
+	}
+
+	err = m.validateReferenceCount(principal, kind.Action, action.Class, propertyName,
+		referenceCount(action.Schema, propertyName)+1)
+	if err != nil {
+		return action.Class, err
 	}
 
 	// the new ref could be a network ref
 	err = m.addNetworkDataTypesForAction(ctx, principal, action)
 	if err != nil {
-		return NewErrInternal("could not update schema for network refs: %v", err)
+		return action.Class, NewErrInternal("could not update schema for network refs: %v", err)
 	}
 
 	err = m.vectorRepo.AddReference(ctx, kind.Action, action.Class, action.ID,
 		propertyName, property)
 	if err != nil {
-		return NewErrInternal("add reference to vector repo: %v", err)
+		return action.Class, NewErrInternal("add reference to vector repo: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Action, action.Class, id, events.OperationUpdate)
+	return action.Class, nil
 }
 
 // AddThingReference Class Instance to the connected DB. If the class contains a network
@@ -96,47 +107,62 @@ func (m *Manager) AddThingReference(ctx context.Context, principal *models.Princ
 	}
 	defer unlock()
 
-	return m.addThingReferenceToConnectorAndSchema(ctx, principal, id, propertyName, property)
+	className, err := m.addThingReferenceToConnectorAndSchema(ctx, principal, id, propertyName, property)
+	m.audit(ctx, principal, audit.OperationAddReference, kind.Thing, className, id, err)
+	return err
 }
 
 func (m *Manager) addThingReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (string, error) {
 
 	// get thing to see if it exists
 	thingRes, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	thing := thingRes.Thing()
-	err = m.validateReference(ctx, property)
+	err = m.validateReference(ctx, principal, kind.Thing, thing.Class, propertyName, property)
 	if err != nil {
-		return err
+		return thing.Class, err
 	}
 
 	err = m.validateCanModifyReference(principal, kind.Thing, thing.Class, propertyName)
 	if err != nil {
-		return err
+		return thing.Class, err
+	}
+
+	err = m.validateReferenceCount(principal, kind.Thing, thing.Class, propertyName,
+		referenceCount(thing.Schema, propertyName)+1)
+	if err != nil {
+		return thing.Class, err
 	}
 
 	// the new ref could be a network ref
 	err = m.addNetworkDataTypesForThing(ctx, principal, thing)
 	if err != nil {
-		return NewErrInternal("could not update schema for network refs: %v", err)
+		return thing.Class, NewErrInternal("could not update schema for network refs: %v", err)
 	}
 
 	err = m.vectorRepo.AddReference(ctx, kind.Thing, thing.Class, thing.ID,
 		propertyName, property)
 	if err != nil {
-		return NewErrInternal("add reference to vector repo: %v", err)
+		return thing.Class, NewErrInternal("add reference to vector repo: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Thing, thing.Class, id, events.OperationUpdate)
+	return thing.Class, nil
 }
 
-func (m *Manager) validateReference(ctx context.Context, reference *models.SingleRef) error {
-	err := validation.New(schema.Schema{}, m.exists, m.network, m.config).
-		ValidateSingleRef(ctx, reference, "reference not found")
+func (m *Manager) validateReference(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className, propertyName string, reference *models.SingleRef) error {
+	targetClasses, err := m.referenceTargetClasses(principal, k, className, propertyName)
+	if err != nil {
+		return err
+	}
+
+	err = validation.New(schema.Schema{}, m.exists, m.network, m.config).
+		ValidateSingleRef(ctx, reference, targetClasses, "reference not found")
 	if err != nil {
 		return NewErrInvalidUserInput("invalid reference: %v", err)
 	}
@@ -144,6 +170,29 @@ func (m *Manager) validateReference(ctx context.Context, reference *models.Singl
 	return nil
 }
 
+// referenceTargetClasses returns the classes the reference property may point
+// to. If the property doesn't exist or is not a reference property, any class
+// is allowed, validateCanModifyReference reports these cases.
+func (m *Manager) referenceTargetClasses(principal *models.Principal, k kind.Kind,
+	className, propertyName string) ([]string, error) {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	prop, err := s.GetProperty(k, schema.ClassName(className), schema.PropertyName(propertyName))
+	if err != nil {
+		return nil, nil
+	}
+
+	dataType, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil || !dataType.IsReference() {
+		return nil, nil
+	}
+
+	return prop.DataType, nil
+}
+
 func (m *Manager) validateCanModifyReference(principal *models.Principal, k kind.Kind,
 	className string, propertyName string) error {
 	class, err := schema.ValidateClassName(className)
@@ -161,6 +210,10 @@ func (m *Manager) validateCanModifyReference(principal *models.Principal, k kind
 		return err
 	}
 
+	if err := checkClassNotFrozen(schema, k, className); err != nil {
+		return err
+	}
+
 	prop, err := schema.GetProperty(k, class, propName)
 	if err != nil {
 		return NewErrInvalidUserInput("Could not find property '%s': %v", propertyName, err)
@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -57,7 +58,9 @@ func Test_ReferencesAdd(t *testing.T) {
 
 	t.Run("without prior refs", func(t *testing.T) {
 		reset()
-		vectorRepo.On("Exists", mock.Anything).Return(true, nil)
+		// the target of the reference
+		vectorRepo.On("ObjectClass", kind.Thing, strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")).
+			Return("Animal", true, nil)
 		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
 			ClassName: "Zoo",
 			Schema: map[string]interface{}{
@@ -78,6 +81,105 @@ func Test_ReferencesAdd(t *testing.T) {
 		require.Nil(t, err)
 		vectorRepo.AssertExpectations(t)
 	})
+
+	t.Run("with a property pointing to multiple classes", func(t *testing.T) {
+		multiTargetSchema := func() schema.Schema {
+			s := zooAnimalSchemaForTest()
+			prop, err := s.GetProperty(kind.Thing, "Zoo", "hasAnimals")
+			require.Nil(t, err)
+			prop.DataType = []string{"Animal", "Keeper"}
+			s.Things.Classes = append(s.Things.Classes, &models.Class{
+				Class: "Keeper",
+			})
+			return s
+		}
+
+		targets := map[strfmt.UUID]string{
+			"d18c8e5e-a339-4c15-8af6-56b0cfe33ce7": "Animal",
+			"0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e01": "Keeper",
+			"0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e02": "Zoo",
+		}
+
+		setup := func() {
+			reset()
+			for id, className := range targets {
+				vectorRepo.On("ObjectClass", kind.Thing, id).Maybe().
+					Return(className, true, nil)
+			}
+			vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+				Return(&search.Result{ClassName: "Zoo"}, nil)
+			schemaManager.GetSchemaResponse = multiTargetSchema()
+		}
+
+		for _, id := range []strfmt.UUID{
+			"d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+			"0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e01",
+		} {
+			t.Run("to an allowed "+targets[id], func(t *testing.T) {
+				setup()
+				ref := &models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/things/" + id)}
+				vectorRepo.On("AddReference", kind.Thing, mock.Anything, "hasAnimals", ref).Return(nil)
+
+				err := manager.AddThingReference(context.Background(), nil,
+					strfmt.UUID("my-id"), "hasAnimals", ref)
+				require.Nil(t, err)
+				vectorRepo.AssertExpectations(t)
+			})
+		}
+
+		t.Run("to a class which is not allowed", func(t *testing.T) {
+			setup()
+			ref := &models.SingleRef{
+				Beacon: strfmt.URI("weaviate://localhost/things/0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e02"),
+			}
+
+			err := manager.AddThingReference(context.Background(), nil,
+				strfmt.UUID("my-id"), "hasAnimals", ref)
+			require.NotNil(t, err)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+			assert.Contains(t, err.Error(), "is of class 'Zoo', "+
+				"but can only point to [Animal Keeper]")
+			vectorRepo.AssertNotCalled(t, "AddReference", mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything)
+		})
+
+		t.Run("in a batch", func(t *testing.T) {
+			setup()
+			missingID := strfmt.UUID("0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e03")
+			vectorRepo.On("ObjectClass", kind.Thing, missingID).Return("", false, nil)
+			vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+			batchManager := NewBatchManager(vectorRepo, vectorizer, locks, schemaManager,
+				network, cfg, logger, authorizer)
+
+			from := strfmt.URI("weaviate://localhost/things/Zoo/" +
+				"3a3f6cd5-5e4a-4d45-9a2e-c3b1bd5c2f10/hasAnimals")
+			to := func(id strfmt.UUID) strfmt.URI {
+				return strfmt.URI("weaviate://localhost/things/" + id)
+			}
+			res, err := batchManager.AddReferences(context.Background(), nil,
+				[]*models.BatchReference{
+					{From: from, To: to("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")},
+					{From: from, To: to("0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e02")},
+					{From: from, To: to("0d5d5ea5-3b8a-4b13-9f44-2f3b6c5a1e01")},
+					{From: from, To: to(missingID)},
+					{From: from, To: to("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")},
+				})
+			require.Nil(t, err)
+			require.Len(t, res, 5)
+			assert.Nil(t, res[0].Err)
+			require.NotNil(t, res[1].Err)
+			assert.Contains(t, res[1].Err.Error(), "is of class 'Zoo', but property "+
+				"'hasAnimals' of class 'Zoo' can only point to [Animal Keeper]")
+			assert.Nil(t, res[2].Err)
+			assert.Nil(t, res[3].Err)
+			assert.Nil(t, res[4].Err)
+
+			// every target is only looked up once and the whole object is never read
+			vectorRepo.AssertNumberOfCalls(t, "ObjectClass", 4)
+			vectorRepo.AssertNotCalled(t, "ThingByID", mock.Anything, mock.Anything,
+				mock.Anything)
+		})
+	})
 }
 
 func zooAnimalSchemaForTest() schema.Schema {
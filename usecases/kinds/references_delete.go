@@ -18,6 +18,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -36,16 +38,18 @@ func (m *Manager) DeleteActionReference(ctx context.Context, principal *models.P
 	}
 	defer unlock()
 
-	return m.deleteActionReferenceFromConnector(ctx, principal, id, propertyName, property)
+	className, err := m.deleteActionReferenceFromConnector(ctx, principal, id, propertyName, property)
+	m.audit(ctx, principal, audit.OperationDeleteReference, kind.Action, className, id, err)
+	return err
 }
 
 func (m *Manager) deleteActionReferenceFromConnector(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (string, error) {
 
 	// get action to see if it exists
 	actionRes, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	action := actionRes.Action()
@@ -53,22 +57,25 @@ func (m *Manager) deleteActionReferenceFromConnector(ctx context.Context, princi
 	// of broken references
 	err = m.validateCanModifyReference(principal, kind.Action, action.Class, propertyName)
 	if err != nil {
-		return err
+		return action.Class, err
 	}
 
 	extended, err := m.removeReferenceFromClassProps(action.Schema, propertyName, property)
 	if err != nil {
-		return err
+		return action.Class, err
 	}
 	action.Schema = extended
 	action.LastUpdateTimeUnix = m.timeSource.Now()
+	// the stored version must not be treated as the expected one
+	action.Version = 0
 
 	err = m.vectorRepo.PutAction(ctx, action, actionRes.Vector)
 	if err != nil {
-		return NewErrInternal("could not store action: %v", err)
+		return action.Class, NewErrInternal("could not store action: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Action, action.Class, id, events.OperationUpdate)
+	return action.Class, nil
 }
 
 // DeleteThingReference from connected DB
@@ -86,16 +93,18 @@ func (m *Manager) DeleteThingReference(ctx context.Context, principal *models.Pr
 	}
 	defer unlock()
 
-	return m.deleteThingReferenceFromConnector(ctx, principal, id, propertyName, property)
+	className, err := m.deleteThingReferenceFromConnector(ctx, principal, id, propertyName, property)
+	m.audit(ctx, principal, audit.OperationDeleteReference, kind.Thing, className, id, err)
+	return err
 }
 
 func (m *Manager) deleteThingReferenceFromConnector(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (string, error) {
 
 	// get thing to see if it exists
 	thingRes, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	thing := thingRes.Thing()
@@ -103,22 +112,25 @@ func (m *Manager) deleteThingReferenceFromConnector(ctx context.Context, princip
 	// of broken references
 	err = m.validateCanModifyReference(principal, kind.Thing, thing.Class, propertyName)
 	if err != nil {
-		return err
+		return thing.Class, err
 	}
 
 	extended, err := m.removeReferenceFromClassProps(thing.Schema, propertyName, property)
 	if err != nil {
-		return err
+		return thing.Class, err
 	}
 	thing.Schema = extended
 	thing.LastUpdateTimeUnix = m.timeSource.Now()
+	// the stored version must not be treated as the expected one
+	thing.Version = 0
 
 	err = m.vectorRepo.PutThing(ctx, thing, thingRes.Vector)
 	if err != nil {
-		return NewErrInternal("could not store thing: %v", err)
+		return thing.Class, NewErrInternal("could not store thing: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Thing, thing.Class, id, events.OperationUpdate)
+	return thing.Class, nil
 }
 
 func (m *Manager) removeReferenceFromClassProps(props interface{}, propertyName string,
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+const (
+	// MaxExpandDepth is the maximum amount of levels of references that can be
+	// resolved inline on a single get request
+	MaxExpandDepth = 3
+
+	// MaxExpandReferences is the maximum amount of references that can be
+	// resolved inline on a single get request, summed up across all levels
+	MaxExpandReferences = 100
+)
+
+// ExpandParams control which reference properties are resolved inline when
+// getting a single thing or action. The zero value disables expansion.
+type ExpandParams struct {
+	// Properties are the names of the reference properties to resolve, they
+	// are matched on every level
+	Properties []string
+
+	// Depth is the amount of levels to resolve, must be between 1 and
+	// MaxExpandDepth if Properties are set
+	Depth int
+}
+
+func (p ExpandParams) enabled() bool {
+	return len(p.Properties) > 0
+}
+
+func (p ExpandParams) validate() error {
+	if p.Depth < 1 || p.Depth > MaxExpandDepth {
+		return NewErrInvalidUserInput("expand depth must be between 1 and %d, got %d",
+			MaxExpandDepth, p.Depth)
+	}
+
+	return nil
+}
+
+// expandReferences replaces the beacons of the specified reference properties
+// with the schema of the referenced objects. References pointing to another
+// peer, to objects which no longer exist or back to one of their own parents
+// are left untouched, as are references to objects the principal is not
+// allowed to get.
+func (m *Manager) expandReferences(ctx context.Context, principal *models.Principal,
+	res *search.Result, params ExpandParams) error {
+	if !params.enabled() {
+		return nil
+	}
+
+	if err := params.validate(); err != nil {
+		return err
+	}
+
+	e := &referenceExpander{
+		repo:       m.vectorRepo,
+		authorizer: m.authorizer,
+		principal:  principal,
+		props:      map[string]struct{}{},
+		parents:    map[strfmt.UUID]struct{}{res.ID: struct{}{}},
+	}
+	for _, prop := range params.Properties {
+		e.props[prop] = struct{}{}
+	}
+
+	return e.expandSchema(ctx, res.Schema, params.Depth)
+}
+
+type referenceExpander struct {
+	repo       VectorRepo
+	authorizer authorizer
+	principal  *models.Principal
+	props      map[string]struct{}
+	parents    map[strfmt.UUID]struct{}
+	resolved   int
+}
+
+func (e *referenceExpander) expandSchema(ctx context.Context,
+	schema models.PropertySchema, depth int) error {
+	if depth == 0 {
+		return nil
+	}
+
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for prop, value := range schemaMap {
+		if _, ok := e.props[prop]; !ok {
+			continue
+		}
+
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			continue
+		}
+
+		for _, ref := range refs {
+			if err := e.expandReference(ctx, ref, depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *referenceExpander) expandReference(ctx context.Context,
+	ref *models.SingleRef, depth int) error {
+	parsed, err := crossref.ParseSingleRef(ref)
+	if err != nil || !parsed.Local {
+		return nil
+	}
+
+	if _, ok := e.parents[parsed.TargetID]; ok {
+		// cyclic reference, resolving it would never terminate
+		return nil
+	}
+
+	e.resolved++
+	if e.resolved > MaxExpandReferences {
+		return NewErrInvalidUserInput("too many references to expand: at most %d "+
+			"references can be resolved on a single request, try a lower depth or "+
+			"fewer properties", MaxExpandReferences)
+	}
+
+	allowed, err := e.allowed(parsed)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return nil
+	}
+
+	target, err := e.fetch(ctx, parsed)
+	if err != nil {
+		return NewErrInternal("expand reference '%s': %v", ref.Beacon, err)
+	}
+
+	if target == nil {
+		return nil
+	}
+
+	ref.Schema = target.Schema
+
+	e.parents[parsed.TargetID] = struct{}{}
+	defer delete(e.parents, parsed.TargetID)
+	return e.expandSchema(ctx, target.Schema, depth-1)
+}
+
+// allowed tells whether the principal may get the target of the reference.
+// The target is then part of the response, so it needs the same permission
+// as getting it directly.
+func (e *referenceExpander) allowed(ref *crossref.Ref) (bool, error) {
+	resource := fmt.Sprintf("things/%s", ref.TargetID)
+	if ref.Kind == kind.Action {
+		resource = fmt.Sprintf("actions/%s", ref.TargetID)
+	}
+
+	err := e.authorizer.Authorize(e.principal, "get", resource)
+	if err == nil {
+		return true, nil
+	}
+
+	if _, ok := err.(autherrs.Forbidden); ok {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (e *referenceExpander) fetch(ctx context.Context,
+	ref *crossref.Ref) (*search.Result, error) {
+	switch ref.Kind {
+	case kind.Thing:
+		return e.repo.ThingByID(ctx, ref.TargetID, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+	case kind.Action:
+		return e.repo.ActionByID(ctx, ref.TargetID, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// MaxBatchExpandTargets is the maximum amount of distinct objects that can
+// be resolved inline on a single batch get request
+const MaxBatchExpandTargets = 1000
+
+// BatchExpandParams control which reference properties are resolved inline
+// when getting a batch of things or actions. Only the first level of
+// references is resolved. The zero value disables expansion.
+type BatchExpandParams struct {
+	// Properties are the names of the reference properties to resolve
+	Properties []string
+
+	// Select are the properties of the targets which are inlined. If empty,
+	// all properties of the targets are inlined.
+	Select []string
+}
+
+func (p BatchExpandParams) enabled() bool {
+	return len(p.Properties) > 0
+}
+
+type expandTarget struct {
+	kind kind.Kind
+	id   strfmt.UUID
+}
+
+// expandBatchReferences replaces the beacons of the specified reference
+// properties of all results with the selected properties of their targets.
+// Every target is fetched only once, no matter how many results point to it,
+// and all targets of the same kind are fetched in a single call. References
+// pointing to another peer or to objects which no longer exist are left
+// untouched.
+func (m *Manager) expandBatchReferences(ctx context.Context,
+	principal *models.Principal, results search.Results,
+	params BatchExpandParams) error {
+	if !params.enabled() {
+		return nil
+	}
+
+	props := map[string]struct{}{}
+	for _, prop := range params.Properties {
+		props[prop] = struct{}{}
+	}
+
+	refsByTarget := map[expandTarget][]*models.SingleRef{}
+	var thingIDs, actionIDs []strfmt.UUID
+	for _, res := range results {
+		schemaMap, ok := res.Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for prop, value := range schemaMap {
+			if _, ok := props[prop]; !ok {
+				continue
+			}
+
+			refs, ok := value.(models.MultipleRef)
+			if !ok {
+				continue
+			}
+
+			for _, ref := range refs {
+				parsed, err := crossref.ParseSingleRef(ref)
+				if err != nil || !parsed.Local {
+					continue
+				}
+
+				target := expandTarget{kind: parsed.Kind, id: parsed.TargetID}
+				if _, ok := refsByTarget[target]; !ok {
+					if parsed.Kind == kind.Thing {
+						thingIDs = append(thingIDs, parsed.TargetID)
+					} else {
+						actionIDs = append(actionIDs, parsed.TargetID)
+					}
+				}
+				refsByTarget[target] = append(refsByTarget[target], ref)
+			}
+		}
+	}
+
+	if len(refsByTarget) > MaxBatchExpandTargets {
+		return NewErrInvalidUserInput("too many references to expand: at most %d "+
+			"distinct objects can be resolved on a single batch get request, got %d, "+
+			"try fewer ids or properties", MaxBatchExpandTargets, len(refsByTarget))
+	}
+
+	// the targets are returned as part of the response, so the principal must
+	// be allowed to get each of them individually
+	if err := m.authorizeByIDs(principal, "things", thingIDs); err != nil {
+		return err
+	}
+
+	if err := m.authorizeByIDs(principal, "actions", actionIDs); err != nil {
+		return err
+	}
+
+	targets, err := m.fetchExpandTargets(ctx, thingIDs, actionIDs)
+	if err != nil {
+		return err
+	}
+
+	for target, refs := range refsByTarget {
+		targetSchema, ok := targets[target]
+		if !ok {
+			continue
+		}
+
+		for _, ref := range refs {
+			ref.Schema = selectSchemaProperties(targetSchema, params.Select)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) fetchExpandTargets(ctx context.Context, thingIDs,
+	actionIDs []strfmt.UUID) (map[expandTarget]models.PropertySchema, error) {
+	out := map[expandTarget]models.PropertySchema{}
+
+	if len(thingIDs) > 0 {
+		res, err := m.vectorRepo.ThingsByIDs(ctx, thingIDs, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+		if err != nil {
+			return nil, NewErrInternal("expand references: things by ids: %v", err)
+		}
+
+		for _, obj := range res {
+			if obj != nil {
+				out[expandTarget{kind: kind.Thing, id: obj.ID}] = obj.Schema
+			}
+		}
+	}
+
+	if len(actionIDs) > 0 {
+		res, err := m.vectorRepo.ActionsByIDs(ctx, actionIDs, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+		if err != nil {
+			return nil, NewErrInternal("expand references: actions by ids: %v", err)
+		}
+
+		for _, obj := range res {
+			if obj != nil {
+				out[expandTarget{kind: kind.Action, id: obj.ID}] = obj.Schema
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// selectSchemaProperties returns a copy of the schema which only contains the
+// selected properties, or all of them if none are selected. Every reference
+// gets its own copy, so that shared targets can be modified independently
+// further down the line.
+func selectSchemaProperties(schema models.PropertySchema,
+	selected []string) models.PropertySchema {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return schema
+	}
+
+	out := map[string]interface{}{}
+	if len(selected) == 0 {
+		for prop, value := range schemaMap {
+			out[prop] = value
+		}
+		return out
+	}
+
+	for _, prop := range selected {
+		if value, ok := schemaMap[prop]; ok {
+			out[prop] = value
+		}
+	}
+
+	return out
+}
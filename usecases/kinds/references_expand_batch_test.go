@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetThingsByIDs_ExpandReferences(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	beacon := func(id strfmt.UUID) *models.SingleRef {
+		return &models.SingleRef{
+			Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/things/%s", id)),
+		}
+	}
+
+	var (
+		firstBookID  = strfmt.UUID("6b2e1f3a-9c4d-4e5f-8a7b-1c2d3e4f5a01")
+		secondBookID = strfmt.UUID("6b2e1f3a-9c4d-4e5f-8a7b-1c2d3e4f5a02")
+		authorID     = strfmt.UUID("6b2e1f3a-9c4d-4e5f-8a7b-1c2d3e4f5a03")
+		missingID    = strfmt.UUID("6b2e1f3a-9c4d-4e5f-8a7b-1c2d3e4f5a04")
+	)
+
+	// both books are written by the same author, the second one also by an
+	// author which no longer exists
+	books := func() []*search.Result {
+		return []*search.Result{
+			&search.Result{
+				ID:        firstBookID,
+				ClassName: "Book",
+				Schema: map[string]interface{}{
+					"title":     "First",
+					"writtenBy": models.MultipleRef{beacon(authorID)},
+				},
+			},
+			&search.Result{
+				ID:        secondBookID,
+				ClassName: "Book",
+				Schema: map[string]interface{}{
+					"title":     "Second",
+					"writtenBy": models.MultipleRef{beacon(authorID), beacon(missingID)},
+				},
+			},
+		}
+	}
+	author := &search.Result{
+		ID:        authorID,
+		ClassName: "Author",
+		Schema:    map[string]interface{}{"name": "Jane", "born": 1970.0},
+	}
+	ids := []strfmt.UUID{firstBookID, secondBookID}
+
+	t.Run("a shared target is fetched once and only selected fields are inlined", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingsByIDs", ids, mock.Anything, mock.Anything).
+			Return(books(), nil).Once()
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{authorID, missingID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{author, nil}, nil).Once()
+
+		res, notFound, err := manager.GetThingsByIDs(context.Background(), &models.Principal{},
+			ids, traverser.UnderscoreProperties{}, BatchExpandParams{
+				Properties: []string{"writtenBy"},
+				Select:     []string{"name"},
+			})
+		require.Nil(t, err)
+		assert.Len(t, notFound, 0)
+		require.Len(t, res, 2)
+
+		for _, book := range res {
+			ref := book.Schema.(map[string]interface{})["writtenBy"].(models.MultipleRef)[0]
+			assert.Equal(t, map[string]interface{}{"name": "Jane"}, ref.Schema)
+		}
+
+		missingRef := res[1].Schema.(map[string]interface{})["writtenBy"].(models.MultipleRef)[1]
+		assert.Nil(t, missingRef.Schema)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("without selected fields all properties are inlined", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingsByIDs", ids, mock.Anything, mock.Anything).
+			Return(books(), nil).Once()
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{authorID, missingID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{author, nil}, nil).Once()
+
+		res, _, err := manager.GetThingsByIDs(context.Background(), &models.Principal{},
+			ids, traverser.UnderscoreProperties{}, BatchExpandParams{
+				Properties: []string{"writtenBy"},
+			})
+		require.Nil(t, err)
+
+		ref := res[0].Schema.(map[string]interface{})["writtenBy"].(models.MultipleRef)[0]
+		assert.Equal(t, map[string]interface{}{"name": "Jane", "born": 1970.0}, ref.Schema)
+	})
+
+	t.Run("without expand params references are not resolved", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingsByIDs", ids, mock.Anything, mock.Anything).
+			Return(books(), nil).Once()
+
+		res, _, err := manager.GetThingsByIDs(context.Background(), &models.Principal{},
+			ids, traverser.UnderscoreProperties{}, BatchExpandParams{})
+		require.Nil(t, err)
+
+		ref := res[0].Schema.(map[string]interface{})["writtenBy"].(models.MultipleRef)[0]
+		assert.Nil(t, ref.Schema)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("too many distinct targets", func(t *testing.T) {
+		reset()
+		refs := make(models.MultipleRef, MaxBatchExpandTargets+1)
+		for i := range refs {
+			refs[i] = beacon(strfmt.UUID(fmt.Sprintf("7c3f2a4b-0d5e-4f6a-9b8c-%012d", i)))
+		}
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{firstBookID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{&search.Result{
+				ID:        firstBookID,
+				ClassName: "Book",
+				Schema:    map[string]interface{}{"writtenBy": refs},
+			}}, nil).Once()
+
+		_, _, err := manager.GetThingsByIDs(context.Background(), &models.Principal{},
+			[]strfmt.UUID{firstBookID}, traverser.UnderscoreProperties{}, BatchExpandParams{
+				Properties: []string{"writtenBy"},
+			})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		vectorRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetThing_ExpandReferences(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	beacon := func(k string, id strfmt.UUID) *models.SingleRef {
+		return &models.SingleRef{
+			Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s/%s", k, id)),
+		}
+	}
+
+	var (
+		authorID = strfmt.UUID("f0c6a1d8-0e65-4d3c-8c0b-2f1c4f0e2a01")
+		bookID   = strfmt.UUID("f0c6a1d8-0e65-4d3c-8c0b-2f1c4f0e2a02")
+		cityID   = strfmt.UUID("f0c6a1d8-0e65-4d3c-8c0b-2f1c4f0e2a03")
+	)
+
+	// author -writtenBy-> book -writtenBy-> author (cycle)
+	// author -livesIn-> city
+	author := func() *search.Result {
+		return &search.Result{
+			ID:        authorID,
+			ClassName: "Author",
+			Schema: map[string]interface{}{
+				"name":      "Jane",
+				"authorOf":  models.MultipleRef{beacon("things", bookID)},
+				"livesIn":   models.MultipleRef{beacon("things", cityID)},
+				"otherRefs": models.MultipleRef{beacon("things", cityID)},
+			},
+		}
+	}
+	book := func() *search.Result {
+		return &search.Result{
+			ID:        bookID,
+			ClassName: "Book",
+			Schema: map[string]interface{}{
+				"title":     "A Book",
+				"writtenBy": models.MultipleRef{beacon("things", authorID)},
+				"livesIn":   models.MultipleRef{beacon("things", cityID)},
+			},
+		}
+	}
+	city := func() *search.Result {
+		return &search.Result{
+			ID:        cityID,
+			ClassName: "City",
+			Schema:    map[string]interface{}{"name": "Amsterdam"},
+		}
+	}
+
+	t.Run("without expand params references are not resolved", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(author(), nil).Once()
+
+		res, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{})
+		require.Nil(t, err)
+
+		schema := res.Schema.(map[string]interface{})
+		assert.Nil(t, schema["authorOf"].(models.MultipleRef)[0].Schema)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("only the listed properties are resolved with depth 1", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(author(), nil).Once()
+		vectorRepo.On("ThingByID", bookID, mock.Anything, mock.Anything).Return(book(), nil).Once()
+		vectorRepo.On("ThingByID", cityID, mock.Anything, mock.Anything).Return(city(), nil).Once()
+
+		res, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{
+				Properties: []string{"authorOf", "livesIn"},
+				Depth:      1,
+			})
+		require.Nil(t, err)
+
+		schema := res.Schema.(map[string]interface{})
+		bookSchema := schema["authorOf"].(models.MultipleRef)[0].Schema.(map[string]interface{})
+		assert.Equal(t, "A Book", bookSchema["title"])
+		assert.Nil(t, bookSchema["livesIn"].(models.MultipleRef)[0].Schema,
+			"second level must not be resolved")
+		citySchema := schema["livesIn"].(models.MultipleRef)[0].Schema.(map[string]interface{})
+		assert.Equal(t, "Amsterdam", citySchema["name"])
+		assert.Nil(t, schema["otherRefs"].(models.MultipleRef)[0].Schema)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("deeper levels are resolved, but cycles are not followed", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(author(), nil).Once()
+		vectorRepo.On("ThingByID", bookID, mock.Anything, mock.Anything).Return(book(), nil).Once()
+
+		res, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{
+				Properties: []string{"authorOf", "writtenBy"},
+				Depth:      3,
+			})
+		require.Nil(t, err)
+
+		schema := res.Schema.(map[string]interface{})
+		bookSchema := schema["authorOf"].(models.MultipleRef)[0].Schema.(map[string]interface{})
+		assert.Nil(t, bookSchema["writtenBy"].(models.MultipleRef)[0].Schema,
+			"cyclic reference back to the root must not be resolved")
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with a depth above the maximum", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(author(), nil).Once()
+
+		_, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{
+				Properties: []string{"authorOf"},
+				Depth:      MaxExpandDepth + 1,
+			})
+		assert.Equal(t, NewErrInvalidUserInput("expand depth must be between 1 and 3, got 4"), err)
+	})
+
+	t.Run("with too many references to resolve", func(t *testing.T) {
+		reset()
+		refs := make(models.MultipleRef, MaxExpandReferences+1)
+		for i := range refs {
+			refs[i] = beacon("things", cityID)
+		}
+		root := author()
+		root.Schema.(map[string]interface{})["livesIn"] = refs
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(root, nil).Once()
+		vectorRepo.On("ThingByID", cityID, mock.Anything, mock.Anything).Return(city(), nil)
+
+		_, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{
+				Properties: []string{"livesIn"},
+				Depth:      1,
+			})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("targets the principal may not get are not resolved", func(t *testing.T) {
+		reset()
+		manager.authorizer = &denyingAuthorizer{denied: map[string]bool{
+			fmt.Sprintf("things/%s", cityID): true,
+		}}
+		vectorRepo.On("ThingByID", authorID, mock.Anything, mock.Anything).Return(author(), nil).Once()
+		vectorRepo.On("ThingByID", bookID, mock.Anything, mock.Anything).Return(book(), nil).Once()
+
+		res, err := manager.GetThing(context.Background(), &models.Principal{}, authorID,
+			traverser.UnderscoreProperties{}, ExpandParams{
+				Properties: []string{"authorOf", "livesIn"},
+				Depth:      1,
+			})
+		require.Nil(t, err)
+
+		schema := res.Schema.(map[string]interface{})
+		bookSchema := schema["authorOf"].(models.MultipleRef)[0].Schema.(map[string]interface{})
+		assert.Equal(t, "A Book", bookSchema["title"])
+		assert.Nil(t, schema["livesIn"].(models.MultipleRef)[0].Schema)
+		vectorRepo.AssertNotCalled(t, "ThingByID", cityID, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// PropertyReferences are the references of a single reference property of
+// an object
+type PropertyReferences struct {
+	Property   string
+	References []ListedReference
+}
+
+// ListedReference is a single reference of an object. Ref is nil if the
+// beacon could not be parsed. Thing or Action are only set if the targets
+// were resolved and the target exists. Forbidden is set instead if the
+// principal may not get the target.
+type ListedReference struct {
+	Beacon    strfmt.URI
+	Ref       *crossref.Ref
+	Thing     *models.Thing
+	Action    *models.Action
+	Forbidden bool
+}
+
+// GetThingReferences returns the reference properties of a thing ordered by
+// the property name, without fetching anything else. If resolve is set, the
+// targets of the references are fetched as well.
+func (m *Manager) GetThingReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, resolve bool) ([]PropertyReferences, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("things/%s", id.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getObjectReferences(ctx, principal, kind.Thing, id, resolve)
+}
+
+// GetActionReferences returns the reference properties of an action ordered
+// by the property name, without fetching anything else. If resolve is set,
+// the targets of the references are fetched as well.
+func (m *Manager) GetActionReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, resolve bool) ([]PropertyReferences, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("actions/%s", id.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getObjectReferences(ctx, principal, kind.Action, id, resolve)
+}
+
+func (m *Manager) getObjectReferences(ctx context.Context, principal *models.Principal,
+	k kind.Kind, id strfmt.UUID, resolve bool) ([]PropertyReferences, error) {
+	obj, err := m.getObjectForReferences(ctx, k, id)
+	if err != nil {
+		return nil, err
+	}
+
+	props := referencesFromSchema(obj.Schema)
+	if !resolve {
+		return props, nil
+	}
+
+	// a target the principal may not get only leaves its own reference
+	// unresolved, rather than failing the whole request
+	var beacons []strfmt.URI
+	var pending []*ListedReference
+	for _, prop := range props {
+		for j := range prop.References {
+			ref := &prop.References[j]
+			allowed, err := m.referenceTargetAllowed(principal, ref.Ref)
+			if err != nil {
+				return nil, err
+			}
+
+			if !allowed {
+				ref.Forbidden = true
+				continue
+			}
+
+			beacons = append(beacons, ref.Beacon)
+			pending = append(pending, ref)
+		}
+	}
+
+	if len(beacons) == 0 {
+		return props, nil
+	}
+
+	// ResolveBeacons acquires the connector lock on its own and authorizes
+	// every target, so it must be called after the lock above was released
+	resolved, err := m.ResolveBeacons(ctx, principal, beacons, traverser.UnderscoreProperties{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, ref := range pending {
+		ref.Thing = resolved[i].Thing
+		ref.Action = resolved[i].Action
+	}
+
+	return props, nil
+}
+
+// referenceTargetAllowed tells whether the principal may get the target of
+// the reference. References which can't be resolved anyway, as the beacon
+// could not be parsed or points to a peer, are left to ResolveBeacons.
+func (m *Manager) referenceTargetAllowed(principal *models.Principal,
+	ref *crossref.Ref) (bool, error) {
+	if ref == nil || !ref.Local {
+		return true, nil
+	}
+
+	resource := fmt.Sprintf("things/%s", ref.TargetID)
+	if ref.Kind == kind.Action {
+		resource = fmt.Sprintf("actions/%s", ref.TargetID)
+	}
+
+	err := m.authorizer.Authorize(principal, "get", resource)
+	if err == nil {
+		return true, nil
+	}
+
+	if _, ok := err.(autherrs.Forbidden); ok {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (m *Manager) getObjectForReferences(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (*search.Result, error) {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	if k == kind.Thing {
+		return m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
+	}
+
+	return m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
+}
+
+// referencesFromSchema extracts all reference properties of the schema. Only
+// references are stored as models.MultipleRef, so no schema lookup is
+// required to tell them apart from other properties.
+func referencesFromSchema(schema models.PropertySchema) []PropertyReferences {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return []PropertyReferences{}
+	}
+
+	out := []PropertyReferences{}
+	for prop, value := range schemaMap {
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			continue
+		}
+
+		listed := make([]ListedReference, len(refs))
+		for i, ref := range refs {
+			listed[i].Beacon = ref.Beacon
+			if parsed, err := crossref.ParseSingleRef(ref); err == nil {
+				listed[i].Ref = parsed
+			}
+		}
+
+		out = append(out, PropertyReferences{Property: prop, References: listed})
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Property < out[b].Property
+	})
+
+	return out
+}
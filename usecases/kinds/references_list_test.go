@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetReferences(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	id := strfmt.UUID("9a4cd8dc-2c76-4b4b-8f5a-5e1bd6d7b0a1")
+	thingID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	actionID := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+	source := &search.Result{
+		ID:        id,
+		ClassName: "Foo",
+		Schema: map[string]interface{}{
+			"name": "foo",
+			"ofBar": models.MultipleRef{
+				&models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/actions/" + actionID)},
+			},
+			"hasBaz": models.MultipleRef{
+				&models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/things/" + thingID)},
+				&models.SingleRef{Beacon: "not-a-beacon"},
+			},
+		},
+	}
+
+	t.Run("without resolving the targets", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(source, nil).Once()
+
+		res, err := manager.GetThingReferences(context.Background(), nil, id, false)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, "hasBaz", res[0].Property)
+		require.Len(t, res[0].References, 2)
+		require.NotNil(t, res[0].References[0].Ref)
+		assert.Equal(t, kind.Thing, res[0].References[0].Ref.Kind)
+		assert.Equal(t, thingID, res[0].References[0].Ref.TargetID)
+		assert.Nil(t, res[0].References[0].Thing)
+		assert.Equal(t, strfmt.URI("not-a-beacon"), res[0].References[1].Beacon)
+		assert.Nil(t, res[0].References[1].Ref)
+
+		assert.Equal(t, "ofBar", res[1].Property)
+		require.Len(t, res[1].References, 1)
+		assert.Equal(t, kind.Action, res[1].References[0].Ref.Kind)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("resolving the targets", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ActionByID", id, mock.Anything, mock.Anything).Return(source, nil).Once()
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{thingID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{
+				&search.Result{ID: thingID, ClassName: "Baz"},
+			}, nil).Once()
+		vectorRepo.On("ActionsByIDs", []strfmt.UUID{actionID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{nil}, nil).Once()
+
+		res, err := manager.GetActionReferences(context.Background(), nil, id, true)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		require.NotNil(t, res[0].References[0].Thing)
+		assert.Equal(t, thingID, res[0].References[0].Thing.ID)
+		assert.Nil(t, res[0].References[1].Thing)
+		assert.Nil(t, res[1].References[0].Action, "the target does not exist")
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("resolving with a target which is forbidden", func(t *testing.T) {
+		reset()
+		manager.authorizer = &denyingAuthorizer{
+			denied: map[string]bool{"actions/" + actionID.String(): true},
+		}
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(source, nil).Once()
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{thingID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{
+				&search.Result{ID: thingID, ClassName: "Baz"},
+			}, nil).Once()
+
+		res, err := manager.GetThingReferences(context.Background(), nil, id, true)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		require.NotNil(t, res[0].References[0].Thing)
+		assert.False(t, res[0].References[0].Forbidden)
+		assert.Nil(t, res[1].References[0].Action)
+		assert.True(t, res[1].References[0].Forbidden)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "ActionsByIDs", mock.Anything, mock.Anything,
+			mock.Anything)
+	})
+
+	t.Run("on a non-existing object", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return((*search.Result)(nil), nil).Once()
+
+		_, err := manager.GetThingReferences(context.Background(), nil, id, true)
+		assert.Equal(t, NewErrNotFound("no thing with id '%s'", id), err)
+	})
+}
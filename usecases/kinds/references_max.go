@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// maxReferences returns the configured limit of references of the property,
+// nil means unlimited
+func maxReferences(s schema.Schema, k kind.Kind, className,
+	propertyName string) *int64 {
+	prop, err := s.GetProperty(k, schema.ClassName(className),
+		schema.PropertyName(propertyName))
+	if err != nil {
+		return nil
+	}
+
+	return prop.MaxReferences
+}
+
+// referenceCount returns how many references are currently set on the
+// property of the object schema
+func referenceCount(propSchema models.PropertySchema, propertyName string) int {
+	return len(references(propSchema, propertyName))
+}
+
+func references(propSchema models.PropertySchema,
+	propertyName string) models.MultipleRef {
+	schemaMap, ok := propSchema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	refs, ok := schemaMap[propertyName].(models.MultipleRef)
+	if !ok {
+		return nil
+	}
+
+	return refs
+}
+
+func checkReferenceCount(s schema.Schema, k kind.Kind, className,
+	propertyName string, count int) error {
+	max := maxReferences(s, k, className, propertyName)
+	if max == nil || int64(count) <= *max {
+		return nil
+	}
+
+	return NewErrInvalidUserInput("property '%s' of class '%s' can hold at most %d "+
+		"references, but the object would have %d", propertyName, className, *max, count)
+}
+
+// CheckReferenceCounts checks every reference property of the object schema
+// against its maximum. Repos call it within the write, so that concurrent
+// writes can not exceed the maximum together.
+func CheckReferenceCounts(s schema.Schema, k kind.Kind, className string,
+	propSchema models.PropertySchema) error {
+	schemaMap, ok := propSchema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for propertyName, value := range schemaMap {
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			continue
+		}
+
+		if err := checkReferenceCount(s, k, className, propertyName,
+			len(refs)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) validateReferenceCount(principal *models.Principal, k kind.Kind,
+	className, propertyName string, count int) error {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	return checkReferenceCount(s, k, className, propertyName, count)
+}
+
+// validateMergedReferenceCounts checks the references of a merge against the
+// maximum of their properties. They are appended to the references the
+// object already has, with uniqueRefs set those already present are skipped
+// just like the repo does.
+func (m *Manager) validateMergedReferenceCounts(principal *models.Principal,
+	k kind.Kind, previous *search.Result, refs BatchReferences,
+	uniqueRefs bool) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	merged := map[string]models.MultipleRef{}
+	for _, ref := range refs {
+		propertyName := ref.From.Property.String()
+		current, ok := merged[propertyName]
+		if !ok {
+			current = append(models.MultipleRef{},
+				references(previous.Schema, propertyName)...)
+		}
+
+		single := ref.To.SingleRef()
+		if uniqueRefs && containsBeacon(current, single.Beacon) {
+			continue
+		}
+		merged[propertyName] = append(current, single)
+	}
+
+	for propertyName, refs := range merged {
+		if err := checkReferenceCount(s, k, previous.ClassName, propertyName,
+			len(refs)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsBeacon(refs models.MultipleRef, beacon strfmt.URI) bool {
+	for _, ref := range refs {
+		if ref.Beacon == beacon {
+			return true
+		}
+	}
+
+	return false
+}
+
+type referenceCountKey struct {
+	kind     kind.Kind
+	id       strfmt.UUID
+	property string
+}
+
+// validateReferenceCounts marks every batch reference which would exceed the
+// maximum of its property as failed. The existing references of each source
+// object are counted in, the references of the batch are added in the order
+// of the batch, so the first references up to the limit still succeed.
+func (b *BatchManager) validateReferenceCounts(ctx context.Context,
+	principal *models.Principal, refs BatchReferences) error {
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	counts := map[referenceCountKey]int{}
+	for i, ref := range refs {
+		if ref.Err != nil {
+			continue
+		}
+
+		className := ref.From.Class.String()
+		propertyName := ref.From.Property.String()
+		if maxReferences(s, ref.From.Kind, className, propertyName) == nil {
+			continue
+		}
+
+		key := referenceCountKey{ref.From.Kind, ref.From.TargetID, propertyName}
+		count, ok := counts[key]
+		if !ok {
+			count, err = b.existingReferenceCount(ctx, key)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := checkReferenceCount(s, ref.From.Kind, className, propertyName,
+			count+1); err != nil {
+			refs[i].Err = err
+		} else {
+			count++
+		}
+		counts[key] = count
+	}
+
+	return nil
+}
+
+func (b *BatchManager) existingReferenceCount(ctx context.Context,
+	key referenceCountKey) (int, error) {
+	var res *search.Result
+	var err error
+	if key.kind == kind.Action {
+		res, err = b.vectorRepo.ActionByID(ctx, key.id, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+	} else {
+		res, err = b.vectorRepo.ThingByID(ctx, key.id, traverser.SelectProperties{},
+			traverser.UnderscoreProperties{})
+	}
+	if err != nil {
+		return 0, NewErrInternal("count existing references of %s: %v", key.id, err)
+	}
+
+	if res == nil {
+		// a missing source is reported by the repo when adding the reference
+		return 0, nil
+	}
+
+	return referenceCount(res.Schema, key.property), nil
+}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MaxReferences(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	var (
+		vectorRepo   *fakeVectorRepo
+		manager      *Manager
+		batchManager *BatchManager
+	)
+
+	zooID := strfmt.UUID("4b0b5b8c-7a4c-4ef7-8b3e-6a0f1a2d9e10")
+	animalIDs := []strfmt.UUID{
+		"d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+		"2c3a5e3d-5b5d-4c6e-9a7f-0d1e2f3a4b5c",
+		"6f7e8d9c-0b1a-4c2d-8e3f-4a5b6c7d8e9f",
+	}
+	animalRef := func(i int) *models.SingleRef {
+		return &models.SingleRef{
+			Beacon: strfmt.URI("weaviate://localhost/things/" + animalIDs[i]),
+		}
+	}
+
+	limitedSchema := func() schema.Schema {
+		s := zooAnimalSchemaForTest()
+		prop, err := s.GetProperty(kind.Thing, "Zoo", "hasAnimals")
+		require.Nil(t, err)
+		max := int64(2)
+		prop.MaxReferences = &max
+		return s
+	}
+
+	zooWithRefs := func(refs ...*models.SingleRef) *search.Result {
+		return &search.Result{
+			ClassName: "Zoo",
+			ID:        zooID,
+			Schema: map[string]interface{}{
+				"name":       "MyZoo",
+				"hasAnimals": models.MultipleRef(refs),
+			},
+		}
+	}
+
+	reset := func(s schema.Schema) {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: s}
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		batchManager = NewBatchManager(vectorRepo, &fakeVectorizer{}, &fakeLocks{},
+			schemaManager, nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+
+		// the targets of the references
+		for _, id := range animalIDs {
+			vectorRepo.On("ObjectClass", kind.Thing, id).Maybe().
+				Return("Animal", true, nil)
+		}
+	}
+
+	t.Run("adding a reference below the limit", func(t *testing.T) {
+		reset(limitedSchema())
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(zooWithRefs(animalRef(0)), nil)
+		vectorRepo.On("AddReference", kind.Thing, zooID, "hasAnimals", animalRef(1)).
+			Return(nil)
+
+		err := manager.AddThingReference(context.Background(), nil, zooID,
+			"hasAnimals", animalRef(1))
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("adding a reference above the limit", func(t *testing.T) {
+		reset(limitedSchema())
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(zooWithRefs(animalRef(0), animalRef(1)), nil)
+
+		err := manager.AddThingReference(context.Background(), nil, zooID,
+			"hasAnimals", animalRef(2))
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "can hold at most 2 references, "+
+			"but the object would have 3")
+		vectorRepo.AssertNotCalled(t, "AddReference", mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything)
+	})
+
+	t.Run("adding a reference without a limit", func(t *testing.T) {
+		reset(zooAnimalSchemaForTest())
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(zooWithRefs(animalRef(0), animalRef(1)), nil)
+		vectorRepo.On("AddReference", kind.Thing, zooID, "hasAnimals", animalRef(2)).
+			Return(nil)
+
+		err := manager.AddThingReference(context.Background(), nil, zooID,
+			"hasAnimals", animalRef(2))
+		require.Nil(t, err)
+	})
+
+	t.Run("replacing the references above the limit", func(t *testing.T) {
+		reset(limitedSchema())
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(zooWithRefs(), nil)
+
+		err := manager.UpdateThingReferences(context.Background(), nil, zooID,
+			"hasAnimals", models.MultipleRef{animalRef(0), animalRef(1), animalRef(2)})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("creating a thing above the limit", func(t *testing.T) {
+		reset(limitedSchema())
+
+		_, err := manager.AddThing(context.Background(), nil, &models.Thing{
+			Class: "Zoo",
+			Schema: map[string]interface{}{
+				"hasAnimals": []interface{}{
+					map[string]interface{}{"beacon": animalRef(0).Beacon.String()},
+					map[string]interface{}{"beacon": animalRef(1).Beacon.String()},
+					map[string]interface{}{"beacon": animalRef(2).Beacon.String()},
+				},
+			},
+		})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "value has 3 references, but the maxReferences is 2")
+		vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("merging references above the limit", func(t *testing.T) {
+		reset(limitedSchema())
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(zooWithRefs(animalRef(0), animalRef(1)), nil)
+
+		err := manager.MergeThing(context.Background(), nil, zooID, &models.Thing{
+			Class: "Zoo",
+			Schema: map[string]interface{}{
+				"hasAnimals": []interface{}{
+					map[string]interface{}{"beacon": animalRef(2).Beacon.String()},
+				},
+			},
+		}, false)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "can hold at most 2 references, "+
+			"but the object would have 3")
+		vectorRepo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("batch references count in the existing references", func(t *testing.T) {
+		reset(limitedSchema())
+		vectorRepo.On("ThingByID", zooID, mock.Anything, mock.Anything).
+			Return(zooWithRefs(animalRef(0)), nil).Once()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		from := strfmt.URI(crossref.NewSource(kind.Thing, "Zoo", "hasAnimals", zooID).String())
+		refs := []*models.BatchReference{
+			{From: from, To: animalRef(1).Beacon},
+			{From: from, To: animalRef(2).Beacon},
+			{From: from, To: animalRef(2).Beacon},
+		}
+
+		res, err := batchManager.AddReferences(context.Background(), nil, refs)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.IsType(t, ErrInvalidUserInput{}, res[1].Err)
+		assert.IsType(t, ErrInvalidUserInput{}, res[2].Err)
+		vectorRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// ResolvedBeacon is the outcome of resolving a single beacon. Exactly one of
+// Thing, Action and Err is set, unless the target does not exist, in which
+// case none of them are.
+type ResolvedBeacon struct {
+	Beacon strfmt.URI
+	Thing  *models.Thing
+	Action *models.Action
+	Err    error
+}
+
+// ResolveBeacons parses every beacon and fetches the objects they point to.
+// The results are in the order of the beacons. Beacons are resolved
+// independently, so that a beacon which cannot be parsed or points to
+// another peer does not fail the whole request.
+func (m *Manager) ResolveBeacons(ctx context.Context, principal *models.Principal,
+	beacons []strfmt.URI, underscore traverser.UnderscoreProperties) ([]ResolvedBeacon, error) {
+	out := make([]ResolvedBeacon, len(beacons))
+	refs := make([]*crossref.Ref, len(beacons))
+	var thingIDs, actionIDs []strfmt.UUID
+	for i, beacon := range beacons {
+		out[i].Beacon = beacon
+
+		ref, err := crossref.Parse(beacon.String())
+		if err != nil {
+			out[i].Err = err
+			continue
+		}
+
+		if !ref.Local {
+			out[i].Err = fmt.Errorf("beacon points to peer '%s', but only local "+
+				"beacons can be resolved", ref.PeerName)
+			continue
+		}
+
+		refs[i] = ref
+		if ref.Kind == kind.Thing {
+			thingIDs = append(thingIDs, ref.TargetID)
+		} else {
+			actionIDs = append(actionIDs, ref.TargetID)
+		}
+	}
+
+	if err := m.authorizeByIDs(principal, "things", thingIDs); err != nil {
+		return nil, err
+	}
+
+	if err := m.authorizeByIDs(principal, "actions", actionIDs); err != nil {
+		return nil, err
+	}
+
+	if underscore.FeatureProjection != nil {
+		return nil, NewErrInvalidUserInput("feature projection is not possible on a batch get request")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	things := map[strfmt.UUID]*models.Thing{}
+	if len(thingIDs) > 0 {
+		res, err := m.vectorRepo.ThingsByIDs(ctx, thingIDs, traverser.SelectProperties{}, underscore)
+		if err != nil {
+			return nil, NewErrInternal("repo: things by ids: %v", err)
+		}
+
+		found, _, err := m.splitFoundByIDs(ctx, thingIDs, res, underscore)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, thing := range found.Things() {
+			things[thing.ID] = thing
+		}
+	}
+
+	actions := map[strfmt.UUID]*models.Action{}
+	if len(actionIDs) > 0 {
+		res, err := m.vectorRepo.ActionsByIDs(ctx, actionIDs, traverser.SelectProperties{}, underscore)
+		if err != nil {
+			return nil, NewErrInternal("repo: actions by ids: %v", err)
+		}
+
+		found, _, err := m.splitFoundByIDs(ctx, actionIDs, res, underscore)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, action := range found.Actions() {
+			actions[action.ID] = action
+		}
+	}
+
+	for i, ref := range refs {
+		if ref == nil {
+			continue
+		}
+
+		if ref.Kind == kind.Thing {
+			out[i].Thing = things[ref.TargetID]
+		} else {
+			out[i].Action = actions[ref.TargetID]
+		}
+	}
+
+	return out, nil
+}
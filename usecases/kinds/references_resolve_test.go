@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/projector"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveBeacons(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	thingID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	missingThingID := strfmt.UUID("f2f3b2b1-6f4c-4b6a-9c3e-0b7f2b6a1f11")
+	actionID := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+	t.Run("with found, missing and invalid beacons", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("ThingsByIDs", []strfmt.UUID{thingID, missingThingID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{
+				&search.Result{ID: thingID, ClassName: "Foo", Schema: map[string]interface{}{"name": "foo"}},
+				nil,
+			}, nil).Once()
+		vectorRepo.On("ActionsByIDs", []strfmt.UUID{actionID}, mock.Anything, mock.Anything).
+			Return([]*search.Result{
+				&search.Result{ID: actionID, ClassName: "Bar", Schema: map[string]interface{}{"name": "bar"}},
+			}, nil).Once()
+
+		beacons := []strfmt.URI{
+			strfmt.URI("weaviate://localhost/things/" + thingID),
+			strfmt.URI("weaviate://localhost/actions/" + actionID),
+			strfmt.URI("weaviate://localhost/things/" + missingThingID),
+			strfmt.URI("weaviate://localhost/things/not-a-uuid"),
+			strfmt.URI("weaviate://OtherPeer/things/" + thingID),
+		}
+
+		res, err := manager.ResolveBeacons(context.Background(), nil, beacons,
+			traverser.UnderscoreProperties{})
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+
+		for i := range res {
+			assert.Equal(t, beacons[i], res[i].Beacon)
+		}
+
+		require.NotNil(t, res[0].Thing)
+		assert.Equal(t, thingID, res[0].Thing.ID)
+		assert.Nil(t, res[0].Err)
+
+		require.NotNil(t, res[1].Action)
+		assert.Equal(t, actionID, res[1].Action.ID)
+		assert.Nil(t, res[1].Err)
+
+		assert.Nil(t, res[2].Thing)
+		assert.Nil(t, res[2].Err)
+
+		assert.NotNil(t, res[3].Err)
+		assert.Equal(t, "beacon points to peer 'OtherPeer', but only local beacons can be resolved",
+			res[4].Err.Error())
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with feature projection", func(t *testing.T) {
+		reset()
+
+		_, err := manager.ResolveBeacons(context.Background(), nil,
+			[]strfmt.URI{strfmt.URI("weaviate://localhost/things/" + thingID)},
+			traverser.UnderscoreProperties{FeatureProjection: &projector.Params{}})
+		assert.Equal(t, NewErrInvalidUserInput("feature projection is not possible on a batch get request"), err)
+	})
+}
@@ -14,11 +14,14 @@ package kinds
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
@@ -40,48 +43,129 @@ func (m *Manager) UpdateActionReferences(ctx context.Context, principal *models.
 	}
 	defer unlock()
 
-	return m.updateActionReferenceToConnectorAndSchema(ctx, principal, id, propertyName, refs)
+	className, err := m.updateActionReferencesToConnectorAndSchema(ctx, principal, id,
+		models.PropertyReferences{propertyName: refs}, false)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Action, className, id, err)
+	return err
 }
 
-func (m *Manager) updateActionReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
+// BulkUpdateActionReferences replaces the references of all properties listed
+// in refs with a single write, so either all or none of the properties are
+// updated. Network refs have the same side-effect on the schema as in
+// UpdateActionReferences.
+func (m *Manager) BulkUpdateActionReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences) error {
 
-	// get action to see if it exists
-	actionRes, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
-	action := actionRes.Action()
-	err = m.validateReferences(ctx, refs)
+	if len(refs) == 0 {
+		return NewErrInvalidUserInput("no reference properties to update")
+	}
+
+	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return err
+		return NewErrInternal("could not aquire lock: %v", err)
 	}
+	defer unlock()
+
+	className, err := m.updateActionReferencesToConnectorAndSchema(ctx, principal, id, refs, false)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Action, className, id, err)
+	return err
+}
+
+// ReplaceActionReferences sets the reference properties listed in refs to
+// exactly the given references, an explicitly empty list removes all
+// references of that property. Reference properties omitted from refs are left
+// untouched, unless clearOmitted is set, in which case all of them are
+// emptied as well. Everything is written at once, so either all or none of
+// the properties are updated.
+func (m *Manager) ReplaceActionReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences, clearOmitted bool) error {
 
-	err = m.validateCanModifyReference(principal, kind.Action, action.Class, propertyName)
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
-	updatedSchema, err := m.replaceClassPropReferences(action.Schema, propertyName, refs)
+	if len(refs) == 0 && !clearOmitted {
+		return NewErrInvalidUserInput("no reference properties to replace")
+	}
+
+	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return err
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	className, err := m.updateActionReferencesToConnectorAndSchema(ctx, principal, id, refs, clearOmitted)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Action, className, id, err)
+	return err
+}
+
+func (m *Manager) updateActionReferencesToConnectorAndSchema(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences, clearOmitted bool) (string, error) {
+
+	// get action to see if it exists
+	actionRes, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
+	if err != nil {
+		return "", err
+	}
+
+	action := actionRes.Action()
+	if clearOmitted {
+		refs, err = m.withOmittedReferencesCleared(principal, kind.Action, action.Class, refs)
+		if err != nil {
+			return action.Class, err
+		}
+	}
+
+	for _, propertyName := range sortedPropertyNames(refs) {
+		err = m.validateReferences(ctx, principal, kind.Action, action.Class, propertyName,
+			refs[propertyName])
+		if err != nil {
+			return action.Class, err
+		}
+
+		err = m.validateCanModifyReference(principal, kind.Action, action.Class, propertyName)
+		if err != nil {
+			return action.Class, err
+		}
+
+		err = m.validateReferenceCount(principal, kind.Action, action.Class, propertyName,
+			len(refs[propertyName]))
+		if err != nil {
+			return action.Class, err
+		}
+	}
+
+	updatedSchema := action.Schema
+	for propertyName, propRefs := range refs {
+		updatedSchema, err = m.replaceClassPropReferences(updatedSchema, propertyName, propRefs)
+		if err != nil {
+			return action.Class, err
+		}
 	}
 	action.Schema = updatedSchema
 	action.LastUpdateTimeUnix = m.timeSource.Now()
+	// the stored version must not be treated as the expected one
+	action.Version = 0
 
 	// the new refs could be network refs
 	err = m.addNetworkDataTypesForAction(ctx, principal, action)
 	if err != nil {
-		return NewErrInternal("could not update schema for network refs: %v", err)
+		return action.Class, NewErrInternal("could not update schema for network refs: %v", err)
 	}
 
 	err = m.vectorRepo.PutAction(ctx, action, actionRes.Vector)
 	if err != nil {
-		return NewErrInternal("could not store action: %v", err)
+		return action.Class, NewErrInternal("could not store action: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Action, action.Class, id, events.OperationUpdate)
+	return action.Class, nil
 }
 
 // UpdateThingReferences Class Instance to the connected DB. If the class contains a network
@@ -101,53 +185,140 @@ func (m *Manager) UpdateThingReferences(ctx context.Context, principal *models.P
 	}
 	defer unlock()
 
-	return m.updateThingReferenceToConnectorAndSchema(ctx, principal, id, propertyName, refs)
+	className, err := m.updateThingReferencesToConnectorAndSchema(ctx, principal, id,
+		models.PropertyReferences{propertyName: refs}, false)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Thing, className, id, err)
+	return err
 }
 
-func (m *Manager) updateThingReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
+// BulkUpdateThingReferences replaces the references of all properties listed
+// in refs with a single write, so either all or none of the properties are
+// updated. Network refs have the same side-effect on the schema as in
+// UpdateThingReferences.
+func (m *Manager) BulkUpdateThingReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences) error {
 
-	// get thing to see if it exists
-	thingRes, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
-	thing := thingRes.Thing()
-	err = m.validateReferences(ctx, refs)
+	if len(refs) == 0 {
+		return NewErrInvalidUserInput("no reference properties to update")
+	}
+
+	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return err
+		return NewErrInternal("could not aquire lock: %v", err)
 	}
+	defer unlock()
 
-	err = m.validateCanModifyReference(principal, kind.Thing, thing.Class, propertyName)
+	className, err := m.updateThingReferencesToConnectorAndSchema(ctx, principal, id, refs, false)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Thing, className, id, err)
+	return err
+}
+
+// ReplaceThingReferences sets the reference properties listed in refs to
+// exactly the given references, an explicitly empty list removes all
+// references of that property. Reference properties omitted from refs are left
+// untouched, unless clearOmitted is set, in which case all of them are
+// emptied as well. Everything is written at once, so either all or none of
+// the properties are updated.
+func (m *Manager) ReplaceThingReferences(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences, clearOmitted bool) error {
+
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
 	if err != nil {
 		return err
 	}
 
-	updatedSchema, err := m.replaceClassPropReferences(thing.Schema, propertyName, refs)
+	if len(refs) == 0 && !clearOmitted {
+		return NewErrInvalidUserInput("no reference properties to replace")
+	}
+
+	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return err
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	className, err := m.updateThingReferencesToConnectorAndSchema(ctx, principal, id, refs, clearOmitted)
+	m.audit(ctx, principal, audit.OperationUpdateReferences, kind.Thing, className, id, err)
+	return err
+}
+
+func (m *Manager) updateThingReferencesToConnectorAndSchema(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, refs models.PropertyReferences, clearOmitted bool) (string, error) {
+
+	// get thing to see if it exists
+	thingRes, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
+	if err != nil {
+		return "", err
+	}
+
+	thing := thingRes.Thing()
+	if clearOmitted {
+		refs, err = m.withOmittedReferencesCleared(principal, kind.Thing, thing.Class, refs)
+		if err != nil {
+			return thing.Class, err
+		}
+	}
+
+	for _, propertyName := range sortedPropertyNames(refs) {
+		err = m.validateReferences(ctx, principal, kind.Thing, thing.Class, propertyName,
+			refs[propertyName])
+		if err != nil {
+			return thing.Class, err
+		}
+
+		err = m.validateCanModifyReference(principal, kind.Thing, thing.Class, propertyName)
+		if err != nil {
+			return thing.Class, err
+		}
+
+		err = m.validateReferenceCount(principal, kind.Thing, thing.Class, propertyName,
+			len(refs[propertyName]))
+		if err != nil {
+			return thing.Class, err
+		}
+	}
+
+	updatedSchema := thing.Schema
+	for propertyName, propRefs := range refs {
+		updatedSchema, err = m.replaceClassPropReferences(updatedSchema, propertyName, propRefs)
+		if err != nil {
+			return thing.Class, err
+		}
 	}
 	thing.Schema = updatedSchema
 	thing.LastUpdateTimeUnix = m.timeSource.Now()
+	// the stored version must not be treated as the expected one
+	thing.Version = 0
 
 	// the new refs could be network refs
 	err = m.addNetworkDataTypesForThing(ctx, principal, thing)
 	if err != nil {
-		return NewErrInternal("could not update schema for network refs: %v", err)
+		return thing.Class, NewErrInternal("could not update schema for network refs: %v", err)
 	}
 
 	err = m.vectorRepo.PutThing(ctx, thing, thingRes.Vector)
 	if err != nil {
-		return NewErrInternal("could not store thing: %v", err)
+		return thing.Class, NewErrInternal("could not store thing: %v", err)
 	}
 
-	return nil
+	m.emitEvent(kind.Thing, thing.Class, id, events.OperationUpdate)
+	return thing.Class, nil
 }
 
-func (m *Manager) validateReferences(ctx context.Context, references models.MultipleRef) error {
-	err := validation.New(schema.Schema{}, m.exists, m.network, m.config).
-		ValidateMultipleRef(ctx, references, "reference not found")
+func (m *Manager) validateReferences(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className, propertyName string, references models.MultipleRef) error {
+	targetClasses, err := m.referenceTargetClasses(principal, k, className, propertyName)
+	if err != nil {
+		return err
+	}
+
+	err = validation.New(schema.Schema{}, m.exists, m.network, m.config).
+		ValidateMultipleRef(ctx, references, targetClasses, "reference not found")
 	if err != nil {
 		return NewErrInvalidUserInput("invalid references: %v", err)
 	}
@@ -166,3 +337,53 @@ func (m *Manager) replaceClassPropReferences(props interface{}, propertyName str
 	propsMap[propertyName] = refs
 	return propsMap, nil
 }
+
+// withOmittedReferencesCleared returns a copy of refs which additionally
+// contains an empty list for every reference property of the class that is
+// not already present in refs
+func (m *Manager) withOmittedReferencesCleared(principal *models.Principal, k kind.Kind,
+	className string, refs models.PropertyReferences) (models.PropertyReferences, error) {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	class := s.GetClass(k, schema.ClassName(className))
+	if class == nil {
+		return nil, NewErrInvalidUserInput("class '%s' does not exist", className)
+	}
+
+	out := make(models.PropertyReferences, len(refs))
+	for name, propRefs := range refs {
+		out[name] = propRefs
+	}
+
+	for _, prop := range class.Properties {
+		if _, ok := out[prop.Name]; ok {
+			continue
+		}
+
+		dt, err := s.FindPropertyDataType(prop.DataType)
+		if err != nil {
+			return nil, NewErrInternal("could not find datatype of property '%s': %v", prop.Name, err)
+		}
+
+		if dt.IsReference() {
+			out[prop.Name] = models.MultipleRef{}
+		}
+	}
+
+	return out, nil
+}
+
+// sortedPropertyNames makes sure properties are always validated in the same
+// order, so the same invalid request always leads to the same error
+func sortedPropertyNames(refs models.PropertyReferences) []string {
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReferencesBulkUpdate(t *testing.T) {
+
+	logger, _ := test.NewNullLogger()
+
+	var (
+		vectorRepo    *fakeVectorRepo
+		schemaManager *fakeSchemaManager
+		manager       *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager = &fakeSchemaManager{}
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	existing := func() *search.Result {
+		return &search.Result{
+			ClassName: "Zoo",
+			Schema: map[string]interface{}{
+				"name": "MyZoo",
+			},
+		}
+	}
+
+	animalRefs := models.MultipleRef{
+		&models.SingleRef{
+			Beacon: strfmt.URI("weaviate://localhost/things/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7"),
+		},
+	}
+
+	t.Run("with all properties being valid references", func(t *testing.T) {
+		reset()
+		// the target of the reference
+		vectorRepo.On("ObjectClass", kind.Thing, strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")).
+			Return("Animal", true, nil)
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(existing(), nil)
+		schemaManager.GetSchemaResponse = zooAnimalSchemaForTest()
+		vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := manager.BulkUpdateThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{"hasAnimals": animalRefs})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+
+		stored := vectorRepo.Calls[len(vectorRepo.Calls)-1].Arguments.Get(0).(*models.Thing)
+		assert.Equal(t, map[string]interface{}{
+			"name":       "MyZoo",
+			"hasAnimals": animalRefs,
+		}, stored.Schema)
+	})
+
+	t.Run("with one property not being a reference", func(t *testing.T) {
+		reset()
+		// the target of the reference
+		vectorRepo.On("ObjectClass", kind.Thing, strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")).
+			Return("Animal", true, nil)
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(existing(), nil)
+		schemaManager.GetSchemaResponse = zooAnimalSchemaForTest()
+
+		err := manager.BulkUpdateThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{
+				"hasAnimals": animalRefs,
+				"name":       animalRefs,
+			})
+		require.NotNil(t, err)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok, "error must be invalid user input")
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without any properties", func(t *testing.T) {
+		reset()
+
+		err := manager.BulkUpdateThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{})
+		require.NotNil(t, err)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok, "error must be invalid user input")
+	})
+}
+
+func Test_ReferencesReplace(t *testing.T) {
+
+	logger, _ := test.NewNullLogger()
+
+	var (
+		vectorRepo    *fakeVectorRepo
+		schemaManager *fakeSchemaManager
+		manager       *Manager
+	)
+
+	animalRefs := models.MultipleRef{
+		&models.SingleRef{
+			Beacon: strfmt.URI("weaviate://localhost/things/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7"),
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager = &fakeSchemaManager{GetSchemaResponse: zooAnimalSchemaForTest()}
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		// the targets of the references
+		for _, id := range []strfmt.UUID{
+			"d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+			"a5b8b4c2-7c1e-4a8f-9e77-59ab0d1c7a51",
+		} {
+			vectorRepo.On("ObjectClass", kind.Thing, id).Return("Animal", true, nil)
+		}
+		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(&search.Result{
+				ClassName: "Zoo",
+				Schema: map[string]interface{}{
+					"name":       "MyZoo",
+					"hasAnimals": animalRefs,
+				},
+			}, nil)
+	}
+
+	storedSchema := func() interface{} {
+		return vectorRepo.Calls[len(vectorRepo.Calls)-1].Arguments.Get(0).(*models.Thing).Schema
+	}
+
+	t.Run("without properties and without clearing omitted ones", func(t *testing.T) {
+		reset()
+
+		err := manager.ReplaceThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{}, false)
+		require.NotNil(t, err)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok, "error must be invalid user input")
+		vectorRepo.AssertNotCalled(t, "PutThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("an explicitly empty property is cleared", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := manager.ReplaceThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{"hasAnimals": {}}, false)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":       "MyZoo",
+			"hasAnimals": models.MultipleRef{},
+		}, storedSchema())
+	})
+
+	t.Run("omitted properties are cleared when requested", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := manager.ReplaceThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{}, true)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":       "MyZoo",
+			"hasAnimals": models.MultipleRef{},
+		}, storedSchema())
+	})
+
+	t.Run("listed properties are replaced, not appended", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil).Once()
+		newRefs := models.MultipleRef{
+			&models.SingleRef{
+				Beacon: strfmt.URI("weaviate://localhost/things/a5b8b4c2-7c1e-4a8f-9e77-59ab0d1c7a51"),
+			},
+		}
+
+		err := manager.ReplaceThingReferences(context.Background(), nil,
+			strfmt.UUID("my-id"), models.PropertyReferences{"hasAnimals": newRefs}, true)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":       "MyZoo",
+			"hasAnimals": newRefs,
+		}, storedSchema())
+	})
+}
@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+)
+
+// reindexTracker keeps the status of the most recent reindex per class
+type reindexTracker struct {
+	sync.Mutex
+	statuses map[string]*models.ReindexStatus
+}
+
+func newReindexTracker() *reindexTracker {
+	return &reindexTracker{statuses: map[string]*models.ReindexStatus{}}
+}
+
+// start registers a new running reindex, it fails if there already is one
+// for the same class
+func (t *reindexTracker) start(status *models.ReindexStatus) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	if prev, ok := t.statuses[status.Class]; ok &&
+		prev.Status == models.ReindexStatusStatusRunning {
+		return false
+	}
+
+	t.statuses[status.Class] = status
+	return true
+}
+
+func (t *reindexTracker) update(className string, fn func(status *models.ReindexStatus)) {
+	t.Lock()
+	defer t.Unlock()
+
+	fn(t.statuses[className])
+}
+
+func (t *reindexTracker) get(className string) (*models.ReindexStatus, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	status, ok := t.statuses[className]
+	if !ok {
+		return nil, false
+	}
+
+	out := *status
+	return &out, true
+}
+
+// ReindexClass re-vectorizes all objects of the class in the background, for
+// example after the contextionary has changed. The returned status reflects
+// the start of the reindex, use GetReindexStatus to follow the progress. If
+// resume is set and a previous reindex of the class was interrupted, the
+// vector repo continues it rather than starting over.
+func (m *Manager) ReindexClass(ctx context.Context, principal *models.Principal,
+	className string, resume bool) (*models.ReindexStatus, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not read schema: %v", err)
+	}
+
+	k, ok := s.GetKindOfClass(schema.ClassName(className))
+	if !ok {
+		return nil, NewErrNotFound("class '%s' not found in schema", className)
+	}
+
+	status := &models.ReindexStatus{
+		Class:   className,
+		Kind:    k.Name(),
+		Status:  models.ReindexStatusStatusRunning,
+		Started: m.timeSource.Now(),
+	}
+	if !m.reindexes.start(status) {
+		return nil, NewErrAlreadyExists("class '%s' is already being reindexed", className)
+	}
+
+	started := *status
+	go m.reindexClass(requestid.Detach(ctx), k, className, resume)
+
+	return &started, nil
+}
+
+// reindexClass must be called with a detached context, the reindex outlives
+// the request that started it
+func (m *Manager) reindexClass(ctx context.Context, k kind.Kind, className string,
+	resume bool) {
+	err := m.vectorRepo.ReindexClass(ctx, k, className, resume,
+		func(res *search.Result) ([]float32, error) {
+			return m.vectorizeSearchResult(ctx, k, res)
+		},
+		func(processed int) {
+			m.reindexes.update(className, func(status *models.ReindexStatus) {
+				status.Processed += int64(processed)
+			})
+		})
+
+	m.reindexes.update(className, func(status *models.ReindexStatus) {
+		status.Finished = m.timeSource.Now()
+		if err != nil {
+			status.Status = models.ReindexStatusStatusFailed
+			status.Error = err.Error()
+			return
+		}
+
+		status.Status = models.ReindexStatusStatusCompleted
+	})
+
+	status, _ := m.reindexes.get(className)
+	logger := requestid.Logger(ctx, m.logger).WithField("action", "reindex_class").
+		WithField("class", className).
+		WithField("processed", status.Processed)
+	if err != nil {
+		logger.WithError(err).Error("reindex failed")
+		return
+	}
+
+	logger.Info("reindex completed")
+}
+
+func (m *Manager) vectorizeSearchResult(ctx context.Context, k kind.Kind,
+	res *search.Result) ([]float32, error) {
+	var (
+		vector []float32
+		err    error
+	)
+
+	switch k {
+	case kind.Thing:
+		vector, _, err = m.vectorizer.Thing(ctx, res.Thing())
+	case kind.Action:
+		vector, _, err = m.vectorizer.Action(ctx, res.Action())
+	default:
+		return nil, fmt.Errorf("impossible kind: %v", k)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vectorize %s %s: %v", k.Name(), res.ID, err)
+	}
+
+	return vector, nil
+}
+
+// GetReindexStatus returns the status of the most recent reindex of the class
+func (m *Manager) GetReindexStatus(ctx context.Context, principal *models.Principal,
+	className string) (*models.ReindexStatus, error) {
+	err := m.authorizer.Authorize(principal, "get", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := m.reindexes.get(className)
+	if !ok {
+		return nil, NewErrNotFound("class '%s' has not been reindexed", className)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReindexClass(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorizer = &fakeVectorizer{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Foo"},
+					},
+				},
+				Actions: &models.Schema{},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, vectorizer,
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+	}
+
+	waitForReindex := func(t *testing.T, className string) *models.ReindexStatus {
+		for i := 0; i < 100; i++ {
+			status, err := manager.GetReindexStatus(context.Background(), nil, className)
+			require.Nil(t, err)
+			if status.Status != models.ReindexStatusStatusRunning {
+				return status
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		t.Fatalf("reindex of %s did not finish in time", className)
+		return nil
+	}
+
+	results := []*search.Result{
+		&search.Result{ClassName: "Foo", ID: "1ab5d4e6-1b3b-4ca0-bd2c-ab68a0bc2b1e", Kind: kind.Thing},
+		&search.Result{ClassName: "Foo", ID: "8e555f0d-8590-48c2-a9a6-70772ed14c0a", Kind: kind.Thing},
+	}
+
+	t.Run("reindexing a class that doesn't exist", func(t *testing.T) {
+		reset()
+
+		_, err := manager.ReindexClass(context.Background(), nil, "Bar", false)
+		assert.Equal(t, NewErrNotFound("class 'Bar' not found in schema"), err)
+	})
+
+	t.Run("the status of a class that was never reindexed", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetReindexStatus(context.Background(), nil, "Foo")
+		assert.Equal(t, NewErrNotFound("class 'Foo' has not been reindexed"), err)
+	})
+
+	t.Run("reindexing all objects of a class", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ReindexClass", kind.Thing, "Foo").Return(results, nil)
+		vectorizer.On("Thing", mock.Anything).Return([]float32{1, 2, 3}, nil)
+
+		status, err := manager.ReindexClass(context.Background(), nil, "Foo", false)
+		require.Nil(t, err)
+		assert.Equal(t, "Foo", status.Class)
+		assert.Equal(t, models.ReindexStatusKindThing, status.Kind)
+		assert.Equal(t, models.ReindexStatusStatusRunning, status.Status)
+
+		status = waitForReindex(t, "Foo")
+		assert.Equal(t, models.ReindexStatusStatusCompleted, status.Status)
+		assert.Equal(t, int64(2), status.Processed)
+		assert.Equal(t, "", status.Error)
+		vectorizer.AssertNumberOfCalls(t, "Thing", 2)
+	})
+
+	t.Run("a failing vectorizer", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ReindexClass", kind.Thing, "Foo").Return(results, nil)
+		vectorizer.On("Thing", mock.Anything).Return([]float32{}, errors.New("c11y is down"))
+
+		_, err := manager.ReindexClass(context.Background(), nil, "Foo", false)
+		require.Nil(t, err)
+
+		status := waitForReindex(t, "Foo")
+		assert.Equal(t, models.ReindexStatusStatusFailed, status.Status)
+		assert.Equal(t, int64(0), status.Processed)
+		assert.Contains(t, status.Error, "c11y is down")
+	})
+
+	t.Run("starting a second reindex while one is running", func(t *testing.T) {
+		reset()
+		block := make(chan time.Time)
+		vectorRepo.On("ReindexClass", kind.Thing, "Foo").
+			WaitUntil(block).Return([]*search.Result{}, nil)
+
+		_, err := manager.ReindexClass(context.Background(), nil, "Foo", false)
+		require.Nil(t, err)
+
+		_, err = manager.ReindexClass(context.Background(), nil, "Foo", false)
+		assert.Equal(t, NewErrAlreadyExists("class 'Foo' is already being reindexed"), err)
+
+		close(block)
+		status := waitForReindex(t, "Foo")
+		assert.Equal(t, models.ReindexStatusStatusCompleted, status.Status)
+
+		_, err = manager.ReindexClass(context.Background(), nil, "Foo", false)
+		assert.Nil(t, err, "a finished reindex can be started again")
+		waitForReindex(t, "Foo")
+	})
+}
@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+)
+
+// reshardRepo persists the status of the most recent reshard per class, so
+// that reshards which were interrupted by a restart can be resumed
+type reshardRepo interface {
+	Put(ctx context.Context, status models.ReshardStatus) error
+	Get(ctx context.Context, className string) (*models.ReshardStatus, error)
+	List(ctx context.Context) ([]*models.ReshardStatus, error)
+}
+
+type noopReshardRepo struct{}
+
+func (n noopReshardRepo) Put(ctx context.Context, status models.ReshardStatus) error {
+	return nil
+}
+
+func (n noopReshardRepo) Get(ctx context.Context, className string) (*models.ReshardStatus, error) {
+	return nil, nil
+}
+
+func (n noopReshardRepo) List(ctx context.Context) ([]*models.ReshardStatus, error) {
+	return nil, nil
+}
+
+// SetReshardRepo persists the status of reshards. Without a repo, the status
+// is only kept in memory and an interrupted reshard is not resumed.
+func (m *Manager) SetReshardRepo(repo reshardRepo) {
+	m.reshardRepo = repo
+}
+
+// reshardTracker keeps the status of the most recent reshard per class
+type reshardTracker struct {
+	sync.Mutex
+	statuses map[string]*models.ReshardStatus
+}
+
+func newReshardTracker() *reshardTracker {
+	return &reshardTracker{statuses: map[string]*models.ReshardStatus{}}
+}
+
+// start registers a new running reshard, it fails if there already is one
+// for the same class
+func (t *reshardTracker) start(status *models.ReshardStatus) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	if prev, ok := t.statuses[status.Class]; ok &&
+		prev.Status == models.ReshardStatusStatusRunning {
+		return false
+	}
+
+	t.statuses[status.Class] = status
+	return true
+}
+
+func (t *reshardTracker) update(className string, fn func(status *models.ReshardStatus)) {
+	t.Lock()
+	defer t.Unlock()
+
+	fn(t.statuses[className])
+}
+
+func (t *reshardTracker) get(className string) (*models.ReshardStatus, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	status, ok := t.statuses[className]
+	if !ok {
+		return nil, false
+	}
+
+	out := *status
+	return &out, true
+}
+
+// autoExpandReplicasPattern matches the values elasticsearch accepts for
+// index.auto_expand_replicas
+var autoExpandReplicasPattern = regexp.MustCompile(`^(false|[0-9]+-([0-9]+|all))$`)
+
+// ReshardClass moves all objects of the class to the specified shard
+// configuration in the background, for example to scale out a class which
+// receives a lot of traffic. The class stays queryable while its objects are
+// moved. The returned status reflects the start of the reshard, use
+// GetReshardStatus to follow the progress. Starting a reshard with the same
+// configuration as an interrupted one resumes it, a reshard which was
+// interrupted by a restart is resumed by ResumeReshards.
+func (m *Manager) ReshardClass(ctx context.Context, principal *models.Principal,
+	className string, config models.ShardingConfig) (*models.ReshardStatus, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if config.NumberOfShards < 1 {
+		return nil, NewErrInvalidUserInput("numberOfShards must be at least 1, got %d",
+			config.NumberOfShards)
+	}
+
+	if !autoExpandReplicasPattern.MatchString(config.AutoExpandReplicas) {
+		return nil, NewErrInvalidUserInput("autoExpandReplicas must be a range such as "+
+			"'0-1' or '0-all', or 'false', got '%s'", config.AutoExpandReplicas)
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not read schema: %v", err)
+	}
+
+	k, ok := s.GetKindOfClass(schema.ClassName(className))
+	if !ok {
+		return nil, NewErrNotFound("class '%s' not found in schema", className)
+	}
+
+	status := &models.ReshardStatus{
+		Class:              className,
+		Kind:               k.Name(),
+		NumberOfShards:     config.NumberOfShards,
+		AutoExpandReplicas: config.AutoExpandReplicas,
+		Status:             models.ReshardStatusStatusRunning,
+		Started:            m.timeSource.Now(),
+	}
+	if !m.reshards.start(status) {
+		return nil, NewErrAlreadyExists("class '%s' is already being resharded", className)
+	}
+
+	started := *status
+	if err := m.reshardRepo.Put(ctx, started); err != nil {
+		m.reshards.update(className, func(status *models.ReshardStatus) {
+			status.Status = models.ReshardStatusStatusFailed
+			status.Error = err.Error()
+		})
+		return nil, NewErrInternal("could not store reshard status: %v", err)
+	}
+
+	go m.reshardClass(requestid.Detach(ctx), k, className, config)
+
+	return &started, nil
+}
+
+// ResumeReshards restarts every reshard which was still running when the
+// process stopped. A reshard which stopped during its catch-up left the
+// class blocked for writes, the block is lifted once the resumed reshard
+// completes or fails.
+func (m *Manager) ResumeReshards(ctx context.Context) error {
+	statuses, err := m.reshardRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list reshards: %v", err)
+	}
+
+	for _, status := range statuses {
+		if status.Status != models.ReshardStatusStatusRunning {
+			continue
+		}
+
+		k, err := kind.Parse(status.Kind)
+		if err != nil {
+			return fmt.Errorf("resume reshard of class '%s': %v", status.Class, err)
+		}
+
+		if !m.reshards.start(status) {
+			continue
+		}
+
+		m.logger.WithField("action", "reshard_class").
+			WithField("class", status.Class).
+			Info("resuming interrupted reshard")
+
+		go m.reshardClass(context.Background(), k, status.Class, models.ShardingConfig{
+			NumberOfShards:     status.NumberOfShards,
+			AutoExpandReplicas: status.AutoExpandReplicas,
+		})
+	}
+
+	return nil
+}
+
+// reshardClass must be called with a detached context, the reshard outlives
+// the request that started it
+func (m *Manager) reshardClass(ctx context.Context, k kind.Kind, className string,
+	config models.ShardingConfig) {
+	err := m.vectorRepo.ReshardClass(ctx, k, className, int(config.NumberOfShards),
+		config.AutoExpandReplicas,
+		func(total, processed int) {
+			m.reshards.update(className, func(status *models.ReshardStatus) {
+				status.Total = int64(total)
+				status.Processed = int64(processed)
+			})
+		})
+
+	m.reshards.update(className, func(status *models.ReshardStatus) {
+		status.Finished = m.timeSource.Now()
+		if err != nil {
+			status.Status = models.ReshardStatusStatusFailed
+			status.Error = err.Error()
+			return
+		}
+
+		status.Status = models.ReshardStatusStatusCompleted
+	})
+
+	status, _ := m.reshards.get(className)
+	if putErr := m.reshardRepo.Put(ctx, *status); putErr != nil {
+		requestid.Logger(ctx, m.logger).WithField("action", "reshard_class").
+			WithField("class", className).WithError(putErr).
+			Error("could not store reshard status")
+	}
+
+	logger := requestid.Logger(ctx, m.logger).WithField("action", "reshard_class").
+		WithField("class", className).
+		WithField("numberOfShards", config.NumberOfShards).
+		WithField("autoExpandReplicas", config.AutoExpandReplicas).
+		WithField("processed", status.Processed)
+	if err != nil {
+		logger.WithError(err).Error("reshard failed")
+		return
+	}
+
+	logger.Info("reshard completed")
+}
+
+// GetReshardStatus returns the status of the most recent reshard of the class
+func (m *Manager) GetReshardStatus(ctx context.Context, principal *models.Principal,
+	className string) (*models.ReshardStatus, error) {
+	err := m.authorizer.Authorize(principal, "get", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := m.reshards.get(className)
+	if ok {
+		return status, nil
+	}
+
+	// the reshard might have happened before a restart
+	status, err = m.reshardRepo.Get(ctx, className)
+	if err != nil {
+		return nil, NewErrInternal("could not read reshard status: %v", err)
+	}
+
+	if status == nil {
+		return nil, NewErrNotFound("class '%s' has not been resharded", className)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReshardClass(t *testing.T) {
+	var (
+		vectorRepo  *fakeVectorRepo
+		reshardRepo *fakeReshardRepo
+		manager     *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		reshardRepo = &fakeReshardRepo{statuses: map[string]models.ReshardStatus{}}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{},
+				Actions: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Foo"},
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		manager.SetReshardRepo(reshardRepo)
+	}
+
+	waitForReshard := func(t *testing.T, className string) *models.ReshardStatus {
+		for i := 0; i < 100; i++ {
+			status, err := manager.GetReshardStatus(context.Background(), nil, className)
+			require.Nil(t, err)
+			if status.Status != models.ReshardStatusStatusRunning {
+				return status
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		t.Fatalf("reshard of %s did not finish in time", className)
+		return nil
+	}
+
+	config := models.ShardingConfig{NumberOfShards: 3, AutoExpandReplicas: "0-all"}
+
+	t.Run("resharding a class that doesn't exist", func(t *testing.T) {
+		reset()
+
+		_, err := manager.ReshardClass(context.Background(), nil, "Bar", config)
+		assert.Equal(t, NewErrNotFound("class 'Bar' not found in schema"), err)
+	})
+
+	t.Run("the status of a class that was never resharded", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetReshardStatus(context.Background(), nil, "Foo")
+		assert.Equal(t, NewErrNotFound("class 'Foo' has not been resharded"), err)
+	})
+
+	t.Run("with an invalid configuration", func(t *testing.T) {
+		reset()
+
+		_, err := manager.ReshardClass(context.Background(), nil, "Foo",
+			models.ShardingConfig{NumberOfShards: 0, AutoExpandReplicas: "0-1"})
+		assert.Equal(t, NewErrInvalidUserInput("numberOfShards must be at least 1, got 0"), err)
+
+		for _, replicas := range []string{"", "1", "0-", "all", "0-1,2"} {
+			_, err = manager.ReshardClass(context.Background(), nil, "Foo",
+				models.ShardingConfig{NumberOfShards: 1, AutoExpandReplicas: replicas})
+			_, ok := err.(ErrInvalidUserInput)
+			assert.True(t, ok, "'%s' should be rejected", replicas)
+		}
+	})
+
+	t.Run("resharding a class", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ReshardClass", kind.Action, "Foo", 3, "0-all").Return(5, nil)
+
+		status, err := manager.ReshardClass(context.Background(), nil, "Foo", config)
+		require.Nil(t, err)
+		assert.Equal(t, "Foo", status.Class)
+		assert.Equal(t, models.ReshardStatusKindAction, status.Kind)
+		assert.Equal(t, int64(3), status.NumberOfShards)
+		assert.Equal(t, "0-all", status.AutoExpandReplicas)
+		assert.Equal(t, models.ReshardStatusStatusRunning, status.Status)
+
+		status = waitForReshard(t, "Foo")
+		assert.Equal(t, models.ReshardStatusStatusCompleted, status.Status)
+		assert.Equal(t, int64(5), status.Total)
+		assert.Equal(t, int64(5), status.Processed)
+		assert.Equal(t, "", status.Error)
+
+		assert.Eventually(t, func() bool {
+			persisted, err := reshardRepo.Get(context.Background(), "Foo")
+			return err == nil && persisted.Status == models.ReshardStatusStatusCompleted
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("the status of a reshard from before a restart", func(t *testing.T) {
+		reset()
+		reshardRepo.statuses["Foo"] = models.ReshardStatus{
+			Class:  "Foo",
+			Status: models.ReshardStatusStatusCompleted,
+		}
+
+		status, err := manager.GetReshardStatus(context.Background(), nil, "Foo")
+		require.Nil(t, err)
+		assert.Equal(t, models.ReshardStatusStatusCompleted, status.Status)
+	})
+
+	t.Run("resuming a reshard which was interrupted by a restart", func(t *testing.T) {
+		reset()
+		reshardRepo.statuses["Foo"] = models.ReshardStatus{
+			Class:              "Foo",
+			Kind:               models.ReshardStatusKindAction,
+			NumberOfShards:     3,
+			AutoExpandReplicas: "0-all",
+			Status:             models.ReshardStatusStatusRunning,
+		}
+		reshardRepo.statuses["Bar"] = models.ReshardStatus{
+			Class:  "Bar",
+			Kind:   models.ReshardStatusKindAction,
+			Status: models.ReshardStatusStatusFailed,
+		}
+		vectorRepo.On("ReshardClass", kind.Action, "Foo", 3, "0-all").Return(5, nil)
+
+		require.Nil(t, manager.ResumeReshards(context.Background()))
+
+		status := waitForReshard(t, "Foo")
+		assert.Equal(t, models.ReshardStatusStatusCompleted, status.Status)
+		assert.Eventually(t, func() bool {
+			persisted, err := reshardRepo.Get(context.Background(), "Foo")
+			return err == nil && persisted.Status == models.ReshardStatusStatusCompleted
+		}, time.Second, 10*time.Millisecond)
+		vectorRepo.AssertNumberOfCalls(t, "ReshardClass", 1)
+	})
+
+	t.Run("a failing vector repo", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ReshardClass", kind.Action, "Foo", 3, "0-all").
+			Return(2, errors.New("es is down"))
+
+		_, err := manager.ReshardClass(context.Background(), nil, "Foo", config)
+		require.Nil(t, err)
+
+		status := waitForReshard(t, "Foo")
+		assert.Equal(t, models.ReshardStatusStatusFailed, status.Status)
+		assert.Equal(t, int64(2), status.Processed)
+		assert.Contains(t, status.Error, "es is down")
+	})
+
+	t.Run("starting a second reshard while one is running", func(t *testing.T) {
+		reset()
+		block := make(chan time.Time)
+		vectorRepo.On("ReshardClass", kind.Action, "Foo", 3, "0-all").
+			WaitUntil(block).Return(0, nil)
+
+		_, err := manager.ReshardClass(context.Background(), nil, "Foo", config)
+		require.Nil(t, err)
+
+		_, err = manager.ReshardClass(context.Background(), nil, "Foo", config)
+		assert.Equal(t, NewErrAlreadyExists("class 'Foo' is already being resharded"), err)
+
+		close(block)
+		status := waitForReshard(t, "Foo")
+		assert.Equal(t, models.ReshardStatusStatusCompleted, status.Status)
+	})
+}
+
+type fakeReshardRepo struct {
+	sync.Mutex
+	statuses map[string]models.ReshardStatus
+}
+
+func (f *fakeReshardRepo) Put(ctx context.Context, status models.ReshardStatus) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.statuses[status.Class] = status
+	return nil
+}
+
+func (f *fakeReshardRepo) Get(ctx context.Context,
+	className string) (*models.ReshardStatus, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	status, ok := f.statuses[className]
+	if !ok {
+		return nil, nil
+	}
+
+	return &status, nil
+}
+
+func (f *fakeReshardRepo) List(ctx context.Context) ([]*models.ReshardStatus, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.ReshardStatus
+	for _, status := range f.statuses {
+		status := status
+		out = append(out, &status)
+	}
+
+	return out, nil
+}
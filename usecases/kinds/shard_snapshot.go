@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+)
+
+// SnapshotShard writes a point-in-time snapshot of a single shard of the
+// class to w. No global lock is held while the snapshot is copied to w, as
+// that can take as long as the client needs to download it, the storage
+// only blocks writes to the shard until the snapshot has been taken.
+func (m *Manager) SnapshotShard(ctx context.Context, principal *models.Principal,
+	className, shardName string, w io.Writer) error {
+	err := m.authorizer.Authorize(principal, "get", "storage/*")
+	if err != nil {
+		return err
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return NewErrInternal("could not read schema: %v", err)
+	}
+
+	k, ok := s.GetKindOfClass(schema.ClassName(className))
+	if !ok {
+		return NewErrNotFound("class '%s' not found in schema", className)
+	}
+
+	err = m.vectorRepo.SnapshotShard(ctx, k, className, shardName, w)
+	if err != nil {
+		return shardSnapshotError("could not snapshot shard in vector repo", err)
+	}
+
+	return nil
+}
+
+// RestoreShard replaces the contents of a single shard of the class with a
+// snapshot which was taken with SnapshotShard. As it overwrites every object
+// of the shard, it requires the same permission as a compaction.
+func (m *Manager) RestoreShard(ctx context.Context, principal *models.Principal,
+	className, shardName string, r io.Reader) error {
+	err := m.authorizer.Authorize(principal, "update", "storage/*")
+	if err != nil {
+		return err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return NewErrInternal("could not read schema: %v", err)
+	}
+
+	k, ok := s.GetKindOfClass(schema.ClassName(className))
+	if !ok {
+		return NewErrNotFound("class '%s' not found in schema", className)
+	}
+
+	err = m.restoreShardInRepo(ctx, s, k, className, shardName, r)
+	m.audit(ctx, principal, audit.OperationRestore, k, className, "", err)
+	return err
+}
+
+func (m *Manager) restoreShardInRepo(ctx context.Context, s schema.Schema,
+	k kind.Kind, className, shardName string, r io.Reader) error {
+	if err := checkClassNotFrozen(s, k, className); err != nil {
+		return err
+	}
+
+	err := m.vectorRepo.RestoreShard(ctx, k, className, shardName, r)
+	if err != nil {
+		return shardSnapshotError("could not restore shard in vector repo", err)
+	}
+
+	return nil
+}
+
+// shardSnapshotError passes on the errors of the vector repo which are not
+// caused by the repo itself, such as a shard which doesn't exist or a
+// malformed snapshot
+func shardSnapshotError(msg string, err error) error {
+	switch cause := errors.Cause(err).(type) {
+	case ErrNotFound, ErrInvalidUserInput, ErrNotImplemented:
+		return cause
+	default:
+		return NewErrInternal("%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShardSnapshots(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		sink       *fakeAuditSink
+		manager    *Manager
+	)
+
+	reset := func(frozen bool) {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Foo", Frozen: frozen},
+					},
+				},
+				Actions: &models.Schema{},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		sink = &fakeAuditSink{}
+		manager.SetAuditSink(sink)
+	}
+
+	t.Run("snapshotting a shard of a class that doesn't exist", func(t *testing.T) {
+		reset(false)
+
+		err := manager.SnapshotShard(context.Background(), nil, "Bar", "single",
+			&bytes.Buffer{})
+		assert.Equal(t, NewErrNotFound("class 'Bar' not found in schema"), err)
+	})
+
+	t.Run("snapshotting a shard", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("SnapshotShard", kind.Thing, "Foo", "single").Return(nil).Once()
+
+		err := manager.SnapshotShard(context.Background(), nil, "Foo", "single",
+			&bytes.Buffer{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("snapshotting a shard that doesn't exist", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("SnapshotShard", kind.Thing, "Foo", "other").
+			Return(NewErrNotFound("shard 'other' not found")).Once()
+
+		err := manager.SnapshotShard(context.Background(), nil, "Foo", "other",
+			&bytes.Buffer{})
+		assert.Equal(t, NewErrNotFound("shard 'other' not found"), err)
+	})
+
+	t.Run("restoring a shard", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("RestoreShard", kind.Thing, "Foo", "single").Return(nil).Once()
+
+		err := manager.RestoreShard(context.Background(), nil, "Foo", "single",
+			&bytes.Buffer{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+
+		require.Len(t, sink.written, 1)
+		assert.Equal(t, audit.OperationRestore, sink.written[0].Operation)
+		assert.Equal(t, kind.Thing, sink.written[0].Kind)
+		assert.Equal(t, "", sink.written[0].Error)
+	})
+
+	t.Run("restoring a shard of a frozen class", func(t *testing.T) {
+		reset(true)
+
+		err := manager.RestoreShard(context.Background(), nil, "Foo", "single",
+			&bytes.Buffer{})
+		assert.IsType(t, ErrReadOnly{}, err)
+		require.Len(t, sink.written, 1)
+		assert.Equal(t, err.Error(), sink.written[0].Error)
+	})
+
+	t.Run("restoring a malformed snapshot", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("RestoreShard", kind.Thing, "Foo", "single").
+			Return(NewErrInvalidUserInput("snapshot is missing an entry")).Once()
+
+		err := manager.RestoreShard(context.Background(), nil, "Foo", "single",
+			&bytes.Buffer{})
+		assert.Equal(t, NewErrInvalidUserInput("snapshot is missing an entry"), err)
+	})
+
+	t.Run("a failing repo", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("RestoreShard", kind.Thing, "Foo", "single").
+			Return(errors.New("oops")).Once()
+
+		err := manager.RestoreShard(context.Background(), nil, "Foo", "single",
+			&bytes.Buffer{})
+		assert.Equal(t, NewErrInternal("could not restore shard in vector repo: oops"), err)
+	})
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
+)
+
+// TruncateClass removes all objects of the class at once, but keeps the class
+// itself with its properties and configuration. It returns the number of
+// objects which were removed. As it is as destructive as deleting the class,
+// it requires the same permission.
+func (m *Manager) TruncateClass(ctx context.Context, principal *models.Principal,
+	className string) (int64, error) {
+	err := m.authorizer.Authorize(principal, "delete", "schema/*")
+	if err != nil {
+		return 0, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return 0, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return 0, NewErrInternal("could not read schema: %v", err)
+	}
+
+	k, ok := s.GetKindOfClass(schema.ClassName(className))
+	if !ok {
+		return 0, NewErrNotFound("class '%s' not found in schema", className)
+	}
+
+	count, err := m.truncateClassInRepo(ctx, s, k, className)
+	m.audit(ctx, principal, audit.OperationTruncate, k, className, "", err)
+	return count, err
+}
+
+func (m *Manager) truncateClassInRepo(ctx context.Context, s schema.Schema,
+	k kind.Kind, className string) (int64, error) {
+	if err := checkClassNotFrozen(s, k, className); err != nil {
+		return 0, err
+	}
+
+	count, err := m.vectorRepo.TruncateClass(ctx, k, className)
+	if err != nil {
+		return 0, NewErrInternal("could not truncate class in vector repo: %v", err)
+	}
+
+	m.emitEvent(k, className, "", events.OperationTruncate)
+
+	return count, nil
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/events"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TruncateClass(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		emitter    *fakeEmitter
+		sink       *fakeAuditSink
+		manager    *Manager
+	)
+
+	reset := func(frozen bool) {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{},
+				Actions: &models.Schema{
+					Classes: []*models.Class{
+						&models.Class{Class: "Foo", Frozen: frozen},
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		manager.timeSource = fakeTimeSource{}
+		emitter = &fakeEmitter{}
+		manager.SetEventEmitter(emitter)
+		sink = &fakeAuditSink{}
+		manager.SetAuditSink(sink)
+	}
+
+	t.Run("truncating a class that doesn't exist", func(t *testing.T) {
+		reset(false)
+
+		_, err := manager.TruncateClass(context.Background(), nil, "Bar")
+		assert.Equal(t, NewErrNotFound("class 'Bar' not found in schema"), err)
+	})
+
+	t.Run("truncating a class", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("TruncateClass", kind.Action, "Foo").Return(int64(17), nil).Once()
+
+		count, err := manager.TruncateClass(context.Background(), nil, "Foo")
+		require.Nil(t, err)
+		assert.Equal(t, int64(17), count)
+		vectorRepo.AssertExpectations(t)
+
+		require.Len(t, emitter.emitted, 1)
+		assert.Equal(t, events.OperationTruncate, emitter.emitted[0].Operation)
+		assert.Equal(t, "Foo", emitter.emitted[0].Class)
+
+		require.Len(t, sink.written, 1)
+		assert.Equal(t, audit.OperationTruncate, sink.written[0].Operation)
+		assert.Equal(t, kind.Action, sink.written[0].Kind)
+		assert.Equal(t, "", sink.written[0].Error)
+	})
+
+	t.Run("truncating a frozen class", func(t *testing.T) {
+		reset(true)
+
+		_, err := manager.TruncateClass(context.Background(), nil, "Foo")
+		assert.IsType(t, ErrReadOnly{}, err)
+		assert.Len(t, emitter.emitted, 0)
+		require.Len(t, sink.written, 1)
+		assert.Equal(t, err.Error(), sink.written[0].Error)
+	})
+
+	t.Run("a failing repo", func(t *testing.T) {
+		reset(false)
+		vectorRepo.On("TruncateClass", kind.Action, "Foo").
+			Return(int64(0), errors.New("oops")).Once()
+
+		_, err := manager.TruncateClass(context.Background(), nil, "Foo")
+		assert.Equal(t, NewErrInternal("could not truncate class in vector repo: oops"), err)
+		assert.Len(t, emitter.emitted, 0)
+	})
+}
@@ -18,6 +18,10 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/events"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -35,35 +39,59 @@ type updateRepo interface {
 // UpdateAction Class Instance to the connected DB. If the class contains a network
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
+//
+// If class.Version is set, the update fails with an ErrVersionConflict
+// unless the stored action still has this version.
 func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal, id strfmt.UUID,
 	class *models.Action) (*models.Action, error) {
+	action, _, err := m.updateAction(ctx, principal, id, class)
+	return action, err
+}
 
+// updateAction authorizes, locks and audits the update. Besides the updated
+// action it returns the state prior to the update.
+func (m *Manager) updateAction(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, class *models.Action) (*models.Action, *search.Result, error) {
 	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return nil, NewErrInternal("could not aquire lock: %v", err)
+		return nil, nil, NewErrInternal("could not aquire lock: %v", err)
 	}
 	defer unlock()
 
-	return m.updateActionToConnectorAndSchema(ctx, principal, id, class)
+	updated, original, err := m.updateActionToConnectorAndSchema(ctx, principal, id, class)
+	m.audit(ctx, principal, audit.OperationUpdate, kind.Action, class.Class, id, err)
+	return updated, original, err
 }
 
 func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, class *models.Action) (*models.Action, error) {
+	id strfmt.UUID, class *models.Action) (*models.Action, *search.Result, error) {
 	if id != class.ID {
-		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
+		return nil, nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
+	}
+
+	if err := validateObjectSize(m.config, class); err != nil {
+		return nil, nil, err
 	}
 
 	originalAction, err := m.getActionFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if err := m.checkNotFrozen(principal, kind.Action, originalAction.ClassName); err != nil {
+		return nil, nil, err
 	}
 
-	m.logger.
+	if err := m.rateLimiter.Take(map[string]int{originalAction.ClassName: 1}); err != nil {
+		return nil, nil, err
+	}
+
+	requestid.Logger(ctx, m.logger).
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Action).
 		WithField("original", originalAction).
@@ -73,56 +101,93 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 
 	err = m.validateAction(ctx, principal, class)
 	if err != nil {
-		return nil, NewErrInvalidUserInput("invalid action: %v", err)
+		return nil, nil, NewErrInvalidUserInput("invalid action: %v", err)
 	}
 
 	err = m.addNetworkDataTypesForAction(ctx, principal, class)
 	if err != nil {
-		return nil, NewErrInternal("could not update schema for network refs: %v", err)
+		return nil, nil, NewErrInternal("could not update schema for network refs: %v", err)
 	}
 
-	class.LastUpdateTimeUnix = m.timeSource.Now()
+	now := m.timeSource.Now()
+	if class.ExpiryTimeUnix == 0 {
+		// an update without an expiry time keeps the original one
+		class.ExpiryTimeUnix = originalAction.Expiry
+	} else if err := validateExpiry(class.ExpiryTimeUnix, now); err != nil {
+		return nil, nil, err
+	}
+	class.LastUpdateTimeUnix = now
 
-	err = m.vectorizeAndPutAction(ctx, class)
+	err = m.vectorizeAndPutAction(ctx, class, originalAction)
 	if err != nil {
-		return nil, NewErrInternal("update action: %v", err)
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return nil, nil, err
+		}
+		return nil, nil, NewErrInternal("update action: %v", err)
 	}
 
-	return class, nil
+	m.emitEvent(kind.Action, class.Class, id, events.OperationUpdate)
+
+	return class, originalAction, nil
 }
 
 // UpdateThing Class Instance to the connected DB. If the class contains a network
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
+//
+// If class.Version is set, the update fails with an ErrVersionConflict
+// unless the stored thing still has this version.
 func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, class *models.Thing) (*models.Thing, error) {
+	thing, _, err := m.updateThing(ctx, principal, id, class)
+	return thing, err
+}
 
+// updateThing authorizes, locks and audits the update. Besides the updated
+// thing it returns the state prior to the update.
+func (m *Manager) updateThing(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, class *models.Thing) (*models.Thing, *search.Result, error) {
 	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
-		return nil, NewErrInternal("could not aquire lock: %v", err)
+		return nil, nil, NewErrInternal("could not aquire lock: %v", err)
 	}
 	defer unlock()
 
-	return m.updateThingToConnectorAndSchema(ctx, principal, id, class)
+	updated, original, err := m.updateThingToConnectorAndSchema(ctx, principal, id, class)
+	m.audit(ctx, principal, audit.OperationUpdate, kind.Thing, class.Class, id, err)
+	return updated, original, err
 }
 
 func (m *Manager) updateThingToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, class *models.Thing) (*models.Thing, error) {
+	id strfmt.UUID, class *models.Thing) (*models.Thing, *search.Result, error) {
 	if id != class.ID {
-		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
+		return nil, nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
+	}
+
+	if err := validateObjectSize(m.config, class); err != nil {
+		return nil, nil, err
 	}
 
 	originalThing, err := m.getThingFromRepo(ctx, id, traverser.UnderscoreProperties{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if err := m.checkNotFrozen(principal, kind.Thing, originalThing.ClassName); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.rateLimiter.Take(map[string]int{originalThing.ClassName: 1}); err != nil {
+		return nil, nil, err
 	}
 
-	m.logger.
+	requestid.Logger(ctx, m.logger).
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Thing).
 		WithField("original", originalThing).
@@ -132,20 +197,33 @@ func (m *Manager) updateThingToConnectorAndSchema(ctx context.Context, principal
 
 	err = m.validateThing(ctx, principal, class)
 	if err != nil {
-		return nil, NewErrInvalidUserInput("invalid thing: %v", err)
+		return nil, nil, NewErrInvalidUserInput("invalid thing: %v", err)
 	}
 
 	err = m.addNetworkDataTypesForThing(ctx, principal, class)
 	if err != nil {
-		return nil, NewErrInternal("update schema for network refs: %v", err)
+		return nil, nil, NewErrInternal("update schema for network refs: %v", err)
 	}
 
-	class.LastUpdateTimeUnix = m.timeSource.Now()
+	now := m.timeSource.Now()
+	if class.ExpiryTimeUnix == 0 {
+		// an update without an expiry time keeps the original one
+		class.ExpiryTimeUnix = originalThing.Expiry
+	} else if err := validateExpiry(class.ExpiryTimeUnix, now); err != nil {
+		return nil, nil, err
+	}
+	class.LastUpdateTimeUnix = now
 
-	err = m.vectorizeAndPutThing(ctx, class)
+	err = m.vectorizeAndPutThing(ctx, class, originalThing)
 	if err != nil {
-		return nil, NewErrInternal("update thing: %v", err)
+		switch err.(type) {
+		case ErrAlreadyExists, ErrInvalidUserInput, ErrVersionConflict, ErrUnavailable:
+			return nil, nil, err
+		}
+		return nil, nil, NewErrInternal("update thing: %v", err)
 	}
 
-	return class, nil
+	m.emitEvent(kind.Thing, class.Class, id, events.OperationUpdate)
+
+	return class, originalThing, nil
 }
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// UpdateThingChangedFields behaves like UpdateThing, but the returned thing
+// only contains the fields which were changed by the update, as well as its
+// class and id. This avoids re-sending large unchanged properties, such as
+// blobs, to the client.
+func (m *Manager) UpdateThingChangedFields(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, class *models.Thing) (*models.Thing, error) {
+	updated, original, err := m.updateThing(ctx, principal, id, class)
+	if err != nil {
+		return nil, err
+	}
+
+	before := original.Thing()
+	changed := &models.Thing{
+		Class:              updated.Class,
+		ID:                 updated.ID,
+		Schema:             changedProperties(before.Schema, updated.Schema),
+		LastUpdateTimeUnix: updated.LastUpdateTimeUnix,
+		Version:            updated.Version,
+		VectorWeights:      changedVectorWeights(before.VectorWeights, updated.VectorWeights),
+	}
+	if expiry, ok := changedInt64(before.ExpiryTimeUnix, updated.ExpiryTimeUnix); ok {
+		changed.ExpiryTimeUnix = expiry
+	}
+
+	return changed, nil
+}
+
+// UpdateActionChangedFields behaves like UpdateAction, but the returned
+// action only contains the fields which were changed by the update, as well
+// as its class and id.
+func (m *Manager) UpdateActionChangedFields(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, class *models.Action) (*models.Action, error) {
+	updated, original, err := m.updateAction(ctx, principal, id, class)
+	if err != nil {
+		return nil, err
+	}
+
+	before := original.Action()
+	changed := &models.Action{
+		Class:              updated.Class,
+		ID:                 updated.ID,
+		Schema:             changedProperties(before.Schema, updated.Schema),
+		LastUpdateTimeUnix: updated.LastUpdateTimeUnix,
+		Version:            updated.Version,
+		VectorWeights:      changedVectorWeights(before.VectorWeights, updated.VectorWeights),
+	}
+	if expiry, ok := changedInt64(before.ExpiryTimeUnix, updated.ExpiryTimeUnix); ok {
+		changed.ExpiryTimeUnix = expiry
+	}
+
+	return changed, nil
+}
+
+// changedProperties contains every property whose value differs between
+// before and after. Properties which were removed are set to nil, so they
+// are present as null in the response. Nil is returned if nothing changed.
+func changedProperties(before, after models.PropertySchema) models.PropertySchema {
+	beforeMap, _ := before.(map[string]interface{})
+	afterMap, _ := after.(map[string]interface{})
+
+	changed := map[string]interface{}{}
+	for prop, value := range afterMap {
+		if prev, ok := beforeMap[prop]; !ok || !sameValue(prev, value) {
+			changed[prop] = value
+		}
+	}
+
+	for prop := range beforeMap {
+		if _, ok := afterMap[prop]; !ok {
+			changed[prop] = nil
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return changed
+}
+
+// sameValue compares the json representations, as values read from the repo
+// and values of the incoming object can have different go types, e.g. a
+// reference as models.MultipleRef or []interface{}.
+func sameValue(a, b interface{}) bool {
+	aBytes, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	bBytes, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// changedInt64 returns after and whether it differs from before, so that a
+// value which was cleared to 0 can be told apart from an unchanged one. An
+// update keeps the previous expiry time if none is set, so a changed expiry
+// time is never 0 at the moment.
+func changedInt64(before, after int64) (int64, bool) {
+	return after, before != after
+}
+
+func changedVectorWeights(before, after models.VectorWeights) models.VectorWeights {
+	if sameValue(before, after) {
+		return nil
+	}
+
+	return after
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateThingChangedFields(t *testing.T) {
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	articleSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"string"}},
+						{Name: "body", DataType: []string{"text"}},
+						{Name: "subtitle", DataType: []string{"string"}},
+					},
+				},
+			},
+		},
+	}
+
+	vectorRepo := &fakeVectorRepo{}
+	vectorizer := &fakeVectorizer{}
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: articleSchema},
+		&fakeNetwork{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, vectorizer,
+		vectorRepo, &fakeExtender{}, &fakeProjector{})
+	manager.timeSource = fakeTimeSource{}
+
+	vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(&search.Result{
+		ClassName: "Article",
+		ID:        id,
+		Schema: map[string]interface{}{
+			"title":    "Original title",
+			"body":     "a very long body which did not change",
+			"subtitle": "to be removed",
+		},
+		Created: 10,
+		Updated: 10,
+		Expiry:  5000,
+	}, nil)
+	vectorRepo.On("PutThing", mock.Anything, mock.Anything).Return(nil)
+	vectorizer.On("Thing", mock.Anything).Return([]float32{1, 2, 3}, nil)
+
+	res, err := manager.UpdateThingChangedFields(context.Background(), nil, id, &models.Thing{
+		Class: "Article",
+		ID:    id,
+		Schema: map[string]interface{}{
+			"title": "New title",
+			"body":  "a very long body which did not change",
+		},
+	})
+	require.Nil(t, err)
+
+	expected := &models.Thing{
+		Class: "Article",
+		ID:    id,
+		Schema: map[string]interface{}{
+			"title":    "New title",
+			"subtitle": nil,
+		},
+		LastUpdateTimeUnix: fakeTimeSource{}.Now(),
+	}
+	assert.Equal(t, expected, res)
+	vectorRepo.AssertExpectations(t)
+}
+
+func Test_ChangedProperties(t *testing.T) {
+	t.Run("without changes", func(t *testing.T) {
+		before := map[string]interface{}{"count": int64(3), "name": "foo"}
+		after := map[string]interface{}{"count": int64(3), "name": "foo"}
+
+		assert.Nil(t, changedProperties(before, after))
+	})
+
+	t.Run("values of different go types are compared by their json", func(t *testing.T) {
+		beacon := strfmt.URI("weaviate://localhost/things/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		before := map[string]interface{}{
+			"ref": models.MultipleRef{{Beacon: beacon}},
+		}
+		after := map[string]interface{}{
+			"ref": []interface{}{map[string]interface{}{"beacon": string(beacon)}},
+		}
+
+		assert.Nil(t, changedProperties(before, after))
+	})
+
+	t.Run("added, changed and removed properties", func(t *testing.T) {
+		before := map[string]interface{}{"kept": "a", "changed": 1.5, "removed": true}
+		after := map[string]interface{}{"kept": "a", "changed": 2.5, "added": "b"}
+
+		expected := map[string]interface{}{"changed": 2.5, "removed": nil, "added": "b"}
+		assert.Equal(t, expected, changedProperties(before, after))
+	})
+
+	t.Run("an object without properties before the update", func(t *testing.T) {
+		after := map[string]interface{}{"added": "b"}
+
+		assert.Equal(t, after, changedProperties(nil, after))
+	})
+}
+
+func Test_ChangedInt64(t *testing.T) {
+	t.Run("unchanged", func(t *testing.T) {
+		_, changed := changedInt64(5000, 5000)
+		assert.False(t, changed)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		value, changed := changedInt64(5000, 6000)
+		assert.True(t, changed)
+		assert.Equal(t, int64(6000), value)
+	})
+
+	t.Run("cleared", func(t *testing.T) {
+		value, changed := changedInt64(5000, 0)
+		assert.True(t, changed)
+		assert.Equal(t, int64(0), value)
+	})
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyOfTypeBlobValidation(t *testing.T) {
+	blobSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Image",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:     "thumbnail",
+							DataType: []string{string(schema.DataTypeBlob)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	type test struct {
+		name        string
+		thumbnail   interface{} // "thumbnail" property in schema
+		expectedErr error
+	}
+
+	tests := []test{
+		test{
+			name:      "valid base64 within the limit",
+			thumbnail: "aGVsbG8=", // "hello"
+		},
+		test{
+			name:      "exactly at the limit",
+			thumbnail: "aGVsbG8gd28=", // "hello wo"
+		},
+		test{
+			name:      "not a string",
+			thumbnail: 7,
+			expectedErr: errors.New("invalid blob property 'thumbnail' on class 'Image': " +
+				"not a base64 encoded string, but int"),
+		},
+		test{
+			name:      "not base64",
+			thumbnail: "not base64!",
+			expectedErr: errors.New("invalid blob property 'thumbnail' on class 'Image': " +
+				"not a base64 encoded string: illegal base64 data at input byte 3"),
+		},
+		test{
+			name:      "too large",
+			thumbnail: "aGVsbG8gd29y", // "hello wor"
+			expectedErr: errors.New("invalid blob property 'thumbnail' on class 'Image': " +
+				"blob has 9 bytes, but at most 8 are allowed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			maxSize := 8
+			config := &config.WeaviateConfig{}
+			config.Config.Ingest.MaxBlobSizeBytes = &maxSize
+			validator := New(blobSchema, fakeExists, &fakePeerLister{}, config)
+
+			obj := &models.Thing{
+				Class: "Image",
+				Schema: map[string]interface{}{
+					"thumbnail": test.thumbnail,
+				},
+			}
+			err := validator.properties(context.Background(), kind.Thing, obj)
+			assert.Equal(t, test.expectedErr, err)
+			if err != nil {
+				return
+			}
+
+			// the blob is kept in its encoded form
+			assert.Equal(t, test.thumbnail, obj.Schema.(map[string]interface{})["thumbnail"])
+		})
+	}
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// isoDateLayouts are accepted in addition to RFC3339 when coercing a string
+// into a date
+var isoDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+func (v *Validator) lenientTypes() bool {
+	return v.config != nil && v.config.Config.Ingest.TypeCoercion == config.TypeCoercionLenient
+}
+
+// coerce converts a value which is compatible with, but not of the exact type
+// of the data type. If there is no sensible conversion, the value is returned
+// unchanged, so that the regular validation can fail with a descriptive
+// error.
+func coerce(val interface{}, dataType schema.DataType) interface{} {
+	switch dataType {
+	case schema.DataTypeInt:
+		if s, ok := val.(string); ok {
+			if parsed, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+				return parsed
+			}
+		}
+	case schema.DataTypeNumber:
+		switch typed := val.(type) {
+		case string:
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(typed), 64); err == nil {
+				return parsed
+			}
+		case int64:
+			return float64(typed)
+		}
+	case schema.DataTypeString, schema.DataTypeText:
+		switch typed := val.(type) {
+		case json.Number:
+			return typed.String()
+		case float64:
+			return strconv.FormatFloat(typed, 'f', -1, 64)
+		case int64:
+			return strconv.FormatInt(typed, 10)
+		}
+	case schema.DataTypeDate:
+		if s, ok := val.(string); ok {
+			for _, layout := range isoDateLayouts {
+				if parsed, err := time.Parse(layout, s); err == nil {
+					return parsed.Format(time.RFC3339Nano)
+				}
+			}
+		}
+	}
+
+	return val
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func coercionTestSchema() schema.Schema {
+	prop := func(name string, dataType schema.DataType) *models.Property {
+		return &models.Property{
+			Name:     name,
+			DataType: []string{string(dataType)},
+		}
+	}
+
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Product",
+					Properties: []*models.Property{
+						prop("stock", schema.DataTypeInt),
+						prop("price", schema.DataTypeNumber),
+						prop("sku", schema.DataTypeString),
+						prop("description", schema.DataTypeText),
+						prop("releaseDate", schema.DataTypeDate),
+						prop("available", schema.DataTypeBoolean),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPropertyTypeCoercion(t *testing.T) {
+	type test struct {
+		name        string
+		prop        string
+		value       interface{}
+		lenient     interface{} // expected value in lenient mode
+		lenientErr  error
+		expectedErr error // expected error in strict mode
+	}
+
+	tests := []test{
+		test{
+			name:    "int from a string",
+			prop:    "stock",
+			value:   "42",
+			lenient: int64(42),
+			expectedErr: errors.New("invalid integer property 'stock' on class 'Product': " +
+				"requires an integer, the given value is '42'"),
+		},
+		test{
+			name:  "int from a non-numerical string",
+			prop:  "stock",
+			value: "many",
+			lenientErr: errors.New("invalid integer property 'stock' on class 'Product': " +
+				"requires an integer, the given value is 'many'"),
+			expectedErr: errors.New("invalid integer property 'stock' on class 'Product': " +
+				"requires an integer, the given value is 'many'"),
+		},
+		test{
+			name:  "int from a string with decimals",
+			prop:  "stock",
+			value: "4.2",
+			lenientErr: errors.New("invalid integer property 'stock' on class 'Product': " +
+				"requires an integer, the given value is '4.2'"),
+			expectedErr: errors.New("invalid integer property 'stock' on class 'Product': " +
+				"requires an integer, the given value is '4.2'"),
+		},
+		test{
+			name:    "number from a string",
+			prop:    "price",
+			value:   "19.99",
+			lenient: float64(19.99),
+			expectedErr: errors.New("invalid number property 'price' on class 'Product': " +
+				"requires a float, the given value is '19.99'"),
+		},
+		test{
+			name:    "number from an int",
+			prop:    "price",
+			value:   int64(20),
+			lenient: float64(20),
+			expectedErr: errors.New("invalid number property 'price' on class 'Product': " +
+				"requires a float, the given value is '20'"),
+		},
+		test{
+			name:    "string from a json number",
+			prop:    "sku",
+			value:   json.Number("1234"),
+			lenient: "1234",
+			expectedErr: errors.New("invalid string property 'sku' on class 'Product': " +
+				"not a string, but json.Number"),
+		},
+		test{
+			name:    "text from a float",
+			prop:    "description",
+			value:   float64(1.5),
+			lenient: "1.5",
+			expectedErr: errors.New("invalid text property 'description' on class 'Product': " +
+				"not a string, but float64"),
+		},
+		test{
+			name:    "date from an ISO date without time",
+			prop:    "releaseDate",
+			value:   "2020-03-17",
+			lenient: time.Date(2020, 3, 17, 0, 0, 0, 0, time.UTC),
+			expectedErr: errors.New("invalid date property 'releaseDate' on class 'Product': " +
+				"requires a string with a RFC3339 formatted date, but the given value is '2020-03-17'"),
+		},
+		test{
+			name:    "date from an ISO datetime without time zone",
+			prop:    "releaseDate",
+			value:   "2020-03-17T10:30:00",
+			lenient: time.Date(2020, 3, 17, 10, 30, 0, 0, time.UTC),
+			expectedErr: errors.New("invalid date property 'releaseDate' on class 'Product': " +
+				"requires a string with a RFC3339 formatted date, but the given value is '2020-03-17T10:30:00'"),
+		},
+		test{
+			name:  "boolean is never coerced",
+			prop:  "available",
+			value: "true",
+			lenientErr: errors.New("invalid boolean property 'available' on class 'Product': " +
+				"not a bool, but string"),
+			expectedErr: errors.New("invalid boolean property 'available' on class 'Product': " +
+				"not a bool, but string"),
+		},
+	}
+
+	validate := func(mode string, test test) (interface{}, error) {
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.Ingest.TypeCoercion = mode
+		validator := New(coercionTestSchema(), fakeExists, &fakePeerLister{}, cfg)
+
+		obj := &models.Thing{
+			Class: "Product",
+			Schema: map[string]interface{}{
+				test.prop: test.value,
+			},
+		}
+		err := validator.properties(context.Background(), kind.Thing, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		return obj.Schema.(map[string]interface{})[test.prop], nil
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" (strict)", func(t *testing.T) {
+			_, err := validate(config.TypeCoercionStrict, test)
+			assert.Equal(t, test.expectedErr, err)
+		})
+
+		t.Run(test.name+" (lenient)", func(t *testing.T) {
+			res, err := validate(config.TypeCoercionLenient, test)
+			if test.lenientErr != nil {
+				assert.Equal(t, test.lenientErr, err)
+				return
+			}
+
+			require.Nil(t, err)
+			if expectedDate, ok := test.lenient.(time.Time); ok {
+				assert.True(t, expectedDate.Equal(res.(time.Time)))
+				return
+			}
+			assert.Equal(t, test.lenient, res)
+		})
+	}
+
+	t.Run("strict is the default", func(t *testing.T) {
+		_, err := validate("", tests[0])
+		assert.Equal(t, tests[0].expectedErr, err)
+	})
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// propertyConstraints checks an already parsed value against the optional
+// minimum, maximum, minLength, maxLength, pattern and maxReferences of the
+// property. The schema makes sure the constraints only appear on matching
+// data types.
+func propertyConstraints(property *models.Property, className string,
+	value interface{}) error {
+	var err error
+	switch typed := value.(type) {
+	case int64:
+		err = numberConstraints(property, float64(typed))
+	case float64:
+		err = numberConstraints(property, typed)
+	case string:
+		err = stringConstraints(property, typed)
+	case models.MultipleRef:
+		err = referenceConstraints(property, typed)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid property '%s' on class '%s': %v",
+			property.Name, className, err)
+	}
+
+	return nil
+}
+
+func numberConstraints(property *models.Property, value float64) error {
+	if property.Minimum != nil && value < *property.Minimum {
+		return fmt.Errorf("value %v is less than the minimum of %v",
+			value, *property.Minimum)
+	}
+
+	if property.Maximum != nil && value > *property.Maximum {
+		return fmt.Errorf("value %v is greater than the maximum of %v",
+			value, *property.Maximum)
+	}
+
+	return nil
+}
+
+func stringConstraints(property *models.Property, value string) error {
+	length := int64(utf8.RuneCountInString(value))
+	if property.MinLength != nil && length < *property.MinLength {
+		return fmt.Errorf("value has %d characters, but the minLength is %d",
+			length, *property.MinLength)
+	}
+
+	if property.MaxLength != nil && length > *property.MaxLength {
+		return fmt.Errorf("value has %d characters, but the maxLength is %d",
+			length, *property.MaxLength)
+	}
+
+	if property.Pattern != "" {
+		pattern, err := regexp.Compile(property.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern in schema: %v", err)
+		}
+
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("value '%s' does not match the pattern '%s'",
+				value, property.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func referenceConstraints(property *models.Property, refs models.MultipleRef) error {
+	if property.MaxReferences != nil && int64(len(refs)) > *property.MaxReferences {
+		return fmt.Errorf("value has %d references, but the maxReferences is %d",
+			len(refs), *property.MaxReferences)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyConstraintsValidation(t *testing.T) {
+	ptFloat64 := func(in float64) *float64 { return &in }
+	ptInt64 := func(in int64) *int64 { return &in }
+
+	constraintsSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Person",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:     "age",
+							DataType: []string{string(schema.DataTypeInt)},
+							Minimum:  ptFloat64(0),
+							Maximum:  ptFloat64(150),
+						},
+						&models.Property{
+							Name:     "height",
+							DataType: []string{string(schema.DataTypeNumber)},
+							Minimum:  ptFloat64(0.5),
+						},
+						&models.Property{
+							Name:      "nickname",
+							DataType:  []string{string(schema.DataTypeString)},
+							MinLength: ptInt64(2),
+							MaxLength: ptInt64(4),
+						},
+						&models.Property{
+							Name:     "zipCode",
+							DataType: []string{string(schema.DataTypeString)},
+							Pattern:  "^[0-9]{4}[A-Z]{2}$",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	type test struct {
+		name        string
+		props       map[string]interface{}
+		expectedErr error
+	}
+
+	tests := []test{
+		test{
+			name:  "int at the minimum",
+			props: map[string]interface{}{"age": json.Number("0")},
+		},
+		test{
+			name:  "int at the maximum",
+			props: map[string]interface{}{"age": json.Number("150")},
+		},
+		test{
+			name:  "int below the minimum",
+			props: map[string]interface{}{"age": json.Number("-1")},
+			expectedErr: errors.New("invalid property 'age' on class 'Person': " +
+				"value -1 is less than the minimum of 0"),
+		},
+		test{
+			name:  "int above the maximum",
+			props: map[string]interface{}{"age": json.Number("151")},
+			expectedErr: errors.New("invalid property 'age' on class 'Person': " +
+				"value 151 is greater than the maximum of 150"),
+		},
+		test{
+			name:  "number at the minimum",
+			props: map[string]interface{}{"height": json.Number("0.5")},
+		},
+		test{
+			name:  "number below the minimum",
+			props: map[string]interface{}{"height": json.Number("0.49")},
+			expectedErr: errors.New("invalid property 'height' on class 'Person': " +
+				"value 0.49 is less than the minimum of 0.5"),
+		},
+		test{
+			name:  "string at the minLength",
+			props: map[string]interface{}{"nickname": "Al"},
+		},
+		test{
+			name:  "string at the maxLength with multi-byte characters",
+			props: map[string]interface{}{"nickname": "Zoë!"},
+		},
+		test{
+			name:  "string shorter than the minLength",
+			props: map[string]interface{}{"nickname": "A"},
+			expectedErr: errors.New("invalid property 'nickname' on class 'Person': " +
+				"value has 1 characters, but the minLength is 2"),
+		},
+		test{
+			name:  "string longer than the maxLength",
+			props: map[string]interface{}{"nickname": "Alice"},
+			expectedErr: errors.New("invalid property 'nickname' on class 'Person': " +
+				"value has 5 characters, but the maxLength is 4"),
+		},
+		test{
+			name:  "string matching the pattern",
+			props: map[string]interface{}{"zipCode": "1234AB"},
+		},
+		test{
+			name:  "string not matching the pattern",
+			props: map[string]interface{}{"zipCode": "1234ab"},
+			expectedErr: errors.New("invalid property 'zipCode' on class 'Person': " +
+				"value '1234ab' does not match the pattern '^[0-9]{4}[A-Z]{2}$'"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := New(constraintsSchema, fakeExists, &fakePeerLister{},
+				&config.WeaviateConfig{})
+
+			obj := &models.Thing{
+				Class:  "Person",
+				Schema: test.props,
+			}
+			err := validator.properties(context.Background(), kind.Thing, obj)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
@@ -43,8 +43,8 @@ func testSchema() schema.Schema {
 	}
 }
 
-func fakeExists(context.Context, kind.Kind, strfmt.UUID) (bool, error) {
-	return true, nil
+func fakeExists(context.Context, kind.Kind, strfmt.UUID) (string, bool, error) {
+	return "", true, nil
 }
 
 type fakePeerLister struct{}
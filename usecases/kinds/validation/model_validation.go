@@ -30,7 +30,8 @@ type getRepo interface {
 	GetAction(context.Context, strfmt.UUID, *models.Action) error
 }
 
-type exists func(context.Context, kind.Kind, strfmt.UUID) (bool, error)
+// exists looks up an object, it returns the name of its class if it exists
+type exists func(context.Context, kind.Kind, strfmt.UUID) (className string, ok bool, err error)
 
 type peerLister interface {
 	ListPeers() (peers.Peers, error)
@@ -67,6 +68,8 @@ const (
 	ErrorInvalidCRefType string = "'cref' type '%s' does not exists"
 	// ErrorNotFoundInDatabase message
 	ErrorNotFoundInDatabase string = "%s: no %s with id %s found"
+	// ErrorTargetClassNotAllowed message
+	ErrorTargetClassNotAllowed string = "%s: %s with id %s is of class '%s', but can only point to %v"
 )
 
 type Validator struct {
@@ -117,9 +120,11 @@ func validateRefType(s string) bool {
 	return (s == "things" || s == "actions")
 }
 
-// ValidateSingleRef validates a single ref based on location URL and existence of the object in the database
+// ValidateSingleRef validates a single ref based on location URL and existence of the object in the database.
+// If targetClasses is set, which is the dataType of the reference property, local refs must point to an
+// object of one of these classes. Network refs can't be checked for their class.
 func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRef,
-	errorVal string) error {
+	targetClasses []string, errorVal string) error {
 
 	ref, err := crossref.ParseSingleRef(cref)
 	if err != nil {
@@ -130,14 +135,13 @@ func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRe
 		return v.validateNetworkRef(ref)
 	}
 
-	return v.validateLocalRef(ctx, ref, errorVal)
+	return v.validateLocalRef(ctx, ref, targetClasses, errorVal)
 }
 
-func (v *Validator) validateLocalRef(ctx context.Context, ref *crossref.Ref, errorVal string) error {
+func (v *Validator) validateLocalRef(ctx context.Context, ref *crossref.Ref,
+	targetClasses []string, errorVal string) error {
 	// Check whether the given Object exists in the DB
-	var err error
-
-	ok, err := v.exists(ctx, ref.Kind, ref.TargetID)
+	className, ok, err := v.exists(ctx, ref.Kind, ref.TargetID)
 	if err != nil {
 		return err
 	}
@@ -146,9 +150,24 @@ func (v *Validator) validateLocalRef(ctx context.Context, ref *crossref.Ref, err
 		return fmt.Errorf(ErrorNotFoundInDatabase, errorVal, ref.Kind.Name(), ref.TargetID)
 	}
 
+	if targetClasses != nil && !containsString(targetClasses, className) {
+		return fmt.Errorf(ErrorTargetClassNotAllowed, errorVal, ref.Kind.Name(), ref.TargetID,
+			className, targetClasses)
+	}
+
 	return nil
 }
 
+func containsString(haystack []string, needle string) bool {
+	for _, hay := range haystack {
+		if hay == needle {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (v *Validator) validateNetworkRef(ref *crossref.Ref) error {
 	// Network ref
 	peers, err := v.peerLister.ListPeers()
@@ -165,13 +184,13 @@ func (v *Validator) validateNetworkRef(ref *crossref.Ref) error {
 }
 
 func (v *Validator) ValidateMultipleRef(ctx context.Context, refs models.MultipleRef,
-	errorVal string) error {
+	targetClasses []string, errorVal string) error {
 	if refs == nil {
 		return nil
 	}
 
 	for _, ref := range refs {
-		err := v.ValidateSingleRef(ctx, ref, errorVal)
+		err := v.ValidateSingleRef(ctx, ref, targetClasses, errorVal)
 		if err != nil {
 			return err
 		}
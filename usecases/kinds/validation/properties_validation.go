@@ -13,6 +13,7 @@ package validation
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
 const (
@@ -88,6 +90,15 @@ func (v *Validator) properties(ctx context.Context, k kind.Kind, object interfac
 			return err
 		}
 
+		property, err := schema.GetPropertyByName(class, propertyKey)
+		if err != nil {
+			return err
+		}
+
+		if err := propertyConstraints(property, className, data); err != nil {
+			return err
+		}
+
 		returnSchema[propertyKey] = data
 	}
 
@@ -111,6 +122,10 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		err  error
 	)
 
+	if v.lenientTypes() {
+		pv = coerce(pv, *dataType)
+	}
+
 	switch *dataType {
 	case schema.DataTypeCRef:
 		data, err = v.cRef(ctx, propertyName, pv, className)
@@ -157,6 +172,11 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		if err != nil {
 			return nil, fmt.Errorf("invalid phoneNumber property '%s' on class '%s': %s", propertyName, className, err)
 		}
+	case schema.DataTypeBlob:
+		data, err = blobVal(pv, v.maxBlobSize())
+		if err != nil {
+			return nil, fmt.Errorf("invalid blob property '%s' on class '%s': %s", propertyName, className, err)
+		}
 
 	default:
 		return nil, fmt.Errorf("unrecognized data type '%s'", *dataType)
@@ -172,6 +192,7 @@ func (v *Validator) cRef(ctx context.Context, propertyName string, pv interface{
 		return nil, fmt.Errorf("reference must be an array, but got a map: %#v", refValue)
 	case []interface{}:
 		crefs := models.MultipleRef{}
+		targetClasses := v.targetClasses(className, propertyName)
 		for _, ref := range refValue {
 
 			refTyped, ok := ref.(map[string]interface{})
@@ -180,7 +201,8 @@ func (v *Validator) cRef(ctx context.Context, propertyName string, pv interface{
 					className, propertyName, ref)
 			}
 
-			cref, err := v.parseAndValidateSingleRef(ctx, propertyName, refTyped, className)
+			cref, err := v.parseAndValidateSingleRef(ctx, propertyName, refTyped, className,
+				targetClasses)
 			if err != nil {
 				return nil, err
 			}
@@ -194,6 +216,22 @@ func (v *Validator) cRef(ctx context.Context, propertyName string, pv interface{
 	}
 }
 
+// targetClasses are the classes a reference property may point to, which
+// make up its dataType
+func (v *Validator) targetClasses(className, propertyName string) []string {
+	class := v.schema.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil
+	}
+
+	prop, err := schema.GetPropertyByName(class, propertyName)
+	if err != nil {
+		return nil
+	}
+
+	return prop.DataType
+}
+
 func stringVal(val interface{}) (string, error) {
 	typed, ok := val.(string)
 	if !ok {
@@ -203,6 +241,34 @@ func stringVal(val interface{}) (string, error) {
 	return typed, nil
 }
 
+// blobVal makes sure the value is valid base64 and within the size limit.
+// The blob is stored in its encoded form.
+func blobVal(val interface{}, maxSize int) (string, error) {
+	typed, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("not a base64 encoded string, but %T", val)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(typed)
+	if err != nil {
+		return "", fmt.Errorf("not a base64 encoded string: %v", err)
+	}
+
+	if len(decoded) > maxSize {
+		return "", fmt.Errorf("blob has %d bytes, but at most %d are allowed", len(decoded), maxSize)
+	}
+
+	return typed, nil
+}
+
+func (v *Validator) maxBlobSize() int {
+	if v.config == nil || v.config.Config.Ingest.MaxBlobSizeBytes == nil {
+		return config.DefaultMaxBlobSizeBytes
+	}
+
+	return *v.config.Config.Ingest.MaxBlobSizeBytes
+}
+
 func boolVal(val interface{}) (bool, error) {
 	typed, ok := val.(bool)
 	if !ok {
@@ -367,7 +433,7 @@ func parseCoordinate(raw interface{}) (float64, error) {
 }
 
 func (v *Validator) parseAndValidateSingleRef(ctx context.Context, propertyName string,
-	pvcr map[string]interface{}, className string) (*models.SingleRef, error) {
+	pvcr map[string]interface{}, className string, targetClasses []string) (*models.SingleRef, error) {
 
 	if _, ok := pvcr["href"]; ok {
 		// delete read only field href
@@ -397,7 +463,7 @@ func (v *Validator) parseAndValidateSingleRef(ctx context.Context, propertyName
 		return nil, fmt.Errorf("invalid reference: %s", err)
 	}
 	errVal := fmt.Sprintf("'cref' %s %s:%s", ref.Kind.Name(), className, propertyName)
-	err = v.ValidateSingleRef(ctx, ref.SingleRef(), errVal)
+	err = v.ValidateSingleRef(ctx, ref.SingleRef(), targetClasses, errVal)
 	if err != nil {
 		return nil, err
 	}
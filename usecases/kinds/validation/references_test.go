@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyOfTypeReferenceWithMultipleTargetClasses(t *testing.T) {
+	refSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Article",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:     "relatedTo",
+							DataType: []string{"Person", "Company"},
+						},
+					},
+				},
+				&models.Class{
+					Class: "Person",
+				},
+				&models.Class{
+					Class: "Company",
+				},
+				&models.Class{
+					Class: "City",
+				},
+			},
+		},
+	}
+
+	const (
+		personID  = "8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c01"
+		companyID = "8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c02"
+		cityID    = "8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c03"
+		missingID = "8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c04"
+	)
+
+	exists := func(ctx context.Context, k kind.Kind, id strfmt.UUID) (string, bool, error) {
+		classes := map[strfmt.UUID]string{
+			personID:  "Person",
+			companyID: "Company",
+			cityID:    "City",
+		}
+
+		className, ok := classes[id]
+		return className, ok, nil
+	}
+
+	ref := func(id string) interface{} {
+		return map[string]interface{}{
+			"beacon": "weaviate://localhost/things/" + id,
+		}
+	}
+
+	type test struct {
+		name        string
+		relatedTo   []interface{}
+		expectedErr error
+	}
+
+	tests := []test{
+		test{
+			name:      "a single allowed target",
+			relatedTo: []interface{}{ref(personID)},
+		},
+		test{
+			name:      "targets of all allowed classes",
+			relatedTo: []interface{}{ref(personID), ref(companyID)},
+		},
+		test{
+			name:      "a target of a class which is not allowed",
+			relatedTo: []interface{}{ref(companyID), ref(cityID)},
+			expectedErr: errors.New("invalid cref: 'cref' thing Article:relatedTo: " +
+				"thing with id " + cityID + " is of class 'City', " +
+				"but can only point to [Person Company]"),
+		},
+		test{
+			name:      "a target which does not exist",
+			relatedTo: []interface{}{ref(missingID)},
+			expectedErr: errors.New("invalid cref: 'cref' thing Article:relatedTo: " +
+				"no thing with id " + missingID + " found"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := New(refSchema, exists, &fakePeerLister{}, nil)
+
+			obj := &models.Thing{
+				Class: "Article",
+				Schema: map[string]interface{}{
+					"relatedTo": test.relatedTo,
+				},
+			}
+			err := validator.properties(context.Background(), kind.Thing, obj)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func TestPropertyOfTypeReferenceWithMaxReferences(t *testing.T) {
+	maxReferences := int64(2)
+	refSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Article",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:          "authors",
+							DataType:      []string{"Person"},
+							MaxReferences: &maxReferences,
+						},
+					},
+				},
+				&models.Class{
+					Class: "Person",
+				},
+			},
+		},
+	}
+
+	exists := func(ctx context.Context, k kind.Kind, id strfmt.UUID) (string, bool, error) {
+		return "Person", true, nil
+	}
+
+	refs := func(count int) []interface{} {
+		out := make([]interface{}, count)
+		for i := range out {
+			out[i] = map[string]interface{}{
+				"beacon": fmt.Sprintf("weaviate://localhost/things/8a3b1c4e-3a8f-4d1e-9c2b-5e6f7a8b9c%02d", i),
+			}
+		}
+		return out
+	}
+
+	type test struct {
+		name        string
+		authors     []interface{}
+		expectedErr error
+	}
+
+	tests := []test{
+		test{
+			name:    "at the maximum",
+			authors: refs(2),
+		},
+		test{
+			name:    "above the maximum",
+			authors: refs(3),
+			expectedErr: errors.New("invalid property 'authors' on class 'Article': " +
+				"value has 3 references, but the maxReferences is 2"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := New(refSchema, exists, &fakePeerLister{}, nil)
+
+			obj := &models.Thing{
+				Class: "Article",
+				Schema: map[string]interface{}{
+					"authors": test.authors,
+				},
+			}
+			err := validator.properties(context.Background(), kind.Thing, obj)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
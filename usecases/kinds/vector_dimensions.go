@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// validateVectorDimensions rejects vectors that exceed the configured maximum
+// dimensions, before they reach the vector index. Whether the dimensions
+// match the other vectors of the class can only be checked by the vector
+// repo.
+func validateVectorDimensions(cfg *config.WeaviateConfig, vector []float32) error {
+	if cfg == nil || cfg.Config.VectorIndex.MaxDimensions == nil {
+		return nil
+	}
+
+	max := *cfg.Config.VectorIndex.MaxDimensions
+	if len(vector) > max {
+		return NewErrInvalidUserInput("vector has %d dimensions, the maximum is %d",
+			len(vector), max)
+	}
+
+	return nil
+}
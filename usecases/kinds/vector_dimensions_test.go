@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VectorDimensions(t *testing.T) {
+	var (
+		vectorRepo   *fakeVectorRepo
+		vectorizer   *fakeVectorizer
+		manager      *Manager
+		batchManager *BatchManager
+	)
+
+	schema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{"string"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	isLong := func(thing *models.Thing) bool {
+		props, ok := thing.Schema.(map[string]interface{})
+		return ok && props["name"] == "long"
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		locks := &fakeLocks{}
+		maxDims := 3
+		cfg := &config.WeaviateConfig{
+			Config: config.Config{
+				VectorIndex: config.VectorIndex{
+					MaxDimensions: &maxDims,
+				},
+			},
+		}
+		authorizer := &fakeAuthorizer{}
+		logger, _ := test.NewNullLogger()
+		vectorizer = &fakeVectorizer{}
+		vectorizer.On("Thing", mock.MatchedBy(isLong)).Return([]float32{0, 1, 2, 3}, nil)
+		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+		manager = NewManager(locks, schemaManager, &fakeNetwork{}, cfg, logger,
+			authorizer, vectorizer, vectorRepo, &fakeExtender{}, &fakeProjector{})
+		batchManager = NewBatchManager(vectorRepo, vectorizer, locks,
+			schemaManager, nil, cfg, logger, authorizer)
+	}
+
+	ctx := context.Background()
+
+	t.Run("adding a thing with a vector within the maximum", func(t *testing.T) {
+		reset()
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"name": "short"},
+		})
+
+		assert.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("adding a thing with a vector exceeding the maximum", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"name": "long"},
+		})
+
+		assert.Equal(t, NewErrInvalidUserInput("vector has 4 dimensions, the maximum is 3"), err)
+		vectorRepo.AssertNotCalled(t, "CreateThing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("adding a thing with a vector not matching the class", func(t *testing.T) {
+		reset()
+		repoErr := NewErrInvalidUserInput("vector has 3 dimensions, but the " +
+			"vectors of class Foo have 2 dimensions")
+		vectorRepo.On("CreateThing", mock.Anything, mock.Anything).Return(repoErr).Once()
+
+		_, err := manager.AddThing(ctx, nil, &models.Thing{
+			Class:  "Foo",
+			Schema: map[string]interface{}{"name": "short"},
+		})
+
+		assert.Equal(t, repoErr, err, "the error is user-facing and not wrapped as internal")
+	})
+
+	t.Run("batch importing things with one vector exceeding the maximum", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+		things := []*models.Thing{
+			{
+				Class:  "Foo",
+				Schema: map[string]interface{}{"name": "short"},
+			},
+			{
+				Class:  "Foo",
+				Schema: map[string]interface{}{"name": "long"},
+			},
+		}
+
+		_, err := batchManager.AddThings(ctx, nil, things, []*string{})
+		require.Nil(t, err)
+		calledWith := vectorRepo.Calls[0].Arguments[0].(BatchThings)
+
+		require.Len(t, calledWith, 2)
+		assert.Nil(t, calledWith[0].Err)
+		assert.Equal(t, 1, calledWith[1].OriginalIndex)
+		assert.Equal(t, "vector has 4 dimensions, the maximum is 3", calledWith[1].Err.Error())
+	})
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+// corpusBuilder is implemented by vectorizers which can tell what they would
+// vectorize without vectorizing it
+type corpusBuilder interface {
+	ThingCorpus(ctx context.Context, object *models.Thing) ([]vectorizer.WeightedCorpus, error)
+	ActionCorpus(ctx context.Context, object *models.Action) ([]vectorizer.WeightedCorpus, error)
+}
+
+// previousThingVector returns the vector of the previous version of the
+// thing if the update leaves everything that is vectorized as it is, for
+// example because only a number property changed. Reusing it saves the call
+// to the contextionary and, as the vector does not change, the update of the
+// vector index. In all other cases, including when this cannot be told for
+// sure, nil is returned and the thing must be vectorized again.
+func (m *Manager) previousThingVector(ctx context.Context, previous *search.Result,
+	updated *models.Thing) []float32 {
+	builder, ok := m.vectorizer.(corpusBuilder)
+	if !ok || !vectorReusable(previous, updated.VectorWeights) {
+		return nil
+	}
+
+	before, err := builder.ThingCorpus(ctx,
+		&models.Thing{Class: previous.ClassName, Schema: previous.Schema})
+	if err != nil {
+		m.logCorpusComparisonError(ctx, err)
+		return nil
+	}
+
+	after, err := builder.ThingCorpus(ctx, updated)
+	if err != nil {
+		m.logCorpusComparisonError(ctx, err)
+		return nil
+	}
+
+	if !corpusUnchanged(before, after) {
+		return nil
+	}
+
+	return previous.Vector
+}
+
+// previousActionVector is the equivalent of previousThingVector for actions
+func (m *Manager) previousActionVector(ctx context.Context, previous *search.Result,
+	updated *models.Action) []float32 {
+	builder, ok := m.vectorizer.(corpusBuilder)
+	if !ok || !vectorReusable(previous, updated.VectorWeights) {
+		return nil
+	}
+
+	before, err := builder.ActionCorpus(ctx,
+		&models.Action{Class: previous.ClassName, Schema: previous.Schema})
+	if err != nil {
+		m.logCorpusComparisonError(ctx, err)
+		return nil
+	}
+
+	after, err := builder.ActionCorpus(ctx, updated)
+	if err != nil {
+		m.logCorpusComparisonError(ctx, err)
+		return nil
+	}
+
+	if !corpusUnchanged(before, after) {
+		return nil
+	}
+
+	return previous.Vector
+}
+
+// vectorReusable rules out the cases in which the corpus alone does not tell
+// whether the vector would change. The vector weights of the previous version
+// are not stored, so any weights on the update could differ from them.
+func vectorReusable(previous *search.Result, weights models.VectorWeights) bool {
+	if previous == nil || len(previous.Vector) == 0 {
+		return false
+	}
+
+	if asMap, ok := weights.(map[string]string); ok {
+		return len(asMap) == 0
+	}
+
+	return weights == nil
+}
+
+func corpusUnchanged(before, after []vectorizer.WeightedCorpus) bool {
+	return reflect.DeepEqual(before, after)
+}
+
+// previousInterpretation keeps the interpretation of the reused vector, as
+// it still describes how the vector came about
+func previousInterpretation(previous *search.Result) *models.Interpretation {
+	if previous.UnderscoreProperties == nil {
+		return nil
+	}
+
+	return previous.UnderscoreProperties.Interpretation
+}
+
+func (m *Manager) logCorpusComparisonError(ctx context.Context, err error) {
+	requestid.Logger(ctx, m.logger).
+		WithField("action", "kinds_compare_corpus").
+		WithError(err).
+		Debug("could not compare corpus to previous version, vectorizing again")
+}
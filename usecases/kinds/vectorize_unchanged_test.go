@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateThing_SkipsVectorizationOfUnchangedCorpus(t *testing.T) {
+	id := strfmt.UUID("3f2a6c1e-8b4d-4e7a-9c0f-1d2e3f4a5b61")
+	productSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Product",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+						{Name: "price", DataType: []string{"number"}},
+					},
+				},
+			},
+		},
+	}
+
+	previousVector := []float32{0.1, 0.2, 0.3}
+	previousInterpretation := &models.Interpretation{
+		Source: []*models.InterpretationSource{{Concept: "shoe"}},
+	}
+
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorizer = &fakeVectorizer{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: productSchema},
+			&fakeNetwork{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, vectorizer,
+			vectorRepo, &fakeExtender{}, &fakeProjector{})
+		manager.timeSource = fakeTimeSource{}
+
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "Product",
+			ID:        id,
+			Schema: map[string]interface{}{
+				"name":  "shoe",
+				"price": 10.0,
+			},
+			Vector: previousVector,
+			UnderscoreProperties: &models.UnderscoreProperties{
+				Interpretation: previousInterpretation,
+			},
+		}, nil)
+	}
+
+	t.Run("updating only a number property", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutThing", mock.Anything, previousVector).Return(nil).Once()
+
+		res, err := manager.UpdateThing(context.Background(), nil, id, &models.Thing{
+			Class:  "Product",
+			ID:     id,
+			Schema: map[string]interface{}{"name": "shoe", "price": 12.0},
+		})
+		require.Nil(t, err)
+
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertExpectations(t)
+		assert.Equal(t, previousInterpretation, res.Meta.Interpretation)
+	})
+
+	t.Run("updating a text property", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{4, 5, 6}, nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{4, 5, 6}).Return(nil).Once()
+
+		_, err := manager.UpdateThing(context.Background(), nil, id, &models.Thing{
+			Class:  "Product",
+			ID:     id,
+			Schema: map[string]interface{}{"name": "boot", "price": 10.0},
+		})
+		require.Nil(t, err)
+
+		vectorizer.AssertExpectations(t)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("updating with vector weights", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{4, 5, 6}, nil).Once()
+		vectorRepo.On("PutThing", mock.Anything, []float32{4, 5, 6}).Return(nil).Once()
+
+		_, err := manager.UpdateThing(context.Background(), nil, id, &models.Thing{
+			Class:         "Product",
+			ID:            id,
+			Schema:        map[string]interface{}{"name": "shoe", "price": 10.0},
+			VectorWeights: map[string]interface{}{"shoe": "0.5"},
+		})
+		require.Nil(t, err)
+
+		vectorizer.AssertExpectations(t)
+	})
+
+	t.Run("merging only a number property", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return assert.ObjectsAreEqual(previousVector, doc.Vector) &&
+				doc.UnderscoreProperties.Interpretation == previousInterpretation
+		})).Return(nil).Once()
+
+		err := manager.MergeThing(context.Background(), nil, id, &models.Thing{
+			Class:  "Product",
+			Schema: map[string]interface{}{"price": 12.0},
+		}, false)
+		require.Nil(t, err)
+
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("merging a text property", func(t *testing.T) {
+		reset()
+		vectorizer.On("Thing", mock.Anything).Return([]float32{4, 5, 6}, nil).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return assert.ObjectsAreEqual([]float32{4, 5, 6}, doc.Vector)
+		})).Return(nil).Once()
+
+		err := manager.MergeThing(context.Background(), nil, id, &models.Thing{
+			Class:  "Product",
+			Schema: map[string]interface{}{"name": "boot"},
+		}, false)
+		require.Nil(t, err)
+
+		vectorizer.AssertExpectations(t)
+		vectorRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package querycache holds the results of identical read queries for a
+// limited time. Entries are tagged with the classes they were built from and
+// are invalidated as soon as any object of one of these classes is written.
+// Values are copied when they are stored and when they are retrieved, so
+// callers are free to modify them.
+package querycache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Config controls the size and lifetime of cache entries
+type Config struct {
+	// TTL is the maximum age of an entry, even if none of its classes was
+	// written to in the meantime
+	TTL time.Duration
+
+	// MaxEntries limits the number of entries, once full the least recently
+	// used entry is evicted
+	MaxEntries int
+}
+
+type entry struct {
+	key     string
+	classes []string
+	value   interface{}
+	expires time.Time
+}
+
+// Cache is a size-limited LRU cache with a TTL per entry. It is safe for
+// concurrent use.
+type Cache struct {
+	lock   sync.Mutex
+	config Config
+
+	// lru has the most recently used entry in the front
+	lru     *list.List
+	entries map[string]*list.Element
+	byClass map[string]map[string]struct{}
+
+	// generation is increased with every invalidation, so that a result which
+	// was built while a write happened is not stored afterwards
+	generation uint64
+
+	now func() time.Time
+}
+
+// New creates an empty cache
+func New(config Config) *Cache {
+	return &Cache{
+		config:  config,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+		byClass: map[string]map[string]struct{}{},
+		now:     time.Now,
+	}
+}
+
+// Generation must be obtained before the query is executed and passed to Set
+// once the result is there
+func (c *Cache) Generation() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.generation
+}
+
+// Get the value for the key if it exists and has not expired yet
+func (c *Cache) Get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := elem.Value.(*entry)
+	if !c.now().Before(e.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return deepCopy(e.value), true
+}
+
+// Set the value for the key. The classes are all classes the value was built
+// from. If any invalidation happened since the generation was obtained, the
+// value might already be outdated and is not stored.
+func (c *Cache) Set(key string, classes []string, value interface{},
+	generation uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if generation != c.generation || c.config.MaxEntries <= 0 {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	elem := c.lru.PushFront(&entry{
+		key:     key,
+		classes: classes,
+		value:   deepCopy(value),
+		expires: c.now().Add(c.config.TTL),
+	})
+	c.entries[key] = elem
+	for _, class := range classes {
+		if _, ok := c.byClass[class]; !ok {
+			c.byClass[class] = map[string]struct{}{}
+		}
+		c.byClass[class][key] = struct{}{}
+	}
+
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// InvalidateClass removes all entries which were built from the class
+func (c *Cache) InvalidateClass(className string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generation++
+	for key := range c.byClass[className] {
+		c.remove(c.entries[key])
+	}
+}
+
+// Purge removes all entries, for example because the schema changed
+func (c *Cache) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generation++
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	c.byClass = map[string]map[string]struct{}{}
+}
+
+// Len is the current number of entries, including expired entries which
+// were not accessed since they expired
+func (c *Cache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.entries, e.key)
+	for _, class := range e.classes {
+		delete(c.byClass[class], e.key)
+		if len(c.byClass[class]) == 0 {
+			delete(c.byClass, class)
+		}
+	}
+}
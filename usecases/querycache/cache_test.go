@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package querycache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCache(maxEntries int) (*Cache, *time.Time) {
+	now := time.Unix(1000, 0)
+	c := New(Config{TTL: time.Minute, MaxEntries: maxEntries})
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestCache(t *testing.T) {
+	t.Run("a set entry can be retrieved", func(t *testing.T) {
+		c, _ := newTestCache(10)
+		c.Set("foo", []string{"Car"}, "bar", c.Generation())
+
+		value, ok := c.Get("foo")
+		assert.True(t, ok)
+		assert.Equal(t, "bar", value)
+	})
+
+	t.Run("an entry expires after the ttl", func(t *testing.T) {
+		c, now := newTestCache(10)
+		c.Set("foo", []string{"Car"}, "bar", c.Generation())
+
+		*now = now.Add(time.Minute)
+		_, ok := c.Get("foo")
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Len())
+	})
+
+	t.Run("the least recently used entry is evicted", func(t *testing.T) {
+		c, _ := newTestCache(2)
+		c.Set("first", nil, 1, c.Generation())
+		c.Set("second", nil, 2, c.Generation())
+		c.Get("first")
+		c.Set("third", nil, 3, c.Generation())
+
+		_, ok := c.Get("second")
+		assert.False(t, ok)
+		_, ok = c.Get("first")
+		assert.True(t, ok)
+		_, ok = c.Get("third")
+		assert.True(t, ok)
+	})
+
+	t.Run("a write invalidates all entries of the class", func(t *testing.T) {
+		c, _ := newTestCache(10)
+		c.Set("cars", []string{"Car"}, 1, c.Generation())
+		c.Set("cars with brands", []string{"Car", "Brand"}, 2, c.Generation())
+		c.Set("brands", []string{"Brand"}, 3, c.Generation())
+
+		c.InvalidateClass("Car")
+
+		_, ok := c.Get("cars")
+		assert.False(t, ok)
+		_, ok = c.Get("cars with brands")
+		assert.False(t, ok)
+		_, ok = c.Get("brands")
+		assert.True(t, ok)
+	})
+
+	t.Run("a result built during a write is not stored", func(t *testing.T) {
+		c, _ := newTestCache(10)
+		generation := c.Generation()
+		c.InvalidateClass("Car")
+		c.Set("cars", []string{"Car"}, 1, generation)
+
+		_, ok := c.Get("cars")
+		assert.False(t, ok)
+	})
+
+	t.Run("purging removes everything", func(t *testing.T) {
+		c, _ := newTestCache(10)
+		c.Set("cars", []string{"Car"}, 1, c.Generation())
+		c.Purge()
+
+		assert.Equal(t, 0, c.Len())
+	})
+	t.Run("callers can't modify the cached value", func(t *testing.T) {
+		c, _ := newTestCache(10)
+		value := []interface{}{
+			map[string]interface{}{"name": "Volvo", "tags": []string{"old"}},
+		}
+		c.Set("cars", []string{"Car"}, value, c.Generation())
+		value[0].(map[string]interface{})["name"] = "changed after set"
+
+		first, ok := c.Get("cars")
+		assert.True(t, ok)
+		car := first.([]interface{})[0].(map[string]interface{})
+		car["name"] = "changed after get"
+		car["tags"].([]string)[0] = "changed after get"
+
+		second, ok := c.Get("cars")
+		assert.True(t, ok)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Volvo", "tags": []string{"old"}},
+		}, second)
+	})
+}
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package querycache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepCopy(t *testing.T) {
+	type ref struct {
+		Class  string
+		Fields map[string]interface{}
+	}
+
+	original := map[string]interface{}{
+		"certainty": 0.7,
+		"owner":     &ref{Class: "Person", Fields: map[string]interface{}{"name": "Jane"}},
+		"refs":      []interface{}{ref{Class: "Brand", Fields: map[string]interface{}{"name": "Volvo"}}},
+		"vector":    []float32{1, 2, 3},
+		"nothing":   nil,
+	}
+
+	copied := deepCopy(original).(map[string]interface{})
+	assert.Equal(t, original, copied)
+
+	copied["owner"].(*ref).Fields["name"] = "John"
+	copied["refs"].([]interface{})[0].(ref).Fields["name"] = "Saab"
+	copied["vector"].([]float32)[0] = 7
+
+	assert.Equal(t, "Jane", original["owner"].(*ref).Fields["name"])
+	assert.Equal(t, "Volvo", original["refs"].([]interface{})[0].(ref).Fields["name"])
+	assert.Equal(t, float32(1), original["vector"].([]float32)[0])
+	assert.Nil(t, deepCopy(nil))
+}
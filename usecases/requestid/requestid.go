@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package requestid identifies all work done on behalf of a single HTTP
+// request. The id is stored in the request context, so that it is inherited
+// by everything the request spawns, and attached to the log entries written
+// for the request.
+package requestid
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Header is used to accept a request id from the client and to return the
+// id of a request in the response
+const Header = "X-Request-ID"
+
+// LogField is the name of the log field that holds the request id
+const LogField = "request_id"
+
+// validID restricts ids sent by clients, so that they can be logged and
+// returned in a header safely
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type contextKey struct{}
+
+// New generates a random request id
+func New() string {
+	return uuid.New().String()
+}
+
+// Valid reports whether an id sent by a client can be used as is
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
+
+// ContextWithID returns a copy of ctx which carries the request id
+func ContextWithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request id of ctx or an empty string if ctx does
+// not belong to a request
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Detach returns a new background context which carries the request id of
+// ctx. It is meant for work which outlives the request, but should still be
+// attributed to it.
+func Detach(ctx context.Context) context.Context {
+	id := FromContext(ctx)
+	if id == "" {
+		return context.Background()
+	}
+
+	return ContextWithID(context.Background(), id)
+}
+
+// Logger adds the request id of ctx to logger, if there is one
+func Logger(ctx context.Context, logger logrus.FieldLogger) logrus.FieldLogger {
+	id := FromContext(ctx)
+	if id == "" {
+		return logger
+	}
+
+	return logger.WithField(LogField, id)
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package requestid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid(New()))
+	assert.True(t, Valid("import-2020.06.01:42"))
+	assert.False(t, Valid(""))
+	assert.False(t, Valid("foo bar"))
+	assert.False(t, Valid("foo\nbar"))
+	assert.False(t, Valid(string(make([]byte, 129))))
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, "", FromContext(context.Background()))
+
+	ctx, cancel := context.WithCancel(ContextWithID(context.Background(), "foo"))
+	assert.Equal(t, "foo", FromContext(ctx))
+
+	cancel()
+	detached := Detach(ctx)
+	assert.Equal(t, "foo", FromContext(detached))
+	assert.Nil(t, detached.Err())
+}
+
+func TestLogger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	Logger(context.Background(), logger).Info("without id")
+	require.NotNil(t, hook.LastEntry())
+	assert.NotContains(t, hook.LastEntry().Data, LogField)
+
+	Logger(ContextWithID(context.Background(), "foo"), logger).Info("with id")
+	assert.Equal(t, "foo", hook.LastEntry().Data[LogField])
+}
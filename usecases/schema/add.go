@@ -55,6 +55,7 @@ func (m *Manager) addClass(ctx context.Context, principal *models.Principal,
 
 	class.Class = upperCaseClassName(class.Class)
 	class.Properties = lowerCaseAllPropertyNames(class.Properties)
+	class.KeyProperty = lowerCaseFirstLetter(class.KeyProperty)
 
 	err = m.validateCanAddClass(ctx, principal, k, class)
 	if err != nil {
@@ -84,6 +85,10 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		return err
 	}
 
+	if err := validateVectorIndexConfig(class.VectorIndexConfig); err != nil {
+		return err
+	}
+
 	// Check properties
 	foundNames := map[string]bool{}
 	for _, property := range class.Properties {
@@ -93,6 +98,26 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 			return err
 		}
 
+		if err := validatePropertyVectorWeight(property); err != nil {
+			return err
+		}
+
+		if err := validatePropertyTokenization(property); err != nil {
+			return err
+		}
+
+		if err := validatePropertyConstraints(property); err != nil {
+			return err
+		}
+
+		if err := validatePropertyUnique(property); err != nil {
+			return err
+		}
+
+		if err := validatePropertyIndexing(property); err != nil {
+			return err
+		}
+
 		if foundNames[property.Name] == true {
 			return fmt.Errorf("name '%s' already in use as a property name for class '%s'", property.Name, class.Class)
 		}
@@ -113,6 +138,10 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		m.handleDeprecatedFielsInProperty(property)
 	}
 
+	if err := validateKeyProperty(class); err != nil {
+		return err
+	}
+
 	// The user has the option to no-index select properties, but if they
 	// no-index every prop, there is a chance we don't have enough info to build
 	// vectors. See validation function for details.
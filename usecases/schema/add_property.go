@@ -58,6 +58,10 @@ func (m *Manager) addClassProperty(ctx context.Context, principal *models.Princi
 		return err
 	}
 
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
 	prop.Name = lowerCaseFirstLetter(prop.Name)
 
 	err = m.validateCanAddProperty(ctx, principal, prop, class)
@@ -78,6 +82,178 @@ func (m *Manager) addClassProperty(ctx context.Context, principal *models.Princi
 	return m.migrator.AddProperty(ctx, k, className, prop)
 }
 
+// UpdateClassAddProperty adds a property which was inferred from an imported
+// object (auto schema). If the class already has a property of that name, it
+// is kept as long as its data type matches, so that concurrent imports can
+// infer the same property. A different data type is a conflict. Warning: It
+// does not lock on its own, assumes that it is called when a schema lock is
+// already held!
+func (m *Manager) UpdateClassAddProperty(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, prop *models.Property) error {
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("schema/%ss", k.Name()))
+	if err != nil {
+		return err
+	}
+
+	semanticSchema := m.state.SchemaFor(k)
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
+	prop.Name = lowerCaseFirstLetter(prop.Name)
+	if existing, err := schema.GetPropertyByName(class, prop.Name); err == nil {
+		if !sameDataType(existing.DataType, prop.DataType) {
+			return fmt.Errorf("property '%s' of class '%s' has data type %v, "+
+				"but the value requires data type %v", prop.Name, className,
+				existing.DataType, prop.DataType)
+		}
+
+		return nil
+	}
+
+	err = m.validateCanAddProperty(ctx, principal, prop, class)
+	if err != nil {
+		return err
+	}
+
+	class.Properties = append(class.Properties, prop)
+	err = m.saveSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.migrator.AddProperty(ctx, k, className, prop)
+}
+
+func sameDataType(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, dt := range b {
+		if !dataTypeAlreadyContained(a, dt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddActionProperties adds all properties to an existing Action at once. If
+// any of the properties is invalid, none of them are added.
+func (m *Manager) AddActionProperties(ctx context.Context, principal *models.Principal,
+	class string, properties []*models.Property) (*models.Class, error) {
+
+	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.addClassProperties(ctx, principal, class, properties, kind.Action)
+}
+
+// AddThingProperties adds all properties to an existing Thing at once. If
+// any of the properties is invalid, none of them are added.
+func (m *Manager) AddThingProperties(ctx context.Context, principal *models.Principal,
+	class string, properties []*models.Property) (*models.Class, error) {
+
+	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.addClassProperties(ctx, principal, class, properties, kind.Thing)
+}
+
+func (m *Manager) addClassProperties(ctx context.Context, principal *models.Principal,
+	className string, props []*models.Property, k kind.Kind) (*models.Class, error) {
+	if len(props) == 0 {
+		return nil, fmt.Errorf("at least one property is required")
+	}
+
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	semanticSchema := m.state.SchemaFor(k)
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkNotFrozen(class); err != nil {
+		return nil, err
+	}
+
+	// the whole set is validated before the class is touched, so that an
+	// invalid property at the end of the list can't leave the others behind
+	names := map[string]struct{}{}
+	for _, prop := range props {
+		if prop == nil {
+			return nil, fmt.Errorf("property must not be null")
+		}
+
+		prop.Name = lowerCaseFirstLetter(prop.Name)
+		if _, ok := names[prop.Name]; ok {
+			return nil, fmt.Errorf("Name '%s' is used for more than one of the properties to add", prop.Name)
+		}
+		names[prop.Name] = struct{}{}
+
+		if err := m.validateCanAddProperty(ctx, principal, prop, class); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, prop := range props {
+		m.handleDeprecatedFielsInProperty(prop)
+	}
+
+	// the properties are migrated before they become part of the schema, so
+	// that a failure can be undone without anyone having seen the properties
+	var migrated []*models.Property
+	for _, prop := range props {
+		if err := m.migrator.AddProperty(ctx, k, className, prop); err != nil {
+			err = fmt.Errorf("migrate property '%s': %v", prop.Name, err)
+			return nil, m.dropMigratedProperties(ctx, k, className, migrated, err)
+		}
+
+		migrated = append(migrated, prop)
+	}
+
+	previous := class.Properties
+	updated := make([]*models.Property, 0, len(previous)+len(props))
+	updated = append(updated, previous...)
+	class.Properties = append(updated, props...)
+
+	if err := m.saveSchema(ctx); err != nil {
+		class.Properties = previous
+		return nil, m.dropMigratedProperties(ctx, k, className, migrated, err)
+	}
+
+	return class, nil
+}
+
+// dropMigratedProperties undoes the migration of properties which could not
+// be added after all. It returns the original error, extended by any error
+// that occurred while dropping the properties.
+func (m *Manager) dropMigratedProperties(ctx context.Context, k kind.Kind,
+	className string, props []*models.Property, err error) error {
+	for _, prop := range props {
+		if dropErr := m.migrator.DropProperty(ctx, k, className, prop.Name); dropErr != nil {
+			err = fmt.Errorf("%v, roll back property '%s': %v", err, prop.Name, dropErr)
+		}
+	}
+
+	return err
+}
+
 func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.Principal,
 	property *models.Property, class *models.Class) error {
 	// Verify format of property.
@@ -98,6 +274,26 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 		return err
 	}
 
+	if err := validatePropertyVectorWeight(property); err != nil {
+		return err
+	}
+
+	if err := validatePropertyTokenization(property); err != nil {
+		return err
+	}
+
+	if err := validatePropertyConstraints(property); err != nil {
+		return err
+	}
+
+	if err := validatePropertyUnique(property); err != nil {
+		return err
+	}
+
+	if err := validatePropertyIndexing(property); err != nil {
+		return err
+	}
+
 	// Validate data type of property.
 	schema, err := m.GetSchema(principal)
 	if err != nil {
@@ -70,6 +70,31 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedResource: "schema/actions",
 		},
 
+		testCase{
+			methodName:       "FreezeThing",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "update",
+			expectedResource: "schema/things",
+		},
+		testCase{
+			methodName:       "UnfreezeThing",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "update",
+			expectedResource: "schema/things",
+		},
+		testCase{
+			methodName:       "FreezeAction",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "update",
+			expectedResource: "schema/actions",
+		},
+		testCase{
+			methodName:       "UnfreezeAction",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "update",
+			expectedResource: "schema/actions",
+		},
+
 		testCase{
 			methodName:       "DeleteThing",
 			additionalArgs:   []interface{}{"somename"},
@@ -95,6 +120,18 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/actions",
 		},
+		testCase{
+			methodName:       "AddThingProperties",
+			additionalArgs:   []interface{}{"somename", []*models.Property{{}}},
+			expectedVerb:     "update",
+			expectedResource: "schema/things",
+		},
+		testCase{
+			methodName:       "AddActionProperties",
+			additionalArgs:   []interface{}{"somename", []*models.Property{{}}},
+			expectedVerb:     "update",
+			expectedResource: "schema/actions",
+		},
 
 		testCase{
 			methodName:       "UpdateThingProperty",
@@ -114,6 +151,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/things",
 		},
+		testCase{
+			methodName:       "UpdateClassAddProperty",
+			additionalArgs:   []interface{}{kind.Action, "somename", &models.Property{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/actions",
+		},
 
 		testCase{
 			methodName:       "DeleteThingProperty",
@@ -127,6 +170,20 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/actions",
 		},
+
+		testCase{
+			methodName:       "ImportSchema",
+			additionalArgs:   []interface{}{&models.Schema{}, &models.Schema{}},
+			expectedVerb:     "create",
+			expectedResource: "schema/*",
+		},
+
+		testCase{
+			methodName:       "UpdateClassName",
+			additionalArgs:   []interface{}{"somename", "othername"},
+			expectedVerb:     "update",
+			expectedResource: "schema/*",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -139,7 +196,8 @@ func Test_Schema_Authorization(t *testing.T) {
 		for _, method := range allExportedMethods(&Manager{}) {
 			switch method {
 			case "TriggerSchemaUpdateCallbacks", "RegisterSchemaUpdateCallback", "UpdateMeta", "GetSchemaSkipAuth",
-				"Indexed", "VectorizeClassName", "VectorizePropertyName":
+				"Indexed", "VectorizeClassName", "VectorizePropertyName", "VectorWeight", "Tokenization",
+				"TextNormalization", "SetClassificationLister":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -59,6 +59,10 @@ func (m *Manager) deleteClass(ctx context.Context, className string, k kind.Kind
 		return fmt.Errorf("could not find class '%s'", className)
 	}
 
+	if err := checkNotFrozen(semanticSchema.Classes[classIdx]); err != nil {
+		return err
+	}
+
 	semanticSchema.Classes[classIdx] = semanticSchema.Classes[len(semanticSchema.Classes)-1]
 	semanticSchema.Classes[len(semanticSchema.Classes)-1] = nil // to prevent leaking this pointer.
 	semanticSchema.Classes = semanticSchema.Classes[:len(semanticSchema.Classes)-1]
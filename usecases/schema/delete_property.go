@@ -16,9 +16,26 @@ import (
 	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
-// DeleteActionProperty to an existing Action
+// ErrInternal indicates that a schema change failed for a reason other than
+// an invalid request, e.g. because the change could not be persisted
+type ErrInternal struct {
+	msg string
+}
+
+func (e ErrInternal) Error() string {
+	return e.msg
+}
+
+// NewErrInternal with Errorf signature
+func NewErrInternal(format string, args ...interface{}) ErrInternal {
+	return ErrInternal{msg: fmt.Sprintf(format, args...)}
+}
+
+// DeleteActionProperty from an existing Action
 func (m *Manager) DeleteActionProperty(ctx context.Context, principal *models.Principal,
 	class string, property string) error {
 
@@ -27,12 +44,10 @@ func (m *Manager) DeleteActionProperty(ctx context.Context, principal *models.Pr
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	return m.deleteClassProperty(ctx, class, property, kind.Action)
 }
 
-// DeleteThingProperty to an existing Thing
+// DeleteThingProperty from an existing Thing
 func (m *Manager) DeleteThingProperty(ctx context.Context, principal *models.Principal,
 	class string, property string) error {
 
@@ -41,50 +56,95 @@ func (m *Manager) DeleteThingProperty(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Thing)
+	return m.deleteClassProperty(ctx, class, property, kind.Thing)
 }
 
-// TODO: https://github.com/semi-technologies/weaviate/issues/973
-// func (m *Manager) deleteClassProperty(ctx context.Context, className string, propName string, k kind.Kind) error {
-// 	unlock, err := m.locks.LockSchema()
-// 	if err != nil {
-// 		return err
-// 	}
-// 	defer unlock()
-
-// 	err = m.migrator.DropProperty(ctx, k, className, propName)
-// 	if err != nil {
-// 		return fmt.Errorf("could not migrate database schema: %v", err)
-// 	}
-
-// 	semanticSchema := m.state.SchemaFor(k)
-// 	class, err := schema.GetClassByName(semanticSchema, className)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	var propIdx = -1
-// 	for idx, prop := range class.Properties {
-// 		if prop.Name == propName {
-// 			propIdx = idx
-// 			break
-// 		}
-// 	}
-
-// 	if propIdx == -1 {
-// 		return fmt.Errorf("could not find property '%s' - it might have already been deleted?", propName)
-// 	}
-
-// 	class.Properties[propIdx] = class.Properties[len(class.Properties)-1]
-// 	class.Properties[len(class.Properties)-1] = nil // to prevent leaking this pointer.
-// 	class.Properties = class.Properties[:len(class.Properties)-1]
-
-// 	err = m.saveSchema(ctx)
-// 	if err != nil {
-// 		return fmt.Errorf("could not persists schema change in configuration: %v", err)
-// 	}
-
-// 	return nil
-// }
+func (m *Manager) deleteClassProperty(ctx context.Context, className string,
+	propName string, k kind.Kind) error {
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	semanticSchema := m.state.SchemaFor(k)
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
+	var propIdx = -1
+	for idx, prop := range class.Properties {
+		if prop.Name == propName {
+			propIdx = idx
+			break
+		}
+	}
+
+	if propIdx == -1 {
+		return fmt.Errorf("could not find property '%s' - it might have already been deleted?", propName)
+	}
+
+	if class.KeyProperty == propName {
+		return fmt.Errorf("property '%s' is the key property of class '%s' and cannot be deleted",
+			propName, className)
+	}
+
+	err = m.validatePropertyNotUsedByClassification(ctx, className, propName)
+	if err != nil {
+		return err
+	}
+
+	// keep the order of the remaining properties intact
+	class.Properties = append(class.Properties[:propIdx], class.Properties[propIdx+1:]...)
+
+	err = m.saveSchema(ctx)
+	if err != nil {
+		return NewErrInternal("could not persists schema change in configuration: %v", err)
+	}
+
+	if err := m.migrator.DropProperty(ctx, k, className, propName); err != nil {
+		return NewErrInternal("could not drop property '%s': %v", propName, err)
+	}
+
+	return nil
+	//TODO gh-846: rollback state update if migration fails
+}
+
+// validatePropertyNotUsedByClassification makes sure that the property is
+// neither classified nor used as a basis of a currently running
+// classification. Finished classifications are not considered, as they no
+// longer read or write the property.
+func (m *Manager) validatePropertyNotUsedByClassification(ctx context.Context,
+	className, propName string) error {
+	if m.classifications == nil {
+		return nil
+	}
+
+	classifications, err := m.classifications.List(ctx)
+	if err != nil {
+		return NewErrInternal("could not check for classifications using property '%s': %v",
+			propName, err)
+	}
+
+	for _, c := range classifications {
+		if c.Class != className || c.Status != models.ClassificationStatusRunning {
+			continue
+		}
+
+		for _, props := range [][]string{c.ClassifyProperties, c.BasedOnProperties} {
+			for _, prop := range props {
+				if prop == propName {
+					return fmt.Errorf("cannot delete property '%s': it is used by the "+
+						"running classification '%s'", propName, c.ID)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// ErrReadOnly is returned for every attempt to change a frozen class
+type ErrReadOnly struct {
+	ClassName string
+}
+
+func (e ErrReadOnly) Error() string {
+	return fmt.Sprintf("class '%s' is frozen, it needs to be unfrozen before it can be changed",
+		e.ClassName)
+}
+
+// ErrClassNotFound indicates that the class to freeze or unfreeze does not
+// exist
+type ErrClassNotFound struct {
+	ClassName string
+}
+
+func (e ErrClassNotFound) Error() string {
+	return fmt.Sprintf("could not find class '%s'", e.ClassName)
+}
+
+// FreezeThing class, so that neither the class nor its things can be changed
+// until it is unfrozen again
+func (m *Manager) FreezeThing(ctx context.Context, principal *models.Principal,
+	className string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	if err != nil {
+		return err
+	}
+
+	return m.setClassFrozen(ctx, kind.Thing, className, true)
+}
+
+// UnfreezeThing class which was previously frozen
+func (m *Manager) UnfreezeThing(ctx context.Context, principal *models.Principal,
+	className string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	if err != nil {
+		return err
+	}
+
+	return m.setClassFrozen(ctx, kind.Thing, className, false)
+}
+
+// FreezeAction class, so that neither the class nor its actions can be
+// changed until it is unfrozen again
+func (m *Manager) FreezeAction(ctx context.Context, principal *models.Principal,
+	className string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	if err != nil {
+		return err
+	}
+
+	return m.setClassFrozen(ctx, kind.Action, className, true)
+}
+
+// UnfreezeAction class which was previously frozen
+func (m *Manager) UnfreezeAction(ctx context.Context, principal *models.Principal,
+	className string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	if err != nil {
+		return err
+	}
+
+	return m.setClassFrozen(ctx, kind.Action, className, false)
+}
+
+func (m *Manager) setClassFrozen(ctx context.Context, k kind.Kind,
+	className string, frozen bool) error {
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	class, err := schema.GetClassByName(m.state.SchemaFor(k), className)
+	if err != nil {
+		return ErrClassNotFound{ClassName: className}
+	}
+
+	if class.Frozen == frozen {
+		return nil
+	}
+
+	class.Frozen = frozen
+	return m.saveSchema(ctx)
+}
+
+func checkNotFrozen(class *models.Class) error {
+	if class.Frozen {
+		return ErrReadOnly{ClassName: class.Class}
+	}
+
+	return nil
+}
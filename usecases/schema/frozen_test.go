@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrozenClass(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	err := sm.AddThing(ctx, nil, &models.Class{
+		Class:              "Car",
+		VectorizeClassName: ptBool(true),
+		Properties: []*models.Property{{
+			Name:     "color",
+			DataType: []string{"string"},
+		}},
+	})
+	require.Nil(t, err)
+
+	t.Run("freezing an unknown class", func(t *testing.T) {
+		err := sm.FreezeThing(ctx, nil, "Plane")
+		assert.Equal(t, ErrClassNotFound{ClassName: "Plane"}, err)
+	})
+
+	t.Run("freezing the class", func(t *testing.T) {
+		err := sm.FreezeThing(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.True(t, testGetClassByName(sm, kind.Thing, "Car").Frozen)
+	})
+
+	t.Run("the frozen class can not be changed", func(t *testing.T) {
+		expected := ErrReadOnly{ClassName: "Car"}
+
+		err := sm.AddThingProperty(ctx, nil, "Car", &models.Property{
+			Name:     "brand",
+			DataType: []string{"string"},
+		})
+		assert.Equal(t, expected, err)
+
+		err = sm.DeleteThingProperty(ctx, nil, "Car", "color")
+		assert.Equal(t, expected, err)
+
+		err = sm.UpdateClassName(ctx, nil, "Car", "Automobile")
+		assert.Equal(t, expected, err)
+
+		err = sm.DeleteThing(ctx, nil, "Car")
+		assert.Equal(t, expected, err)
+
+		assert.NotNil(t, testGetClassByName(sm, kind.Thing, "Car"))
+	})
+
+	t.Run("unfreezing the class makes it changeable again", func(t *testing.T) {
+		err := sm.UnfreezeThing(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.False(t, testGetClassByName(sm, kind.Thing, "Car").Frozen)
+
+		err = sm.DeleteThingProperty(ctx, nil, "Car", "color")
+		assert.Nil(t, err)
+	})
+}
@@ -51,6 +51,11 @@ func (m *Manager) Indexed(className, propertyName string) bool {
 
 	for _, prop := range class.Properties {
 		if prop.Name == propertyName {
+			if len(prop.DataType) == 1 && prop.DataType[0] == string(schema.DataTypeBlob) {
+				// binary data has no meaning to the contextionary
+				return false
+			}
+
 			if prop.Index == nil {
 				return true
 			}
@@ -95,3 +100,66 @@ func (m *Manager) VectorizePropertyName(className, propertyName string) bool {
 
 	return false
 }
+
+// VectorWeight returns the weight of a property when combining the property
+// vectors into the object vector. Properties without an explicit weight
+// have a weight of 1.
+func (m *Manager) VectorWeight(className, propertyName string) float32 {
+	s := schema.Schema{
+		Actions: m.state.ActionSchema,
+		Things:  m.state.ThingSchema,
+	}
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return 1
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name == propertyName {
+			if prop.VectorWeight == nil {
+				return 1
+			}
+
+			return *prop.VectorWeight
+		}
+	}
+
+	return 1
+}
+
+// Tokenization returns how the value of a property is split into words
+// before it is vectorized. Properties without an explicit tokenization are
+// split into words.
+func (m *Manager) Tokenization(className, propertyName string) string {
+	s := schema.Schema{
+		Actions: m.state.ActionSchema,
+		Things:  m.state.ThingSchema,
+	}
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return models.PropertyTokenizationWord
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name == propertyName && prop.Tokenization != "" {
+			return prop.Tokenization
+		}
+	}
+
+	return models.PropertyTokenizationWord
+}
+
+// TextNormalization returns the normalization steps configured for the class,
+// nil if the class doesn't configure any and the defaults apply
+func (m *Manager) TextNormalization(className string) *models.TextNormalization {
+	s := schema.Schema{
+		Actions: m.state.ActionSchema,
+		Things:  m.state.ThingSchema,
+	}
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil
+	}
+
+	return class.TextNormalization
+}
@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -34,6 +35,14 @@ func (f *fakeRepo) SaveSchema(ctx context.Context, schema State) error {
 	return nil
 }
 
+type failingRepo struct {
+	fakeRepo
+}
+
+func (f *failingRepo) SaveSchema(ctx context.Context, schema State) error {
+	return fmt.Errorf("save failed")
+}
+
 type fakeLocks struct{}
 
 func newFakeLocks() *fakeLocks {
@@ -79,3 +88,11 @@ type fakeAuthorizer struct{}
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
 	return nil
 }
+
+type fakeClassificationLister struct {
+	classifications []*models.Classification
+}
+
+func (f *fakeClassificationLister) List(ctx context.Context) ([]*models.Classification, error) {
+	return f.classifications, nil
+}
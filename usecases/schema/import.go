@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// ImportResult lists what happened to every class of an imported schema
+type ImportResult struct {
+	Created   []string
+	Unchanged []string
+	Conflicts []ImportConflict
+}
+
+// ImportConflict is a class of the imported schema which already exists, but
+// differs from the imported definition
+type ImportConflict struct {
+	Kind   kind.Kind
+	Class  string
+	Reason string
+}
+
+type importClass struct {
+	kind  kind.Kind
+	class *models.Class
+}
+
+// ImportSchema creates all classes of the imported things and actions schema
+// which don't exist yet. Classes which already exist with an identical
+// definition are left untouched, so importing the same schema twice is a
+// no-op. If any class exists with a different definition nothing is created
+// at all and the conflicts are returned in the result instead.
+func (m *Manager) ImportSchema(ctx context.Context, principal *models.Principal,
+	things, actions *models.Schema) (*ImportResult, error) {
+	err := m.authorizer.Authorize(principal, "create", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []importClass
+	for k, s := range map[kind.Kind]*models.Schema{kind.Thing: things, kind.Action: actions} {
+		if s == nil {
+			continue
+		}
+
+		for _, class := range s.Classes {
+			class.Class = upperCaseClassName(class.Class)
+			class.Properties = lowerCaseAllPropertyNames(class.Properties)
+			class.KeyProperty = lowerCaseFirstLetter(class.KeyProperty)
+			classes = append(classes, importClass{kind: k, class: class})
+		}
+	}
+
+	// things first, then in alphabetical order, so that the result is stable
+	sort.Slice(classes, func(a, b int) bool {
+		if classes[a].kind != classes[b].kind {
+			return classes[a].kind == kind.Thing
+		}
+		return classes[a].class.Class < classes[b].class.Class
+	})
+
+	res := &ImportResult{
+		Created:   []string{},
+		Unchanged: []string{},
+		Conflicts: []ImportConflict{},
+	}
+
+	var toCreate []importClass
+	current := m.GetSchemaSkipAuth()
+	for _, c := range classes {
+		existing := current.FindClassByName(schema.ClassName(c.class.Class))
+		if existing == nil {
+			toCreate = append(toCreate, c)
+			continue
+		}
+
+		var reason string
+		existingKind, _ := current.GetKindOfClass(schema.ClassName(c.class.Class))
+		if existingKind != c.kind {
+			reason = fmt.Sprintf("class already exists with kind '%s'", existingKind.Name())
+		} else {
+			reason = diffClasses(existing, c.class)
+		}
+
+		if reason != "" {
+			res.Conflicts = append(res.Conflicts, ImportConflict{
+				Kind:   c.kind,
+				Class:  c.class.Class,
+				Reason: reason,
+			})
+			continue
+		}
+
+		res.Unchanged = append(res.Unchanged, c.class.Class)
+	}
+
+	if len(res.Conflicts) > 0 {
+		return res, nil
+	}
+
+	if err := m.createImportedClasses(ctx, principal, toCreate); err != nil {
+		return nil, err
+	}
+
+	for _, c := range toCreate {
+		res.Created = append(res.Created, c.class.Class)
+	}
+
+	return res, nil
+}
+
+// createImportedClasses creates the classes in three passes: Classes may
+// reference each other, so the classes are first created without their
+// reference properties which are only added once all classes exist. Frozen
+// classes can't be altered, so they are only frozen at the very end. If any
+// step fails, the classes created so far are removed again, so that a failed
+// import can simply be retried.
+func (m *Manager) createImportedClasses(ctx context.Context,
+	principal *models.Principal, classes []importClass) (err error) {
+	var created []importClass
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if rollbackErr := m.removeImportedClasses(ctx, created); rollbackErr != nil {
+			err = fmt.Errorf("%v, roll back: %v", err, rollbackErr)
+		}
+	}()
+
+	refProps := make([][]*models.Property, len(classes))
+	for i, c := range classes {
+		withoutRefs := *c.class
+		withoutRefs.Frozen = false
+		withoutRefs.Properties = nil
+		for _, prop := range c.class.Properties {
+			if len(prop.DataType) > 0 && schema.IsRefDataType(prop.DataType) {
+				refProps[i] = append(refProps[i], prop)
+				continue
+			}
+
+			withoutRefs.Properties = append(withoutRefs.Properties, prop)
+		}
+
+		if err := m.addClass(ctx, principal, &withoutRefs, c.kind); err != nil {
+			return fmt.Errorf("import class '%s': %v", c.class.Class, err)
+		}
+
+		created = append(created, c)
+	}
+
+	for i, c := range classes {
+		for _, prop := range refProps[i] {
+			if err := m.addClassProperty(ctx, principal, c.class.Class, prop, c.kind); err != nil {
+				return fmt.Errorf("import class '%s': property '%s': %v",
+					c.class.Class, prop.Name, err)
+			}
+		}
+	}
+
+	for _, c := range classes {
+		if !c.class.Frozen {
+			continue
+		}
+
+		if err := m.setClassFrozen(ctx, c.kind, c.class.Class, true); err != nil {
+			return fmt.Errorf("import class '%s': %v", c.class.Class, err)
+		}
+	}
+
+	return nil
+}
+
+// removeImportedClasses deletes the classes in reverse order. A class may
+// have been frozen already, so it is unfrozen first. It tries to remove every
+// class, even if an earlier one could not be removed.
+func (m *Manager) removeImportedClasses(ctx context.Context,
+	classes []importClass) error {
+	var errs []string
+	for i := len(classes) - 1; i >= 0; i-- {
+		c := classes[i]
+		if err := m.setClassFrozen(ctx, c.kind, c.class.Class, false); err != nil {
+			errs = append(errs, fmt.Sprintf("class '%s': %v", c.class.Class, err))
+			continue
+		}
+
+		if err := m.deleteClass(ctx, c.class.Class, c.kind); err != nil {
+			errs = append(errs, fmt.Sprintf("class '%s': %v", c.class.Class, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// diffClasses returns a description of the first difference between the two
+// classes or an empty string if they are identical. The order of the
+// properties does not matter.
+func diffClasses(existing, imported *models.Class) string {
+	existingProps := map[string]*models.Property{}
+	for _, prop := range existing.Properties {
+		existingProps[prop.Name] = prop
+	}
+
+	importedProps := map[string]*models.Property{}
+	for _, prop := range imported.Properties {
+		importedProps[prop.Name] = prop
+		existingProp, ok := existingProps[prop.Name]
+		if !ok {
+			return fmt.Sprintf("property '%s' does not exist on the existing class", prop.Name)
+		}
+
+		// cardinality is deprecated and always removed when a class is created
+		withoutCardinality := *prop
+		withoutCardinality.Cardinality = ""
+		if !equalAsJSON(existingProp, &withoutCardinality) {
+			return fmt.Sprintf("property '%s' differs from the existing property", prop.Name)
+		}
+	}
+
+	for _, prop := range existing.Properties {
+		if _, ok := importedProps[prop.Name]; !ok {
+			return fmt.Sprintf("existing class has an additional property '%s'", prop.Name)
+		}
+	}
+
+	existingSettings := *existing
+	existingSettings.Properties = nil
+	importedSettings := *imported
+	importedSettings.Properties = nil
+	if !equalAsJSON(&existingSettings, &importedSettings) {
+		return "class settings differ from the existing class"
+	}
+
+	return ""
+}
+
+func equalAsJSON(a, b interface{}) bool {
+	aBytes, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	bBytes, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return string(aBytes) == string(bBytes)
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportSchema(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	// the schema is recreated for every import, so that the imports never
+	// share any state with the manager
+	things := func() *models.Schema {
+		return &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:              "Author",
+					VectorizeClassName: ptBool(true),
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{"string"},
+						},
+						{
+							// references a class which is only imported later on
+							Name:     "wrote",
+							DataType: []string{"Book"},
+						},
+					},
+				},
+				{
+					Class:              "Book",
+					VectorizeClassName: ptBool(true),
+					Frozen:             true,
+					Properties: []*models.Property{
+						{
+							Name:     "title",
+							DataType: []string{"string"},
+						},
+						{
+							Name:     "writtenBy",
+							DataType: []string{"Author"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	actions := func() *models.Schema {
+		return &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:              "Publish",
+					VectorizeClassName: ptBool(true),
+					Properties: []*models.Property{
+						{
+							Name:     "publishedBook",
+							DataType: []string{"Book"},
+						},
+						{
+							Name:     "publisher",
+							DataType: []string{"string"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("importing into an empty schema", func(t *testing.T) {
+		res, err := sm.ImportSchema(ctx, nil, things(), actions())
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"Author", "Book", "Publish"}, res.Created)
+		assert.Len(t, res.Unchanged, 0)
+		assert.Len(t, res.Conflicts, 0)
+
+		author := testGetClassByName(sm, kind.Thing, "Author")
+		require.NotNil(t, author)
+		assert.NotNil(t, testGetPropertyOfClass(author, "wrote"))
+
+		book := testGetClassByName(sm, kind.Thing, "Book")
+		require.NotNil(t, book)
+		assert.NotNil(t, testGetPropertyOfClass(book, "writtenBy"))
+		assert.True(t, book.Frozen)
+
+		publish := testGetClassByName(sm, kind.Action, "Publish")
+		require.NotNil(t, publish)
+		assert.Len(t, publish.Properties, 2)
+	})
+
+	t.Run("importing the same schema again", func(t *testing.T) {
+		res, err := sm.ImportSchema(ctx, nil, things(), actions())
+		require.Nil(t, err)
+
+		assert.Len(t, res.Created, 0)
+		assert.Equal(t, []string{"Author", "Book", "Publish"}, res.Unchanged)
+		assert.Len(t, res.Conflicts, 0)
+	})
+
+	t.Run("importing a schema with conflicting classes", func(t *testing.T) {
+		changed := things()
+		changed.Classes[0].Properties[0].DataType = []string{"text"}
+		changed.Classes = append(changed.Classes, &models.Class{
+			Class:              "Library",
+			VectorizeClassName: ptBool(true),
+			Properties: []*models.Property{{
+				Name:     "address",
+				DataType: []string{"string"},
+			}},
+		})
+		movedToActions := actions()
+		movedToActions.Classes = append(movedToActions.Classes, changed.Classes[1])
+		changed.Classes = append(changed.Classes[:1], changed.Classes[2:]...)
+
+		res, err := sm.ImportSchema(ctx, nil, changed, movedToActions)
+		require.Nil(t, err)
+
+		expected := []ImportConflict{
+			{
+				Kind:   kind.Thing,
+				Class:  "Author",
+				Reason: "property 'name' differs from the existing property",
+			},
+			{
+				Kind:   kind.Action,
+				Class:  "Book",
+				Reason: "class already exists with kind 'thing'",
+			},
+		}
+		assert.Equal(t, expected, res.Conflicts)
+		assert.Len(t, res.Created, 0)
+		assert.Nil(t, testGetClassByName(sm, kind.Thing, "Library"),
+			"nothing must be created if there are conflicts")
+	})
+
+	t.Run("importing a class with fewer properties", func(t *testing.T) {
+		changed := things()
+		changed.Classes[0].Properties = changed.Classes[0].Properties[:1]
+
+		res, err := sm.ImportSchema(ctx, nil, changed, nil)
+		require.Nil(t, err)
+
+		require.Len(t, res.Conflicts, 1)
+		assert.Equal(t, "existing class has an additional property 'wrote'",
+			res.Conflicts[0].Reason)
+	})
+
+	t.Run("an import which fails partway", func(t *testing.T) {
+		sm := newSchemaManager()
+		broken := actions()
+		broken.Classes[0].Properties[0].DataType = []string{"Magazine"}
+
+		_, err := sm.ImportSchema(ctx, nil, things(), broken)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "import class 'Publish': property 'publishedBook'")
+
+		assert.Nil(t, testGetClassByName(sm, kind.Thing, "Author"))
+		assert.Nil(t, testGetClassByName(sm, kind.Thing, "Book"))
+		assert.Nil(t, testGetClassByName(sm, kind.Action, "Publish"))
+
+		t.Run("can be retried", func(t *testing.T) {
+			res, err := sm.ImportSchema(ctx, nil, things(), actions())
+			require.Nil(t, err)
+			assert.Equal(t, []string{"Author", "Book", "Publish"}, res.Created)
+			assert.Len(t, res.Conflicts, 0)
+		})
+	})
+
+	t.Run("an import with the same class twice", func(t *testing.T) {
+		sm := newSchemaManager()
+		// the duplicate is only detected when it is created
+		duplicate := actions()
+		duplicate.Classes = append(duplicate.Classes, &models.Class{
+			Class:              "Publish",
+			VectorizeClassName: ptBool(true),
+		})
+
+		_, err := sm.ImportSchema(ctx, nil, things(), duplicate)
+		require.NotNil(t, err)
+		assert.Nil(t, testGetClassByName(sm, kind.Thing, "Author"))
+		assert.Nil(t, testGetClassByName(sm, kind.Thing, "Book"))
+		assert.Nil(t, testGetClassByName(sm, kind.Action, "Publish"))
+	})
+}
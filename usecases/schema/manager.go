@@ -38,6 +38,7 @@ type Manager struct {
 	callbacks        []func(updatedSchema schema.Schema)
 	logger           logrus.FieldLogger
 	authorizer       authorizer
+	classifications  classificationLister
 }
 
 type SchemaGetter interface {
@@ -62,6 +63,12 @@ type c11yClient interface {
 	IsWordPresent(ctx context.Context, word string) (bool, error)
 }
 
+// classificationLister is used to prevent schema changes which would break a
+// classification
+type classificationLister interface {
+	List(ctx context.Context) ([]*models.Classification, error)
+}
+
 // NewManager creates a new manager
 func NewManager(migrator migrate.Migrator, repo Repo, locks locks.ConnectorSchemaLock,
 	network network.Network, logger logrus.FieldLogger, c11yClient c11yClient,
@@ -90,6 +97,13 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// SetClassificationLister enables the checks that prevent deleting properties
+// which are used by a running classification. If no lister is set, those
+// checks are skipped.
+func (m *Manager) SetClassificationLister(cl classificationLister) {
+	m.classifications = cl
+}
+
 type unlocker interface {
 	Unlock() error
 }
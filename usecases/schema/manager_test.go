@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -56,6 +57,29 @@ func (n *NilMigrator) DropProperty(ctx context.Context, kind kind.Kind, classNam
 	return nil
 }
 
+// failingMigrator fails to add the property with the name failOn and records
+// which properties were added and dropped
+type failingMigrator struct {
+	NilMigrator
+	failOn  string
+	added   []string
+	dropped []string
+}
+
+func (m *failingMigrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
+	if prop.Name == m.failOn {
+		return fmt.Errorf("migration failed")
+	}
+
+	m.added = append(m.added, prop.Name)
+	return nil
+}
+
+func (m *failingMigrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propName string) error {
+	m.dropped = append(m.dropped, propName)
+	return nil
+}
+
 var schemaTests = []struct {
 	name string
 	fn   func(*testing.T, *Manager)
@@ -68,6 +92,8 @@ var schemaTests = []struct {
 	{name: "CantAddSameClassTwiceDifferentKind", fn: testCantAddSameClassTwiceDifferentKinds},
 	{name: "UpdateClassName", fn: testUpdateClassName},
 	{name: "UpdateClassNameCollision", fn: testUpdateClassNameCollision},
+	{name: "UpdateClassNameRewritesReferences", fn: testUpdateClassNameRewritesReferences},
+	{name: "UpdateClassNameFromAnotherKindCollision", fn: testUpdateClassNameFromAnotherKindCollision},
 	{name: "AddThingClassWithKeywords", fn: testAddThingClassWithKeywords},
 	{name: "AddThingClassWithInvalidKeywordWeights", fn: testAddThingClassWithInvalidKeywordWeights},
 	{name: "UpdateClassKeywords", fn: testUpdateClassKeywords},
@@ -76,12 +102,19 @@ var schemaTests = []struct {
 	{name: "AddInvalidPropertyWithEmptyDataTypeDuringCreation", fn: testAddInvalidPropertyWithEmptyDataTypeDuringCreation},
 	{name: "AddPropertyDWithInvalidKeywordWeightsDuringCreation", fn: testAddPropertyWithInvalidKeywordWeightsDuringCreation},
 	{name: "DropProperty", fn: testDropProperty},
+	{name: "DropPropertyUsedByClassification", fn: testDropPropertyUsedByClassification},
 	{name: "UpdatePropertyName", fn: testUpdatePropertyName},
 	{name: "UpdatePropertyNameCollision", fn: testUpdatePropertyNameCollision},
 	{name: "UpdatePropertyKeywords", fn: testUpdatePropertyKeywords},
 	{name: "UpdatePropertyAddDataTypeNew", fn: testUpdatePropertyAddDataTypeNew},
 	{name: "UpdatePropertyAddDataTypeExisting", fn: testUpdatePropertyAddDataTypeExisting},
 	{name: "AddProperty with deprecated fields", fn: testAddPropertyWithDeprecatedFields},
+	{name: "AddProperties", fn: testAddProperties},
+	{name: "AddPropertiesWithInvalidProperty", fn: testAddPropertiesWithInvalidProperty},
+	{name: "AddPropertiesWithDuplicateNames", fn: testAddPropertiesWithDuplicateNames},
+	{name: "AddPropertiesWithFailingMigration", fn: testAddPropertiesWithFailingMigration},
+	{name: "AddPropertiesWithFailingSave", fn: testAddPropertiesWithFailingSave},
+	{name: "UpdateClassAddProperty", fn: testUpdateClassAddProperty},
 }
 
 func testUpdateMeta(t *testing.T, lsm *Manager) {
@@ -311,6 +344,44 @@ func testUpdateClassNameCollision(t *testing.T, lsm *Manager) {
 	assert.Equal(t, thingClasses[0], "InitialName")
 }
 
+func testUpdateClassNameRewritesReferences(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	assert.Nil(t, lsm.AddThing(context.Background(), nil,
+		&models.Class{Class: "Author", VectorizeClassName: ptBool(true)}))
+	assert.Nil(t, lsm.AddAction(context.Background(), nil,
+		&models.Class{
+			Class:              "Write",
+			VectorizeClassName: ptBool(true),
+			Properties: []*models.Property{{
+				Name:     "writtenBy",
+				DataType: []string{"Author"},
+			}},
+		}))
+
+	err := lsm.UpdateClassName(context.Background(), nil, "Author", "Writer")
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"Writer"}, testGetClassNames(lsm, kind.Thing))
+	write := testGetClassByName(lsm, kind.Action, "Write")
+	require.NotNil(t, write)
+	assert.Equal(t, []string{"Writer"}, write.Properties[0].DataType)
+}
+
+func testUpdateClassNameFromAnotherKindCollision(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	assert.Nil(t, lsm.AddThing(context.Background(), nil,
+		&models.Class{Class: "InitialName", VectorizeClassName: ptBool(true)}))
+	assert.Nil(t, lsm.AddAction(context.Background(), nil,
+		&models.Class{Class: "ExistingClass", VectorizeClassName: ptBool(true)}))
+
+	err := lsm.UpdateClassName(context.Background(), nil, "InitialName", "ExistingClass")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []string{"InitialName"}, testGetClassNames(lsm, kind.Thing))
+}
+
 func testAddThingClassWithKeywords(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
@@ -424,6 +495,10 @@ func testAddPropertyDuringCreation(t *testing.T, lsm *Manager) {
 			DataType:              []string{"string"},
 			VectorizePropertyName: false,
 		},
+		{
+			Name:     "photo",
+			DataType: []string{"blob"},
+		},
 	}
 
 	err := lsm.AddThing(context.Background(), nil, &models.Class{
@@ -434,12 +509,13 @@ func testAddPropertyDuringCreation(t *testing.T, lsm *Manager) {
 
 	thingClasses := testGetClasses(lsm, kind.Thing)
 	require.Len(t, thingClasses, 1)
-	require.Len(t, thingClasses[0].Properties, 3)
+	require.Len(t, thingClasses[0].Properties, 4)
 	assert.Equal(t, thingClasses[0].Properties[0].Name, "color")
 	assert.Equal(t, thingClasses[0].Properties[0].DataType, []string{"string"})
 
 	assert.True(t, lsm.Indexed("Car", "color"), "color should be indexed")
 	assert.False(t, lsm.Indexed("Car", "colorRaw"), "color should not be indexed")
+	assert.False(t, lsm.Indexed("Car", "photo"), "blobs should never be indexed")
 
 	assert.True(t, lsm.VectorizePropertyName("Car", "color"), "color prop should be vectorized")
 	assert.False(t, lsm.VectorizePropertyName("Car", "content"), "content prop should not be vectorized")
@@ -538,15 +614,11 @@ func testAddPropertyWithInvalidKeywordWeightsDuringCreation(t *testing.T, lsm *M
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/semi-technologies/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
 		{Name: "color", DataType: []string{"string"}},
+		{Name: "brand", DataType: []string{"string"}},
 	}
 
 	err := lsm.AddThing(context.Background(), nil, &models.Class{
@@ -557,16 +629,216 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 
 	thingClasses := testGetClasses(lsm, kind.Thing)
 	require.Len(t, thingClasses, 1)
-	assert.Len(t, thingClasses[0].Properties, 1)
+	assert.Len(t, thingClasses[0].Properties, 2)
 
 	// Now drop the property
-	lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	assert.Nil(t, err)
 
 	thingClasses = testGetClasses(lsm, kind.Thing)
 	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 1)
+	assert.Equal(t, "brand", thingClasses[0].Properties[0].Name)
+
+	// Dropping it again fails
+	err = lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	assert.NotNil(t, err)
+}
+
+func testAddProperties(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Properties: []*models.Property{{Name: "color", DataType: []string{"string"}}},
+	})
+	require.Nil(t, err)
+
+	class, err := lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "Brand", DataType: []string{"string"}},
+		{Name: "horsepower", DataType: []string{"int"}},
+		{Name: "sibling", DataType: []string{"Car"}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "Car", class.Class)
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 4)
+	assert.Equal(t, "brand", thingClasses[0].Properties[1].Name)
+	assert.Equal(t, "horsepower", thingClasses[0].Properties[2].Name)
+	assert.Equal(t, "sibling", thingClasses[0].Properties[3].Name)
+}
+
+func testAddPropertiesWithInvalidProperty(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Properties: []*models.Property{{Name: "color", DataType: []string{"string"}}},
+	})
+	require.Nil(t, err)
+
+	_, err = lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "brand", DataType: []string{"string"}},
+		{Name: "manufacturer", DataType: []string{"NotAClass"}},
+	})
+	assert.NotNil(t, err)
+
+	_, err = lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "brand", DataType: []string{"string"}},
+		{Name: "color", DataType: []string{"string"}},
+	})
+	assert.NotNil(t, err)
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 1, "none of the properties was added")
+	assert.Equal(t, "color", thingClasses[0].Properties[0].Name)
+}
+
+func testAddPropertiesWithDuplicateNames(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+	})
+	require.Nil(t, err)
+
+	_, err = lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "brand", DataType: []string{"string"}},
+		{Name: "Brand", DataType: []string{"text"}},
+	})
+	assert.EqualError(t, err, "Name 'brand' is used for more than one of the properties to add")
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	assert.Len(t, thingClasses[0].Properties, 0)
+}
+
+func testAddPropertiesWithFailingMigration(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+	})
+	require.Nil(t, err)
+
+	migrator := &failingMigrator{failOn: "horsepower"}
+	lsm.migrator = migrator
+
+	_, err = lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "brand", DataType: []string{"string"}},
+		{Name: "horsepower", DataType: []string{"int"}},
+		{Name: "color", DataType: []string{"string"}},
+	})
+	assert.EqualError(t, err, "migrate property 'horsepower': migration failed")
+
+	assert.Equal(t, []string{"brand"}, migrator.dropped, "the migration is rolled back")
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
 	assert.Len(t, thingClasses[0].Properties, 0)
 }
 
+func testAddPropertiesWithFailingSave(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Properties: []*models.Property{{Name: "color", DataType: []string{"string"}}},
+	})
+	require.Nil(t, err)
+
+	migrator := &failingMigrator{}
+	lsm.migrator = migrator
+	lsm.repo = &failingRepo{}
+
+	_, err = lsm.AddThingProperties(context.Background(), nil, "Car", []*models.Property{
+		{Name: "brand", DataType: []string{"string"}},
+		{Name: "horsepower", DataType: []string{"int"}},
+	})
+	assert.EqualError(t, err, "save failed")
+
+	assert.Equal(t, []string{"brand", "horsepower"}, migrator.dropped)
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 1, "the schema in memory is unchanged")
+	assert.Equal(t, "color", thingClasses[0].Properties[0].Name)
+}
+
+func testUpdateClassAddProperty(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+	})
+	require.Nil(t, err)
+
+	err = lsm.UpdateClassAddProperty(context.Background(), nil, kind.Thing, "Car",
+		&models.Property{Name: "Horsepower", DataType: []string{"number"}})
+	require.Nil(t, err)
+
+	// inferring the same property again is not an error
+	err = lsm.UpdateClassAddProperty(context.Background(), nil, kind.Thing, "Car",
+		&models.Property{Name: "horsepower", DataType: []string{"number"}})
+	require.Nil(t, err)
+
+	err = lsm.UpdateClassAddProperty(context.Background(), nil, kind.Thing, "Car",
+		&models.Property{Name: "horsepower", DataType: []string{"string"}})
+	assert.EqualError(t, err, "property 'horsepower' of class 'Car' has data type [number], "+
+		"but the value requires data type [string]")
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 1)
+	assert.Equal(t, "horsepower", thingClasses[0].Properties[0].Name)
+	assert.Equal(t, []string{"number"}, thingClasses[0].Properties[0].DataType)
+}
+
+func testDropPropertyUsedByClassification(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	var properties []*models.Property = []*models.Property{
+		{Name: "color", DataType: []string{"string"}},
+		{Name: "brand", DataType: []string{"string"}},
+	}
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Properties: properties,
+	})
+	require.Nil(t, err)
+
+	lsm.SetClassificationLister(&fakeClassificationLister{
+		classifications: []*models.Classification{
+			{
+				ID:                 "7f6a1b2c-1111-4c4c-8a8a-111111111111",
+				Class:              "Car",
+				ClassifyProperties: []string{"color"},
+				Status:             models.ClassificationStatusCompleted,
+			},
+			{
+				ID:                "7f6a1b2c-2222-4c4c-8a8a-222222222222",
+				Class:             "Car",
+				BasedOnProperties: []string{"brand"},
+				Status:            models.ClassificationStatusRunning,
+			},
+		},
+	})
+
+	err = lsm.DeleteThingProperty(context.Background(), nil, "Car", "brand")
+	assert.Equal(t, "cannot delete property 'brand': it is used by the running "+
+		"classification '7f6a1b2c-2222-4c4c-8a8a-222222222222'", err.Error())
+
+	err = lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	assert.Nil(t, err, "a completed classification does not prevent deletion")
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 1)
+	require.Len(t, thingClasses[0].Properties, 1)
+	assert.Equal(t, "brand", thingClasses[0].Properties[0].Name)
+}
+
 func testUpdatePropertyName(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonRepo persists the schema as json, like the etcd repo does, so that
+// anything lost in serialization is lost in the tests, too.
+type jsonRepo struct {
+	state []byte
+}
+
+func (r *jsonRepo) LoadSchema(context.Context) (*State, error) {
+	if r.state == nil {
+		return nil, nil
+	}
+
+	var state State
+	if err := json.Unmarshal(r.state, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (r *jsonRepo) SaveSchema(ctx context.Context, state State) error {
+	bytes, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	r.state = bytes
+	return nil
+}
+
+// classRecordingMigrator records the classes it was asked to create
+type classRecordingMigrator struct {
+	NilMigrator
+	added []*models.Class
+}
+
+func (m *classRecordingMigrator) AddClass(ctx context.Context, kind kind.Kind,
+	class *models.Class) error {
+	m.added = append(m.added, class)
+	return nil
+}
+
+func TestClassMetadataRoundTrip(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo := &jsonRepo{}
+	newManager := func(t *testing.T, migrator *classRecordingMigrator) *Manager {
+		m, err := NewManager(migrator, repo, newFakeLocks(), nil, logger,
+			&fakeC11y{}, &fakeAuthorizer{}, &fakeStopwordDetector{})
+		require.Nil(t, err)
+		return m
+	}
+
+	class := func() *models.Class {
+		return &models.Class{
+			Class:              "Car",
+			Description:        "A vehicle with four wheels",
+			VectorizeClassName: ptBool(true),
+			ModuleConfig: map[string]interface{}{
+				"owner": "fleet-team",
+				"tags":  []interface{}{"vehicle", "transport"},
+				"nested": map[string]interface{}{
+					"priority": float64(3),
+					"enabled":  true,
+				},
+			},
+			Properties: []*models.Property{
+				{
+					Name:        "brand",
+					DataType:    []string{"string"},
+					Description: "The manufacturer of the car",
+					ModuleConfig: map[string]interface{}{
+						"source": "registry",
+					},
+				},
+			},
+		}
+	}
+
+	migrator := &classRecordingMigrator{}
+	err := newManager(t, migrator).AddThing(context.Background(), nil, class())
+	require.Nil(t, err)
+
+	t.Run("the migrator receives the full class", func(t *testing.T) {
+		require.Len(t, migrator.added, 1)
+		assert.Equal(t, class(), migrator.added[0])
+	})
+
+	t.Run("the class is returned unchanged after loading the schema", func(t *testing.T) {
+		reloaded := newManager(t, &classRecordingMigrator{})
+		schema, err := reloaded.GetSchema(nil)
+		require.Nil(t, err)
+
+		require.Len(t, schema.Things.Classes, 1)
+		assert.Equal(t, class(), schema.Things.Classes[0])
+	})
+
+	t.Run("updating the class description and module config", func(t *testing.T) {
+		m := newManager(t, &classRecordingMigrator{})
+		update := class()
+		update.Description = "A car"
+		update.ModuleConfig = map[string]interface{}{"owner": "sales-team"}
+		err := m.UpdateThing(context.Background(), nil, "Car", update)
+		require.Nil(t, err)
+
+		schema, err := newManager(t, &classRecordingMigrator{}).GetSchema(nil)
+		require.Nil(t, err)
+		require.Len(t, schema.Things.Classes, 1)
+		assert.Equal(t, "A car", schema.Things.Classes[0].Description)
+		assert.Equal(t, map[string]interface{}{"owner": "sales-team"},
+			schema.Things.Classes[0].ModuleConfig)
+	})
+
+	t.Run("updating the property description and module config", func(t *testing.T) {
+		m := newManager(t, &classRecordingMigrator{})
+		err := m.UpdateThingProperty(context.Background(), nil, "Car", "brand",
+			&models.Property{
+				Name:         "brand",
+				Description:  "The make of the car",
+				ModuleConfig: map[string]interface{}{"source": "manual"},
+			})
+		require.Nil(t, err)
+
+		schema, err := newManager(t, &classRecordingMigrator{}).GetSchema(nil)
+		require.Nil(t, err)
+		require.Len(t, schema.Things.Classes, 1)
+		prop := schema.Things.Classes[0].Properties[0]
+		assert.Equal(t, "The make of the car", prop.Description)
+		assert.Equal(t, map[string]interface{}{"source": "manual"}, prop.ModuleConfig)
+		assert.Equal(t, []string{"string"}, prop.DataType)
+	})
+
+	t.Run("omitting description and module config on update keeps them", func(t *testing.T) {
+		m := newManager(t, &classRecordingMigrator{})
+		err := m.UpdateThing(context.Background(), nil, "Car", &models.Class{Class: "Car"})
+		require.Nil(t, err)
+
+		schema, err := newManager(t, &classRecordingMigrator{}).GetSchema(nil)
+		require.Nil(t, err)
+		assert.Equal(t, "A car", schema.Things.Classes[0].Description)
+		assert.Equal(t, map[string]interface{}{"owner": "sales-team"},
+			schema.Things.Classes[0].ModuleConfig)
+	})
+}
@@ -54,6 +54,8 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 
 	var newName *string
 	var newKeywords *models.Keywords
+	newDescription := class.Description
+	newModuleConfig := class.ModuleConfig
 
 	if class.Class != className {
 		// the name in the URI and body don't match, so we assume the user wants to rename
@@ -73,6 +75,10 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 		return err
 	}
 
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
 	classNameAfterUpdate := className
 	keywordsAfterUpdate := class.Keywords
 
@@ -98,6 +104,17 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 	// Validated! Now apply the changes.
 	class.Class = classNameAfterUpdate
 	class.Keywords = keywordsAfterUpdate
+	// like keywords, an omitted description or module config leaves the
+	// existing one in place
+	if newDescription != "" {
+		class.Description = newDescription
+	}
+	if newModuleConfig != nil {
+		class.ModuleConfig = newModuleConfig
+	}
+	if newName != nil {
+		m.renameClassInReferenceDataTypes(className, *newName)
+	}
 
 	err = m.saveSchema(ctx)
 
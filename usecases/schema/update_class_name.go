@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// UpdateClassName renames an existing Thing or Action class. Reference
+// properties of any class which point to the renamed class are updated as
+// well. Since that can affect classes of both kinds, permissions on the entire
+// schema are required.
+func (m *Manager) UpdateClassName(ctx context.Context, principal *models.Principal,
+	oldName, newName string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/*")
+	if err != nil {
+		return err
+	}
+
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return m.updateClassName(ctx, oldName, upperCaseClassName(newName))
+}
+
+func (m *Manager) updateClassName(ctx context.Context, oldName, newName string) error {
+	k, class, err := m.classAndKindByName(oldName)
+	if err != nil {
+		return err
+	}
+
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
+	if oldName == newName {
+		return nil
+	}
+
+	err = m.validateClassNameUniqueness(newName)
+	if err != nil {
+		return err
+	}
+
+	err = m.validateClassNameAndKeywords(ctx, k, newName, class.Keywords,
+		VectorizeClassName(class))
+	if err != nil {
+		return err
+	}
+
+	// Migrate the stored data first: if this fails the schema still points to
+	// the old name, so the schema and the data remain consistent and the
+	// rename can simply be retried. The migrators resume a partial rename.
+	err = m.migrator.UpdateClass(ctx, k, oldName, &newName, nil)
+	if err != nil {
+		return fmt.Errorf("could not migrate class '%s' to '%s': %v", oldName, newName, err)
+	}
+
+	class.Class = newName
+	m.renameClassInReferenceDataTypes(oldName, newName)
+
+	return m.saveSchema(ctx)
+}
+
+func (m *Manager) classAndKindByName(className string) (kind.Kind, *models.Class, error) {
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		class, err := schema.GetClassByName(m.state.SchemaFor(k), className)
+		if err == nil {
+			return k, class, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("could not find class '%s'", className)
+}
+
+// renameClassInReferenceDataTypes makes sure that every reference property
+// pointing to the old class name points to the new name instead. Beacons
+// themselves only contain the kind and id of the target, so stored
+// references don't need to be touched.
+func (m *Manager) renameClassInReferenceDataTypes(oldName, newName string) {
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		for _, class := range m.state.SchemaFor(k).Classes {
+			for _, prop := range class.Properties {
+				for i, dataType := range prop.DataType {
+					if dataType == oldName {
+						prop.DataType[i] = newName
+					}
+				}
+			}
+		}
+	}
+}
@@ -72,6 +72,10 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 		return err
 	}
 
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
 	prop, err := schema.GetPropertyByName(class, name)
 	if err != nil {
 		return err
@@ -100,8 +104,19 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 	}
 
 	// Validated! Now apply the changes.
+	if class.KeyProperty == prop.Name {
+		class.KeyProperty = propNameAfterUpdate
+	}
 	prop.Name = propNameAfterUpdate
 	prop.Keywords = keywordsAfterUpdate
+	// like keywords, an omitted description or module config leaves the
+	// existing one in place
+	if property.Description != "" {
+		prop.Description = property.Description
+	}
+	if property.ModuleConfig != nil {
+		prop.ModuleConfig = property.ModuleConfig
+	}
 
 	err = m.saveSchema(ctx)
 	if err != nil {
@@ -126,6 +141,10 @@ func (m *Manager) UpdatePropertyAddDataType(ctx context.Context, principal *mode
 		return err
 	}
 
+	if err := checkNotFrozen(class); err != nil {
+		return err
+	}
+
 	prop, err := schema.GetPropertyByName(class, propName)
 	if err != nil {
 		return err
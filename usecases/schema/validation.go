@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/fatih/camelcase"
@@ -136,6 +137,202 @@ func validateWeight(keyword *models.KeywordsItems0) error {
 	return fmt.Errorf("weight must be between 0 and 1, but got %v", keyword.Weight)
 }
 
+func validatePropertyVectorWeight(property *models.Property) error {
+	if property.VectorWeight == nil || *property.VectorWeight >= 0 {
+		return nil
+	}
+
+	return fmt.Errorf("property '%s': vectorWeight must not be negative, but got %v",
+		property.Name, *property.VectorWeight)
+}
+
+// validatePropertyTokenization makes sure a tokenization is only set on data
+// types which are split into words before they are vectorized
+func validatePropertyTokenization(property *models.Property) error {
+	switch property.Tokenization {
+	case "":
+		return nil
+	case models.PropertyTokenizationWord, models.PropertyTokenizationWhitespace,
+		models.PropertyTokenizationField:
+	default:
+		return fmt.Errorf("property '%s': tokenization must be one of '%s', '%s' or '%s', but got '%s'",
+			property.Name, models.PropertyTokenizationWord, models.PropertyTokenizationWhitespace,
+			models.PropertyTokenizationField, property.Tokenization)
+	}
+
+	if len(property.DataType) != 1 || (property.DataType[0] != string(schema.DataTypeString) &&
+		property.DataType[0] != string(schema.DataTypeText)) {
+		return fmt.Errorf("property '%s': tokenization is only supported for the data types "+
+			"string and text", property.Name)
+	}
+
+	return nil
+}
+
+// validatePropertyConstraints makes sure value constraints only appear on
+// data types they can be applied to and that they can be satisfied at all
+func validatePropertyConstraints(property *models.Property) error {
+	var dataType schema.DataType
+	if len(property.DataType) == 1 {
+		dataType = schema.DataType(property.DataType[0])
+	}
+
+	numeric := dataType == schema.DataTypeInt || dataType == schema.DataTypeNumber
+	textual := dataType == schema.DataTypeString || dataType == schema.DataTypeText
+
+	if (property.Minimum != nil || property.Maximum != nil) && !numeric {
+		return fmt.Errorf("property '%s': minimum and maximum are only supported for the "+
+			"data types int and number", property.Name)
+	}
+
+	if property.Minimum != nil && property.Maximum != nil &&
+		*property.Minimum > *property.Maximum {
+		return fmt.Errorf("property '%s': minimum %v is greater than maximum %v",
+			property.Name, *property.Minimum, *property.Maximum)
+	}
+
+	if (property.MinLength != nil || property.MaxLength != nil || property.Pattern != "") &&
+		!textual {
+		return fmt.Errorf("property '%s': minLength, maxLength and pattern are only "+
+			"supported for the data types string and text", property.Name)
+	}
+
+	if property.MinLength != nil && *property.MinLength < 0 {
+		return fmt.Errorf("property '%s': minLength must not be negative, but got %d",
+			property.Name, *property.MinLength)
+	}
+
+	if property.MaxLength != nil && *property.MaxLength < 0 {
+		return fmt.Errorf("property '%s': maxLength must not be negative, but got %d",
+			property.Name, *property.MaxLength)
+	}
+
+	if property.MinLength != nil && property.MaxLength != nil &&
+		*property.MinLength > *property.MaxLength {
+		return fmt.Errorf("property '%s': minLength %d is greater than maxLength %d",
+			property.Name, *property.MinLength, *property.MaxLength)
+	}
+
+	if property.Pattern != "" {
+		if _, err := regexp.Compile(property.Pattern); err != nil {
+			return fmt.Errorf("property '%s': invalid pattern: %v", property.Name, err)
+		}
+	}
+
+	if property.MaxReferences != nil {
+		if len(property.DataType) == 0 || !schema.IsRefDataType(property.DataType) {
+			return fmt.Errorf("property '%s': maxReferences is only supported for "+
+				"reference properties", property.Name)
+		}
+
+		if *property.MaxReferences < 1 {
+			return fmt.Errorf("property '%s': maxReferences must be at least 1, but got %d",
+				property.Name, *property.MaxReferences)
+		}
+	}
+
+	return nil
+}
+
+// validatePropertyUnique makes sure unique constraints are only set on data
+// types whose values can be compared as a whole
+func validatePropertyUnique(property *models.Property) error {
+	if !property.Unique {
+		return nil
+	}
+
+	if len(property.DataType) == 1 {
+		switch schema.DataType(property.DataType[0]) {
+		case schema.DataTypeString, schema.DataTypeText, schema.DataTypeInt,
+			schema.DataTypeNumber, schema.DataTypeDate:
+			return nil
+		}
+	}
+
+	return fmt.Errorf("property '%s': unique is not supported for data type %v",
+		property.Name, property.DataType)
+}
+
+// validatePropertyIndexing makes sure indexSearchable is only set on string
+// and text properties and indexFilterable only on all other data types, see
+// schema.HasInvertedIndex
+func validatePropertyIndexing(property *models.Property) error {
+	if schema.IsWordIndexed(property.DataType) {
+		if property.IndexFilterable != nil {
+			return fmt.Errorf("property '%s': indexFilterable is not supported for "+
+				"data type %v, use indexSearchable instead", property.Name, property.DataType)
+		}
+
+		return nil
+	}
+
+	if property.IndexSearchable != nil {
+		return fmt.Errorf("property '%s': indexSearchable is only supported for "+
+			"string and text properties, use indexFilterable instead", property.Name)
+	}
+
+	return nil
+}
+
+// validateKeyProperty makes sure the key property of a class exists and has
+// a data type whose values can be used to derive an id from
+func validateKeyProperty(class *models.Class) error {
+	if class.KeyProperty == "" {
+		return nil
+	}
+
+	for _, property := range class.Properties {
+		if property.Name != class.KeyProperty {
+			continue
+		}
+
+		if len(property.DataType) == 1 {
+			switch schema.DataType(property.DataType[0]) {
+			case schema.DataTypeString, schema.DataTypeText, schema.DataTypeInt:
+				return nil
+			}
+		}
+
+		return fmt.Errorf("key property '%s' must be of data type string, text or int, "+
+			"got %v", property.Name, property.DataType)
+	}
+
+	return fmt.Errorf("key property '%s' is not a property of class '%s'",
+		class.KeyProperty, class.Class)
+}
+
+// MaxVectorIndexEF is the largest ef the vector index accepts, both as
+// efConstruction and as ef at query time
+const MaxVectorIndexEF = 4096
+
+// validateVectorIndexConfig makes sure all set hnsw parameters are within a
+// sensible range. Unset (zero) parameters are valid, they use the defaults of
+// the vector index.
+func validateVectorIndexConfig(cfg *models.VectorIndexConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if err := validateVectorIndexParam("efConstruction", cfg.EfConstruction, 4, MaxVectorIndexEF); err != nil {
+		return err
+	}
+
+	if err := validateVectorIndexParam("maxConnections", cfg.MaxConnections, 4, 256); err != nil {
+		return err
+	}
+
+	return validateVectorIndexParam("ef", cfg.Ef, 1, MaxVectorIndexEF)
+}
+
+func validateVectorIndexParam(name string, value, min, max int64) error {
+	if value == 0 || (value >= min && value <= max) {
+		return nil
+	}
+
+	return fmt.Errorf("vectorIndexConfig: %s must be between %d and %d, but got %d",
+		name, min, max, value)
+}
+
 // Check that the format of the name is correct
 // Check that the name is acceptable according to the contextionary
 func (m *Manager) validatePropertyNameAndKeywords(ctx context.Context, className string, propertyName string, keywords models.Keywords, vectorizeProperty bool) error {
@@ -998,6 +998,445 @@ func Test_AllUsablePropsNoindexed(t *testing.T) {
 	})
 }
 
+func Test_Validation_PropertyVectorWeight(t *testing.T) {
+	newClass := func(weight float32) *models.Class {
+		return &models.Class{
+			Class: "ValidName",
+			Properties: []*models.Property{{
+				DataType:     []string{"string"},
+				Name:         "name",
+				VectorWeight: &weight,
+			}},
+		}
+	}
+
+	t.Run("with a positive weight", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(2.5))
+		assert.Nil(t, err)
+		assert.Equal(t, float32(2.5), m.VectorWeight("ValidName", "name"))
+	})
+
+	t.Run("with a weight of zero", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(0))
+		assert.Nil(t, err)
+	})
+
+	t.Run("with a negative weight", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(-1))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("without a weight", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, &models.Class{
+			Class: "ValidName",
+			Properties: []*models.Property{{
+				DataType: []string{"string"},
+				Name:     "name",
+			}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, float32(1), m.VectorWeight("ValidName", "name"))
+	})
+
+	t.Run("adding a property with a negative weight", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(1))
+		require.Nil(t, err)
+
+		weight := float32(-0.5)
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType:     []string{"string"},
+			Name:         "description",
+			VectorWeight: &weight,
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Validation_PropertyTokenization(t *testing.T) {
+	newClass := func(dataType, tokenization string) *models.Class {
+		return &models.Class{
+			Class: "ValidName",
+			Properties: []*models.Property{{
+				DataType:     []string{dataType},
+				Name:         "sku",
+				Tokenization: tokenization,
+			}},
+		}
+	}
+
+	t.Run("without a tokenization", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", ""))
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationWord, m.Tokenization("ValidName", "sku"))
+	})
+
+	t.Run("with a valid tokenization", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("text", "field"))
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationField, m.Tokenization("ValidName", "sku"))
+	})
+
+	t.Run("with an invalid tokenization", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", "letters"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a tokenization on a non-text data type", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("int", "field"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("adding a property with an invalid tokenization", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", ""))
+		require.Nil(t, err)
+
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType:     []string{"number"},
+			Name:         "price",
+			Tokenization: "whitespace",
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Validation_PropertyConstraints(t *testing.T) {
+	ptFloat64 := func(in float64) *float64 { return &in }
+	ptInt64 := func(in int64) *int64 { return &in }
+
+	type test struct {
+		name     string
+		property *models.Property
+		valid    bool
+	}
+
+	tests := []test{
+		{
+			name: "minimum and maximum on an int",
+			property: &models.Property{DataType: []string{"int"}, Name: "age",
+				Minimum: ptFloat64(0), Maximum: ptFloat64(150)},
+			valid: true,
+		},
+		{
+			name: "equal minimum and maximum on a number",
+			property: &models.Property{DataType: []string{"number"}, Name: "age",
+				Minimum: ptFloat64(1.5), Maximum: ptFloat64(1.5)},
+			valid: true,
+		},
+		{
+			name: "minimum greater than maximum",
+			property: &models.Property{DataType: []string{"int"}, Name: "age",
+				Minimum: ptFloat64(10), Maximum: ptFloat64(5)},
+		},
+		{
+			name: "maximum on a string",
+			property: &models.Property{DataType: []string{"string"}, Name: "age",
+				Maximum: ptFloat64(5)},
+		},
+		{
+			name: "lengths and pattern on a string",
+			property: &models.Property{DataType: []string{"string"}, Name: "zip",
+				MinLength: ptInt64(6), MaxLength: ptInt64(6), Pattern: "^[0-9]{4}[A-Z]{2}$"},
+			valid: true,
+		},
+		{
+			name: "negative minLength",
+			property: &models.Property{DataType: []string{"text"}, Name: "zip",
+				MinLength: ptInt64(-1)},
+		},
+		{
+			name: "minLength greater than maxLength",
+			property: &models.Property{DataType: []string{"text"}, Name: "zip",
+				MinLength: ptInt64(7), MaxLength: ptInt64(6)},
+		},
+		{
+			name: "maxLength on an int",
+			property: &models.Property{DataType: []string{"int"}, Name: "zip",
+				MaxLength: ptInt64(6)},
+		},
+		{
+			name: "invalid pattern",
+			property: &models.Property{DataType: []string{"string"}, Name: "zip",
+				Pattern: "[0-9"},
+		},
+		{
+			name: "maxReferences on a string",
+			property: &models.Property{DataType: []string{"string"}, Name: "zip",
+				MaxReferences: ptInt64(10)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, &models.Class{
+				Class:      "ValidName",
+				Properties: []*models.Property{test.property},
+			})
+			if test.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+
+	t.Run("maxReferences on a reference property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, &models.Class{Class: "Target"})
+		require.Nil(t, err)
+
+		err = m.AddThing(context.Background(), nil, &models.Class{
+			Class: "ValidName",
+			Properties: []*models.Property{{
+				DataType:      []string{"Target"},
+				Name:          "targets",
+				MaxReferences: ptInt64(10),
+			}},
+		})
+		assert.Nil(t, err)
+
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType:      []string{"Target"},
+			Name:          "otherTargets",
+			MaxReferences: ptInt64(0),
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("adding a property with an invalid constraint", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, &models.Class{Class: "ValidName"})
+		require.Nil(t, err)
+
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType: []string{"boolean"},
+			Name:     "active",
+			Pattern:  "^true$",
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Validation_PropertyUnique(t *testing.T) {
+	newClass := func(dataType string) *models.Class {
+		return &models.Class{
+			Class: "ValidName",
+			Properties: []*models.Property{{
+				DataType: []string{dataType},
+				Name:     "email",
+				Unique:   true,
+			}},
+		}
+	}
+
+	t.Run("on a string property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("on a boolean property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("boolean"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("on a reference property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("ValidName"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("adding a unique geo property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string"))
+		require.Nil(t, err)
+
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType: []string{"geoCoordinates"},
+			Name:     "location",
+			Unique:   true,
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Validation_PropertyIndexing(t *testing.T) {
+	vFalse := false
+	newClass := func(prop *models.Property) *models.Class {
+		prop.Name = "notes"
+		return &models.Class{
+			Class:      "ValidName",
+			Properties: []*models.Property{prop},
+		}
+	}
+
+	t.Run("indexInverted on any property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(&models.Property{
+			DataType:      []string{"int"},
+			IndexInverted: &vFalse,
+		}))
+		assert.Nil(t, err)
+	})
+
+	t.Run("indexSearchable on a text property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(&models.Property{
+			DataType:        []string{"text"},
+			IndexSearchable: &vFalse,
+		}))
+		assert.Nil(t, err)
+	})
+
+	t.Run("indexSearchable on a date property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(&models.Property{
+			DataType:        []string{"date"},
+			IndexSearchable: &vFalse,
+		}))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("indexFilterable on a number property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(&models.Property{
+			DataType:        []string{"number"},
+			IndexFilterable: &vFalse,
+		}))
+		assert.Nil(t, err)
+	})
+
+	t.Run("adding a string property with indexFilterable", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass(&models.Property{
+			DataType: []string{"int"},
+		}))
+		require.Nil(t, err)
+
+		err = m.AddThingProperty(context.Background(), nil, "ValidName", &models.Property{
+			DataType:        []string{"string"},
+			Name:            "title",
+			IndexFilterable: &vFalse,
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Validation_KeyProperty(t *testing.T) {
+	newClass := func(dataType, keyProperty string) *models.Class {
+		return &models.Class{
+			Class:       "ValidName",
+			KeyProperty: keyProperty,
+			Properties: []*models.Property{{
+				DataType: []string{dataType},
+				Name:     "sku",
+			}},
+		}
+	}
+
+	t.Run("on a string property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", "sku"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("on an int property with an upper-case name", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("int", "Sku"))
+		require.Nil(t, err)
+		s := m.GetSchemaSkipAuth()
+		assert.Equal(t, "sku", s.FindClassByName("ValidName").KeyProperty)
+	})
+
+	t.Run("on a number property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("number", "sku"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("on a property that does not exist", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", "ean"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deleting the key property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", "sku"))
+		require.Nil(t, err)
+
+		err = m.DeleteThingProperty(context.Background(), nil, "ValidName", "sku")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("renaming the key property", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, newClass("string", "sku"))
+		require.Nil(t, err)
+
+		err = m.UpdateThingProperty(context.Background(), nil, "ValidName", "sku",
+			&models.Property{Name: "articleNumber"})
+		require.Nil(t, err)
+		s := m.GetSchemaSkipAuth()
+		assert.Equal(t, "articleNumber", s.FindClassByName("ValidName").KeyProperty)
+	})
+}
+
+func Test_Validation_VectorIndexConfig(t *testing.T) {
+	newClass := func(cfg *models.VectorIndexConfig) *models.Class {
+		return &models.Class{
+			Class:             "ValidName",
+			VectorIndexConfig: cfg,
+			Properties: []*models.Property{{
+				DataType: []string{"string"},
+				Name:     "name",
+			}},
+		}
+	}
+
+	type testCase struct {
+		name  string
+		cfg   *models.VectorIndexConfig
+		valid bool
+	}
+
+	tests := []testCase{
+		{name: "without a config", cfg: nil, valid: true},
+		{name: "with an empty config", cfg: &models.VectorIndexConfig{}, valid: true},
+		{
+			name:  "with all parameters in range",
+			cfg:   &models.VectorIndexConfig{EfConstruction: 256, MaxConnections: 32, Ef: 100},
+			valid: true,
+		},
+		{name: "with a too small efConstruction", cfg: &models.VectorIndexConfig{EfConstruction: 2}},
+		{name: "with a too large maxConnections", cfg: &models.VectorIndexConfig{MaxConnections: 1000}},
+		{name: "with a negative ef", cfg: &models.VectorIndexConfig{Ef: -1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, newClass(test.cfg))
+			if test.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func ptFalse() *bool {
 	f := false
 	return &f
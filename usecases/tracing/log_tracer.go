@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogTracer writes every finished span to the logger. It is meant for
+// debugging without running a tracing backend, the spans of a request can be
+// correlated through their trace_id.
+type LogTracer struct {
+	logger logrus.FieldLogger
+	now    func() time.Time
+}
+
+// NewLogTracer logs finished spans on level info
+func NewLogTracer(logger logrus.FieldLogger) *LogTracer {
+	return &LogTracer{logger: logger, now: time.Now}
+}
+
+// StartSpan starts a span which is logged once it is finished
+func (t *LogTracer) StartSpan(operationName string, parent *SpanContext) Span {
+	span := &logSpan{
+		tracer:    t,
+		operation: operationName,
+		started:   t.now(),
+		tags:      map[string]interface{}{},
+	}
+
+	span.context.SpanID = newID()
+	if parent != nil && parent.TraceID != "" {
+		span.context.TraceID = parent.TraceID
+		span.parentID = parent.SpanID
+	} else {
+		span.context.TraceID = newID()
+	}
+
+	return span
+}
+
+type logSpan struct {
+	sync.Mutex
+	tracer    *LogTracer
+	operation string
+	context   SpanContext
+	parentID  string
+	started   time.Time
+	tags      map[string]interface{}
+}
+
+func (s *logSpan) Context() SpanContext {
+	return s.context
+}
+
+func (s *logSpan) SetTag(key string, value interface{}) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.tags[key] = value
+}
+
+func (s *logSpan) Finish() {
+	s.Lock()
+	defer s.Unlock()
+
+	took := s.tracer.now().Sub(s.started)
+	s.tracer.logger.
+		WithField("action", "trace_span").
+		WithField("operation", s.operation).
+		WithField("trace_id", s.context.TraceID).
+		WithField("span_id", s.context.SpanID).
+		WithField("parent_span_id", s.parentID).
+		WithField("took", took).
+		WithField("tags", s.tags).
+		Info("span finished")
+}
+
+// newID returns a random 64 bit id in hex, the same format as used by most
+// OpenTracing implementations
+func newID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		// the ids only correlate log lines, a failing random source must not
+		// fail the request
+		return "0000000000000000"
+	}
+
+	return hex.EncodeToString(b)
+}
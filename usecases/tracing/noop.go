@@ -0,0 +1,26 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package tracing
+
+// NoopTracer discards all spans, it is the default tracer
+type NoopTracer struct{}
+
+// StartSpan returns a span that does nothing
+func (t NoopTracer) StartSpan(operationName string, parent *SpanContext) Span {
+	return noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (s noopSpan) Context() SpanContext                 { return SpanContext{} }
+func (s noopSpan) SetTag(key string, value interface{}) {}
+func (s noopSpan) Finish()                              {}
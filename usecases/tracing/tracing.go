@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package tracing provides request-scoped spans to find out where the time
+// of a request is spent, e.g. between the contextionary, bolt and the vector
+// index. The API follows OpenTracing, so that a full tracer can be plugged
+// in by implementing Tracer. The global tracer is a noop unless configured
+// otherwise, so there is no overhead when tracing is disabled.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TraceIDHeader is used to propagate the trace id across process boundaries,
+// both on incoming HTTP requests and on outgoing contextionary calls
+const TraceIDHeader = "X-Trace-Id"
+
+// SpanIDHeader propagates the id of the calling span on outgoing calls, so
+// that the callee can attach its spans as children
+const SpanIDHeader = "X-Span-Id"
+
+// SpanContext identifies a span within a trace
+type SpanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+// Span is a single timed operation within a trace
+type Span interface {
+	Context() SpanContext
+	SetTag(key string, value interface{})
+	Finish()
+}
+
+// Tracer creates spans. The parent is nil for the root span of a new trace.
+// A parent with only a TraceID set continues a trace that was started by a
+// different process.
+type Tracer interface {
+	StartSpan(operationName string, parent *SpanContext) Span
+}
+
+// New returns the tracer with the given name. An empty name or "noop"
+// disables tracing, "log" writes finished spans to the logger.
+func New(name string, logger logrus.FieldLogger) (Tracer, error) {
+	switch name {
+	case "", "noop":
+		return NoopTracer{}, nil
+	case "log":
+		return NewLogTracer(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown tracer %q, must be one of: noop, log", name)
+	}
+}
+
+var (
+	globalTracer Tracer = NoopTracer{}
+	globalLock   sync.RWMutex
+)
+
+// SetGlobalTracer replaces the tracer used by StartSpanFromContext, it is
+// meant to be called once on startup
+func SetGlobalTracer(tracer Tracer) {
+	globalLock.Lock()
+	defer globalLock.Unlock()
+
+	globalTracer = tracer
+}
+
+// GlobalTracer returns the configured tracer, NoopTracer by default
+func GlobalTracer() Tracer {
+	globalLock.RLock()
+	defer globalLock.RUnlock()
+
+	return globalTracer
+}
+
+type contextKey int
+
+const (
+	spanKey contextKey = iota
+	remoteParentKey
+)
+
+// StartSpanFromContext starts a span that is a child of the span in ctx, if
+// any. The returned context contains the new span and must be passed on to
+// calls that should appear as children. Callers must call Finish on the span.
+func StartSpanFromContext(ctx context.Context, operationName string) (Span, context.Context) {
+	tracer := GlobalTracer()
+	if _, ok := tracer.(NoopTracer); ok {
+		return noopSpan{}, ctx
+	}
+
+	span := tracer.StartSpan(operationName, parentFromContext(ctx))
+	return span, context.WithValue(ctx, spanKey, span)
+}
+
+// SpanFromContext returns the current span of ctx, or nil if there is none
+func SpanFromContext(ctx context.Context) Span {
+	span, _ := ctx.Value(spanKey).(Span)
+	return span
+}
+
+// ContextWithRemoteParent continues a trace started by a different process,
+// e.g. from the TraceIDHeader of an incoming request
+func ContextWithRemoteParent(ctx context.Context, parent SpanContext) context.Context {
+	return context.WithValue(ctx, remoteParentKey, parent)
+}
+
+func parentFromContext(ctx context.Context) *SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		parent := span.Context()
+		return &parent
+	}
+
+	if remote, ok := ctx.Value(remoteParentKey).(SpanContext); ok {
+		return &remote
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	tracer, err := New("", logger)
+	require.Nil(t, err)
+	assert.Equal(t, NoopTracer{}, tracer)
+
+	tracer, err = New("log", logger)
+	require.Nil(t, err)
+	assert.IsType(t, &LogTracer{}, tracer)
+
+	_, err = New("jaeger", logger)
+	assert.Equal(t, `unknown tracer "jaeger", must be one of: noop, log`, err.Error())
+}
+
+func TestNoopTracerLeavesContextUntouched(t *testing.T) {
+	SetGlobalTracer(NoopTracer{})
+
+	ctx := context.Background()
+	span, spanCtx := StartSpanFromContext(ctx, "some_operation")
+	span.SetTag("foo", "bar")
+	span.Finish()
+
+	assert.Equal(t, ctx, spanCtx)
+	assert.Nil(t, SpanFromContext(spanCtx))
+	assert.Equal(t, SpanContext{}, span.Context())
+}
+
+func TestLogTracer(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	tracer := NewLogTracer(logger)
+	clock := time.Unix(0, 0)
+	tracer.now = func() time.Time { return clock }
+	SetGlobalTracer(tracer)
+	defer SetGlobalTracer(NoopTracer{})
+
+	t.Run("a parent with a child span", func(t *testing.T) {
+		hook.Reset()
+
+		root, ctx := StartSpanFromContext(context.Background(), "http_request")
+		child, _ := StartSpanFromContext(ctx, "db.putObjectInTx")
+		child.SetTag("objects", 3)
+		clock = clock.Add(20 * time.Millisecond)
+		child.Finish()
+		root.Finish()
+
+		require.Len(t, hook.AllEntries(), 2)
+		childEntry := hook.AllEntries()[0]
+		rootEntry := hook.AllEntries()[1]
+
+		assert.Equal(t, logrus.InfoLevel, childEntry.Level)
+		assert.Equal(t, "db.putObjectInTx", childEntry.Data["operation"])
+		assert.Equal(t, 20*time.Millisecond, childEntry.Data["took"])
+		assert.Equal(t, map[string]interface{}{"objects": 3}, childEntry.Data["tags"])
+		assert.Equal(t, root.Context().TraceID, childEntry.Data["trace_id"])
+		assert.Equal(t, root.Context().SpanID, childEntry.Data["parent_span_id"])
+
+		assert.Equal(t, "http_request", rootEntry.Data["operation"])
+		assert.Equal(t, "", rootEntry.Data["parent_span_id"])
+		assert.NotEqual(t, root.Context().SpanID, child.Context().SpanID)
+	})
+
+	t.Run("continuing a remote trace", func(t *testing.T) {
+		hook.Reset()
+
+		ctx := ContextWithRemoteParent(context.Background(), SpanContext{
+			TraceID: "remote-trace",
+			SpanID:  "remote-span",
+		})
+		span, _ := StartSpanFromContext(ctx, "http_request")
+		span.Finish()
+
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, "remote-trace", hook.LastEntry().Data["trace_id"])
+		assert.Equal(t, "remote-span", hook.LastEntry().Data["parent_span_id"])
+	})
+}
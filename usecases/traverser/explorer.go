@@ -20,6 +20,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
 	libprojector "github.com/semi-technologies/weaviate/usecases/projector"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
 	"github.com/semi-technologies/weaviate/usecases/traverser/grouper"
 	"github.com/sirupsen/logrus"
@@ -36,6 +37,7 @@ type Explorer struct {
 	nnExtender  nnExtender
 	projector   projector
 	pathBuilder pathBuilder
+	explainer   explainer
 }
 
 type distancer func(a, b []float32) (float32, error)
@@ -44,7 +46,7 @@ type vectorClassSearch interface {
 	ClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, vector []float32, limit int,
-		filters *filters.LocalFilter) ([]search.Result, error)
+		filters *filters.LocalFilter, classNames []string) ([]search.Result, error)
 }
 
 type explorerRepo interface {
@@ -64,11 +66,17 @@ type pathBuilder interface {
 	CalculatePath(in []search.Result, params *sempath.Params) ([]search.Result, error)
 }
 
+type explainer interface {
+	Explain(ctx context.Context, in []search.Result,
+		searchVector []float32) ([]search.Result, error)
+}
+
 // NewExplorer with search and connector repo
 func NewExplorer(search vectorClassSearch, vectorizer CorpiVectorizer,
 	distancer distancer, logger logrus.FieldLogger, nnExtender nnExtender,
-	projector projector, pathBuilder pathBuilder) *Explorer {
-	return &Explorer{search, vectorizer, distancer, logger, nnExtender, projector, pathBuilder}
+	projector projector, pathBuilder pathBuilder, explainer explainer) *Explorer {
+	return &Explorer{search, vectorizer, distancer, logger, nnExtender, projector,
+		pathBuilder, explainer}
 }
 
 // GetClass from search and connector repo
@@ -90,6 +98,28 @@ func (e *Explorer) GetClass(ctx context.Context,
 
 func (e *Explorer) getClassExploration(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
+	if ef := params.Explore.EF; ef < 0 || ef > schemaUC.MaxVectorIndexEF {
+		return nil, fmt.Errorf("explorer: get class: ef must be between 1 and %d, but got %d",
+			schemaUC.MaxVectorIndexEF, ef)
+	}
+
+	if len(params.Explore.Vector) > 0 && len(params.Explore.Values) > 0 {
+		return nil, fmt.Errorf("explorer: get class: concepts and nearVector cannot be combined")
+	}
+
+	if err := validateRerankParams(params.Rerank); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if params.Sort != nil {
+		return nil, fmt.Errorf("explorer: get class: sort not possible on 'explore' " +
+			"queries, results are ordered by their certainty")
+	}
+
+	if err := validatePropertyBoosts(params.Explore); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
 	searchVector, err := e.vectorFromExploreParams(ctx, params.Explore)
 	if err != nil {
 		return nil, fmt.Errorf("explorer: get class: vectorize params: %v", err)
@@ -102,6 +132,15 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		return nil, fmt.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	if len(params.Explore.PropertyBoosts) > 0 {
+		boosted, err := e.boost(ctx, res, searchVector, params.Explore.PropertyBoosts)
+		if err != nil {
+			return nil, fmt.Errorf("boost: %v", err)
+		}
+
+		res = boosted
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -111,6 +150,15 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		res = grouped
 	}
 
+	if params.Rerank != nil {
+		reranked, err := e.rerank(res, searchVector, params.Rerank)
+		if err != nil {
+			return nil, fmt.Errorf("rerank: %v", err)
+		}
+
+		res = reranked
+	}
+
 	if params.UnderscoreProperties.NearestNeighbors {
 		withNN, err := e.nnExtender.Multi(ctx, res, nil)
 		if err != nil {
@@ -140,12 +188,34 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		res = withPath
 	}
 
-	return e.searchResultsToGetResponse(ctx, res, params.Explore.Certainty, searchVector)
+	if params.UnderscoreProperties.Interpretation {
+		explained, err := e.explainer.Explain(ctx, res, searchVector)
+		if err != nil {
+			return nil, fmt.Errorf("extend with interpretation: %v", err)
+		}
+
+		res = explained
+	}
+
+	return e.searchResultsToGetResponse(ctx, res, params.Explore.Certainty,
+		searchVector, params.UnderscoreProperties)
 }
 
 func (e *Explorer) getClassList(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
 
+	if params.Rerank != nil {
+		return nil, fmt.Errorf("rerank not possible on 'list' queries, only on 'explore' queries")
+	}
+
+	if params.UnderscoreProperties.Certainty || params.UnderscoreProperties.Distance {
+		return nil, fmt.Errorf("certainty and distance not possible on 'list' queries, only on 'explore' queries")
+	}
+
+	if err := filters.ValidateSort(params.Sort); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
 	res, err := e.search.ClassSearch(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("explorer: get class: search: %v", err)
@@ -182,15 +252,28 @@ func (e *Explorer) getClassList(ctx context.Context,
 		return nil, fmt.Errorf("semantic path not possible on 'list' queries, only on 'explore' queries")
 	}
 
-	return e.searchResultsToGetResponse(ctx, res, 0, nil)
+	return e.searchResultsToGetResponse(ctx, res, 0, nil, params.UnderscoreProperties)
 }
 
 func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	input []search.Result, requiredCertainty float64,
-	searchVector []float32) ([]interface{}, error) {
+	searchVector []float32, underscore UnderscoreProperties) ([]interface{}, error) {
 	output := make([]interface{}, 0, len(input))
 
 	for _, res := range input {
+		if searchVector != nil {
+			dist, err := e.distancer(res.Vector, searchVector)
+			if err != nil {
+				return nil, fmt.Errorf("explorer: calculate distance: %v", err)
+			}
+
+			if 1-(dist) < float32(requiredCertainty) {
+				continue
+			}
+
+			res = withCertaintyAndDistance(res, dist, underscore)
+		}
+
 		if res.UnderscoreProperties != nil {
 			if res.UnderscoreProperties.Classification != nil {
 				res.Schema.(map[string]interface{})["_classification"] = res.UnderscoreProperties.Classification
@@ -211,16 +294,13 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			if res.UnderscoreProperties.SemanticPath != nil {
 				res.Schema.(map[string]interface{})["_semanticPath"] = res.UnderscoreProperties.SemanticPath
 			}
-		}
 
-		if searchVector != nil {
-			dist, err := e.distancer(res.Vector, searchVector)
-			if err != nil {
-				return nil, fmt.Errorf("explorer: calculate distance: %v", err)
+			if res.UnderscoreProperties.Certainty != nil {
+				res.Schema.(map[string]interface{})["_certainty"] = *res.UnderscoreProperties.Certainty
 			}
 
-			if 1-(dist) < float32(requiredCertainty) {
-				continue
+			if res.UnderscoreProperties.Distance != nil {
+				res.Schema.(map[string]interface{})["_distance"] = *res.UnderscoreProperties.Distance
 			}
 		}
 
@@ -230,18 +310,55 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	return output, nil
 }
 
+// withCertaintyAndDistance sets the requested underscore props from the same
+// normalized distance that the required certainty is checked against, so
+// that clients can pick meaningful thresholds from the returned values
+func withCertaintyAndDistance(res search.Result, dist float32,
+	underscore UnderscoreProperties) search.Result {
+	if !underscore.Certainty && !underscore.Distance {
+		return res
+	}
+
+	up := &models.UnderscoreProperties{}
+	if res.UnderscoreProperties != nil {
+		copied := *res.UnderscoreProperties
+		up = &copied
+	}
+
+	if underscore.Certainty {
+		certainty := 1 - dist
+		up.Certainty = &certainty
+	}
+
+	if underscore.Distance {
+		up.Distance = &dist
+	}
+
+	res.UnderscoreProperties = up
+	return res
+}
+
 func (e *Explorer) Concepts(ctx context.Context,
 	params ExploreParams) ([]search.Result, error) {
 	if params.Network {
 		return nil, fmt.Errorf("explorer: network exploration currently not supported")
 	}
 
+	if len(params.Vector) > 0 && len(params.Values) > 0 {
+		return nil, fmt.Errorf("explorer: concepts and nearVector cannot be combined")
+	}
+
+	if len(params.Vector) == 0 && len(params.Values) == 0 {
+		return nil, fmt.Errorf("explorer: either concepts or nearVector must be set")
+	}
+
 	vector, err := e.vectorFromExploreParams(ctx, &params)
 	if err != nil {
 		return nil, fmt.Errorf("vectorize params: %v", err)
 	}
 
-	res, err := e.search.VectorSearch(ctx, vector, params.Limit, nil)
+	res, err := e.search.VectorSearch(ctx, vector, params.Limit, nil,
+		params.ClassNames)
 	if err != nil {
 		return nil, fmt.Errorf("vector search: %v", err)
 	}
@@ -253,6 +370,7 @@ func (e *Explorer) Concepts(ctx context.Context,
 		if err != nil {
 			return nil, fmt.Errorf("res %s: %v", item.Beacon, err)
 		}
+		item.Distance = dist
 		item.Certainty = 1 - dist
 		if item.Certainty >= float32(params.Certainty) {
 			results = append(results, item)
@@ -264,14 +382,25 @@ func (e *Explorer) Concepts(ctx context.Context,
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	params *ExploreParams) ([]float32, error) {
+	if err := validateExploreMove(params.MoveTo); err != nil {
+		return nil, fmt.Errorf("move to: %v", err)
+	}
 
-	vector, err := e.vectorizer.Corpi(ctx, params.Values)
-	if err != nil {
-		return nil, fmt.Errorf("vectorize keywords: %v", err)
+	if err := validateExploreMove(params.MoveAwayFrom); err != nil {
+		return nil, fmt.Errorf("move away from: %v", err)
+	}
+
+	vector := params.Vector
+	if len(vector) == 0 {
+		corpiVector, err := e.vectorizer.Corpi(ctx, params.Values)
+		if err != nil {
+			return nil, fmt.Errorf("vectorize keywords: %v", err)
+		}
+		vector = corpiVector
 	}
 
 	if params.MoveTo.Force > 0 && len(params.MoveTo.Values) > 0 {
-		moveToVector, err := e.vectorizer.Corpi(ctx, params.MoveTo.Values)
+		moveToVector, err := e.moveVector(ctx, params.MoveTo)
 		if err != nil {
 			return nil, fmt.Errorf("vectorize move to: %v", err)
 		}
@@ -284,7 +413,7 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	}
 
 	if params.MoveAwayFrom.Force > 0 && len(params.MoveAwayFrom.Values) > 0 {
-		moveAwayVector, err := e.vectorizer.Corpi(ctx, params.MoveAwayFrom.Values)
+		moveAwayVector, err := e.moveVector(ctx, params.MoveAwayFrom)
 		if err != nil {
 			return nil, fmt.Errorf("vectorize move away from: %v", err)
 		}
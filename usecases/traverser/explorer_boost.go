@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+)
+
+func validatePropertyBoosts(params *ExploreParams) error {
+	if len(params.PropertyBoosts) == 0 {
+		return nil
+	}
+
+	if len(params.Values) == 0 {
+		return fmt.Errorf("boost: property boosts require concepts, they cannot be " +
+			"combined with nearVector")
+	}
+
+	seen := map[string]struct{}{}
+	for _, boost := range params.PropertyBoosts {
+		if boost.Property == "" {
+			return fmt.Errorf("boost: property must be set")
+		}
+
+		if boost.Weight < 0 {
+			return fmt.Errorf("boost: weight of property '%s' must not be negative, but got %v",
+				boost.Property, boost.Weight)
+		}
+
+		if _, ok := seen[boost.Property]; ok {
+			return fmt.Errorf("boost: property '%s' is boosted more than once", boost.Property)
+		}
+		seen[boost.Property] = struct{}{}
+	}
+
+	return nil
+}
+
+// boost orders the results by the weighted mean of the certainties of their
+// individual text properties. Each property is vectorized on its own and
+// compared to the search vector, so a match in a boosted property counts
+// more than the same match in any other. A text without any word known to
+// the contextionary has a certainty of 0. Results without any weighted text
+// property fall back to the certainty of the whole object. The sort is
+// stable, so results with equal scores keep their vector search order.
+func (e *Explorer) boost(ctx context.Context, in []search.Result,
+	searchVector []float32, boosts []PropertyBoost) ([]search.Result, error) {
+	weights := map[string]float64{}
+	for _, boost := range boosts {
+		weights[boost.Property] = float64(boost.Weight)
+	}
+
+	vectors, err := e.vectorizeBoostedTexts(ctx, in, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]float64, len(in))
+	for i, res := range in {
+		score, err := e.boostedScore(res, searchVector, weights, vectors)
+		if err != nil {
+			return nil, fmt.Errorf("result %s: %v", res.ID, err)
+		}
+
+		scores[i] = score
+	}
+
+	positions := make([]int, len(in))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(a, b int) bool {
+		return scores[positions[a]] > scores[positions[b]]
+	})
+
+	out := make([]search.Result, len(in))
+	for i, pos := range positions {
+		out[i] = in[pos]
+	}
+
+	return out, nil
+}
+
+type weightedText struct {
+	prop   string
+	text   string
+	weight float64
+}
+
+// weightedTexts returns the non-empty text properties of the result which
+// have a weight, ordered by their name
+func weightedTexts(res search.Result, weights map[string]float64) []weightedText {
+	schema, _ := res.Schema.(map[string]interface{})
+	props := make([]string, 0, len(schema))
+	for prop := range schema {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	var out []weightedText
+	for _, prop := range props {
+		text, ok := schema[prop].(string)
+		if !ok || text == "" {
+			continue
+		}
+
+		weight, ok := weights[prop]
+		if !ok {
+			weight = 1
+		}
+		if weight == 0 {
+			continue
+		}
+
+		out = append(out, weightedText{prop: prop, text: text, weight: weight})
+	}
+
+	return out
+}
+
+// vectorizeBoostedTexts vectorizes each distinct text of all results exactly
+// once, as the same text is commonly repeated across results, e.g. in
+// categories. All texts are vectorized in a single batch. Texts without any
+// known word have a nil vector.
+func (e *Explorer) vectorizeBoostedTexts(ctx context.Context, in []search.Result,
+	weights map[string]float64) (map[string][]float32, error) {
+	vectors := map[string][]float32{}
+	var texts []weightedText
+	var corpi []string
+	for _, res := range in {
+		for _, text := range weightedTexts(res, weights) {
+			if _, ok := vectors[text.text]; ok {
+				continue
+			}
+
+			vectors[text.text] = nil
+			texts = append(texts, text)
+			corpi = append(corpi, text.text)
+		}
+	}
+
+	if len(corpi) == 0 {
+		return vectors, nil
+	}
+
+	results := e.vectorizer.BatchCorpi(ctx, corpi)
+	for i, text := range texts {
+		if err := results[i].Err; err != nil {
+			if _, ok := err.(vectorizer.ErrNoUsableWords); ok {
+				continue
+			}
+
+			return nil, fmt.Errorf("vectorize property '%s': %v", text.prop, err)
+		}
+
+		vectors[text.text] = results[i].Vector
+	}
+
+	return vectors, nil
+}
+
+func (e *Explorer) boostedScore(res search.Result, searchVector []float32,
+	weights map[string]float64, vectors map[string][]float32) (float64, error) {
+	var sum, totalWeight float64
+	for _, text := range weightedTexts(res, weights) {
+		totalWeight += text.weight
+
+		vector := vectors[text.text]
+		if vector == nil {
+			// without a single known word the text can't match at all
+			continue
+		}
+
+		dist, err := e.distancer(vector, searchVector)
+		if err != nil {
+			return 0, fmt.Errorf("calculate distance of property '%s': %v", text.prop, err)
+		}
+
+		sum += text.weight * float64(1-dist)
+	}
+
+	if totalWeight == 0 {
+		dist, err := e.distancer(res.Vector, searchVector)
+		if err != nil {
+			return 0, fmt.Errorf("calculate distance: %v", err)
+		}
+
+		return float64(1 - dist), nil
+	}
+
+	return sum / totalWeight, nil
+}
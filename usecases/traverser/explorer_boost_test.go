@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// textVectorizer vectorizes each text to a fixed vector, so that the
+// certainty of each property can be controlled by its text
+type textVectorizer struct {
+	fakeVectorizer
+	sync.Mutex
+	vectors map[string][]float32
+	calls   int
+}
+
+func (v *textVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
+	v.Lock()
+	defer v.Unlock()
+	v.calls++
+	if corpi[0] == "broken" {
+		return nil, fmt.Errorf("contextionary unavailable")
+	}
+
+	vector, ok := v.vectors[corpi[0]]
+	if !ok {
+		return nil, vectorizer.NewErrNoUsableWordsf("no known word in '%s'", corpi[0])
+	}
+
+	return vector, nil
+}
+
+func (v *textVectorizer) BatchCorpi(ctx context.Context,
+	corpi []string) []vectorizer.BatchResult {
+	out := make([]vectorizer.BatchResult, len(corpi))
+	for i := range corpi {
+		out[i].Vector, out[i].Err = v.Corpi(ctx, []string{corpi[i]})
+	}
+	return out
+}
+
+func Test_Explorer_PropertyBoosts(t *testing.T) {
+	// the certainty of a vector is its first dimension
+	distancer := func(a, b []float32) (float32, error) {
+		return 1 - a[0]*b[0], nil
+	}
+
+	result := func(name, title, body string, certainty float32) search.Result {
+		return search.Result{
+			Kind:   kind.Thing,
+			Vector: []float32{certainty},
+			Schema: map[string]interface{}{
+				"name":  name,
+				"title": title,
+				"body":  body,
+				"count": float64(7),
+			},
+		}
+	}
+
+	getClass := func(t *testing.T, vectorizer *textVectorizer, results []search.Result,
+		explore *ExploreParams) ([]interface{}, error) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("VectorClassSearch", mock.Anything).Return(results, nil)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, distancer, log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+		return explorer.GetClass(context.Background(), GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Explore:    explore,
+			Pagination: &filters.Pagination{Limit: 100},
+		})
+	}
+
+	names := func(res []interface{}) []string {
+		out := make([]string, len(res))
+		for i, r := range res {
+			out[i] = r.(map[string]interface{})["name"].(string)
+		}
+		return out
+	}
+
+	vectors := func() *textVectorizer {
+		return &textVectorizer{vectors: map[string][]float32{
+			"query":        {1},
+			"a":            {0.1},
+			"b":            {0.2},
+			"matching":     {0.9},
+			"not matching": {0.3},
+		}}
+	}
+
+	// a matches in the title, b in the body, the whole object of b is closer
+	results := func() []search.Result {
+		return []search.Result{
+			result("b", "not matching", "matching", 0.8),
+			result("a", "matching", "not matching", 0.7),
+		}
+	}
+
+	t.Run("without boosts the vector order is kept", func(t *testing.T) {
+		res, err := getClass(t, vectors(), results(), &ExploreParams{Values: []string{"query"}})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "a"}, names(res))
+	})
+
+	t.Run("boosting the title", func(t *testing.T) {
+		vectorizer := vectors()
+		res, err := getClass(t, vectorizer, results(), &ExploreParams{
+			Values:         []string{"query"},
+			PropertyBoosts: []PropertyBoost{{Property: "title", Weight: 3}},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, names(res))
+		// the concepts and each distinct text are vectorized exactly once
+		assert.Equal(t, 5, vectorizer.calls)
+	})
+
+	t.Run("boosting the body", func(t *testing.T) {
+		res, err := getClass(t, vectors(), results(), &ExploreParams{
+			Values:         []string{"query"},
+			PropertyBoosts: []PropertyBoost{{Property: "body", Weight: 3}},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "a"}, names(res))
+	})
+
+	t.Run("a weight of 0 ignores the property", func(t *testing.T) {
+		res, err := getClass(t, vectors(), results(), &ExploreParams{
+			Values: []string{"query"},
+			PropertyBoosts: []PropertyBoost{
+				{Property: "body", Weight: 0},
+				{Property: "name", Weight: 0},
+			},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, names(res))
+	})
+
+	t.Run("results without weighted text fall back to the object", func(t *testing.T) {
+		res, err := getClass(t, vectors(), results(), &ExploreParams{
+			Values: []string{"query"},
+			PropertyBoosts: []PropertyBoost{
+				{Property: "title", Weight: 0},
+				{Property: "body", Weight: 0},
+				{Property: "name", Weight: 0},
+			},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "a"}, names(res))
+	})
+
+	t.Run("with a negative weight", func(t *testing.T) {
+		_, err := getClass(t, vectors(), nil, &ExploreParams{
+			Values:         []string{"query"},
+			PropertyBoosts: []PropertyBoost{{Property: "title", Weight: -1}},
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "weight of property 'title' must not be negative")
+	})
+
+	t.Run("with a property boosted twice", func(t *testing.T) {
+		_, err := getClass(t, vectors(), nil, &ExploreParams{
+			Values: []string{"query"},
+			PropertyBoosts: []PropertyBoost{
+				{Property: "title", Weight: 1},
+				{Property: "title", Weight: 2},
+			},
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "property 'title' is boosted more than once")
+	})
+
+	t.Run("with a nearVector", func(t *testing.T) {
+		_, err := getClass(t, vectors(), nil, &ExploreParams{
+			Vector:         []float32{1},
+			PropertyBoosts: []PropertyBoost{{Property: "title", Weight: 2}},
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "property boosts require concepts")
+	})
+
+	t.Run("a value without known words scores 0", func(t *testing.T) {
+		res, err := getClass(t, vectors(), []search.Result{
+			result("a", "unknown", "not matching", 0.9),
+			result("b", "not matching", "not matching", 0.1),
+		}, &ExploreParams{
+			Values:         []string{"query"},
+			PropertyBoosts: []PropertyBoost{{Property: "title", Weight: 2}},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "a"}, names(res))
+	})
+
+	t.Run("when a property cannot be vectorized", func(t *testing.T) {
+		_, err := getClass(t, vectors(), []search.Result{
+			result("a", "broken", "matching", 0.8),
+		}, &ExploreParams{
+			Values:         []string{"query"},
+			PropertyBoosts: []PropertyBoost{{Property: "title", Weight: 2}},
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vectorize property 'title'")
+	})
+}
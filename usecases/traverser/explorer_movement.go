@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+)
+
+func validateExploreMove(move ExploreMove) error {
+	if move.Force < 0 || move.Force > 1 {
+		return fmt.Errorf("force must be between 0 and 1, but got %v", move.Force)
+	}
+
+	if len(move.Weights) == 0 {
+		return nil
+	}
+
+	if len(move.Weights) != len(move.Values) {
+		return fmt.Errorf("got %d weights for %d concepts, every concept needs a weight",
+			len(move.Weights), len(move.Values))
+	}
+
+	for i, weight := range move.Weights {
+		if weight < 0 || weight > 1 {
+			return fmt.Errorf("weight of concept '%s' must be between 0 and 1, but got %v",
+				move.Values[i], weight)
+		}
+	}
+
+	return nil
+}
+
+// moveVector is the target of a movement. Without weights, all concepts are
+// vectorized together as a single corpus. With weights it is the weighted
+// mean of the concepts, each concept is vectorized on its own, so a concept
+// that is not part of the contextionary fails the movement instead of being
+// silently ignored.
+func (e *Explorer) moveVector(ctx context.Context, move ExploreMove) ([]float32, error) {
+	if len(move.Weights) == 0 {
+		return e.vectorizer.Corpi(ctx, move.Values)
+	}
+
+	return e.vectorizer.WeightedCorpi(ctx, move.Values, move.Weights)
+}
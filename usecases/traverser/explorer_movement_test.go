@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateExploreMove(t *testing.T) {
+	tests := []struct {
+		name        string
+		move        ExploreMove
+		expectedErr string
+	}{
+		{
+			name: "no movement",
+		},
+		{
+			name: "without weights",
+			move: ExploreMove{Values: []string{"company"}, Force: 0.8},
+		},
+		{
+			name: "with a weight per concept",
+			move: ExploreMove{
+				Values:  []string{"company", "technology"},
+				Force:   0.8,
+				Weights: []float32{1, 0.5},
+			},
+		},
+		{
+			name:        "with a force above 1",
+			move:        ExploreMove{Values: []string{"company"}, Force: 1.5},
+			expectedErr: "force must be between 0 and 1, but got 1.5",
+		},
+		{
+			name: "with a weight missing",
+			move: ExploreMove{
+				Values:  []string{"company", "technology"},
+				Force:   0.8,
+				Weights: []float32{1},
+			},
+			expectedErr: "got 1 weights for 2 concepts, every concept needs a weight",
+		},
+		{
+			name: "with a negative weight",
+			move: ExploreMove{
+				Values:  []string{"company", "technology"},
+				Force:   0.8,
+				Weights: []float32{1, -0.5},
+			},
+			expectedErr: "weight of concept 'technology' must be between 0 and 1, but got -0.5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExploreMove(test.move)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func Test_Explorer_InvalidMovement(t *testing.T) {
+	params := GetParams{
+		Kind:       kind.Thing,
+		ClassName:  "BestClass",
+		Pagination: &filters.Pagination{Limit: 100},
+		Explore: &ExploreParams{
+			Values: []string{"apple"},
+			MoveAwayFrom: ExploreMove{
+				Values:  []string{"fruit"},
+				Force:   0.8,
+				Weights: []float32{2},
+			},
+		},
+	}
+
+	log, _ := test.NewNullLogger()
+	explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{}, newFakeDistancer(),
+		log, &fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+	_, err := explorer.GetClass(context.Background(), params)
+	assert.EqualError(t, err, "explorer: get class: vectorize params: move away from: "+
+		"weight of concept 'fruit' must be between 0 and 1, but got 2")
+}
+
+func Test_Explorer_MoveVector(t *testing.T) {
+	explorer := &Explorer{vectorizer: &fakeMovementVectorizer{}}
+
+	t.Run("without weights all concepts are vectorized together", func(t *testing.T) {
+		vector, err := explorer.moveVector(context.Background(), ExploreMove{
+			Values: []string{"company", "technology"},
+			Force:  0.8,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 1, 1}, vector)
+	})
+
+	t.Run("with weights the concepts are vectorized on their own", func(t *testing.T) {
+		vector, err := explorer.moveVector(context.Background(), ExploreMove{
+			Values:  []string{"company", "technology"},
+			Force:   0.8,
+			Weights: []float32{1, 0.5},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{2, 2, 2}, vector)
+	})
+}
+
+type fakeMovementVectorizer struct {
+	fakeVectorizer
+}
+
+func (f *fakeMovementVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
+	return []float32{1, 1, 1}, nil
+}
+
+func (f *fakeMovementVectorizer) WeightedCorpi(ctx context.Context, corpi []string,
+	weights []float32) ([]float32, error) {
+	return []float32{2, 2, 2}, nil
+}
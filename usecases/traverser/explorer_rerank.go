@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+func validateRerankParams(params *RerankParams) error {
+	if params == nil {
+		return nil
+	}
+
+	if params.Property == "" {
+		return fmt.Errorf("rerank: property must be set")
+	}
+
+	if params.Weight < 0 || params.Weight > 1 {
+		return fmt.Errorf("rerank: weight must be between 0 and 1, but got %v", params.Weight)
+	}
+
+	return nil
+}
+
+// rerank orders the results by a blend of their certainty and the value of
+// the rerank property. As the property can have any range, its values are
+// normalized to [0,1] across the results first. Results without a numeric
+// value for the property score as if they had the lowest value. The sort is
+// stable, so results with equal scores keep their vector search order.
+func (e *Explorer) rerank(in []search.Result, searchVector []float32,
+	params *RerankParams) ([]search.Result, error) {
+	certainties := make([]float64, len(in))
+	values := make([]float64, len(in))
+	present := make([]bool, len(in))
+	var min, max float64
+	found := false
+
+	for i, res := range in {
+		dist, err := e.distancer(res.Vector, searchVector)
+		if err != nil {
+			return nil, fmt.Errorf("calculate distance: %v", err)
+		}
+		certainties[i] = float64(1 - dist)
+
+		value, ok := numericProperty(res, params.Property)
+		if !ok {
+			continue
+		}
+
+		values[i] = value
+		present[i] = true
+		if !found || value < min {
+			min = value
+		}
+		if !found || value > max {
+			max = value
+		}
+		found = true
+	}
+
+	weight := float64(params.Weight)
+	scores := make([]float64, len(in))
+	for i := range in {
+		var normalized float64
+		if present[i] {
+			if max > min {
+				normalized = (values[i] - min) / (max - min)
+			} else {
+				normalized = 1
+			}
+		}
+
+		scores[i] = (1-weight)*certainties[i] + weight*normalized
+	}
+
+	positions := make([]int, len(in))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(a, b int) bool {
+		return scores[positions[a]] > scores[positions[b]]
+	})
+
+	out := make([]search.Result, len(in))
+	for i, pos := range positions {
+		out[i] = in[pos]
+	}
+
+	return out, nil
+}
+
+func numericProperty(res search.Result, prop string) (float64, bool) {
+	schema, ok := res.Schema.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+
+	switch v := schema[prop].(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
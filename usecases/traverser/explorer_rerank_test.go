@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_Rerank(t *testing.T) {
+	// the certainty of each result is the first dimension of its vector
+	distancer := func(a, b []float32) (float32, error) {
+		return 1 - a[0], nil
+	}
+
+	result := func(name string, certainty float32, popularity interface{}) search.Result {
+		schema := map[string]interface{}{"name": name}
+		if popularity != nil {
+			schema["popularity"] = popularity
+		}
+
+		return search.Result{Kind: kind.Thing, Vector: []float32{certainty}, Schema: schema}
+	}
+
+	getClass := func(t *testing.T, results []search.Result,
+		rerank *RerankParams) ([]interface{}, error) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("VectorClassSearch", mock.Anything).Return(results, nil)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, distancer, log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+		return explorer.GetClass(context.Background(), GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Explore:    &ExploreParams{Vector: []float32{1}},
+			Pagination: &filters.Pagination{Limit: 100},
+			Rerank:     rerank,
+		})
+	}
+
+	names := func(res []interface{}) []string {
+		out := make([]string, len(res))
+		for i, r := range res {
+			out[i] = r.(map[string]interface{})["name"].(string)
+		}
+		return out
+	}
+
+	t.Run("blending in the property", func(t *testing.T) {
+		res, err := getClass(t, []search.Result{
+			result("a", 0.9, float64(1)),
+			result("b", 0.8, float64(100)),
+			result("c", 0.7, int64(50)),
+		}, &RerankParams{Property: "popularity", Weight: 0.5})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "c", "a"}, names(res))
+	})
+
+	t.Run("with a weight of 0 the vector order is kept", func(t *testing.T) {
+		res, err := getClass(t, []search.Result{
+			result("a", 0.9, float64(1)),
+			result("b", 0.8, float64(100)),
+		}, &RerankParams{Property: "popularity", Weight: 0})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, names(res))
+	})
+
+	t.Run("equal scores keep their vector order", func(t *testing.T) {
+		res, err := getClass(t, []search.Result{
+			result("a", 0.5, float64(3)),
+			result("b", 0.5, float64(7)),
+			result("c", 0.5, float64(7)),
+			result("d", 0.5, float64(3)),
+		}, &RerankParams{Property: "popularity", Weight: 1})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "c", "a", "d"}, names(res))
+	})
+
+	t.Run("results without the property score lowest", func(t *testing.T) {
+		res, err := getClass(t, []search.Result{
+			result("a", 0.9, nil),
+			result("b", 0.8, float64(5)),
+			result("c", 0.7, "not a number"),
+		}, &RerankParams{Property: "popularity", Weight: 1})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"b", "a", "c"}, names(res))
+	})
+
+	t.Run("with an invalid weight", func(t *testing.T) {
+		_, err := getClass(t, nil, &RerankParams{Property: "popularity", Weight: 1.5})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "weight must be between 0 and 1")
+	})
+
+	t.Run("on a list query", func(t *testing.T) {
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(&fakeVectorSearcher{}, &fakeVectorizer{}, distancer, log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+		_, err := explorer.GetClass(context.Background(), GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Rerank:    &RerankParams{Property: "popularity", Weight: 0.5},
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "rerank not possible on 'list' queries")
+	})
+}
@@ -27,6 +27,86 @@ import (
 )
 
 func Test_Explorer_GetClass(t *testing.T) {
+	t.Run("when the explore param has an invalid ef", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Values: []string{"foo"},
+				EF:     -1,
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, &fakeVectorizer{}, newFakeDistancer(), log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "ef must be between 1 and 4096")
+		search.AssertNotCalled(t, "VectorClassSearch")
+	})
+
+	t.Run("when the explore param combines concepts and a nearVector", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Values: []string{"foo"},
+				Vector: []float32{0.4, 0.5, 0.6},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, &fakeVectorizer{}, newFakeDistancer(), log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "concepts and nearVector cannot be combined")
+	})
+
+	t.Run("when a nearVector is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Vector: []float32{0.4, 0.5, 0.6},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, &fakeVectorizer{}, newFakeDistancer(), log,
+			&fakeExtender{}, &fakeProjector{}, &fakePathBuilder{}, &fakeExplainer{})
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.4, 0.5, 0.6}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+		require.Len(t, res, 1)
+		assert.Equal(t, map[string]interface{}{"name": "Foo"}, res[0])
+	})
+
 	t.Run("when an explore param is set", func(t *testing.T) {
 		params := GetParams{
 			Kind:      kind.Thing,
@@ -61,7 +141,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 		log, _ := test.NewNullLogger()
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = []float32{1, 2, 3}
 		search.
@@ -118,7 +199,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = []float32{1, 2, 3}
 		search.
@@ -137,6 +219,117 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when an explore param is set and _certainty and _distance are set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Values:    []string{"foo"},
+				Certainty: 0.7,
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+			UnderscoreProperties: UnderscoreProperties{
+				Certainty: true,
+				Distance:  true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		extender := &fakeExtender{}
+		log, _ := test.NewNullLogger()
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		distancer := func(a, b []float32) (float32, error) {
+			return 0.25, nil
+		}
+		explorer := NewExplorer(search, vectorizer, distancer, log, extender, projector, pathBuilder, explainer)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{1, 2, 3}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain the certainty and distance", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name":       "Foo",
+					"_certainty": float32(0.75),
+					"_distance":  float32(0.25),
+				}, res[0])
+		})
+	})
+
+	t.Run("when no explore param is set, but _certainty is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+			UnderscoreProperties: UnderscoreProperties{
+				Certainty: true,
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		extender := &fakeExtender{}
+		log, _ := test.NewNullLogger()
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err,
+			"certainty and distance not possible on 'list' queries, only on 'explore' queries")
+	})
+
+	t.Run("when no explore param is set, but the sort property is invalid", func(t *testing.T) {
+		params := GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			Sort: &filters.Sort{
+				Property: "name",
+				Order:    filters.SortOrderAsc,
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		extender := &fakeExtender{}
+		log, _ := test.NewNullLogger()
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err,
+			"explorer: get class: sorting is only possible on '_creationTimeUnix', but got 'name'")
+	})
+
 	t.Run("when no explore param is set", func(t *testing.T) {
 		params := GetParams{
 			Kind:       kind.Thing,
@@ -168,7 +361,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 		log, _ := test.NewNullLogger()
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = nil
 		search.
@@ -237,7 +431,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 		log, _ := test.NewNullLogger()
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = nil
 		search.
@@ -315,7 +510,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 		log, _ := test.NewNullLogger()
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = nil
 		search.
@@ -422,7 +618,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 		}
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = nil
 		searcher.
@@ -526,7 +723,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 			},
 		}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = nil
 		searcher.
@@ -653,7 +851,8 @@ func Test_Explorer_GetClass(t *testing.T) {
 				},
 			},
 		}
-		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		expectedParamsToSearch := params
 		expectedParamsToSearch.SearchVector = []float32{1, 2, 3}
 		searcher.
@@ -715,6 +914,96 @@ func Test_Explorer_GetClass(t *testing.T) {
 				}, res[1])
 		})
 	})
+
+	t.Run("when the _interpretation prop is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+			UnderscoreProperties: UnderscoreProperties{
+				Interpretation: true,
+			},
+			Explore: &ExploreParams{
+				Values: []string{"foobar"},
+			},
+		}
+
+		interpretation := &models.Interpretation{
+			Source: []*models.InterpretationSource{
+				{Concept: "foo", Weight: 1, Occurrence: 100},
+				{Concept: "bar", Weight: 1, Occurrence: 100},
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+				UnderscoreProperties: &models.UnderscoreProperties{
+					Interpretation: interpretation,
+				},
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{
+			returnArgs: []search.Result{
+				{
+					Kind: kind.Thing,
+					ID:   "id1",
+					Schema: map[string]interface{}{
+						"name": "Foo",
+					},
+					UnderscoreProperties: &models.UnderscoreProperties{
+						Interpretation: &models.Interpretation{
+							Source: interpretation.Source,
+							Contributions: []*models.InterpretationContribution{
+								{Concept: "foo", Weight: 0.8},
+								{Concept: "bar", Weight: 0.3},
+							},
+						},
+					},
+				},
+			},
+		}
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{1, 2, 3}
+		searcher.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("class search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			searcher.AssertExpectations(t)
+		})
+
+		t.Run("response must contain the contributions", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+					"_interpretation": &models.Interpretation{
+						Source: interpretation.Source,
+						Contributions: []*models.InterpretationContribution{
+							{Concept: "foo", Weight: 0.8},
+							{Concept: "bar", Weight: 0.3},
+						},
+					},
+				}, res[0])
+		})
+	})
 }
 
 func newFakeDistancer() func(a, b []float32) (float32, error) {
@@ -24,6 +24,7 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
 	libprojector "github.com/semi-technologies/weaviate/usecases/projector"
 	"github.com/semi-technologies/weaviate/usecases/sempath"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -94,6 +95,20 @@ func (f *fakeVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32,
 	return []float32{1, 2, 3}, nil
 }
 
+func (f *fakeVectorizer) BatchCorpi(ctx context.Context,
+	corpi []string) []vectorizer.BatchResult {
+	out := make([]vectorizer.BatchResult, len(corpi))
+	for i := range corpi {
+		out[i].Vector, out[i].Err = f.Corpi(ctx, []string{corpi[i]})
+	}
+	return out
+}
+
+func (f *fakeVectorizer) WeightedCorpi(ctx context.Context, corpi []string,
+	weights []float32) ([]float32, error) {
+	return []float32{1, 2, 3}, nil
+}
+
 func (f *fakeVectorizer) MoveTo(source []float32, target []float32, weight float32) ([]float32, error) {
 	res := make([]float32, len(source), len(source))
 	for i, v := range source {
@@ -116,15 +131,18 @@ func (f *fakeVectorizer) NormalizedDistance(source, target []float32) (float32,
 
 type fakeVectorSearcher struct {
 	mock.Mock
-	calledWithVector []float32
-	calledWithLimit  int
-	results          []search.Result
+	calledWithVector     []float32
+	calledWithLimit      int
+	calledWithClassNames []string
+	results              []search.Result
 }
 
 func (f *fakeVectorSearcher) VectorSearch(ctx context.Context,
-	vector []float32, limit int, filters *filters.LocalFilter) ([]search.Result, error) {
+	vector []float32, limit int, filters *filters.LocalFilter,
+	classNames []string) ([]search.Result, error) {
 	f.calledWithVector = vector
 	f.calledWithLimit = limit
+	f.calledWithClassNames = classNames
 	return f.results, nil
 }
 
@@ -134,6 +152,18 @@ func (f *fakeVectorSearcher) Aggregate(ctx context.Context,
 	return args.Get(0).(*aggregation.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) Facet(ctx context.Context,
+	params FacetParams) (*aggregation.Facet, error) {
+	args := f.Called(params)
+	return args.Get(0).(*aggregation.Facet), args.Error(1)
+}
+
+func (f *fakeVectorSearcher) Count(ctx context.Context, k kind.Kind, className string,
+	filters *filters.LocalFilter) (int64, error) {
+	args := f.Called(k, className, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (f *fakeVectorSearcher) VectorClassSearch(ctx context.Context,
 	params GetParams) ([]search.Result, error) {
 	args := f.Called(params)
@@ -201,7 +231,8 @@ func (f *fakeVectorRepo) PutAction(ctx context.Context, index string,
 	return nil
 }
 func (f *fakeVectorRepo) VectorSearch(ctx context.Context,
-	vector []float32, limit int, filters *filters.LocalFilter) ([]search.Result, error) {
+	vector []float32, limit int, filters *filters.LocalFilter,
+	classNames []string) ([]search.Result, error) {
 	return nil, nil
 }
 
@@ -211,6 +242,18 @@ func (f *fakeVectorRepo) Aggregate(ctx context.Context,
 	return args.Get(0).(*aggregation.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) Facet(ctx context.Context,
+	params FacetParams) (*aggregation.Facet, error) {
+	args := f.Called(params)
+	return args.Get(0).(*aggregation.Facet), args.Error(1)
+}
+
+func (f *fakeVectorRepo) Count(ctx context.Context, k kind.Kind, className string,
+	filters *filters.LocalFilter) (int64, error) {
+	args := f.Called(k, className, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (f *fakeVectorRepo) GetThing(ctx context.Context, uuid strfmt.UUID,
 	res *models.Thing) error {
 	args := f.Called(uuid)
@@ -266,3 +309,12 @@ type fakePathBuilder struct {
 func (f *fakePathBuilder) CalculatePath(in []search.Result, params *sempath.Params) ([]search.Result, error) {
 	return f.returnArgs, nil
 }
+
+type fakeExplainer struct {
+	returnArgs []search.Result
+}
+
+func (f *fakeExplainer) Explain(ctx context.Context, in []search.Result,
+	searchVector []float32) ([]search.Result, error) {
+	return f.returnArgs, nil
+}
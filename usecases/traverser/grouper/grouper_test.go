@@ -116,7 +116,7 @@ func TestGrouper_ModeMerge(t *testing.T) {
 			Vector: []float32{0.1, 0.1, 0.96},
 			Schema: map[string]interface{}{
 				"name":    "A2",
-				"count":   11.0,
+				"count":   int64(11), // int props are read as int64
 				"illegal": true,
 			},
 		},
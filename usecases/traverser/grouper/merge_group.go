@@ -152,7 +152,7 @@ func valueTypeOf(in interface{}) valueType {
 	switch in.(type) {
 	case string:
 		return textual
-	case float64:
+	case float64, int64:
 		return numerical
 	case bool:
 		return boolean
@@ -193,12 +193,15 @@ func mergeTextualProps(in []interface{}) (string, error) {
 func mergeNumericalProps(in []interface{}) (float64, error) {
 	var sum float64
 	for i, elem := range in {
-		asFloat, ok := elem.(float64)
-		if !ok {
+		switch typed := elem.(type) {
+		case float64:
+			sum += typed
+		case int64:
+			// int props are read from the storage as int64
+			sum += float64(typed)
+		default:
 			return 0, fmt.Errorf("element %d: expected numerical element to be float64, but got %T", i, elem)
 		}
-
-		sum += asFloat
 	}
 
 	return sum / float64(len(in)), nil
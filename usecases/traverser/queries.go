@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RunningQuery describes a traverser query which has not finished yet
+type RunningQuery struct {
+	ID        string
+	Username  string
+	Operation string
+	ClassName string
+	Started   time.Time
+}
+
+type runningQuery struct {
+	RunningQuery
+	cancel context.CancelFunc
+}
+
+// queryRegistry keeps track of all in-flight queries, so that they can be
+// inspected and canceled by an operator
+type queryRegistry struct {
+	sync.Mutex
+	queries map[string]*runningQuery
+	now     func() time.Time
+}
+
+func newQueryRegistry() *queryRegistry {
+	return &queryRegistry{
+		queries: map[string]*runningQuery{},
+		now:     time.Now,
+	}
+}
+
+// register the query and derive a cancelable context from ctx, which must be
+// used for the query. The returned func must be called once the query
+// finished.
+func (r *queryRegistry) register(ctx context.Context, principal *models.Principal,
+	operation, className string) (context.Context, func()) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		// the query can still run, it just can't be listed or canceled
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	q := &runningQuery{
+		RunningQuery: RunningQuery{
+			ID:        id.String(),
+			Operation: operation,
+			ClassName: className,
+			Started:   r.now(),
+		},
+		cancel: cancel,
+	}
+	if principal != nil {
+		q.Username = principal.Username
+	}
+
+	r.Lock()
+	r.queries[q.ID] = q
+	r.Unlock()
+
+	return ctx, func() {
+		r.Lock()
+		delete(r.queries, q.ID)
+		r.Unlock()
+		cancel()
+	}
+}
+
+func (r *queryRegistry) list() []RunningQuery {
+	r.Lock()
+	defer r.Unlock()
+
+	out := make([]RunningQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		out = append(out, q.RunningQuery)
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Started.Before(out[b].Started)
+	})
+
+	return out
+}
+
+func (r *queryRegistry) cancel(id string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	q, ok := r.queries[id]
+	if !ok {
+		return false
+	}
+
+	q.cancel()
+	return true
+}
+
+// ListQueries returns all in-flight queries, the longest running first
+func (t *Traverser) ListQueries(ctx context.Context,
+	principal *models.Principal) ([]RunningQuery, error) {
+	err := t.authorizer.Authorize(principal, "list", "traversal/queries")
+	if err != nil {
+		return nil, err
+	}
+
+	return t.queries.list(), nil
+}
+
+// CancelQuery cancels the context of an in-flight query. The query ends with
+// an error as soon as the underlying repo notices the cancellation.
+func (t *Traverser) CancelQuery(ctx context.Context, principal *models.Principal,
+	id string) error {
+	err := t.authorizer.Authorize(principal, "delete", fmt.Sprintf("traversal/queries/%s", id))
+	if err != nil {
+		return err
+	}
+
+	if !t.queries.cancel(id) {
+		return NewErrNotFound("no running query with id '%s'", id)
+	}
+
+	return nil
+}
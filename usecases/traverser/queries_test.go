@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingExplorer only returns once the context of the query is canceled
+type blockingExplorer struct{}
+
+func (e *blockingExplorer) GetClass(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (e *blockingExplorer) Concepts(ctx context.Context,
+	params ExploreParams) ([]search.Result, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func Test_Traverser_RunningQueries(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, &fakeVectorizer{}, &fakeVectorSearcher{},
+		&blockingExplorer{}, nil)
+	principal := &models.Principal{Username: "alice"}
+	ctx := context.Background()
+
+	errs := make(chan error)
+	go func() {
+		_, err := traverser.GetClass(ctx, principal, GetParams{ClassName: "Car"})
+		errs <- err
+	}()
+
+	var queries []RunningQuery
+	require.Eventually(t, func() bool {
+		var err error
+		queries, err = traverser.ListQueries(ctx, nil)
+		require.Nil(t, err)
+		return len(queries) == 1
+	}, time.Second, time.Millisecond)
+
+	t.Run("the running query is listed", func(t *testing.T) {
+		assert.Equal(t, "alice", queries[0].Username)
+		assert.Equal(t, "Get", queries[0].Operation)
+		assert.Equal(t, "Car", queries[0].ClassName)
+		assert.Len(t, queries[0].ID, 36)
+	})
+
+	t.Run("canceling an unknown query", func(t *testing.T) {
+		err := traverser.CancelQuery(ctx, nil, "not-a-query")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("canceling the running query", func(t *testing.T) {
+		err := traverser.CancelQuery(ctx, nil, queries[0].ID)
+		require.Nil(t, err)
+
+		select {
+		case err := <-errs:
+			assert.Equal(t, context.Canceled, err)
+		case <-time.After(time.Second):
+			t.Fatal("query was not canceled")
+		}
+
+		remaining, err := traverser.ListQueries(ctx, nil)
+		require.Nil(t, err)
+		assert.Len(t, remaining, 0)
+	})
+}
@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/requestid"
+	"github.com/sirupsen/logrus"
+)
+
+// slowQueryLog logs the queries which take longer than the threshold. Only
+// the shape of a query is logged, filter values, search terms and the
+// contents of the results are left out. The description of a query is only
+// built once it turned out to be slow, so fast queries merely read the clock
+// twice.
+type slowQueryLog struct {
+	logger    logrus.FieldLogger
+	threshold time.Duration
+	now       func() time.Time
+}
+
+func newSlowQueryLog(cfg config.SlowQueryLog,
+	logger logrus.FieldLogger) *slowQueryLog {
+	return &slowQueryLog{
+		logger:    logger,
+		threshold: time.Duration(cfg.ThresholdMilliseconds) * time.Millisecond,
+		now:       time.Now,
+	}
+}
+
+// start timing a query. The returned func must be called with the outcome of
+// the query once it finished. describe is only called for slow queries.
+func (l *slowQueryLog) start(ctx context.Context, operation, className string,
+	filter *filters.LocalFilter, describe func() string) func(interface{}, error) {
+	if l.threshold <= 0 {
+		return func(interface{}, error) {}
+	}
+
+	started := l.now()
+	return func(res interface{}, err error) {
+		took := l.now().Sub(started)
+		if took < l.threshold {
+			return
+		}
+
+		entry := requestid.Logger(ctx, l.logger).WithFields(logrus.Fields{
+			"action":    "traverser_slow_query",
+			"operation": operation,
+			"class":     className,
+			"query":     describe(),
+			"filter":    normalizeFilter(filter),
+			"results":   resultCount(res),
+			"took_ms":   int64(took / time.Millisecond),
+		})
+		if err != nil {
+			entry = entry.WithError(err)
+		}
+
+		entry.Warnf("%s query took %s, which exceeds the slow query threshold of %s",
+			operation, took, l.threshold)
+	}
+}
+
+func resultCount(res interface{}) int {
+	switch r := res.(type) {
+	case []interface{}:
+		return len(r)
+	case []search.Result:
+		return len(r)
+	case *aggregation.Result:
+		if r == nil {
+			return 0
+		}
+		return len(r.Groups)
+	case *aggregation.Facet:
+		if r == nil {
+			return 0
+		}
+		return len(r.Values)
+	default:
+		return 0
+	}
+}
+
+// normalizeFilter describes the operators and paths of a filter, but not its
+// values, for example "And(Equal(title), Like(inPublication.Publication.name))"
+func normalizeFilter(filter *filters.LocalFilter) string {
+	if filter == nil || filter.Root == nil {
+		return ""
+	}
+
+	return normalizeClause(filter.Root)
+}
+
+func normalizeClause(clause *filters.Clause) string {
+	if len(clause.Operands) > 0 {
+		operands := make([]string, len(clause.Operands))
+		for i := range clause.Operands {
+			operands[i] = normalizeClause(&clause.Operands[i])
+		}
+
+		return fmt.Sprintf("%s(%s)", clause.Operator.Name(),
+			strings.Join(operands, ", "))
+	}
+
+	if clause.On == nil {
+		return clause.Operator.Name() + "()"
+	}
+
+	return fmt.Sprintf("%s(%s)", clause.Operator.Name(), normalizePath(clause.On))
+}
+
+func normalizePath(path *filters.Path) string {
+	segments := []string{path.Property.String()}
+	for child := path.Child; child != nil; child = child.Child {
+		segments = append(segments, child.Class.String(), child.Property.String())
+	}
+
+	return strings.Join(segments, ".")
+}
+
+func describeGetQuery(params GetParams) string {
+	var args []string
+	if params.Pagination != nil {
+		args = append(args, fmt.Sprintf("limit: %d", params.Pagination.Limit))
+	}
+	if params.Explore != nil {
+		args = append(args, describeExploreArgs(*params.Explore)...)
+	}
+	if params.SearchVector != nil {
+		args = append(args, "nearVector")
+	}
+	if params.Group != nil {
+		args = append(args, "group: "+params.Group.Strategy)
+	}
+	if params.Rerank != nil {
+		args = append(args, "rerank")
+	}
+	if params.Sort != nil {
+		args = append(args, "sort: "+params.Sort.Property)
+	}
+
+	return fmt.Sprintf("Get %s%s { %s }", params.ClassName, describeArgs(args),
+		describeSelectProperties(params.Properties))
+}
+
+func describeSelectProperties(props SelectProperties) string {
+	out := make([]string, len(props))
+	for i, prop := range props {
+		if len(prop.Refs) == 0 {
+			out[i] = prop.Name
+			continue
+		}
+
+		refs := make([]string, len(prop.Refs))
+		for j, ref := range prop.Refs {
+			refs[j] = fmt.Sprintf("... on %s { %s }", ref.ClassName,
+				describeSelectProperties(ref.RefProperties))
+		}
+		out[i] = fmt.Sprintf("%s { %s }", prop.Name, strings.Join(refs, " "))
+	}
+
+	return strings.Join(out, " ")
+}
+
+func describeAggregateQuery(params AggregateParams) string {
+	var args []string
+	if params.GroupBy != nil {
+		args = append(args, "groupBy: "+normalizePath(params.GroupBy))
+	}
+	if params.Limit != nil {
+		args = append(args, fmt.Sprintf("limit: %d", *params.Limit))
+	}
+
+	var props []string
+	if params.IncludeMetaCount {
+		props = append(props, "meta { count }")
+	}
+	for _, prop := range params.Properties {
+		aggregators := make([]string, len(prop.Aggregators))
+		for i, aggregator := range prop.Aggregators {
+			aggregators[i] = aggregator.String()
+		}
+		props = append(props, fmt.Sprintf("%s { %s }", prop.Name,
+			strings.Join(aggregators, " ")))
+	}
+
+	return fmt.Sprintf("Aggregate %s%s { %s }", params.ClassName,
+		describeArgs(args), strings.Join(props, " "))
+}
+
+func describeExploreQuery(params ExploreParams) string {
+	args := []string{fmt.Sprintf("limit: %d", params.Limit)}
+	if len(params.ClassNames) > 0 {
+		args = append(args, fmt.Sprintf("classes: [%s]",
+			strings.Join(params.ClassNames, ", ")))
+	}
+	args = append(args, describeExploreArgs(params)...)
+
+	return "Explore" + describeArgs(args)
+}
+
+// describeExploreArgs lists which parts of a vector search are used, the
+// search terms themselves are left out
+func describeExploreArgs(params ExploreParams) []string {
+	var args []string
+	if params.Vector != nil {
+		args = append(args, "nearVector")
+	} else {
+		args = append(args, fmt.Sprintf("concepts: %d", len(params.Values)))
+	}
+	if len(params.MoveTo.Values) > 0 {
+		args = append(args, "moveTo")
+	}
+	if len(params.MoveAwayFrom.Values) > 0 {
+		args = append(args, "moveAwayFrom")
+	}
+	if params.Certainty != 0 {
+		args = append(args, fmt.Sprintf("certainty: %v", params.Certainty))
+	}
+	if len(params.PropertyBoosts) > 0 {
+		args = append(args, "propertyBoosts")
+	}
+
+	return args
+}
+
+func describeFacetQuery(params FacetParams) string {
+	return fmt.Sprintf("Facet %s(property: %s, limit: %d)", params.ClassName,
+		params.Property, params.Limit)
+}
+
+func describeArgs(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	return "(" + strings.Join(args, ", ") + ")"
+}
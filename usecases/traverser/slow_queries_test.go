@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedResultsExplorer returns a fixed number of results for every query
+type fixedResultsExplorer struct {
+	results int
+}
+
+func (e *fixedResultsExplorer) GetClass(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	return make([]interface{}, e.results), nil
+}
+
+func (e *fixedResultsExplorer) Concepts(ctx context.Context,
+	params ExploreParams) ([]search.Result, error) {
+	return make([]search.Result, e.results), nil
+}
+
+func Test_Traverser_SlowQueryLog(t *testing.T) {
+	// every query takes exactly as long as the clock advances between two
+	// readings
+	traverserWith := func(thresholdMS int,
+		took time.Duration) (*Traverser, *test.Hook) {
+		logger, hook := test.NewNullLogger()
+		traverser := NewTraverser(&config.WeaviateConfig{
+			Config: config.Config{
+				SlowQueryLog: config.SlowQueryLog{ThresholdMilliseconds: thresholdMS},
+			},
+		}, &fakeLocks{}, logger, &fakeAuthorizer{}, &fakeVectorizer{},
+			&fakeVectorSearcher{}, &fixedResultsExplorer{results: 3}, &fakeSchemaGetter{})
+
+		now := time.Now()
+		traverser.slowQueries.now = func() time.Time {
+			now = now.Add(took)
+			return now
+		}
+
+		return traverser, hook
+	}
+
+	params := GetParams{
+		ClassName: "Article",
+		Properties: SelectProperties{
+			{Name: "title", IsPrimitive: true},
+			{Name: "inPublication", Refs: []SelectClass{{
+				ClassName:     "Publication",
+				RefProperties: SelectProperties{{Name: "name", IsPrimitive: true}},
+			}}},
+		},
+		Pagination: &filters.Pagination{Limit: 10},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Article", Property: "title"},
+					Value:    &filters.Value{Value: "a secret title", Type: schema.DataTypeString},
+				},
+				{
+					Operator: filters.OperatorLike,
+					On: &filters.Path{
+						Class:    "Article",
+						Property: "inPublication",
+						Child:    &filters.Path{Class: "Publication", Property: "name"},
+					},
+					Value: &filters.Value{Value: "secret*", Type: schema.DataTypeString},
+				},
+			},
+		}},
+	}
+
+	t.Run("a query above the threshold is logged", func(t *testing.T) {
+		traverser, hook := traverserWith(100, 250*time.Millisecond)
+
+		_, err := traverser.GetClass(context.Background(), nil, params)
+		require.Nil(t, err)
+
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, logrus.WarnLevel, entry.Level)
+		assert.Equal(t, "traverser_slow_query", entry.Data["action"])
+		assert.Equal(t, "Get", entry.Data["operation"])
+		assert.Equal(t, "Article", entry.Data["class"])
+		assert.Equal(t, "Get Article(limit: 10) { title inPublication "+
+			"{ ... on Publication { name } } }", entry.Data["query"])
+		assert.Equal(t, "And(Equal(title), Like(inPublication.Publication.name))",
+			entry.Data["filter"])
+		assert.Equal(t, 3, entry.Data["results"])
+		assert.Equal(t, int64(250), entry.Data["took_ms"])
+
+		msg, err := entry.String()
+		require.Nil(t, err)
+		assert.NotContains(t, msg, "secret")
+	})
+
+	t.Run("a query below the threshold is not logged", func(t *testing.T) {
+		traverser, hook := traverserWith(100, 50*time.Millisecond)
+
+		_, err := traverser.GetClass(context.Background(), nil, params)
+		require.Nil(t, err)
+
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	t.Run("a threshold of 0 disables the log", func(t *testing.T) {
+		traverser, hook := traverserWith(0, time.Hour)
+
+		_, err := traverser.GetClass(context.Background(), nil, params)
+		require.Nil(t, err)
+
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	t.Run("a slow explore query", func(t *testing.T) {
+		traverser, hook := traverserWith(100, time.Second)
+
+		_, err := traverser.Explore(context.Background(), nil, ExploreParams{
+			Values: []string{"a secret search term"},
+			MoveTo: ExploreMove{Values: []string{"secret"}, Force: 0.5},
+		})
+		require.Nil(t, err)
+
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, "Explore", entry.Data["operation"])
+		assert.Equal(t, "Explore(limit: 20, concepts: 1, moveTo)", entry.Data["query"])
+		assert.Equal(t, "", entry.Data["filter"])
+		assert.Equal(t, 3, entry.Data["results"])
+
+		msg, err := entry.String()
+		require.Nil(t, err)
+		assert.NotContains(t, msg, "secret")
+	})
+}
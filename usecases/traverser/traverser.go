@@ -21,6 +21,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,18 +44,26 @@ type Traverser struct {
 	vectorSearcher VectorSearcher
 	explorer       explorer
 	schemaGetter   schema.SchemaGetter
+	queryCache     queryCache
+	queries        *queryRegistry
+	slowQueries    *slowQueryLog
 }
 
 type CorpiVectorizer interface {
 	Corpi(ctx context.Context, corpi []string) ([]float32, error)
+	BatchCorpi(ctx context.Context, corpi []string) []vectorizer.BatchResult
+	WeightedCorpi(ctx context.Context, corpi []string, weights []float32) ([]float32, error)
 	MoveTo(source []float32, target []float32, weight float32) ([]float32, error)
 	MoveAwayFrom(source []float32, target []float32, weight float32) ([]float32, error)
 }
 
 type VectorSearcher interface {
 	VectorSearch(ctx context.Context, vector []float32,
-		limit int, filters *filters.LocalFilter) ([]search.Result, error)
+		limit int, filters *filters.LocalFilter, classNames []string) ([]search.Result, error)
 	Aggregate(ctx context.Context, params AggregateParams) (*aggregation.Result, error)
+	Facet(ctx context.Context, params FacetParams) (*aggregation.Facet, error)
+	Count(ctx context.Context, k kind.Kind, className string,
+		filters *filters.LocalFilter) (int64, error)
 }
 
 type explorer interface {
@@ -76,6 +85,8 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		vectorSearcher: vectorSearcher,
 		explorer:       explorer,
 		schemaGetter:   schemaGetter,
+		queries:        newQueryRegistry(),
+		slowQueries:    newSlowQueryLog(config.Config.SlowQueryLog, logger),
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -32,6 +33,16 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := t.validateFilters(params.Filters); err != nil {
+		return nil, err
+	}
+
+	ctx, done := t.queries.register(ctx, principal, "Aggregate", params.ClassName.String())
+	defer done()
+
+	finished := t.slowQueries.start(ctx, "Aggregate", params.ClassName.String(),
+		params.Filters, func() string { return describeAggregateQuery(*params) })
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, fmt.Errorf("could not acquire lock: %v", err)
@@ -40,7 +51,23 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	inspector := newTypeInspector(t.schemaGetter)
 
+	if params.CountOnly() {
+		count, err := t.vectorSearcher.Count(ctx, params.Kind,
+			params.ClassName.String(), params.Filters)
+		if err != nil {
+			finished(nil, err)
+			return nil, err
+		}
+
+		res := &aggregation.Result{
+			Groups: []aggregation.Group{{Count: int(count)}},
+		}
+		finished(res, nil)
+		return res, nil
+	}
+
 	res, err := t.vectorSearcher.Aggregate(ctx, *params)
+	finished(res, err)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +89,13 @@ type AggregateParams struct {
 	Limit            *int
 }
 
+// CountOnly is true if nothing but meta { count } of an ungrouped
+// aggregation is requested. Such a query can be answered by counting the
+// matching objects without aggregating any of their properties.
+func (p AggregateParams) CountOnly() bool {
+	return p.IncludeMetaCount && p.GroupBy == nil && len(p.Properties) == 0
+}
+
 // Aggregator is the desired computation that the database connector
 // should perform on this property
 type Aggregator struct {
@@ -16,16 +16,87 @@ import (
 	"testing"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_Traverser_Aggregate(t *testing.T) {
+	t.Run("with only meta count", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		locks := &fakeLocks{}
+		authorizer := &fakeAuthorizer{}
+		vectorizer := &fakeVectorizer{}
+		vectorRepo := &fakeVectorRepo{}
+		explorer := &fakeExplorer{}
+		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorRepo, explorer, schemaGetter)
+
+		params := AggregateParams{
+			ClassName:        "MyClass",
+			Kind:             kind.Thing,
+			IncludeMetaCount: true,
+		}
+
+		vectorRepo.On("Count", kind.Thing, "MyClass", (*filters.LocalFilter)(nil)).
+			Return(int64(42), nil).Once()
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &aggregation.Result{
+			Groups: []aggregation.Group{{Count: 42}},
+		}, res)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "Aggregate", params)
+	})
+
+	t.Run("with a filter on a property without an inverted index", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		vectorRepo := &fakeVectorRepo{}
+		vFalse := false
+		schemaGetter := &fakeSchemaGetter{schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{{
+					Class: "MyClass",
+					Properties: []*models.Property{{
+						Name:            "label",
+						DataType:        []string{string(schema.DataTypeString)},
+						IndexSearchable: &vFalse,
+					}},
+				}},
+			},
+		}}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo, &fakeExplorer{}, schemaGetter)
+
+		params := AggregateParams{
+			ClassName:        "MyClass",
+			Kind:             kind.Thing,
+			IncludeMetaCount: true,
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "MyClass", Property: "label"},
+				Value:    &filters.Value{Value: "foo", Type: schema.DataTypeString},
+			}},
+		}
+
+		_, err := traverser.Aggregate(context.Background(), nil, &params)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "property 'label' of class 'MyClass' has no "+
+			"inverted index")
+		vectorRepo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("with aggregation only", func(t *testing.T) {
 		principal := &models.Principal{}
 		logger, _ := test.NewNullLogger()
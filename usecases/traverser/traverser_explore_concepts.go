@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
 )
 
@@ -31,7 +32,33 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.Concepts(ctx, params)
+	if err := t.validateExploreClassNames(params.ClassNames); err != nil {
+		return nil, err
+	}
+
+	ctx, done := t.queries.register(ctx, principal, "Explore", "")
+	defer done()
+
+	finished := t.slowQueries.start(ctx, "Explore", "", nil,
+		func() string { return describeExploreQuery(params) })
+	res, err := t.explorer.Concepts(ctx, params)
+	finished(res, err)
+	return res, err
+}
+
+func (t *Traverser) validateExploreClassNames(classNames []string) error {
+	if len(classNames) == 0 {
+		return nil
+	}
+
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	for _, className := range classNames {
+		if s.FindClassByName(schema.ClassName(className)) == nil {
+			return NewErrInvalidUserInput("class '%s' does not exist", className)
+		}
+	}
+
+	return nil
 }
 
 // ExploreParams to do a vector based explore search
@@ -42,10 +69,41 @@ type ExploreParams struct {
 	MoveAwayFrom ExploreMove
 	Certainty    float64
 	Network      bool
+
+	// EF overrides the ef of the vector index for this search, 0 means the
+	// ef configured for the class is used
+	EF int
+
+	// Vector is a search vector computed outside of weaviate (nearVector). If
+	// set, it is used as is instead of vectorizing the Values
+	Vector []float32
+
+	// PropertyBoosts order the results by how similar their individual text
+	// properties are to the search terms, with the listed properties counting
+	// more (or less) than the others. If empty, the results are ordered by
+	// the vector of the whole object.
+	PropertyBoosts []PropertyBoost
+
+	// ClassNames limit the exploration to the objects of these classes, only
+	// their vector indices are searched. If empty, all classes are explored.
+	ClassNames []string
+}
+
+// PropertyBoost weighs a single property when comparing the search terms to
+// the text properties of the results. Properties without a boost have a
+// weight of 1, a weight of 0 ignores the property.
+type PropertyBoost struct {
+	Property string
+	Weight   float32
 }
 
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
 type ExploreMove struct {
 	Values []string
 	Force  float32
+
+	// Weights optionally weigh the Values against each other, the weight at
+	// position i belongs to the value at position i. Each weight must be
+	// between 0 and 1. Without weights, all values count the same.
+	Weights []float32
 }
@@ -16,6 +16,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -36,7 +38,8 @@ func Test_ExploreConcepts(t *testing.T) {
 		extender := &fakeExtender{}
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
 			vectorizer, vectorSearcher, explorer, schemaGetter)
@@ -60,7 +63,8 @@ func Test_ExploreConcepts(t *testing.T) {
 		extender := &fakeExtender{}
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
 			vectorizer, vectorSearcher, explorer, schemaGetter)
@@ -89,6 +93,7 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/things/123-456-789",
 				Certainty: 0.5,
+				Distance:  0.5,
 			},
 			search.Result{
 				ClassName: "AnAction",
@@ -96,6 +101,7 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/actions/987-654-321",
 				Certainty: 0.5,
+				Distance:  0.5,
 			},
 		}, res)
 
@@ -115,7 +121,8 @@ func Test_ExploreConcepts(t *testing.T) {
 		extender := &fakeExtender{}
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
 			vectorizer, vectorSearcher, explorer, schemaGetter)
@@ -155,7 +162,8 @@ func Test_ExploreConcepts(t *testing.T) {
 		extender := &fakeExtender{}
 		projector := &fakeProjector{}
 		pathBuilder := &fakePathBuilder{}
-		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder)
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
 			vectorizer, vectorSearcher, explorer, schemaGetter)
@@ -193,6 +201,7 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/things/123-456-789",
 				Certainty: 0.5,
+				Distance:  0.5,
 			},
 			search.Result{
 				ClassName: "AnAction",
@@ -200,6 +209,7 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/actions/987-654-321",
 				Certainty: 0.5,
+				Distance:  0.5,
 			},
 		}, res)
 
@@ -209,4 +219,130 @@ func Test_ExploreConcepts(t *testing.T) {
 		assert.Equal(t, 100, vectorSearcher.calledWithLimit,
 			"limit explicitly set")
 	})
+
+	t.Run("with a nearVector instead of concepts", func(t *testing.T) {
+
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			Vector: []float32{0.4, 0.5, 0.6},
+		}
+		vectorSearcher.results = []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+			},
+		}
+
+		res, err := traverser.Explore(context.Background(), nil, params)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "weaviate://localhost/things/123-456-789", res[0].Beacon)
+		assert.Equal(t, []float32{0.4, 0.5, 0.6}, vectorSearcher.calledWithVector,
+			"the vector is used as is instead of vectorizing concepts")
+	})
+
+	t.Run("with both concepts and a nearVector", func(t *testing.T) {
+
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			Values: []string{"a search term"},
+			Vector: []float32{0.4, 0.5, 0.6},
+		}
+
+		_, err := traverser.Explore(context.Background(), nil, params)
+		assert.Equal(t, fmt.Errorf(
+			"explorer: concepts and nearVector cannot be combined"), err)
+	})
+
+	t.Run("with neither concepts nor a nearVector", func(t *testing.T) {
+
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+
+		_, err := traverser.Explore(context.Background(), nil, ExploreParams{})
+		assert.Equal(t, fmt.Errorf(
+			"explorer: either concepts or nearVector must be set"), err)
+	})
+
+	t.Run("limited to specific classes", func(t *testing.T) {
+
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		extender := &fakeExtender{}
+		projector := &fakeProjector{}
+		pathBuilder := &fakePathBuilder{}
+		explainer := &fakeExplainer{}
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log, extender, projector, pathBuilder, explainer)
+		schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{{Class: "BestClass"}},
+			},
+			Actions: &models.Schema{
+				Classes: []*models.Class{{Class: "AnAction"}},
+			},
+		}}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+
+		t.Run("with existing classes of both kinds", func(t *testing.T) {
+			_, err := traverser.Explore(context.Background(), nil, ExploreParams{
+				Values:     []string{"a search term"},
+				ClassNames: []string{"BestClass", "AnAction"},
+			})
+			require.Nil(t, err)
+			assert.Equal(t, []string{"BestClass", "AnAction"},
+				vectorSearcher.calledWithClassNames)
+		})
+
+		t.Run("with a class that doesn't exist", func(t *testing.T) {
+			_, err := traverser.Explore(context.Background(), nil, ExploreParams{
+				Values:     []string{"a search term"},
+				ClassNames: []string{"BestClass", "NotThere"},
+			})
+			assert.Equal(t, NewErrInvalidUserInput("class 'NotThere' does not exist"), err)
+		})
+	})
 }
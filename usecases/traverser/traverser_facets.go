@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+const (
+	// DefaultFacetLimit is used if a facet request does not set a limit
+	DefaultFacetLimit = 10
+
+	// MaxFacetLimit is the largest number of distinct values a single facet
+	// request can return
+	MaxFacetLimit = 1000
+)
+
+// FacetParams describe which property of which class to build the value
+// distribution for. Only objects matching the optional Filters are
+// considered.
+type FacetParams struct {
+	Kind      kind.Kind
+	ClassName schema.ClassName
+	Property  schema.PropertyName
+	Filters   *filters.LocalFilter
+	Limit     int
+}
+
+// Facet returns the most common distinct values of a primitive property
+// together with the number of objects that have them. Unlike Aggregate it
+// does not compute statistics over the values, but their distribution.
+func (t *Traverser) Facet(ctx context.Context, principal *models.Principal,
+	params FacetParams) (*aggregation.Facet, error) {
+	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := t.queries.register(ctx, principal, "Facet", params.ClassName.String())
+	defer done()
+
+	finished := t.slowQueries.start(ctx, "Facet", params.ClassName.String(),
+		params.Filters, func() string { return describeFacetQuery(params) })
+
+	unlock, err := t.locks.LockConnector()
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	if params.Limit == 0 {
+		params.Limit = DefaultFacetLimit
+	}
+
+	if err := t.validateFacetParams(params); err != nil {
+		return nil, err
+	}
+
+	if err := t.validateFilters(params.Filters); err != nil {
+		return nil, err
+	}
+
+	res, err := t.vectorSearcher.Facet(ctx, params)
+	finished(res, err)
+	return res, err
+}
+
+func (t *Traverser) validateFacetParams(params FacetParams) error {
+	if params.Limit < 0 || params.Limit > MaxFacetLimit {
+		return NewErrInvalidUserInput("limit must be between 1 and %d, got %d",
+			MaxFacetLimit, params.Limit)
+	}
+
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(params.Kind, params.ClassName)
+	if class == nil {
+		return NewErrNotFound("%s class '%s' does not exist", params.Kind.Name(),
+			params.ClassName)
+	}
+
+	prop, err := schema.GetPropertyByName(class, params.Property.String())
+	if err != nil {
+		return NewErrNotFound("property '%s' does not exist on class '%s'",
+			params.Property, params.ClassName)
+	}
+
+	dt, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return fmt.Errorf("find data type of property '%s': %v", params.Property, err)
+	}
+
+	if !dt.IsPrimitive() {
+		return NewErrInvalidUserInput("property '%s' is a reference, only primitive "+
+			"properties can be faceted", params.Property)
+	}
+
+	switch dt.AsPrimitive() {
+	case schema.DataTypeString, schema.DataTypeInt, schema.DataTypeNumber,
+		schema.DataTypeBoolean, schema.DataTypeDate:
+		return nil
+	default:
+		return NewErrInvalidUserInput("property '%s' of type '%s' cannot be faceted",
+			params.Property, dt.AsPrimitive())
+	}
+}
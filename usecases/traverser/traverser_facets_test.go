@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Traverser_Facet(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		traverser  *Traverser
+	)
+
+	reset := func() {
+		logger, _ := test.NewNullLogger()
+		vectorRepo = &fakeVectorRepo{}
+		traverser = NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+	}
+
+	t.Run("without a limit", func(t *testing.T) {
+		reset()
+		expected := &aggregation.Facet{
+			Values: []aggregation.FacetValue{
+				{Value: "foo", Count: 7},
+				{Value: "bar", Count: 3},
+			},
+			Cardinality: 2,
+		}
+		vectorRepo.On("Facet", FacetParams{
+			Kind:      kind.Thing,
+			ClassName: "MyClass",
+			Property:  "label",
+			Limit:     DefaultFacetLimit,
+		}).Return(expected, nil).Once()
+
+		res, err := traverser.Facet(context.Background(), &models.Principal{},
+			FacetParams{Kind: kind.Thing, ClassName: "MyClass", Property: "label"})
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	type test struct {
+		name        string
+		params      FacetParams
+		expectedErr interface{}
+	}
+
+	tests := []test{
+		test{
+			name: "with a limit above the maximum",
+			params: FacetParams{Kind: kind.Thing, ClassName: "MyClass", Property: "label",
+				Limit: MaxFacetLimit + 1},
+			expectedErr: ErrInvalidUserInput{},
+		},
+		test{
+			name:        "on a non-existing class",
+			params:      FacetParams{Kind: kind.Thing, ClassName: "NotMyClass", Property: "label"},
+			expectedErr: ErrNotFound{},
+		},
+		test{
+			name:        "on a class of the wrong kind",
+			params:      FacetParams{Kind: kind.Action, ClassName: "MyClass", Property: "label"},
+			expectedErr: ErrNotFound{},
+		},
+		test{
+			name:        "on a non-existing property",
+			params:      FacetParams{Kind: kind.Thing, ClassName: "MyClass", Property: "notAProp"},
+			expectedErr: ErrNotFound{},
+		},
+		test{
+			name:        "on a reference property",
+			params:      FacetParams{Kind: kind.Thing, ClassName: "MyClass", Property: "a ref"},
+			expectedErr: ErrInvalidUserInput{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reset()
+
+			_, err := traverser.Facet(context.Background(), &models.Principal{}, test.params)
+			require.NotNil(t, err)
+			assert.IsType(t, test.expectedErr, err)
+			vectorRepo.AssertNotCalled(t, "Facet", test.params)
+		})
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -25,11 +27,102 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := t.validatePropertyBoostNames(params); err != nil {
+		return nil, err
+	}
+
+	if err := t.validateFilters(params.Filters); err != nil {
+		return nil, err
+	}
+
+	ctx, done := t.queries.register(ctx, principal, "Get", params.ClassName)
+	defer done()
+
+	finished := t.slowQueries.start(ctx, "Get", params.ClassName, params.Filters,
+		func() string { return describeGetQuery(params) })
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, fmt.Errorf("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
-	return t.explorer.GetClass(ctx, params)
+	pagination, err := t.limitPagination(params.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	params.Pagination = pagination
+
+	res, err := t.getClassCached(ctx, principal, params)
+	finished(res, err)
+	return res, err
+}
+
+// validatePropertyBoostNames makes sure that every boosted property is a
+// string or text property of the class, as only those are compared to the
+// search terms. An unknown class is left to the search to report.
+func (t *Traverser) validatePropertyBoostNames(params GetParams) error {
+	if params.Explore == nil || len(params.Explore.PropertyBoosts) == 0 {
+		return nil
+	}
+
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(params.Kind, schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil
+	}
+
+	for _, boost := range params.Explore.PropertyBoosts {
+		prop, err := schema.GetPropertyByName(class, boost.Property)
+		if err != nil {
+			return NewErrInvalidUserInput("boost: property '%s' does not exist on class '%s'",
+				boost.Property, params.ClassName)
+		}
+
+		dt, err := s.FindPropertyDataType(prop.DataType)
+		if err != nil || !dt.IsPrimitive() ||
+			(dt.AsPrimitive() != schema.DataTypeString && dt.AsPrimitive() != schema.DataTypeText) {
+			return NewErrInvalidUserInput("boost: property '%s' of class '%s' is not a string "+
+				"or text property", boost.Property, params.ClassName)
+		}
+	}
+
+	return nil
+}
+
+// validateFilters rejects a filter on a property without an inverted index,
+// so that every backend reports it the same way
+func (t *Traverser) validateFilters(where *filters.LocalFilter) error {
+	if where == nil {
+		return nil
+	}
+
+	err := filters.ValidateInvertedIndex(t.schemaGetter.GetSchemaSkipAuth(), where)
+	if err != nil {
+		return NewErrInvalidUserInput("invalid where filter: %v", err)
+	}
+
+	return nil
+}
+
+// limitPagination applies the configured default and maximum limits. If no
+// default is configured and none was requested, the pagination stays unset.
+func (t *Traverser) limitPagination(
+	pagination *filters.Pagination) (*filters.Pagination, error) {
+	defaults := t.config.Config.QueryDefaults
+
+	var requested *int64
+	if pagination != nil {
+		limit := int64(pagination.Limit)
+		requested = &limit
+	} else if defaults.Limit == 0 {
+		return nil, nil
+	}
+
+	limit, err := defaults.EffectiveLimit(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filters.Pagination{Limit: int(limit)}, nil
 }
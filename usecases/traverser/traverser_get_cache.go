@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+type queryCache interface {
+	Generation() uint64
+	Get(key string) (interface{}, bool)
+	Set(key string, classes []string, value interface{}, generation uint64)
+}
+
+// SetQueryCache enables caching of the results of Get queries. As the
+// principal is part of the cache key, results are never shared across
+// principals.
+func (t *Traverser) SetQueryCache(cache queryCache) {
+	t.queryCache = cache
+}
+
+// getClassCached serves the query from the cache if possible, otherwise it
+// is executed and its result stored for subsequent identical queries
+func (t *Traverser) getClassCached(ctx context.Context, principal *models.Principal,
+	params GetParams) (interface{}, error) {
+	if t.queryCache == nil {
+		return t.explorer.GetClass(ctx, params)
+	}
+
+	key, err := getCacheKey(principal, params)
+	if err != nil {
+		// not every query can be normalized, it is then simply not cached
+		return t.explorer.GetClass(ctx, params)
+	}
+
+	if res, ok := t.queryCache.Get(key); ok {
+		return res, nil
+	}
+
+	generation := t.queryCache.Generation()
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	t.queryCache.Set(key, params.referencedClassNames(), res, generation)
+	return res, nil
+}
+
+type getCacheKeyParts struct {
+	Username string      `json:"username"`
+	Groups   []string    `json:"groups"`
+	Params   interface{} `json:"params"`
+}
+
+func getCacheKey(principal *models.Principal, params GetParams) (string, error) {
+	parts := getCacheKeyParts{Params: params}
+	if principal != nil {
+		parts.Username = principal.Username
+		parts.Groups = append([]string{}, principal.Groups...)
+		sort.Strings(parts.Groups)
+	}
+
+	key, err := json.Marshal(parts)
+	if err != nil {
+		return "", err
+	}
+
+	return string(key), nil
+}
+
+// referencedClassNames are the class which is queried as well as every
+// class which is reached through a selected reference property, as a write
+// to any of them can change the result
+func (p GetParams) referencedClassNames() []string {
+	names := map[string]struct{}{p.ClassName: {}}
+	addRefClassNames(names, p.Properties)
+
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func addRefClassNames(names map[string]struct{}, props SelectProperties) {
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			names[ref.ClassName] = struct{}{}
+			addRefClassNames(names, ref.RefProperties)
+		}
+	}
+}
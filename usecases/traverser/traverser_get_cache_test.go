@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/querycache"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingExplorer struct {
+	calls int
+}
+
+func (e *countingExplorer) GetClass(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	e.calls++
+	return []interface{}{map[string]interface{}{"call": e.calls}}, nil
+}
+
+func (e *countingExplorer) Concepts(ctx context.Context,
+	params ExploreParams) ([]search.Result, error) {
+	return nil, nil
+}
+
+func Test_Traverser_GetClassWithQueryCache(t *testing.T) {
+	var (
+		explorer  *countingExplorer
+		cache     *querycache.Cache
+		traverser *Traverser
+	)
+
+	reset := func() {
+		logger, _ := test.NewNullLogger()
+		explorer = &countingExplorer{}
+		cache = querycache.New(querycache.Config{TTL: time.Minute, MaxEntries: 10})
+		traverser = NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, &fakeVectorizer{}, &fakeVectorSearcher{}, explorer, nil)
+		traverser.SetQueryCache(cache)
+	}
+
+	params := GetParams{
+		ClassName: "Car",
+		Properties: SelectProperties{
+			{Name: "name", IsPrimitive: true},
+			{Name: "madeBy", Refs: []SelectClass{{
+				ClassName:     "Manufacturer",
+				RefProperties: SelectProperties{{Name: "name", IsPrimitive: true}},
+			}}},
+		},
+	}
+	alice := &models.Principal{Username: "alice"}
+	bob := &models.Principal{Username: "bob"}
+
+	t.Run("an identical query is served from the cache", func(t *testing.T) {
+		reset()
+
+		first, err := traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+		second, err := traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+
+		assert.Equal(t, first, second)
+		assert.Equal(t, 1, explorer.calls)
+	})
+
+	t.Run("results are not shared across principals", func(t *testing.T) {
+		reset()
+
+		_, err := traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+		_, err = traverser.GetClass(context.Background(), bob, params)
+		require.Nil(t, err)
+
+		assert.Equal(t, 2, explorer.calls)
+	})
+
+	t.Run("a write to a referenced class invalidates the result", func(t *testing.T) {
+		reset()
+
+		_, err := traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+		cache.InvalidateClass("Manufacturer")
+		_, err = traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+
+		assert.Equal(t, 2, explorer.calls)
+	})
+}
@@ -31,6 +31,8 @@ type GetParams struct {
 	Explore              *ExploreParams
 	SearchVector         []float32
 	Group                *GroupParams
+	Rerank               *RerankParams
+	Sort                 *filters.Sort
 	UnderscoreProperties UnderscoreProperties
 }
 
@@ -56,6 +58,14 @@ type GroupParams struct {
 	Force    float32
 }
 
+// RerankParams reorder the results of a vector search by a weighted blend of
+// their certainty and the value of a numeric property. A weight of 0 keeps
+// the vector order, a weight of 1 orders by the property alone.
+type RerankParams struct {
+	Property string
+	Weight   float32
+}
+
 // FindSelectClass by specifying the exact class name
 func (sp SelectProperty) FindSelectClass(className schema.ClassName) *SelectClass {
 	for _, selectClass := range sp.Refs {
@@ -145,6 +155,8 @@ type UnderscoreProperties struct {
 	Vector            bool
 	Interpretation    bool
 	NearestNeighbors  bool
+	Certainty         bool
+	Distance          bool
 	SemanticPath      *sempath.Params
 	FeatureProjection *libprojector.Params
 }
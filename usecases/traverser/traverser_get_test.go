@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Traverser_LimitPagination(t *testing.T) {
+	traverserWith := func(defaults config.QueryDefaults) *Traverser {
+		return &Traverser{config: &config.WeaviateConfig{
+			Config: config.Config{QueryDefaults: defaults},
+		}}
+	}
+
+	t.Run("without a requested limit", func(t *testing.T) {
+		res, err := traverserWith(config.QueryDefaults{Limit: 20, MaxLimit: 100}).
+			limitPagination(nil)
+		require.Nil(t, err)
+		assert.Equal(t, &filters.Pagination{Limit: 20}, res)
+	})
+
+	t.Run("without a requested or default limit", func(t *testing.T) {
+		res, err := traverserWith(config.QueryDefaults{}).limitPagination(nil)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("with a limit above the maximum", func(t *testing.T) {
+		res, err := traverserWith(config.QueryDefaults{Limit: 20, MaxLimit: 100}).
+			limitPagination(&filters.Pagination{Limit: 1000})
+		require.Nil(t, err)
+		assert.Equal(t, &filters.Pagination{Limit: 100}, res)
+	})
+
+	t.Run("with a limit above the maximum and rejection turned on", func(t *testing.T) {
+		_, err := traverserWith(config.QueryDefaults{
+			Limit:                20,
+			MaxLimit:             100,
+			RejectExceedingLimit: true,
+		}).limitPagination(&filters.Pagination{Limit: 1000})
+		assert.Equal(t, "limit 1000 exceeds the maximum limit of 100", err.Error())
+	})
+
+	t.Run("with a negative limit", func(t *testing.T) {
+		_, err := traverserWith(config.QueryDefaults{Limit: 20, MaxLimit: 100}).
+			limitPagination(&filters.Pagination{Limit: -1})
+		require.NotNil(t, err)
+		assert.Equal(t, "limit must not be negative, got -1", err.Error())
+	})
+}
+
+func Test_Traverser_ValidatePropertyBoostNames(t *testing.T) {
+	tr := &Traverser{schemaGetter: &fakeSchemaGetter{schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"string"}},
+						{Name: "body", DataType: []string{"text"}},
+						{Name: "wordCount", DataType: []string{"int"}},
+					},
+				},
+			},
+		},
+	}}}
+
+	paramsWith := func(props ...string) GetParams {
+		boosts := make([]PropertyBoost, len(props))
+		for i, prop := range props {
+			boosts[i] = PropertyBoost{Property: prop, Weight: 2}
+		}
+		return GetParams{
+			Kind:      kind.Thing,
+			ClassName: "Article",
+			Explore:   &ExploreParams{Values: []string{"news"}, PropertyBoosts: boosts},
+		}
+	}
+
+	t.Run("with string and text properties", func(t *testing.T) {
+		assert.Nil(t, tr.validatePropertyBoostNames(paramsWith("title", "body")))
+	})
+
+	t.Run("with a property which does not exist", func(t *testing.T) {
+		err := tr.validatePropertyBoostNames(paramsWith("title", "summary"))
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "property 'summary' does not exist on class 'Article'")
+	})
+
+	t.Run("with a property which is not a text", func(t *testing.T) {
+		err := tr.validatePropertyBoostNames(paramsWith("wordCount"))
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "is not a string or text property")
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchThings vectorizes the things concurrently, as the contextionary has
+// no batch call
+func (v *Vectorizer) BatchThings(ctx context.Context,
+	objects []*models.Thing) []BatchResult {
+	return v.batch(len(objects), func(i int) BatchResult {
+		vector, source, err := v.Thing(ctx, objects[i])
+		return BatchResult{Vector: vector, Source: source, Err: err}
+	})
+}
+
+// BatchActions vectorizes the actions concurrently, as the contextionary has
+// no batch call
+func (v *Vectorizer) BatchActions(ctx context.Context,
+	objects []*models.Action) []BatchResult {
+	return v.batch(len(objects), func(i int) BatchResult {
+		vector, source, err := v.Action(ctx, objects[i])
+		return BatchResult{Vector: vector, Source: source, Err: err}
+	})
+}
+
+// BatchCorpi vectorizes every corpus on its own and concurrently. A corpus
+// without any known word fails with an ErrNoUsableWords.
+func (v *Vectorizer) BatchCorpi(ctx context.Context, corpi []string) []BatchResult {
+	return v.batch(len(corpi), func(i int) BatchResult {
+		vector, err := v.Corpi(ctx, []string{corpi[i]})
+		return BatchResult{Vector: vector, Err: err}
+	})
+}
+
+func (v *Vectorizer) batch(n int, vectorize func(i int) BatchResult) []BatchResult {
+	out := make([]BatchResult, n)
+	sem := make(chan struct{}, v.batchConcurrency)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			out[i] = vectorize(i)
+		}(i)
+	}
+	wg.Wait()
+
+	return out
+}
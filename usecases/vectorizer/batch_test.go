@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchVectorization(t *testing.T) {
+	t.Run("things are vectorized in parallel, but not more than allowed", func(t *testing.T) {
+		client := &concurrencyTrackingClient{failFor: "broken"}
+		v := New(client, &propertyIndexer{}).WithBatchConcurrency(3)
+
+		things := make([]*models.Thing, 20)
+		for i := range things {
+			things[i] = &models.Thing{
+				Class:  "Car",
+				Schema: map[string]interface{}{"brand": fmt.Sprintf("brand%d", i)},
+			}
+		}
+		things[7].Schema = map[string]interface{}{"brand": "broken"}
+
+		res := v.BatchThings(context.Background(), things)
+		require.Len(t, res, len(things))
+		assert.Equal(t, 3, client.maxActive)
+
+		for i, item := range res {
+			if i == 7 {
+				assert.NotNil(t, item.Err, "the error belongs to the right object")
+				continue
+			}
+
+			assert.Nil(t, item.Err)
+			assert.Equal(t, []float32{0, 1, 2}, item.Vector)
+		}
+	})
+
+	t.Run("every corpus is vectorized on its own", func(t *testing.T) {
+		client := &fakeCorpusClient{
+			vectors: map[string][]float32{"car": {1, 0}, "bike": {0, 1}},
+			strict:  true,
+		}
+		v := New(client, nil)
+
+		res := v.BatchCorpi(context.Background(), []string{"car", "unknown", "bike"})
+		require.Len(t, res, 3)
+		assert.Equal(t, []float32{1, 0}, res[0].Vector)
+		assert.IsType(t, ErrNoUsableWords{}, res[1].Err)
+		assert.Equal(t, []float32{0, 1}, res[2].Vector)
+	})
+}
+
+// concurrencyTrackingClient records the highest number of parallel
+// vectorizations, each one takes long enough for the others to catch up
+type concurrencyTrackingClient struct {
+	sync.Mutex
+	active    int
+	maxActive int
+	failFor   string
+}
+
+func (c *concurrencyTrackingClient) VectorForCorpi(ctx context.Context, corpi []string,
+	overrides map[string]string) ([]float32, []InputElement, error) {
+	c.Lock()
+	c.active++
+	if c.active > c.maxActive {
+		c.maxActive = c.active
+	}
+	c.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	c.Lock()
+	c.active--
+	c.Unlock()
+
+	for _, corpus := range corpi {
+		if strings.Contains(corpus, c.failFor) {
+			return nil, nil, fmt.Errorf("c11y unavailable")
+		}
+	}
+	return []float32{0, 1, 2}, nil, nil
+}
@@ -11,10 +11,17 @@
 
 package vectorizer
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
 
 type fakeClient struct {
-	lastInput []string
+	lastInput    []string
+	missingWords map[string]bool
 }
 
 func (c *fakeClient) VectorForCorpi(ctx context.Context, corpi []string, overrides map[string]string) ([]float32, []InputElement, error) {
@@ -33,5 +40,61 @@ func (c *fakeClient) NearestWordsByVector(ctx context.Context,
 }
 
 func (c *fakeClient) IsWordPresent(ctx context.Context, word string) (bool, error) {
-	return true, nil
+	return !c.missingWords[word], nil
+}
+
+func (c *fakeClient) MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error) {
+	panic("not implemented")
+}
+
+func (c *fakeClient) MultiNearestWordsByVector(ctx context.Context, vectors [][]float32,
+	n int, k int) ([]*models.NearestNeighbors, error) {
+	panic("not implemented")
+}
+
+// fakeNNExtender returns as many neighbors as requested
+type fakeNNExtender struct {
+	lastLimit int
+}
+
+func (e *fakeNNExtender) Single(ctx context.Context, in *search.Result,
+	limit *int) (*search.Result, error) {
+	e.lastLimit = *limit
+
+	neighbors := make([]*models.NearestNeighbor, *limit)
+	for i := range neighbors {
+		neighbors[i] = &models.NearestNeighbor{
+			Concept:  fmt.Sprintf("neighbor%d", i),
+			Distance: float32(i) / 10,
+		}
+	}
+
+	in.UnderscoreProperties = &models.UnderscoreProperties{
+		NearestNeighbors: &models.NearestNeighbors{Neighbors: neighbors},
+	}
+	return in, nil
+}
+
+// fakeCorpusClient returns a predefined vector per corpus. Unknown corpi have
+// a zero vector, unless strict is set, then they fail like in the
+// contextionary.
+type fakeCorpusClient struct {
+	vectors map[string][]float32
+	calls   []string
+	strict  bool
+}
+
+func (c *fakeCorpusClient) VectorForCorpi(ctx context.Context, corpi []string,
+	overrides map[string]string) ([]float32, []InputElement, error) {
+	c.calls = append(c.calls, corpi[0])
+	vector, ok := c.vectors[corpi[0]]
+	if !ok && c.strict {
+		return nil, nil, NewErrNoUsableWordsf("none of the words in corpus '%s' are present "+
+			"in the contextionary", corpi[0])
+	}
+	if !ok {
+		return []float32{0, 0}, nil, nil
+	}
+
+	return vector, nil, nil
 }
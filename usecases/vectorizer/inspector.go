@@ -19,8 +19,16 @@ import (
 	"unicode/utf8"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/nearestneighbors"
 )
 
+// MaxInspectionLimit is the maximum number of nearest neighbors that can be
+// requested when inspecting a single word. The nearest neighbor extender only
+// considers nearestneighbors.DefaultK candidates, so more could never be
+// returned.
+const MaxInspectionLimit = nearestneighbors.DefaultK
+
 type inspectorClient interface {
 	VectorForWord(ctx context.Context, word string) ([]float32, error)
 	VectorForCorpi(ctx context.Context, words []string,
@@ -29,12 +37,45 @@ type inspectorClient interface {
 	IsWordPresent(ctx context.Context, word string) (bool, error)
 }
 
+type nnExtender interface {
+	Single(ctx context.Context, in *search.Result, limit *int) (*search.Result, error)
+}
+
+// ErrInvalidInspection indicates that the word or the limit of a word
+// inspection cannot be used, for example because it contains several words
+type ErrInvalidInspection struct {
+	Err error
+}
+
+func (e ErrInvalidInspection) Error() string {
+	return e.Err.Error()
+}
+
+func NewErrInvalidInspectionf(pattern string, args ...interface{}) ErrInvalidInspection {
+	return ErrInvalidInspection{Err: fmt.Errorf(pattern, args...)}
+}
+
+// ErrWordNotPresent indicates that an inspected word is not part of the
+// contextionary, so there is no vector to show
+type ErrWordNotPresent struct {
+	Err error
+}
+
+func (e ErrWordNotPresent) Error() string {
+	return e.Err.Error()
+}
+
+func NewErrWordNotPresentf(pattern string, args ...interface{}) ErrWordNotPresent {
+	return ErrWordNotPresent{Err: fmt.Errorf(pattern, args...)}
+}
+
 type Inspector struct {
-	client inspectorClient
+	client     inspectorClient
+	nnExtender nnExtender
 }
 
-func NewInspector(client inspectorClient) *Inspector {
-	return &Inspector{client: client}
+func NewInspector(client inspectorClient, nnExtender nnExtender) *Inspector {
+	return &Inspector{client: client, nnExtender: nnExtender}
 }
 
 func (i *Inspector) GetWords(ctx context.Context, words string) (*models.C11yWordsResponse, error) {
@@ -59,6 +100,78 @@ func (i *Inspector) GetWords(ctx context.Context, words string) (*models.C11yWor
 	}, nil
 }
 
+// InspectWord returns the vector of a single word and its limit nearest
+// neighbors. A nil limit uses the default limit of the nearest neighbor
+// extender.
+func (i *Inspector) InspectWord(ctx context.Context, word string,
+	limit *int) (*models.C11yWordInspection, error) {
+	if limit == nil {
+		defaultLimit := nearestneighbors.DefaultLimit
+		limit = &defaultLimit
+	}
+
+	if *limit < 1 || *limit > MaxInspectionLimit {
+		return nil, NewErrInvalidInspectionf("limit must be between 1 and %d, but got %d",
+			MaxInspectionLimit, *limit)
+	}
+
+	if word == "" {
+		return nil, NewErrInvalidInspectionf("word must not be empty")
+	}
+
+	wordArray, err := i.validateAndSplit(word)
+	if err != nil {
+		return nil, ErrInvalidInspection{Err: err}
+	}
+
+	if len(wordArray) != 1 {
+		return nil, NewErrInvalidInspectionf("expected a single word, but got %d: %v",
+			len(wordArray), wordArray)
+	}
+
+	ok, err := i.client.IsWordPresent(ctx, wordArray[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not check word presence: %v", err)
+	}
+
+	if !ok {
+		return nil, NewErrWordNotPresentf("word '%s' is not present in the contextionary",
+			wordArray[0])
+	}
+
+	vector, err := i.client.VectorForWord(ctx, wordArray[0])
+	if err != nil {
+		return nil, fmt.Errorf("get vector for word '%s': %v", wordArray[0], err)
+	}
+
+	res, err := i.nnExtender.Single(ctx, &search.Result{Vector: vector}, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get nearest neighbors of word '%s': %v", wordArray[0], err)
+	}
+
+	return &models.C11yWordInspection{
+		Word:             wordArray[0],
+		Vector:           vector,
+		NearestNeighbors: c11yNearestNeighbors(res.UnderscoreProperties.NearestNeighbors),
+	}, nil
+}
+
+func c11yNearestNeighbors(in *models.NearestNeighbors) models.C11yNearestNeighbors {
+	out := models.C11yNearestNeighbors{}
+	if in == nil {
+		return out
+	}
+
+	for _, neighbor := range in.Neighbors {
+		out = append(out, &models.C11yNearestNeighborsItems0{
+			Word:     neighbor.Concept,
+			Distance: neighbor.Distance,
+		})
+	}
+
+	return out
+}
+
 func (i *Inspector) validateAndSplit(words string) ([]string, error) {
 	// set first character to lowercase
 	wordChars := []rune(words)
@@ -181,10 +181,73 @@ func TestInspector(t *testing.T) {
 
 	for _, test := range tests {
 		client := &fakeClient{}
-		i := NewInspector(client)
+		i := NewInspector(client, &fakeNNExtender{})
 		res, err := i.GetWords(context.Background(), test.input)
 		require.Equal(t, err, test.expectedErr)
 		assert.Equal(t, res, test.expectedOutput)
 	}
 
 }
+
+func TestInspectWord(t *testing.T) {
+	t.Run("a word present in the contextionary", func(t *testing.T) {
+		extender := &fakeNNExtender{}
+		i := NewInspector(&fakeClient{}, extender)
+
+		res, err := i.InspectWord(context.Background(), "Apple", ptInt(2))
+		require.Nil(t, err)
+		assert.Equal(t, &models.C11yWordInspection{
+			Word:   "apple",
+			Vector: []float32{3, 2, 1, 0},
+			NearestNeighbors: models.C11yNearestNeighbors{
+				&models.C11yNearestNeighborsItems0{Word: "neighbor0", Distance: 0},
+				&models.C11yNearestNeighborsItems0{Word: "neighbor1", Distance: 0.1},
+			},
+		}, res)
+	})
+
+	t.Run("without a limit", func(t *testing.T) {
+		extender := &fakeNNExtender{}
+		i := NewInspector(&fakeClient{}, extender)
+
+		res, err := i.InspectWord(context.Background(), "apple", nil)
+		require.Nil(t, err)
+		assert.Len(t, res.NearestNeighbors, 10)
+		assert.Equal(t, 10, extender.lastLimit)
+	})
+
+	t.Run("a word not present in the contextionary", func(t *testing.T) {
+		client := &fakeClient{missingWords: map[string]bool{"applez": true}}
+		i := NewInspector(client, &fakeNNExtender{})
+
+		_, err := i.InspectWord(context.Background(), "applez", ptInt(2))
+		assert.Equal(t, NewErrWordNotPresentf(
+			"word 'applez' is not present in the contextionary"), err)
+	})
+
+	invalid := []struct {
+		name  string
+		word  string
+		limit int
+	}{
+		{name: "an empty word", word: "", limit: 2},
+		{name: "several words", word: "redApple", limit: 2},
+		{name: "invalid characters", word: "apple!", limit: 2},
+		{name: "a negative limit", word: "apple", limit: -1},
+		{name: "a limit of 0", word: "apple", limit: 0},
+		{name: "a limit above the maximum", word: "apple", limit: MaxInspectionLimit + 1},
+	}
+
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			i := NewInspector(&fakeClient{}, &fakeNNExtender{})
+
+			_, err := i.InspectWord(context.Background(), test.word, &test.limit)
+			assert.IsType(t, ErrInvalidInspection{}, err)
+		})
+	}
+}
+
+func ptInt(in int) *int {
+	return &in
+}
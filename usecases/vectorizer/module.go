@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// DefaultModule is the name of the contextionary-based vectorizer which is
+// used unless another module is configured
+const DefaultModule = "text2vec-contextionary"
+
+// Module turns things and actions into vectors when they are imported, both
+// individually and in batches, and builds the search vectors of nearText-like
+// queries (concepts with optional moveTo/moveAwayFrom). An alternative
+// embedding service can be plugged in by implementing Module and registering a
+// factory for it with Register.
+type Module interface {
+	Thing(ctx context.Context, object *models.Thing) ([]float32, []InputElement, error)
+	Action(ctx context.Context, object *models.Action) ([]float32, []InputElement, error)
+
+	// BatchThings and BatchActions vectorize all objects of a batch import.
+	// The results are in the order of the objects, an object which can't be
+	// vectorized only fails on its own.
+	BatchThings(ctx context.Context, objects []*models.Thing) []BatchResult
+	BatchActions(ctx context.Context, objects []*models.Action) []BatchResult
+
+	ThingCorpus(ctx context.Context, object *models.Thing) ([]WeightedCorpus, error)
+	ActionCorpus(ctx context.Context, object *models.Action) ([]WeightedCorpus, error)
+
+	Corpi(ctx context.Context, corpi []string) ([]float32, error)
+	// BatchCorpi vectorizes every corpus on its own, the results are in the
+	// order of the corpi
+	BatchCorpi(ctx context.Context, corpi []string) []BatchResult
+	WeightedCorpi(ctx context.Context, corpi []string, weights []float32) ([]float32, error)
+	MoveTo(source []float32, target []float32, weight float32) ([]float32, error)
+	MoveAwayFrom(source []float32, target []float32, weight float32) ([]float32, error)
+
+	// SetIndexChecker is called once the schema is available, so that the
+	// module can decide which classes and properties to vectorize
+	SetIndexChecker(IndexCheck)
+}
+
+// BatchResult is the vector of a single item of a batch vectorization or the
+// reason why the item could not be vectorized
+type BatchResult struct {
+	Vector []float32
+	Source []InputElement
+	Err    error
+}
+
+// WordSpace is implemented by modules whose vectors are built from the words
+// of a vocabulary, such as the contextionary. Nearest neighbors,
+// interpretations, semantic paths and the inspection of words are only
+// available with such a module, see WordSpaceOf.
+type WordSpace interface {
+	ContextionaryClient
+	IsWordPresent(ctx context.Context, word string) (bool, error)
+	VectorForWord(ctx context.Context, word string) ([]float32, error)
+	MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error)
+	NearestWordsByVector(ctx context.Context, vector []float32, n int, k int) ([]string, []float32, error)
+	MultiNearestWordsByVector(ctx context.Context, vectors [][]float32, n int, k int) ([]*models.NearestNeighbors, error)
+}
+
+// WordSpaceOf returns the word space of the module. If the module has none,
+// every call of the returned word space fails.
+func WordSpaceOf(m Module) WordSpace {
+	if ws, ok := m.(WordSpace); ok {
+		return ws
+	}
+
+	return noWordSpace{}
+}
+
+// NormalizationSetter is implemented by modules which support the
+// server-wide text normalization settings
+type NormalizationSetter interface {
+	SetNormalization(Normalization, StopwordDetector)
+}
+
+// ContextionaryClient is the subset of the contextionary used for
+// vectorization
+type ContextionaryClient interface {
+	VectorForCorpi(ctx context.Context, corpi []string,
+		overrides map[string]string) ([]float32, []InputElement, error)
+}
+
+// ModuleDependencies are passed to every module factory, a module may ignore
+// the ones it does not need. BatchConcurrency limits how many objects of a
+// batch a module vectorizes in parallel if its embedding service can only
+// vectorize one object per call.
+type ModuleDependencies struct {
+	Contextionary    WordSpace
+	BatchConcurrency int
+}
+
+// ModuleFactory creates a new instance of a vectorizer module
+type ModuleFactory func(deps ModuleDependencies) (Module, error)
+
+var (
+	modulesLock sync.Mutex
+	modules     = map[string]ModuleFactory{}
+)
+
+func init() {
+	Register(DefaultModule, func(deps ModuleDependencies) (Module, error) {
+		if deps.Contextionary == nil {
+			return nil, fmt.Errorf("module %s requires a contextionary", DefaultModule)
+		}
+
+		return &contextionaryModule{
+			Vectorizer: New(deps.Contextionary, nil).
+				WithBatchConcurrency(deps.BatchConcurrency),
+			WordSpace: deps.Contextionary,
+		}, nil
+	})
+}
+
+// contextionaryModule vectorizes through the contextionary, whose vectors
+// live in the space of its words
+type contextionaryModule struct {
+	*Vectorizer
+	WordSpace
+}
+
+// Register makes a vectorizer module available under the given name, so it
+// can be selected through the config. It panics if the name is already taken.
+func Register(name string, factory ModuleFactory) {
+	modulesLock.Lock()
+	defer modulesLock.Unlock()
+
+	if _, ok := modules[name]; ok {
+		panic(fmt.Sprintf("vectorizer module %s registered twice", name))
+	}
+
+	modules[name] = factory
+}
+
+// NewModule creates the module registered under the given name. The default
+// module is used if the name is empty.
+func NewModule(name string, deps ModuleDependencies) (Module, error) {
+	if name == "" {
+		name = DefaultModule
+	}
+
+	modulesLock.Lock()
+	factory, ok := modules[name]
+	modulesLock.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown vectorizer module '%s', available modules are %v",
+			name, ModuleNames())
+	}
+
+	return factory(deps)
+}
+
+// ModuleNames lists all registered modules in alphabetical order
+func ModuleNames() []string {
+	modulesLock.Lock()
+	defer modulesLock.Unlock()
+
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+type noWordSpace struct{}
+
+var errNoWordSpace = fmt.Errorf("the configured vectorizer module has no "+
+	"vocabulary, this is only supported with the %s module", DefaultModule)
+
+func (noWordSpace) VectorForCorpi(ctx context.Context, corpi []string,
+	overrides map[string]string) ([]float32, []InputElement, error) {
+	return nil, nil, errNoWordSpace
+}
+
+func (noWordSpace) IsWordPresent(ctx context.Context, word string) (bool, error) {
+	return false, errNoWordSpace
+}
+
+func (noWordSpace) VectorForWord(ctx context.Context, word string) ([]float32, error) {
+	return nil, errNoWordSpace
+}
+
+func (noWordSpace) MultiVectorForWord(ctx context.Context, words []string) ([][]float32, error) {
+	return nil, errNoWordSpace
+}
+
+func (noWordSpace) NearestWordsByVector(ctx context.Context, vector []float32,
+	n int, k int) ([]string, []float32, error) {
+	return nil, nil, errNoWordSpace
+}
+
+func (noWordSpace) MultiNearestWordsByVector(ctx context.Context, vectors [][]float32,
+	n int, k int) ([]*models.NearestNeighbors, error) {
+	return nil, errNoWordSpace
+}
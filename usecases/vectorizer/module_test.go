@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleRegistry(t *testing.T) {
+	deps := ModuleDependencies{Contextionary: &fakeClient{}}
+
+	t.Run("without a name the contextionary module is used", func(t *testing.T) {
+		m, err := NewModule("", deps)
+		require.Nil(t, err)
+		_, ok := m.(NormalizationSetter)
+		assert.True(t, ok)
+		_, ok = m.(WordSpace)
+		assert.True(t, ok, "the contextionary has a vocabulary")
+	})
+
+	t.Run("the contextionary module requires a contextionary", func(t *testing.T) {
+		_, err := NewModule(DefaultModule, ModuleDependencies{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with an unknown name", func(t *testing.T) {
+		_, err := NewModule("text2vec-unknown", deps)
+		assert.EqualError(t, err, "unknown vectorizer module 'text2vec-unknown', "+
+			"available modules are [text2vec-contextionary]")
+	})
+
+	t.Run("with an alternative module", func(t *testing.T) {
+		Register("text2vec-test", func(deps ModuleDependencies) (Module, error) {
+			return New(deps.Contextionary, nil), nil
+		})
+		defer func() {
+			modulesLock.Lock()
+			delete(modules, "text2vec-test")
+			modulesLock.Unlock()
+		}()
+
+		m, err := NewModule("text2vec-test", deps)
+		require.Nil(t, err)
+		assert.NotNil(t, m)
+		assert.Equal(t, []string{"text2vec-contextionary", "text2vec-test"}, ModuleNames())
+
+		_, err = WordSpaceOf(m).VectorForWord(context.Background(), "car")
+		assert.Equal(t, errNoWordSpace, err)
+	})
+
+	t.Run("registering the same name twice", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register(DefaultModule, nil)
+		})
+	})
+}
@@ -12,10 +12,12 @@
 package vectorizer
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMoveVectorToAnother(t *testing.T) {
@@ -165,3 +167,52 @@ func TestMoveVectorToAnother(t *testing.T) {
 		}
 	})
 }
+
+func TestWeightedCorpi(t *testing.T) {
+	client := &fakeCorpusClient{
+		strict: true,
+		vectors: map[string][]float32{
+			"company":    []float32{1, 0},
+			"technology": []float32{0, 1},
+		},
+	}
+	v := New(client, &propertyIndexer{})
+
+	t.Run("without weights", func(t *testing.T) {
+		res, err := v.WeightedCorpi(context.Background(),
+			[]string{"company", "technology"}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.5, 0.5}, res)
+	})
+
+	t.Run("with weights", func(t *testing.T) {
+		res, err := v.WeightedCorpi(context.Background(),
+			[]string{"company", "technology"}, []float32{1, 0.25})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.8, 0.2}, res)
+	})
+
+	t.Run("with a concept not present in the contextionary", func(t *testing.T) {
+		_, err := v.WeightedCorpi(context.Background(),
+			[]string{"company", "technologyyy"}, nil)
+		assert.EqualError(t, err, "concept 'technologyyy': vectorizing corpus '[technologyyy]': "+
+			"none of the words in corpus 'technologyyy' are present in the contextionary")
+	})
+
+	t.Run("a single corpus not present in the contextionary", func(t *testing.T) {
+		_, err := v.Corpi(context.Background(), []string{"technologyyy"})
+		assert.IsType(t, ErrNoUsableWords{}, err)
+	})
+
+	t.Run("with a weight missing", func(t *testing.T) {
+		_, err := v.WeightedCorpi(context.Background(),
+			[]string{"company", "technology"}, []float32{1})
+		assert.EqualError(t, err, "got 1 weights for 2 corpi")
+	})
+
+	t.Run("with all weights 0", func(t *testing.T) {
+		_, err := v.WeightedCorpi(context.Background(),
+			[]string{"company", "technology"}, []float32{0, 0})
+		assert.EqualError(t, err, "at least one weight must be greater than 0")
+	})
+}
@@ -26,6 +26,12 @@ func (n *NoOpVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32,
 	return []float32{}, nil
 }
 
+// WeightedCorpi is not implemented in the NoOpVectorizer
+func (n *NoOpVectorizer) WeightedCorpi(ctx context.Context, corpi []string,
+	weights []float32) ([]float32, error) {
+	return []float32{}, nil
+}
+
 // MoveTo is not implemented in the NoOpVectorizer
 func (n *NoOpVectorizer) MoveTo(source []float32, target []float32, weight float32) ([]float32, error) {
 	return []float32{}, nil
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization are the steps which are applied to the values of string and
+// text properties before they are vectorized
+type Normalization struct {
+	Lowercase        bool
+	StripPunctuation bool
+	UnicodeNFC       bool
+	RemoveStopwords  bool
+}
+
+// DefaultNormalization only lowercases the text, which is all the vectorizer
+// did before the other steps could be configured
+var DefaultNormalization = Normalization{Lowercase: true}
+
+// WithClass overrides the steps which are set on the class
+func (n Normalization) WithClass(class *models.TextNormalization) Normalization {
+	if class == nil {
+		return n
+	}
+
+	if class.Lowercase != nil {
+		n.Lowercase = *class.Lowercase
+	}
+	if class.StripPunctuation != nil {
+		n.StripPunctuation = *class.StripPunctuation
+	}
+	if class.UnicodeNFC != nil {
+		n.UnicodeNFC = *class.UnicodeNFC
+	}
+	if class.RemoveStopwords != nil {
+		n.RemoveStopwords = *class.RemoveStopwords
+	}
+
+	return n
+}
+
+// StopwordDetector decides whether a word is a stopword of the contextionary
+type StopwordDetector interface {
+	IsStopWord(ctx context.Context, word string) (bool, error)
+}
+
+// SetNormalization sets the steps for all classes which don't configure
+// their own. Stopwords can only be removed if a detector is set.
+func (v *Vectorizer) SetNormalization(defaults Normalization, stopwords StopwordDetector) {
+	v.normalization = defaults
+	v.stopwords = nil
+	if stopwords != nil {
+		v.stopwords = newStopwordCache(stopwords)
+	}
+}
+
+// maxStopwordCacheEntries bounds the memory of the stopword cache, most of
+// the cached words are not stopwords, so there is no natural limit
+const maxStopwordCacheEntries = 50000
+
+// stopwordCache remembers which words are stopwords, so that not every word
+// of every text costs a call to the contextionary. The stopwords of a
+// contextionary don't change while it is running, so entries never expire,
+// but the cache starts over once it is full. Errors are not cached.
+type stopwordCache struct {
+	sync.RWMutex
+	detector StopwordDetector
+	words    map[string]bool
+}
+
+func newStopwordCache(detector StopwordDetector) *stopwordCache {
+	return &stopwordCache{detector: detector, words: map[string]bool{}}
+}
+
+func (c *stopwordCache) IsStopWord(ctx context.Context, word string) (bool, error) {
+	c.RLock()
+	stopword, ok := c.words[word]
+	c.RUnlock()
+	if ok {
+		return stopword, nil
+	}
+
+	stopword, err := c.detector.IsStopWord(ctx, word)
+	if err != nil {
+		return false, err
+	}
+
+	c.Lock()
+	if len(c.words) >= maxStopwordCacheEntries {
+		c.words = map[string]bool{}
+	}
+	c.words[word] = stopword
+	c.Unlock()
+
+	return stopword, nil
+}
+
+// normalize applies the steps in an order in which they don't undo each
+// other: the unicode normalization comes first, so that composed characters
+// are not torn apart, and stopwords are detected on the final words.
+func (v *Vectorizer) normalize(ctx context.Context, text string,
+	n Normalization) (string, error) {
+	if n.UnicodeNFC {
+		text = norm.NFC.String(text)
+	}
+
+	if n.Lowercase {
+		text = strings.ToLower(text)
+	}
+
+	if n.StripPunctuation {
+		text = strings.Join(strings.Fields(strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return ' '
+			}
+
+			return r
+		}, text)), " ")
+	}
+
+	if n.RemoveStopwords && v.stopwords != nil {
+		words := strings.Fields(text)
+		kept := words[:0]
+		for _, word := range words {
+			stopword, err := v.stopwords.IsStopWord(ctx, strings.ToLower(word))
+			if err != nil {
+				return "", fmt.Errorf("check stopword %q: %v", word, err)
+			}
+
+			if !stopword {
+				kept = append(kept, word)
+			}
+		}
+		text = strings.Join(kept, " ")
+	}
+
+	return text, nil
+}
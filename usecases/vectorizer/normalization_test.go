@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type normalizingPropertyIndexer struct {
+	propertyIndexer
+	normalization *models.TextNormalization
+}
+
+func (p *normalizingPropertyIndexer) TextNormalization(class string) *models.TextNormalization {
+	return p.normalization
+}
+
+type fakeStopwordDetector struct {
+	stopwords map[string]bool
+	err       error
+	calls     int
+}
+
+func (f *fakeStopwordDetector) IsStopWord(ctx context.Context, word string) (bool, error) {
+	f.calls++
+	return f.stopwords[word], f.err
+}
+
+func TestVectorizerNormalization(t *testing.T) {
+	input := &models.Thing{
+		Class: "Car",
+		Schema: map[string]interface{}{
+			"title": "The Cafe\u0301, a Review!",
+		},
+	}
+	enabled := true
+	disabled := false
+
+	tests := []struct {
+		name          string
+		defaults      Normalization
+		class         *models.TextNormalization
+		expectedTitle string
+	}{
+		{
+			name:          "with the default normalization",
+			defaults:      DefaultNormalization,
+			expectedTitle: "the cafe\u0301, a review!",
+		},
+		{
+			name:          "with lowercasing turned off globally",
+			defaults:      Normalization{},
+			expectedTitle: "The Cafe\u0301, a Review!",
+		},
+		{
+			name:          "with unicode normalization",
+			defaults:      Normalization{Lowercase: true, UnicodeNFC: true},
+			expectedTitle: "the caf\u00e9, a review!",
+		},
+		{
+			name:          "with punctuation stripped",
+			defaults:      Normalization{Lowercase: true, StripPunctuation: true},
+			expectedTitle: "the cafe\u0301 a review",
+		},
+		{
+			name:          "with stopwords removed",
+			defaults:      Normalization{Lowercase: true, RemoveStopwords: true},
+			expectedTitle: "cafe\u0301, review!",
+		},
+		{
+			name:          "with stopwords removed without lowercasing",
+			defaults:      Normalization{RemoveStopwords: true},
+			expectedTitle: "Cafe\u0301, Review!",
+		},
+		{
+			name:     "with the class overriding the global steps",
+			defaults: Normalization{Lowercase: true, StripPunctuation: true},
+			class: &models.TextNormalization{
+				Lowercase:  &disabled,
+				UnicodeNFC: &enabled,
+			},
+			expectedTitle: "The Caf\u00e9 a Review",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := New(&fakeCorpusClient{}, &normalizingPropertyIndexer{
+				propertyIndexer: propertyIndexer{excludedClass: "Car", excludedProperty: "title"},
+				normalization:   test.class,
+			})
+			v.SetNormalization(test.defaults, &fakeStopwordDetector{
+				stopwords: map[string]bool{"the": true, "a": true},
+			})
+
+			corpus, err := v.ThingCorpus(context.Background(), input)
+			require.Nil(t, err)
+			require.Len(t, corpus, 1)
+			assert.Equal(t, test.expectedTitle, corpus[0].Corpus)
+		})
+	}
+
+	t.Run("with the stopword detector failing", func(t *testing.T) {
+		v := New(&fakeCorpusClient{}, &propertyIndexer{})
+		v.SetNormalization(Normalization{RemoveStopwords: true},
+			&fakeStopwordDetector{err: errors.New("contextionary unavailable")})
+
+		_, err := v.ThingCorpus(context.Background(), input)
+		assert.EqualError(t, err,
+			"check stopword \"The\": contextionary unavailable")
+	})
+
+	t.Run("every word is only checked once", func(t *testing.T) {
+		detector := &fakeStopwordDetector{stopwords: map[string]bool{"the": true}}
+		v := New(&fakeCorpusClient{}, &propertyIndexer{})
+		v.SetNormalization(Normalization{Lowercase: true, RemoveStopwords: true}, detector)
+
+		for i := 0; i < 2; i++ {
+			_, err := v.ThingCorpus(context.Background(), &models.Thing{
+				Class:  "Car",
+				Schema: map[string]interface{}{"title": "the car and the bike"},
+			})
+			require.Nil(t, err)
+		}
+
+		// "the", "car", "and" and "bike"
+		assert.Equal(t, 4, detector.calls)
+	})
+}
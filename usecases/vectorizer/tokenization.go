@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2020 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// tokenize prepares the value of a property according to its tokenization,
+// so that the contextionary splits it into the desired words
+func tokenize(value, tokenization string) string {
+	switch tokenization {
+	case models.PropertyTokenizationWhitespace:
+		// the contextionary splits at any non-alphanumeric character, so these
+		// are removed from within each whitespace-separated word
+		words := strings.Fields(value)
+		for i, word := range words {
+			words[i] = onlyAlphanumeric(word)
+		}
+		return strings.Join(words, " ")
+	case models.PropertyTokenizationField:
+		// compound words of the contextionary, including its extensions, are
+		// lowercase words separated by single spaces. Joining the words instead
+		// would produce a single unknown word, e.g. "newyork".
+		return strings.Join(strings.FieldsFunc(strings.ToLower(value), notAlphanumeric), " ")
+	default:
+		// word tokenization is what the contextionary does on its own
+		return value
+	}
+}
+
+func onlyAlphanumeric(in string) string {
+	return strings.Map(func(r rune) rune {
+		if notAlphanumeric(r) {
+			return -1
+		}
+
+		return r
+	}, in)
+}
+
+func notAlphanumeric(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -14,16 +14,24 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fatih/camelcase"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/tracing"
 )
 
 // Vectorizer turns things and actions into vectors
 type Vectorizer struct {
-	client     client
-	indexCheck IndexCheck
+	client        ContextionaryClient
+	indexCheck    IndexCheck
+	normalization Normalization
+	stopwords     StopwordDetector
+
+	// batchConcurrency limits how many objects of a batch are vectorized in
+	// parallel, the contextionary only vectorizes one object per call
+	batchConcurrency int
 }
 
 type ErrNoUsableWords struct {
@@ -38,9 +46,19 @@ func NewErrNoUsableWordsf(pattern string, args ...interface{}) ErrNoUsableWords
 	return ErrNoUsableWords{Err: fmt.Errorf(pattern, args...)}
 }
 
-type client interface {
-	VectorForCorpi(ctx context.Context, corpi []string,
-		overrides map[string]string) ([]float32, []InputElement, error)
+// ErrContextionaryUnavailable indicates that the contextionary could not be
+// reached at all (as opposed to the contextionary not knowing the words), for
+// example because all retries failed or the circuit breaker is open
+type ErrContextionaryUnavailable struct {
+	Err error
+}
+
+func (e ErrContextionaryUnavailable) Error() string {
+	return e.Err.Error()
+}
+
+func NewErrContextionaryUnavailablef(pattern string, args ...interface{}) ErrContextionaryUnavailable {
+	return ErrContextionaryUnavailable{Err: fmt.Errorf(pattern, args...)}
 }
 
 // IndexCheck returns whether a property of a class should be indexed
@@ -48,11 +66,30 @@ type IndexCheck interface {
 	Indexed(className, property string) bool
 	VectorizeClassName(className string) bool
 	VectorizePropertyName(className, propertyName string) bool
+	VectorWeight(className, propertyName string) float32
+	Tokenization(className, propertyName string) string
+	TextNormalization(className string) *models.TextNormalization
 }
 
 // New from c11y client
-func New(client client, indexCheck IndexCheck) *Vectorizer {
-	return &Vectorizer{client, indexCheck}
+func New(client ContextionaryClient, indexCheck IndexCheck) *Vectorizer {
+	return &Vectorizer{
+		client:        client,
+		indexCheck:    indexCheck,
+		normalization: DefaultNormalization,
+
+		batchConcurrency: 1,
+	}
+}
+
+// WithBatchConcurrency sets how many objects of a batch are vectorized in
+// parallel, values below 1 are ignored
+func (v *Vectorizer) WithBatchConcurrency(n int) *Vectorizer {
+	if n > 0 {
+		v.batchConcurrency = n
+	}
+
+	return v
 }
 
 func (v *Vectorizer) SetIndexChecker(ic IndexCheck) {
@@ -81,37 +118,16 @@ func (v *Vectorizer) Action(ctx context.Context, object *models.Action) ([]float
 
 func (v *Vectorizer) object(ctx context.Context, className string,
 	schema interface{}, overrides map[string]string) ([]float32, []InputElement, error) {
-	var corpi []string
-
-	if v.indexCheck.VectorizeClassName(className) {
-		corpi = append(corpi, camelCaseToLower(className))
-	}
+	span, ctx := tracing.StartSpanFromContext(ctx, "vectorizer.object")
+	defer span.Finish()
+	span.SetTag("class", className)
 
-	if schema != nil {
-		for prop, value := range schema.(map[string]interface{}) {
-			if !v.indexCheck.Indexed(className, prop) {
-				continue
-			}
-
-			valueString, ok := value.(string)
-			if ok {
-				if v.indexCheck.VectorizePropertyName(className, prop) {
-					// use prop and value
-					corpi = append(corpi, strings.ToLower(
-						fmt.Sprintf("%s %s", camelCaseToLower(prop), valueString)))
-				} else {
-					corpi = append(corpi, strings.ToLower(valueString))
-				}
-			}
-		}
-	}
-
-	if len(corpi) == 0 {
-		// fall back to using the class name
-		corpi = append(corpi, camelCaseToLower(className))
+	corpi, weights, err := v.objectCorpi(ctx, className, schema)
+	if err != nil {
+		return nil, nil, fmt.Errorf("normalizing object text: %v", err)
 	}
 
-	vector, ie, err := v.client.VectorForCorpi(ctx, []string{strings.Join(corpi, " ")}, overrides)
+	vector, ie, err := v.vectorForWeightedCorpi(ctx, corpi, weights, overrides)
 	if err != nil {
 		switch err.(type) {
 		case ErrNoUsableWords:
@@ -137,6 +153,10 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 				"\n\nThe following words were extracted from your object: %v"+
 				"\n\nTo learn more about the contextionary and how it behaves, check out: https://www.semi.technology/documentation/weaviate/current/contextionary.html"+
 				"\n\nOriginal error: %v", corpi, err)
+		case ErrContextionaryUnavailable:
+			return nil, nil, fmt.Errorf("vectorizing object failed, because the contextionary "+
+				"is unreachable, this is not a problem with the object itself and the "+
+				"request can be retried: %v", err)
 		default:
 			return nil, nil, fmt.Errorf("vectorizing object with corpus '%+v': %v", corpi, err)
 		}
@@ -145,6 +165,195 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 	return vector, ie, nil
 }
 
+// ThingCorpus returns the corpi that are vectorized for the thing, exactly as
+// they are passed to the contextionary
+func (v *Vectorizer) ThingCorpus(ctx context.Context,
+	object *models.Thing) ([]WeightedCorpus, error) {
+	corpi, weights, err := v.objectCorpi(ctx, object.Class, object.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return weightedCorpi(corpi, weights), nil
+}
+
+// ActionCorpus returns the corpi that are vectorized for the action, exactly
+// as they are passed to the contextionary
+func (v *Vectorizer) ActionCorpus(ctx context.Context,
+	object *models.Action) ([]WeightedCorpus, error) {
+	corpi, weights, err := v.objectCorpi(ctx, object.Class, object.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return weightedCorpi(corpi, weights), nil
+}
+
+// objectCorpi builds one corpus per vectorized property (and the class name if
+// configured) along with the weight of each corpus. The values of the
+// properties are normalized as configured for the class.
+func (v *Vectorizer) objectCorpi(ctx context.Context, className string,
+	schema interface{}) ([]string, []float32, error) {
+	var corpi []string
+	var weights []float32
+	normalization := v.normalization.WithClass(v.indexCheck.TextNormalization(className))
+
+	if v.indexCheck.VectorizeClassName(className) {
+		corpi = append(corpi, camelCaseToLower(className))
+		weights = append(weights, 1)
+	}
+
+	if schema != nil {
+		props := schema.(map[string]interface{})
+		for _, prop := range sortedKeys(props) {
+			value := props[prop]
+			if !v.indexCheck.Indexed(className, prop) {
+				continue
+			}
+
+			weight := v.indexCheck.VectorWeight(className, prop)
+			if weight == 0 {
+				continue
+			}
+
+			valueString, ok := value.(string)
+			if ok {
+				valueString, err := v.normalize(ctx, valueString, normalization)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				valueString = tokenize(valueString, v.indexCheck.Tokenization(className, prop))
+				if v.indexCheck.VectorizePropertyName(className, prop) {
+					// use prop and value
+					corpi = append(corpi,
+						fmt.Sprintf("%s %s", camelCaseToLower(prop), valueString))
+				} else {
+					corpi = append(corpi, valueString)
+				}
+				weights = append(weights, weight)
+			}
+		}
+	}
+
+	if len(corpi) == 0 {
+		// fall back to using the class name
+		corpi = append(corpi, camelCaseToLower(className))
+		weights = append(weights, 1)
+	}
+
+	return corpi, weights, nil
+}
+
+func sortedKeys(in map[string]interface{}) []string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// vectorForWeightedCorpi builds a single vector for all corpi. As long as
+// every corpus has the default weight, they are vectorized together.
+// Otherwise the corpi are vectorized once per distinct weight and the
+// resulting vectors are combined into a weighted mean in which each vector
+// counts as often as its corpus has words, multiplied by its weight. With
+// equal weights this approximates vectorizing all corpi together.
+func (v *Vectorizer) vectorForWeightedCorpi(ctx context.Context, corpi []string,
+	weights []float32, overrides map[string]string) ([]float32, []InputElement, error) {
+	groups := weightedCorpi(corpi, weights)
+	if len(groups) == 1 {
+		return v.client.VectorForCorpi(ctx, []string{groups[0].Corpus}, overrides)
+	}
+
+	var combined []float32
+	var total float32
+	var elements []InputElement
+	var lastErr error
+	for _, group := range groups {
+		weight, corpus := group.Weight, group.Corpus
+		vector, ie, err := v.client.VectorForCorpi(ctx, []string{corpus}, overrides)
+		if err != nil {
+			if _, ok := err.(ErrNoUsableWords); ok {
+				// a single group without any usable words is fine, as long as at
+				// least one group can be vectorized, just like a single word
+				// without meaning does not fail a combined corpus
+				lastErr = err
+				continue
+			}
+
+			return nil, nil, err
+		}
+
+		if combined == nil {
+			combined = make([]float32, len(vector))
+		}
+
+		factor := weight * float32(len(strings.Fields(corpus)))
+		for i := range vector {
+			combined[i] += vector[i] * factor
+		}
+		total += factor
+		elements = append(elements, ie...)
+	}
+
+	if combined == nil {
+		return nil, nil, lastErr
+	}
+
+	for i := range combined {
+		combined[i] = combined[i] / total
+	}
+
+	return combined, elements, nil
+}
+
+// WeightedCorpus is a single corpus as passed to the contextionary
+type WeightedCorpus struct {
+	Corpus string
+	Weight float32
+}
+
+// weightedCorpi combines all corpi with the same weight into a single corpus,
+// in the order in which the weights first appear. As long as every corpus has
+// the default weight, there is only a single corpus.
+func weightedCorpi(corpi []string, weights []float32) []WeightedCorpus {
+	if allDefaultWeights(weights) {
+		return []WeightedCorpus{{Corpus: strings.Join(corpi, " "), Weight: 1}}
+	}
+
+	var distinctWeights []float32
+	corpiByWeight := map[float32][]string{}
+	for i, corpus := range corpi {
+		if _, ok := corpiByWeight[weights[i]]; !ok {
+			distinctWeights = append(distinctWeights, weights[i])
+		}
+		corpiByWeight[weights[i]] = append(corpiByWeight[weights[i]], corpus)
+	}
+
+	out := make([]WeightedCorpus, len(distinctWeights))
+	for i, weight := range distinctWeights {
+		out[i] = WeightedCorpus{
+			Corpus: strings.Join(corpiByWeight[weight], " "),
+			Weight: weight,
+		}
+	}
+
+	return out
+}
+
+func allDefaultWeights(weights []float32) bool {
+	for _, weight := range weights {
+		if weight != 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Corpi takes any list of strings and builds a common vector for all of them
 func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
 ) ([]float32, error) {
@@ -155,12 +364,66 @@ func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
 
 	vector, _, err := v.client.VectorForCorpi(ctx, corpi, nil)
 	if err != nil {
+		if _, ok := err.(ErrNoUsableWords); ok {
+			return nil, NewErrNoUsableWordsf("vectorizing corpus '%+v': %v", corpi, err)
+		}
 		return nil, fmt.Errorf("vectorizing corpus '%+v': %v", corpi, err)
 	}
 
 	return vector, nil
 }
 
+// WeightedCorpi vectorizes every corpus on its own and combines the vectors
+// into their mean, weighted by the weight at the same position. Without
+// weights, all corpi count the same. As opposed to Corpi, every single corpus
+// must be known to the contextionary.
+func (v *Vectorizer) WeightedCorpi(ctx context.Context, corpi []string,
+	weights []float32) ([]float32, error) {
+	if len(corpi) == 0 {
+		return nil, fmt.Errorf("at least one corpus is required")
+	}
+
+	if len(weights) > 0 && len(weights) != len(corpi) {
+		return nil, fmt.Errorf("got %d weights for %d corpi", len(weights), len(corpi))
+	}
+
+	var out []float32
+	var totalWeight float32
+	for i, corpus := range corpi {
+		weight := float32(1)
+		if len(weights) > 0 {
+			weight = weights[i]
+		}
+
+		vector, err := v.Corpi(ctx, []string{corpus})
+		if err != nil {
+			return nil, fmt.Errorf("concept '%s': %v", corpus, err)
+		}
+
+		if out == nil {
+			out = make([]float32, len(vector))
+		} else if len(vector) != len(out) {
+			return nil, fmt.Errorf("concept '%s': vector lengths don't match: got %d and %d",
+				corpus, len(out), len(vector))
+		}
+
+		for j := range vector {
+			out[j] += vector[j] * weight
+		}
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("at least one weight must be greater than 0")
+	}
+
+	for j := range out {
+		out[j] /= totalWeight
+	}
+
+	return out, nil
+}
+
 func camelCaseToLower(in string) string {
 	parts := camelcase.Split(in)
 	var sb strings.Builder
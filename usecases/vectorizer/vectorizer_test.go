@@ -183,6 +183,183 @@ func (p *propertyIndexer) VectorizePropertyName(class, prop string) bool {
 	return p.excludedProperty != prop
 }
 
+func (p *propertyIndexer) VectorWeight(class, prop string) float32 {
+	return 1
+}
+
+func (p *propertyIndexer) Tokenization(class, prop string) string {
+	return models.PropertyTokenizationWord
+}
+
+func (p *propertyIndexer) TextNormalization(class string) *models.TextNormalization {
+	return nil
+}
+
+type tokenizedPropertyIndexer struct {
+	propertyIndexer
+	tokenization map[string]string
+}
+
+func (p *tokenizedPropertyIndexer) Tokenization(class, prop string) string {
+	if tokenization, ok := p.tokenization[prop]; ok {
+		return tokenization
+	}
+
+	return models.PropertyTokenizationWord
+}
+
+type weightedPropertyIndexer struct {
+	propertyIndexer
+	weights map[string]float32
+}
+
+func (p *weightedPropertyIndexer) VectorWeight(class, prop string) float32 {
+	if weight, ok := p.weights[prop]; ok {
+		return weight
+	}
+
+	return 1
+}
+
+func TestVectorizingWithPropertyWeights(t *testing.T) {
+	input := &models.Thing{
+		Class: "Car",
+		Schema: map[string]interface{}{
+			"title": "fast",
+			"body":  "a very long review",
+		},
+	}
+
+	vectors := map[string][]float32{
+		"title fast":              {1, 0},
+		"body a very long review": {0, 1},
+	}
+
+	t.Run("with default weights the corpus is vectorized at once", func(t *testing.T) {
+		client := &fakeCorpusClient{vectors: map[string][]float32{}}
+		v := New(client, &weightedPropertyIndexer{})
+
+		_, _, err := v.Thing(context.Background(), input)
+		require.Nil(t, err)
+		require.Len(t, client.calls, 1)
+	})
+
+	t.Run("with a higher weight on the title", func(t *testing.T) {
+		client := &fakeCorpusClient{vectors: vectors}
+		v := New(client, &weightedPropertyIndexer{
+			propertyIndexer: propertyIndexer{excludedClass: "Car"},
+			weights:         map[string]float32{"title": 10},
+		})
+
+		res, _, err := v.Thing(context.Background(), input)
+		require.Nil(t, err)
+
+		// title: 2 words * 10, body: 5 words * 1
+		assert.InDeltaSlice(t, []float32{0.8, 0.2}, res, 0.0001)
+		assert.ElementsMatch(t, []string{"title fast", "body a very long review"}, client.calls)
+	})
+
+	t.Run("with a weight of zero the property is ignored", func(t *testing.T) {
+		client := &fakeCorpusClient{vectors: vectors}
+		v := New(client, &weightedPropertyIndexer{
+			weights: map[string]float32{"body": 0},
+		})
+
+		_, _, err := v.Thing(context.Background(), input)
+		require.Nil(t, err)
+		require.Len(t, client.calls, 1)
+		assert.ElementsMatch(t, []string{"car", "title", "fast"},
+			strings.Split(client.calls[0], " "))
+	})
+}
+
+func TestVectorizerCorpus(t *testing.T) {
+	input := &models.Thing{
+		Class: "Car",
+		Schema: map[string]interface{}{
+			"title": "Fast",
+			"body":  "a very long review",
+		},
+	}
+
+	t.Run("with default weights", func(t *testing.T) {
+		v := New(&fakeCorpusClient{}, &weightedPropertyIndexer{})
+
+		corpus, err := v.ThingCorpus(context.Background(), input)
+		require.Nil(t, err)
+		assert.Equal(t, []WeightedCorpus{
+			{Corpus: "car body a very long review title fast", Weight: 1},
+		}, corpus)
+	})
+
+	t.Run("with a higher weight on the title", func(t *testing.T) {
+		v := New(&fakeCorpusClient{}, &weightedPropertyIndexer{
+			propertyIndexer: propertyIndexer{excludedClass: "Car"},
+			weights:         map[string]float32{"title": 10},
+		})
+
+		corpus, err := v.ThingCorpus(context.Background(), input)
+		require.Nil(t, err)
+		assert.Equal(t, []WeightedCorpus{
+			{Corpus: "body a very long review", Weight: 1},
+			{Corpus: "title fast", Weight: 10},
+		}, corpus)
+	})
+}
+
+func TestVectorizingWithTokenization(t *testing.T) {
+	input := &models.Thing{
+		Class: "Car",
+		Schema: map[string]interface{}{
+			"sku":   "AB-12 34",
+			"title": "e-mail Client",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		tokenization string
+		expected     string
+	}{
+		{
+			name:         "word tokenization leaves splitting to the contextionary",
+			tokenization: models.PropertyTokenizationWord,
+			expected:     "e-mail client sku ab-12 34",
+		},
+		{
+			name:         "whitespace tokenization keeps words together",
+			tokenization: models.PropertyTokenizationWhitespace,
+			expected:     "e-mail client sku ab12 34",
+		},
+		{
+			name:         "field tokenization turns the value into a single phrase",
+			tokenization: models.PropertyTokenizationField,
+			expected:     "e-mail client sku ab 12 34",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := New(&fakeCorpusClient{}, &tokenizedPropertyIndexer{
+				propertyIndexer: propertyIndexer{excludedClass: "Car", excludedProperty: "title"},
+				tokenization:    map[string]string{"sku": test.tokenization},
+			})
+
+			corpus, err := v.ThingCorpus(context.Background(), input)
+			require.Nil(t, err)
+			require.Len(t, corpus, 1)
+			assert.ElementsMatch(t, strings.Split(test.expected, " "),
+				strings.Split(corpus[0].Corpus, " "))
+		})
+	}
+}
+
+func TestFieldTokenization(t *testing.T) {
+	// a compound word of the contextionary must survive as such
+	assert.Equal(t, "new york", tokenize("New  York", models.PropertyTokenizationField))
+	assert.Equal(t, "ab 12 34", tokenize("AB-12 34", models.PropertyTokenizationField))
+}
+
 func TestVectorizingActions(t *testing.T) {
 	type testCase struct {
 		name               string